@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// topobackup exports the whole topo to an archive and restores it, and
+// checks the topo records are consistent.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/helpers"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %v [flags] <command> [args]\n\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "Commands:\n")
+	fmt.Fprintf(os.Stderr, "  backup <archive>   writes all the files of the global and cell topos to a gzipped tar archive\n")
+	fmt.Fprintf(os.Stderr, "  restore <archive>  writes the files of an archive to the topo, keeping existing cell records\n")
+	fmt.Fprintf(os.Stderr, "  check              reports the inconsistencies between topo records\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	defer exit.RecoverAll()
+	defer logutil.Flush()
+
+	flag.Usage = usage
+	flag.Parse()
+	args := flag.Args()
+	if len(args) == 0 {
+		flag.Usage()
+		exit.Return(1)
+	}
+
+	ts := topo.Open()
+	defer ts.Close()
+	ctx := context.Background()
+
+	switch args[0] {
+	case "backup":
+		if len(args) != 2 {
+			log.Exitf("backup requires the archive file name")
+		}
+		backup(ctx, ts, args[1])
+	case "restore":
+		if len(args) != 2 {
+			log.Exitf("restore requires the archive file name")
+		}
+		restore(ctx, ts, args[1])
+	case "check":
+		if len(args) != 1 {
+			log.Exitf("check doesn't take any parameter")
+		}
+		check(ctx, ts)
+	default:
+		flag.Usage()
+		log.Exitf("unknown command %v", args[0])
+	}
+}
+
+func backup(ctx context.Context, ts *topo.Server, fileName string) {
+	f, err := os.Create(fileName)
+	if err != nil {
+		log.Exitf("Cannot create %v: %v", fileName, err)
+	}
+	manifest, count, err := helpers.BackupTopo(ctx, ts, f)
+	if err != nil {
+		f.Close()
+		log.Exitf("BackupTopo failed: %v", err)
+	}
+	if err := f.Close(); err != nil {
+		log.Exitf("Cannot write %v: %v", fileName, err)
+	}
+	fmt.Printf("Backed up %v files of cells %v to %v\n", count, manifest.Cells, fileName)
+}
+
+func restore(ctx context.Context, ts *topo.Server, fileName string) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		log.Exitf("Cannot open %v: %v", fileName, err)
+	}
+	defer f.Close()
+	manifest, count, err := helpers.RestoreTopo(ctx, ts, f)
+	if err != nil {
+		log.Exitf("RestoreTopo failed after restoring %v files: %v", count, err)
+	}
+	fmt.Printf("Restored %v files of cells %v, backed up at %v\n", count, manifest.Cells, manifest.BackupTime)
+}
+
+func check(ctx context.Context, ts *topo.Server) {
+	problems, err := helpers.CheckTopo(ctx, ts)
+	if err != nil {
+		log.Exitf("CheckTopo failed: %v", err)
+	}
+	for _, problem := range problems {
+		fmt.Println(problem)
+	}
+	if len(problems) > 0 {
+		log.Exitf("Found %v inconsistencies in the topo", len(problems))
+	}
+	fmt.Println("No inconsistencies found in the topo")
+}
@@ -28,6 +28,8 @@ import (
 	"vitess.io/vitess/go/vt/vtadmin/cluster"
 	"vitess.io/vitess/go/vt/vtadmin/grpcserver"
 	vtadminhttp "vitess.io/vitess/go/vt/vtadmin/http"
+	"vitess.io/vitess/go/vt/vtadmin/rbac"
+	"vitess.io/vitess/go/vt/vttls"
 )
 
 var (
@@ -36,6 +38,11 @@ var (
 	clusterConfigs       cluster.ClustersFlag
 	clusterFileConfig    cluster.FileConfig
 	defaultClusterConfig cluster.Config
+	rbacConfigPath       string
+	disableRBAC          bool
+	tlsCert              string
+	tlsKey               string
+	tlsCA                string
 
 	rootCmd = &cobra.Command{
 		Use: "vtadmin",
@@ -67,7 +74,52 @@ func run(cmd *cobra.Command, args []string) {
 		clusters[i] = cluster
 	}
 
-	s := vtadmin.NewAPI(clusters, opts, httpOpts)
+	var (
+		authz *rbac.Authorizer
+		authn rbac.Authenticator
+	)
+
+	switch {
+	case disableRBAC && rbacConfigPath != "":
+		log.Fatal("cannot pass both -rbac-config and -no-rbac")
+	case disableRBAC:
+		log.Warning("RBAC is disabled, all the RPCs are allowed to everyone")
+	case rbacConfigPath == "":
+		log.Warning("neither -rbac-config nor -no-rbac was passed, so everyone may read, but the RPCs that change a cluster are denied. " +
+			"Pass -rbac-config to allow them to some users, or -no-rbac to allow them to everyone")
+
+		var err error
+		authz, err = rbac.NewAuthorizer(rbac.ReadOnlyConfig())
+		if err != nil {
+			log.Fatal(err)
+		}
+	default:
+		rbacConfig, err := rbac.LoadConfig(rbacConfigPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		authz, err = rbac.NewAuthorizer(rbacConfig)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		authn, err = rbac.NewAuthenticator(rbacConfig.Authenticator)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if tlsCert != "" || tlsKey != "" {
+		tlsConfig, err := vttls.ServerConfig(tlsCert, tlsKey, tlsCA)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		opts.TLSConfig = tlsConfig
+	}
+
+	s := vtadmin.NewAPI(clusters, opts, httpOpts, authz, authn)
 	if err := s.ListenAndServe(); err != nil {
 		log.Fatal(err)
 	}
@@ -81,6 +133,12 @@ func main() {
 	rootCmd.Flags().Var(&clusterFileConfig, "cluster-config", "path to a yaml cluster configuration. see clusters.example.yaml") // (TODO:@amason) provide example config.
 	rootCmd.Flags().Var(&defaultClusterConfig, "cluster-defaults", "default options for all clusters")
 
+	rootCmd.Flags().StringVar(&rbacConfigPath, "rbac-config", "", "path to a yaml RBAC configuration, which authenticates users and authorizes their RPCs. if neither -rbac-config nor -no-rbac is passed, everyone may read, but the RPCs that change a cluster are denied")
+	rootCmd.Flags().BoolVar(&disableRBAC, "no-rbac", false, "whether to disable RBAC, allowing all RPCs to everyone")
+	rootCmd.Flags().StringVar(&tlsCert, "tls-cert", "", "path to the server certificate. if set, both gRPC and HTTP are served over TLS")
+	rootCmd.Flags().StringVar(&tlsKey, "tls-key", "", "path to the server private key")
+	rootCmd.Flags().StringVar(&tlsCA, "tls-ca", "", "path to the CA of client certificates. if set, clients must present a certificate it signed, which the tls authenticator uses to identify them")
+
 	rootCmd.Flags().BoolVar(&opts.EnableTracing, "grpc-tracing", false, "whether to enable tracing on the gRPC server")
 	rootCmd.Flags().BoolVar(&httpOpts.EnableTracing, "http-tracing", false, "whether to enable tracing on the HTTP server")
 	rootCmd.Flags().BoolVar(&httpOpts.DisableCompression, "http-no-compress", false, "whether to disable compression of HTTP API responses")
@@ -51,9 +51,16 @@ mode helps make backups minimally disruptive to serving capacity and orthogonal
 to the handling of the query path.
 
 The command-line parameters to vtbackup specify a policy for when a new backup
-is needed, and when old backups should be removed. If the existing backups
-already satisfy the policy, then vtbackup will do nothing and return success
-immediately.
+is needed, and when old backups should be removed. A backup retention policy
+set in topology for the shard or its keyspace (see the vtctl
+SetBackupRetentionPolicy command) takes precedence over the flags for the
+latter. If the existing backups already satisfy the policy, then vtbackup
+will do nothing and return success immediately.
+
+With -verify, vtbackup instead proves that an existing backup is restorable:
+it restores the backup into a scratch mysqld, runs CHECK TABLE and CHECKSUM
+TABLE on all its tables, and records the result in the backup's VERIFICATION
+file.
 */
 package main
 
@@ -73,6 +80,7 @@ import (
 	"vitess.io/vitess/go/cmd"
 	"vitess.io/vitess/go/exit"
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
@@ -108,12 +116,15 @@ var (
 	_ = flag.Duration("replication_timeout", 1*time.Hour, "DEPRECATED AND UNUSED")
 
 	minBackupInterval = flag.Duration("min_backup_interval", 0, "Only take a new backup if it's been at least this long since the most recent backup.")
-	minRetentionTime  = flag.Duration("min_retention_time", 0, "Keep each old backup for at least this long before removing it. Set to 0 to disable pruning of old backups.")
-	minRetentionCount = flag.Int("min_retention_count", 1, "Always keep at least this many of the most recent backups in this backup storage location, even if some are older than the min_retention_time. This must be at least 1 since a backup must always exist to allow new backups to be made")
+	minRetentionTime  = flag.Duration("min_retention_time", 0, "Keep each old backup for at least this long before removing it. Set to 0 to disable pruning of old backups. Ignored if the shard or its keyspace has a backup retention policy in topology.")
+	minRetentionCount = flag.Int("min_retention_count", 1, "Always keep at least this many of the most recent complete backups in this backup storage location, even if some are older than the min_retention_time. This must be at least 1 since a backup must always exist to allow new backups to be made. Ignored if the shard or its keyspace has a backup retention policy in topology.")
 
 	initialBackup    = flag.Bool("initial_backup", false, "Instead of restoring from backup, initialize an empty database with the provided init_db_sql_file and upload a backup of that for the shard, if the shard has no backups yet. This can be used to seed a brand new shard with an initial, empty backup. If any backups already exist for the shard, this will be considered a successful no-op. This can only be done before the shard exists in topology (i.e. before any tablets are deployed).")
 	allowFirstBackup = flag.Bool("allow_first_backup", false, "Allow this job to take the first backup of an existing shard.")
 
+	verify           = flag.Bool("verify", false, "Instead of taking a backup, restore an existing backup into a scratch mysqld, run CHECK TABLE and CHECKSUM TABLE on all its tables, and record the result in the backup's VERIFICATION file. Fails if the backup doesn't pass verification.")
+	verifyBackupName = flag.String("verify_backup_name", "", "With -verify, the name of the backup to verify. Defaults to the most recent complete full backup.")
+
 	// vttablet-like flags
 	initDbNameOverride = flag.String("init_db_name_override", "", "(init parameter) override the name of the db used by vttablet")
 	initKeyspace       = flag.String("init_keyspace", "", "(init parameter) keyspace to use for this tablet")
@@ -168,6 +179,14 @@ func main() {
 	topoServer := topo.Open()
 	defer topoServer.Close()
 
+	if *verify {
+		if err := verifyBackup(ctx); err != nil {
+			log.Errorf("Backup verification failed: %v", err)
+			exit.Return(1)
+		}
+		return
+	}
+
 	// Try to take a backup, if it's been long enough since the last one.
 	// Skip pruning if backup wasn't fully successful. We don't want to be
 	// deleting things if the backup process is not healthy.
@@ -185,56 +204,18 @@ func main() {
 	}
 
 	// Prune old backups.
-	if err := pruneBackups(ctx, backupStorage, backupDir); err != nil {
+	if err := pruneBackups(ctx, topoServer, backupStorage, backupDir); err != nil {
 		log.Errorf("Couldn't prune old backups: %v", err)
 		exit.Return(1)
 	}
 }
 
 func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage) error {
-	// This is an imaginary tablet alias. The value doesn't matter for anything,
-	// except that we generate a random UID to ensure the target backup
-	// directory is unique if multiple vtbackup instances are launched for the
-	// same shard, at exactly the same second, pointed at the same backup
-	// storage location.
-	bigN, err := rand.Int(rand.Reader, big.NewInt(math.MaxUint32))
-	if err != nil {
-		return fmt.Errorf("can't generate random tablet UID: %v", err)
-	}
-	tabletAlias := &topodatapb.TabletAlias{
-		Cell: "vtbackup",
-		Uid:  uint32(bigN.Uint64()),
-	}
-
-	// Clean up our temporary data dir if we exit for any reason, to make sure
-	// every invocation of vtbackup starts with a clean slate, and it does not
-	// accumulate garbage (and run out of disk space) if it's restarted.
-	tabletDir := mysqlctl.TabletDir(tabletAlias.Uid)
-	defer func() {
-		log.Infof("Removing temporary tablet directory: %v", tabletDir)
-		if err := os.RemoveAll(tabletDir); err != nil {
-			log.Warningf("Failed to remove temporary tablet directory: %v", err)
-		}
-	}()
-
-	// Start up mysqld as if we are mysqlctld provisioning a fresh tablet.
-	mysqld, mycnf, err := mysqlctl.CreateMysqldAndMycnf(tabletAlias.Uid, *mysqlSocket, int32(*mysqlPort))
+	tabletAlias, mysqld, mycnf, cleanup, err := startScratchMysqld(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to initialize mysql config: %v", err)
-	}
-	initCtx, initCancel := context.WithTimeout(ctx, *mysqlTimeout)
-	defer initCancel()
-	if err := mysqld.Init(initCtx, mycnf, *initDBSQLFile); err != nil {
-		return fmt.Errorf("failed to initialize mysql data dir and start mysqld: %v", err)
+		return err
 	}
-	// Shut down mysqld when we're done.
-	defer func() {
-		// Be careful not to use the original context, because we don't want to
-		// skip shutdown just because we timed out waiting for other things.
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
-		mysqld.Shutdown(ctx, mycnf, false)
-	}()
+	defer cleanup()
 
 	extraEnv := map[string]string{
 		"TABLET_ALIAS": topoproto.TabletAliasString(tabletAlias),
@@ -406,6 +387,89 @@ func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage back
 	return nil
 }
 
+// startScratchMysqld starts a mysqld in a new temporary tablet directory,
+// as if we are mysqlctld provisioning a fresh tablet. The returned cleanup
+// function shuts mysqld down and removes the directory.
+func startScratchMysqld(ctx context.Context) (*topodatapb.TabletAlias, *mysqlctl.Mysqld, *mysqlctl.Mycnf, func(), error) {
+	// This is an imaginary tablet alias. The value doesn't matter for anything,
+	// except that we generate a random UID to ensure the target backup
+	// directory is unique if multiple vtbackup instances are launched for the
+	// same shard, at exactly the same second, pointed at the same backup
+	// storage location.
+	bigN, err := rand.Int(rand.Reader, big.NewInt(math.MaxUint32))
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("can't generate random tablet UID: %v", err)
+	}
+	tabletAlias := &topodatapb.TabletAlias{
+		Cell: "vtbackup",
+		Uid:  uint32(bigN.Uint64()),
+	}
+
+	// Clean up our temporary data dir if we exit for any reason, to make sure
+	// every invocation of vtbackup starts with a clean slate, and it does not
+	// accumulate garbage (and run out of disk space) if it's restarted.
+	tabletDir := mysqlctl.TabletDir(tabletAlias.Uid)
+	removeTabletDir := func() {
+		log.Infof("Removing temporary tablet directory: %v", tabletDir)
+		if err := os.RemoveAll(tabletDir); err != nil {
+			log.Warningf("Failed to remove temporary tablet directory: %v", err)
+		}
+	}
+
+	mysqld, mycnf, err := mysqlctl.CreateMysqldAndMycnf(tabletAlias.Uid, *mysqlSocket, int32(*mysqlPort))
+	if err != nil {
+		removeTabletDir()
+		return nil, nil, nil, nil, fmt.Errorf("failed to initialize mysql config: %v", err)
+	}
+	initCtx, initCancel := context.WithTimeout(ctx, *mysqlTimeout)
+	defer initCancel()
+	if err := mysqld.Init(initCtx, mycnf, *initDBSQLFile); err != nil {
+		removeTabletDir()
+		return nil, nil, nil, nil, fmt.Errorf("failed to initialize mysql data dir and start mysqld: %v", err)
+	}
+	cleanup := func() {
+		// Be careful not to use the original context, because we don't want to
+		// skip shutdown just because we timed out waiting for other things.
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		mysqld.Shutdown(ctx, mycnf, false)
+		removeTabletDir()
+	}
+	return tabletAlias, mysqld, mycnf, cleanup, nil
+}
+
+// verifyBackup restores a backup into a scratch mysqld, checks its tables,
+// and records the result in the backup's VERIFICATION file.
+func verifyBackup(ctx context.Context) error {
+	tabletAlias, mysqld, mycnf, cleanup, err := startScratchMysqld(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	params := mysqlctl.RestoreParams{
+		Cnf:         mycnf,
+		Mysqld:      mysqld,
+		Logger:      logutil.NewConsoleLogger(),
+		Concurrency: *concurrency,
+		HookExtraEnv: map[string]string{
+			"TABLET_ALIAS": topoproto.TabletAliasString(tabletAlias),
+		},
+		DeleteBeforeRestore: true,
+		Keyspace:            *initKeyspace,
+		Shard:               *initShard,
+	}
+	verification, err := mysqlctl.VerifyBackup(ctx, params, *verifyBackupName)
+	if err != nil {
+		return err
+	}
+	if !verification.Passed {
+		return fmt.Errorf("backup failed verification: %v", strings.Join(verification.Errors, "; "))
+	}
+	log.Infof("Backup passed verification, %v tables checked.", len(verification.Checksums))
+	return nil
+}
+
 func resetReplication(ctx context.Context, pos mysql.Position, mysqld mysqlctl.MysqlDaemon) error {
 	cmds := []string{
 		"STOP SLAVE",
@@ -502,45 +566,29 @@ func retryOnError(ctx context.Context, fn func() error) error {
 	}
 }
 
-func pruneBackups(ctx context.Context, backupStorage backupstorage.BackupStorage, backupDir string) error {
-	if *minRetentionTime == 0 {
+func pruneBackups(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage, backupDir string) error {
+	// A retention policy set in topology for the shard or its keyspace takes
+	// precedence over the flags.
+	policy, err := topoServer.GetBackupRetentionPolicy(ctx, *initKeyspace, *initShard)
+	switch {
+	case err == nil && policy != nil:
+		log.Infof("Using backup retention policy from topology: %v", policy)
+	case err != nil && !topo.IsErrType(err, topo.NoNode):
+		return fmt.Errorf("can't get backup retention policy: %v", err)
+	case *minRetentionTime == 0:
 		log.Info("Pruning of old backups is disabled.")
 		return nil
+	default:
+		policy = &topodatapb.BackupRetentionPolicy{
+			MaxAge:        protoutil.DurationToProto(*minRetentionTime),
+			MinValidCount: uint32(*minRetentionCount),
+		}
 	}
-	backups, err := backupStorage.ListBackups(ctx, backupDir)
+	pruned, err := mysqlctl.PruneBackups(ctx, logutil.NewConsoleLogger(), backupStorage, backupDir, policy, false)
 	if err != nil {
-		return fmt.Errorf("can't list backups: %v", err)
-	}
-	numBackups := len(backups)
-	if numBackups <= *minRetentionCount {
-		log.Infof("Found %v backups. Not pruning any since this is within the min_retention_count of %v.", numBackups, *minRetentionCount)
-		return nil
-	}
-	// We have more than the minimum retention count, so we could afford to
-	// prune some. See if any are beyond the minimum retention time.
-	// ListBackups returns them sorted by oldest first.
-	for _, backup := range backups {
-		backupTime, err := parseBackupTime(backup.Name())
-		if err != nil {
-			return err
-		}
-		if time.Since(backupTime) < *minRetentionTime {
-			// The oldest remaining backup is not old enough to prune.
-			log.Infof("Oldest backup taken at %v has not reached min_retention_time of %v. Nothing left to prune.", backupTime, *minRetentionTime)
-			break
-		}
-		// Remove the backup.
-		log.Infof("Removing old backup %v from %v, since it's older than min_retention_time of %v", backup.Name(), backupDir, *minRetentionTime)
-		if err := backupStorage.RemoveBackup(ctx, backupDir, backup.Name()); err != nil {
-			return fmt.Errorf("couldn't remove backup %v from %v: %v", backup.Name(), backupDir, err)
-		}
-		// We successfully removed one backup. Can we afford to prune any more?
-		numBackups--
-		if numBackups == *minRetentionCount {
-			log.Infof("Successfully pruned backup count to min_retention_count of %v.", *minRetentionCount)
-			break
-		}
+		return err
 	}
+	log.Infof("Pruned %v old backups from %v.", len(pruned), backupDir)
 	return nil
 }
 
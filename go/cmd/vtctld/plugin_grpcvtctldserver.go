@@ -19,12 +19,14 @@ package main
 import (
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
+	"vitess.io/vitess/go/vt/vtctl/wranglerserver"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
 )
 
 func init() {
 	servenv.OnRun(func() {
 		if servenv.GRPCCheckServiceMap("vtctld") {
-			grpcvtctldserver.StartServer(servenv.GRPCServer, ts)
+			grpcvtctldserver.StartServerWithWrangler(servenv.GRPCServer, ts, wranglerserver.New(ts, tmclient.NewTabletManagerClient()))
 		}
 	})
 }
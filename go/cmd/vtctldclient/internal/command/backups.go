@@ -17,20 +17,90 @@ limitations under the License.
 package command
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
-// GetBackups makes a GetBackups gRPC call to a vtctld.
-var GetBackups = &cobra.Command{
-	Use:  "GetBackups keyspace shard",
-	Args: cobra.ExactArgs(2),
-	RunE: commandGetBackups,
+var (
+	// Backup makes a Backup gRPC call to a vtctld.
+	Backup = &cobra.Command{
+		Use:  "Backup [--concurrency <concurrency>] [--allow-primary] [--incremental] <tablet_alias>",
+		Args: cobra.ExactArgs(1),
+		RunE: commandBackup,
+	}
+	// GetBackups makes a GetBackups gRPC call to a vtctld.
+	GetBackups = &cobra.Command{
+		Use:  "GetBackups keyspace shard",
+		Args: cobra.ExactArgs(2),
+		RunE: commandGetBackups,
+	}
+	// RestoreFromBackup makes a RestoreFromBackup gRPC call to a vtctld.
+	RestoreFromBackup = &cobra.Command{
+		Use:  "RestoreFromBackup [--restore-to-pos <position> | --restore-to-timestamp <RFC3339 time>] <tablet_alias>",
+		Args: cobra.ExactArgs(1),
+		RunE: commandRestoreFromBackup,
+	}
+)
+
+var backupOptions = struct {
+	AllowPrimary bool
+	Concurrency  uint64
+	Incremental  bool
+}{}
+
+func commandBackup(cmd *cobra.Command, args []string) error {
+	tabletAlias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	stream, err := client.Backup(commandCtx, &vtctldatapb.BackupRequest{
+		TabletAlias:  tabletAlias,
+		AllowPrimary: backupOptions.AllowPrimary,
+		Concurrency:  backupOptions.Concurrency,
+		Incremental:  backupOptions.Incremental,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printEventStream(func() (*logutilpb.Event, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+
+		return resp.Event, nil
+	})
+}
+
+// printEventStream prints the events returned by recv until the stream ends.
+func printEventStream(recv func() (*logutilpb.Event, error)) error {
+	for {
+		e, err := recv()
+		switch err {
+		case nil:
+			fmt.Println(logutil.EventString(e))
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
 }
 
 func commandGetBackups(cmd *cobra.Command, args []string) error {
@@ -57,6 +127,61 @@ func commandGetBackups(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var restoreFromBackupOptions = struct {
+	RestoreToPos       string
+	RestoreToTimestamp string
+}{}
+
+func commandRestoreFromBackup(cmd *cobra.Command, args []string) error {
+	if restoreFromBackupOptions.RestoreToPos != "" && restoreFromBackupOptions.RestoreToTimestamp != "" {
+		return errors.New("can only pass one of --restore-to-pos and --restore-to-timestamp")
+	}
+
+	tabletAlias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	req := &vtctldatapb.RestoreFromBackupRequest{
+		TabletAlias:  tabletAlias,
+		RestoreToPos: restoreFromBackupOptions.RestoreToPos,
+	}
+
+	if restoreFromBackupOptions.RestoreToTimestamp != "" {
+		t, err := time.Parse(time.RFC3339, restoreFromBackupOptions.RestoreToTimestamp)
+		if err != nil {
+			return fmt.Errorf("cannot parse --restore-to-timestamp as RFC3339: %w", err)
+		}
+
+		req.RestoreToTimestamp = logutil.TimeToProto(t)
+	}
+
+	cli.FinishedParsing(cmd)
+
+	stream, err := client.RestoreFromBackup(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	return printEventStream(func() (*logutilpb.Event, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+
+		return resp.Event, nil
+	})
+}
+
 func init() {
+	Backup.Flags().BoolVar(&backupOptions.AllowPrimary, "allow-primary", false, "Allow the backup to proceed if the tablet is a primary. WARNING: If using the builtin backup engine, this will shutdown mysqld on the primary and stop writes for the duration of the backup.")
+	Backup.Flags().Uint64Var(&backupOptions.Concurrency, "concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously.")
+	Backup.Flags().BoolVar(&backupOptions.Incremental, "incremental", false, "Only back up the binary logs written since the most recent backup, without stopping mysqld.")
+	Root.AddCommand(Backup)
+
 	Root.AddCommand(GetBackups)
+
+	RestoreFromBackup.Flags().StringVar(&restoreFromBackupOptions.RestoreToPos, "restore-to-pos", "", "Replay binary logs from incremental backups up to and including this position.")
+	RestoreFromBackup.Flags().StringVar(&restoreFromBackupOptions.RestoreToTimestamp, "restore-to-timestamp", "", "Replay binary logs from incremental backups up to this time, in RFC3339 format.")
+	Root.AddCommand(RestoreFromBackup)
 }
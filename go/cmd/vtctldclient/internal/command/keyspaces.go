@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo"
 
@@ -66,12 +67,30 @@ var (
 		Args:    cobra.NoArgs,
 		RunE:    commandGetKeyspaces,
 	}
+	// RebuildKeyspaceGraph makes one or more RebuildKeyspaceGraph gRPC calls to a vtctld.
+	RebuildKeyspaceGraph = &cobra.Command{
+		Use:  "RebuildKeyspaceGraph [--cells=c1,c2,...] [--allow-partial] ks1 [ks2 ...]",
+		Args: cobra.MinimumNArgs(1),
+		RunE: commandRebuildKeyspaceGraph,
+	}
 	// RemoveKeyspaceCell makes a RemoveKeyspaceCell gRPC call to a vtctld.
 	RemoveKeyspaceCell = &cobra.Command{
 		Use:  "RemoveKeyspaceCell <keyspace> <cell>",
 		Args: cobra.ExactArgs(2),
 		RunE: commandRemoveKeyspaceCell,
 	}
+	// ThrottleApp makes a ThrottleApp gRPC call to a vtctld.
+	ThrottleApp = &cobra.Command{
+		Use:  "ThrottleApp [--ratio RATIO] [--duration DURATION] <keyspace> <app>",
+		Args: cobra.ExactArgs(2),
+		RunE: commandThrottleApp,
+	}
+	// UnthrottleApp makes an UnthrottleApp gRPC call to a vtctld.
+	UnthrottleApp = &cobra.Command{
+		Use:  "UnthrottleApp <keyspace> <app>",
+		Args: cobra.ExactArgs(2),
+		RunE: commandUnthrottleApp,
+	}
 )
 
 var createKeyspaceOptions = struct {
@@ -86,6 +105,7 @@ var createKeyspaceOptions = struct {
 	KeyspaceType      cli.KeyspaceTypeFlag
 	BaseKeyspace      string
 	SnapshotTimestamp string
+	DurabilityPolicy  string
 }{
 	KeyspaceType: cli.KeyspaceTypeFlag(topodatapb.KeyspaceType_NORMAL),
 }
@@ -132,6 +152,7 @@ func commandCreateKeyspace(cmd *cobra.Command, args []string) error {
 		Type:               topodatapb.KeyspaceType(createKeyspaceOptions.KeyspaceType),
 		BaseKeyspace:       createKeyspaceOptions.BaseKeyspace,
 		SnapshotTime:       snapshotTime,
+		DurabilityPolicy:   createKeyspaceOptions.DurabilityPolicy,
 	}
 
 	for n, v := range createKeyspaceOptions.ServedFromsMap.StringMapValue {
@@ -239,6 +260,29 @@ func commandGetKeyspaces(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var rebuildKeyspaceGraphOptions = struct {
+	Cells        []string
+	AllowPartial bool
+}{}
+
+func commandRebuildKeyspaceGraph(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	keyspaces := cmd.Flags().Args()
+	for _, ks := range keyspaces {
+		_, err := client.RebuildKeyspaceGraph(commandCtx, &vtctldatapb.RebuildKeyspaceGraphRequest{
+			Keyspace:     ks,
+			Cells:        rebuildKeyspaceGraphOptions.Cells,
+			AllowPartial: rebuildKeyspaceGraphOptions.AllowPartial,
+		})
+		if err != nil {
+			return fmt.Errorf("RebuildKeyspaceGraph(%v) failed: %w", ks, err)
+		}
+	}
+
+	return nil
+}
+
 var removeKeyspaceCellOptions = struct {
 	Force     bool
 	Recursive bool
@@ -266,6 +310,53 @@ func commandRemoveKeyspaceCell(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var throttleAppOptions = struct {
+	Ratio    float64
+	Duration time.Duration
+}{}
+
+func commandThrottleApp(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ThrottleApp(commandCtx, &vtctldatapb.ThrottleAppRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		AppName:  cmd.Flags().Arg(1),
+		Ratio:    throttleAppOptions.Ratio,
+		Duration: protoutil.DurationToProto(throttleAppOptions.Duration),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Rule)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func commandUnthrottleApp(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	keyspace := cmd.Flags().Arg(0)
+	app := cmd.Flags().Arg(1)
+
+	_, err := client.UnthrottleApp(commandCtx, &vtctldatapb.UnthrottleAppRequest{
+		Keyspace: keyspace,
+		AppName:  app,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully unthrottled app %s in keyspace %s\n", app, keyspace)
+
+	return nil
+}
+
 func init() {
 	CreateKeyspace.Flags().BoolVarP(&createKeyspaceOptions.Force, "force", "f", false, "Proceeds even if the keyspace already exists. Does not overwrite the existing keyspace record")
 	CreateKeyspace.Flags().BoolVarP(&createKeyspaceOptions.AllowEmptyVSchema, "allow-empty-vschema", "e", false, "Allows a new keyspace to have no vschema")
@@ -275,6 +366,7 @@ func init() {
 	CreateKeyspace.Flags().Var(&createKeyspaceOptions.KeyspaceType, "type", "The type of the keyspace")
 	CreateKeyspace.Flags().StringVar(&createKeyspaceOptions.BaseKeyspace, "base-keyspace", "", "The base keyspace for a snapshot keyspace.")
 	CreateKeyspace.Flags().StringVar(&createKeyspaceOptions.SnapshotTimestamp, "snapshot-timestamp", "", "The snapshot time for a snapshot keyspace, as a timestamp in RFC3339 format.")
+	CreateKeyspace.Flags().StringVar(&createKeyspaceOptions.DurabilityPolicy, "durability-policy", "", "The durability policy used to reparent the shards of the keyspace. Empty uses the default policy.")
 	Root.AddCommand(CreateKeyspace)
 
 	DeleteKeyspace.Flags().BoolVarP(&deleteKeyspaceOptions.Recursive, "recursive", "r", false, "Recursively delete all shards in the keyspace, and all tablets in those shards.")
@@ -284,7 +376,17 @@ func init() {
 	Root.AddCommand(GetKeyspace)
 	Root.AddCommand(GetKeyspaces)
 
+	RebuildKeyspaceGraph.Flags().StringSliceVarP(&rebuildKeyspaceGraphOptions.Cells, "cells", "c", nil, "Specifies a comma-separated list of cells to update")
+	RebuildKeyspaceGraph.Flags().BoolVar(&rebuildKeyspaceGraphOptions.AllowPartial, "allow-partial", false, "Specifies whether a SNAPSHOT keyspace is allowed to serve with an incomplete set of shards. Ignored for all other types of keyspaces")
+	Root.AddCommand(RebuildKeyspaceGraph)
+
 	RemoveKeyspaceCell.Flags().BoolVarP(&removeKeyspaceCellOptions.Force, "force", "f", false, "Proceed even if the cell's topology server cannot be reached. The assumption is that you turned down the entire cell, and just need to update the global topo data.")
 	RemoveKeyspaceCell.Flags().BoolVarP(&removeKeyspaceCellOptions.Recursive, "recursive", "r", false, "Also delete all tablets in that cell beloning to the specified keyspace.")
 	Root.AddCommand(RemoveKeyspaceCell)
+
+	ThrottleApp.Flags().Float64Var(&throttleAppOptions.Ratio, "ratio", 1, "The ratio of throttler checks to reject for the app, between 0 and 1.")
+	ThrottleApp.Flags().DurationVar(&throttleAppOptions.Duration, "duration", time.Hour, "How long to throttle the app for.")
+	Root.AddCommand(ThrottleApp)
+
+	Root.AddCommand(UnthrottleApp)
 }
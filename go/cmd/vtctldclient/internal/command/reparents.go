@@ -76,6 +76,8 @@ var emergencyReparentShardOptions = struct {
 	WaitReplicasTimeout       time.Duration
 	NewPrimaryAliasStr        string
 	IgnoreReplicaAliasStrList []string
+	MaxReplicationLag         time.Duration
+	DryRun                    bool
 }{}
 
 func commandEmergencyReparentShard(cmd *cobra.Command, args []string) error {
@@ -113,6 +115,8 @@ func commandEmergencyReparentShard(cmd *cobra.Command, args []string) error {
 		NewPrimary:          newPrimaryAlias,
 		IgnoreReplicas:      ignoreReplicaAliases,
 		WaitReplicasTimeout: protoutil.DurationToProto(emergencyReparentShardOptions.WaitReplicasTimeout),
+		MaxReplicationLag:   protoutil.DurationToProto(emergencyReparentShardOptions.MaxReplicationLag),
+		DryRun:              emergencyReparentShardOptions.DryRun,
 	})
 	if err != nil {
 		return err
@@ -259,6 +263,8 @@ func init() {
 	EmergencyReparentShard.Flags().DurationVar(&emergencyReparentShardOptions.WaitReplicasTimeout, "wait-replicas-timeout", *topo.RemoteOperationTimeout, "Time to wait for replicas to catch up in reparenting.")
 	EmergencyReparentShard.Flags().StringVar(&emergencyReparentShardOptions.NewPrimaryAliasStr, "new-primary", "", "Alias of a tablet that should be the new primary. If not specified, the vtctld will select the best candidate to promote.")
 	EmergencyReparentShard.Flags().StringSliceVarP(&emergencyReparentShardOptions.IgnoreReplicaAliasStrList, "ignore-replicas", "i", nil, "Comma-separated, repeated list of replica tablet aliases to ignore during the emergency reparent.")
+	EmergencyReparentShard.Flags().DurationVar(&emergencyReparentShardOptions.MaxReplicationLag, "max-replication-lag", 0, "Exclude candidates that were lagging by more than this. 0 means no limit.")
+	EmergencyReparentShard.Flags().BoolVar(&emergencyReparentShardOptions.DryRun, "dry-run", false, "Only print which tablet would be promoted and why, without stopping replication or changing any tablet.")
 	Root.AddCommand(EmergencyReparentShard)
 
 	InitShardPrimary.Flags().DurationVar(&initShardPrimaryOptions.WaitReplicasTimeout, "wait-replicas-timeout", 30*time.Second, "time to wait for replicas to catch up in reparenting")
@@ -19,21 +19,81 @@ package command
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
-// GetSchema makes a GetSchema gRPC call to a vtctld.
-var GetSchema = &cobra.Command{
-	Use:  "GetSchema [--tables TABLES ...] [--exclude-tables EXCLUDE_TABLES ...] [{--table-names-only | --table-sizes-only}] [--include-views] alias",
-	Args: cobra.ExactArgs(1),
-	RunE: commandGetSchema,
+var (
+	// ApplySchema makes an ApplySchema gRPC call to a vtctld.
+	ApplySchema = &cobra.Command{
+		Use:  "ApplySchema [--allow-long-unavailability] [--ddl-strategy <strategy>] [--wait-replicas-timeout <duration>] {--sql <sql> ... | --sql-file <file>} keyspace",
+		Args: cobra.ExactArgs(1),
+		RunE: commandApplySchema,
+	}
+	// GetSchema makes a GetSchema gRPC call to a vtctld.
+	GetSchema = &cobra.Command{
+		Use:  "GetSchema [--tables TABLES ...] [--exclude-tables EXCLUDE_TABLES ...] [{--table-names-only | --table-sizes-only}] [--include-views] alias",
+		Args: cobra.ExactArgs(1),
+		RunE: commandGetSchema,
+	}
+)
+
+var applySchemaOptions = struct {
+	AllowLongUnavailability bool
+	SQL                     []string
+	SQLFile                 string
+	DDLStrategy             string
+	WaitReplicasTimeout     time.Duration
+}{}
+
+func commandApplySchema(cmd *cobra.Command, args []string) error {
+	sql := applySchemaOptions.SQL
+	switch {
+	case len(sql) > 0 && applySchemaOptions.SQLFile != "":
+		return errors.New("can only pass one of --sql and --sql-file")
+	case applySchemaOptions.SQLFile != "":
+		data, err := ioutil.ReadFile(applySchemaOptions.SQLFile)
+		if err != nil {
+			return err
+		}
+
+		sql = []string{string(data)}
+	case len(sql) == 0:
+		return errors.New("one of --sql or --sql-file is required")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ApplySchema(commandCtx, &vtctldatapb.ApplySchemaRequest{
+		Keyspace:                cmd.Flags().Arg(0),
+		AllowLongUnavailability: applySchemaOptions.AllowLongUnavailability,
+		Sql:                     sql,
+		DdlStrategy:             applySchemaOptions.DDLStrategy,
+		WaitReplicasTimeout:     protoutil.DurationToProto(applySchemaOptions.WaitReplicasTimeout),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, event := range resp.Events {
+		fmt.Println(logutil.EventString(event))
+	}
+
+	if len(resp.UuidList) > 0 {
+		fmt.Printf("%s\n", strings.Join(resp.UuidList, "\n"))
+	}
+
+	return nil
 }
 
 var getSchemaOptions = struct {
@@ -91,6 +151,13 @@ func commandGetSchema(cmd *cobra.Command, args []string) error {
 }
 
 func init() {
+	ApplySchema.Flags().BoolVar(&applySchemaOptions.AllowLongUnavailability, "allow-long-unavailability", false, "Allow large schema changes which incur a longer unavailability of the database.")
+	ApplySchema.Flags().StringArrayVar(&applySchemaOptions.SQL, "sql", nil, "Semicolon-delimited, repeatable SQL commands to apply. Exactly one of --sql or --sql-file is required.")
+	ApplySchema.Flags().StringVar(&applySchemaOptions.SQLFile, "sql-file", "", "Path to a file containing semicolon-delimited SQL commands to apply. Exactly one of --sql or --sql-file is required.")
+	ApplySchema.Flags().StringVar(&applySchemaOptions.DDLStrategy, "ddl-strategy", "direct", "Online DDL strategy, compatible with @@ddl_strategy session variable (examples: 'gh-ost', 'pt-osc', 'gh-ost --max-load=Threads_running=100').")
+	ApplySchema.Flags().DurationVar(&applySchemaOptions.WaitReplicasTimeout, "wait-replicas-timeout", 10*time.Second, "Amount of time to wait for replicas to receive the schema change via replication.")
+	Root.AddCommand(ApplySchema)
+
 	GetSchema.Flags().StringSliceVar(&getSchemaOptions.Tables, "tables", nil, "TODO")
 	GetSchema.Flags().StringSliceVar(&getSchemaOptions.ExcludeTables, "exclude-tables", nil, "TODO")
 	GetSchema.Flags().BoolVar(&getSchemaOptions.IncludeViews, "include-views", false, "TODO")
@@ -53,6 +53,12 @@ var (
 		Args: cobra.NoArgs,
 		RunE: commandGetTablets,
 	}
+	// RefreshState makes a RefreshState gRPC call to a vtctld.
+	RefreshState = &cobra.Command{
+		Use:  "RefreshState TABLET_ALIAS",
+		Args: cobra.ExactArgs(1),
+		RunE: commandRefreshState,
+	}
 )
 
 var changeTabletTypeOptions = struct {
@@ -193,6 +199,26 @@ func commandGetTablets(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func commandRefreshState(cmd *cobra.Command, args []string) error {
+	alias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	_, err = client.RefreshState(commandCtx, &vtctldatapb.RefreshStateRequest{
+		TabletAlias: alias,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Refreshed state on %s\n", topoproto.TabletAliasString(alias))
+
+	return nil
+}
+
 func init() {
 	ChangeTabletType.Flags().BoolVarP(&changeTabletTypeOptions.DryRun, "dry-run", "d", false, "Shows the proposed change without actually executing it")
 	Root.AddCommand(ChangeTabletType)
@@ -207,4 +233,6 @@ func init() {
 	GetTablets.Flags().StringVarP(&getTabletsOptions.Shard, "shard", "s", "", "shard to filter tablets by")
 	GetTablets.Flags().StringVar(&getTabletsOptions.Format, "format", "awk", "Output format to use; valid choices are (json, awk)")
 	Root.AddCommand(GetTablets)
+
+	Root.AddCommand(RefreshState)
 }
@@ -17,16 +17,26 @@ limitations under the License.
 package command
 
 import (
+	"errors"
 	"fmt"
+	"io/ioutil"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/json2"
 
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
 var (
+	// ApplyVSchema makes an ApplyVSchema gRPC call to a vtctld.
+	ApplyVSchema = &cobra.Command{
+		Use:  "ApplyVSchema {--vschema <vschema> | --vschema-file <file> | --sql <sql> | --sql-file <file>} [--cells c1,c2,...] [--skip-rebuild] [--dry-run] keyspace",
+		Args: cobra.ExactArgs(1),
+		RunE: commandApplyVSchema,
+	}
 	// GetSrvVSchema makes a GetSrvVSchema gRPC call to a vtctld.
 	GetSrvVSchema = &cobra.Command{
 		Use:  "GetSrvVSchema cell",
@@ -41,6 +51,74 @@ var (
 	}
 )
 
+var applyVSchemaOptions = struct {
+	VSchema     string
+	VSchemaFile string
+	SQL         string
+	SQLFile     string
+	DryRun      bool
+	SkipRebuild bool
+	Cells       []string
+}{}
+
+func commandApplyVSchema(cmd *cobra.Command, args []string) error {
+	sqlMode := (applyVSchemaOptions.SQL != "") != (applyVSchemaOptions.SQLFile != "")
+	jsonMode := (applyVSchemaOptions.VSchema != "") != (applyVSchemaOptions.VSchemaFile != "")
+
+	if sqlMode == jsonMode {
+		return errors.New("exactly one of --sql, --sql-file, --vschema or --vschema-file is required")
+	}
+
+	req := &vtctldatapb.ApplyVSchemaRequest{
+		Keyspace:    cmd.Flags().Arg(0),
+		DryRun:      applyVSchemaOptions.DryRun,
+		SkipRebuild: applyVSchemaOptions.SkipRebuild,
+		Cells:       applyVSchemaOptions.Cells,
+	}
+
+	if sqlMode {
+		req.Sql = applyVSchemaOptions.SQL
+		if applyVSchemaOptions.SQLFile != "" {
+			data, err := ioutil.ReadFile(applyVSchemaOptions.SQLFile)
+			if err != nil {
+				return err
+			}
+
+			req.Sql = string(data)
+		}
+	} else {
+		data := []byte(applyVSchemaOptions.VSchema)
+		if applyVSchemaOptions.VSchemaFile != "" {
+			var err error
+			data, err = ioutil.ReadFile(applyVSchemaOptions.VSchemaFile)
+			if err != nil {
+				return err
+			}
+		}
+
+		req.VSchema = &vschemapb.Keyspace{}
+		if err := json2.Unmarshal(data, req.VSchema); err != nil {
+			return err
+		}
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ApplyVSchema(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.VSchema)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("New VSchema object:\n%s\nIf this is not what you expected, check the input data (as JSON parsing will skip unexpected fields).\n", data)
+
+	return nil
+}
+
 func commandGetSrvVSchema(cmd *cobra.Command, args []string) error {
 	cli.FinishedParsing(cmd)
 
@@ -86,6 +164,15 @@ func commandGetVSchema(cmd *cobra.Command, args []string) error {
 }
 
 func init() {
+	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.VSchema, "vschema", "", "VSchema to apply, in JSON format.")
+	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.VSchemaFile, "vschema-file", "", "Path to a file containing the VSchema to apply, in JSON format.")
+	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.SQL, "sql", "", "A VSchema DDL statement (e.g. `alter vschema add vindex ...`).")
+	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.SQLFile, "sql-file", "", "Path to a file containing a VSchema DDL statement.")
+	ApplyVSchema.Flags().BoolVar(&applyVSchemaOptions.DryRun, "dry-run", false, "Print the altered VSchema without saving it.")
+	ApplyVSchema.Flags().BoolVar(&applyVSchemaOptions.SkipRebuild, "skip-rebuild", false, "Skip rebuilding the SrvVSchema objects.")
+	ApplyVSchema.Flags().StringSliceVar(&applyVSchemaOptions.Cells, "cells", nil, "Limits the rebuild to the given cells, after upload. Ignored if --skip-rebuild is set.")
+	Root.AddCommand(ApplyVSchema)
+
 	Root.AddCommand(GetSrvVSchema)
 	Root.AddCommand(GetVSchema)
 }
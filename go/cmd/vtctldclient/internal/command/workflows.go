@@ -0,0 +1,351 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// GetWorkflow makes a GetWorkflow gRPC call to a vtctld.
+	GetWorkflow = &cobra.Command{
+		Use:  "GetWorkflow keyspace workflow",
+		Args: cobra.ExactArgs(2),
+		RunE: commandGetWorkflow,
+	}
+	// GetWorkflowProgress makes a GetWorkflowProgress gRPC call to a vtctld.
+	GetWorkflowProgress = &cobra.Command{
+		Use:  "GetWorkflowProgress keyspace workflow",
+		Args: cobra.ExactArgs(2),
+		RunE: commandGetWorkflowProgress,
+	}
+	// GetWorkflows makes a GetWorkflows gRPC call to a vtctld.
+	GetWorkflows = &cobra.Command{
+		Use:  "GetWorkflows keyspace",
+		Args: cobra.ExactArgs(1),
+		RunE: commandGetWorkflows,
+	}
+	// MoveTables makes a MoveTables gRPC call to a vtctld.
+	MoveTables = &cobra.Command{
+		Use:  "MoveTables --workflow <workflow> [--all [--exclude t1,t2,...]] [--cells c1,c2,...] [--tablet-types t1,t2,...] [--auto-start=false] [--stop-after-copy] source_keyspace target_keyspace [table1,table2,...]",
+		Args: cobra.RangeArgs(2, 3),
+		RunE: commandMoveTables,
+	}
+	// Reshard makes a Reshard gRPC call to a vtctld.
+	Reshard = &cobra.Command{
+		Use:  "Reshard [--cells c1,c2,...] [--tablet-types t1,t2,...] [--skip-schema-copy] [--auto-start=false] [--stop-after-copy] keyspace workflow source_shards target_shards",
+		Args: cobra.ExactArgs(4),
+		RunE: commandReshard,
+	}
+	// VDiff makes a VDiff gRPC call to a vtctld.
+	VDiff = &cobra.Command{
+		Use:  "VDiff [--source-cell <cell>] [--target-cell <cell>] [--tablet-types t1,t2,...] [--filtered-replication-wait-time <duration>] [--limit <rows>] [--tables t1,t2,...] keyspace workflow",
+		Args: cobra.ExactArgs(2),
+		RunE: commandVDiff,
+	}
+	// WorkflowAction makes a WorkflowAction gRPC call to a vtctld.
+	WorkflowAction = &cobra.Command{
+		Use:  "WorkflowAction [--dry-run] keyspace workflow {start|stop|delete}",
+		Args: cobra.ExactArgs(3),
+		RunE: commandWorkflowAction,
+	}
+)
+
+// parseTabletTypes parses the values of a --tablet-types flag.
+func parseTabletTypes(types []string) ([]topodatapb.TabletType, error) {
+	if len(types) == 0 {
+		return nil, nil
+	}
+
+	return topoproto.ParseTabletTypes(strings.Join(types, ","))
+}
+
+func commandGetWorkflow(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetWorkflow(commandCtx, &vtctldatapb.GetWorkflowRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Workflow: cmd.Flags().Arg(1),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Workflow)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func commandGetWorkflowProgress(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetWorkflowProgress(commandCtx, &vtctldatapb.GetWorkflowProgressRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Workflow: cmd.Flags().Arg(1),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Progress)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func commandGetWorkflows(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetWorkflows(commandCtx, &vtctldatapb.GetWorkflowsRequest{
+		Keyspace: cmd.Flags().Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Workflows)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+var moveTablesOptions = struct {
+	Workflow      string
+	AllTables     bool
+	ExcludeTables []string
+	Cells         []string
+	TabletTypes   []string
+	AutoStart     bool
+	StopAfterCopy bool
+}{}
+
+func commandMoveTables(cmd *cobra.Command, args []string) error {
+	if moveTablesOptions.Workflow == "" {
+		return errors.New("--workflow is required")
+	}
+
+	var tables []string
+	switch {
+	case moveTablesOptions.AllTables && cmd.Flags().NArg() == 3:
+		return errors.New("cannot pass a list of tables along with --all")
+	case !moveTablesOptions.AllTables && cmd.Flags().NArg() != 3:
+		return errors.New("a list of tables is required unless --all is passed")
+	case !moveTablesOptions.AllTables && len(moveTablesOptions.ExcludeTables) > 0:
+		return errors.New("--exclude can only be passed along with --all")
+	case cmd.Flags().NArg() == 3:
+		tables = strings.Split(cmd.Flags().Arg(2), ",")
+	}
+
+	tabletTypes, err := parseTabletTypes(moveTablesOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.MoveTables(commandCtx, &vtctldatapb.MoveTablesRequest{
+		Workflow:       moveTablesOptions.Workflow,
+		SourceKeyspace: cmd.Flags().Arg(0),
+		TargetKeyspace: cmd.Flags().Arg(1),
+		IncludeTables:  tables,
+		AllTables:      moveTablesOptions.AllTables,
+		ExcludeTables:  moveTablesOptions.ExcludeTables,
+		Cells:          moveTablesOptions.Cells,
+		TabletTypes:    tabletTypes,
+		AutoStart:      moveTablesOptions.AutoStart,
+		StopAfterCopy:  moveTablesOptions.StopAfterCopy,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, event := range resp.Events {
+		fmt.Println(logutil.EventString(event))
+	}
+
+	return nil
+}
+
+var reshardOptions = struct {
+	Cells          []string
+	TabletTypes    []string
+	SkipSchemaCopy bool
+	AutoStart      bool
+	StopAfterCopy  bool
+}{}
+
+func commandReshard(cmd *cobra.Command, args []string) error {
+	tabletTypes, err := parseTabletTypes(reshardOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.Reshard(commandCtx, &vtctldatapb.ReshardRequest{
+		Keyspace:       cmd.Flags().Arg(0),
+		Workflow:       cmd.Flags().Arg(1),
+		SourceShards:   strings.Split(cmd.Flags().Arg(2), ","),
+		TargetShards:   strings.Split(cmd.Flags().Arg(3), ","),
+		SkipSchemaCopy: reshardOptions.SkipSchemaCopy,
+		Cells:          reshardOptions.Cells,
+		TabletTypes:    tabletTypes,
+		AutoStart:      reshardOptions.AutoStart,
+		StopAfterCopy:  reshardOptions.StopAfterCopy,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, event := range resp.Events {
+		fmt.Println(logutil.EventString(event))
+	}
+
+	return nil
+}
+
+var vdiffOptions = struct {
+	SourceCell                  string
+	TargetCell                  string
+	TabletTypes                 []string
+	FilteredReplicationWaitTime time.Duration
+	Limit                       int64
+	Tables                      []string
+}{}
+
+func commandVDiff(cmd *cobra.Command, args []string) error {
+	tabletTypes, err := parseTabletTypes(vdiffOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.VDiff(commandCtx, &vtctldatapb.VDiffRequest{
+		Keyspace:                    cmd.Flags().Arg(0),
+		Workflow:                    cmd.Flags().Arg(1),
+		SourceCell:                  vdiffOptions.SourceCell,
+		TargetCell:                  vdiffOptions.TargetCell,
+		TabletTypes:                 tabletTypes,
+		FilteredReplicationWaitTime: protoutil.DurationToProto(vdiffOptions.FilteredReplicationWaitTime),
+		MaxRows:                     vdiffOptions.Limit,
+		Tables:                      vdiffOptions.Tables,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Reports)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+var workflowActionOptions = struct {
+	DryRun bool
+}{}
+
+func commandWorkflowAction(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.WorkflowAction(commandCtx, &vtctldatapb.WorkflowActionRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Workflow: cmd.Flags().Arg(1),
+		Action:   cmd.Flags().Arg(2),
+		DryRun:   workflowActionOptions.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if workflowActionOptions.DryRun {
+		fmt.Printf("%s\n", strings.Join(resp.DryRunResults, "\n"))
+		return nil
+	}
+
+	data, err := cli.MarshalJSON(resp.RowsAffectedByTablet)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func init() {
+	Root.AddCommand(GetWorkflow)
+	Root.AddCommand(GetWorkflowProgress)
+	Root.AddCommand(GetWorkflows)
+
+	MoveTables.Flags().StringVarP(&moveTablesOptions.Workflow, "workflow", "w", "", "Name of the workflow to create.")
+	MoveTables.Flags().BoolVar(&moveTablesOptions.AllTables, "all", false, "Move all tables from the source keyspace.")
+	MoveTables.Flags().StringSliceVar(&moveTablesOptions.ExcludeTables, "exclude", nil, "Tables to exclude when --all is passed.")
+	MoveTables.Flags().StringSliceVarP(&moveTablesOptions.Cells, "cells", "c", nil, "Cells or cell aliases to replicate from.")
+	MoveTables.Flags().StringSliceVar(&moveTablesOptions.TabletTypes, "tablet-types", nil, "Source tablet types to replicate from (e.g. master, replica, rdonly).")
+	MoveTables.Flags().BoolVar(&moveTablesOptions.AutoStart, "auto-start", true, "Start the streams right away. If false, they are created stopped and need to be started with WorkflowAction.")
+	MoveTables.Flags().BoolVar(&moveTablesOptions.StopAfterCopy, "stop-after-copy", false, "Stop the streams once the copy phase has completed.")
+	Root.AddCommand(MoveTables)
+
+	Reshard.Flags().StringSliceVarP(&reshardOptions.Cells, "cells", "c", nil, "Cells or cell aliases to replicate from.")
+	Reshard.Flags().StringSliceVar(&reshardOptions.TabletTypes, "tablet-types", nil, "Source tablet types to replicate from.")
+	Reshard.Flags().BoolVar(&reshardOptions.SkipSchemaCopy, "skip-schema-copy", false, "Skip copying the schema from the source shards to the target shards.")
+	Reshard.Flags().BoolVar(&reshardOptions.AutoStart, "auto-start", true, "Start the streams right away. If false, they are created stopped and need to be started with WorkflowAction.")
+	Reshard.Flags().BoolVar(&reshardOptions.StopAfterCopy, "stop-after-copy", false, "Stop the streams once the copy phase has completed.")
+	Root.AddCommand(Reshard)
+
+	VDiff.Flags().StringVar(&vdiffOptions.SourceCell, "source-cell", "", "The source cell to compare from.")
+	VDiff.Flags().StringVar(&vdiffOptions.TargetCell, "target-cell", "", "The target cell to compare with.")
+	VDiff.Flags().StringSliceVar(&vdiffOptions.TabletTypes, "tablet-types", nil, "Tablet types for source and target. Defaults to master,replica,rdonly.")
+	VDiff.Flags().DurationVar(&vdiffOptions.FilteredReplicationWaitTime, "filtered-replication-wait-time", 30*time.Second, "Maximum time to wait for filtered replication to catch up before comparing.")
+	VDiff.Flags().Int64Var(&vdiffOptions.Limit, "limit", 0, "Maximum number of rows to compare per table. Zero means no limit.")
+	VDiff.Flags().StringSliceVar(&vdiffOptions.Tables, "tables", nil, "Only compare these tables of the workflow.")
+	Root.AddCommand(VDiff)
+
+	WorkflowAction.Flags().BoolVarP(&workflowActionOptions.DryRun, "dry-run", "d", false, "Show the changes the action would make without making them.")
+	Root.AddCommand(WorkflowAction)
+}
@@ -98,15 +98,46 @@ func (gtid filePosGTID) ContainsGTID(other GTID) bool {
 	if !ok {
 		return false
 	}
-	if filePosOther.file < gtid.file {
+	switch compareBinlogFiles(filePosOther.file, gtid.file) {
+	case -1:
 		return true
-	}
-	if filePosOther.file > gtid.file {
+	case 1:
 		return false
 	}
 	return filePosOther.pos <= gtid.pos
 }
 
+// compareBinlogFiles compares two binlog file names, returning -1, 0 or 1.
+// Binlog files are named basename.NNNNNN, and the sequence number grows
+// past six digits once it reaches 999999. The sequence numbers are
+// therefore compared as integers when the base names match.
+func compareBinlogFiles(a, b string) int {
+	aBase, aSeq, aOK := splitBinlogFile(a)
+	bBase, bSeq, bOK := splitBinlogFile(b)
+	if aOK && bOK && aBase == bBase {
+		switch {
+		case aSeq < bSeq:
+			return -1
+		case aSeq > bSeq:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(a, b)
+}
+
+func splitBinlogFile(file string) (base string, seq uint64, ok bool) {
+	i := strings.LastIndexByte(file, '.')
+	if i < 0 {
+		return "", 0, false
+	}
+	seq, err := strconv.ParseUint(file[i+1:], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return file[:i], seq, true
+}
+
 // Contains implements GTIDSet.Contains().
 func (gtid filePosGTID) Contains(other GTIDSet) bool {
 	if other == nil {
@@ -87,6 +87,24 @@ func Test_filePosGTID_ContainsGTID(t *testing.T) {
 			args{other: filePosGTID{file: "testfile", pos: 103939867}},
 			false,
 		},
+		{
+			"returns true when the other file is older",
+			fields{file: "mysql-bin.000010", pos: 4},
+			args{other: filePosGTID{file: "mysql-bin.000009", pos: 1234}},
+			true,
+		},
+		{
+			"compares file sequence numbers as integers past 999999",
+			fields{file: "mysql-bin.1000000", pos: 4},
+			args{other: filePosGTID{file: "mysql-bin.999999", pos: 1234}},
+			true,
+		},
+		{
+			"returns false when the other file is newer past 999999",
+			fields{file: "mysql-bin.999999", pos: 1234},
+			args{other: filePosGTID{file: "mysql-bin.1000000", pos: 4}},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
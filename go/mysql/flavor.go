@@ -187,6 +187,32 @@ func (c *Conn) MasterPosition() (Position, error) {
 	}, nil
 }
 
+// GTIDEnabled returns true if the server writes GTIDs to its binary logs, so
+// that replication can be positioned by GTID. The binary logs of servers
+// without GTIDs can only be streamed with the FilePos flavor.
+func (c *Conn) GTIDEnabled() (bool, error) {
+	query := "select @@global.gtid_mode"
+	if c.IsMariaDB() {
+		// MariaDB always writes GTIDs since 10.0, which is also the version
+		// that introduced this variable.
+		query = "select @@global.gtid_binlog_pos"
+	}
+	qr, err := c.ExecuteFetch(query, 1, false)
+	if err != nil {
+		if sqlErr, ok := err.(*SQLError); ok && sqlErr.Number() == ERUnknownSystemVariable {
+			return false, nil
+		}
+		return false, err
+	}
+	if c.IsMariaDB() {
+		return true, nil
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return false, vterrors.Errorf(vtrpc.Code_INTERNAL, "unexpected result for %s: %v", query, qr.Rows)
+	}
+	return strings.EqualFold(qr.Rows[0][0].ToString(), "ON"), nil
+}
+
 // MasterFilePosition returns the current master's file based replication position.
 func (c *Conn) MasterFilePosition() (Position, error) {
 	filePosFlavor := filePosFlavor{}
@@ -313,8 +339,10 @@ func parseReplicationStatus(fields map[string]string) ReplicationStatus {
 	status.MasterPort = int(parseInt)
 	parseInt, _ = strconv.ParseInt(fields["Connect_Retry"], 10, 0)
 	status.MasterConnectRetry = int(parseInt)
-	parseUint, _ := strconv.ParseUint(fields["Seconds_Behind_Master"], 10, 0)
+	parseUint, err := strconv.ParseUint(fields["Seconds_Behind_Master"], 10, 0)
 	status.SecondsBehindMaster = uint(parseUint)
+	// Seconds_Behind_Master is NULL when the lag is unknown.
+	status.ReplicationLagUnknown = err != nil
 	parseUint, _ = strconv.ParseUint(fields["Master_Server_Id"], 10, 0)
 	status.MasterServerID = uint(parseUint)
 
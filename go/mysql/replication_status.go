@@ -37,10 +37,13 @@ type ReplicationStatus struct {
 	IOThreadRunning      bool
 	SQLThreadRunning     bool
 	SecondsBehindMaster  uint
-	MasterHost           string
-	MasterPort           int
-	MasterConnectRetry   int
-	MasterUUID           SID
+	// ReplicationLagUnknown is true when MySQL reports no replication lag,
+	// in which case SecondsBehindMaster is 0.
+	ReplicationLagUnknown bool
+	MasterHost            string
+	MasterPort            int
+	MasterConnectRetry    int
+	MasterUUID            SID
 }
 
 // ReplicationRunning returns true iff both the IO and SQL threads are
@@ -52,18 +55,19 @@ func (s *ReplicationStatus) ReplicationRunning() bool {
 // ReplicationStatusToProto translates a Status to proto3.
 func ReplicationStatusToProto(s ReplicationStatus) *replicationdatapb.Status {
 	return &replicationdatapb.Status{
-		Position:             EncodePosition(s.Position),
-		RelayLogPosition:     EncodePosition(s.RelayLogPosition),
-		FilePosition:         EncodePosition(s.FilePosition),
-		FileRelayLogPosition: EncodePosition(s.FileRelayLogPosition),
-		MasterServerId:       uint32(s.MasterServerID),
-		IoThreadRunning:      s.IOThreadRunning,
-		SqlThreadRunning:     s.SQLThreadRunning,
-		SecondsBehindMaster:  uint32(s.SecondsBehindMaster),
-		MasterHost:           s.MasterHost,
-		MasterPort:           int32(s.MasterPort),
-		MasterConnectRetry:   int32(s.MasterConnectRetry),
-		MasterUuid:           s.MasterUUID.String(),
+		Position:              EncodePosition(s.Position),
+		RelayLogPosition:      EncodePosition(s.RelayLogPosition),
+		FilePosition:          EncodePosition(s.FilePosition),
+		FileRelayLogPosition:  EncodePosition(s.FileRelayLogPosition),
+		MasterServerId:        uint32(s.MasterServerID),
+		IoThreadRunning:       s.IOThreadRunning,
+		SqlThreadRunning:      s.SQLThreadRunning,
+		SecondsBehindMaster:   uint32(s.SecondsBehindMaster),
+		ReplicationLagUnknown: s.ReplicationLagUnknown,
+		MasterHost:            s.MasterHost,
+		MasterPort:            int32(s.MasterPort),
+		MasterConnectRetry:    int32(s.MasterConnectRetry),
+		MasterUuid:            s.MasterUUID.String(),
 	}
 }
 
@@ -93,18 +97,19 @@ func ProtoToReplicationStatus(s *replicationdatapb.Status) ReplicationStatus {
 		}
 	}
 	return ReplicationStatus{
-		Position:             pos,
-		RelayLogPosition:     relayPos,
-		FilePosition:         filePos,
-		FileRelayLogPosition: fileRelayPos,
-		MasterServerID:       uint(s.MasterServerId),
-		IOThreadRunning:      s.IoThreadRunning,
-		SQLThreadRunning:     s.SqlThreadRunning,
-		SecondsBehindMaster:  uint(s.SecondsBehindMaster),
-		MasterHost:           s.MasterHost,
-		MasterPort:           int(s.MasterPort),
-		MasterConnectRetry:   int(s.MasterConnectRetry),
-		MasterUUID:           sid,
+		Position:              pos,
+		RelayLogPosition:      relayPos,
+		FilePosition:          filePos,
+		FileRelayLogPosition:  fileRelayPos,
+		MasterServerID:        uint(s.MasterServerId),
+		IOThreadRunning:       s.IoThreadRunning,
+		SQLThreadRunning:      s.SqlThreadRunning,
+		SecondsBehindMaster:   uint(s.SecondsBehindMaster),
+		ReplicationLagUnknown: s.ReplicationLagUnknown,
+		MasterHost:            s.MasterHost,
+		MasterPort:            int(s.MasterPort),
+		MasterConnectRetry:    int(s.MasterConnectRetry),
+		MasterUUID:            sid,
 	}
 }
 
@@ -491,6 +491,90 @@ func TestClientFoundRows(t *testing.T) {
 	c.Close()
 }
 
+// gtidModeHandler answers select @@global.gtid_mode with the given mode, or
+// fails it with the given error.
+type gtidModeHandler struct {
+	testHandler
+	gtidMode string
+	gtidErr  error
+}
+
+func (th *gtidModeHandler) ComQuery(c *Conn, query string, callback func(*sqltypes.Result) error) error {
+	if query != "select @@global.gtid_mode" {
+		return th.testHandler.ComQuery(c, query, callback)
+	}
+	th.mu.Lock()
+	gtidMode, gtidErr := th.gtidMode, th.gtidErr
+	th.mu.Unlock()
+	if gtidErr != nil {
+		return gtidErr
+	}
+	return callback(sqltypes.MakeTestResult(sqltypes.MakeTestFields("@@global.gtid_mode", "varchar"), gtidMode))
+}
+
+func TestGTIDEnabled(t *testing.T) {
+	th := &gtidModeHandler{}
+
+	authServer := NewAuthServerStatic("", "", 0)
+	authServer.entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+		UserData: "userData1",
+	}}
+	defer authServer.close()
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0, false)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "password1",
+	}
+	c, err := Connect(context.Background(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	tests := []struct {
+		gtidMode string
+		err      error
+		want     bool
+		wantErr  bool
+	}{
+		{gtidMode: "ON", want: true},
+		{gtidMode: "OFF", want: false},
+		{gtidMode: "ON_PERMISSIVE", want: false},
+		{err: NewSQLError(ERUnknownSystemVariable, SSUnknownSQLState, "Unknown system variable 'gtid_mode'"), want: false},
+		{err: NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "access denied"), wantErr: true},
+	}
+	for _, tt := range tests {
+		th.mu.Lock()
+		th.gtidMode = tt.gtidMode
+		th.gtidErr = tt.err
+		th.mu.Unlock()
+
+		got, err := c.GTIDEnabled()
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("GTIDEnabled(%v): expected an error", tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("GTIDEnabled(%v, %v): %v", tt.gtidMode, tt.err, err)
+		}
+		if got != tt.want {
+			t.Errorf("GTIDEnabled(%v, %v) = %v, want %v", tt.gtidMode, tt.err, got, tt.want)
+		}
+	}
+}
+
 func TestConnCounts(t *testing.T) {
 	th := &testHandler{}
 
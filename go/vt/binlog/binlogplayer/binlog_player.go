@@ -66,6 +66,10 @@ var (
 	BlpStopped = "Stopped"
 	// BlpError is for the Error state.
 	BlpError = "Error"
+
+	// DDLAwaitingApproval is the message prefix of a stream that was
+	// stopped at a DDL that has to be applied by an operator.
+	DDLAwaitingApproval = "DDL awaiting approval: "
 )
 
 // Stats is the internal stats of a player. It is a different
@@ -94,6 +98,9 @@ type Stats struct {
 	CopyRowCount  *stats.Counter
 	CopyLoopCount *stats.Counter
 	ErrorCounts   *stats.CountersWithMultiLabels
+	// ConflictCounts counts the changes skipped because they
+	// conflicted with the target rows, per table.
+	ConflictCounts *stats.CountersWithSingleLabel
 }
 
 // RecordHeartbeat updates the time the last heartbeat from vstreamer was seen
@@ -149,6 +156,7 @@ func NewStats() *Stats {
 	bps.CopyRowCount = stats.NewCounter("", "")
 	bps.CopyLoopCount = stats.NewCounter("", "")
 	bps.ErrorCounts = stats.NewCountersWithMultiLabels("", "", []string{"type"})
+	bps.ConflictCounts = stats.NewCountersWithSingleLabel("", "", "Table", "")
 	return bps
 }
 
@@ -374,6 +374,19 @@ func (dbcfgs *DBConfigs) InitWithSocket(defaultSocketFile string) {
 	log.Infof("DBConfigs: %v\n", dbcfgs.String())
 }
 
+// SetFlavor overrides the flavor of the connection parameters after
+// InitWithSocket, as if it had been configured beforehand.
+func (dbcfgs *DBConfigs) SetFlavor(flavor string) {
+	dbcfgs.Flavor = flavor
+	for _, userKey := range All {
+		if userKey == ExternalRepl {
+			continue
+		}
+		_, cp := dbcfgs.getParams(userKey, dbcfgs)
+		cp.Flavor = flavor
+	}
+}
+
 func (dbcfgs *DBConfigs) getParams(userKey string, dbc *DBConfigs) (*UserConfig, *mysql.ConnParams) {
 	var uc *UserConfig
 	var cp *mysql.ConnParams
@@ -215,6 +215,27 @@ func TestUseTCP(t *testing.T) {
 	assert.Equal(t, want, dbConfigs.dbaParams)
 }
 
+func TestSetFlavor(t *testing.T) {
+	dbConfigs := DBConfigs{
+		Host: "a",
+		Port: 1,
+		App: UserConfig{
+			User: "app",
+		},
+		Dba: UserConfig{
+			User: "dba",
+		},
+	}
+	dbConfigs.InitWithSocket("")
+	dbConfigs.SetFlavor(mysql.FilePosFlavorID)
+
+	assert.Equal(t, mysql.FilePosFlavorID, dbConfigs.Flavor)
+	assert.Equal(t, mysql.FilePosFlavorID, dbConfigs.appParams.Flavor)
+	assert.Equal(t, mysql.FilePosFlavorID, dbConfigs.dbaParams.Flavor)
+	assert.Equal(t, mysql.FilePosFlavorID, dbConfigs.AllPrivsWithDB().connParams.Flavor)
+	assert.Equal(t, "", dbConfigs.externalReplParams.Flavor)
+}
+
 func TestAccessors(t *testing.T) {
 	dbc := &DBConfigs{
 		appParams:      mysql.ConnParams{},
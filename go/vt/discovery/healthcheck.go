@@ -80,6 +80,8 @@ var (
 	KeyspacesToWatch flagutil.StringListValue
 	// RefreshInterval is the interval at which healthcheck refreshes its list of tablets from topo
 	RefreshInterval = flag.Duration("tablet_refresh_interval", 1*time.Minute, "tablet refresh interval")
+	// RefreshWatch tells us whether to watch the tablets in topo instead of polling them
+	RefreshWatch = flag.Bool("tablet_refresh_watch", false, "watch the tablets of each cell in topo, instead of listing them every tablet_refresh_interval. Changes are seen as they happen, and topo isn't read when nothing changes. If a watch fails, it is set again after tablet_refresh_interval.")
 	// RefreshKnownTablets tells us whether to process all tablets or only new tablets
 	RefreshKnownTablets = flag.Bool("tablet_refresh_known_tablets", true, "tablet refresh reloads the tablet address/port map from topo in case it changes")
 	// TopoReadConcurrency tells us how many topo reads are allowed in parallel
@@ -293,7 +295,11 @@ func NewHealthCheck(ctx context.Context, retryDelay, healthCheckTimeout time.Dur
 		} else if len(KeyspacesToWatch) > 0 {
 			filter = NewFilterByKeyspace(KeyspacesToWatch)
 		}
-		topoWatchers = append(topoWatchers, NewCellTabletsWatcher(ctx, topoServer, hc, filter, c, *RefreshInterval, *RefreshKnownTablets, *TopoReadConcurrency))
+		if *RefreshWatch {
+			topoWatchers = append(topoWatchers, NewWatchingCellTabletsWatcher(ctx, topoServer, hc, filter, c, *RefreshInterval))
+		} else {
+			topoWatchers = append(topoWatchers, NewCellTabletsWatcher(ctx, topoServer, hc, filter, c, *RefreshInterval, *RefreshKnownTablets, *TopoReadConcurrency))
+		}
 	}
 
 	hc.topoWatchers = topoWatchers
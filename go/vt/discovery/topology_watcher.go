@@ -41,6 +41,7 @@ import (
 
 const (
 	topologyWatcherOpListTablets   = "ListTablets"
+	topologyWatcherOpWatchTablets  = "WatchTablets"
 	topologyWatcherOpGetTablet     = "GetTablet"
 	topologyWatcherOpAddTablet     = "AddTablet"
 	topologyWatcherOpRemoveTablet  = "RemoveTablet"
@@ -49,9 +50,9 @@ const (
 
 var (
 	topologyWatcherOperations = stats.NewCountersWithSingleLabel("TopologyWatcherOperations", "Topology watcher operation counts",
-		"Operation", topologyWatcherOpListTablets, topologyWatcherOpGetTablet, topologyWatcherOpAddTablet, topologyWatcherOpRemoveTablet, topologyWatcherOpReplaceTablet)
+		"Operation", topologyWatcherOpListTablets, topologyWatcherOpWatchTablets, topologyWatcherOpGetTablet, topologyWatcherOpAddTablet, topologyWatcherOpRemoveTablet, topologyWatcherOpReplaceTablet)
 	topologyWatcherErrors = stats.NewCountersWithSingleLabel("TopologyWatcherErrors", "Topology watcher error counts",
-		"Operation", topologyWatcherOpListTablets, topologyWatcherOpGetTablet)
+		"Operation", topologyWatcherOpListTablets, topologyWatcherOpWatchTablets, topologyWatcherOpGetTablet)
 )
 
 // tabletInfo is used internally by the TopologyWatcher class
@@ -61,8 +62,9 @@ type tabletInfo struct {
 }
 
 // TopologyWatcher polls tablet from a configurable set of tablets
-// periodically. When tablets are added / removed, it calls
-// the LegacyTabletRecorder AddTablet / RemoveTablet interface appropriately.
+// periodically, or watches all the tablets of a cell in topo. When
+// tablets are added / removed, it calls the LegacyTabletRecorder
+// AddTablet / RemoveTablet interface appropriately.
 type TopologyWatcher struct {
 	// set at construction time
 	topoServer          *topo.Server
@@ -75,6 +77,10 @@ type TopologyWatcher struct {
 	sem                 chan int
 	ctx                 context.Context
 	cancelFunc          context.CancelFunc
+	// watch is set if the tablets of the cell are watched in topo,
+	// instead of being polled. refreshInterval is then the delay
+	// before a failed watch is set again.
+	watch bool
 	// wg keeps track of all launched Go routines.
 	wg sync.WaitGroup
 
@@ -86,6 +92,8 @@ type TopologyWatcher struct {
 	topoChecksum uint32
 	// lastRefresh records the timestamp of the last topo refresh
 	lastRefresh time.Time
+	// watching is true while the topo watch of the tablets is up.
+	watching bool
 	// firstLoadDone is true when first load of the topology data is done.
 	firstLoadDone bool
 	// firstLoadChan is closed when the initial loading of topology data is done.
@@ -122,10 +130,29 @@ func NewCellTabletsWatcher(ctx context.Context, topoServer *topo.Server, tr Tabl
 	})
 }
 
+// NewWatchingCellTabletsWatcher returns a TopologyWatcher that watches all
+// the tablets in a cell in topo, so changes are seen as they happen. If
+// the watch fails, it is set again after retryDelay.
+func NewWatchingCellTabletsWatcher(ctx context.Context, topoServer *topo.Server, tr TabletRecorder, f TabletFilter, cell string, retryDelay time.Duration) *TopologyWatcher {
+	tw := NewTopologyWatcher(ctx, topoServer, tr, f, cell, retryDelay, true /* refreshKnownTablets */, 1 /* topoReadConcurrency */, nil)
+	tw.watch = true
+	return tw
+}
+
 // Start starts the topology watcher
 func (tw *TopologyWatcher) Start() {
 	tw.wg.Add(1)
 	defer tw.wg.Done()
+	if tw.watch {
+		for {
+			tw.watchTablets()
+			select {
+			case <-tw.ctx.Done():
+				return
+			case <-time.After(tw.refreshInterval):
+			}
+		}
+	}
 	ticker := time.NewTicker(tw.refreshInterval)
 	defer ticker.Stop()
 	for {
@@ -163,14 +190,9 @@ func (tw *TopologyWatcher) loadTablets() {
 		return
 	}
 
-	// Accumulate a list of all known alias strings to use later
-	// when sorting
-	tabletAliasStrs := make([]string, 0, len(tabletAliases))
-
 	tw.mu.Lock()
 	for _, tAlias := range tabletAliases {
 		aliasStr := topoproto.TabletAliasString(tAlias)
-		tabletAliasStrs = append(tabletAliasStrs, aliasStr)
 
 		if !tw.refreshKnownTablets {
 			// we already have a tabletInfo for this and the flag tells us to not refresh
@@ -213,7 +235,86 @@ func (tw *TopologyWatcher) loadTablets() {
 	tw.mu.Unlock()
 	wg.Wait()
 	tw.mu.Lock()
+	tw.setTablets(newTablets)
+	tw.mu.Unlock()
+}
+
+// watchTablets watches the tablets of the cell in topo, and applies the
+// changes until the watch fails or the watcher is stopped.
+func (tw *TopologyWatcher) watchTablets() {
+	current, changes, cancel, err := tw.topoServer.WatchTablets(tw.ctx, tw.cell)
+	topologyWatcherOperations.Add(topologyWatcherOpWatchTablets, 1)
+	if err != nil {
+		topologyWatcherErrors.Add(topologyWatcherOpWatchTablets, 1)
+		select {
+		case <-tw.ctx.Done():
+			return
+		default:
+		}
+		log.Errorf("cannot watch tablets for cell: %v: %v", tw.cell, err)
+		return
+	}
+
+	newTablets := make(map[string]*tabletInfo)
+	for _, ti := range current {
+		if !(tw.tabletFilter == nil || tw.tabletFilter.IsIncluded(ti.Tablet)) {
+			continue
+		}
+		aliasStr := topoproto.TabletAliasString(ti.Alias)
+		newTablets[aliasStr] = &tabletInfo{
+			alias:  aliasStr,
+			tablet: ti.Tablet,
+		}
+	}
+	tw.mu.Lock()
+	tw.setTablets(newTablets)
+	tw.watching = true
+	tw.mu.Unlock()
+	defer func() {
+		tw.mu.Lock()
+		tw.watching = false
+		tw.lastRefresh = time.Now()
+		tw.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-tw.ctx.Done():
+			cancel()
+			for range changes {
+			}
+			return
+		case wd, ok := <-changes:
+			if !ok {
+				return
+			}
+			if wd.Alias == nil {
+				// The watch failed, changes is closed right after.
+				topologyWatcherErrors.Add(topologyWatcherOpWatchTablets, 1)
+				log.Errorf("watch on tablets for cell %v failed, retrying in %v: %v", tw.cell, tw.refreshInterval, wd.Err)
+				continue
+			}
+
+			// A deleted tablet, and a tablet that doesn't pass the
+			// filter anymore, are both removed.
+			aliasStr := topoproto.TabletAliasString(wd.Alias)
+			var newVal *tabletInfo
+			if wd.Err == nil && (tw.tabletFilter == nil || tw.tabletFilter.IsIncluded(wd.Value.Tablet)) {
+				newVal = &tabletInfo{
+					alias:  aliasStr,
+					tablet: wd.Value.Tablet,
+				}
+			}
+			tw.mu.Lock()
+			tw.setTablet(aliasStr, newVal)
+			tw.mu.Unlock()
+		}
+	}
+}
 
+// setTablets replaces all the known tablets with newTablets, and calls the
+// TabletRecorder for the ones that changed. tw.mu must be held.
+func (tw *TopologyWatcher) setTablets(newTablets map[string]*tabletInfo) {
 	for alias, newVal := range newTablets {
 		// trust the alias from topo and add it if it doesn't exist
 		if val, ok := tw.tablets[alias]; !ok {
@@ -243,22 +344,48 @@ func (tw *TopologyWatcher) loadTablets() {
 		tw.firstLoadDone = true
 		close(tw.firstLoadChan)
 	}
+	tw.updateChecksum()
+}
+
+// setTablet adds, replaces or, if newVal is nil, removes a single tablet,
+// and calls the TabletRecorder if it changed. tw.mu must be held.
+func (tw *TopologyWatcher) setTablet(alias string, newVal *tabletInfo) {
+	val, ok := tw.tablets[alias]
+	switch {
+	case newVal == nil && ok:
+		tw.tabletRecorder.RemoveTablet(val.tablet)
+		topologyWatcherOperations.Add(topologyWatcherOpRemoveTablet, 1)
+		delete(tw.tablets, alias)
+	case newVal == nil:
+		return
+	case !ok:
+		tw.tabletRecorder.AddTablet(newVal.tablet)
+		topologyWatcherOperations.Add(topologyWatcherOpAddTablet, 1)
+		tw.tablets[alias] = newVal
+	default:
+		if TabletToMapKey(val.tablet) != TabletToMapKey(newVal.tablet) {
+			tw.tabletRecorder.ReplaceTablet(val.tablet, newVal.tablet)
+			topologyWatcherOperations.Add(topologyWatcherOpReplaceTablet, 1)
+		}
+		tw.tablets[alias] = newVal
+	}
+	tw.updateChecksum()
+}
 
-	// iterate through the tablets in a stable order and compute a
-	// checksum of the tablet map
+// updateChecksum iterates through the tablets in a stable order and
+// computes a checksum of the tablet map. tw.mu must be held.
+func (tw *TopologyWatcher) updateChecksum() {
+	tabletAliasStrs := make([]string, 0, len(tw.tablets))
+	for alias := range tw.tablets {
+		tabletAliasStrs = append(tabletAliasStrs, alias)
+	}
 	sort.Strings(tabletAliasStrs)
 	var buf bytes.Buffer
 	for _, alias := range tabletAliasStrs {
-		_, ok := tw.tablets[alias]
-		if ok {
-			buf.WriteString(alias)
-		}
+		buf.WriteString(alias)
 	}
 	tw.topoChecksum = crc32.ChecksumIEEE(buf.Bytes())
 	tw.lastRefresh = time.Now()
-
-	tw.mu.Unlock()
-
 }
 
 // RefreshLag returns the time since the last refresh
@@ -266,6 +393,10 @@ func (tw *TopologyWatcher) RefreshLag() time.Duration {
 	tw.mu.Lock()
 	defer tw.mu.Unlock()
 
+	if tw.watching {
+		// Changes are seen as they happen.
+		return 0
+	}
 	return time.Since(tw.lastRefresh)
 }
 
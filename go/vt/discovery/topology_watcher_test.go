@@ -17,6 +17,7 @@ limitations under the License.
 package discovery
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 	"time"
@@ -289,6 +290,113 @@ func checkWatcher(t *testing.T, refreshKnownTablets bool) {
 	tw.Stop()
 }
 
+// waitForTablets waits until the tablets of fhc are the given ones.
+func waitForTablets(t *testing.T, fhc *FakeHealthCheck, tablets ...*topodatapb.Tablet) {
+	t.Helper()
+	timeout := time.After(10 * time.Second)
+	for {
+		allTablets := fhc.GetAllTablets()
+		found := 0
+		for _, tablet := range tablets {
+			if got, ok := allTablets[TabletToMapKey(tablet)]; ok && proto.Equal(got, tablet) {
+				found++
+			}
+		}
+		if found == len(tablets) && len(allTablets) == len(tablets) {
+			return
+		}
+		select {
+		case <-timeout:
+			t.Fatalf("fhc.GetAllTablets() = %+v; want %+v", allTablets, tablets)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestWatchingCellTabletsWatcher(t *testing.T) {
+	ctx := context.Background()
+	ts, factory := memorytopo.NewServerAndFactory("aa")
+	fhc := NewFakeHealthCheck()
+	filter := NewFilterByKeyspace([]string{"keyspace"})
+	tablet := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "aa",
+			Uid:  0,
+		},
+		Hostname: "host1",
+		PortMap: map[string]int32{
+			"vt": 123,
+		},
+		Keyspace: "keyspace",
+		Shard:    "shard",
+	}
+	if err := ts.CreateTablet(ctx, tablet); err != nil {
+		t.Fatalf("CreateTablet failed: %v", err)
+	}
+
+	// The tablets that exist are loaded right away.
+	tw := NewWatchingCellTabletsWatcher(ctx, ts, fhc, filter, "aa", 10*time.Millisecond)
+	go tw.Start()
+	defer tw.Stop()
+	<-tw.firstLoadChan
+	waitForTablets(t, fhc, tablet)
+	checkChecksum(t, tw, 3238442862)
+	if lag := tw.RefreshLag(); lag != 0 {
+		t.Errorf("RefreshLag() = %v, want 0 while watching", lag)
+	}
+
+	// A new tablet is added as soon as it is created.
+	tablet2 := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "aa",
+			Uid:  2,
+		},
+		Hostname: "host2",
+		PortMap: map[string]int32{
+			"vt": 789,
+		},
+		Keyspace: "keyspace",
+		Shard:    "shard",
+	}
+	if err := ts.CreateTablet(ctx, tablet2); err != nil {
+		t.Fatalf("CreateTablet failed: %v", err)
+	}
+	waitForTablets(t, fhc, tablet, tablet2)
+	checkChecksum(t, tw, 2762153755)
+
+	// A tablet that moves is replaced.
+	if _, err := ts.UpdateTabletFields(ctx, tablet.Alias, func(t *topodatapb.Tablet) error {
+		t.PortMap["vt"] = 456
+		tablet = t
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateTabletFields failed: %v", err)
+	}
+	waitForTablets(t, fhc, tablet, tablet2)
+
+	// A tablet that doesn't pass the filter anymore is removed.
+	if _, err := ts.UpdateTabletFields(ctx, tablet.Alias, func(t *topodatapb.Tablet) error {
+		t.Keyspace = "other_keyspace"
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateTabletFields failed: %v", err)
+	}
+	waitForTablets(t, fhc, tablet2)
+	checkChecksum(t, tw, 789108290)
+
+	// A failed watch is set again, and catches up.
+	factory.SetError(fmt.Errorf("topo is down"))
+	for tw.RefreshLag() == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	factory.SetError(nil)
+	if err := ts.DeleteTablet(ctx, tablet2.Alias); err != nil {
+		t.Fatalf("DeleteTablet failed: %v", err)
+	}
+	waitForTablets(t, fhc)
+	checkChecksum(t, tw, 0)
+}
+
 func TestFilterByShard(t *testing.T) {
 	testcases := []struct {
 		filters  []string
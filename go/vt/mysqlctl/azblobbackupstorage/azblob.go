@@ -250,6 +250,9 @@ func (bh *AZBlobBackupHandle) ReadFile(ctx context.Context, filename string) (io
 	blobURL := containerURL.NewBlobURL(obj)
 
 	resp, err := blobURL.Download(ctx, 0, azblob.CountToEnd, azblob.BlobAccessConditions{}, false)
+	if serr, ok := err.(azblob.StorageError); ok && serr.ServiceCode() == azblob.ServiceCodeBlobNotFound {
+		return nil, fmt.Errorf("%w: %v", backupstorage.ErrFileNotFound, err)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -342,6 +345,12 @@ func (bs *AZBlobBackupStorage) StartBackup(ctx context.Context, dir, name string
 	}, nil
 }
 
+// ReopenBackup implements BackupStorage. Blobs are simply overwritten,
+// so this is the same as StartBackup.
+func (bs *AZBlobBackupStorage) ReopenBackup(ctx context.Context, dir, name string) (backupstorage.BackupHandle, error) {
+	return bs.StartBackup(ctx, dir, name)
+}
+
 // RemoveBackup implements BackupStorage.
 func (bs *AZBlobBackupStorage) RemoveBackup(ctx context.Context, dir, name string) error {
 	log.Infof("ListBackups: [azblob] container: %s, directory: %s", *containerName, objName(dir, ""))
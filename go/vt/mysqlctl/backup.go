@@ -93,6 +93,8 @@ var (
 // - uses the BackupStorage service to store a new backup
 // - shuts down Mysqld during the backup
 // - remember if we were replicating, restore the exact same state
+// If params.Incremental is set, only the binary logs written since the most
+// recent backup are stored, and mysqld keeps running.
 func Backup(ctx context.Context, params BackupParams) error {
 	startTs := time.Now()
 	backupDir := GetBackupDir(params.Keyspace, params.Shard)
@@ -103,18 +105,39 @@ func Backup(ctx context.Context, params BackupParams) error {
 		return vterrors.Wrap(err, "unable to get backup storage")
 	}
 	defer bs.Close()
-	bh, err := bs.StartBackup(ctx, backupDir, name)
-	if err != nil {
-		return vterrors.Wrap(err, "StartBackup failed")
+
+	// An incremental backup picks up where the most recent backup left off.
+	var fromPos mysql.Position
+	if params.Incremental {
+		bhs, err := bs.ListBackups(ctx, backupDir)
+		if err != nil {
+			return vterrors.Wrap(err, "ListBackups failed")
+		}
+		if len(bhs) == 0 {
+			return vterrors.Wrapf(ErrNoBackup, "incremental backup needs a previous backup in %v", backupDir)
+		}
+		fromPos, err = findLatestBackupPosition(ctx, params, bhs)
+		if err != nil {
+			return vterrors.Wrapf(err, "incremental backup needs a previous backup in %v", backupDir)
+		}
 	}
 
-	be, err := GetBackupEngine()
+	bh, err := bs.StartBackup(ctx, backupDir, name)
 	if err != nil {
-		return vterrors.Wrap(err, "failed to find backup engine")
+		return vterrors.Wrap(err, "StartBackup failed")
 	}
 
 	// Take the backup, and either AbortBackup or EndBackup.
-	usable, err := be.ExecuteBackup(ctx, params, bh)
+	var usable bool
+	if params.Incremental {
+		usable, err = executeIncrementalBackup(ctx, params, bh, fromPos)
+	} else {
+		var be BackupEngine
+		if be, err = GetBackupEngine(); err != nil {
+			return vterrors.Wrap(err, "failed to find backup engine")
+		}
+		usable, err = be.ExecuteBackup(ctx, params, bh)
+	}
 	logger := params.Logger
 	var finishErr error
 	if usable {
@@ -267,7 +290,13 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, ErrNoBackup
 	}
 
-	bh, err := FindBackupToRestore(ctx, params, bhs)
+	var bh backupstorage.BackupHandle
+	var incrementals []backupstorage.BackupHandle
+	if params.isPointInTime() {
+		bh, incrementals, err = findPointInTimeRestorePath(ctx, params, bhs)
+	} else {
+		bh, err = FindBackupToRestore(ctx, params, bhs)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -301,6 +330,16 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, vterrors.Wrap(err, "mysql_upgrade failed")
 	}
 
+	// For a point-in-time restore, replay the binary logs from the
+	// incremental backups on top of the full backup.
+	if params.isPointInTime() {
+		pos, err := applyIncrementalBackups(ctx, params, manifest.Position, incrementals)
+		if err != nil {
+			return nil, err
+		}
+		manifest.Position = pos
+	}
+
 	// Add backupTime and restorePosition to LocalMetadata
 	params.LocalMetadata["RestoredBackupTime"] = manifest.BackupTime
 	params.LocalMetadata["RestorePosition"] = mysql.EncodePosition(manifest.Position)
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
@@ -66,6 +67,9 @@ type BackupParams struct {
 	TabletAlias string
 	// BackupTime is the time at which the backup is being started
 	BackupTime time.Time
+	// Incremental, if set, only archives the binary logs written since the
+	// most recent backup instead of taking a full backup with the engine
+	Incremental bool
 }
 
 // RestoreParams is the struct that holds all params passed to ExecuteRestore
@@ -92,6 +96,18 @@ type RestoreParams struct {
 	// StartTime: if non-zero, look for a backup that was taken at or before this time
 	// Otherwise, find the most recent backup
 	StartTime time.Time
+	// RestoreToPos: if non-zero, restore the latest full backup at or before this
+	// position, and replay binary logs from incremental backups up to it
+	RestoreToPos mysql.Position
+	// RestoreToTimestamp: if non-zero, restore the latest full backup taken at or
+	// before this time, and replay binary logs from incremental backups up to it
+	RestoreToTimestamp time.Time
+}
+
+// isPointInTime returns true if the restore should replay binary logs up to
+// a given position or timestamp rather than stop at a backup's position.
+func (params *RestoreParams) isPointInTime() bool {
+	return !params.RestoreToPos.IsZero() || !params.RestoreToTimestamp.IsZero()
 }
 
 // RestoreEngine is the interface to restore a backup with a given engine.
@@ -155,6 +171,8 @@ func GetBackupManifest(ctx context.Context, backup backupstorage.BackupHandle) (
 }
 
 // getBackupManifestInto fetches and decodes a MANIFEST file into the specified object.
+// If the MANIFEST records an encryption key, it must itself be encrypted with
+// that key, and so must every other file read from the backup afterwards.
 func getBackupManifestInto(ctx context.Context, backup backupstorage.BackupHandle, outManifest interface{}) error {
 	file, err := backup.ReadFile(ctx, backupManifestFileName)
 	if err != nil {
@@ -162,9 +180,44 @@ func getBackupManifestInto(ctx context.Context, backup backupstorage.BackupHandl
 	}
 	defer file.Close()
 
-	if err := json.NewDecoder(file).Decode(outManifest); err != nil {
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return vterrors.Wrap(err, "can't read MANIFEST")
+	}
+	if err := json.Unmarshal(data, outManifest); err != nil {
+		return vterrors.Wrap(err, "can't decode MANIFEST")
+	}
+
+	var bm BackupManifest
+	if err := json.Unmarshal(data, &bm); err != nil {
 		return vterrors.Wrap(err, "can't decode MANIFEST")
 	}
+	return checkBackupEncryption(backup, file, bm.EncryptionKeyID)
+}
+
+// checkBackupEncryption checks that the MANIFEST of a backup was encrypted
+// with the key it records, and makes the handle reject any file of the
+// backup that isn't encrypted with that key. When backups are encrypted,
+// backups that aren't are rejected, unless plaintext backups are allowed.
+func checkBackupEncryption(backup backupstorage.BackupHandle, manifestFile io.Reader, keyID string) error {
+	fileKeyID := ""
+	if ef, ok := manifestFile.(backupstorage.EncryptedFile); ok {
+		fileKeyID = ef.EncryptionKeyID()
+	}
+	if fileKeyID != keyID {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "MANIFEST records encryption key %q, but is encrypted with key %q", keyID, fileKeyID)
+	}
+	ebh, ok := backup.(backupstorage.EncryptedBackupHandle)
+	if !ok {
+		return nil
+	}
+	if keyID == "" {
+		if !*backupstorage.EncryptionAllowPlaintext {
+			return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup %v is not encrypted; set -backup_storage_encryption_allow_plaintext to read it", backup.Name())
+		}
+		return nil
+	}
+	ebh.RequireEncryptionKeyID(keyID)
 	return nil
 }
 
@@ -188,11 +241,34 @@ type BackupManifest struct {
 	// FinishedTime is the time (in RFC 3339 format, UTC) at which the backup finished, if known.
 	// Some backups may not set this field if they were created before the field was added.
 	FinishedTime string
+
+	// Incremental is true if the backup only contains the binary logs written
+	// between FromPosition and Position. It can only be restored on top of a
+	// backup whose position is at least FromPosition.
+	Incremental bool
+
+	// FromPosition is the replication position an incremental backup starts at.
+	// It is empty for full backups.
+	FromPosition mysql.Position
+
+	// EncryptionKeyID is the ID of the key the backup files were encrypted
+	// with, if backup encryption was enabled.
+	EncryptionKeyID string
+}
+
+// backupEncryptionKeyID returns the ID of the key the files of a backup are
+// encrypted with, or "" if they are not encrypted.
+func backupEncryptionKeyID(bh backupstorage.BackupHandle) string {
+	if ebh, ok := bh.(backupstorage.EncryptedBackupHandle); ok {
+		return ebh.EncryptionKeyID()
+	}
+	return ""
 }
 
 // FindBackupToRestore returns a selected candidate backup to be restored.
-// It returns the most recent backup that is complete, meaning it has a valid
-// MANIFEST file.
+// It returns the most recent full backup that is complete, meaning it has a
+// valid MANIFEST file. Incremental backups are never selected, since they
+// only contain binary logs.
 func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backupstorage.BackupHandle) (backupstorage.BackupHandle, error) {
 	var bh backupstorage.BackupHandle
 	var index int
@@ -208,6 +284,9 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 			params.Logger.Warningf("Possibly incomplete backup %v in directory %v on BackupStorage: can't read MANIFEST: %v)", bh.Name(), backupDir, err)
 			continue
 		}
+		if bm.Incremental {
+			continue
+		}
 
 		var backupTime time.Time
 		if checkBackupTime {
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+func TestGetBackupManifestEncryption(t *testing.T) {
+	ctx := context.Background()
+	root, err := ioutil.TempDir("", "backupenginetest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	oldKeyFile := *backupstorage.EncryptionKeyFile
+	defer func() { *backupstorage.EncryptionKeyFile = oldKeyFile }()
+	*backupstorage.EncryptionKeyFile = path.Join(root, "keys.json")
+	require.NoError(t, ioutil.WriteFile(*backupstorage.EncryptionKeyFile,
+		[]byte(`{"current_key_id": "k1", "keys": {"k1": "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="}}`), 0600))
+
+	fbs := &filebackupstorage.FileBackupStorage{}
+	ebs := backupstorage.NewEncryptedBackupStorage(fbs, backupstorage.KeyManagerMap["file"])
+	addFile := func(bh backupstorage.BackupHandle, filename string, data []byte) {
+		t.Helper()
+		wc, err := bh.AddFile(ctx, filename, int64(len(data)))
+		require.NoError(t, err)
+		_, err = wc.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, wc.Close())
+	}
+	addManifest := func(bh backupstorage.BackupHandle, keyID string) {
+		t.Helper()
+		data, err := json.Marshal(&BackupManifest{BackupMethod: builtinBackupEngineName, EncryptionKeyID: keyID})
+		require.NoError(t, err)
+		addFile(bh, backupManifestFileName, data)
+	}
+	readBackup := func() backupstorage.BackupHandle {
+		t.Helper()
+		bhs, err := ebs.ListBackups(ctx, "ks/0")
+		require.NoError(t, err)
+		require.Len(t, bhs, 1)
+		return bhs[0]
+	}
+
+	bh, err := ebs.StartBackup(ctx, "ks/0", "backup1")
+	require.NoError(t, err)
+	addFile(bh, "0", []byte("data"))
+	addManifest(bh, "k1")
+	require.NoError(t, bh.EndBackup(ctx))
+
+	rbh := readBackup()
+	bm, err := GetBackupManifest(ctx, rbh)
+	require.NoError(t, err)
+	assert.Equal(t, "k1", bm.EncryptionKeyID)
+	rc, err := rbh.ReadFile(ctx, "0")
+	require.NoError(t, err)
+	rc.Close()
+
+	// Once the MANIFEST was read, files replaced by plaintext are rejected.
+	plain, err := fbs.ReopenBackup(ctx, "ks/0", "backup1")
+	require.NoError(t, err)
+	addFile(plain, "0", []byte("data"))
+	_, err = rbh.ReadFile(ctx, "0")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "file is not encrypted")
+
+	// A plaintext MANIFEST that records a key is rejected.
+	addManifest(plain, "k1")
+	_, err = GetBackupManifest(ctx, readBackup())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `MANIFEST records encryption key "k1", but is encrypted with key ""`)
+
+	// A plaintext backup is rejected, unless plaintext backups are allowed.
+	addManifest(plain, "")
+	_, err = GetBackupManifest(ctx, readBackup())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "backup backup1 is not encrypted")
+	*backupstorage.EncryptionAllowPlaintext = true
+	_, err = GetBackupManifest(ctx, readBackup())
+	*backupstorage.EncryptionAllowPlaintext = false
+	require.NoError(t, err)
+
+	// So is an encrypted MANIFEST that doesn't record its key.
+	bh, err = ebs.ReopenBackup(ctx, "ks/0", "backup1")
+	require.NoError(t, err)
+	addManifest(bh, "")
+	_, err = GetBackupManifest(ctx, readBackup())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `MANIFEST records encryption key "", but is encrypted with key "k1"`)
+}
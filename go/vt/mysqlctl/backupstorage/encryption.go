@@ -0,0 +1,605 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupstorage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"sync"
+)
+
+// This file implements client-side encryption of backups. When a key manager
+// is configured, every file added to a backup goes through AES-GCM before
+// reaching the underlying BackupStorage, and is decrypted when read back.
+//
+// An encrypted file is laid out as:
+//   magic | key ID length (uint16) | key ID | nonce prefix (8 bytes) | chunks
+// and each chunk as:
+//   sealed length (uint32, high bit set on the last chunk) | sealed data
+// The nonce of a chunk is the nonce prefix followed by the chunk index. The
+// backup directory, the backup name, the file name and whether it is the last
+// chunk are authenticated, so truncated files, and files moved to another
+// name, backup or shard, are detected. Files without the magic prefix are read
+// as is, unless the backup handle was told the key its files must be
+// encrypted with (see EncryptedBackupHandle.RequireEncryptionKeyID). Backups
+// whose MANIFEST isn't encrypted, such as backups taken before encryption was
+// turned on, are only restored with -backup_storage_encryption_allow_plaintext.
+
+var (
+	// EncryptionKeyManager is the name of the KeyManager used to encrypt backups.
+	// Exported for test purposes.
+	EncryptionKeyManager = flag.String("backup_storage_encryption_key_manager", "", "if set, backup files are encrypted with AES-GCM using keys from this key manager (file, or a registered KMS plugin). Restores decrypt backup files transparently as long as the key manager knows their key.")
+	// EncryptionKeyFile is the file used by the "file" KeyManager.
+	// Exported for test purposes.
+	EncryptionKeyFile = flag.String("backup_storage_encryption_key_file", "", `JSON file with the backup encryption keys for the "file" key manager, e.g. {"current_key_id": "k2", "keys": {"k1": "<base64 key>", "k2": "<base64 key>"}}`)
+	// EncryptionAllowPlaintext allows backups that aren't encrypted to be read when a key manager is configured.
+	// Exported for test purposes.
+	EncryptionAllowPlaintext = flag.Bool("backup_storage_encryption_allow_plaintext", false, "if set along with -backup_storage_encryption_key_manager, backups that aren't encrypted, e.g. because they were taken before encryption was turned on, can still be restored")
+)
+
+const (
+	encryptionMagic     = "VTBKENC1"
+	encryptionChunkSize = 64 * 1024
+	lastChunkFlag       = uint32(1) << 31
+	noncePrefixSize     = 8
+)
+
+// KeyManager provides the keys backups are encrypted with. Keys must be 16,
+// 24 or 32 bytes long, to select AES-128, AES-192 or AES-256.
+type KeyManager interface {
+	// CurrentKey returns the ID and value of the key to encrypt new backups with.
+	CurrentKey(ctx context.Context) (string, []byte, error)
+
+	// Key returns the value of the key with the given ID, to decrypt
+	// existing backups.
+	Key(ctx context.Context, keyID string) ([]byte, error)
+}
+
+// KeyManagerMap contains the registered implementations for KeyManager.
+var KeyManagerMap = make(map[string]KeyManager)
+
+// EncryptedBackupStorage is implemented by encrypted BackupStorages.
+type EncryptedBackupStorage interface {
+	BackupStorage
+
+	// ReopenBackupWithKey is like ReopenBackup, except that the files added
+	// to the backup are encrypted with the given key instead of the current
+	// one, so they can be read along with the files that already are.
+	ReopenBackupWithKey(ctx context.Context, dir, name, keyID string) (BackupHandle, error)
+}
+
+// EncryptedBackupHandle is implemented by the BackupHandles of an encrypted
+// BackupStorage.
+type EncryptedBackupHandle interface {
+	BackupHandle
+
+	// EncryptionKeyID returns the ID of the key files added to the backup
+	// are encrypted with.
+	EncryptionKeyID() string
+
+	// RequireEncryptionKeyID makes ReadFile reject the files of the backup
+	// that are not encrypted with the given key, including files that are
+	// not encrypted at all. It is called once the MANIFEST of the backup
+	// was read.
+	RequireEncryptionKeyID(keyID string)
+}
+
+// EncryptedFile is implemented by the readers returned by ReadFile on the
+// BackupHandles of an encrypted BackupStorage.
+type EncryptedFile interface {
+	// EncryptionKeyID returns the ID of the key the file was encrypted
+	// with, or "" if it was not encrypted.
+	EncryptionKeyID() string
+}
+
+// getKeyManager returns the configured KeyManager, or nil if backups
+// should not be encrypted.
+func getKeyManager() (KeyManager, error) {
+	if *EncryptionKeyManager == "" {
+		return nil, nil
+	}
+	km, ok := KeyManagerMap[*EncryptionKeyManager]
+	if !ok {
+		return nil, fmt.Errorf("no registered implementation of KeyManager %q", *EncryptionKeyManager)
+	}
+	return km, nil
+}
+
+// NewEncryptedBackupStorage returns a BackupStorage that encrypts the files
+// of the backups it stores in bs with keys from km.
+func NewEncryptedBackupStorage(bs BackupStorage, km KeyManager) BackupStorage {
+	return &encryptedBackupStorage{
+		BackupStorage: bs,
+		km:            km,
+	}
+}
+
+type encryptedBackupStorage struct {
+	BackupStorage
+	km KeyManager
+}
+
+// ListBackups is part of the BackupStorage interface.
+func (ebs *encryptedBackupStorage) ListBackups(ctx context.Context, dir string) ([]BackupHandle, error) {
+	bhs, err := ebs.BackupStorage.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]BackupHandle, len(bhs))
+	for i, bh := range bhs {
+		result[i] = &encryptedBackupHandle{
+			BackupHandle: bh,
+			km:           ebs.km,
+		}
+	}
+	return result, nil
+}
+
+// StartBackup is part of the BackupStorage interface.
+func (ebs *encryptedBackupStorage) StartBackup(ctx context.Context, dir, name string) (BackupHandle, error) {
+	keyID, key, err := ebs.km.CurrentKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can't get backup encryption key: %v", err)
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup encryption key %v: %v", keyID, err)
+	}
+	bh, err := ebs.BackupStorage.StartBackup(ctx, dir, name)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedBackupHandle{
+		BackupHandle: bh,
+		km:           ebs.km,
+		keyID:        keyID,
+		gcm:          gcm,
+	}, nil
+}
+
+// ReopenBackup is part of the BackupStorage interface. Files added to the
+// reopened backup are encrypted with the current key.
+func (ebs *encryptedBackupStorage) ReopenBackup(ctx context.Context, dir, name string) (BackupHandle, error) {
+	keyID, key, err := ebs.km.CurrentKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can't get backup encryption key: %v", err)
+	}
+	return ebs.reopenBackup(ctx, dir, name, keyID, key)
+}
+
+// ReopenBackupWithKey is part of the EncryptedBackupStorage interface.
+func (ebs *encryptedBackupStorage) ReopenBackupWithKey(ctx context.Context, dir, name, keyID string) (BackupHandle, error) {
+	key, err := ebs.km.Key(ctx, keyID)
+	if err != nil {
+		return nil, fmt.Errorf("can't get backup encryption key %v: %v", keyID, err)
+	}
+	return ebs.reopenBackup(ctx, dir, name, keyID, key)
+}
+
+func (ebs *encryptedBackupStorage) reopenBackup(ctx context.Context, dir, name, keyID string, key []byte) (BackupHandle, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup encryption key %v: %v", keyID, err)
+	}
+	bh, err := ebs.BackupStorage.ReopenBackup(ctx, dir, name)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedBackupHandle{
+		BackupHandle: bh,
+		km:           ebs.km,
+		keyID:        keyID,
+		gcm:          gcm,
+	}, nil
+}
+
+type encryptedBackupHandle struct {
+	BackupHandle
+	km KeyManager
+
+	// keyID and gcm are only set for read-write backups.
+	keyID string
+	gcm   cipher.AEAD
+
+	// mu protects requiredKeyID.
+	mu            sync.Mutex
+	requiredKeyID string
+}
+
+// EncryptionKeyID is part of the EncryptedBackupHandle interface.
+func (ebh *encryptedBackupHandle) EncryptionKeyID() string {
+	return ebh.keyID
+}
+
+// RequireEncryptionKeyID is part of the EncryptedBackupHandle interface.
+func (ebh *encryptedBackupHandle) RequireEncryptionKeyID(keyID string) {
+	ebh.mu.Lock()
+	defer ebh.mu.Unlock()
+	ebh.requiredKeyID = keyID
+}
+
+// AddFile is part of the BackupHandle interface.
+func (ebh *encryptedBackupHandle) AddFile(ctx context.Context, filename string, filesize int64) (io.WriteCloser, error) {
+	if ebh.gcm == nil {
+		return nil, fmt.Errorf("AddFile cannot be called on read-only backup")
+	}
+	if filesize != FileSizeUnknown {
+		// Account for the framing and authentication tags.
+		filesize += (filesize/encryptionChunkSize + 1) * int64(4+ebh.gcm.Overhead())
+	}
+	wc, err := ebh.BackupHandle.AddFile(ctx, filename, filesize)
+	if err != nil {
+		return nil, err
+	}
+	return newEncryptingWriter(wc, ebh.keyID, ebh.gcm, fileAdditionalData(ebh.Directory(), ebh.Name(), filename))
+}
+
+// ReadFile is part of the BackupHandle interface.
+func (ebh *encryptedBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	rc, err := ebh.BackupHandle.ReadFile(ctx, filename)
+	if err != nil {
+		return nil, err
+	}
+	ebh.mu.Lock()
+	requiredKeyID := ebh.requiredKeyID
+	ebh.mu.Unlock()
+	r, err := newDecryptingReader(ctx, rc, ebh.km, requiredKeyID, fileAdditionalData(ebh.Directory(), ebh.Name(), filename))
+	if err != nil {
+		rc.Close()
+		return nil, fmt.Errorf("can't decrypt %v: %v", filename, err)
+	}
+	return r, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptingWriter seals the data written to it in chunks.
+type encryptingWriter struct {
+	wc     io.WriteCloser
+	gcm    cipher.AEAD
+	ad     []byte
+	nonce  []byte
+	chunk  uint32
+	buf    []byte
+	sealed []byte
+}
+
+func newEncryptingWriter(wc io.WriteCloser, keyID string, gcm cipher.AEAD, ad []byte) (*encryptingWriter, error) {
+	if len(keyID) > math.MaxUint16 {
+		wc.Close()
+		return nil, fmt.Errorf("backup encryption key ID is too long: %v", keyID)
+	}
+	ew := &encryptingWriter{
+		wc:    wc,
+		gcm:   gcm,
+		ad:    ad,
+		nonce: make([]byte, gcm.NonceSize()),
+		buf:   make([]byte, 0, encryptionChunkSize),
+	}
+	if _, err := rand.Read(ew.nonce[:noncePrefixSize]); err != nil {
+		wc.Close()
+		return nil, err
+	}
+
+	header := bytes.NewBufferString(encryptionMagic)
+	binary.Write(header, binary.BigEndian, uint16(len(keyID)))
+	header.WriteString(keyID)
+	header.Write(ew.nonce[:noncePrefixSize])
+	if _, err := wc.Write(header.Bytes()); err != nil {
+		wc.Close()
+		return nil, err
+	}
+	return ew, nil
+}
+
+// Write is part of the io.Writer interface.
+func (ew *encryptingWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		if len(ew.buf) == encryptionChunkSize {
+			if err := ew.flush(false); err != nil {
+				return n, err
+			}
+		}
+		c := copy(ew.buf[len(ew.buf):encryptionChunkSize], p)
+		ew.buf = ew.buf[:len(ew.buf)+c]
+		p = p[c:]
+		n += c
+	}
+	return n, nil
+}
+
+// Close seals the last chunk, and closes the underlying writer.
+func (ew *encryptingWriter) Close() error {
+	err := ew.flush(true)
+	if closeErr := ew.wc.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (ew *encryptingWriter) flush(last bool) error {
+	if ew.chunk == math.MaxUint32 {
+		return fmt.Errorf("file too large to encrypt")
+	}
+	binary.BigEndian.PutUint32(ew.nonce[noncePrefixSize:], ew.chunk)
+	ew.chunk++
+
+	ew.sealed = ew.gcm.Seal(ew.sealed[:0], ew.nonce, ew.buf, chunkAdditionalData(ew.ad, last))
+	length := uint32(len(ew.sealed))
+	if last {
+		length |= lastChunkFlag
+	}
+	var lengthBuf [4]byte
+	binary.BigEndian.PutUint32(lengthBuf[:], length)
+	if _, err := ew.wc.Write(lengthBuf[:]); err != nil {
+		return err
+	}
+	if _, err := ew.wc.Write(ew.sealed); err != nil {
+		return err
+	}
+	ew.buf = ew.buf[:0]
+	return nil
+}
+
+// fileAdditionalData returns the additional data that binds the chunks of a
+// file to the name of the file, of its backup and of the backup's directory.
+func fileAdditionalData(backupDir, backupName, filename string) []byte {
+	var ad bytes.Buffer
+	for _, name := range []string{backupDir, backupName, filename} {
+		binary.Write(&ad, binary.BigEndian, uint32(len(name)))
+		ad.WriteString(name)
+	}
+	return ad.Bytes()
+}
+
+// chunkAdditionalData returns the additional data authenticated with a
+// chunk of a file: the file additional data, then whether it is the last
+// chunk.
+func chunkAdditionalData(ad []byte, last bool) []byte {
+	flag := byte(0)
+	if last {
+		flag = 1
+	}
+	return append(ad[:len(ad):len(ad)], flag)
+}
+
+// decryptingReader opens the chunks sealed by an encryptingWriter.
+type decryptingReader struct {
+	rc     io.ReadCloser
+	r      *bufio.Reader
+	gcm    cipher.AEAD
+	keyID  string
+	ad     []byte
+	nonce  []byte
+	chunk  uint32
+	sealed []byte
+	buf    []byte
+	done   bool
+}
+
+// newDecryptingReader returns a reader that decrypts rc if it was encrypted,
+// or reads it as is otherwise. If requiredKeyID is set, rc must have been
+// encrypted with that key.
+func newDecryptingReader(ctx context.Context, rc io.ReadCloser, km KeyManager, requiredKeyID string, ad []byte) (io.ReadCloser, error) {
+	r := bufio.NewReader(rc)
+	magic, err := r.Peek(len(encryptionMagic))
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, err
+	}
+	if string(magic) != encryptionMagic {
+		if requiredKeyID != "" {
+			return nil, fmt.Errorf("file is not encrypted, but the backup is encrypted with key %v", requiredKeyID)
+		}
+		return &plaintextReader{Reader: r, rc: rc}, nil
+	}
+	if _, err := r.Discard(len(encryptionMagic)); err != nil {
+		return nil, err
+	}
+
+	var keyIDLen uint16
+	if err := binary.Read(r, binary.BigEndian, &keyIDLen); err != nil {
+		return nil, fmt.Errorf("can't read encryption header: %v", err)
+	}
+	keyID := make([]byte, keyIDLen)
+	if _, err := io.ReadFull(r, keyID); err != nil {
+		return nil, fmt.Errorf("can't read encryption header: %v", err)
+	}
+	if requiredKeyID != "" && string(keyID) != requiredKeyID {
+		return nil, fmt.Errorf("file is encrypted with key %v, but the backup is encrypted with key %v", string(keyID), requiredKeyID)
+	}
+	key, err := km.Key(ctx, string(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("can't get backup encryption key %v: %v", string(keyID), err)
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup encryption key %v: %v", string(keyID), err)
+	}
+	dr := &decryptingReader{
+		rc:    rc,
+		r:     r,
+		gcm:   gcm,
+		keyID: string(keyID),
+		ad:    ad,
+		nonce: make([]byte, gcm.NonceSize()),
+	}
+	if _, err := io.ReadFull(r, dr.nonce[:noncePrefixSize]); err != nil {
+		return nil, fmt.Errorf("can't read encryption header: %v", err)
+	}
+	return dr, nil
+}
+
+// Read is part of the io.Reader interface.
+func (dr *decryptingReader) Read(p []byte) (int, error) {
+	for len(dr.buf) == 0 {
+		if dr.done {
+			return 0, io.EOF
+		}
+		if err := dr.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, dr.buf)
+	dr.buf = dr.buf[n:]
+	return n, nil
+}
+
+func (dr *decryptingReader) readChunk() error {
+	var length uint32
+	if err := binary.Read(dr.r, binary.BigEndian, &length); err != nil {
+		if err == io.EOF {
+			return fmt.Errorf("encrypted file is truncated")
+		}
+		return err
+	}
+	last := length&lastChunkFlag != 0
+	length &^= lastChunkFlag
+	if length > encryptionChunkSize+uint32(dr.gcm.Overhead()) {
+		return fmt.Errorf("invalid encrypted chunk length %v", length)
+	}
+	if cap(dr.sealed) < int(length) {
+		dr.sealed = make([]byte, length)
+	}
+	dr.sealed = dr.sealed[:length]
+	if _, err := io.ReadFull(dr.r, dr.sealed); err != nil {
+		return fmt.Errorf("encrypted file is truncated: %v", err)
+	}
+
+	binary.BigEndian.PutUint32(dr.nonce[noncePrefixSize:], dr.chunk)
+	dr.chunk++
+	plain, err := dr.gcm.Open(dr.sealed[:0], dr.nonce, dr.sealed, chunkAdditionalData(dr.ad, last))
+	if err != nil {
+		return fmt.Errorf("can't decrypt chunk %v: %v", dr.chunk-1, err)
+	}
+	dr.buf = plain
+	dr.done = last
+	return nil
+}
+
+// EncryptionKeyID is part of the EncryptedFile interface.
+func (dr *decryptingReader) EncryptionKeyID() string {
+	return dr.keyID
+}
+
+// Close is part of the io.Closer interface.
+func (dr *decryptingReader) Close() error {
+	return dr.rc.Close()
+}
+
+// plaintextReader reads a file that was not encrypted.
+type plaintextReader struct {
+	*bufio.Reader
+	rc io.ReadCloser
+}
+
+// EncryptionKeyID is part of the EncryptedFile interface.
+func (pr *plaintextReader) EncryptionKeyID() string {
+	return ""
+}
+
+// Close is part of the io.Closer interface.
+func (pr *plaintextReader) Close() error {
+	return pr.rc.Close()
+}
+
+// fileKeyManager reads keys from the JSON file named by the
+// backup_storage_encryption_key_file flag. The file is read on each
+// call, so keys can be rotated without restarting.
+type fileKeyManager struct{}
+
+// encryptionKeyFile is the content of the file read by fileKeyManager.
+type encryptionKeyFile struct {
+	// CurrentKeyID is the ID of the key to encrypt new backups with.
+	CurrentKeyID string `json:"current_key_id"`
+	// Keys maps key IDs to base64 encoded keys.
+	Keys map[string]string `json:"keys"`
+}
+
+func (fileKeyManager) read() (*encryptionKeyFile, error) {
+	if *EncryptionKeyFile == "" {
+		return nil, fmt.Errorf("backup_storage_encryption_key_file is not set")
+	}
+	data, err := ioutil.ReadFile(*EncryptionKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	keys := &encryptionKeyFile{}
+	if err := json.Unmarshal(data, keys); err != nil {
+		return nil, fmt.Errorf("can't parse %v: %v", *EncryptionKeyFile, err)
+	}
+	return keys, nil
+}
+
+func (fkm fileKeyManager) key(keys *encryptionKeyFile, keyID string) ([]byte, error) {
+	encoded, ok := keys.Keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %v not found in %v", keyID, *EncryptionKeyFile)
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("can't decode key %v in %v: %v", keyID, *EncryptionKeyFile, err)
+	}
+	return key, nil
+}
+
+// CurrentKey is part of the KeyManager interface.
+func (fkm fileKeyManager) CurrentKey(ctx context.Context) (string, []byte, error) {
+	keys, err := fkm.read()
+	if err != nil {
+		return "", nil, err
+	}
+	if keys.CurrentKeyID == "" {
+		return "", nil, fmt.Errorf("current_key_id is not set in %v", *EncryptionKeyFile)
+	}
+	key, err := fkm.key(keys, keys.CurrentKeyID)
+	if err != nil {
+		return "", nil, err
+	}
+	return keys.CurrentKeyID, key, nil
+}
+
+// Key is part of the KeyManager interface.
+func (fkm fileKeyManager) Key(ctx context.Context, keyID string) ([]byte, error) {
+	keys, err := fkm.read()
+	if err != nil {
+		return nil, err
+	}
+	return fkm.key(keys, keyID)
+}
+
+func init() {
+	KeyManagerMap["file"] = fileKeyManager{}
+}
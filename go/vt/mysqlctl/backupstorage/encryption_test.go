@@ -0,0 +1,316 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupstorage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/concurrency"
+)
+
+// memoryBackupStorage keeps backups in memory.
+type memoryBackupStorage struct {
+	files map[string]map[string]*bytes.Buffer
+}
+
+type memoryBackupHandle struct {
+	concurrency.AllErrorRecorder
+	bs        *memoryBackupStorage
+	dir, name string
+}
+
+type bufferCloser struct {
+	*bytes.Buffer
+}
+
+func (bufferCloser) Close() error { return nil }
+
+func (bh *memoryBackupHandle) Directory() string { return bh.dir }
+func (bh *memoryBackupHandle) Name() string      { return bh.name }
+
+func (bh *memoryBackupHandle) AddFile(ctx context.Context, filename string, filesize int64) (io.WriteCloser, error) {
+	buf := &bytes.Buffer{}
+	bh.bs.files[path.Join(bh.dir, bh.name)][filename] = buf
+	return bufferCloser{buf}, nil
+}
+
+func (bh *memoryBackupHandle) EndBackup(ctx context.Context) error   { return nil }
+func (bh *memoryBackupHandle) AbortBackup(ctx context.Context) error { return nil }
+
+func (bh *memoryBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	buf, ok := bh.bs.files[path.Join(bh.dir, bh.name)][filename]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrFileNotFound, filename)
+	}
+	return ioutil.NopCloser(bytes.NewReader(buf.Bytes())), nil
+}
+
+func (bs *memoryBackupStorage) ListBackups(ctx context.Context, dir string) ([]BackupHandle, error) {
+	var result []BackupHandle
+	for name := range bs.files {
+		if path.Dir(name) == dir {
+			result = append(result, &memoryBackupHandle{bs: bs, dir: dir, name: path.Base(name)})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name() < result[j].Name() })
+	return result, nil
+}
+
+func (bs *memoryBackupStorage) StartBackup(ctx context.Context, dir, name string) (BackupHandle, error) {
+	bs.files[path.Join(dir, name)] = make(map[string]*bytes.Buffer)
+	return &memoryBackupHandle{bs: bs, dir: dir, name: name}, nil
+}
+
+func (bs *memoryBackupStorage) ReopenBackup(ctx context.Context, dir, name string) (BackupHandle, error) {
+	if _, ok := bs.files[path.Join(dir, name)]; !ok {
+		return nil, fmt.Errorf("no backup %v", name)
+	}
+	return &memoryBackupHandle{bs: bs, dir: dir, name: name}, nil
+}
+
+func (bs *memoryBackupStorage) RemoveBackup(ctx context.Context, dir, name string) error {
+	delete(bs.files, path.Join(dir, name))
+	return nil
+}
+
+func (bs *memoryBackupStorage) Close() error { return nil }
+
+func writeKeyFile(t *testing.T, currentKeyID string, keys map[string][]byte) {
+	t.Helper()
+	content := fmt.Sprintf(`{"current_key_id": %q, "keys": {`, currentKeyID)
+	first := true
+	for id, key := range keys {
+		if !first {
+			content += ","
+		}
+		first = false
+		content += fmt.Sprintf("%q: %q", id, base64.StdEncoding.EncodeToString(key))
+	}
+	content += "}}"
+	require.NoError(t, ioutil.WriteFile(*EncryptionKeyFile, []byte(content), 0600))
+}
+
+func randomBytes(t *testing.T, n int) []byte {
+	t.Helper()
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return b
+}
+
+func addFile(t *testing.T, bh BackupHandle, name string, data []byte) {
+	t.Helper()
+	wc, err := bh.AddFile(context.Background(), name, int64(len(data)))
+	require.NoError(t, err)
+	_, err = wc.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, wc.Close())
+}
+
+func readFile(bh BackupHandle, name string) ([]byte, error) {
+	rc, err := bh.ReadFile(context.Background(), name)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return ioutil.ReadAll(rc)
+}
+
+func TestEncryptedBackupStorage(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "backupencryption")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	oldKeyFile := *EncryptionKeyFile
+	defer func() { *EncryptionKeyFile = oldKeyFile }()
+	*EncryptionKeyFile = path.Join(dir, "keys.json")
+
+	key1 := randomBytes(t, 32)
+	writeKeyFile(t, "k1", map[string][]byte{"k1": key1})
+
+	underlying := &memoryBackupStorage{files: make(map[string]map[string]*bytes.Buffer)}
+	bs := NewEncryptedBackupStorage(underlying, KeyManagerMap["file"])
+
+	// Files of all sizes, including several chunks and an exact number of chunks.
+	contents := map[string][]byte{
+		"empty":    {},
+		"small":    []byte("some backup contents"),
+		"chunks":   randomBytes(t, 3*encryptionChunkSize+17),
+		"boundary": randomBytes(t, 2*encryptionChunkSize),
+	}
+	bh, err := bs.StartBackup(ctx, "ks/0", "backup1")
+	require.NoError(t, err)
+	assert.Equal(t, "k1", bh.(EncryptedBackupHandle).EncryptionKeyID())
+	for name, data := range contents {
+		addFile(t, bh, name, data)
+	}
+	require.NoError(t, bh.EndBackup(ctx))
+
+	// Nothing is stored in plaintext.
+	stored := underlying.files["ks/0/backup1"]["small"].Bytes()
+	assert.False(t, bytes.Contains(stored, contents["small"]))
+	assert.True(t, bytes.HasPrefix(stored, []byte(encryptionMagic)))
+
+	// Rotate the key: new backups use the new key, old ones can still be read.
+	key2 := randomBytes(t, 16)
+	writeKeyFile(t, "k2", map[string][]byte{"k1": key1, "k2": key2})
+	bh, err = bs.StartBackup(ctx, "ks/0", "backup2")
+	require.NoError(t, err)
+	assert.Equal(t, "k2", bh.(EncryptedBackupHandle).EncryptionKeyID())
+	addFile(t, bh, "small", contents["small"])
+
+	// Backups taken before encryption are read as is.
+	plain, err := underlying.StartBackup(ctx, "ks/0", "backup0")
+	require.NoError(t, err)
+	addFile(t, plain, "small", contents["small"])
+
+	bhs, err := bs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+	require.Len(t, bhs, 3)
+	got, err := readFile(bhs[0], "small")
+	require.NoError(t, err)
+	assert.Equal(t, contents["small"], got)
+	for name, data := range contents {
+		got, err := readFile(bhs[1], name)
+		require.NoError(t, err, name)
+		assert.Equal(t, data, got, name)
+	}
+	got, err = readFile(bhs[2], "small")
+	require.NoError(t, err)
+	assert.Equal(t, contents["small"], got)
+
+	// Truncated and tampered files are rejected.
+	full := underlying.files["ks/0/backup1"]["chunks"].Bytes()
+	underlying.files["ks/0/backup1"]["chunks"] = bytes.NewBuffer(full[:len(full)-100])
+	_, err = readFile(bhs[1], "chunks")
+	assert.Error(t, err)
+	underlying.files["ks/0/backup1"]["chunks"] = bytes.NewBuffer(full[:encryptionChunkSize+100])
+	_, err = readFile(bhs[1], "chunks")
+	assert.Error(t, err)
+	tampered := append([]byte{}, full...)
+	tampered[len(tampered)-1] ^= 1
+	underlying.files["ks/0/backup1"]["chunks"] = bytes.NewBuffer(tampered)
+	_, err = readFile(bhs[1], "chunks")
+	assert.Error(t, err)
+
+	underlying.files["ks/0/backup1"]["chunks"] = bytes.NewBuffer(full)
+
+	// Files moved to another name or another backup are rejected.
+	underlying.files["ks/0/backup1"]["moved"] = bytes.NewBuffer(underlying.files["ks/0/backup1"]["small"].Bytes())
+	_, err = readFile(bhs[1], "moved")
+	assert.Error(t, err)
+	underlying.files["ks/0/backup2"]["small"] = bytes.NewBuffer(underlying.files["ks/0/backup1"]["small"].Bytes())
+	_, err = readFile(bhs[2], "small")
+	assert.Error(t, err)
+
+	// So are backups copied under another shard.
+	underlying.files["ks/-80/backup1"] = map[string]*bytes.Buffer{
+		"small": bytes.NewBuffer(underlying.files["ks/0/backup1"]["small"].Bytes()),
+	}
+	copied, err := bs.ListBackups(ctx, "ks/-80")
+	require.NoError(t, err)
+	require.Len(t, copied, 1)
+	_, err = readFile(copied[0], "small")
+	assert.Error(t, err)
+
+	// A key that is no longer known can't decrypt.
+	writeKeyFile(t, "k2", map[string][]byte{"k2": key2})
+	_, err = readFile(bhs[1], "small")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "key k1 not found")
+}
+
+func TestGetBackupStorageEncryption(t *testing.T) {
+	oldImplementation, oldKeyManager := *BackupStorageImplementation, *EncryptionKeyManager
+	defer func() {
+		*BackupStorageImplementation, *EncryptionKeyManager = oldImplementation, oldKeyManager
+		delete(BackupStorageMap, "memory")
+	}()
+	BackupStorageMap["memory"] = &memoryBackupStorage{}
+	*BackupStorageImplementation = "memory"
+
+	bs, err := GetBackupStorage()
+	require.NoError(t, err)
+	assert.Equal(t, BackupStorageMap["memory"], bs)
+
+	*EncryptionKeyManager = "file"
+	bs, err = GetBackupStorage()
+	require.NoError(t, err)
+	assert.IsType(t, &encryptedBackupStorage{}, bs)
+
+	*EncryptionKeyManager = "unknown"
+	_, err = GetBackupStorage()
+	assert.Error(t, err)
+}
+
+func TestEncryptedBackupRequireKeyID(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "backupencryption")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	oldKeyFile := *EncryptionKeyFile
+	defer func() { *EncryptionKeyFile = oldKeyFile }()
+	*EncryptionKeyFile = path.Join(dir, "keys.json")
+	writeKeyFile(t, "k1", map[string][]byte{"k1": randomBytes(t, 32), "k2": randomBytes(t, 32)})
+
+	underlying := &memoryBackupStorage{files: make(map[string]map[string]*bytes.Buffer)}
+	bs := NewEncryptedBackupStorage(underlying, KeyManagerMap["file"])
+	bh, err := bs.StartBackup(ctx, "ks/0", "backup1")
+	require.NoError(t, err)
+	addFile(t, bh, "encrypted", []byte("some backup contents"))
+	plain, err := underlying.ReopenBackup(ctx, "ks/0", "backup1")
+	require.NoError(t, err)
+	addFile(t, plain, "plain", []byte("some backup contents"))
+
+	bhs, err := bs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+	require.Len(t, bhs, 1)
+	rc, err := bhs[0].ReadFile(ctx, "encrypted")
+	require.NoError(t, err)
+	assert.Equal(t, "k1", rc.(EncryptedFile).EncryptionKeyID())
+	rc.Close()
+	rc, err = bhs[0].ReadFile(ctx, "plain")
+	require.NoError(t, err)
+	assert.Equal(t, "", rc.(EncryptedFile).EncryptionKeyID())
+	rc.Close()
+
+	// Once the key is required, plaintext files and files encrypted with
+	// another key are rejected.
+	bhs[0].(EncryptedBackupHandle).RequireEncryptionKeyID("k1")
+	_, err = readFile(bhs[0], "encrypted")
+	assert.NoError(t, err)
+	_, err = readFile(bhs[0], "plain")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "file is not encrypted, but the backup is encrypted with key k1")
+	bhs[0].(EncryptedBackupHandle).RequireEncryptionKeyID("k2")
+	_, err = readFile(bhs[0], "encrypted")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "file is encrypted with key k1, but the backup is encrypted with key k2")
+}
@@ -19,6 +19,7 @@ limitations under the License.
 package backupstorage
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"io"
@@ -26,6 +27,7 @@ import (
 	"context"
 
 	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/vterrors"
 )
 
 var (
@@ -36,8 +38,24 @@ var (
 	// This is typically used while creating a file programmatically, where it is
 	// impossible to compute the final size on disk ahead of time.
 	FileSizeUnknown = int64(-1)
+
+	// ErrFileNotFound is returned, possibly wrapped, by BackupHandle.ReadFile
+	// when the file doesn't exist in the backup.
+	ErrFileNotFound = errors.New("file not found in backup")
 )
 
+// IsFileNotFound returns true if err was caused by reading a file that
+// doesn't exist in a backup.
+func IsFileNotFound(err error) bool {
+	for err != nil {
+		if errors.Is(err, ErrFileNotFound) {
+			return true
+		}
+		err = vterrors.Cause(err)
+	}
+	return false
+}
+
 // BackupHandle describes an individual backup.
 type BackupHandle interface {
 	// Directory is the location of the backup. Will contain keyspace/shard.
@@ -73,6 +91,7 @@ type BackupHandle interface {
 
 	// ReadFile starts reading a file from a backup.
 	// Only works for read-only backups (created by ListBackups).
+	// If the file doesn't exist, the error wraps ErrFileNotFound.
 	// The context is valid for the duration of the reads, until the
 	// ReadCloser is closed.
 	ReadFile(ctx context.Context, filename string) (io.ReadCloser, error)
@@ -99,6 +118,12 @@ type BackupStorage interface {
 	// function, and should not be stored by the implementation.
 	StartBackup(ctx context.Context, dir, name string) (BackupHandle, error)
 
+	// ReopenBackup returns a read-write handle on an existing backup,
+	// so files can be added to it or replaced. EndBackup must be
+	// called when done. AbortBackup must not be, since it would
+	// remove the whole backup.
+	ReopenBackup(ctx context.Context, dir, name string) (BackupHandle, error)
+
 	// RemoveBackup removes all the data associated with a backup.
 	// It will not appear in ListBackups after RemoveBackup succeeds.
 	RemoveBackup(ctx context.Context, dir, name string) error
@@ -113,6 +138,8 @@ type BackupStorage interface {
 var BackupStorageMap = make(map[string]BackupStorage)
 
 // GetBackupStorage returns the current BackupStorage implementation.
+// If a backup encryption key manager is configured, the implementation is
+// wrapped so backup files are encrypted.
 // Should be called after flags have been initialized.
 // When all operations are done, call BackupStorage.Close() to free resources.
 func GetBackupStorage() (BackupStorage, error) {
@@ -120,5 +147,12 @@ func GetBackupStorage() (BackupStorage, error) {
 	if !ok {
 		return nil, fmt.Errorf("no registered implementation of BackupStorage")
 	}
+	km, err := getKeyManager()
+	if err != nil {
+		return nil, err
+	}
+	if km != nil {
+		return NewEncryptedBackupStorage(bs, km), nil
+	}
 	return bs, nil
 }
@@ -28,9 +28,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
-	"github.com/planetscale/pargzip"
-
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -77,11 +74,20 @@ type builtinBackupManifest struct {
 	// TransformHook that was used on the files, if any.
 	TransformHook string
 
-	// SkipCompress is true if the backup files were NOT run through gzip.
+	// SkipCompress is true if the backup files were NOT compressed.
 	// The field is expressed as a negative because it will come through as
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionCodec is the codec the files were compressed with, if they
+	// were. Empty means pargzip, the only codec before the field existed.
+	CompressionCodec string
+
+	// ExternalDecompressor is the command the files were meant to be
+	// decompressed with if CompressionCodec is external. It's informational:
+	// restores only run the external_decompressor flag.
+	ExternalDecompressor string
 }
 
 // FileEntry is one file to backup
@@ -90,6 +96,7 @@ type FileEntry struct {
 	// - backupInnodbDataHomeDir for files that go into Mycnf.InnodbDataHomeDir
 	// - backupInnodbLogGroupHomeDir for files that go into Mycnf.InnodbLogGroupHomeDir
 	// - backupData for files that go into Mycnf.DataDir
+	// - backupBinlogDir for binary logs, which go into the directory of Mycnf.BinLogPath
 	Base string
 
 	// Name is the file name, relative to Base
@@ -110,6 +117,8 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 		root = cnf.InnodbLogGroupHomeDir
 	case backupData:
 		root = cnf.DataDir
+	case backupBinlogDir:
+		root = path.Dir(cnf.BinLogPath)
 	default:
 		return nil, vterrors.Errorf(vtrpc.Code_UNKNOWN, "unknown base: %v", fe.Base)
 	}
@@ -138,7 +147,7 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 // and an overall error.
 func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
 
-	params.Logger.Infof("Hook: %v, Compress: %v", *backupStorageHook, *backupStorageCompress)
+	params.Logger.Infof("Hook: %v, Compress: %v, Codec: %v", *backupStorageHook, *backupStorageCompress, *compressionCodec)
 
 	// Save initial state so we can restore.
 	replicaStartRequired := false
@@ -276,7 +285,7 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 }
 
 // backupFiles finds the list of files to backup, and creates the backup.
-func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position) (finalErr error) {
+func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position) error {
 
 	// Get the files to backup.
 	// We don't care about totalSize because we add each file separately.
@@ -322,25 +331,15 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 		return bh.Error()
 	}
 
-	// open the MANIFEST
-	wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
-	if err != nil {
-		return vterrors.Wrapf(err, "cannot add %v to backup", backupManifestFileName)
-	}
-	defer func() {
-		if closeErr := wc.Close(); finalErr == nil {
-			finalErr = closeErr
-		}
-	}()
-
 	// JSON-encode and write the MANIFEST
 	bm := &builtinBackupManifest{
 		// Common base fields
 		BackupManifest: BackupManifest{
-			BackupMethod: builtinBackupEngineName,
-			Position:     replicationPosition,
-			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
-			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+			BackupMethod:    builtinBackupEngineName,
+			Position:        replicationPosition,
+			BackupTime:      params.BackupTime.UTC().Format(time.RFC3339),
+			FinishedTime:    time.Now().UTC().Format(time.RFC3339),
+			EncryptionKeyID: backupEncryptionKeyID(bh),
 		},
 
 		// Builtin-specific fields
@@ -348,6 +347,36 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 		TransformHook: *backupStorageHook,
 		SkipCompress:  !*backupStorageCompress,
 	}
+	setBackupManifestCompression(bm)
+	return writeBackupManifest(ctx, bh, bm)
+}
+
+// setBackupManifestCompression records in a builtin manifest how the backup
+// files were compressed.
+func setBackupManifestCompression(bm *builtinBackupManifest) {
+	if bm.SkipCompress {
+		return
+	}
+	bm.CompressionCodec = *compressionCodec
+	if bm.CompressionCodec == externalCompressionCodec {
+		bm.ExternalDecompressor = *externalDecompressor
+	}
+}
+
+// writeBackupManifest JSON-encodes the given manifest and adds it to the
+// backup as the MANIFEST file.
+func writeBackupManifest(ctx context.Context, bh backupstorage.BackupHandle, bm interface{}) (finalErr error) {
+	// open the MANIFEST
+	wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to backup", backupManifestFileName)
+	}
+	defer func() {
+		if closeErr := wc.Close(); finalErr == nil {
+			finalErr = closeErr
+		}
+	}()
+
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
 		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
@@ -408,27 +437,30 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		writer = pipe
 	}
 
-	// Create the gzip compression pipe, if necessary.
-	var gzip *pargzip.Writer
+	// Create the compression pipe, if necessary.
+	var compressor io.WriteCloser
 	if *backupStorageCompress {
-		gzip = pargzip.NewWriter(writer)
-		gzip.ChunkSize = *backupCompressBlockSize
-		gzip.Parallel = *backupCompressBlocks
-		gzip.CompressionLevel = pargzip.BestSpeed
-		writer = gzip
+		codec, err := getCompressionCodec(*compressionCodec, "")
+		if err != nil {
+			return err
+		}
+		if compressor, err = codec.NewCompressor(ctx, writer); err != nil {
+			return vterrors.Wrap(err, "can't create compressor")
+		}
+		writer = compressor
 	}
 
-	// Copy from the source file to writer (optional gzip,
+	// Copy from the source file to writer (optional compression,
 	// optional pipe, tee, output file and hasher).
 	_, err = io.Copy(writer, source)
 	if err != nil {
 		return vterrors.Wrap(err, "cannot copy data")
 	}
 
-	// Close gzip to flush it, after that all data is sent to writer.
-	if gzip != nil {
-		if err = gzip.Close(); err != nil {
-			return vterrors.Wrap(err, "cannot close gzip")
+	// Close the compressor to flush it, after that all data is sent to writer.
+	if compressor != nil {
+		if err = compressor.Close(); err != nil {
+			return vterrors.Wrap(err, "cannot close compressor")
 		}
 	}
 
@@ -510,7 +542,7 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 			// And restore the file.
 			name := fmt.Sprintf("%v", i)
 			params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
-			err := be.restoreFile(ctx, params, bh, &fes[i], bm.TransformHook, !bm.SkipCompress, name)
+			err := be.restoreFile(ctx, params, bh, &fes[i], bm, name)
 			if err != nil {
 				rec.RecordError(vterrors.Wrapf(err, "can't restore file %v to %v", name, fes[i].Name))
 			}
@@ -521,7 +553,7 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 }
 
 // restoreFile restores an individual file.
-func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string) (finalErr error) {
+func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, bm builtinBackupManifest, name string) (finalErr error) {
 	// Open the source file for reading.
 	source, err := bh.ReadFile(ctx, name)
 	if err != nil {
@@ -557,32 +589,36 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 
 	// Create the external read pipe, if any.
 	var wait hook.WaitFunc
-	if transformHook != "" {
-		h := hook.NewHook(transformHook, []string{"-operation", "read"})
+	if bm.TransformHook != "" {
+		h := hook.NewHook(bm.TransformHook, []string{"-operation", "read"})
 		h.ExtraEnv = params.HookExtraEnv
 		reader, wait, _, err = h.ExecuteAsReadPipe(reader)
 		if err != nil {
-			return vterrors.Wrapf(err, "'%v' hook returned error", transformHook)
+			return vterrors.Wrapf(err, "'%v' hook returned error", bm.TransformHook)
 		}
 	}
 
 	// Create the uncompresser if needed.
-	if compress {
-		gz, err := pgzip.NewReader(reader)
+	if !bm.SkipCompress {
+		codec, err := getCompressionCodec(bm.CompressionCodec, bm.ExternalDecompressor)
+		if err != nil {
+			return err
+		}
+		decompressor, err := codec.NewDecompressor(ctx, reader)
 		if err != nil {
-			return vterrors.Wrap(err, "can't open gzip decompressor")
+			return vterrors.Wrap(err, "can't open decompressor")
 		}
 		defer func() {
-			if cerr := gz.Close(); cerr != nil {
+			if cerr := decompressor.Close(); cerr != nil {
 				if finalErr != nil {
 					// We already have an error, just log this one.
-					log.Errorf("failed to close gzip decompressor %v: %v", name, cerr)
+					log.Errorf("failed to close decompressor %v: %v", name, cerr)
 				} else {
-					finalErr = vterrors.Wrap(err, "failed to close gzip decompressor")
+					finalErr = vterrors.Wrap(cerr, "failed to close decompressor")
 				}
 			}
 		}()
-		reader = gz
+		reader = decompressor
 	}
 
 	// Copy the data. Will also write to the hasher.
@@ -594,10 +630,10 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	if wait != nil {
 		stderr, err := wait()
 		if stderr != "" {
-			log.Infof("'%v' hook returned stderr: %v", transformHook, stderr)
+			log.Infof("'%v' hook returned stderr: %v", bm.TransformHook, stderr)
 		}
 		if err != nil {
-			return vterrors.Wrapf(err, "'%v' returned error", transformHook)
+			return vterrors.Wrapf(err, "'%v' returned error", bm.TransformHook)
 		}
 	}
 
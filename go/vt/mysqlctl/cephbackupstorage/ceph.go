@@ -143,7 +143,20 @@ func (bh *CephBackupHandle) ReadFile(ctx context.Context, filename string) (io.R
 	// ceph bucket name
 	bucket := alterBucketName(bh.dir)
 	object := objName(bh.dir, bh.name, filename)
-	return bh.client.GetObjectWithContext(ctx, bucket, object, minio.GetObjectOptions{})
+	obj, err := bh.client.GetObjectWithContext(ctx, bucket, object, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// The object is only fetched when it's first read or stat'ed, so stat
+	// it to report a missing file here.
+	if _, err := obj.Stat(); err != nil {
+		obj.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, fmt.Errorf("%w: %v", backupstorage.ErrFileNotFound, err)
+		}
+		return nil, err
+	}
+	return obj, nil
 }
 
 // CephBackupStorage implements BackupStorage for Ceph Cloud Storage.
@@ -231,6 +244,12 @@ func (bs *CephBackupStorage) StartBackup(ctx context.Context, dir, name string)
 	}, nil
 }
 
+// ReopenBackup implements BackupStorage. Objects are simply overwritten,
+// so this is the same as StartBackup.
+func (bs *CephBackupStorage) ReopenBackup(ctx context.Context, dir, name string) (backupstorage.BackupHandle, error) {
+	return bs.StartBackup(ctx, dir, name)
+}
+
 // RemoveBackup implements BackupStorage.
 func (bs *CephBackupStorage) RemoveBackup(ctx context.Context, dir, name string) error {
 	c, err := bs.client()
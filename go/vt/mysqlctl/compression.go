@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os/exec"
+
+	"github.com/google/shlex"
+	"github.com/klauspost/compress/zstd"
+	"github.com/klauspost/pgzip"
+	"github.com/pierrec/lz4/v4"
+	"github.com/planetscale/pargzip"
+
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// pargzipCompressionCodec is the default codec. Backups compressed
+	// before codecs could be chosen all used it.
+	pargzipCompressionCodec = "pargzip"
+
+	// externalCompressionCodec runs the commands given by the
+	// external_compressor and external_decompressor flags.
+	externalCompressionCodec = "external"
+)
+
+var (
+	// compressionCodec is the codec new builtin backups are compressed with.
+	compressionCodec = flag.String("backup_storage_compression_codec", pargzipCompressionCodec, "if backup_storage_compress is true, the codec the builtin backup engine compresses files with: pargzip, zstd, lz4 or external. The codec is recorded in the backup MANIFEST, so restores always use the matching decompressor.")
+
+	// externalCompressor is the command the external codec compresses with.
+	externalCompressor = flag.String("external_compressor", "", "with -backup_storage_compression_codec=external, the command to compress backup files with. It reads from stdin and writes to stdout, e.g. 'zstd -T0 -c'.")
+
+	// externalDecompressor is the command the external codec decompresses
+	// with. It's recorded in the MANIFEST of new backups for reference only.
+	externalDecompressor = flag.String("external_decompressor", "", "the command to decompress backup files compressed with the external codec. It reads from stdin and writes to stdout, e.g. 'zstd -d -c'. It's recorded in the MANIFEST of new backups for reference, but restores only ever run the command given by this flag.")
+)
+
+// CompressionCodec compresses and decompresses backup files.
+type CompressionCodec interface {
+	// NewCompressor returns a writer that compresses what is written to it
+	// into w. Closing it flushes everything to w, but doesn't close w.
+	NewCompressor(ctx context.Context, w io.Writer) (io.WriteCloser, error)
+
+	// NewDecompressor returns a reader that decompresses what is read from r.
+	NewDecompressor(ctx context.Context, r io.Reader) (io.ReadCloser, error)
+}
+
+// CompressionCodecMap contains the registered compression codecs, by name.
+// The external codec isn't in the map, since it's configured by flags.
+var CompressionCodecMap = map[string]CompressionCodec{
+	pargzipCompressionCodec: pargzipCodec{},
+	"zstd":                  zstdCodec{},
+	"lz4":                   lz4Codec{},
+}
+
+// getCompressionCodec returns the codec with the given name. The external
+// codec only ever runs the external_decompressor flag: manifestDecompressor,
+// the command recorded in the MANIFEST of the backup, could have been written
+// by anyone with access to the backup storage, so it's never run. Restoring a
+// backup that records one fails until the flag is set.
+func getCompressionCodec(name, manifestDecompressor string) (CompressionCodec, error) {
+	if name == "" {
+		name = pargzipCompressionCodec
+	}
+	if name == externalCompressionCodec {
+		if *externalDecompressor == "" && manifestDecompressor != "" {
+			return nil, fmt.Errorf("backup was compressed with the %v compression codec, set external_decompressor to restore it (the MANIFEST suggests %q)", externalCompressionCodec, manifestDecompressor)
+		}
+		return externalCodec{compressor: *externalCompressor, decompressor: *externalDecompressor}, nil
+	}
+	codec, ok := CompressionCodecMap[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown compression codec %q", name)
+	}
+	return codec, nil
+}
+
+// pargzipCodec compresses with gzip, in parallel blocks.
+type pargzipCodec struct{}
+
+func (pargzipCodec) NewCompressor(ctx context.Context, w io.Writer) (io.WriteCloser, error) {
+	gzip := pargzip.NewWriter(w)
+	gzip.ChunkSize = *backupCompressBlockSize
+	gzip.Parallel = *backupCompressBlocks
+	gzip.CompressionLevel = pargzip.BestSpeed
+	return gzip, nil
+}
+
+func (pargzipCodec) NewDecompressor(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	return pgzip.NewReader(r)
+}
+
+// zstdCodec compresses with zstd, using backup_storage_number_blocks
+// goroutines.
+type zstdCodec struct{}
+
+func (zstdCodec) NewCompressor(ctx context.Context, w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(*backupCompressBlocks))
+}
+
+func (zstdCodec) NewDecompressor(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	d, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return d.IOReadCloser(), nil
+}
+
+// lz4Codec compresses with lz4, using backup_storage_number_blocks
+// goroutines.
+type lz4Codec struct{}
+
+func (lz4Codec) NewCompressor(ctx context.Context, w io.Writer) (io.WriteCloser, error) {
+	lw := lz4.NewWriter(w)
+	if err := lw.Apply(lz4.ConcurrencyOption(*backupCompressBlocks)); err != nil {
+		return nil, err
+	}
+	return lw, nil
+}
+
+func (lz4Codec) NewDecompressor(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	return ioutil.NopCloser(lz4.NewReader(r)), nil
+}
+
+// externalCodec pipes the data through external commands.
+type externalCodec struct {
+	compressor, decompressor string
+}
+
+func (c externalCodec) NewCompressor(ctx context.Context, w io.Writer) (io.WriteCloser, error) {
+	cmd, err := externalCommand(ctx, c.compressor, "external_compressor")
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdout = w
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	ec := &externalCompressorPipe{cmd: cmd, stdin: stdin}
+	cmd.Stderr = &ec.stderr
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "can't start %v", c.compressor)
+	}
+	return ec, nil
+}
+
+func (c externalCodec) NewDecompressor(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	cmd, err := externalCommand(ctx, c.decompressor, "external_decompressor")
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdin = r
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	ed := &externalDecompressorPipe{cmd: cmd, stdout: stdout}
+	cmd.Stderr = &ed.stderr
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "can't start %v", c.decompressor)
+	}
+	return ed, nil
+}
+
+func externalCommand(ctx context.Context, command, flagName string) (*exec.Cmd, error) {
+	args, err := shlex.Split(command)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "can't parse %v", flagName)
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("%v must be set to use the %v compression codec", flagName, externalCompressionCodec)
+	}
+	return exec.CommandContext(ctx, args[0], args[1:]...), nil
+}
+
+// externalCompressorPipe is the stdin of a running compression command.
+// Closing it waits for the command to finish writing.
+type externalCompressorPipe struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stderr bytes.Buffer
+}
+
+func (ec *externalCompressorPipe) Write(p []byte) (int, error) {
+	return ec.stdin.Write(p)
+}
+
+func (ec *externalCompressorPipe) Close() error {
+	if err := ec.stdin.Close(); err != nil {
+		return err
+	}
+	if err := ec.cmd.Wait(); err != nil {
+		return fmt.Errorf("%v failed: %v, stderr: %v", ec.cmd.Path, err, ec.stderr.String())
+	}
+	return nil
+}
+
+// externalDecompressorPipe is the stdout of a running decompression command.
+// Closing it stops reading and waits for the command to exit.
+type externalDecompressorPipe struct {
+	cmd    *exec.Cmd
+	stdout io.ReadCloser
+	stderr bytes.Buffer
+}
+
+func (ed *externalDecompressorPipe) Read(p []byte) (int, error) {
+	return ed.stdout.Read(p)
+}
+
+func (ed *externalDecompressorPipe) Close() error {
+	// Wait must not be called before we are done reading.
+	ed.stdout.Close()
+	if err := ed.cmd.Wait(); err != nil {
+		return fmt.Errorf("%v failed: %v, stderr: %v", ed.cmd.Path, err, ed.stderr.String())
+	}
+	return nil
+}
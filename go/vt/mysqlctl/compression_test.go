@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressionCodecs(t *testing.T) {
+	ctx := context.Background()
+	data := []byte(strings.Repeat("some very compressible backup data ", 100000))
+
+	codecs := []string{"", "pargzip", "zstd", "lz4"}
+	if _, err := exec.LookPath("gzip"); err == nil {
+		oldCompressor, oldDecompressor := *externalCompressor, *externalDecompressor
+		defer func() { *externalCompressor, *externalDecompressor = oldCompressor, oldDecompressor }()
+		*externalCompressor, *externalDecompressor = "gzip -c", "gzip -d -c"
+		codecs = append(codecs, externalCompressionCodec)
+	}
+	for _, name := range codecs {
+		t.Run(name, func(t *testing.T) {
+			codec, err := getCompressionCodec(name, "")
+			require.NoError(t, err)
+
+			var compressed bytes.Buffer
+			w, err := codec.NewCompressor(ctx, &compressed)
+			require.NoError(t, err)
+			_, err = w.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+			assert.Less(t, compressed.Len(), len(data)/10)
+
+			r, err := codec.NewDecompressor(ctx, &compressed)
+			require.NoError(t, err)
+			got, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			assert.Equal(t, data, got)
+		})
+	}
+
+	_, err := getCompressionCodec("brotli", "")
+	assert.EqualError(t, err, `unknown compression codec "brotli"`)
+
+	*externalDecompressor = ""
+	codec, err := getCompressionCodec(externalCompressionCodec, "")
+	require.NoError(t, err)
+	_, err = codec.NewDecompressor(ctx, &bytes.Buffer{})
+	assert.EqualError(t, err, "external_decompressor must be set to use the external compression codec")
+}
+
+func TestSetBackupManifestCompression(t *testing.T) {
+	oldCodec, oldDecompressor := *compressionCodec, *externalDecompressor
+	defer func() { *compressionCodec, *externalDecompressor = oldCodec, oldDecompressor }()
+
+	*compressionCodec = "zstd"
+	bm := &builtinBackupManifest{}
+	setBackupManifestCompression(bm)
+	assert.Equal(t, "zstd", bm.CompressionCodec)
+	assert.Empty(t, bm.ExternalDecompressor)
+
+	*compressionCodec = externalCompressionCodec
+	*externalDecompressor = "zstd -d -c"
+	bm = &builtinBackupManifest{}
+	setBackupManifestCompression(bm)
+	assert.Equal(t, externalCompressionCodec, bm.CompressionCodec)
+	assert.Equal(t, "zstd -d -c", bm.ExternalDecompressor)
+
+	// Only the flag is run, never the decompressor recorded in the MANIFEST.
+	*externalDecompressor = "unzstd -c"
+	codec, err := getCompressionCodec(bm.CompressionCodec, bm.ExternalDecompressor)
+	require.NoError(t, err)
+	assert.Equal(t, "unzstd -c", codec.(externalCodec).decompressor)
+	*externalDecompressor = ""
+	_, err = getCompressionCodec(bm.CompressionCodec, bm.ExternalDecompressor)
+	assert.EqualError(t, err, `backup was compressed with the external compression codec, set external_decompressor to restore it (the MANIFEST suggests "zstd -d -c")`)
+
+	bm = &builtinBackupManifest{SkipCompress: true}
+	setBackupManifestCompression(bm)
+	assert.Empty(t, bm.CompressionCodec)
+}
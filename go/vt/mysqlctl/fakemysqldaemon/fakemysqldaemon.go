@@ -158,6 +158,9 @@ type FakeMysqlDaemon struct {
 	// BinlogPlayerEnabled is used by {Enable,Disable}BinlogPlayer
 	BinlogPlayerEnabled sync2.AtomicBool
 
+	// AppliedBinlogFiles records the files passed to ApplyBinlogFiles.
+	AppliedBinlogFiles []string
+
 	// SemiSyncMasterEnabled represents the state of rpl_semi_sync_master_enabled.
 	SemiSyncMasterEnabled bool
 	// SemiSyncReplicaEnabled represents the state of rpl_semi_sync_slave_enabled.
@@ -458,6 +461,12 @@ func (fmd *FakeMysqlDaemon) FetchSuperQuery(ctx context.Context, query string) (
 	return qr, nil
 }
 
+// ApplyBinlogFiles is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) ApplyBinlogFiles(ctx context.Context, binlogFiles []string, stopPos mysql.Position, stopTime time.Time) error {
+	fmd.AppliedBinlogFiles = append(fmd.AppliedBinlogFiles, binlogFiles...)
+	return nil
+}
+
 // EnableBinlogPlayback is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) EnableBinlogPlayback() error {
 	fmd.BinlogPlayerEnabled.Set(true)
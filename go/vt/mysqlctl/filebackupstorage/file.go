@@ -103,7 +103,11 @@ func (fbh *FileBackupHandle) ReadFile(ctx context.Context, filename string) (io.
 		return nil, fmt.Errorf("ReadFile cannot be called on read-write backup")
 	}
 	p := path.Join(*FileBackupStorageRoot, fbh.dir, fbh.name, filename)
-	return os.Open(p)
+	f, err := os.Open(p)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w: %v", backupstorage.ErrFileNotFound, err)
+	}
+	return f, err
 }
 
 // FileBackupStorage implements BackupStorage for local file system.
@@ -161,6 +165,20 @@ func (fbs *FileBackupStorage) StartBackup(ctx context.Context, dir, name string)
 	}, nil
 }
 
+// ReopenBackup is part of the BackupStorage interface
+func (fbs *FileBackupStorage) ReopenBackup(ctx context.Context, dir, name string) (backupstorage.BackupHandle, error) {
+	p := path.Join(*FileBackupStorageRoot, dir, name)
+	if _, err := os.Stat(p); err != nil {
+		return nil, err
+	}
+	return &FileBackupHandle{
+		fbs:      fbs,
+		dir:      dir,
+		name:     name,
+		readOnly: false,
+	}, nil
+}
+
 // RemoveBackup is part of the BackupStorage interface
 func (fbs *FileBackupStorage) RemoveBackup(ctx context.Context, dir, name string) error {
 	p := path.Join(*FileBackupStorageRoot, dir, name)
@@ -112,7 +112,11 @@ func (bh *GCSBackupHandle) ReadFile(ctx context.Context, filename string) (io.Re
 		return nil, fmt.Errorf("ReadFile cannot be called on read-write backup")
 	}
 	object := objName(bh.dir, bh.name, filename)
-	return bh.client.Bucket(*bucket).Object(object).NewReader(ctx)
+	r, err := bh.client.Bucket(*bucket).Object(object).NewReader(ctx)
+	if err == storage.ErrObjectNotExist {
+		return nil, fmt.Errorf("%w: %v", backupstorage.ErrFileNotFound, err)
+	}
+	return r, err
 }
 
 // GCSBackupStorage implements BackupStorage for Google Cloud Storage.
@@ -196,6 +200,12 @@ func (bs *GCSBackupStorage) StartBackup(ctx context.Context, dir, name string) (
 	}, nil
 }
 
+// ReopenBackup implements BackupStorage. Objects are simply overwritten,
+// so this is the same as StartBackup.
+func (bs *GCSBackupStorage) ReopenBackup(ctx context.Context, dir, name string) (backupstorage.BackupHandle, error) {
+	return bs.StartBackup(ctx, dir, name)
+}
+
 // RemoveBackup implements BackupStorage.
 func (bs *GCSBackupStorage) RemoveBackup(ctx context.Context, dir, name string) error {
 	c, err := bs.client(ctx)
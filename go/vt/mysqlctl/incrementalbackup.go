@@ -0,0 +1,322 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file handles incremental backups, which archive the binary logs
+// written since a previous backup, and point-in-time restores, which replay
+// those binary logs on top of a full backup.
+
+const (
+	// backupBinlogDir is the base for binary log files in incremental backups.
+	backupBinlogDir = "BinLog"
+)
+
+// findLatestBackupPosition returns the position of the most recent backup,
+// full or incremental, that has a valid MANIFEST. An incremental backup
+// continues from there.
+func findLatestBackupPosition(ctx context.Context, params BackupParams, bhs []backupstorage.BackupHandle) (mysql.Position, error) {
+	for i := len(bhs) - 1; i >= 0; i-- {
+		bm, err := GetBackupManifest(ctx, bhs[i])
+		if err != nil {
+			params.Logger.Warningf("Possibly incomplete backup %v on BackupStorage: can't read MANIFEST: %v", bhs[i].Name(), err)
+			continue
+		}
+		if bm.Position.IsZero() {
+			continue
+		}
+		return bm.Position, nil
+	}
+	return mysql.Position{}, ErrNoCompleteBackup
+}
+
+// executeIncrementalBackup flushes the binary logs, and archives the ones that
+// contain the transactions executed after fromPos. It returns a boolean that
+// indicates if the backup is usable, and an overall error.
+func executeIncrementalBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fromPos mysql.Position) (bool, error) {
+	if _, ok := fromPos.GTIDSet.(mysql.Mysql56GTIDSet); !ok {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "incremental backups require a MySQL 5.6+ GTID position, got %v", fromPos)
+	}
+
+	// Rotate the binary logs, so every transaction executed so far is in a
+	// file mysqld no longer writes to.
+	params.Logger.Infof("flushing binary logs")
+	if err := params.Mysqld.ExecuteSuperQueryList(ctx, []string{"FLUSH BINARY LOGS"}); err != nil {
+		return false, vterrors.Wrap(err, "can't flush binary logs")
+	}
+	binlogs, err := listBinaryLogs(ctx, params.Mysqld)
+	if err != nil {
+		return false, err
+	}
+	if len(binlogs) < 2 {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "expected at least two binary logs after flushing them, got %v", binlogs)
+	}
+
+	// The binary log mysqld just opened starts with everything we archive.
+	toPos, err := binlogPreviousGTIDs(ctx, params.Mysqld, binlogs[len(binlogs)-1])
+	if err != nil {
+		return false, err
+	}
+	if !toPos.AtLeast(fromPos) {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binary logs at %v do not contain the position of the last backup %v", toPos, fromPos)
+	}
+	if fromPos.AtLeast(toPos) {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no transactions were executed since the last backup at %v", fromPos)
+	}
+
+	// Walk back to the most recent binary log that starts at or before fromPos.
+	first := -1
+	for i := len(binlogs) - 2; i >= 0; i-- {
+		pos, err := binlogPreviousGTIDs(ctx, params.Mysqld, binlogs[i])
+		if err != nil {
+			return false, err
+		}
+		if fromPos.AtLeast(pos) {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binary logs needed to go back to %v have been purged", fromPos)
+	}
+
+	fes := make([]FileEntry, 0, len(binlogs)-1-first)
+	for _, binlog := range binlogs[first : len(binlogs)-1] {
+		fes = append(fes, FileEntry{
+			Base: backupBinlogDir,
+			Name: binlog,
+		})
+	}
+	params.Logger.Infof("backing up %v binary logs from %v to %v", len(fes), fromPos, toPos)
+
+	be := &BuiltinBackupEngine{}
+	for i := range fes {
+		name := fmt.Sprintf("%v", i)
+		if err := be.backupFile(ctx, params, bh, &fes[i], name); err != nil {
+			return false, err
+		}
+	}
+
+	bm := &builtinBackupManifest{
+		BackupManifest: BackupManifest{
+			BackupMethod:    builtinBackupEngineName,
+			Position:        toPos,
+			BackupTime:      params.BackupTime.UTC().Format(time.RFC3339),
+			FinishedTime:    time.Now().UTC().Format(time.RFC3339),
+			Incremental:     true,
+			FromPosition:    fromPos,
+			EncryptionKeyID: backupEncryptionKeyID(bh),
+		},
+		FileEntries:   fes,
+		TransformHook: *backupStorageHook,
+		SkipCompress:  !*backupStorageCompress,
+	}
+	setBackupManifestCompression(bm)
+	if err := writeBackupManifest(ctx, bh, bm); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// listBinaryLogs returns the names of the binary logs mysqld knows about,
+// oldest first.
+func listBinaryLogs(ctx context.Context, mysqld MysqlDaemon) ([]string, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't list binary logs")
+	}
+	binlogs := make([]string, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		binlogs = append(binlogs, row[0].ToString())
+	}
+	return binlogs, nil
+}
+
+// binlogPreviousGTIDs returns the GTID set that was executed before the given
+// binary log was opened, as recorded in its Previous_gtids event.
+func binlogPreviousGTIDs(ctx context.Context, mysqld MysqlDaemon, binlog string) (mysql.Position, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, fmt.Sprintf("SHOW BINLOG EVENTS IN '%s' LIMIT 2", binlog))
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrapf(err, "can't read events of binary log %v", binlog)
+	}
+	if len(qr.Fields) < 6 {
+		return mysql.Position{}, vterrors.Errorf(vtrpc.Code_INTERNAL, "unexpected result for events of binary log %v: %v", binlog, qr.Fields)
+	}
+	for _, row := range qr.Rows {
+		// Columns are Log_name, Pos, Event_type, Server_id, End_log_pos, Info.
+		if row[2].ToString() != "Previous_gtids" {
+			continue
+		}
+		return mysql.ParsePosition(mysql.Mysql56FlavorID, strings.Replace(row[5].ToString(), "\n", "", -1))
+	}
+	return mysql.Position{}, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binary log %v has no Previous_gtids event", binlog)
+}
+
+// findPointInTimeRestorePath returns the full backup, followed by the
+// incremental backups, that need to be restored to reach the point in time
+// requested in params.
+func findPointInTimeRestorePath(ctx context.Context, params RestoreParams, bhs []backupstorage.BackupHandle) (backupstorage.BackupHandle, []backupstorage.BackupHandle, error) {
+	manifests := make([]*BackupManifest, len(bhs))
+	for i, bh := range bhs {
+		bm, err := GetBackupManifest(ctx, bh)
+		if err != nil {
+			params.Logger.Warningf("Possibly incomplete backup %v on BackupStorage: can't read MANIFEST: %v)", bh.Name(), err)
+			continue
+		}
+		manifests[i] = bm
+	}
+
+	// Start from the most recent full backup at or before the restore point.
+	full := -1
+	for i := len(bhs) - 1; i >= 0; i-- {
+		bm := manifests[i]
+		if bm == nil || bm.Incremental {
+			continue
+		}
+		if !params.RestoreToPos.IsZero() {
+			if params.RestoreToPos.AtLeast(bm.Position) {
+				full = i
+				break
+			}
+			continue
+		}
+		backupTime, err := time.Parse(time.RFC3339, bm.BackupTime)
+		if err != nil {
+			params.Logger.Warningf("Restore: skipping backup %v with invalid time %v: %v", bhs[i].Name(), bm.BackupTime, err)
+			continue
+		}
+		if !backupTime.After(params.RestoreToTimestamp) {
+			full = i
+			break
+		}
+	}
+	if full < 0 {
+		return nil, nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no full backup found before the restore point %v", restorePointString(params))
+	}
+	params.Logger.Infof("Restore: found full backup %v at %v", bhs[full].Name(), manifests[full].Position)
+
+	// Then chain the incremental backups that move the position forward,
+	// until one of them covers the restore point.
+	pos := manifests[full].Position
+	reached := restorePointReached(params, manifests[full])
+	var incrementals []backupstorage.BackupHandle
+	for i := full + 1; i < len(bhs) && !reached; i++ {
+		bm := manifests[i]
+		if bm == nil || !bm.Incremental {
+			continue
+		}
+		if pos.AtLeast(bm.Position) || !pos.AtLeast(bm.FromPosition) {
+			// Either nothing new, or there is a gap between the two.
+			continue
+		}
+		params.Logger.Infof("Restore: found incremental backup %v from %v to %v", bhs[i].Name(), bm.FromPosition, bm.Position)
+		incrementals = append(incrementals, bhs[i])
+		pos = bm.Position
+		reached = restorePointReached(params, bm)
+	}
+	if !reached {
+		return nil, nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backups do not reach the restore point %v: the latest position they cover is %v", restorePointString(params), pos)
+	}
+	return bhs[full], incrementals, nil
+}
+
+// restorePointReached returns true if restoring the given backup brings the
+// database up to, or past, the restore point requested in params.
+func restorePointReached(params RestoreParams, bm *BackupManifest) bool {
+	if !params.RestoreToPos.IsZero() {
+		return bm.Position.AtLeast(params.RestoreToPos)
+	}
+	backupTime, err := time.Parse(time.RFC3339, bm.BackupTime)
+	if err != nil {
+		return false
+	}
+	return !backupTime.Before(params.RestoreToTimestamp)
+}
+
+func restorePointString(params RestoreParams) string {
+	if !params.RestoreToPos.IsZero() {
+		return mysql.EncodePosition(params.RestoreToPos)
+	}
+	return params.RestoreToTimestamp.UTC().Format(time.RFC3339)
+}
+
+// applyIncrementalBackups replays the binary logs of the given incremental
+// backups, in order, on top of a restored backup at pos. mysqld must be
+// running. It returns the position mysqld ends up at.
+func applyIncrementalBackups(ctx context.Context, params RestoreParams, pos mysql.Position, bhs []backupstorage.BackupHandle) (mysql.Position, error) {
+	// Let mysqld know what the restored backup contains, so it skips the
+	// transactions at the start of the binary logs that it already has.
+	params.Logger.Infof("Restore: setting position to %v before replaying binary logs", pos)
+	if err := params.Mysqld.SetReplicationPosition(ctx, pos); err != nil {
+		return mysql.Position{}, vterrors.Wrap(err, "can't set replication position")
+	}
+
+	tmpDir, err := ioutil.TempDir("", "restore_binlogs")
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrap(err, "can't create temporary directory for binary logs")
+	}
+	defer os.RemoveAll(tmpDir)
+
+	be := &BuiltinBackupEngine{}
+	for _, bh := range bhs {
+		var bm builtinBackupManifest
+		if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+			return mysql.Position{}, err
+		}
+
+		// Restore the binary logs into a scratch directory rather than next
+		// to the ones mysqld is writing.
+		cnf := *params.Cnf
+		cnf.BinLogPath = path.Join(tmpDir, bh.Name(), "binlog")
+		binlogParams := params
+		binlogParams.Cnf = &cnf
+		params.Logger.Infof("Restore: copying %v binary logs from %v", len(bm.FileEntries), bh.Name())
+		if err := be.restoreFiles(ctx, binlogParams, bh, bm); err != nil {
+			return mysql.Position{}, vterrors.Wrapf(err, "failed to restore binary logs from %v", bh.Name())
+		}
+
+		binlogFiles := make([]string, 0, len(bm.FileEntries))
+		for _, fe := range bm.FileEntries {
+			binlogFiles = append(binlogFiles, path.Join(path.Dir(cnf.BinLogPath), fe.Name))
+		}
+		params.Logger.Infof("Restore: replaying binary logs %v", binlogFiles)
+		if err := params.Mysqld.ApplyBinlogFiles(ctx, binlogFiles, params.RestoreToPos, params.RestoreToTimestamp); err != nil {
+			return mysql.Position{}, vterrors.Wrapf(err, "failed to replay binary logs from %v", bh.Name())
+		}
+	}
+
+	pos, err = params.Mysqld.MasterPosition()
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrap(err, "can't get position after replaying binary logs")
+	}
+	params.Logger.Infof("Restore: replayed binary logs up to %v", pos)
+	return pos, nil
+}
@@ -0,0 +1,315 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+const testSID = "00000000-0000-0000-0000-000000000001"
+
+func testPos(t *testing.T, gtids string) mysql.Position {
+	t.Helper()
+	pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, gtids)
+	require.NoError(t, err)
+	return pos
+}
+
+// binlogDaemon fakes the MysqlDaemon methods used by incremental backups
+// and point-in-time restores.
+type binlogDaemon struct {
+	MysqlDaemon
+
+	t              *testing.T
+	queries        map[string]*sqltypes.Result
+	executed       []string
+	replicationPos mysql.Position
+	restoredPos    mysql.Position
+	applied        map[string]string
+}
+
+func (d *binlogDaemon) ExecuteSuperQueryList(ctx context.Context, queryList []string) error {
+	d.executed = append(d.executed, queryList...)
+	return nil
+}
+
+func (d *binlogDaemon) FetchSuperQuery(ctx context.Context, query string) (*sqltypes.Result, error) {
+	qr, ok := d.queries[query]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query: %v", query)
+	}
+	return qr, nil
+}
+
+func (d *binlogDaemon) SetReplicationPosition(ctx context.Context, pos mysql.Position) error {
+	d.restoredPos = pos
+	d.replicationPos = pos
+	return nil
+}
+
+func (d *binlogDaemon) ApplyBinlogFiles(ctx context.Context, binlogFiles []string, stopPos mysql.Position, stopTime time.Time) error {
+	for _, file := range binlogFiles {
+		data, err := ioutil.ReadFile(file)
+		require.NoError(d.t, err)
+		d.applied[path.Base(file)] = string(data)
+	}
+	d.replicationPos = stopPos
+	return nil
+}
+
+func (d *binlogDaemon) MasterPosition() (mysql.Position, error) {
+	return d.replicationPos, nil
+}
+
+func binlogEventsResult(binlog, previousGTIDs string) *sqltypes.Result {
+	return sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("Log_name|Pos|Event_type|Server_id|End_log_pos|Info", "varchar|int64|varchar|int64|int64|varchar"),
+		binlog+"|4|Format_desc|1|125|Server ver: 5.7.30-log, Binlog ver: 4",
+		binlog+"|125|Previous_gtids|1|196|"+previousGTIDs,
+	)
+}
+
+// addTestBackup writes a backup with the given MANIFEST to the file backup storage.
+func addTestBackup(t *testing.T, dir, name string, bm BackupManifest) {
+	fbs := &filebackupstorage.FileBackupStorage{}
+	bh, err := fbs.StartBackup(context.Background(), dir, name)
+	require.NoError(t, err)
+	require.NoError(t, writeBackupManifest(context.Background(), bh, &builtinBackupManifest{BackupManifest: bm}))
+	require.NoError(t, bh.EndBackup(context.Background()))
+}
+
+func TestFindPointInTimeRestorePath(t *testing.T) {
+	root, err := ioutil.TempDir("", "pitrtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+
+	addTestBackup(t, "ks/0", "2021-01-01.100000.zone1-100", BackupManifest{
+		BackupMethod: builtinBackupEngineName,
+		Position:     testPos(t, testSID+":1-10"),
+		BackupTime:   "2021-01-01T10:00:00Z",
+	})
+	addTestBackup(t, "ks/0", "2021-01-01.110000.zone1-100", BackupManifest{
+		BackupMethod: builtinBackupEngineName,
+		Position:     testPos(t, testSID+":1-20"),
+		BackupTime:   "2021-01-01T11:00:00Z",
+		Incremental:  true,
+		FromPosition: testPos(t, testSID+":1-10"),
+	})
+	addTestBackup(t, "ks/0", "2021-01-01.120000.zone1-100", BackupManifest{
+		BackupMethod: builtinBackupEngineName,
+		Position:     testPos(t, testSID+":1-30"),
+		BackupTime:   "2021-01-01T12:00:00Z",
+		Incremental:  true,
+		FromPosition: testPos(t, testSID+":1-20"),
+	})
+	addTestBackup(t, "ks/0", "2021-01-01.130000.zone1-100", BackupManifest{
+		BackupMethod: builtinBackupEngineName,
+		Position:     testPos(t, testSID+":1-35"),
+		BackupTime:   "2021-01-01T13:00:00Z",
+	})
+
+	fbs := &filebackupstorage.FileBackupStorage{}
+	bhs, err := fbs.ListBackups(context.Background(), "ks/0")
+	require.NoError(t, err)
+	require.Len(t, bhs, 4)
+
+	names := func(bhs []backupstorage.BackupHandle) []string {
+		var result []string
+		for _, bh := range bhs {
+			result = append(result, bh.Name())
+		}
+		return result
+	}
+
+	testcases := []struct {
+		name         string
+		pos          string
+		timestamp    string
+		full         string
+		incrementals []string
+		err          string
+	}{{
+		name: "position of a full backup",
+		pos:  testSID + ":1-10",
+		full: "2021-01-01.100000.zone1-100",
+	}, {
+		name:         "position within incremental backups",
+		pos:          testSID + ":1-25",
+		full:         "2021-01-01.100000.zone1-100",
+		incrementals: []string{"2021-01-01.110000.zone1-100", "2021-01-01.120000.zone1-100"},
+	}, {
+		name:         "position of an incremental backup",
+		pos:          testSID + ":1-20",
+		full:         "2021-01-01.100000.zone1-100",
+		incrementals: []string{"2021-01-01.110000.zone1-100"},
+	}, {
+		name: "position before all backups",
+		pos:  testSID + ":1-5",
+		err:  "no full backup found before the restore point",
+	}, {
+		name: "position after all backups",
+		pos:  testSID + ":1-40",
+		err:  "backups do not reach the restore point",
+	}, {
+		name:         "timestamp within incremental backups",
+		timestamp:    "2021-01-01T10:30:00Z",
+		full:         "2021-01-01.100000.zone1-100",
+		incrementals: []string{"2021-01-01.110000.zone1-100"},
+	}, {
+		name:      "timestamp of a full backup",
+		timestamp: "2021-01-01T13:00:00Z",
+		full:      "2021-01-01.130000.zone1-100",
+	}, {
+		name:      "timestamp after all backups",
+		timestamp: "2021-01-01T14:00:00Z",
+		err:       "backups do not reach the restore point",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := RestoreParams{Logger: logutil.NewMemoryLogger()}
+			if tc.pos != "" {
+				params.RestoreToPos = testPos(t, tc.pos)
+			}
+			if tc.timestamp != "" {
+				params.RestoreToTimestamp, err = time.Parse(time.RFC3339, tc.timestamp)
+				require.NoError(t, err)
+			}
+			full, incrementals, err := findPointInTimeRestorePath(context.Background(), params, bhs)
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.full, full.Name())
+			assert.Equal(t, tc.incrementals, names(incrementals))
+		})
+	}
+
+	// Regular restores never pick an incremental backup.
+	bhs, err = fbs.ListBackups(context.Background(), "ks/0")
+	require.NoError(t, err)
+	bh, err := FindBackupToRestore(context.Background(), RestoreParams{Logger: logutil.NewMemoryLogger()}, bhs[:3])
+	require.NoError(t, err)
+	assert.Equal(t, "2021-01-01.100000.zone1-100", bh.Name())
+}
+
+func TestIncrementalBackupAndRestore(t *testing.T) {
+	root, err := ioutil.TempDir("", "incrementalbackuptest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+
+	binlogDir := path.Join(root, "binlogs")
+	require.NoError(t, os.MkdirAll(binlogDir, os.ModePerm))
+	for _, binlog := range []string{"bin.000001", "bin.000002", "bin.000003"} {
+		require.NoError(t, ioutil.WriteFile(path.Join(binlogDir, binlog), []byte("contents of "+binlog), os.ModePerm))
+	}
+	cnf := &Mycnf{BinLogPath: path.Join(binlogDir, "bin")}
+
+	mysqld := &binlogDaemon{
+		t: t,
+		queries: map[string]*sqltypes.Result{
+			"SHOW BINARY LOGS": sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields("Log_name|File_size", "varchar|int64"),
+				"bin.000001|100",
+				"bin.000002|100",
+				"bin.000003|100",
+			),
+			"SHOW BINLOG EVENTS IN 'bin.000001' LIMIT 2": binlogEventsResult("bin.000001", ""),
+			"SHOW BINLOG EVENTS IN 'bin.000002' LIMIT 2": binlogEventsResult("bin.000002", testSID+":1-10"),
+			"SHOW BINLOG EVENTS IN 'bin.000003' LIMIT 2": binlogEventsResult("bin.000003", testSID+":1-20"),
+		},
+		applied: make(map[string]string),
+	}
+
+	ctx := context.Background()
+	backupParams := BackupParams{
+		Cnf:         cnf,
+		Mysqld:      mysqld,
+		Logger:      logutil.NewMemoryLogger(),
+		Concurrency: 1,
+		BackupTime:  time.Date(2021, 1, 1, 11, 0, 0, 0, time.UTC),
+		Incremental: true,
+	}
+
+	// Only the binary log with transactions after the last backup is archived.
+	fbs := &filebackupstorage.FileBackupStorage{}
+	bh, err := fbs.StartBackup(ctx, "ks/0", "incremental")
+	require.NoError(t, err)
+	usable, err := executeIncrementalBackup(ctx, backupParams, bh, testPos(t, testSID+":1-12"))
+	require.NoError(t, err)
+	assert.True(t, usable)
+	assert.Equal(t, []string{"FLUSH BINARY LOGS"}, mysqld.executed)
+
+	bhs, err := fbs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+	require.Len(t, bhs, 1)
+	var bm builtinBackupManifest
+	require.NoError(t, getBackupManifestInto(ctx, bhs[0], &bm))
+	assert.True(t, bm.Incremental)
+	assert.Equal(t, testPos(t, testSID+":1-12"), bm.FromPosition)
+	assert.Equal(t, testPos(t, testSID+":1-20"), bm.Position)
+	require.Len(t, bm.FileEntries, 1)
+	assert.Equal(t, FileEntry{Base: backupBinlogDir, Name: "bin.000002", Hash: bm.FileEntries[0].Hash}, bm.FileEntries[0])
+
+	// Nothing to back up if no transactions were executed since then.
+	bh, err = fbs.StartBackup(ctx, "ks/0", "empty")
+	require.NoError(t, err)
+	usable, err = executeIncrementalBackup(ctx, backupParams, bh, testPos(t, testSID+":1-20"))
+	assert.False(t, usable)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no transactions were executed since the last backup")
+
+	// Binary logs that were purged can't be backed up.
+	mysqld.queries["SHOW BINLOG EVENTS IN 'bin.000001' LIMIT 2"] = binlogEventsResult("bin.000001", testSID+":1-5")
+	bh, err = fbs.StartBackup(ctx, "ks/0", "purged")
+	require.NoError(t, err)
+	_, err = executeIncrementalBackup(ctx, backupParams, bh, testPos(t, testSID+":1-3"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "have been purged")
+
+	// The archived binary log is replayed on top of the full backup position.
+	restoreParams := RestoreParams{
+		Cnf:          cnf,
+		Mysqld:       mysqld,
+		Logger:       logutil.NewMemoryLogger(),
+		Concurrency:  1,
+		RestoreToPos: testPos(t, testSID+":1-15"),
+	}
+	pos, err := applyIncrementalBackups(ctx, restoreParams, testPos(t, testSID+":1-12"), bhs)
+	require.NoError(t, err)
+	assert.Equal(t, testPos(t, testSID+":1-12"), mysqld.restoredPos)
+	assert.Equal(t, map[string]string{"bin.000002": "contents of bin.000002"}, mysqld.applied)
+	assert.Equal(t, testPos(t, testSID+":1-15"), pos)
+}
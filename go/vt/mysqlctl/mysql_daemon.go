@@ -18,6 +18,7 @@ package mysqlctl
 
 import (
 	"context"
+	"time"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
@@ -88,6 +89,9 @@ type MysqlDaemon interface {
 	// FetchSuperQuery executes one query, returns the result
 	FetchSuperQuery(ctx context.Context, query string) (*sqltypes.Result, error)
 
+	// ApplyBinlogFiles replays binary logs, up to stopPos or stopTime if set
+	ApplyBinlogFiles(ctx context.Context, binlogFiles []string, stopPos mysql.Position, stopTime time.Time) error
+
 	// EnableBinlogPlayback enables playback of binlog events
 	EnableBinlogPlayback() error
 
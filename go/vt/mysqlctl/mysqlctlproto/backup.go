@@ -17,6 +17,7 @@ limitations under the License.
 package mysqlctlproto
 
 import (
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 
 	mysqlctlpb "vitess.io/vitess/go/vt/proto/mysqlctl"
@@ -29,3 +30,16 @@ func BackupHandleToProto(bh backupstorage.BackupHandle) *mysqlctlpb.BackupInfo {
 		Directory: bh.Directory(),
 	}
 }
+
+// BackupVerificationToProto returns a BackupVerification proto from the
+// result of verifying a backup. The table checksums are left out.
+func BackupVerificationToProto(v *mysqlctl.BackupVerification) *mysqlctlpb.BackupVerification {
+	if v == nil {
+		return nil
+	}
+	return &mysqlctlpb.BackupVerification{
+		VerifiedTime: v.VerifiedTime,
+		Passed:       v.Passed,
+		Errors:       v.Errors,
+	}
+}
@@ -1047,6 +1047,63 @@ func (mysqld *Mysqld) executeMysqlScript(connParams *mysql.ConnParams, sql io.Re
 	return nil
 }
 
+// ApplyBinlogFiles replays the given binary logs with the mysqlbinlog and mysql
+// command line tools, using the dba user. If stopPos is not zero, only the
+// transactions it contains are replayed. If stopTime is not zero, replaying
+// stops at the first event at or after it.
+func (mysqld *Mysqld) ApplyBinlogFiles(ctx context.Context, binlogFiles []string, stopPos mysql.Position, stopTime time.Time) error {
+	dir, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return err
+	}
+	name, err := binaryPath(dir, "mysqlbinlog")
+	if err != nil {
+		return err
+	}
+	var args []string
+	if !stopPos.IsZero() {
+		args = append(args, "--include-gtids="+stopPos.GTIDSet.String())
+	}
+	if !stopTime.IsZero() {
+		// mysqlbinlog reads the datetime in its local time zone.
+		args = append(args, "--stop-datetime="+stopTime.Local().Format("2006-01-02 15:04:05"))
+	}
+	args = append(args, binlogFiles...)
+	env, err := buildLdPaths()
+	if err != nil {
+		return err
+	}
+
+	log.Infof("ApplyBinlogFiles: %v %v", name, args)
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = env
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	events, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	params, err := mysqld.dbcfgs.DbaConnector().MysqlParams()
+	if err == nil {
+		err = mysqld.executeMysqlScript(params, events)
+	}
+	if err != nil {
+		// Don't leave mysqlbinlog blocked on a pipe nobody reads anymore.
+		cmd.Process.Kill()
+		cmd.Wait()
+		return err
+	}
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("mysqlbinlog: %v, stderr: %v", err, stderr.String())
+	}
+	return nil
+}
+
 // defaultsExtraFile returns the filename for a temporary config file
 // that contains the user, password and socket file to connect to
 // mysqld.  We write a temporary config file so the password is never
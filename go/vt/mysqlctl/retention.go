@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// FindBackupsToPrune returns the backups that the retention policy doesn't
+// keep, oldest first. bhs must be sorted by name, as returned by
+// BackupStorage.ListBackups.
+//
+// Only complete full backups, i.e. the ones with a valid MANIFEST, count
+// towards the policy. They are pruned when they are beyond the policy's
+// keep_count or older than its max_age, except that the min_valid_count most
+// recent ones (and never less than one) are always kept. Incremental and
+// incomplete backups, i.e. the ones without a MANIFEST, are pruned once they
+// are older than the oldest full backup that is kept, since nothing can be
+// restored from them anymore. If any other MANIFEST can't be read, nothing is
+// pruned.
+func FindBackupsToPrune(ctx context.Context, logger logutil.Logger, bhs []backupstorage.BackupHandle, policy *topodatapb.BackupRetentionPolicy, now time.Time) ([]backupstorage.BackupHandle, error) {
+	maxAge, _, err := protoutil.DurationFromProto(policy.MaxAge)
+	if err != nil {
+		return nil, err
+	}
+	minValidCount := int(policy.MinValidCount)
+	if minValidCount < 1 {
+		minValidCount = 1
+	}
+
+	manifests := make([]*BackupManifest, len(bhs))
+	for i, bh := range bhs {
+		bm, err := GetBackupManifest(ctx, bh)
+		switch {
+		case backupstorage.IsFileNotFound(err):
+			logger.Warningf("Backup %v is incomplete: %v", bh.Name(), err)
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("can't check backup %v: %v", bh.Name(), err)
+		}
+		manifests[i] = bm
+	}
+
+	// Walk the full backups from the most recent one.
+	prune := make([]bool, len(bhs))
+	kept := 0
+	oldestKept := len(bhs)
+	for i := len(bhs) - 1; i >= 0; i-- {
+		bm := manifests[i]
+		if bm == nil || bm.Incremental {
+			continue
+		}
+		expired := policy.KeepCount > 0 && kept >= int(policy.KeepCount)
+		if maxAge > 0 && !expired {
+			backupTime, err := backupTime(bhs[i], bm)
+			if err != nil {
+				logger.Warningf("Keeping backup %v: %v", bhs[i].Name(), err)
+			} else {
+				expired = now.Sub(backupTime) > maxAge
+			}
+		}
+		if expired && kept >= minValidCount {
+			prune[i] = true
+			continue
+		}
+		kept++
+		oldestKept = i
+	}
+
+	var result []backupstorage.BackupHandle
+	for i, bh := range bhs {
+		if prune[i] || (i < oldestKept && oldestKept < len(bhs)) {
+			result = append(result, bh)
+		}
+	}
+	return result, nil
+}
+
+// PruneBackups removes the backups in dir that the retention policy doesn't
+// keep, and returns their names. With dryRun, it only returns the names.
+func PruneBackups(ctx context.Context, logger logutil.Logger, bs backupstorage.BackupStorage, dir string, policy *topodatapb.BackupRetentionPolicy, dryRun bool) ([]string, error) {
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, fmt.Errorf("can't list backups: %v", err)
+	}
+	toPrune, err := FindBackupsToPrune(ctx, logger, bhs, policy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, bh := range toPrune {
+		if dryRun {
+			logger.Infof("Would remove backup %v from %v", bh.Name(), dir)
+		} else {
+			logger.Infof("Removing backup %v from %v", bh.Name(), dir)
+			if err := bs.RemoveBackup(ctx, dir, bh.Name()); err != nil {
+				return names, fmt.Errorf("couldn't remove backup %v from %v: %v", bh.Name(), dir, err)
+			}
+		}
+		names = append(names, bh.Name())
+	}
+	return names, nil
+}
+
+// backupTime returns when a backup was taken, from its MANIFEST or else from
+// its name, which is formatted as "date.time.tablet-alias".
+func backupTime(bh backupstorage.BackupHandle, bm *BackupManifest) (time.Time, error) {
+	if bm.BackupTime != "" {
+		return time.Parse(time.RFC3339, bm.BackupTime)
+	}
+	parts := strings.Split(bh.Name(), ".")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("backup name not in expected format (date.time.tablet-alias): %v", bh.Name())
+	}
+	return time.Parse(BackupTimestampFormat, parts[0]+"."+parts[1])
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestFindBackupsToPrune(t *testing.T) {
+	ctx := context.Background()
+	root, err := ioutil.TempDir("", "retentiontest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+	fbs := &filebackupstorage.FileBackupStorage{}
+
+	full := func(name, backupTime string) {
+		addTestBackup(t, "ks/0", name, BackupManifest{BackupMethod: builtinBackupEngineName, BackupTime: backupTime})
+	}
+	full("2021-01-01.000000.zone1-100", "2021-01-01T00:00:00Z")
+	addTestBackup(t, "ks/0", "2021-01-01.060000.zone1-100", BackupManifest{
+		BackupMethod: builtinBackupEngineName,
+		BackupTime:   "2021-01-01T06:00:00Z",
+		Incremental:  true,
+	})
+	// A backup that never finished has no MANIFEST.
+	bh, err := fbs.StartBackup(ctx, "ks/0", "2021-01-02.000000.zone1-101")
+	require.NoError(t, err)
+	require.NoError(t, bh.EndBackup(ctx))
+	full("2021-01-03.000000.zone1-100", "2021-01-03T00:00:00Z")
+	addTestBackup(t, "ks/0", "2021-01-03.060000.zone1-100", BackupManifest{
+		BackupMethod: builtinBackupEngineName,
+		BackupTime:   "2021-01-03T06:00:00Z",
+		Incremental:  true,
+	})
+	full("2021-01-04.000000.zone1-100", "2021-01-04T00:00:00Z")
+	// Unfinished backups more recent than the oldest kept one may be in progress.
+	bh, err = fbs.StartBackup(ctx, "ks/0", "2021-01-05.000000.zone1-101")
+	require.NoError(t, err)
+	require.NoError(t, bh.EndBackup(ctx))
+
+	bhs, err := fbs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+	now := time.Date(2021, 1, 5, 12, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name   string
+		policy *topodatapb.BackupRetentionPolicy
+		want   []string
+	}{{
+		name:   "no limits",
+		policy: &topodatapb.BackupRetentionPolicy{},
+		want:   nil,
+	}, {
+		name:   "keep count",
+		policy: &topodatapb.BackupRetentionPolicy{KeepCount: 2},
+		want: []string{
+			"2021-01-01.000000.zone1-100",
+			"2021-01-01.060000.zone1-100",
+			"2021-01-02.000000.zone1-101",
+		},
+	}, {
+		name:   "keep one",
+		policy: &topodatapb.BackupRetentionPolicy{KeepCount: 1},
+		want: []string{
+			"2021-01-01.000000.zone1-100",
+			"2021-01-01.060000.zone1-100",
+			"2021-01-02.000000.zone1-101",
+			"2021-01-03.000000.zone1-100",
+			"2021-01-03.060000.zone1-100",
+		},
+	}, {
+		name:   "max age",
+		policy: &topodatapb.BackupRetentionPolicy{MaxAge: protoutil.DurationToProto(72 * time.Hour)},
+		want: []string{
+			"2021-01-01.000000.zone1-100",
+			"2021-01-01.060000.zone1-100",
+			"2021-01-02.000000.zone1-101",
+		},
+	}, {
+		name: "min valid count wins over max age",
+		policy: &topodatapb.BackupRetentionPolicy{
+			MaxAge:        protoutil.DurationToProto(time.Hour),
+			MinValidCount: 2,
+		},
+		want: []string{
+			"2021-01-01.000000.zone1-100",
+			"2021-01-01.060000.zone1-100",
+			"2021-01-02.000000.zone1-101",
+		},
+	}, {
+		name: "the last valid backup is always kept",
+		policy: &topodatapb.BackupRetentionPolicy{
+			MaxAge: protoutil.DurationToProto(time.Hour),
+		},
+		want: []string{
+			"2021-01-01.000000.zone1-100",
+			"2021-01-01.060000.zone1-100",
+			"2021-01-02.000000.zone1-101",
+			"2021-01-03.000000.zone1-100",
+			"2021-01-03.060000.zone1-100",
+		},
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			toPrune, err := FindBackupsToPrune(ctx, logutil.NewMemoryLogger(), bhs, tc.policy, now)
+			require.NoError(t, err)
+			var got []string
+			for _, bh := range toPrune {
+				got = append(got, bh.Name())
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	// A dry run doesn't remove anything.
+	policy := &topodatapb.BackupRetentionPolicy{KeepCount: 2}
+	names, err := PruneBackups(ctx, logutil.NewMemoryLogger(), fbs, "ks/0", policy, true)
+	require.NoError(t, err)
+	assert.Len(t, names, 3)
+	bhs, err = fbs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+	assert.Len(t, bhs, 7)
+
+	names, err = PruneBackups(ctx, logutil.NewMemoryLogger(), fbs, "ks/0", policy, false)
+	require.NoError(t, err)
+	assert.Len(t, names, 3)
+	bhs, err = fbs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+	assert.Len(t, bhs, 4)
+	assert.Equal(t, "2021-01-03.000000.zone1-100", bhs[0].Name())
+}
+
+func TestFindBackupsToPruneUnreadableManifest(t *testing.T) {
+	ctx := context.Background()
+	root, err := ioutil.TempDir("", "retentiontest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+	fbs := &filebackupstorage.FileBackupStorage{}
+
+	// A MANIFEST that can't be read doesn't make a backup incomplete.
+	bh, err := fbs.StartBackup(ctx, "ks/0", "2021-01-01.000000.zone1-100")
+	require.NoError(t, err)
+	wc, err := bh.AddFile(ctx, backupManifestFileName, 0)
+	require.NoError(t, err)
+	_, err = wc.Write([]byte("not a manifest"))
+	require.NoError(t, err)
+	require.NoError(t, wc.Close())
+	require.NoError(t, bh.EndBackup(ctx))
+	addTestBackup(t, "ks/0", "2021-01-02.000000.zone1-100", BackupManifest{BackupMethod: builtinBackupEngineName, BackupTime: "2021-01-02T00:00:00Z"})
+
+	bhs, err := fbs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+	_, err = FindBackupsToPrune(ctx, logutil.NewMemoryLogger(), bhs, &topodatapb.BackupRetentionPolicy{KeepCount: 1}, time.Now())
+	assert.Error(t, err)
+
+	names, err := PruneBackups(ctx, logutil.NewMemoryLogger(), fbs, "ks/0", &topodatapb.BackupRetentionPolicy{KeepCount: 1}, false)
+	assert.Error(t, err)
+	assert.Empty(t, names)
+	bhs, err = fbs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+	assert.Len(t, bhs, 2)
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// backupVerificationFileName is the file of a backup the result of its
+	// last verification is stored in. It is kept apart from the MANIFEST so
+	// recording a verification can never damage the backup.
+	backupVerificationFileName = "VERIFICATION"
+
+	// verifyTablesQuery lists the tables CHECK TABLE and CHECKSUM TABLE are run on.
+	verifyTablesQuery = "SELECT table_schema, table_name FROM information_schema.tables WHERE table_type = 'BASE TABLE' AND table_schema NOT IN ('information_schema', 'mysql', 'performance_schema', 'sys')"
+)
+
+// BackupVerification is the result of restoring a backup and checking its
+// tables, as recorded in the backup's VERIFICATION file.
+type BackupVerification struct {
+	// VerifiedTime is when the verification finished (RFC 3339 format, UTC).
+	VerifiedTime string
+
+	// Passed is true if the backup was restored, mysqld started on it, and
+	// all its tables passed CHECK TABLE.
+	Passed bool
+
+	// Errors lists the problems that were found.
+	Errors []string
+
+	// Checksums maps every table, as schema.table, to its CHECKSUM TABLE
+	// result.
+	Checksums map[string]string
+}
+
+// VerifyBackup restores a full backup of params.Keyspace/params.Shard into
+// the directories of params.Cnf, starts mysqld on it, and runs CHECK TABLE and
+// CHECKSUM TABLE on every table. Anything already in those directories is
+// deleted. The result is recorded in the backup's VERIFICATION file and
+// returned.
+// An empty backupName verifies the most recent complete full backup.
+//
+// A backup that fails verification is not an error: the returned
+// BackupVerification says so. Errors are only returned if the backup can't be
+// found or the result can't be recorded.
+func VerifyBackup(ctx context.Context, params RestoreParams, backupName string) (*BackupVerification, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+
+	backupDir := GetBackupDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	var bh backupstorage.BackupHandle
+	if backupName == "" {
+		if bh, err = FindBackupToRestore(ctx, params, bhs); err != nil {
+			return nil, err
+		}
+	} else {
+		for _, candidate := range bhs {
+			if candidate.Name() == backupName {
+				bh = candidate
+				break
+			}
+		}
+		if bh == nil {
+			return nil, fmt.Errorf("backup %v not found in %v", backupName, backupDir)
+		}
+	}
+	bm, err := GetBackupManifest(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if bm.Incremental {
+		return nil, fmt.Errorf("backup %v is incremental, only full backups can be verified", bh.Name())
+	}
+
+	params.Logger.Infof("VerifyBackup: verifying backup %v", bh.Name())
+	verification := &BackupVerification{}
+	verification.Checksums, verification.Errors = restoreAndCheckTables(ctx, params, bh)
+	verification.Passed = len(verification.Errors) == 0
+	verification.VerifiedTime = time.Now().UTC().Format(time.RFC3339)
+	for _, e := range verification.Errors {
+		params.Logger.Errorf("VerifyBackup: %v", e)
+	}
+
+	if err := recordBackupVerification(ctx, bs, bh, bm.EncryptionKeyID, verification); err != nil {
+		return verification, err
+	}
+	return verification, nil
+}
+
+// restoreAndCheckTables restores a backup, checks its tables, and shuts
+// mysqld down. It returns the table checksums and the problems found.
+func restoreAndCheckTables(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle) (map[string]string, []string) {
+	re, err := GetRestoreEngine(ctx, bh)
+	if err != nil {
+		return nil, []string{fmt.Sprintf("can't find restore engine: %v", err)}
+	}
+	if _, err := re.ExecuteRestore(ctx, params, bh); err != nil {
+		return nil, []string{fmt.Sprintf("restore failed: %v", err)}
+	}
+
+	// Nothing but us needs to connect to this mysqld.
+	params.Logger.Infof("VerifyBackup: starting mysqld")
+	if err := params.Mysqld.Start(ctx, params.Cnf, "--skip-grant-tables", "--skip-networking"); err != nil {
+		return nil, []string{fmt.Sprintf("mysqld failed to start: %v", err)}
+	}
+	defer func() {
+		// Shut down even if ctx is done, so mysqld doesn't outlive us.
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := params.Mysqld.Shutdown(shutdownCtx, params.Cnf, true); err != nil {
+			params.Logger.Warningf("VerifyBackup: can't shut down mysqld: %v", err)
+		}
+	}()
+
+	qr, err := params.Mysqld.FetchSuperQuery(ctx, verifyTablesQuery)
+	if err != nil {
+		return nil, []string{fmt.Sprintf("can't list tables: %v", err)}
+	}
+	params.Logger.Infof("VerifyBackup: checking %v tables", len(qr.Rows))
+	checksums := make(map[string]string, len(qr.Rows))
+	var errs []string
+	for _, row := range qr.Rows {
+		table := sqlescape.EscapeID(row[0].ToString()) + "." + sqlescape.EscapeID(row[1].ToString())
+		name := row[0].ToString() + "." + row[1].ToString()
+
+		// CHECK TABLE returns Table, Op, Msg_type and Msg_text. A table is
+		// fine if it has no error and its last status is OK.
+		check, err := params.Mysqld.FetchSuperQuery(ctx, "CHECK TABLE "+table)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("CHECK TABLE %v failed: %v", name, err))
+			continue
+		}
+		status := ""
+		for _, msg := range check.Rows {
+			if len(msg) < 4 {
+				continue
+			}
+			switch msg[2].ToString() {
+			case "error":
+				errs = append(errs, fmt.Sprintf("CHECK TABLE %v: %v", name, msg[3].ToString()))
+			case "status":
+				status = msg[3].ToString()
+			}
+		}
+		if status != "OK" {
+			errs = append(errs, fmt.Sprintf("CHECK TABLE %v: status %q", name, status))
+		}
+
+		// CHECKSUM TABLE returns Table and Checksum.
+		checksum, err := params.Mysqld.FetchSuperQuery(ctx, "CHECKSUM TABLE "+table)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("CHECKSUM TABLE %v failed: %v", name, err))
+			continue
+		}
+		if len(checksum.Rows) != 1 || len(checksum.Rows[0]) != 2 {
+			errs = append(errs, fmt.Sprintf("CHECKSUM TABLE %v returned an unexpected result: %v", name, checksum.Rows))
+			continue
+		}
+		checksums[name] = checksum.Rows[0][1].ToString()
+	}
+	return checksums, errs
+}
+
+// recordBackupVerification writes the verification result to the
+// VERIFICATION file of a backup, replacing any earlier one. The MANIFEST is
+// left untouched. The file of an encrypted backup is encrypted with the key
+// of the backup, keyID, since files encrypted with another key are rejected
+// when the backup is read.
+func recordBackupVerification(ctx context.Context, bs backupstorage.BackupStorage, bh backupstorage.BackupHandle, keyID string, verification *BackupVerification) error {
+	data, err := json.MarshalIndent(verification, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Don't abort the reopened backup on failure, that would remove it.
+	var wbh backupstorage.BackupHandle
+	if ebs, ok := bs.(backupstorage.EncryptedBackupStorage); ok && keyID != "" {
+		wbh, err = ebs.ReopenBackupWithKey(ctx, bh.Directory(), bh.Name(), keyID)
+	} else {
+		wbh, err = bs.ReopenBackup(ctx, bh.Directory(), bh.Name())
+	}
+	if err != nil {
+		return vterrors.Wrapf(err, "can't reopen backup %v", bh.Name())
+	}
+	err = writeBackupVerification(ctx, wbh, data)
+	if endErr := wbh.EndBackup(ctx); err == nil {
+		err = endErr
+	}
+	return err
+}
+
+func writeBackupVerification(ctx context.Context, bh backupstorage.BackupHandle, data []byte) error {
+	wc, err := bh.AddFile(ctx, backupVerificationFileName, int64(len(data)))
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to backup", backupVerificationFileName)
+	}
+	if _, err := wc.Write(data); err != nil {
+		wc.Close()
+		return vterrors.Wrapf(err, "cannot write %v", backupVerificationFileName)
+	}
+	if err := wc.Close(); err != nil {
+		return vterrors.Wrapf(err, "cannot close %v", backupVerificationFileName)
+	}
+	return nil
+}
+
+// GetBackupVerification returns the result of the last time a backup was
+// verified with VerifyBackup. It fails if the backup was never verified.
+func GetBackupVerification(ctx context.Context, bh backupstorage.BackupHandle) (*BackupVerification, error) {
+	file, err := bh.ReadFile(ctx, backupVerificationFileName)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "can't read %v", backupVerificationFileName)
+	}
+	defer file.Close()
+
+	verification := &BackupVerification{}
+	if err := json.NewDecoder(file).Decode(verification); err != nil {
+		return nil, vterrors.Wrapf(err, "can't decode %v", backupVerificationFileName)
+	}
+	return verification, nil
+}
+
+// FindBackupVerification returns the result of the last time a backup was
+// verified with VerifyBackup, or nil if it never was. Unlike
+// GetBackupVerification, it reads the MANIFEST of the backup first, so the
+// VERIFICATION file of an encrypted backup must be encrypted with its key.
+func FindBackupVerification(ctx context.Context, bh backupstorage.BackupHandle) (*BackupVerification, error) {
+	if _, err := GetBackupManifest(ctx, bh); err != nil {
+		if backupstorage.IsFileNotFound(err) {
+			// An incomplete backup can't have been verified.
+			return nil, nil
+		}
+		return nil, err
+	}
+	verification, err := GetBackupVerification(ctx, bh)
+	if backupstorage.IsFileNotFound(err) {
+		return nil, nil
+	}
+	return verification, err
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+const verifyTestEngineName = "verifytest"
+
+// verifyTestEngine restores backups by doing nothing.
+type verifyTestEngine struct {
+	BackupEngine
+	restored []string
+}
+
+func (e *verifyTestEngine) ExecuteRestore(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle) (*BackupManifest, error) {
+	e.restored = append(e.restored, bh.Name())
+	return GetBackupManifest(ctx, bh)
+}
+
+// verifyDaemon fakes the MysqlDaemon methods used by VerifyBackup.
+type verifyDaemon struct {
+	MysqlDaemon
+
+	queries map[string]*sqltypes.Result
+	running bool
+}
+
+func (d *verifyDaemon) Start(ctx context.Context, cnf *Mycnf, mysqldArgs ...string) error {
+	d.running = true
+	return nil
+}
+
+func (d *verifyDaemon) Shutdown(ctx context.Context, cnf *Mycnf, waitForMysqld bool) error {
+	d.running = false
+	return nil
+}
+
+func (d *verifyDaemon) FetchSuperQuery(ctx context.Context, query string) (*sqltypes.Result, error) {
+	if !d.running {
+		return nil, fmt.Errorf("mysqld is not running")
+	}
+	qr, ok := d.queries[query]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query: %v", query)
+	}
+	return qr, nil
+}
+
+func checkTableResult(table string, msgs ...string) *sqltypes.Result {
+	var rows []string
+	for i := 0; i < len(msgs); i += 2 {
+		rows = append(rows, table+"|check|"+msgs[i]+"|"+msgs[i+1])
+	}
+	return sqltypes.MakeTestResult(sqltypes.MakeTestFields("Table|Op|Msg_type|Msg_text", "varchar|varchar|varchar|varchar"), rows...)
+}
+
+func checksumTableResult(table, checksum string) *sqltypes.Result {
+	return sqltypes.MakeTestResult(sqltypes.MakeTestFields("Table|Checksum", "varchar|int64"), table+"|"+checksum)
+}
+
+func TestVerifyBackup(t *testing.T) {
+	ctx := context.Background()
+	root, err := ioutil.TempDir("", "verifytest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+	oldImplementation := *backupstorage.BackupStorageImplementation
+	defer func() { *backupstorage.BackupStorageImplementation = oldImplementation }()
+	*backupstorage.BackupStorageImplementation = "file"
+	engine := &verifyTestEngine{}
+	BackupRestoreEngineMap[verifyTestEngineName] = engine
+	defer delete(BackupRestoreEngineMap, verifyTestEngineName)
+
+	for _, name := range []string{"2021-01-01.000000.zone1-100", "2021-01-02.000000.zone1-100"} {
+		fbs := &filebackupstorage.FileBackupStorage{}
+		bh, err := fbs.StartBackup(ctx, "ks/0", name)
+		require.NoError(t, err)
+		require.NoError(t, writeBackupManifest(ctx, bh, &builtinBackupManifest{
+			BackupManifest: BackupManifest{BackupMethod: verifyTestEngineName, BackupTime: "2021-01-01T00:00:00Z"},
+			FileEntries:    []FileEntry{{Base: backupData, Name: "vt_ks/t1.ibd", Hash: "abc"}},
+		}))
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+
+	mysqld := &verifyDaemon{queries: map[string]*sqltypes.Result{
+		verifyTablesQuery: sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_schema|table_name", "varchar|varchar"),
+			"vt_ks|t1",
+			"vt_ks|t2",
+		),
+		"CHECK TABLE `vt_ks`.`t1`":    checkTableResult("vt_ks.t1", "status", "OK"),
+		"CHECKSUM TABLE `vt_ks`.`t1`": checksumTableResult("vt_ks.t1", "1234"),
+		"CHECK TABLE `vt_ks`.`t2`":    checkTableResult("vt_ks.t2", "status", "OK"),
+		"CHECKSUM TABLE `vt_ks`.`t2`": checksumTableResult("vt_ks.t2", "5678"),
+	}}
+	params := RestoreParams{
+		Mysqld:   mysqld,
+		Logger:   logutil.NewMemoryLogger(),
+		Keyspace: "ks",
+		Shard:    "0",
+	}
+
+	// The most recent backup is verified by default.
+	verification, err := VerifyBackup(ctx, params, "")
+	require.NoError(t, err)
+	assert.True(t, verification.Passed)
+	assert.Empty(t, verification.Errors)
+	assert.Equal(t, map[string]string{"vt_ks.t1": "1234", "vt_ks.t2": "5678"}, verification.Checksums)
+	assert.Equal(t, []string{"2021-01-02.000000.zone1-100"}, engine.restored)
+	assert.False(t, mysqld.running)
+
+	// The result is recorded next to the MANIFEST, which is left untouched.
+	fbs := &filebackupstorage.FileBackupStorage{}
+	bhs, err := fbs.ListBackups(ctx, "ks/0")
+	require.NoError(t, err)
+	require.Len(t, bhs, 2)
+	recorded, err := GetBackupVerification(ctx, bhs[1])
+	require.NoError(t, err)
+	assert.Equal(t, verification, recorded)
+	var bm builtinBackupManifest
+	require.NoError(t, getBackupManifestInto(ctx, bhs[1], &bm))
+	assert.Equal(t, verifyTestEngineName, bm.BackupMethod)
+	assert.Equal(t, "abc", bm.FileEntries[0].Hash)
+	_, err = GetBackupVerification(ctx, bhs[0])
+	assert.Error(t, err)
+
+	// A corrupted table fails verification of a named backup.
+	mysqld.queries["CHECK TABLE `vt_ks`.`t2`"] = checkTableResult("vt_ks.t2", "error", "Corrupt", "status", "Corrupt")
+	verification, err = VerifyBackup(ctx, params, "2021-01-01.000000.zone1-100")
+	require.NoError(t, err)
+	assert.False(t, verification.Passed)
+	assert.Equal(t, []string{"CHECK TABLE vt_ks.t2: Corrupt", `CHECK TABLE vt_ks.t2: status "Corrupt"`}, verification.Errors)
+	recorded, err = GetBackupVerification(ctx, bhs[0])
+	require.NoError(t, err)
+	assert.False(t, recorded.Passed)
+
+	_, err = VerifyBackup(ctx, params, "2021-01-03.000000.zone1-100")
+	assert.EqualError(t, err, "backup 2021-01-03.000000.zone1-100 not found in ks/0")
+}
+
+func TestVerifyBackupKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	root, err := ioutil.TempDir("", "verifytest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	oldImplementation, oldKeyManager, oldKeyFile := *backupstorage.BackupStorageImplementation, *backupstorage.EncryptionKeyManager, *backupstorage.EncryptionKeyFile
+	defer func() {
+		*backupstorage.BackupStorageImplementation, *backupstorage.EncryptionKeyManager, *backupstorage.EncryptionKeyFile = oldImplementation, oldKeyManager, oldKeyFile
+	}()
+	*backupstorage.BackupStorageImplementation = "file"
+	*backupstorage.EncryptionKeyManager = "file"
+	*backupstorage.EncryptionKeyFile = path.Join(root, "keys.json")
+	writeKeys := func(currentKeyID string) {
+		t.Helper()
+		require.NoError(t, ioutil.WriteFile(*backupstorage.EncryptionKeyFile, []byte(`{"current_key_id": "`+currentKeyID+`", "keys": {
+			"k1": "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=",
+			"k2": "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="}}`), 0600))
+	}
+	engine := &verifyTestEngine{}
+	BackupRestoreEngineMap[verifyTestEngineName] = engine
+	defer delete(BackupRestoreEngineMap, verifyTestEngineName)
+
+	writeKeys("k1")
+	bs, err := backupstorage.GetBackupStorage()
+	require.NoError(t, err)
+	bh, err := bs.StartBackup(ctx, "ks/0", "2021-01-01.000000.zone1-100")
+	require.NoError(t, err)
+	require.NoError(t, writeBackupManifest(ctx, bh, &builtinBackupManifest{
+		BackupManifest: BackupManifest{BackupMethod: verifyTestEngineName, BackupTime: "2021-01-01T00:00:00Z", EncryptionKeyID: "k1"},
+	}))
+	require.NoError(t, bh.EndBackup(ctx))
+	readBackup := func() backupstorage.BackupHandle {
+		t.Helper()
+		bhs, err := bs.ListBackups(ctx, "ks/0")
+		require.NoError(t, err)
+		require.Len(t, bhs, 1)
+		return bhs[0]
+	}
+
+	// A backup that was never verified has no result.
+	recorded, err := FindBackupVerification(ctx, readBackup())
+	require.NoError(t, err)
+	assert.Nil(t, recorded)
+
+	// After the current key changed, the result is still encrypted with the
+	// key of the backup, so it can be read back.
+	writeKeys("k2")
+	mysqld := &verifyDaemon{queries: map[string]*sqltypes.Result{
+		verifyTablesQuery:             sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_schema|table_name", "varchar|varchar"), "vt_ks|t1"),
+		"CHECK TABLE `vt_ks`.`t1`":    checkTableResult("vt_ks.t1", "status", "OK"),
+		"CHECKSUM TABLE `vt_ks`.`t1`": checksumTableResult("vt_ks.t1", "1234"),
+	}}
+	verification, err := VerifyBackup(ctx, RestoreParams{
+		Mysqld:   mysqld,
+		Logger:   logutil.NewMemoryLogger(),
+		Keyspace: "ks",
+		Shard:    "0",
+	}, "")
+	require.NoError(t, err)
+	assert.True(t, verification.Passed)
+	recorded, err = FindBackupVerification(ctx, readBackup())
+	require.NoError(t, err)
+	assert.Equal(t, verification, recorded)
+}
@@ -172,10 +172,11 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	bm := &xtraBackupManifest{
 		// Common base fields
 		BackupManifest: BackupManifest{
-			BackupMethod: xtrabackupEngineName,
-			Position:     replicationPosition,
-			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
-			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+			BackupMethod:    xtrabackupEngineName,
+			Position:        replicationPosition,
+			BackupTime:      params.BackupTime.UTC().Format(time.RFC3339),
+			FinishedTime:    time.Now().UTC().Format(time.RFC3339),
+			EncryptionKeyID: backupEncryptionKeyID(bh),
 		},
 
 		// XtraBackup-specific fields
@@ -3279,9 +3279,19 @@ func (this *HttpAPI) AuditRecovery(params martini.Params, r render.Render, req *
 		if derr != nil || page < 0 {
 			page = 0
 		}
-		unacknowledgedOnly := (req.URL.Query().Get("unacknowledged") == "true")
 
-		audits, err = logic.ReadRecentRecoveries(params["clusterName"], params["clusterAlias"], unacknowledgedOnly, page)
+		if params["host"] != "" {
+			instanceKey, kerr := this.getInstanceKey(params["host"], params["port"])
+			if kerr != nil {
+				Respond(r, &APIResponse{Code: ERROR, Message: kerr.Error()})
+				return
+			}
+			audits, err = logic.ReadInstanceRecoveries(&instanceKey, page)
+		} else {
+			unacknowledgedOnly := (req.URL.Query().Get("unacknowledged") == "true")
+
+			audits, err = logic.ReadRecentRecoveries(params["clusterName"], params["clusterAlias"], unacknowledgedOnly, page)
+		}
 	}
 
 	if err != nil {
@@ -3781,6 +3791,8 @@ func (this *HttpAPI) RegisterRequests(m *martini.ClassicMartini) {
 	this.registerAPIRequest(m, "audit-recovery/cluster/:clusterName/:page", this.AuditRecovery)
 	this.registerAPIRequest(m, "audit-recovery/alias/:clusterAlias", this.AuditRecovery)
 	this.registerAPIRequest(m, "audit-recovery/alias/:clusterAlias/:page", this.AuditRecovery)
+	this.registerAPIRequest(m, "audit-recovery/instance/:host/:port", this.AuditRecovery)
+	this.registerAPIRequest(m, "audit-recovery/instance/:host/:port/:page", this.AuditRecovery)
 	this.registerAPIRequest(m, "audit-recovery-steps/:uid", this.AuditRecoverySteps)
 	this.registerAPIRequest(m, "active-cluster-recovery/:clusterName", this.ActiveClusterRecovery)
 	this.registerAPIRequest(m, "recently-active-cluster-recovery/:clusterName", this.RecentlyActiveClusterRecovery)
@@ -1,14 +1,26 @@
 package http
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
 	"strings"
 	"testing"
 
 	"github.com/go-martini/martini"
+	"github.com/martini-contrib/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/vt/orchestrator/config"
+	"vitess.io/vitess/go/vt/orchestrator/db"
 	"vitess.io/vitess/go/vt/orchestrator/external/golib/log"
 	test "vitess.io/vitess/go/vt/orchestrator/external/golib/tests"
+	"vitess.io/vitess/go/vt/orchestrator/inst"
+	"vitess.io/vitess/go/vt/orchestrator/logic"
 )
 
 func init() {
@@ -53,3 +65,53 @@ func TestKnownPaths(t *testing.T) {
 		test.S(t).ExpectTrue(pathsMap[synonym])
 	}
 }
+
+func TestAuditRecoveryInstance(t *testing.T) {
+	dir, err := ioutil.TempDir("", "orchestrator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	oldBackendDB, oldDataFile := config.Config.BackendDB, config.Config.SQLite3DataFile
+	defer func() {
+		config.Config.BackendDB, config.Config.SQLite3DataFile = oldBackendDB, oldDataFile
+	}()
+	config.Config.BackendDB = "sqlite3"
+	config.Config.SQLite3DataFile = path.Join(dir, "orchestrator.db")
+	_, err = db.OpenOrchestrator()
+	require.NoError(t, err)
+
+	for _, port := range []int{100, 101} {
+		analysisEntry := &inst.ReplicationAnalysis{
+			Analysis:            inst.ErrantGTIDDetected,
+			AnalyzedInstanceKey: inst.InstanceKey{Hostname: "localhost", Port: port},
+			ClusterDetails:      inst.ClusterInfo{ClusterName: "localhost:100"},
+		}
+		_, err := logic.AttemptRecoveryRegistration(analysisEntry, false, false)
+		require.NoError(t, err)
+	}
+
+	m := martini.Classic()
+	m.Use(render.Renderer())
+	api := HttpAPI{}
+	api.RegisterRequests(m)
+
+	get := func(path string) []*logic.TopologyRecovery {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", path, nil)
+		require.NoError(t, err)
+		m.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var recoveries []*logic.TopologyRecovery
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &recoveries))
+		return recoveries
+	}
+
+	recoveries := get("/api/audit-recovery/instance/localhost/101")
+	require.Len(t, recoveries, 1)
+	assert.Equal(t, inst.InstanceKey{Hostname: "localhost", Port: 101}, recoveries[0].AnalysisEntry.AnalyzedInstanceKey)
+	assert.Equal(t, inst.ErrantGTIDDetected, recoveries[0].AnalysisEntry.Analysis)
+
+	assert.Empty(t, get("/api/audit-recovery/instance/localhost/101/1"))
+	assert.Len(t, get("/api/audit-recovery"), 2)
+}
@@ -44,6 +44,7 @@ const (
 	NotConnectedToMaster                                    AnalysisCode = "NotConnectedToMaster"
 	ConnectedToWrongMaster                                  AnalysisCode = "ConnectedToWrongMaster"
 	ReplicationStopped                                      AnalysisCode = "ReplicationStopped"
+	ErrantGTIDDetected                                      AnalysisCode = "ErrantGTIDDetected"
 	ReplicaSemiSyncMustBeSet                                AnalysisCode = "ReplicaSemiSyncMustBeSet"
 	ReplicaSemiSyncMustNotBeSet                             AnalysisCode = "ReplicaSemiSyncMustNotBeSet"
 	UnreachableMasterWithLaggingReplicas                    AnalysisCode = "UnreachableMasterWithLaggingReplicas"
@@ -184,6 +185,7 @@ type ReplicationAnalysis struct {
 	StartActivePeriod                         string
 	SkippableDueToDowntime                    bool
 	GTIDMode                                  string
+	GTIDErrant                                string
 	MinReplicaGTIDMode                        string
 	MaxReplicaGTIDMode                        string
 	MaxReplicaGTIDErrant                      string
@@ -130,6 +130,7 @@ func GetReplicationAnalysis(clusterName string, hints *ReplicationAnalysisHints)
 			) = master_instance.cluster_name
 		) AS is_cluster_master,
 		MIN(master_instance.gtid_mode) AS gtid_mode,
+		MIN(master_instance.gtid_errant) AS gtid_errant,
 		COUNT(replica_instance.server_id) AS count_replicas,
 		IFNULL(
 			SUM(
@@ -405,6 +406,7 @@ func GetReplicationAnalysis(clusterName string, hints *ReplicationAnalysisHints)
 		a.ClusterDetails.ClusterAlias = m.GetString("cluster_alias")
 		a.ClusterDetails.ClusterDomain = m.GetString("cluster_domain")
 		a.GTIDMode = m.GetString("gtid_mode")
+		a.GTIDErrant = m.GetString("gtid_errant")
 		a.LastCheckValid = m.GetBool("is_last_check_valid")
 		a.LastCheckPartialSuccess = m.GetBool("last_check_partial_success")
 		a.CountReplicas = m.GetUint("count_replicas")
@@ -528,6 +530,10 @@ func GetReplicationAnalysis(clusterName string, hints *ReplicationAnalysisHints)
 			a.Analysis = ConnectedToWrongMaster
 			a.Description = "Connected to wrong master"
 			//
+		} else if topo.IsReplicaType(a.TabletType) && !a.IsMaster && a.GTIDErrant != "" {
+			a.Analysis = ErrantGTIDDetected
+			a.Description = "Replica has errant GTIDs"
+			//
 		} else if topo.IsReplicaType(a.TabletType) && !a.IsMaster && a.ReplicationStopped {
 			a.Analysis = ReplicationStopped
 			a.Description = "Replication is stopped"
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inst
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/orchestrator/config"
+	"vitess.io/vitess/go/vt/orchestrator/db"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// useTestBackend makes orchestrator use a new sqlite backend database for the
+// rest of the test.
+func useTestBackend(t *testing.T) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "orchestrator")
+	require.NoError(t, err)
+
+	oldBackendDB, oldDataFile := config.Config.BackendDB, config.Config.SQLite3DataFile
+	t.Cleanup(func() {
+		config.Config.BackendDB, config.Config.SQLite3DataFile = oldBackendDB, oldDataFile
+		os.RemoveAll(dir)
+	})
+	config.Config.BackendDB = "sqlite3"
+	config.Config.SQLite3DataFile = path.Join(dir, "orchestrator.db")
+
+	_, err = db.OpenOrchestrator()
+	require.NoError(t, err)
+}
+
+// writeTestInstance records a MySQL instance of shard ks/0 and its tablet in
+// the backend database.
+func writeTestInstance(t *testing.T, instance *Instance, tabletType topodatapb.TabletType) {
+	t.Helper()
+
+	instance.Version = "5.7.30"
+	instance.ClusterName = "localhost:100"
+	instance.SuggestedClusterAlias = "ks/0"
+	require.NoError(t, WriteInstance(instance, true, nil))
+	require.NoError(t, SaveTablet(&topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "zone1",
+			Uid:  uint32(instance.Key.Port),
+		},
+		MysqlHostname: instance.Key.Hostname,
+		MysqlPort:     int32(instance.Key.Port),
+		Keyspace:      "ks",
+		Shard:         "0",
+		Type:          tabletType,
+	}))
+}
+
+func TestGetReplicationAnalysisErrantGTID(t *testing.T) {
+	useTestBackend(t)
+
+	masterKey := InstanceKey{Hostname: "localhost", Port: 100}
+	// The sqlite backend has a single connection, so the master is read-only
+	// to keep the analysis from reading its tablet while it reads the rows.
+	writeTestInstance(t, &Instance{Key: masterKey, ReadOnly: true}, topodatapb.TabletType_MASTER)
+
+	replica := func(port int, gtidErrant string, replicating bool) *Instance {
+		return &Instance{
+			Key:                        InstanceKey{Hostname: "localhost", Port: port},
+			MasterKey:                  masterKey,
+			ReadOnly:                   true,
+			ReplicationSQLThreadRuning: replicating,
+			ReplicationIOThreadRuning:  replicating,
+			GtidErrant:                 gtidErrant,
+		}
+	}
+	errantGTIDs := "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-3"
+	writeTestInstance(t, replica(101, "", true), topodatapb.TabletType_REPLICA)
+	writeTestInstance(t, replica(102, errantGTIDs, true), topodatapb.TabletType_REPLICA)
+	writeTestInstance(t, replica(103, "", false), topodatapb.TabletType_REPLICA)
+	// Errant GTIDs take precedence over stopped replication, which would
+	// otherwise be restarted and apply them.
+	writeTestInstance(t, replica(104, errantGTIDs, false), topodatapb.TabletType_RDONLY)
+
+	analysis, err := GetReplicationAnalysis("", &ReplicationAnalysisHints{})
+	require.NoError(t, err)
+
+	codes := make(map[int]AnalysisCode)
+	errant := make(map[int]string)
+	for _, a := range analysis {
+		codes[a.AnalyzedInstanceKey.Port] = a.Analysis
+		errant[a.AnalyzedInstanceKey.Port] = a.GTIDErrant
+	}
+	assert.Equal(t, map[int]AnalysisCode{
+		100: MasterIsReadOnly,
+		102: ErrantGTIDDetected,
+		103: ReplicationStopped,
+		104: ErrantGTIDDetected,
+	}, codes)
+	assert.Equal(t, errantGTIDs, errant[102])
+}
@@ -17,58 +17,88 @@ limitations under the License.
 package inst
 
 import (
-	"fmt"
+	"context"
+	"time"
+
+	"github.com/patrickmn/go-cache"
 
 	"vitess.io/vitess/go/vt/orchestrator/external/golib/log"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 )
 
 //=======================================================================
 
 var (
-	durabilityPolicies  = make(map[string]durabler)
-	curDurabilityPolicy durabler
-)
-
-func init() {
-	registerDurability("none", &durabilityNone{})
-	registerDurability("semi_sync", &durabilitySemiSync{})
-	registerDurability("cross_cell", &durabilityCrossCell{})
-}
+	// defaultDurabilityPolicy is used for keyspaces that don't specify a
+	// durability policy in the topo.
+	defaultDurabilityPolicy string
 
-type durabler interface {
-	promotionRule(*topodatapb.Tablet) CandidatePromotionRule
-	masterSemiSync(InstanceKey) int
-	replicaSemiSync(master, replica *topodatapb.Tablet) bool
-}
+	// keyspaceDurabilityCache caches the durability policy name of each
+	// keyspace, so that the topo is not read on every instance poll.
+	keyspaceDurabilityCache = cache.New(time.Minute, time.Minute)
+)
 
-func registerDurability(name string, d durabler) {
-	if durabilityPolicies[name] != nil {
-		log.Fatalf("durability policy %v already registered", name)
+// SetDurabilityPolicy sets the durability policy used for keyspaces that don't
+// specify one in the topo. The policies are the ones registered with
+// reparentutil.RegisterDurability, so vtorc and the reparenting tools in
+// vtctld agree on who should be primary. An empty name uses the
+// -durability_policy flag, which is the default of vtctld as well.
+func SetDurabilityPolicy(name string) error {
+	if name == "" {
+		name = reparentutil.DefaultDurabilityPolicy()
 	}
-	durabilityPolicies[name] = d
+	if _, err := reparentutil.GetDurabilityPolicy(name); err != nil {
+		return err
+	}
+	defaultDurabilityPolicy = name
+	log.Infof("Durability setting: %v", name)
+	return nil
 }
 
-//=======================================================================
+// keyspaceDurability returns the durability policy of the keyspace.
+func keyspaceDurability(keyspace string) reparentutil.Durabler {
+	name := defaultDurabilityPolicy
+	if keyspacePolicy, found := keyspaceDurabilityCache.Get(keyspace); found {
+		if keyspacePolicy.(string) != "" {
+			name = keyspacePolicy.(string)
+		}
+	} else if TopoServ != nil && keyspace != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+		defer cancel()
+		ki, err := TopoServ.GetKeyspace(ctx, keyspace)
+		if err != nil {
+			log.Errorf("cannot read durability policy of keyspace %v, using %v: %v", keyspace, defaultDurabilityPolicy, err)
+		} else {
+			keyspaceDurabilityCache.Set(keyspace, ki.DurabilityPolicy, cache.DefaultExpiration)
+			if ki.DurabilityPolicy != "" {
+				name = ki.DurabilityPolicy
+			}
+		}
+	}
 
-func SetDurabilityPolicy(name string) error {
-	curDurabilityPolicy = durabilityPolicies[name]
-	if curDurabilityPolicy == nil {
-		return fmt.Errorf("durability policy %v not found", name)
+	durability, err := reparentutil.GetDurabilityPolicy(name)
+	if err != nil {
+		log.Errorf("keyspace %v: %v, using %v", keyspace, err, defaultDurabilityPolicy)
+		durability, _ = reparentutil.GetDurabilityPolicy(defaultDurabilityPolicy)
 	}
-	log.Infof("Durability setting: %v", name)
-	return nil
+	return durability
 }
 
 // PromotionRule returns the promotion rule for the instance.
 func PromotionRule(tablet *topodatapb.Tablet) CandidatePromotionRule {
-	return curDurabilityPolicy.promotionRule(tablet)
+	return keyspaceDurability(tablet.Keyspace).PromotionRule(tablet)
 }
 
 // MasterSemiSync returns the master semi-sync setting for the instance.
 // 0 means none. Non-zero specifies the number of required ackers.
 func MasterSemiSync(instanceKey InstanceKey) int {
-	return curDurabilityPolicy.masterSemiSync(instanceKey)
+	master, err := ReadTablet(instanceKey)
+	if err != nil {
+		master = &topodatapb.Tablet{}
+	}
+	return keyspaceDurability(master.Keyspace).SemiSyncAckers(master)
 }
 
 // ReplicaSemiSync returns the replica semi-sync setting for the instance.
@@ -81,83 +111,11 @@ func ReplicaSemiSync(masterKey, replicaKey InstanceKey) bool {
 	if err != nil {
 		return false
 	}
-	return curDurabilityPolicy.replicaSemiSync(master, replica)
+	return ReplicaSemiSyncFromTablet(master, replica)
 }
 
 // ReplicaSemiSyncFromTablet returns the replica semi-sync setting from the tablet record.
 // Prefer using this function if tablet record is available.
 func ReplicaSemiSyncFromTablet(master, replica *topodatapb.Tablet) bool {
-	return curDurabilityPolicy.replicaSemiSync(master, replica)
-}
-
-//=======================================================================
-
-type durabilityNone struct{}
-
-func (d *durabilityNone) promotionRule(tablet *topodatapb.Tablet) CandidatePromotionRule {
-	switch tablet.Type {
-	case topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA:
-		return NeutralPromoteRule
-	}
-	return MustNotPromoteRule
-}
-
-func (d *durabilityNone) masterSemiSync(instanceKey InstanceKey) int {
-	return 0
-}
-
-func (d *durabilityNone) replicaSemiSync(master, replica *topodatapb.Tablet) bool {
-	return false
-}
-
-//=======================================================================
-
-type durabilitySemiSync struct{}
-
-func (d *durabilitySemiSync) promotionRule(tablet *topodatapb.Tablet) CandidatePromotionRule {
-	switch tablet.Type {
-	case topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA:
-		return NeutralPromoteRule
-	}
-	return MustNotPromoteRule
-}
-
-func (d *durabilitySemiSync) masterSemiSync(instanceKey InstanceKey) int {
-	return 1
-}
-
-func (d *durabilitySemiSync) replicaSemiSync(master, replica *topodatapb.Tablet) bool {
-	switch replica.Type {
-	case topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA:
-		return true
-	}
-	return false
-}
-
-//=======================================================================
-
-type durabilityCrossCell struct{}
-
-func (d *durabilityCrossCell) promotionRule(tablet *topodatapb.Tablet) CandidatePromotionRule {
-	switch tablet.Type {
-	case topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA:
-		return NeutralPromoteRule
-	}
-	return MustNotPromoteRule
-}
-
-func (d *durabilityCrossCell) masterSemiSync(instanceKey InstanceKey) int {
-	return 1
-}
-
-func (d *durabilityCrossCell) replicaSemiSync(master, replica *topodatapb.Tablet) bool {
-	// Prevent panics.
-	if master.Alias == nil || replica.Alias == nil {
-		return false
-	}
-	switch replica.Type {
-	case topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA:
-		return master.Alias.Cell != replica.Alias.Cell
-	}
-	return false
+	return keyspaceDurability(master.Keyspace).IsReplicaSemiSync(master, replica)
 }
@@ -299,6 +299,61 @@ func tabletDemoteMaster(instanceKey inst.InstanceKey, forward bool) error {
 	return err
 }
 
+// TabletSetMaster requests the replica tablet to replicate from the master
+// recorded in the shard record, and to start replication.
+func TabletSetMaster(instanceKey inst.InstanceKey) error {
+	if instanceKey.Hostname == "" {
+		return errors.New("Can't set master: instance is unspecified")
+	}
+	tablet, err := inst.ReadTablet(instanceKey)
+	if err != nil {
+		return err
+	}
+	sCtx, sCancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer sCancel()
+	si, err := ts.GetShard(sCtx, tablet.Keyspace, tablet.Shard)
+	if err != nil {
+		return err
+	}
+	if !si.HasMaster() {
+		return fmt.Errorf("no master tablet for shard %v/%v", tablet.Keyspace, tablet.Shard)
+	}
+	tmc := tmclient.NewTabletManagerClient()
+	ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer cancel()
+	return tmc.SetMaster(ctx, tablet, si.MasterAlias, 0, "", true)
+}
+
+// TabletStartReplication requests the replica tablet to start replication.
+func TabletStartReplication(instanceKey inst.InstanceKey) error {
+	if instanceKey.Hostname == "" {
+		return errors.New("Can't start replication: instance is unspecified")
+	}
+	tablet, err := inst.ReadTablet(instanceKey)
+	if err != nil {
+		return err
+	}
+	tmc := tmclient.NewTabletManagerClient()
+	ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer cancel()
+	return tmc.StartReplication(ctx, tablet)
+}
+
+// TabletSetReadOnly requests the tablet to make its MySQL instance read-only.
+func TabletSetReadOnly(instanceKey inst.InstanceKey) error {
+	if instanceKey.Hostname == "" {
+		return errors.New("Can't set read-only: instance is unspecified")
+	}
+	tablet, err := inst.ReadTablet(instanceKey)
+	if err != nil {
+		return err
+	}
+	tmc := tmclient.NewTabletManagerClient()
+	ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer cancel()
+	return tmc.SetReadOnly(ctx, tablet)
+}
+
 func ShardMaster(instanceKey *inst.InstanceKey) (masterKey *inst.InstanceKey, err error) {
 	tablet, err := inst.ReadTablet(*instanceKey)
 	if err != nil {
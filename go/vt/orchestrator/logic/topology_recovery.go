@@ -1565,6 +1565,8 @@ func getCheckAndRecoverFunction(analysisCode inst.AnalysisCode, analyzedInstance
 	case inst.NotConnectedToMaster, inst.ConnectedToWrongMaster, inst.ReplicationStopped, inst.ReplicaIsWritable,
 		inst.ReplicaSemiSyncMustBeSet, inst.ReplicaSemiSyncMustNotBeSet:
 		return fixReplica, false
+	case inst.ErrantGTIDDetected:
+		return recoverErrantGTIDDetected, true
 	// intermediate master
 	case inst.DeadIntermediateMaster:
 		return checkAndRecoverDeadIntermediateMaster, true
@@ -2196,6 +2198,9 @@ func fixMaster(analysisEntry inst.ReplicationAnalysis, candidateInstanceKey *ins
 }
 
 // fixReplica sets the replica as read-only and points it at the current master.
+// A replica whose replication is stopped but otherwise points at the right
+// master only gets its replication restarted, and only if the SQL thread did not
+// stop on an error.
 func fixReplica(analysisEntry inst.ReplicationAnalysis, candidateInstanceKey *inst.InstanceKey, forceInstanceRecovery bool, skipProcesses bool) (recoveryAttempted bool, topologyRecovery *TopologyRecovery, err error) {
 	topologyRecovery, err = AttemptRecoveryRegistration(&analysisEntry, false, true)
 	if topologyRecovery == nil {
@@ -2213,16 +2218,62 @@ func fixReplica(analysisEntry inst.ReplicationAnalysis, candidateInstanceKey *in
 	}
 	defer unlock(&err)
 
-	if _, err := inst.SetReadOnly(&analysisEntry.AnalyzedInstanceKey, true); err != nil {
+	if err := TabletSetReadOnly(analysisEntry.AnalyzedInstanceKey); err != nil {
 		return false, topologyRecovery, err
 	}
 
-	masterKey, err := ShardMaster(&analysisEntry.AnalyzedInstanceKey)
+	if analysisEntry.Analysis == inst.ReplicationStopped {
+		instance, found, err := inst.ReadInstance(&analysisEntry.AnalyzedInstanceKey)
+		if err != nil {
+			return false, topologyRecovery, err
+		}
+		if !found {
+			return false, topologyRecovery, fmt.Errorf("fixReplica: instance %+v not found", analysisEntry.AnalyzedInstanceKey)
+		}
+		if instance.LastSQLError != "" {
+			AuditTopologyRecovery(topologyRecovery, fmt.Sprintf("- fixReplica: will not restart replication on %+v, the SQL thread stopped with an error: %s", analysisEntry.AnalyzedInstanceKey, instance.LastSQLError))
+			return false, topologyRecovery, nil
+		}
+		err = TabletStartReplication(analysisEntry.AnalyzedInstanceKey)
+		AuditTopologyRecovery(topologyRecovery, fmt.Sprintf("- fixReplica: start replication on %+v: success=%t", analysisEntry.AnalyzedInstanceKey, (err == nil)))
+		if err != nil {
+			return false, topologyRecovery, err
+		}
+		return true, topologyRecovery, nil
+	}
+
+	err = TabletSetMaster(analysisEntry.AnalyzedInstanceKey)
+	AuditTopologyRecovery(topologyRecovery, fmt.Sprintf("- fixReplica: set master on %+v: success=%t", analysisEntry.AnalyzedInstanceKey, (err == nil)))
+	if err != nil {
+		return false, topologyRecovery, err
+	}
+	return true, topologyRecovery, nil
+}
+
+// recoverErrantGTIDDetected quarantines a replica with errant GTIDs by
+// changing its tablet type to DRAINED, so that it stops serving and can no
+// longer be chosen as a master. Fixing the errant GTIDs is left to an operator.
+func recoverErrantGTIDDetected(analysisEntry inst.ReplicationAnalysis, candidateInstanceKey *inst.InstanceKey, forceInstanceRecovery bool, skipProcesses bool) (recoveryAttempted bool, topologyRecovery *TopologyRecovery, err error) {
+	topologyRecovery, err = AttemptRecoveryRegistration(&analysisEntry, false, true)
+	if topologyRecovery == nil {
+		AuditTopologyRecovery(topologyRecovery, fmt.Sprintf("found an active or recent recovery on %+v. Will not issue another recoverErrantGTIDDetected.", analysisEntry.AnalyzedInstanceKey))
+		return false, nil, err
+	}
+	log.Infof("Analysis: %v, will quarantine replica with errant GTIDs %+v: %s", analysisEntry.Analysis, analysisEntry.AnalyzedInstanceKey, analysisEntry.GTIDErrant)
+
+	unlock, err := LockShard(analysisEntry.AnalyzedInstanceKey)
 	if err != nil {
-		log.Info("Could not compute master for %+v", analysisEntry.AnalyzedInstanceKey)
+		log.Infof("CheckAndRecover: Analysis: %+v, InstanceKey: %+v, candidateInstanceKey: %+v, "+
+			"skipProcesses: %v: NOT detecting/recovering host, could not obtain shard lock (%v)",
+			analysisEntry.Analysis, analysisEntry.AnalyzedInstanceKey, candidateInstanceKey, skipProcesses, err)
 		return false, topologyRecovery, err
 	}
-	if _, err := inst.MoveBelowGTID(&analysisEntry.AnalyzedInstanceKey, masterKey); err != nil {
+	defer unlock(&err)
+
+	AuditTopologyRecovery(topologyRecovery, fmt.Sprintf("- recoverErrantGTIDDetected: errant GTIDs on %+v: %s", analysisEntry.AnalyzedInstanceKey, analysisEntry.GTIDErrant))
+	_, err = inst.ChangeTabletType(analysisEntry.AnalyzedInstanceKey, topodatapb.TabletType_DRAINED)
+	AuditTopologyRecovery(topologyRecovery, fmt.Sprintf("- recoverErrantGTIDDetected: change tablet type of %+v to DRAINED: success=%t", analysisEntry.AnalyzedInstanceKey, (err == nil)))
+	if err != nil {
 		return false, topologyRecovery, err
 	}
 	return true, topologyRecovery, nil
@@ -652,6 +652,14 @@ func ReadCompletedRecoveries(page int) ([]*TopologyRecovery, error) {
 	return readRecoveries(`where end_recovery is not null`, limit, sqlutils.Args(config.AuditPageSize, page*config.AuditPageSize))
 }
 
+// ReadInstanceRecoveries reads recovery entry/audit entries of a given instance from topology_recovery
+func ReadInstanceRecoveries(instanceKey *inst.InstanceKey, page int) ([]*TopologyRecovery, error) {
+	limit := `
+		limit ?
+		offset ?`
+	return readRecoveries(`where hostname=? and port=?`, limit, sqlutils.Args(instanceKey.Hostname, instanceKey.Port, config.AuditPageSize, page*config.AuditPageSize))
+}
+
 // ReadRecovery reads completed recovery entry/audit entries from topology_recovery
 func ReadRecovery(recoveryId int64) ([]*TopologyRecovery, error) {
 	whereClause := `where recovery_id = ?`
@@ -0,0 +1,328 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logic
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/orchestrator/config"
+	"vitess.io/vitess/go/vt/orchestrator/db"
+	"vitess.io/vitess/go/vt/orchestrator/external/golib/log"
+	"vitess.io/vitess/go/vt/orchestrator/inst"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const recoveryTestTMClientProtocol = "orchestrator.test"
+
+// recoveryTestTMC is the tablet manager client of the current test.
+var recoveryTestTMC *recoveryTestTMClient
+
+func init() {
+	config.Config.HostnameResolveMethod = "none"
+	config.MarkConfigurationLoaded()
+	log.SetLevel(log.ERROR)
+
+	tmclient.RegisterTabletManagerClientFactory(recoveryTestTMClientProtocol, func() tmclient.TabletManagerClient {
+		return recoveryTestTMC
+	})
+}
+
+// recoveryTestTMClient records the tablet manager RPCs of the recoveries.
+// ChangeType also changes the type of the tablet in the topo.
+type recoveryTestTMClient struct {
+	tmclient.TabletManagerClient
+
+	ts    *topo.Server
+	calls []string
+}
+
+func (fake *recoveryTestTMClient) SetReadOnly(ctx context.Context, tablet *topodatapb.Tablet) error {
+	fake.calls = append(fake.calls, fmt.Sprintf("SetReadOnly %v", topoproto.TabletAliasString(tablet.Alias)))
+	return nil
+}
+
+func (fake *recoveryTestTMClient) SetMaster(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, timeCreatedNS int64, waitPosition string, forceStartReplication bool) error {
+	fake.calls = append(fake.calls, fmt.Sprintf("SetMaster %v %v", topoproto.TabletAliasString(tablet.Alias), topoproto.TabletAliasString(parent)))
+	return nil
+}
+
+func (fake *recoveryTestTMClient) StartReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
+	fake.calls = append(fake.calls, fmt.Sprintf("StartReplication %v", topoproto.TabletAliasString(tablet.Alias)))
+	return nil
+}
+
+func (fake *recoveryTestTMClient) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, tabletType topodatapb.TabletType) error {
+	fake.calls = append(fake.calls, fmt.Sprintf("ChangeType %v %v", topoproto.TabletAliasString(tablet.Alias), tabletType))
+	_, err := fake.ts.UpdateTabletFields(ctx, tablet.Alias, func(t *topodatapb.Tablet) error {
+		t.Type = tabletType
+		return nil
+	})
+	return err
+}
+
+var (
+	recoveryTestMasterKey  = inst.InstanceKey{Hostname: "localhost", Port: 100}
+	recoveryTestReplicaKey = inst.InstanceKey{Hostname: "localhost", Port: 101}
+)
+
+// setupRecoveryTest gives orchestrator a new sqlite backend database and a
+// topo with shard ks/0, whose master is zone1-0000000100 on localhost:100
+// and which has a replica zone1-0000000101 on localhost:101. It returns the
+// fake tablet manager client the recoveries use.
+func setupRecoveryTest(t *testing.T) *recoveryTestTMClient {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "orchestrator")
+	require.NoError(t, err)
+
+	oldBackendDB, oldDataFile, oldTS, oldProtocol := config.Config.BackendDB, config.Config.SQLite3DataFile, ts, *tmclient.TabletManagerProtocol
+	t.Cleanup(func() {
+		config.Config.BackendDB, config.Config.SQLite3DataFile, ts, *tmclient.TabletManagerProtocol = oldBackendDB, oldDataFile, oldTS, oldProtocol
+		inst.TopoServ = oldTS
+		os.RemoveAll(dir)
+	})
+	config.Config.BackendDB = "sqlite3"
+	config.Config.SQLite3DataFile = path.Join(dir, "orchestrator.db")
+	_, err = db.OpenOrchestrator()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	ts = memorytopo.NewServer("zone1")
+	inst.TopoServ = ts
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, "ks", "0"))
+	for _, tablet := range []*topodatapb.Tablet{
+		{
+			Alias:         &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+			MysqlHostname: recoveryTestMasterKey.Hostname,
+			MysqlPort:     int32(recoveryTestMasterKey.Port),
+			Keyspace:      "ks",
+			Shard:         "0",
+			Type:          topodatapb.TabletType_MASTER,
+		},
+		{
+			Alias:         &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+			MysqlHostname: recoveryTestReplicaKey.Hostname,
+			MysqlPort:     int32(recoveryTestReplicaKey.Port),
+			Keyspace:      "ks",
+			Shard:         "0",
+			Type:          topodatapb.TabletType_REPLICA,
+		},
+	} {
+		require.NoError(t, ts.CreateTablet(ctx, tablet))
+		require.NoError(t, inst.SaveTablet(tablet))
+	}
+	_, err = ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}
+		return nil
+	})
+	require.NoError(t, err)
+
+	recoveryTestTMC = &recoveryTestTMClient{ts: ts}
+	*tmclient.TabletManagerProtocol = recoveryTestTMClientProtocol
+
+	return recoveryTestTMC
+}
+
+func recoveryTestAnalysis(analysisCode inst.AnalysisCode) inst.ReplicationAnalysis {
+	return inst.ReplicationAnalysis{
+		Analysis:            analysisCode,
+		AnalyzedInstanceKey: recoveryTestReplicaKey,
+		ClusterDetails:      inst.ClusterInfo{ClusterName: recoveryTestMasterKey.StringCode()},
+	}
+}
+
+// recoveryTestSteps returns the audited steps of a recovery.
+func recoveryTestSteps(t *testing.T, topologyRecovery *TopologyRecovery) []string {
+	t.Helper()
+
+	steps, err := ReadTopologyRecoverySteps(topologyRecovery.UID)
+	require.NoError(t, err)
+
+	var messages []string
+	for _, step := range steps {
+		messages = append(messages, step.Message)
+	}
+
+	return messages
+}
+
+func TestGetCheckAndRecoverFunction(t *testing.T) {
+	tests := []struct {
+		analysisCode inst.AnalysisCode
+		expected     interface{}
+		actionable   bool
+	}{
+		{inst.ErrantGTIDDetected, recoverErrantGTIDDetected, true},
+		{inst.NotConnectedToMaster, fixReplica, false},
+		{inst.ConnectedToWrongMaster, fixReplica, false},
+		{inst.ReplicationStopped, fixReplica, false},
+		{inst.ReplicaIsWritable, fixReplica, false},
+		{inst.ReplicaSemiSyncMustBeSet, fixReplica, false},
+		{inst.ReplicaSemiSyncMustNotBeSet, fixReplica, false},
+		{inst.MasterIsReadOnly, fixMaster, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.analysisCode), func(t *testing.T) {
+			f, actionable := getCheckAndRecoverFunction(tt.analysisCode, &recoveryTestReplicaKey)
+			require.NotNil(t, f)
+			assert.Equal(t, reflect.ValueOf(tt.expected).Pointer(), reflect.ValueOf(f).Pointer())
+			assert.Equal(t, tt.actionable, actionable)
+		})
+	}
+}
+
+func TestFixReplica(t *testing.T) {
+	tests := []struct {
+		analysisCode inst.AnalysisCode
+		lastSQLError string
+		attempted    bool
+		calls        []string
+	}{
+		{
+			analysisCode: inst.ConnectedToWrongMaster,
+			attempted:    true,
+			calls:        []string{"SetReadOnly zone1-0000000101", "SetMaster zone1-0000000101 zone1-0000000100"},
+		},
+		{
+			analysisCode: inst.NotConnectedToMaster,
+			attempted:    true,
+			calls:        []string{"SetReadOnly zone1-0000000101", "SetMaster zone1-0000000101 zone1-0000000100"},
+		},
+		{
+			analysisCode: inst.ReplicaIsWritable,
+			attempted:    true,
+			calls:        []string{"SetReadOnly zone1-0000000101", "SetMaster zone1-0000000101 zone1-0000000100"},
+		},
+		{
+			analysisCode: inst.ReplicaSemiSyncMustBeSet,
+			attempted:    true,
+			calls:        []string{"SetReadOnly zone1-0000000101", "SetMaster zone1-0000000101 zone1-0000000100"},
+		},
+		{
+			analysisCode: inst.ReplicationStopped,
+			attempted:    true,
+			calls:        []string{"SetReadOnly zone1-0000000101", "StartReplication zone1-0000000101"},
+		},
+		{
+			analysisCode: inst.ReplicationStopped,
+			lastSQLError: "Error 'Duplicate entry' on query",
+			attempted:    false,
+			calls:        []string{"SetReadOnly zone1-0000000101"},
+		},
+	}
+
+	for _, tt := range tests {
+		name := string(tt.analysisCode)
+		if tt.lastSQLError != "" {
+			name += " with SQL error"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			tmc := setupRecoveryTest(t)
+			require.NoError(t, inst.WriteInstance(&inst.Instance{
+				Key:          recoveryTestReplicaKey,
+				MasterKey:    recoveryTestMasterKey,
+				Version:      "5.7.30",
+				LastSQLError: tt.lastSQLError,
+			}, true, nil))
+
+			attempted, topologyRecovery, err := fixReplica(recoveryTestAnalysis(tt.analysisCode), nil, false, false)
+			require.NoError(t, err)
+			require.NotNil(t, topologyRecovery)
+			assert.Equal(t, tt.attempted, attempted)
+			assert.Equal(t, tt.calls, tmc.calls)
+
+			steps := recoveryTestSteps(t, topologyRecovery)
+			require.Len(t, steps, 1)
+			if tt.lastSQLError != "" {
+				assert.Contains(t, steps[0], "will not restart replication")
+			} else {
+				assert.Contains(t, steps[0], "success=true")
+			}
+		})
+	}
+}
+
+func TestRecoverErrantGTIDDetected(t *testing.T) {
+	tmc := setupRecoveryTest(t)
+
+	analysisEntry := recoveryTestAnalysis(inst.ErrantGTIDDetected)
+	analysisEntry.GTIDErrant = "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-3"
+	attempted, topologyRecovery, err := recoverErrantGTIDDetected(analysisEntry, nil, false, false)
+	require.NoError(t, err)
+	require.NotNil(t, topologyRecovery)
+	assert.True(t, attempted)
+	assert.Equal(t, []string{"ChangeType zone1-0000000101 DRAINED"}, tmc.calls)
+
+	// The tablet record is refreshed, and the recovery is audited.
+	tablet, err := inst.ReadTablet(recoveryTestReplicaKey)
+	require.NoError(t, err)
+	assert.Equal(t, topodatapb.TabletType_DRAINED, tablet.Type)
+	steps := recoveryTestSteps(t, topologyRecovery)
+	require.Len(t, steps, 2)
+	assert.Contains(t, steps[0], analysisEntry.GTIDErrant)
+	assert.Contains(t, steps[1], "DRAINED: success=true")
+
+	// Another recovery of the cluster is blocked while this one is active.
+	attempted, topologyRecovery, _ = recoverErrantGTIDDetected(analysisEntry, nil, false, false)
+	assert.False(t, attempted)
+	assert.Nil(t, topologyRecovery)
+	assert.Len(t, tmc.calls, 1)
+}
+
+func TestReadInstanceRecoveries(t *testing.T) {
+	setupRecoveryTest(t)
+
+	replicaAnalysis := recoveryTestAnalysis(inst.ErrantGTIDDetected)
+	_, err := AttemptRecoveryRegistration(&replicaAnalysis, false, false)
+	require.NoError(t, err)
+	masterAnalysis := recoveryTestAnalysis(inst.MasterIsReadOnly)
+	masterAnalysis.AnalyzedInstanceKey = recoveryTestMasterKey
+	_, err = AttemptRecoveryRegistration(&masterAnalysis, false, false)
+	require.NoError(t, err)
+
+	recoveries, err := ReadInstanceRecoveries(&recoveryTestReplicaKey, 0)
+	require.NoError(t, err)
+	require.Len(t, recoveries, 1)
+	assert.Equal(t, recoveryTestReplicaKey, recoveries[0].AnalysisEntry.AnalyzedInstanceKey)
+	assert.Equal(t, inst.ErrantGTIDDetected, recoveries[0].AnalysisEntry.Analysis)
+
+	recoveries, err = ReadInstanceRecoveries(&recoveryTestMasterKey, 0)
+	require.NoError(t, err)
+	require.Len(t, recoveries, 1)
+	assert.Equal(t, inst.MasterIsReadOnly, recoveries[0].AnalysisEntry.Analysis)
+
+	recoveries, err = ReadInstanceRecoveries(&recoveryTestReplicaKey, 1)
+	require.NoError(t, err)
+	assert.Empty(t, recoveries)
+}
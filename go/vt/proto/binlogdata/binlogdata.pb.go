@@ -34,6 +34,10 @@ const (
 	OnDDLAction_STOP        OnDDLAction = 1
 	OnDDLAction_EXEC        OnDDLAction = 2
 	OnDDLAction_EXEC_IGNORE OnDDLAction = 3
+	// EXEC_ADDITIVE applies additive DDLs like ADD COLUMN or ADD INDEX
+	// and rebuilds the table plans. Any other DDL stops the stream
+	// until an operator applies it and restarts the workflow.
+	OnDDLAction_EXEC_ADDITIVE OnDDLAction = 4
 )
 
 var OnDDLAction_name = map[int32]string{
@@ -41,13 +45,15 @@ var OnDDLAction_name = map[int32]string{
 	1: "STOP",
 	2: "EXEC",
 	3: "EXEC_IGNORE",
+	4: "EXEC_ADDITIVE",
 }
 
 var OnDDLAction_value = map[string]int32{
-	"IGNORE":      0,
-	"STOP":        1,
-	"EXEC":        2,
-	"EXEC_IGNORE": 3,
+	"IGNORE":        0,
+	"STOP":          1,
+	"EXEC":          2,
+	"EXEC_IGNORE":   3,
+	"EXEC_ADDITIVE": 4,
 }
 
 func (x OnDDLAction) String() string {
@@ -58,6 +64,42 @@ func (OnDDLAction) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_5fd02bcb2e350dad, []int{0}
 }
 
+// OnConflictAction lists the possible actions when a replicated
+// change conflicts with the current row on the target.
+type OnConflictAction int32
+
+const (
+	// OVERWRITE applies all changes without checking for conflicts.
+	OnConflictAction_OVERWRITE OnConflictAction = 0
+	// LAST_WRITER_WINS applies a change only if the value of the
+	// conflict column of the target row is not newer than that
+	// of the change.
+	OnConflictAction_LAST_WRITER_WINS OnConflictAction = 1
+	// REJECT skips and logs changes whose before image does not
+	// match the target row.
+	OnConflictAction_REJECT OnConflictAction = 2
+)
+
+var OnConflictAction_name = map[int32]string{
+	0: "OVERWRITE",
+	1: "LAST_WRITER_WINS",
+	2: "REJECT",
+}
+
+var OnConflictAction_value = map[string]int32{
+	"OVERWRITE":        0,
+	"LAST_WRITER_WINS": 1,
+	"REJECT":           2,
+}
+
+func (x OnConflictAction) String() string {
+	return proto.EnumName(OnConflictAction_name, int32(x))
+}
+
+func (OnConflictAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5fd02bcb2e350dad, []int{1}
+}
+
 // VEventType enumerates the event types. Many of these types
 // will not be encountered in RBR mode.
 type VEventType int32
@@ -144,7 +186,7 @@ func (x VEventType) String() string {
 }
 
 func (VEventType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{1}
+	return fileDescriptor_5fd02bcb2e350dad, []int{2}
 }
 
 // MigrationType specifies the type of migration for the Journal.
@@ -170,7 +212,7 @@ func (x MigrationType) String() string {
 }
 
 func (MigrationType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_5fd02bcb2e350dad, []int{2}
+	return fileDescriptor_5fd02bcb2e350dad, []int{3}
 }
 
 type BinlogTransaction_Statement_Category int32
@@ -757,10 +799,16 @@ type Filter struct {
 	// If the value is ERR_ON_MISMATCH (default), then it errors out.
 	// If it's BEST_EFFORT, it sends a field event with fake column
 	// names as "@1", "@2", etc.
-	FieldEventMode       Filter_FieldEventMode `protobuf:"varint,2,opt,name=fieldEventMode,proto3,enum=binlogdata.Filter_FieldEventMode" json:"fieldEventMode,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	FieldEventMode Filter_FieldEventMode `protobuf:"varint,2,opt,name=fieldEventMode,proto3,enum=binlogdata.Filter_FieldEventMode" json:"fieldEventMode,omitempty"`
+	// ExcludeVreplicationWorkflow makes the streamer skip the rows of
+	// transactions that were applied on the source by the streams of the
+	// named vreplication workflow. Such transactions start with a write to
+	// their stream's row in the _vt.vreplication table. This is used by
+	// bi-directional workflows to prevent replication loops.
+	ExcludeVreplicationWorkflow string   `protobuf:"bytes,3,opt,name=exclude_vreplication_workflow,json=excludeVreplicationWorkflow,proto3" json:"exclude_vreplication_workflow,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
 }
 
 func (m *Filter) Reset()         { *m = Filter{} }
@@ -810,6 +858,13 @@ func (m *Filter) GetFieldEventMode() Filter_FieldEventMode {
 	return Filter_ERR_ON_MISMATCH
 }
 
+func (m *Filter) GetExcludeVreplicationWorkflow() string {
+	if m != nil {
+		return m.ExcludeVreplicationWorkflow
+	}
+	return ""
+}
+
 // BinlogSource specifies the source  and filter parameters for
 // Filtered Replication. KeyRange and Tables are legacy. Filter
 // is the new way to specify the filtering rules.
@@ -837,7 +892,14 @@ type BinlogSource struct {
 	StopAfterCopy bool `protobuf:"varint,9,opt,name=stop_after_copy,json=stopAfterCopy,proto3" json:"stop_after_copy,omitempty"`
 	// ExternalCluster is the name of the mounted cluster which has the source keyspace/db for this workflow
 	// it is of the type <cluster_type.cluster_name>
-	ExternalCluster      string   `protobuf:"bytes,10,opt,name=external_cluster,json=externalCluster,proto3" json:"external_cluster,omitempty"`
+	ExternalCluster string `protobuf:"bytes,10,opt,name=external_cluster,json=externalCluster,proto3" json:"external_cluster,omitempty"`
+	// TableOnDdl overrides OnDdl for DDLs on specific target tables.
+	TableOnDdl map[string]OnDDLAction `protobuf:"bytes,11,rep,name=table_on_ddl,json=tableOnDdl,proto3" json:"table_on_ddl,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=binlogdata.OnDDLAction"`
+	// OnConflict specifies how conflicting changes are resolved.
+	OnConflict OnConflictAction `protobuf:"varint,12,opt,name=on_conflict,json=onConflict,proto3,enum=binlogdata.OnConflictAction" json:"on_conflict,omitempty"`
+	// ConflictColumn is the target column, usually a timestamp, that's
+	// compared by the LAST_WRITER_WINS action.
+	ConflictColumn       string   `protobuf:"bytes,13,opt,name=conflict_column,json=conflictColumn,proto3" json:"conflict_column,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -946,6 +1008,27 @@ func (m *BinlogSource) GetExternalCluster() string {
 	return ""
 }
 
+func (m *BinlogSource) GetTableOnDdl() map[string]OnDDLAction {
+	if m != nil {
+		return m.TableOnDdl
+	}
+	return nil
+}
+
+func (m *BinlogSource) GetOnConflict() OnConflictAction {
+	if m != nil {
+		return m.OnConflict
+	}
+	return OnConflictAction_OVERWRITE
+}
+
+func (m *BinlogSource) GetConflictColumn() string {
+	if m != nil {
+		return m.ConflictColumn
+	}
+	return ""
+}
+
 // RowChange represents one row change.
 // If Before is set and not After, it's a delete.
 // If After is set and not Before, it's an insert.
@@ -2215,6 +2298,7 @@ func (m *VStreamResultsResponse) GetRows() []*query.Row {
 
 func init() {
 	proto.RegisterEnum("binlogdata.OnDDLAction", OnDDLAction_name, OnDDLAction_value)
+	proto.RegisterEnum("binlogdata.OnConflictAction", OnConflictAction_name, OnConflictAction_value)
 	proto.RegisterEnum("binlogdata.VEventType", VEventType_name, VEventType_value)
 	proto.RegisterEnum("binlogdata.MigrationType", MigrationType_name, MigrationType_value)
 	proto.RegisterEnum("binlogdata.BinlogTransaction_Statement_Category", BinlogTransaction_Statement_Category_name, BinlogTransaction_Statement_Category_value)
@@ -2229,6 +2313,7 @@ func init() {
 	proto.RegisterType((*Rule)(nil), "binlogdata.Rule")
 	proto.RegisterType((*Filter)(nil), "binlogdata.Filter")
 	proto.RegisterType((*BinlogSource)(nil), "binlogdata.BinlogSource")
+	proto.RegisterMapType((map[string]OnDDLAction)(nil), "binlogdata.BinlogSource.TableOnDdlEntry")
 	proto.RegisterType((*RowChange)(nil), "binlogdata.RowChange")
 	proto.RegisterType((*RowEvent)(nil), "binlogdata.RowEvent")
 	proto.RegisterType((*FieldEvent)(nil), "binlogdata.FieldEvent")
@@ -2252,130 +2337,141 @@ func init() {
 func init() { proto.RegisterFile("binlogdata.proto", fileDescriptor_5fd02bcb2e350dad) }
 
 var fileDescriptor_5fd02bcb2e350dad = []byte{
-	// 1955 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x6f, 0x23, 0x59,
-	0x15, 0xee, 0xf2, 0xdb, 0xa7, 0x1c, 0xa7, 0x72, 0xf3, 0xc0, 0xb4, 0x66, 0xa2, 0x4c, 0x89, 0x99,
-	0x0e, 0x91, 0x70, 0x06, 0xc3, 0x34, 0x42, 0x62, 0x66, 0xf0, 0xa3, 0x3a, 0xed, 0x8e, 0x1f, 0xe9,
-	0xeb, 0xea, 0xf4, 0x68, 0x36, 0xa5, 0x4a, 0xf9, 0x26, 0x29, 0x52, 0x76, 0xb9, 0xab, 0xae, 0x93,
-	0xf1, 0x0f, 0x40, 0x62, 0x8f, 0x84, 0xf8, 0x0b, 0xac, 0x59, 0x02, 0x5b, 0x60, 0xc9, 0x0f, 0x60,
-	0x81, 0x1a, 0xf1, 0x23, 0xd8, 0xa1, 0xfb, 0xa8, 0x57, 0x7a, 0xa6, 0x93, 0x1e, 0x89, 0x05, 0x6c,
-	0xac, 0x7b, 0xcf, 0x3d, 0xe7, 0xdc, 0xf3, 0xfa, 0x4e, 0x1d, 0x5f, 0xd0, 0xce, 0xdc, 0xb9, 0xe7,
-	0x5f, 0x4c, 0x6d, 0x6a, 0x37, 0x17, 0x81, 0x4f, 0x7d, 0x04, 0x09, 0xe5, 0xa1, 0x7a, 0x4d, 0x83,
-	0x85, 0x23, 0x0e, 0x1e, 0xaa, 0xaf, 0x96, 0x24, 0x58, 0xc9, 0x4d, 0x9d, 0xfa, 0x0b, 0x3f, 0x91,
-	0xd2, 0x87, 0x50, 0xee, 0x5e, 0xda, 0x41, 0x48, 0x28, 0xda, 0x81, 0x92, 0xe3, 0xb9, 0x64, 0x4e,
-	0x1b, 0xca, 0x9e, 0xb2, 0x5f, 0xc4, 0x72, 0x87, 0x10, 0x14, 0x1c, 0x7f, 0x3e, 0x6f, 0xe4, 0x38,
-	0x95, 0xaf, 0x19, 0x6f, 0x48, 0x82, 0x6b, 0x12, 0x34, 0xf2, 0x82, 0x57, 0xec, 0xf4, 0x7f, 0xe5,
-	0x61, 0xa3, 0xc3, 0xed, 0x30, 0x03, 0x7b, 0x1e, 0xda, 0x0e, 0x75, 0xfd, 0x39, 0x3a, 0x02, 0x08,
-	0xa9, 0x4d, 0xc9, 0x8c, 0xcc, 0x69, 0xd8, 0x50, 0xf6, 0xf2, 0xfb, 0x6a, 0xeb, 0x51, 0x33, 0xe5,
-	0xc1, 0x1b, 0x22, 0xcd, 0x49, 0xc4, 0x8f, 0x53, 0xa2, 0xa8, 0x05, 0x2a, 0xb9, 0x26, 0x73, 0x6a,
-	0x51, 0xff, 0x8a, 0xcc, 0x1b, 0x85, 0x3d, 0x65, 0x5f, 0x6d, 0x6d, 0x34, 0x85, 0x83, 0x06, 0x3b,
-	0x31, 0xd9, 0x01, 0x06, 0x12, 0xaf, 0x1f, 0xfe, 0x39, 0x07, 0xd5, 0x58, 0x1b, 0x1a, 0x40, 0xc5,
-	0xb1, 0x29, 0xb9, 0xf0, 0x83, 0x15, 0x77, 0xb3, 0xde, 0xfa, 0xf8, 0x9e, 0x86, 0x34, 0xbb, 0x52,
-	0x0e, 0xc7, 0x1a, 0xd0, 0x0f, 0xa0, 0xec, 0x88, 0xe8, 0xf1, 0xe8, 0xa8, 0xad, 0xcd, 0xb4, 0x32,
-	0x19, 0x58, 0x1c, 0xf1, 0x20, 0x0d, 0xf2, 0xe1, 0x2b, 0x8f, 0x87, 0xac, 0x86, 0xd9, 0x52, 0xff,
-	0x9d, 0x02, 0x95, 0x48, 0x2f, 0xda, 0x84, 0xf5, 0xce, 0xc0, 0x7a, 0x31, 0xc2, 0x46, 0x77, 0x7c,
-	0x34, 0xea, 0x7f, 0x69, 0xf4, 0xb4, 0x07, 0xa8, 0x06, 0x95, 0xce, 0xc0, 0xea, 0x18, 0x47, 0xfd,
-	0x91, 0xa6, 0xa0, 0x35, 0xa8, 0x76, 0x06, 0x56, 0x77, 0x3c, 0x1c, 0xf6, 0x4d, 0x2d, 0x87, 0xd6,
-	0x41, 0xed, 0x0c, 0x2c, 0x3c, 0x1e, 0x0c, 0x3a, 0xed, 0xee, 0xb1, 0x96, 0x47, 0xdb, 0xb0, 0xd1,
-	0x19, 0x58, 0xbd, 0xe1, 0xc0, 0xea, 0x19, 0x27, 0xd8, 0xe8, 0xb6, 0x4d, 0xa3, 0xa7, 0x15, 0x10,
-	0x40, 0x89, 0x91, 0x7b, 0x03, 0xad, 0x28, 0xd7, 0x13, 0xc3, 0xd4, 0x4a, 0x52, 0x5d, 0x7f, 0x34,
-	0x31, 0xb0, 0xa9, 0x95, 0xe5, 0xf6, 0xc5, 0x49, 0xaf, 0x6d, 0x1a, 0x5a, 0x45, 0x6e, 0x7b, 0xc6,
-	0xc0, 0x30, 0x0d, 0xad, 0xfa, 0xac, 0x50, 0xc9, 0x69, 0xf9, 0x67, 0x85, 0x4a, 0x5e, 0x2b, 0xe8,
-	0xbf, 0x56, 0x60, 0x7b, 0x42, 0x03, 0x62, 0xcf, 0x8e, 0xc9, 0x0a, 0xdb, 0xf3, 0x0b, 0x82, 0xc9,
-	0xab, 0x25, 0x09, 0x29, 0x7a, 0x08, 0x95, 0x85, 0x1f, 0xba, 0x2c, 0x76, 0x3c, 0xc0, 0x55, 0x1c,
-	0xef, 0xd1, 0x21, 0x54, 0xaf, 0xc8, 0xca, 0x0a, 0x18, 0xbf, 0x0c, 0x18, 0x6a, 0xc6, 0x05, 0x19,
-	0x6b, 0xaa, 0x5c, 0xc9, 0x55, 0x3a, 0xbe, 0xf9, 0xbb, 0xe3, 0xab, 0x9f, 0xc3, 0xce, 0x6d, 0xa3,
-	0xc2, 0x85, 0x3f, 0x0f, 0x09, 0x1a, 0x00, 0x12, 0x82, 0x16, 0x4d, 0x72, 0xcb, 0xed, 0x53, 0x5b,
-	0xef, 0xbf, 0xb5, 0x00, 0xf0, 0xc6, 0xd9, 0x6d, 0x92, 0xfe, 0x15, 0x6c, 0x8a, 0x7b, 0x4c, 0xfb,
-	0xcc, 0x23, 0xe1, 0x7d, 0x5c, 0xdf, 0x81, 0x12, 0xe5, 0xcc, 0x8d, 0xdc, 0x5e, 0x7e, 0xbf, 0x8a,
-	0xe5, 0xee, 0x5d, 0x3d, 0x9c, 0xc2, 0x56, 0xf6, 0xe6, 0xff, 0x8a, 0x7f, 0x3f, 0x86, 0x02, 0x5e,
-	0x7a, 0x04, 0x6d, 0x41, 0x71, 0x66, 0x53, 0xe7, 0x52, 0x7a, 0x23, 0x36, 0xcc, 0x95, 0x73, 0xd7,
-	0xa3, 0x24, 0xe0, 0x29, 0xac, 0x62, 0xb9, 0xd3, 0x7f, 0xaf, 0x40, 0xe9, 0x09, 0x5f, 0xa2, 0x8f,
-	0xa0, 0x18, 0x2c, 0x99, 0xb3, 0x02, 0xeb, 0x5a, 0xda, 0x02, 0xa6, 0x19, 0x8b, 0x63, 0xd4, 0x87,
-	0xfa, 0xb9, 0x4b, 0xbc, 0x29, 0x87, 0xee, 0xd0, 0x9f, 0x8a, 0xaa, 0xa8, 0xb7, 0x3e, 0x48, 0x0b,
-	0x08, 0x9d, 0xcd, 0x27, 0x19, 0x46, 0x7c, 0x4b, 0x50, 0x7f, 0x0c, 0xf5, 0x2c, 0x07, 0x83, 0x93,
-	0x81, 0xb1, 0x35, 0x1e, 0x59, 0xc3, 0xfe, 0x64, 0xd8, 0x36, 0xbb, 0x4f, 0xb5, 0x07, 0x1c, 0x31,
-	0xc6, 0xc4, 0xb4, 0x8c, 0x27, 0x4f, 0xc6, 0xd8, 0xd4, 0x14, 0xfd, 0x37, 0x79, 0xa8, 0x89, 0xa0,
-	0x4c, 0xfc, 0x65, 0xe0, 0x10, 0x96, 0xc5, 0x2b, 0xb2, 0x0a, 0x17, 0xb6, 0x43, 0xa2, 0x2c, 0x46,
-	0x7b, 0x16, 0x90, 0xf0, 0xd2, 0x0e, 0xa6, 0xd2, 0x73, 0xb1, 0x41, 0x9f, 0x80, 0xca, 0xb3, 0x49,
-	0x2d, 0xba, 0x5a, 0x10, 0x9e, 0xc7, 0x7a, 0x6b, 0x2b, 0x29, 0x6c, 0x9e, 0x2b, 0x6a, 0xae, 0x16,
-	0x04, 0x03, 0x8d, 0xd7, 0x59, 0x34, 0x14, 0xee, 0x81, 0x86, 0xa4, 0x86, 0x8a, 0x99, 0x1a, 0x3a,
-	0x88, 0x13, 0x52, 0x92, 0x5a, 0xde, 0x88, 0x5e, 0x94, 0x24, 0xd4, 0x84, 0x92, 0x3f, 0xb7, 0xa6,
-	0x53, 0xaf, 0x51, 0xe6, 0x66, 0x7e, 0x27, 0xcd, 0x3b, 0x9e, 0xf7, 0x7a, 0x83, 0xb6, 0x28, 0x8b,
-	0xa2, 0x3f, 0xef, 0x4d, 0x3d, 0xf4, 0x21, 0xd4, 0xc9, 0x57, 0x94, 0x04, 0x73, 0xdb, 0xb3, 0x66,
-	0x2b, 0xd6, 0xbd, 0x2a, 0xdc, 0xf5, 0xb5, 0x88, 0x3a, 0x64, 0x44, 0xf4, 0x11, 0xac, 0x87, 0xd4,
-	0x5f, 0x58, 0xf6, 0x39, 0x25, 0x81, 0xe5, 0xf8, 0x8b, 0x55, 0xa3, 0xba, 0xa7, 0xec, 0x57, 0xf0,
-	0x1a, 0x23, 0xb7, 0x19, 0xb5, 0xeb, 0x2f, 0x56, 0xe8, 0xfb, 0xa0, 0xc5, 0xea, 0x1c, 0x6f, 0x19,
-	0x32, 0xa3, 0x81, 0x2b, 0x5c, 0x8f, 0xe8, 0x5d, 0x41, 0xd6, 0x9f, 0x43, 0x15, 0xfb, 0x37, 0xdd,
-	0x4b, 0xee, 0xba, 0x0e, 0xa5, 0x33, 0x72, 0xee, 0x07, 0x44, 0xd6, 0x34, 0xc8, 0x9e, 0x8f, 0xfd,
-	0x1b, 0x2c, 0x4f, 0xd0, 0x1e, 0x14, 0xf9, 0xf5, 0xb2, 0xb3, 0xa4, 0x59, 0xc4, 0x81, 0x6e, 0x43,
-	0x05, 0xfb, 0x37, 0xbc, 0x42, 0xd0, 0xfb, 0x20, 0x72, 0x61, 0xcd, 0xed, 0x59, 0x94, 0xe8, 0x2a,
-	0xa7, 0x8c, 0xec, 0x19, 0x41, 0x8f, 0x41, 0x0d, 0xfc, 0x1b, 0xcb, 0xe1, 0xd7, 0x0b, 0xd0, 0xaa,
-	0xad, 0xed, 0x4c, 0x1d, 0x47, 0xc6, 0x61, 0x08, 0xa2, 0x65, 0xa8, 0x3f, 0x07, 0x48, 0xca, 0xf0,
-	0xae, 0x4b, 0xbe, 0xc7, 0x12, 0x47, 0xbc, 0x69, 0xa4, 0xbf, 0x26, 0x4d, 0xe6, 0x1a, 0xb0, 0x3c,
-	0xd3, 0x7f, 0xa5, 0x40, 0x75, 0xc2, 0x0a, 0xed, 0x88, 0xba, 0xd3, 0x6f, 0x51, 0x9e, 0x08, 0x0a,
-	0x17, 0xd4, 0x9d, 0xf2, 0xba, 0xac, 0x62, 0xbe, 0x46, 0x9f, 0x44, 0x86, 0x2d, 0xac, 0xab, 0xb0,
-	0x51, 0xe0, 0xb7, 0x67, 0x4a, 0x81, 0xd7, 0xec, 0xc0, 0x0e, 0xe9, 0xc9, 0x31, 0xae, 0x70, 0xd6,
-	0x93, 0xe3, 0x50, 0xff, 0x1c, 0x8a, 0xa7, 0xdc, 0x8a, 0xc7, 0xa0, 0x72, 0xe5, 0x16, 0xd3, 0x16,
-	0xc1, 0x3c, 0x13, 0x9e, 0xd8, 0x62, 0x0c, 0x61, 0xb4, 0x0c, 0xf5, 0x36, 0xac, 0x1d, 0x4b, 0x6b,
-	0x39, 0xc3, 0xbb, 0xbb, 0xa3, 0xff, 0x31, 0x07, 0xe5, 0x67, 0xfe, 0x92, 0x95, 0x0a, 0xaa, 0x43,
-	0xce, 0x9d, 0x72, 0xb9, 0x3c, 0xce, 0xb9, 0x53, 0xf4, 0x73, 0xa8, 0xcf, 0xdc, 0x8b, 0xc0, 0x66,
-	0x15, 0x2c, 0xc0, 0x28, 0xfa, 0xc9, 0x77, 0xd3, 0x96, 0x0d, 0x23, 0x0e, 0x8e, 0xc8, 0xb5, 0x59,
-	0x7a, 0x9b, 0xc2, 0x58, 0x3e, 0x83, 0xb1, 0x0f, 0xa1, 0xee, 0xf9, 0x8e, 0xed, 0x59, 0x71, 0x87,
-	0x2f, 0x08, 0x1c, 0x70, 0xea, 0x49, 0xd4, 0xe6, 0x6f, 0xc5, 0xa5, 0x78, 0xcf, 0xb8, 0xa0, 0x4f,
-	0xa1, 0xb6, 0xb0, 0x03, 0xea, 0x3a, 0xee, 0xc2, 0x66, 0x33, 0x52, 0x89, 0x0b, 0x66, 0xcc, 0xce,
-	0xc4, 0x0d, 0x67, 0xd8, 0x19, 0xac, 0x42, 0xde, 0xbd, 0xac, 0x1b, 0x3f, 0xb8, 0x3a, 0xf7, 0xfc,
-	0x9b, 0xb0, 0x51, 0xe6, 0xf6, 0xaf, 0x0b, 0xfa, 0xcb, 0x88, 0xac, 0xff, 0x21, 0x0f, 0xa5, 0x53,
-	0x51, 0x9d, 0x07, 0x50, 0xe0, 0x31, 0x12, 0x73, 0xd0, 0x4e, 0xfa, 0x32, 0xc1, 0xc1, 0x03, 0xc4,
-	0x79, 0xd0, 0x7b, 0x50, 0xa5, 0xee, 0x8c, 0x84, 0xd4, 0x9e, 0x2d, 0x78, 0x50, 0xf3, 0x38, 0x21,
-	0x7c, 0x6d, 0x89, 0xbd, 0x07, 0xd5, 0x78, 0x72, 0x93, 0xc1, 0x4a, 0x08, 0xe8, 0x87, 0x50, 0x65,
-	0xf8, 0xe2, 0x73, 0x5a, 0xa3, 0xc8, 0x01, 0xbb, 0x75, 0x0b, 0x5d, 0xdc, 0x04, 0x5c, 0x09, 0x22,
-	0xc4, 0xfe, 0x04, 0x54, 0x8e, 0x08, 0x29, 0x24, 0x7a, 0xdd, 0x4e, 0xb6, 0xd7, 0x45, 0xc8, 0xc3,
-	0x90, 0x7c, 0x1e, 0xd0, 0x23, 0x28, 0x5e, 0x73, 0xf3, 0xca, 0x72, 0x5e, 0x4c, 0x3b, 0xca, 0x53,
-	0x21, 0xce, 0xd9, 0xc7, 0xf8, 0x17, 0xa2, 0xb2, 0x78, 0x97, 0xbb, 0xf5, 0x31, 0x96, 0x45, 0x87,
-	0x23, 0x1e, 0x36, 0xce, 0x4d, 0x67, 0x1e, 0x6f, 0x74, 0x55, 0xcc, 0x96, 0xe8, 0x03, 0xa8, 0x39,
-	0xcb, 0x20, 0xe0, 0x13, 0xaa, 0x3b, 0x23, 0x8d, 0x2d, 0x1e, 0x28, 0x55, 0xd2, 0x4c, 0x77, 0x46,
-	0xd0, 0xcf, 0xa0, 0xee, 0xd9, 0x21, 0x65, 0xc0, 0x93, 0x8e, 0x6c, 0xf3, 0xab, 0x32, 0xe8, 0x13,
-	0xc0, 0x13, 0x9e, 0xa8, 0x5e, 0xb2, 0xd1, 0x2f, 0xa1, 0x36, 0x74, 0xe7, 0xee, 0xcc, 0xf6, 0x38,
-	0x40, 0x59, 0xe0, 0x53, 0xad, 0x85, 0xaf, 0xef, 0xd7, 0x55, 0xd0, 0x2e, 0xa8, 0xcc, 0x04, 0xc7,
-	0xf7, 0x96, 0xb3, 0xb9, 0xa8, 0xf6, 0x3c, 0xae, 0x2e, 0x8e, 0xbb, 0x82, 0xc0, 0x90, 0x2a, 0x6f,
-	0x9a, 0x38, 0x97, 0x64, 0x66, 0xa3, 0x8f, 0x63, 0x64, 0x08, 0xb4, 0x37, 0xb2, 0x98, 0x4a, 0x8c,
-	0x8a, 0x30, 0xa3, 0xff, 0x25, 0x07, 0xf5, 0x53, 0x31, 0xae, 0x44, 0x23, 0xd2, 0xe7, 0xb0, 0x49,
-	0xce, 0xcf, 0x89, 0x43, 0xdd, 0x6b, 0x62, 0x39, 0xb6, 0xe7, 0x91, 0xc0, 0x92, 0x08, 0x56, 0x5b,
-	0xeb, 0x4d, 0xf1, 0xb7, 0xa5, 0xcb, 0xe9, 0xfd, 0x1e, 0xde, 0x88, 0x79, 0x25, 0x69, 0x8a, 0x0c,
-	0xd8, 0x74, 0x67, 0x33, 0x32, 0x75, 0x6d, 0x9a, 0x56, 0x20, 0x5a, 0xfe, 0xb6, 0xf4, 0xf4, 0xd4,
-	0x3c, 0xb2, 0x29, 0x49, 0xd4, 0xc4, 0x12, 0xb1, 0x9a, 0x0f, 0x99, 0x33, 0xc1, 0x45, 0x3c, 0x75,
-	0xad, 0x49, 0x49, 0x93, 0x13, 0xb1, 0x3c, 0xcc, 0x4c, 0x74, 0x85, 0x5b, 0x13, 0x5d, 0xf2, 0xd5,
-	0x2d, 0xde, 0xf9, 0xd5, 0xfd, 0x0c, 0xd6, 0x45, 0xbb, 0x8d, 0x52, 0x1f, 0x21, 0xfc, 0x1b, 0x7b,
-	0x6e, 0x8d, 0x26, 0x9b, 0x50, 0xff, 0x14, 0xd6, 0xe3, 0x40, 0xca, 0x89, 0xef, 0x00, 0x4a, 0xbc,
-	0x7c, 0xa2, 0x74, 0xa0, 0x37, 0xe1, 0x8b, 0x25, 0x87, 0xfe, 0xcb, 0x1c, 0xa0, 0x48, 0xde, 0xbf,
-	0x09, 0xff, 0x47, 0x93, 0xb1, 0x05, 0x45, 0x4e, 0x97, 0x99, 0x10, 0x1b, 0x16, 0x07, 0x16, 0xd4,
-	0xc5, 0x55, 0x9c, 0x06, 0x21, 0xfc, 0x9c, 0xfd, 0x62, 0x12, 0x2e, 0x3d, 0x8a, 0x25, 0x87, 0xfe,
-	0x27, 0x05, 0x36, 0x33, 0x71, 0x90, 0xb1, 0x4c, 0x10, 0xa3, 0xbc, 0x05, 0x31, 0xfb, 0x50, 0x59,
-	0x5c, 0xbd, 0x05, 0x59, 0xf1, 0xe9, 0xd7, 0xb6, 0xc3, 0x5d, 0x28, 0x04, 0xac, 0x2d, 0x8b, 0x6f,
-	0x6d, 0x7a, 0x38, 0xe1, 0x74, 0x36, 0xe1, 0x64, 0xfc, 0xc8, 0x4c, 0x38, 0xd2, 0x7e, 0x17, 0xd4,
-	0x54, 0x67, 0x60, 0xad, 0x24, 0x5b, 0x55, 0x32, 0x75, 0xdf, 0x58, 0x54, 0x6a, 0xaa, 0xa8, 0x58,
-	0x7f, 0x76, 0xfc, 0xd9, 0xc2, 0x23, 0x94, 0x88, 0x94, 0x55, 0x70, 0x42, 0xd0, 0xbf, 0x00, 0x35,
-	0x25, 0x79, 0xd7, 0x20, 0x93, 0x24, 0x21, 0x7f, 0x67, 0x12, 0xfe, 0xae, 0xc0, 0x76, 0x52, 0xcc,
-	0x4b, 0x8f, 0xfe, 0x5f, 0xd5, 0xa3, 0x1e, 0xc0, 0xce, 0x6d, 0xef, 0xde, 0xa9, 0xca, 0xbe, 0x45,
-	0xed, 0x1c, 0x7c, 0x06, 0x6a, 0x6a, 0x74, 0x67, 0xff, 0xf0, 0xfb, 0x47, 0xa3, 0x31, 0x36, 0xb4,
-	0x07, 0xa8, 0x02, 0x85, 0x89, 0x39, 0x3e, 0xd1, 0x14, 0xb6, 0x32, 0xbe, 0x30, 0xba, 0xe2, 0xd5,
-	0x80, 0xad, 0x2c, 0xc9, 0x94, 0x3f, 0xf8, 0xb7, 0x02, 0x90, 0x7c, 0xf1, 0x91, 0x0a, 0xe5, 0x17,
-	0xa3, 0xe3, 0xd1, 0xf8, 0xe5, 0x48, 0x28, 0x38, 0x32, 0xfb, 0x3d, 0x4d, 0x41, 0x55, 0x28, 0x8a,
-	0x67, 0x88, 0x1c, 0xbb, 0x41, 0xbe, 0x41, 0xe4, 0x51, 0x0d, 0x2a, 0xf1, 0x03, 0x44, 0x01, 0x95,
-	0x21, 0x1f, 0x3f, 0x33, 0xc8, 0x77, 0x85, 0x12, 0x53, 0x88, 0x8d, 0x93, 0x41, 0xbb, 0x6b, 0x68,
-	0x65, 0x76, 0x10, 0xbf, 0x30, 0x00, 0x94, 0xa2, 0xe7, 0x05, 0x26, 0x39, 0x31, 0x4c, 0x0d, 0xd8,
-	0x3d, 0x63, 0xf3, 0xa9, 0x81, 0x35, 0x95, 0xd1, 0xf0, 0xf8, 0xa5, 0x56, 0x63, 0xb4, 0x27, 0x7d,
-	0x63, 0xd0, 0xd3, 0xd6, 0xd0, 0x1a, 0x54, 0x9f, 0x1a, 0x6d, 0x6c, 0x76, 0x8c, 0xb6, 0xa9, 0xd5,
-	0xd9, 0xc9, 0x29, 0x37, 0x70, 0x9d, 0x5d, 0xf3, 0x6c, 0xfc, 0x02, 0x8f, 0xda, 0x03, 0x4d, 0x63,
-	0x9b, 0x53, 0x03, 0x4f, 0xfa, 0xe3, 0x91, 0xb6, 0xc1, 0xee, 0x19, 0xb4, 0x27, 0xe6, 0xc9, 0xb1,
-	0x86, 0x98, 0xfc, 0xa4, 0x7d, 0x6a, 0x9c, 0x8c, 0xfb, 0x23, 0x53, 0xdb, 0x3c, 0x78, 0xc4, 0xbe,
-	0x73, 0xe9, 0x09, 0x10, 0xa0, 0x64, 0xb6, 0x3b, 0x03, 0x63, 0xa2, 0x3d, 0x60, 0xeb, 0xc9, 0xd3,
-	0x36, 0xee, 0x4d, 0x34, 0xa5, 0xf3, 0xd3, 0xbf, 0xbe, 0xde, 0x55, 0xfe, 0xf6, 0x7a, 0x57, 0xf9,
-	0xc7, 0xeb, 0x5d, 0xe5, 0xb7, 0xff, 0xdc, 0x7d, 0xf0, 0xe5, 0xa3, 0x6b, 0x97, 0x92, 0x30, 0x6c,
-	0xba, 0xfe, 0xa1, 0x58, 0x1d, 0x5e, 0xf8, 0x87, 0xd7, 0xf4, 0x90, 0xbf, 0xac, 0x1d, 0x26, 0x18,
-	0x3c, 0x2b, 0x71, 0xca, 0x8f, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x22, 0xbd, 0x3f, 0x86, 0xb5,
-	0x13, 0x00, 0x00,
+	// 2136 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x73, 0x1b, 0x59,
+	0x15, 0x4e, 0xeb, 0x65, 0xe9, 0xb4, 0x2c, 0xb5, 0xaf, 0x1f, 0x88, 0x90, 0xb8, 0x3c, 0x5d, 0x64,
+	0x62, 0x5c, 0x35, 0xf6, 0x60, 0x98, 0xf0, 0x28, 0xc2, 0xa0, 0x47, 0xc7, 0x91, 0x2d, 0x4b, 0xce,
+	0x55, 0x47, 0x99, 0x9a, 0x4d, 0x57, 0xa7, 0x75, 0x6d, 0x37, 0x6e, 0x75, 0x2b, 0xdd, 0x57, 0x76,
+	0xf4, 0x03, 0xa8, 0x62, 0xcf, 0x86, 0x35, 0x3b, 0x7e, 0x03, 0xb0, 0x05, 0x96, 0xfc, 0x00, 0x16,
+	0x54, 0x28, 0x7e, 0x03, 0xc5, 0x8e, 0xba, 0x8f, 0x7e, 0xc8, 0x9e, 0x24, 0xce, 0x54, 0xb1, 0x60,
+	0x36, 0xaa, 0x7b, 0xcf, 0x3d, 0xe7, 0xdc, 0xf3, 0xfa, 0x4e, 0x1f, 0x5d, 0xd0, 0x5e, 0xba, 0xbe,
+	0x17, 0x9c, 0x8d, 0x6d, 0x6a, 0xef, 0x4e, 0xc3, 0x80, 0x06, 0x08, 0x52, 0xca, 0x5d, 0xf5, 0x92,
+	0x86, 0x53, 0x47, 0x1c, 0xdc, 0x55, 0x5f, 0xcd, 0x48, 0x38, 0x97, 0x9b, 0x1a, 0x0d, 0xa6, 0x41,
+	0x2a, 0xa5, 0x1f, 0xc3, 0x52, 0xfb, 0xdc, 0x0e, 0x23, 0x42, 0xd1, 0x06, 0x94, 0x1c, 0xcf, 0x25,
+	0x3e, 0x6d, 0x28, 0x5b, 0xca, 0x76, 0x11, 0xcb, 0x1d, 0x42, 0x50, 0x70, 0x02, 0xdf, 0x6f, 0xe4,
+	0x38, 0x95, 0xaf, 0x19, 0x6f, 0x44, 0xc2, 0x4b, 0x12, 0x36, 0xf2, 0x82, 0x57, 0xec, 0xf4, 0x7f,
+	0xe5, 0x61, 0xa5, 0xc5, 0xed, 0x30, 0x43, 0xdb, 0x8f, 0x6c, 0x87, 0xba, 0x81, 0x8f, 0x0e, 0x00,
+	0x22, 0x6a, 0x53, 0x32, 0x21, 0x3e, 0x8d, 0x1a, 0xca, 0x56, 0x7e, 0x5b, 0xdd, 0x7f, 0xb8, 0x9b,
+	0xf1, 0xe0, 0x86, 0xc8, 0xee, 0x30, 0xe6, 0xc7, 0x19, 0x51, 0xb4, 0x0f, 0x2a, 0xb9, 0x24, 0x3e,
+	0xb5, 0x68, 0x70, 0x41, 0xfc, 0x46, 0x61, 0x4b, 0xd9, 0x56, 0xf7, 0x57, 0x76, 0x85, 0x83, 0x06,
+	0x3b, 0x31, 0xd9, 0x01, 0x06, 0x92, 0xac, 0xef, 0xfe, 0x39, 0x07, 0x95, 0x44, 0x1b, 0xea, 0x41,
+	0xd9, 0xb1, 0x29, 0x39, 0x0b, 0xc2, 0x39, 0x77, 0xb3, 0xb6, 0xff, 0xe9, 0x2d, 0x0d, 0xd9, 0x6d,
+	0x4b, 0x39, 0x9c, 0x68, 0x40, 0x9f, 0xc0, 0x92, 0x23, 0xa2, 0xc7, 0xa3, 0xa3, 0xee, 0xaf, 0x66,
+	0x95, 0xc9, 0xc0, 0xe2, 0x98, 0x07, 0x69, 0x90, 0x8f, 0x5e, 0x79, 0x3c, 0x64, 0x55, 0xcc, 0x96,
+	0xfa, 0xef, 0x15, 0x28, 0xc7, 0x7a, 0xd1, 0x2a, 0xd4, 0x5b, 0x3d, 0xeb, 0x79, 0x1f, 0x1b, 0xed,
+	0xc1, 0x41, 0xbf, 0xfb, 0xa5, 0xd1, 0xd1, 0xee, 0xa0, 0x2a, 0x94, 0x5b, 0x3d, 0xab, 0x65, 0x1c,
+	0x74, 0xfb, 0x9a, 0x82, 0x96, 0xa1, 0xd2, 0xea, 0x59, 0xed, 0xc1, 0xf1, 0x71, 0xd7, 0xd4, 0x72,
+	0xa8, 0x0e, 0x6a, 0xab, 0x67, 0xe1, 0x41, 0xaf, 0xd7, 0x6a, 0xb6, 0x8f, 0xb4, 0x3c, 0x5a, 0x87,
+	0x95, 0x56, 0xcf, 0xea, 0x1c, 0xf7, 0xac, 0x8e, 0x71, 0x82, 0x8d, 0x76, 0xd3, 0x34, 0x3a, 0x5a,
+	0x01, 0x01, 0x94, 0x18, 0xb9, 0xd3, 0xd3, 0x8a, 0x72, 0x3d, 0x34, 0x4c, 0xad, 0x24, 0xd5, 0x75,
+	0xfb, 0x43, 0x03, 0x9b, 0xda, 0x92, 0xdc, 0x3e, 0x3f, 0xe9, 0x34, 0x4d, 0x43, 0x2b, 0xcb, 0x6d,
+	0xc7, 0xe8, 0x19, 0xa6, 0xa1, 0x55, 0x0e, 0x0b, 0xe5, 0x9c, 0x96, 0x3f, 0x2c, 0x94, 0xf3, 0x5a,
+	0x41, 0xff, 0x8d, 0x02, 0xeb, 0x43, 0x1a, 0x12, 0x7b, 0x72, 0x44, 0xe6, 0xd8, 0xf6, 0xcf, 0x08,
+	0x26, 0xaf, 0x66, 0x24, 0xa2, 0xe8, 0x2e, 0x94, 0xa7, 0x41, 0xe4, 0xb2, 0xd8, 0xf1, 0x00, 0x57,
+	0x70, 0xb2, 0x47, 0x7b, 0x50, 0xb9, 0x20, 0x73, 0x2b, 0x64, 0xfc, 0x32, 0x60, 0x68, 0x37, 0x29,
+	0xc8, 0x44, 0x53, 0xf9, 0x42, 0xae, 0xb2, 0xf1, 0xcd, 0xbf, 0x3f, 0xbe, 0xfa, 0x29, 0x6c, 0x5c,
+	0x37, 0x2a, 0x9a, 0x06, 0x7e, 0x44, 0x50, 0x0f, 0x90, 0x10, 0xb4, 0x68, 0x9a, 0x5b, 0x6e, 0x9f,
+	0xba, 0x7f, 0xff, 0x9d, 0x05, 0x80, 0x57, 0x5e, 0x5e, 0x27, 0xe9, 0xaf, 0x61, 0x55, 0xdc, 0x63,
+	0xda, 0x2f, 0x3d, 0x12, 0xdd, 0xc6, 0xf5, 0x0d, 0x28, 0x51, 0xce, 0xdc, 0xc8, 0x6d, 0xe5, 0xb7,
+	0x2b, 0x58, 0xee, 0x3e, 0xd4, 0xc3, 0x31, 0xac, 0x2d, 0xde, 0xfc, 0x3f, 0xf1, 0xef, 0x87, 0x50,
+	0xc0, 0x33, 0x8f, 0xa0, 0x35, 0x28, 0x4e, 0x6c, 0xea, 0x9c, 0x4b, 0x6f, 0xc4, 0x86, 0xb9, 0x72,
+	0xea, 0x7a, 0x94, 0x84, 0x3c, 0x85, 0x15, 0x2c, 0x77, 0xfa, 0xbf, 0x15, 0x28, 0x3d, 0xe1, 0x4b,
+	0xf4, 0x31, 0x14, 0xc3, 0x99, 0x47, 0x62, 0xac, 0x6b, 0x59, 0x0b, 0x98, 0x66, 0x2c, 0x8e, 0x51,
+	0x17, 0x6a, 0xa7, 0x2e, 0xf1, 0xc6, 0x1c, 0xba, 0xc7, 0xc1, 0x58, 0x54, 0x45, 0x6d, 0xff, 0xa3,
+	0xac, 0x80, 0xd0, 0xb9, 0xfb, 0x64, 0x81, 0x11, 0x5f, 0x13, 0x44, 0x2d, 0xb8, 0x4f, 0x5e, 0x3b,
+	0xde, 0x6c, 0x4c, 0xac, 0xcb, 0x90, 0x4c, 0x3d, 0xd7, 0xb1, 0x99, 0x2f, 0xd6, 0x55, 0x10, 0x5e,
+	0x9c, 0x7a, 0xc1, 0x15, 0x0f, 0x6f, 0x05, 0x7f, 0x47, 0x32, 0x8d, 0x32, 0x3c, 0x2f, 0x24, 0x8b,
+	0xfe, 0x08, 0x6a, 0x8b, 0xb7, 0x30, 0x48, 0x1a, 0x18, 0x5b, 0x83, 0xbe, 0x75, 0xdc, 0x1d, 0x1e,
+	0x37, 0xcd, 0xf6, 0x53, 0xed, 0x0e, 0x47, 0x9d, 0x31, 0x34, 0x2d, 0xe3, 0xc9, 0x93, 0x01, 0x36,
+	0x35, 0x45, 0xff, 0x5d, 0x11, 0xaa, 0x22, 0xb0, 0xc3, 0x60, 0x16, 0x3a, 0x84, 0x55, 0xc2, 0x05,
+	0x99, 0x47, 0x53, 0xdb, 0x21, 0x71, 0x25, 0xc4, 0x7b, 0x16, 0xd4, 0xe8, 0xdc, 0x0e, 0xc7, 0x32,
+	0x7a, 0x62, 0x83, 0x3e, 0x03, 0x95, 0x57, 0x04, 0xb5, 0xe8, 0x7c, 0x4a, 0xb8, 0xb1, 0xb5, 0xfd,
+	0xb5, 0x14, 0x1c, 0x3c, 0xdf, 0xd4, 0x9c, 0x4f, 0x09, 0x06, 0x9a, 0xac, 0x17, 0x11, 0x55, 0xb8,
+	0x05, 0xa2, 0xd2, 0x3a, 0x2c, 0x2e, 0xd4, 0xe1, 0x4e, 0x92, 0xd4, 0x92, 0xd4, 0x72, 0x23, 0x03,
+	0x71, 0xa2, 0xd1, 0x2e, 0x94, 0x02, 0xdf, 0x1a, 0x8f, 0xbd, 0xc6, 0x12, 0x37, 0xf3, 0x5b, 0x59,
+	0xde, 0x81, 0xdf, 0xe9, 0xf4, 0x9a, 0xa2, 0xb4, 0x8a, 0x81, 0xdf, 0x19, 0x7b, 0xe8, 0x01, 0xd4,
+	0xc8, 0x6b, 0x4a, 0x42, 0xdf, 0xf6, 0xac, 0xc9, 0x9c, 0x75, 0xc0, 0x32, 0x77, 0x7d, 0x39, 0xa6,
+	0x1e, 0x33, 0x22, 0xfa, 0x18, 0xea, 0x11, 0x0d, 0xa6, 0x96, 0x7d, 0x4a, 0x49, 0x68, 0x39, 0xc1,
+	0x74, 0xde, 0xa8, 0x6c, 0x29, 0xdb, 0x65, 0xbc, 0xcc, 0xc8, 0x4d, 0x46, 0x6d, 0x07, 0xd3, 0x39,
+	0xfa, 0x1e, 0x68, 0x89, 0x3a, 0xc7, 0x9b, 0x45, 0xcc, 0x68, 0xe0, 0x0a, 0xeb, 0x31, 0xbd, 0x2d,
+	0xc8, 0xe8, 0x10, 0xaa, 0xdc, 0x3f, 0x4b, 0xda, 0xab, 0xf2, 0x72, 0xdc, 0xbe, 0x09, 0x08, 0x91,
+	0x37, 0x11, 0xe5, 0x01, 0x33, 0xda, 0xf0, 0x69, 0x38, 0x97, 0xa1, 0xe6, 0x04, 0xf4, 0x18, 0xd4,
+	0xc0, 0xb7, 0x9c, 0xc0, 0x3f, 0xf5, 0x5c, 0x87, 0x36, 0xaa, 0xdc, 0xf5, 0x7b, 0x8b, 0xae, 0xb7,
+	0xe5, 0xa9, 0xf4, 0x1f, 0x82, 0x84, 0x82, 0x1e, 0x42, 0x3d, 0x96, 0xb5, 0x9c, 0xc0, 0x9b, 0x4d,
+	0xfc, 0xc6, 0x32, 0x37, 0xba, 0x16, 0x93, 0xdb, 0x9c, 0x7a, 0x77, 0x04, 0xf5, 0x6b, 0x66, 0xb0,
+	0xef, 0xc6, 0x05, 0x99, 0xcb, 0x4a, 0x62, 0x4b, 0xf4, 0x09, 0x14, 0x2f, 0x6d, 0x6f, 0x16, 0xe3,
+	0xe5, 0xed, 0x19, 0xe0, 0x5c, 0x3f, 0xcd, 0xfd, 0x58, 0xd1, 0x9f, 0x41, 0x05, 0x07, 0x57, 0xed,
+	0x73, 0x5e, 0x06, 0x3a, 0x94, 0x5e, 0x92, 0xd3, 0x20, 0x24, 0xb2, 0x47, 0x80, 0xfc, 0x86, 0xe2,
+	0xe0, 0x0a, 0xcb, 0x13, 0xb4, 0x05, 0x45, 0x9e, 0x8a, 0x46, 0xee, 0x06, 0x8b, 0x38, 0xd0, 0x6d,
+	0x28, 0xe3, 0xe0, 0x8a, 0xa3, 0x05, 0xdd, 0x07, 0x11, 0x2c, 0xcb, 0xb7, 0x27, 0x71, 0xd1, 0x57,
+	0x38, 0xa5, 0x6f, 0x4f, 0x08, 0x7a, 0x04, 0x6a, 0x18, 0x5c, 0x59, 0x0e, 0xbf, 0x5e, 0x34, 0x41,
+	0x75, 0x7f, 0x7d, 0xa1, 0x2f, 0xc4, 0xc6, 0x61, 0x08, 0xe3, 0x65, 0xa4, 0x3f, 0x03, 0x48, 0x21,
+	0xf9, 0xbe, 0x4b, 0xbe, 0xcb, 0x8a, 0x98, 0x78, 0xe3, 0x58, 0x7f, 0x55, 0x9a, 0xcc, 0x35, 0x60,
+	0x79, 0xa6, 0xff, 0x5a, 0x81, 0xca, 0x90, 0x81, 0xee, 0x80, 0xba, 0xe3, 0xaf, 0x01, 0x55, 0x04,
+	0x85, 0x33, 0xea, 0x8e, 0x65, 0x43, 0xe1, 0x6b, 0xf4, 0x59, 0x6c, 0xd8, 0xd4, 0xba, 0x88, 0x1a,
+	0x05, 0x7e, 0xfb, 0x42, 0x52, 0x78, 0x4a, 0x7b, 0x76, 0x44, 0x4f, 0x8e, 0x70, 0x99, 0xb3, 0x9e,
+	0x1c, 0x45, 0xfa, 0xe7, 0x50, 0x1c, 0x71, 0x2b, 0x1e, 0x81, 0xca, 0x95, 0x5b, 0x4c, 0x5b, 0xdc,
+	0x36, 0x17, 0xc2, 0x93, 0x58, 0x8c, 0x21, 0x8a, 0x97, 0x91, 0xde, 0x84, 0xe5, 0x23, 0x69, 0x2d,
+	0x67, 0xf8, 0x70, 0x77, 0xf4, 0x3f, 0xe6, 0x60, 0xe9, 0x30, 0x98, 0x31, 0xd8, 0xa0, 0x1a, 0xe4,
+	0xdc, 0x31, 0x97, 0xcb, 0xe3, 0x9c, 0x3b, 0x46, 0xbf, 0x80, 0xda, 0xc4, 0x3d, 0x0b, 0x45, 0x27,
+	0xe5, 0x8d, 0x49, 0xd4, 0xdb, 0xb7, 0xb3, 0x96, 0x1d, 0xc7, 0x1c, 0xbc, 0x3b, 0x2d, 0x4f, 0xb2,
+	0xdb, 0x4c, 0xbf, 0xc9, 0x2f, 0xf4, 0x9b, 0x07, 0x50, 0xf3, 0x02, 0xc7, 0xf6, 0xac, 0xe4, 0x8b,
+	0x59, 0x10, 0x3d, 0x81, 0x53, 0x4f, 0x24, 0xf1, 0x7a, 0x5c, 0x8a, 0xb7, 0x8c, 0x0b, 0x7a, 0x0c,
+	0xd5, 0xa9, 0x1d, 0x52, 0xd7, 0x71, 0xa7, 0x36, 0x9b, 0x39, 0x4b, 0x5c, 0x70, 0xc1, 0xec, 0x85,
+	0xb8, 0xe1, 0x05, 0x76, 0xd6, 0x62, 0x22, 0xde, 0x11, 0x92, 0xcf, 0x47, 0xd4, 0x58, 0xe2, 0xf6,
+	0xd7, 0x05, 0x3d, 0xfe, 0x64, 0x44, 0xfa, 0x1f, 0xf2, 0x50, 0x1a, 0x89, 0xea, 0xdc, 0x81, 0x02,
+	0x8f, 0x91, 0x98, 0x2b, 0x37, 0xb2, 0x97, 0x09, 0x0e, 0x1e, 0x20, 0xce, 0x83, 0xee, 0x41, 0x85,
+	0xba, 0x13, 0x12, 0x51, 0x7b, 0x32, 0xe5, 0x41, 0xcd, 0xe3, 0x94, 0xf0, 0x95, 0x25, 0x76, 0x0f,
+	0x2a, 0xc9, 0x24, 0x2c, 0x83, 0x95, 0x12, 0xd0, 0xf7, 0xa1, 0xc2, 0xf0, 0xc5, 0xe7, 0xde, 0x46,
+	0x91, 0x03, 0x76, 0xed, 0x1a, 0xba, 0xb8, 0x09, 0xb8, 0x1c, 0xca, 0x15, 0xfa, 0x11, 0xa8, 0x1c,
+	0x11, 0x52, 0x48, 0xf4, 0xfd, 0x8d, 0xc5, 0xbe, 0x1f, 0x23, 0x0f, 0x43, 0xfa, 0xb9, 0x45, 0x0f,
+	0xa1, 0x78, 0xc9, 0xcd, 0x5b, 0x92, 0xf3, 0x77, 0xd6, 0x51, 0x9e, 0x0a, 0x71, 0xce, 0x86, 0x9b,
+	0x5f, 0x8a, 0xca, 0x6a, 0x94, 0x6f, 0x0e, 0x37, 0xb2, 0xe8, 0x70, 0xcc, 0xc3, 0xda, 0xdc, 0x78,
+	0xe2, 0xf1, 0xa6, 0x5f, 0xc1, 0x6c, 0x89, 0x3e, 0x82, 0xaa, 0x33, 0x0b, 0x43, 0x3e, 0xf1, 0xbb,
+	0x13, 0xd2, 0x58, 0xe3, 0x81, 0x52, 0x25, 0xcd, 0x74, 0x27, 0x04, 0xfd, 0x0c, 0x6a, 0x9e, 0x1d,
+	0x51, 0x06, 0x3c, 0xe9, 0xc8, 0xfa, 0x96, 0x72, 0x1d, 0x7d, 0x02, 0x78, 0xc2, 0x13, 0xd5, 0x4b,
+	0x37, 0xfa, 0x39, 0x54, 0x8f, 0x5d, 0xdf, 0x9d, 0xd8, 0x1e, 0x07, 0x28, 0x0b, 0x7c, 0xa6, 0xb5,
+	0x14, 0xfc, 0x5b, 0x77, 0x15, 0xb4, 0x09, 0x2a, 0x33, 0x41, 0xb4, 0x76, 0x51, 0xed, 0x79, 0x5c,
+	0x99, 0x1e, 0x89, 0xae, 0xce, 0x91, 0x2a, 0x6f, 0x1a, 0x3a, 0xe7, 0x64, 0x62, 0xa3, 0x4f, 0x13,
+	0x64, 0x08, 0xb4, 0x37, 0x16, 0x31, 0x95, 0x1a, 0x15, 0x63, 0x46, 0xff, 0x4b, 0x0e, 0x6a, 0x23,
+	0x31, 0xfe, 0xc5, 0x23, 0xe7, 0xe7, 0xb0, 0x4a, 0x4e, 0x4f, 0x89, 0x43, 0xdd, 0x4b, 0x62, 0x39,
+	0xb6, 0xe7, 0x91, 0xd0, 0x92, 0x08, 0x56, 0xf7, 0xeb, 0xbb, 0xe2, 0x6f, 0x60, 0x9b, 0xd3, 0xbb,
+	0x1d, 0xbc, 0x92, 0xf0, 0x4a, 0xd2, 0x18, 0x19, 0xb0, 0xea, 0x4e, 0x26, 0x64, 0xec, 0xda, 0x34,
+	0xab, 0x40, 0xb4, 0xfc, 0x75, 0xe9, 0xe9, 0xc8, 0x3c, 0xb0, 0x29, 0x49, 0xd5, 0x24, 0x12, 0x89,
+	0x9a, 0x07, 0xcc, 0x99, 0xf0, 0x2c, 0x99, 0x62, 0x97, 0xa5, 0xa4, 0xc9, 0x89, 0x58, 0x1e, 0x2e,
+	0x4c, 0xc8, 0x85, 0x6b, 0x13, 0x72, 0x3a, 0x81, 0x14, 0xdf, 0x3b, 0x81, 0xfc, 0x1c, 0xea, 0xa2,
+	0xdd, 0xc6, 0xa9, 0x8f, 0x11, 0xfe, 0xd6, 0x9e, 0x5b, 0xa5, 0xe9, 0x26, 0xd2, 0x1f, 0x43, 0x3d,
+	0x09, 0xa4, 0x9c, 0xa0, 0x77, 0xa0, 0xc4, 0xcb, 0x27, 0x4e, 0x07, 0xba, 0x09, 0x5f, 0x2c, 0x39,
+	0xf4, 0x5f, 0xe5, 0x00, 0xc5, 0xf2, 0xc1, 0x55, 0xf4, 0x7f, 0x9a, 0x8c, 0x35, 0x28, 0x72, 0xba,
+	0xcc, 0x84, 0xd8, 0xb0, 0x38, 0xb0, 0xa0, 0x4e, 0x2f, 0x92, 0x34, 0x08, 0xe1, 0x67, 0xec, 0x17,
+	0x93, 0x68, 0xe6, 0x51, 0x2c, 0x39, 0xf4, 0x3f, 0x29, 0xb0, 0xba, 0x10, 0x07, 0x19, 0xcb, 0x14,
+	0x31, 0xca, 0x3b, 0x10, 0xb3, 0x0d, 0xe5, 0xe9, 0xc5, 0x3b, 0x90, 0x95, 0x9c, 0x7e, 0x65, 0x3b,
+	0xdc, 0x84, 0x42, 0x18, 0x5c, 0xc5, 0xdf, 0xda, 0xec, 0x70, 0xc2, 0xe9, 0x6c, 0xc2, 0x59, 0xf0,
+	0x23, 0xcb, 0x11, 0xdb, 0xef, 0x82, 0x9a, 0xe9, 0x0c, 0xac, 0x95, 0x2c, 0x56, 0x95, 0x4c, 0xdd,
+	0x5b, 0x8b, 0x4a, 0xcd, 0x14, 0x15, 0xeb, 0xcf, 0x4e, 0x30, 0x99, 0x7a, 0x84, 0x12, 0x91, 0xb2,
+	0x32, 0x4e, 0x09, 0xfa, 0x17, 0xa0, 0x66, 0x24, 0xdf, 0x37, 0xc8, 0xa4, 0x49, 0xc8, 0xbf, 0x37,
+	0x09, 0x7f, 0x57, 0x60, 0x3d, 0x2d, 0xe6, 0x99, 0x47, 0xbf, 0x51, 0xf5, 0xa8, 0x87, 0xb0, 0x71,
+	0xdd, 0xbb, 0x0f, 0xaa, 0xb2, 0xaf, 0x51, 0x3b, 0x3b, 0xcf, 0x40, 0xcd, 0x0c, 0xd1, 0xec, 0xc5,
+	0xa4, 0x7b, 0xd0, 0x1f, 0x60, 0x43, 0xbb, 0x83, 0xca, 0x50, 0x18, 0x9a, 0x83, 0x13, 0x4d, 0x61,
+	0x2b, 0xe3, 0x0b, 0xa3, 0x2d, 0x5e, 0x61, 0xd8, 0xca, 0x92, 0x4c, 0x79, 0xb4, 0x02, 0xcb, 0x9c,
+	0xd0, 0xec, 0x74, 0xba, 0x66, 0x77, 0x64, 0x68, 0x85, 0x9d, 0x36, 0x68, 0xd7, 0xff, 0x1e, 0xb0,
+	0xf7, 0x95, 0xc1, 0xc8, 0xc0, 0x2f, 0x70, 0xd7, 0x64, 0xaa, 0xd7, 0x40, 0xeb, 0x35, 0x87, 0xa6,
+	0xc5, 0xf7, 0xd8, 0x7a, 0xd1, 0xed, 0x0f, 0x35, 0x85, 0x5d, 0x8e, 0x8d, 0x43, 0xa3, 0x6d, 0x6a,
+	0xb9, 0x9d, 0xff, 0x28, 0x00, 0xe9, 0x24, 0x81, 0x54, 0x58, 0x7a, 0xde, 0x3f, 0xea, 0x0f, 0x5e,
+	0xf4, 0x85, 0x61, 0x07, 0x66, 0xb7, 0xa3, 0x29, 0xa8, 0x02, 0x45, 0xf1, 0x5c, 0x94, 0x63, 0xc2,
+	0xf2, 0xad, 0x28, 0xcf, 0x1e, 0x92, 0x92, 0x87, 0xa2, 0x02, 0x5a, 0x82, 0x7c, 0xf2, 0x1c, 0x24,
+	0xdf, 0x7f, 0x4a, 0x4c, 0x21, 0x36, 0x4e, 0x7a, 0xcd, 0xb6, 0xa1, 0x2d, 0xb1, 0x83, 0xe4, 0x25,
+	0x08, 0xa0, 0x14, 0x3f, 0x03, 0x31, 0x49, 0xf6, 0x78, 0x04, 0xec, 0x9e, 0x81, 0xf9, 0xd4, 0xc0,
+	0x9a, 0xca, 0x68, 0x78, 0xf0, 0x42, 0xab, 0x32, 0xda, 0x93, 0xae, 0xd1, 0xeb, 0x68, 0xcb, 0xcc,
+	0xbb, 0xa7, 0x46, 0x13, 0x9b, 0x2d, 0xa3, 0x69, 0x6a, 0x35, 0x76, 0x32, 0xe2, 0x06, 0xd6, 0xd9,
+	0x35, 0x87, 0x83, 0xe7, 0xb8, 0xdf, 0xec, 0x69, 0x1a, 0xdb, 0x8c, 0x0c, 0x3c, 0xec, 0x0e, 0xfa,
+	0xda, 0x0a, 0xbb, 0x87, 0x85, 0xe0, 0xe4, 0x48, 0x43, 0x4c, 0x7e, 0xd8, 0x1c, 0x19, 0x27, 0x83,
+	0x6e, 0xdf, 0xd4, 0x56, 0x77, 0x1e, 0xb2, 0xef, 0x67, 0x76, 0xb2, 0x04, 0x28, 0x99, 0xcd, 0x56,
+	0xcf, 0x18, 0x6a, 0x77, 0xd8, 0x7a, 0xf8, 0xb4, 0x89, 0x3b, 0x43, 0x4d, 0x69, 0xfd, 0xe4, 0xaf,
+	0x6f, 0x36, 0x95, 0xbf, 0xbd, 0xd9, 0x54, 0xfe, 0xf1, 0x66, 0x53, 0xf9, 0xed, 0x3f, 0x37, 0xef,
+	0x7c, 0xf9, 0xf0, 0xd2, 0xa5, 0x24, 0x8a, 0x76, 0xdd, 0x60, 0x4f, 0xac, 0xf6, 0xce, 0x82, 0xbd,
+	0x4b, 0xba, 0xc7, 0x5f, 0x40, 0xf7, 0x52, 0x6c, 0xbf, 0x2c, 0x71, 0xca, 0x0f, 0xfe, 0x3b, 0x00,
+	0x22, 0xa6, 0xf6, 0x8f, 0x5d, 0x15, 0x00, 0x00,
 }
 
 func (m *Charset) Marshal() (dAtA []byte, err error) {
@@ -2780,6 +2876,13 @@ func (m *Filter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ExcludeVreplicationWorkflow) > 0 {
+		i -= len(m.ExcludeVreplicationWorkflow)
+		copy(dAtA[i:], m.ExcludeVreplicationWorkflow)
+		i = encodeVarintBinlogdata(dAtA, i, uint64(len(m.ExcludeVreplicationWorkflow)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.FieldEventMode != 0 {
 		i = encodeVarintBinlogdata(dAtA, i, uint64(m.FieldEventMode))
 		i--
@@ -2826,6 +2929,35 @@ func (m *BinlogSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ConflictColumn) > 0 {
+		i -= len(m.ConflictColumn)
+		copy(dAtA[i:], m.ConflictColumn)
+		i = encodeVarintBinlogdata(dAtA, i, uint64(len(m.ConflictColumn)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.OnConflict != 0 {
+		i = encodeVarintBinlogdata(dAtA, i, uint64(m.OnConflict))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.TableOnDdl) > 0 {
+		for k := range m.TableOnDdl {
+			v := m.TableOnDdl[k]
+			baseI := i
+			i = encodeVarintBinlogdata(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintBinlogdata(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintBinlogdata(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ExternalCluster) > 0 {
 		i -= len(m.ExternalCluster)
 		copy(dAtA[i:], m.ExternalCluster)
@@ -4254,6 +4386,10 @@ func (m *Filter) Size() (n int) {
 	if m.FieldEventMode != 0 {
 		n += 1 + sovBinlogdata(uint64(m.FieldEventMode))
 	}
+	l = len(m.ExcludeVreplicationWorkflow)
+	if l > 0 {
+		n += 1 + l + sovBinlogdata(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4305,6 +4441,21 @@ func (m *BinlogSource) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBinlogdata(uint64(l))
 	}
+	if len(m.TableOnDdl) > 0 {
+		for k, v := range m.TableOnDdl {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovBinlogdata(uint64(len(k))) + 1 + sovBinlogdata(uint64(v))
+			n += mapEntrySize + 1 + sovBinlogdata(uint64(mapEntrySize))
+		}
+	}
+	if m.OnConflict != 0 {
+		n += 1 + sovBinlogdata(uint64(m.OnConflict))
+	}
+	l = len(m.ConflictColumn)
+	if l > 0 {
+		n += 1 + l + sovBinlogdata(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5886,6 +6037,38 @@ func (m *Filter) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeVreplicationWorkflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludeVreplicationWorkflow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBinlogdata(dAtA[iNdEx:])
@@ -6230,6 +6413,170 @@ func (m *BinlogSource) Unmarshal(dAtA []byte) error {
 			}
 			m.ExternalCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableOnDdl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TableOnDdl == nil {
+				m.TableOnDdl = make(map[string]OnDDLAction)
+			}
+			var mapkey string
+			var mapvalue OnDDLAction
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBinlogdata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBinlogdata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthBinlogdata
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthBinlogdata
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBinlogdata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= OnDDLAction(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipBinlogdata(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthBinlogdata
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TableOnDdl[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnConflict", wireType)
+			}
+			m.OnConflict = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OnConflict |= OnConflictAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictColumn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictColumn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBinlogdata(dAtA[iNdEx:])
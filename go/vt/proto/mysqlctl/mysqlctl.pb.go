@@ -435,11 +435,13 @@ var xxx_messageInfo_RefreshConfigResponse proto.InternalMessageInfo
 
 // BackupInfo is the read-only attributes of a mysqlctl/backupstorage.BackupHandle.
 type BackupInfo struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Directory            string   `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Directory string `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
+	// Verification is the result of the last time the backup was verified, if it was.
+	Verification         *BackupVerification `protobuf:"bytes,3,opt,name=verification,proto3" json:"verification,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *BackupInfo) Reset()         { *m = BackupInfo{} }
@@ -489,6 +491,80 @@ func (m *BackupInfo) GetDirectory() string {
 	return ""
 }
 
+func (m *BackupInfo) GetVerification() *BackupVerification {
+	if m != nil {
+		return m.Verification
+	}
+	return nil
+}
+
+// BackupVerification is the result of restoring a backup and checking its tables.
+type BackupVerification struct {
+	// VerifiedTime is when the verification finished (RFC 3339 format, UTC).
+	VerifiedTime string `protobuf:"bytes,1,opt,name=verified_time,json=verifiedTime,proto3" json:"verified_time,omitempty"`
+	// Passed is true if the backup was restored and all its tables passed CHECK TABLE.
+	Passed bool `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// Errors lists the problems that were found.
+	Errors               []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupVerification) Reset()         { *m = BackupVerification{} }
+func (m *BackupVerification) String() string { return proto.CompactTextString(m) }
+func (*BackupVerification) ProtoMessage()    {}
+func (*BackupVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd8c110e42f9cbb9, []int{11}
+}
+func (m *BackupVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupVerification.Merge(m, src)
+}
+func (m *BackupVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupVerification proto.InternalMessageInfo
+
+func (m *BackupVerification) GetVerifiedTime() string {
+	if m != nil {
+		return m.VerifiedTime
+	}
+	return ""
+}
+
+func (m *BackupVerification) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *BackupVerification) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StartRequest)(nil), "mysqlctl.StartRequest")
 	proto.RegisterType((*StartResponse)(nil), "mysqlctl.StartResponse")
@@ -501,38 +577,43 @@ func init() {
 	proto.RegisterType((*RefreshConfigRequest)(nil), "mysqlctl.RefreshConfigRequest")
 	proto.RegisterType((*RefreshConfigResponse)(nil), "mysqlctl.RefreshConfigResponse")
 	proto.RegisterType((*BackupInfo)(nil), "mysqlctl.BackupInfo")
+	proto.RegisterType((*BackupVerification)(nil), "mysqlctl.BackupVerification")
 }
 
 func init() { proto.RegisterFile("mysqlctl.proto", fileDescriptor_cd8c110e42f9cbb9) }
 
 var fileDescriptor_cd8c110e42f9cbb9 = []byte{
-	// 405 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xdf, 0x6a, 0xda, 0x50,
-	0x1c, 0x36, 0x73, 0x1b, 0xf1, 0x37, 0x5d, 0xc6, 0xd9, 0xd4, 0x18, 0xb6, 0xe8, 0x02, 0x1b, 0x5e,
-	0x19, 0xd8, 0x2e, 0xc6, 0x7a, 0x51, 0xa8, 0x42, 0xa1, 0x17, 0xa5, 0x10, 0x29, 0x94, 0xde, 0x48,
-	0x6a, 0x4e, 0x62, 0xa8, 0xe6, 0xc4, 0x73, 0x4e, 0x14, 0x5f, 0xa1, 0x4f, 0xd0, 0x47, 0xea, 0x65,
-	0x1f, 0xa1, 0xd8, 0x17, 0x29, 0x3d, 0x26, 0x31, 0x31, 0xda, 0xbb, 0x73, 0xbe, 0x7f, 0x9c, 0x7c,
-	0x1f, 0x81, 0xcf, 0xb3, 0x15, 0x9b, 0x4f, 0xc7, 0x7c, 0xda, 0x0b, 0x29, 0xe1, 0x04, 0xc9, 0xc9,
-	0xdd, 0x30, 0xa1, 0x3a, 0xe4, 0x36, 0xe5, 0x16, 0x9e, 0x47, 0x98, 0x71, 0xd4, 0x86, 0x4f, 0x82,
-	0x73, 0x46, 0x36, 0xf5, 0x98, 0x2a, 0x75, 0xca, 0xdd, 0x8a, 0x05, 0x1b, 0xe8, 0x84, 0x7a, 0xcc,
-	0x50, 0xa0, 0x16, 0x1b, 0x58, 0x48, 0x02, 0x86, 0x8d, 0xff, 0xa0, 0x0c, 0x27, 0x11, 0x77, 0xc8,
-	0x32, 0x48, 0x42, 0x7e, 0x83, 0xb2, 0xb4, 0x7d, 0x3e, 0x72, 0x09, 0x1d, 0x6d, 0xac, 0xaa, 0xd4,
-	0x91, 0xba, 0xb2, 0x55, 0x7b, 0x85, 0x4f, 0x09, 0x3d, 0x17, 0xa0, 0x81, 0xe0, 0xcb, 0xd6, 0x1a,
-	0xc7, 0xa9, 0xd0, 0xb0, 0xa2, 0x40, 0x08, 0x2e, 0x43, 0x8f, 0xda, 0x0e, 0x8e, 0x53, 0x8d, 0x16,
-	0x34, 0x0b, 0x4c, 0x6c, 0xaa, 0xc3, 0x57, 0x0b, 0xfb, 0x81, 0xcf, 0x07, 0x24, 0x70, 0x7d, 0x2f,
-	0x71, 0x34, 0xe0, 0x5b, 0x1e, 0x8e, 0xe5, 0x02, 0x77, 0x29, 0x66, 0x93, 0xbc, 0xbe, 0x09, 0xf5,
-	0x1d, 0x3c, 0x36, 0x1c, 0x03, 0xf4, 0xed, 0xf1, 0x6d, 0x14, 0x9e, 0x05, 0x2e, 0x41, 0x08, 0xde,
-	0x07, 0xf6, 0x0c, 0x8b, 0x6f, 0xaa, 0x58, 0xe2, 0x8c, 0xbe, 0x43, 0xc5, 0xf1, 0x29, 0x1e, 0x73,
-	0x42, 0x57, 0xea, 0x3b, 0x41, 0x6c, 0x81, 0x3f, 0x77, 0x65, 0x90, 0xc5, 0xc3, 0x07, 0x7c, 0x8a,
-	0x8e, 0xe0, 0x83, 0x68, 0x10, 0x35, 0x7a, 0xe9, 0x2c, 0xd9, 0x0d, 0xb4, 0x66, 0x01, 0x8f, 0x9f,
-	0x51, 0x42, 0x03, 0x90, 0x93, 0xc6, 0x50, 0x2b, 0x23, 0xcb, 0x0f, 0xa0, 0x69, 0xfb, 0xa8, 0x34,
-	0xe4, 0x0a, 0x94, 0x9d, 0x22, 0x51, 0x67, 0x6b, 0xd8, 0xdf, 0xbe, 0xf6, 0xf3, 0x0d, 0x45, 0x9a,
-	0x7c, 0x01, 0xd5, 0x6c, 0xe1, 0xe8, 0x47, 0xc6, 0x54, 0xdc, 0x47, 0xd3, 0x0f, 0xd1, 0x69, 0xa0,
-	0x05, 0xb5, 0xdc, 0x22, 0x28, 0x67, 0x29, 0x4e, 0xa8, 0xb5, 0x0f, 0xf2, 0x49, 0x66, 0xff, 0xdf,
-	0xc3, 0x5a, 0x97, 0x1e, 0xd7, 0xba, 0xf4, 0xb4, 0xd6, 0xa5, 0xfb, 0x67, 0xbd, 0x74, 0xfd, 0x6b,
-	0xe1, 0x73, 0xcc, 0x58, 0xcf, 0x27, 0xe6, 0xe6, 0x64, 0x7a, 0xc4, 0x5c, 0x70, 0x53, 0xfc, 0x2c,
-	0x66, 0x12, 0x78, 0xf3, 0x51, 0xdc, 0xff, 0xbe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x5d, 0xef, 0x36,
-	0xdc, 0x4e, 0x03, 0x00, 0x00,
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x5f, 0x28, 0x4c, 0xe9, 0xb7, 0x96, 0xa2, 0x0f, 0xd6, 0x66, 0xd1, 0xc8, 0x4a, 0x10, 0xa8,
+	0xa7, 0x46, 0x1a, 0x07, 0x04, 0x27, 0x58, 0x25, 0x24, 0x0e, 0x08, 0xc9, 0x03, 0x84, 0xb8, 0x54,
+	0xa1, 0x71, 0x32, 0x8b, 0x36, 0xce, 0x6c, 0xb7, 0xd3, 0x6e, 0x9c, 0x79, 0x02, 0x1e, 0x89, 0x23,
+	0x8f, 0x80, 0xca, 0x8b, 0xa0, 0x39, 0x4e, 0x9a, 0x2c, 0x2b, 0x37, 0x7f, 0xbf, 0x7f, 0x72, 0xfc,
+	0xfd, 0x02, 0x77, 0x17, 0x97, 0xf2, 0x7c, 0x3e, 0x53, 0xf3, 0x71, 0x26, 0xb8, 0xe2, 0x68, 0x17,
+	0xb3, 0x1f, 0x40, 0xe7, 0x54, 0x85, 0x42, 0x11, 0x7a, 0xbe, 0xa4, 0x52, 0xe1, 0x11, 0xec, 0x69,
+	0x2e, 0x9a, 0x86, 0x22, 0x91, 0x8e, 0x35, 0x6c, 0x8d, 0xda, 0x04, 0x72, 0xe8, 0xb5, 0x48, 0xa4,
+	0xdf, 0x83, 0xae, 0x31, 0xc8, 0x8c, 0xa7, 0x92, 0xfa, 0x2f, 0xa0, 0x77, 0x7a, 0xb6, 0x54, 0x11,
+	0xbf, 0x48, 0x8b, 0x90, 0xa7, 0xd0, 0xbb, 0x08, 0x99, 0x9a, 0xc6, 0x5c, 0x4c, 0x73, 0xab, 0x63,
+	0x0d, 0xad, 0x91, 0x4d, 0xba, 0x57, 0xf0, 0x1b, 0x2e, 0xde, 0x69, 0xd0, 0x47, 0xb8, 0xb7, 0xb1,
+	0x9a, 0x38, 0x07, 0xfa, 0x64, 0x99, 0x6a, 0xc1, 0xc7, 0x2c, 0x11, 0x61, 0x44, 0x4d, 0xaa, 0x7f,
+	0x00, 0x83, 0x06, 0x63, 0x4c, 0xfb, 0x70, 0x9f, 0x50, 0x96, 0x32, 0x35, 0xe1, 0x69, 0xcc, 0x92,
+	0xc2, 0xd1, 0x87, 0x07, 0x75, 0xd8, 0xc8, 0x35, 0x1e, 0x0b, 0x2a, 0xcf, 0xea, 0xfa, 0x01, 0xec,
+	0x5f, 0xc3, 0x8d, 0xe1, 0xbb, 0x05, 0x70, 0x12, 0xce, 0xbe, 0x2d, 0xb3, 0xb7, 0x69, 0xcc, 0x11,
+	0xe1, 0x76, 0x1a, 0x2e, 0xa8, 0xfe, 0xa8, 0x36, 0xd1, 0x67, 0x3c, 0x84, 0x76, 0xc4, 0x04, 0x9d,
+	0x29, 0x2e, 0x2e, 0x9d, 0x5b, 0x9a, 0xd8, 0x00, 0xf8, 0x0a, 0x3a, 0x2b, 0x2a, 0x58, 0xcc, 0x66,
+	0xa1, 0x62, 0x3c, 0x75, 0x5a, 0x43, 0x6b, 0xb4, 0x77, 0x7c, 0x38, 0x2e, 0xf7, 0x92, 0xa7, 0x7f,
+	0xaa, 0x68, 0x48, 0xcd, 0xe1, 0x33, 0xc0, 0xa6, 0x06, 0x1f, 0x43, 0x37, 0x57, 0xd1, 0x68, 0xaa,
+	0x58, 0x79, 0xa5, 0x4e, 0x01, 0x7e, 0x60, 0x0b, 0x8a, 0x7d, 0xd8, 0xcd, 0x42, 0x29, 0x69, 0xa4,
+	0xef, 0x65, 0x13, 0x33, 0x5d, 0xe1, 0x54, 0x08, 0x2e, 0xa4, 0xd3, 0xd2, 0x6b, 0x36, 0xd3, 0xf1,
+	0x8f, 0x16, 0xd8, 0xfa, 0x99, 0x27, 0x6a, 0x8e, 0x2f, 0xe1, 0x8e, 0xde, 0x37, 0xf6, 0x37, 0x97,
+	0xad, 0x36, 0xc6, 0x1d, 0x34, 0x70, 0xf3, 0x68, 0x3b, 0x38, 0x01, 0xbb, 0xd8, 0x2f, 0x1e, 0x54,
+	0x64, 0xf5, 0xba, 0xb8, 0xee, 0x4d, 0x54, 0x19, 0xf2, 0x19, 0x7a, 0xd7, 0xd6, 0x8e, 0xc3, 0x8d,
+	0xe1, 0xe6, 0xae, 0xb8, 0x8f, 0xfe, 0xa3, 0x28, 0x93, 0xdf, 0x43, 0xa7, 0x5a, 0x0f, 0x7c, 0x58,
+	0x31, 0x35, 0xdb, 0xe4, 0x7a, 0xdb, 0xe8, 0x32, 0x90, 0x40, 0xb7, 0xd6, 0x1f, 0xac, 0x59, 0x9a,
+	0x85, 0x73, 0x8f, 0xb6, 0xf2, 0x45, 0xe6, 0xc9, 0xf3, 0x5f, 0x6b, 0xcf, 0xfa, 0xbd, 0xf6, 0xac,
+	0x3f, 0x6b, 0xcf, 0xfa, 0xf9, 0xd7, 0xdb, 0xf9, 0xf2, 0x64, 0xc5, 0x14, 0x95, 0x72, 0xcc, 0x78,
+	0x90, 0x9f, 0x82, 0x84, 0x07, 0x2b, 0x15, 0xe8, 0x5f, 0x3b, 0x28, 0x02, 0xbf, 0xee, 0xea, 0xf9,
+	0xd9, 0xbf, 0x01, 0x00, 0xb7, 0x55, 0xb9, 0xdf, 0xfc, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1072,6 +1153,18 @@ func (m *BackupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Verification != nil {
+		{
+			size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMysqlctl(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Directory) > 0 {
 		i -= len(m.Directory)
 		copy(dAtA[i:], m.Directory)
@@ -1089,6 +1182,59 @@ func (m *BackupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BackupVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintMysqlctl(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VerifiedTime) > 0 {
+		i -= len(m.VerifiedTime)
+		copy(dAtA[i:], m.VerifiedTime)
+		i = encodeVarintMysqlctl(dAtA, i, uint64(len(m.VerifiedTime)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMysqlctl(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMysqlctl(v)
 	base := offset
@@ -1243,6 +1389,35 @@ func (m *BackupInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMysqlctl(uint64(l))
 	}
+	if m.Verification != nil {
+		l = m.Verification.Size()
+		n += 1 + l + sovMysqlctl(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerifiedTime)
+	if l > 0 {
+		n += 1 + l + sovMysqlctl(uint64(l))
+	}
+	if m.Passed {
+		n += 2
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovMysqlctl(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1940,6 +2115,180 @@ func (m *BackupInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Directory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMysqlctl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMysqlctl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMysqlctl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Verification == nil {
+				m.Verification = &BackupVerification{}
+			}
+			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMysqlctl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMysqlctl
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMysqlctl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMysqlctl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMysqlctl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMysqlctl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMysqlctl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMysqlctl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMysqlctl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMysqlctl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMysqlctl
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMysqlctl(dAtA[iNdEx:])
@@ -952,7 +952,17 @@ type ExecuteOptions struct {
 	// has_created_temp_tables signals whether plans created in this session should be cached or not
 	// if the user has created temp tables, Vitess will not reuse plans created for this session in other sessions.
 	// The current session can still use other sessions cached plans.
-	HasCreatedTempTables bool     `protobuf:"varint,12,opt,name=has_created_temp_tables,json=hasCreatedTempTables,proto3" json:"has_created_temp_tables,omitempty"`
+	HasCreatedTempTables bool `protobuf:"varint,12,opt,name=has_created_temp_tables,json=hasCreatedTempTables,proto3" json:"has_created_temp_tables,omitempty"`
+	// read_after_write_gtid is a MySQL 5.6 GTID set the tablet has to have executed
+	// before it runs a read, so that the session reads its own writes.
+	ReadAfterWriteGtid string `protobuf:"bytes,13,opt,name=read_after_write_gtid,json=readAfterWriteGtid,proto3" json:"read_after_write_gtid,omitempty"`
+	// read_after_write_timeout is the time, in seconds, the tablet waits for
+	// read_after_write_gtid to be executed before failing the read.
+	// 0 means the wait is only bounded by the query timeout.
+	ReadAfterWriteTimeout float64 `protobuf:"fixed64,14,opt,name=read_after_write_timeout,json=readAfterWriteTimeout,proto3" json:"read_after_write_timeout,omitempty"`
+	// session_track_gtids asks the tablet to return the GTID set executed at the time
+	// an autocommitted write finished in QueryResult.session_state_changes.
+	SessionTrackGtids    bool     `protobuf:"varint,15,opt,name=session_track_gtids,json=sessionTrackGtids,proto3" json:"session_track_gtids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1047,6 +1057,27 @@ func (m *ExecuteOptions) GetHasCreatedTempTables() bool {
 	return false
 }
 
+func (m *ExecuteOptions) GetReadAfterWriteGtid() string {
+	if m != nil {
+		return m.ReadAfterWriteGtid
+	}
+	return ""
+}
+
+func (m *ExecuteOptions) GetReadAfterWriteTimeout() float64 {
+	if m != nil {
+		return m.ReadAfterWriteTimeout
+	}
+	return 0
+}
+
+func (m *ExecuteOptions) GetSessionTrackGtids() bool {
+	if m != nil {
+		return m.SessionTrackGtids
+	}
+	return false
+}
+
 // Field describes a single column returned by a query
 type Field struct {
 	// name of the field as returned by mysql C API
@@ -1256,10 +1287,13 @@ func (m *Row) GetValues() []byte {
 // len(QueryResult[0].fields) is always equal to len(row) (for each
 // row in rows for each QueryResult in QueryResult[1:]).
 type QueryResult struct {
-	Fields               []*Field `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
-	RowsAffected         uint64   `protobuf:"varint,2,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
-	InsertId             uint64   `protobuf:"varint,3,opt,name=insert_id,json=insertId,proto3" json:"insert_id,omitempty"`
-	Rows                 []*Row   `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	Fields       []*Field `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	RowsAffected uint64   `protobuf:"varint,2,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
+	InsertId     uint64   `protobuf:"varint,3,opt,name=insert_id,json=insertId,proto3" json:"insert_id,omitempty"`
+	Rows         []*Row   `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	// session_state_changes holds the GTID set executed at the time the query finished,
+	// if the query was a write and the session tracks GTIDs.
+	SessionStateChanges  string   `protobuf:"bytes,6,opt,name=session_state_changes,json=sessionStateChanges,proto3" json:"session_state_changes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1326,6 +1360,13 @@ func (m *QueryResult) GetRows() []*Row {
 	return nil
 }
 
+func (m *QueryResult) GetSessionStateChanges() string {
+	if m != nil {
+		return m.SessionStateChanges
+	}
+	return ""
+}
+
 // QueryWarning is used to convey out of band query execution warnings
 // by storing in the vtgate.Session
 type QueryWarning struct {
@@ -4875,214 +4916,220 @@ func init() {
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 3303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4d, 0x90, 0x1b, 0x49,
-	0x56, 0xee, 0x2a, 0xfd, 0xb4, 0xf4, 0xd4, 0x52, 0x67, 0x67, 0x77, 0xdb, 0x9a, 0xf6, 0x8c, 0xa7,
-	0xb7, 0x76, 0x67, 0xd7, 0x18, 0x68, 0x7b, 0xda, 0x5e, 0x63, 0x66, 0x17, 0x98, 0x6a, 0x75, 0xb5,
-	0x47, 0xb6, 0x54, 0x92, 0x53, 0x25, 0x7b, 0x3d, 0x41, 0x44, 0x45, 0x59, 0x4a, 0xab, 0x2b, 0xba,
-	0x54, 0xa5, 0xae, 0xaa, 0x6e, 0x8f, 0x6e, 0x86, 0x65, 0x59, 0xfe, 0x59, 0xfe, 0x77, 0xd9, 0x60,
-	0x83, 0x08, 0x0e, 0x04, 0x17, 0x22, 0xb8, 0x71, 0xe6, 0x30, 0x41, 0x70, 0x20, 0xe0, 0x08, 0x1c,
-	0x58, 0x86, 0x20, 0xe0, 0xb4, 0x41, 0x70, 0xe0, 0xc0, 0x81, 0x20, 0xf2, 0xa7, 0x4a, 0x52, 0xb7,
-	0xc6, 0xee, 0xf5, 0x32, 0xb1, 0x61, 0x8f, 0x6f, 0xf9, 0x7e, 0xf2, 0xe7, 0x7d, 0xf9, 0xf2, 0xbd,
-	0x57, 0xa9, 0x14, 0x94, 0x0e, 0x8f, 0x68, 0x38, 0xde, 0x1a, 0x85, 0x41, 0x1c, 0xe0, 0x1c, 0x27,
-	0x36, 0x2a, 0x71, 0x30, 0x0a, 0xfa, 0x4e, 0xec, 0x08, 0xf6, 0x46, 0xe9, 0x38, 0x0e, 0x47, 0x3d,
-	0x41, 0x68, 0x5f, 0x53, 0x20, 0x6f, 0x39, 0xe1, 0x80, 0xc6, 0x78, 0x03, 0x0a, 0x07, 0x74, 0x1c,
-	0x8d, 0x9c, 0x1e, 0xad, 0x2a, 0x9b, 0xca, 0xa5, 0x22, 0x49, 0x69, 0xbc, 0x06, 0xb9, 0x68, 0xdf,
-	0x09, 0xfb, 0x55, 0x95, 0x0b, 0x04, 0x81, 0xbf, 0x08, 0xa5, 0xd8, 0x79, 0xe8, 0xd1, 0xd8, 0x8e,
-	0xc7, 0x23, 0x5a, 0xcd, 0x6c, 0x2a, 0x97, 0x2a, 0xdb, 0x6b, 0x5b, 0xe9, 0x7c, 0x16, 0x17, 0x5a,
-	0xe3, 0x11, 0x25, 0x10, 0xa7, 0x6d, 0x8c, 0x21, 0xdb, 0xa3, 0x9e, 0x57, 0xcd, 0xf2, 0xb1, 0x78,
-	0x5b, 0xdb, 0x85, 0xca, 0x3d, 0xeb, 0x96, 0x13, 0xd3, 0x9a, 0xe3, 0x79, 0x34, 0xac, 0xef, 0xb2,
-	0xe5, 0x1c, 0x45, 0x34, 0xf4, 0x9d, 0x61, 0xba, 0x9c, 0x84, 0xc6, 0xe7, 0x20, 0x3f, 0x08, 0x83,
-	0xa3, 0x51, 0x54, 0x55, 0x37, 0x33, 0x97, 0x8a, 0x44, 0x52, 0xda, 0xcf, 0x02, 0x18, 0xc7, 0xd4,
-	0x8f, 0xad, 0xe0, 0x80, 0xfa, 0xf8, 0x75, 0x28, 0xc6, 0xee, 0x90, 0x46, 0xb1, 0x33, 0x1c, 0xf1,
-	0x21, 0x32, 0x64, 0xc2, 0xf8, 0x18, 0x93, 0x36, 0xa0, 0x30, 0x0a, 0x22, 0x37, 0x76, 0x03, 0x9f,
-	0xdb, 0x53, 0x24, 0x29, 0xad, 0xfd, 0x34, 0xe4, 0xee, 0x39, 0xde, 0x11, 0xc5, 0x6f, 0x42, 0x96,
-	0x1b, 0xac, 0x70, 0x83, 0x4b, 0x5b, 0x02, 0x74, 0x6e, 0x27, 0x17, 0xb0, 0xb1, 0x8f, 0x99, 0x26,
-	0x1f, 0x7b, 0x89, 0x08, 0x42, 0x3b, 0x80, 0xa5, 0x1d, 0xd7, 0xef, 0xdf, 0x73, 0x42, 0x97, 0x81,
-	0xf1, 0x9c, 0xc3, 0xe0, 0xcf, 0x41, 0x9e, 0x37, 0xa2, 0x6a, 0x66, 0x33, 0x73, 0xa9, 0xb4, 0xbd,
-	0x24, 0x3b, 0xf2, 0xb5, 0x11, 0x29, 0xd3, 0xfe, 0x4a, 0x01, 0xd8, 0x09, 0x8e, 0xfc, 0xfe, 0x5d,
-	0x26, 0xc4, 0x08, 0x32, 0xd1, 0xa1, 0x27, 0x81, 0x64, 0x4d, 0x7c, 0x07, 0x2a, 0x0f, 0x5d, 0xbf,
-	0x6f, 0x1f, 0xcb, 0xe5, 0x08, 0x2c, 0x4b, 0xdb, 0x9f, 0x93, 0xc3, 0x4d, 0x3a, 0x6f, 0x4d, 0xaf,
-	0x3a, 0x32, 0xfc, 0x38, 0x1c, 0x93, 0xf2, 0xc3, 0x69, 0xde, 0x46, 0x17, 0xf0, 0x69, 0x25, 0x36,
-	0xe9, 0x01, 0x1d, 0x27, 0x93, 0x1e, 0xd0, 0x31, 0xfe, 0x91, 0x69, 0x8b, 0x4a, 0xdb, 0xab, 0xc9,
-	0x5c, 0x53, 0x7d, 0xa5, 0x99, 0xef, 0xa8, 0x37, 0x15, 0xed, 0x2f, 0x16, 0xa1, 0x62, 0x7c, 0x40,
-	0x7b, 0x47, 0x31, 0x6d, 0x8d, 0xd8, 0x1e, 0x44, 0xb8, 0x09, 0xcb, 0xae, 0xdf, 0xf3, 0x8e, 0xfa,
-	0xb4, 0x6f, 0x3f, 0x72, 0xa9, 0xd7, 0x8f, 0xb8, 0x1f, 0x55, 0xd2, 0x75, 0xcf, 0xea, 0x6f, 0xd5,
-	0xa5, 0xf2, 0x1e, 0xd7, 0x25, 0x15, 0x77, 0x86, 0xc6, 0x97, 0x61, 0xa5, 0xe7, 0xb9, 0xd4, 0x8f,
-	0xed, 0x47, 0xcc, 0x5e, 0x3b, 0x0c, 0x1e, 0x47, 0xd5, 0xdc, 0xa6, 0x72, 0xa9, 0x40, 0x96, 0x85,
-	0x60, 0x8f, 0xf1, 0x49, 0xf0, 0x38, 0xc2, 0xef, 0x40, 0xe1, 0x71, 0x10, 0x1e, 0x78, 0x81, 0xd3,
-	0xaf, 0xe6, 0xf9, 0x9c, 0x17, 0xe7, 0xcf, 0x79, 0x5f, 0x6a, 0x91, 0x54, 0x1f, 0x5f, 0x02, 0x14,
-	0x1d, 0x7a, 0x76, 0x44, 0x3d, 0xda, 0x8b, 0x6d, 0xcf, 0x1d, 0xba, 0x71, 0xb5, 0xc0, 0x5d, 0xb2,
-	0x12, 0x1d, 0x7a, 0x1d, 0xce, 0x6e, 0x30, 0x2e, 0xb6, 0x61, 0x3d, 0x0e, 0x1d, 0x3f, 0x72, 0x7a,
-	0x6c, 0x30, 0xdb, 0x8d, 0x02, 0xcf, 0xe1, 0xee, 0x58, 0xe4, 0x53, 0x5e, 0x9e, 0x3f, 0xa5, 0x35,
-	0xe9, 0x52, 0x4f, 0x7a, 0x90, 0xb5, 0x78, 0x0e, 0x17, 0xbf, 0x0d, 0xeb, 0xd1, 0x81, 0x3b, 0xb2,
-	0xf9, 0x38, 0xf6, 0xc8, 0x73, 0x7c, 0xbb, 0xe7, 0xf4, 0xf6, 0x69, 0x15, 0xb8, 0xd9, 0x98, 0x09,
-	0xf9, 0xbe, 0xb7, 0x3d, 0xc7, 0xaf, 0x31, 0x09, 0x03, 0x9d, 0xe9, 0xf9, 0x34, 0xb4, 0x8f, 0x69,
-	0x18, 0xb1, 0xd5, 0x94, 0x9e, 0x06, 0x7a, 0x5b, 0x28, 0xdf, 0x13, 0xba, 0xa4, 0x32, 0x9a, 0xa1,
-	0xf1, 0x17, 0xe1, 0xfc, 0xbe, 0x13, 0xd9, 0xbd, 0x90, 0x3a, 0x31, 0xed, 0xdb, 0x31, 0x1d, 0x8e,
-	0xec, 0x58, 0xf8, 0xe0, 0x12, 0x5f, 0xc3, 0xda, 0xbe, 0x13, 0xd5, 0x84, 0xd4, 0xa2, 0xc3, 0x11,
-	0x8f, 0x23, 0x91, 0xf6, 0x25, 0xa8, 0xcc, 0xee, 0x26, 0x5e, 0x81, 0xb2, 0xf5, 0xa0, 0x6d, 0xd8,
-	0xba, 0xb9, 0x6b, 0x9b, 0x7a, 0xd3, 0x40, 0x0b, 0xb8, 0x0c, 0x45, 0xce, 0x6a, 0x99, 0x8d, 0x07,
-	0x48, 0xc1, 0x8b, 0x90, 0xd1, 0x1b, 0x0d, 0xa4, 0x6a, 0x37, 0xa1, 0x90, 0x6c, 0x0b, 0x5e, 0x86,
-	0x52, 0xd7, 0xec, 0xb4, 0x8d, 0x5a, 0x7d, 0xaf, 0x6e, 0xec, 0xa2, 0x05, 0x5c, 0x80, 0x6c, 0xab,
-	0x61, 0xb5, 0x91, 0x22, 0x5a, 0x7a, 0x1b, 0xa9, 0xac, 0xe7, 0xee, 0x8e, 0x8e, 0x32, 0xda, 0x9f,
-	0x2a, 0xb0, 0x36, 0x0f, 0x5e, 0x5c, 0x82, 0xc5, 0x5d, 0x63, 0x4f, 0xef, 0x36, 0x2c, 0xb4, 0x80,
-	0x57, 0x61, 0x99, 0x18, 0x6d, 0x43, 0xb7, 0xf4, 0x9d, 0x86, 0x61, 0x13, 0x43, 0xdf, 0x45, 0x0a,
-	0xc6, 0x50, 0x61, 0x2d, 0xbb, 0xd6, 0x6a, 0x36, 0xeb, 0x96, 0x65, 0xec, 0x22, 0x15, 0xaf, 0x01,
-	0xe2, 0xbc, 0xae, 0x39, 0xe1, 0x66, 0x30, 0x82, 0xa5, 0x8e, 0x41, 0xea, 0x7a, 0xa3, 0xfe, 0x3e,
-	0x1b, 0x00, 0x65, 0xf1, 0x67, 0xe0, 0x8d, 0x5a, 0xcb, 0xec, 0xd4, 0x3b, 0x96, 0x61, 0x5a, 0x76,
-	0xc7, 0xd4, 0xdb, 0x9d, 0xf7, 0x5a, 0x16, 0x1f, 0x59, 0x18, 0x97, 0xc3, 0x15, 0x00, 0xbd, 0x6b,
-	0xb5, 0xc4, 0x38, 0x28, 0xaf, 0x1d, 0x42, 0x65, 0x16, 0x79, 0xb6, 0x2a, 0xb9, 0x44, 0xbb, 0xdd,
-	0xd0, 0x4d, 0xd3, 0x20, 0x68, 0x01, 0xe7, 0x41, 0xbd, 0x77, 0x4d, 0xd8, 0x7a, 0x8b, 0xfa, 0xd7,
-	0x91, 0xca, 0x06, 0x62, 0xad, 0x5b, 0x21, 0xa5, 0xfd, 0x31, 0xca, 0xb0, 0x75, 0x33, 0xba, 0x41,
-	0x1f, 0xc5, 0xdb, 0xc4, 0x1d, 0xec, 0xc7, 0x28, 0xcb, 0xd6, 0xcd, 0x78, 0xf7, 0xdd, 0x78, 0x7f,
-	0xcf, 0xf1, 0xbc, 0x87, 0x4e, 0xef, 0x00, 0xe5, 0x6e, 0x67, 0x0b, 0x0a, 0x52, 0x6f, 0x67, 0x0b,
-	0x2a, 0xca, 0xdc, 0xce, 0x16, 0x32, 0x28, 0xab, 0xfd, 0xa5, 0x0a, 0x39, 0xbe, 0x3d, 0x2c, 0xce,
-	0x4f, 0x45, 0x6f, 0xde, 0x4e, 0x63, 0x9e, 0xfa, 0x94, 0x98, 0xc7, 0x5d, 0x41, 0x46, 0x5f, 0x41,
-	0xe0, 0x0b, 0x50, 0x0c, 0xc2, 0x81, 0x70, 0x12, 0x99, 0x37, 0x0a, 0x41, 0x38, 0xe0, 0x8e, 0xc1,
-	0x62, 0x36, 0x4b, 0x37, 0x0f, 0x9d, 0x88, 0xf2, 0xa3, 0x5b, 0x24, 0x29, 0x8d, 0x5f, 0x03, 0xa6,
-	0x67, 0xf3, 0x75, 0xe4, 0xb9, 0x6c, 0x31, 0x08, 0x07, 0x26, 0x5b, 0xca, 0x67, 0xa1, 0xdc, 0x0b,
-	0xbc, 0xa3, 0xa1, 0x6f, 0x7b, 0xd4, 0x1f, 0xc4, 0xfb, 0xd5, 0xc5, 0x4d, 0xe5, 0x52, 0x99, 0x2c,
-	0x09, 0x66, 0x83, 0xf3, 0x70, 0x15, 0x16, 0x7b, 0xfb, 0x4e, 0x18, 0x51, 0x71, 0x5c, 0xcb, 0x24,
-	0x21, 0xf9, 0xac, 0xb4, 0xe7, 0x0e, 0x1d, 0x2f, 0xe2, 0x47, 0xb3, 0x4c, 0x52, 0x9a, 0x19, 0xf1,
-	0xc8, 0x73, 0x06, 0x11, 0x3f, 0x52, 0x65, 0x22, 0x08, 0xfc, 0x26, 0x94, 0xe4, 0x84, 0x1c, 0x82,
-	0x12, 0x5f, 0x0e, 0x08, 0x16, 0x43, 0x40, 0xfb, 0x09, 0xc8, 0x90, 0xe0, 0x31, 0x9b, 0x53, 0xac,
-	0x28, 0xaa, 0x2a, 0x9b, 0x99, 0x4b, 0x98, 0x24, 0x24, 0xcb, 0x7b, 0x32, 0xf4, 0x8b, 0x8c, 0x90,
-	0x04, 0xfb, 0x6f, 0x2b, 0x50, 0xe2, 0x47, 0x96, 0xd0, 0xe8, 0xc8, 0x8b, 0x59, 0x8a, 0x90, 0xb1,
-	0x51, 0x99, 0x49, 0x11, 0x7c, 0x5f, 0x88, 0x94, 0x31, 0x00, 0x58, 0xb8, 0xb3, 0x9d, 0x47, 0x8f,
-	0x68, 0x2f, 0xa6, 0x22, 0x13, 0x66, 0xc9, 0x12, 0x63, 0xea, 0x92, 0xc7, 0x90, 0x77, 0xfd, 0x88,
-	0x86, 0xb1, 0xed, 0xf6, 0xf9, 0x9e, 0x64, 0x49, 0x41, 0x30, 0xea, 0x7d, 0x7c, 0x11, 0xb2, 0x3c,
-	0x60, 0x66, 0xf9, 0x2c, 0x20, 0x67, 0x21, 0xc1, 0x63, 0xc2, 0xf9, 0xb7, 0xb3, 0x85, 0x1c, 0xca,
-	0x6b, 0x5f, 0x86, 0x25, 0xbe, 0xb8, 0xfb, 0x4e, 0xe8, 0xbb, 0xfe, 0x80, 0xe7, 0xff, 0xa0, 0x2f,
-	0xfc, 0xa2, 0x4c, 0x78, 0x9b, 0xd9, 0x3c, 0xa4, 0x51, 0xe4, 0x0c, 0xa8, 0xcc, 0xc7, 0x09, 0xa9,
-	0xfd, 0x71, 0x06, 0x4a, 0x9d, 0x38, 0xa4, 0xce, 0x90, 0xa7, 0x76, 0xfc, 0x65, 0x80, 0x28, 0x76,
-	0x62, 0x3a, 0xa4, 0x7e, 0x9c, 0xd8, 0xf7, 0xba, 0x9c, 0x79, 0x4a, 0x6f, 0xab, 0x93, 0x28, 0x91,
-	0x29, 0x7d, 0xbc, 0x0d, 0x25, 0xca, 0xc4, 0x76, 0xcc, 0x4a, 0x04, 0x99, 0x86, 0x56, 0x92, 0x28,
-	0x96, 0xd6, 0x0e, 0x04, 0x68, 0xda, 0xde, 0xf8, 0x8e, 0x0a, 0xc5, 0x74, 0x34, 0xac, 0x43, 0xa1,
-	0xe7, 0xc4, 0x74, 0x10, 0x84, 0x63, 0x99, 0xb9, 0xdf, 0x7a, 0xda, 0xec, 0x5b, 0x35, 0xa9, 0x4c,
-	0xd2, 0x6e, 0xf8, 0x0d, 0x10, 0xe5, 0x90, 0x70, 0x4b, 0x61, 0x6f, 0x91, 0x73, 0xb8, 0x63, 0xbe,
-	0x03, 0x78, 0x14, 0xba, 0x43, 0x27, 0x1c, 0xdb, 0x07, 0x74, 0x9c, 0x64, 0xb9, 0xcc, 0x9c, 0x9d,
-	0x44, 0x52, 0xef, 0x0e, 0x1d, 0xcb, 0x88, 0x78, 0x73, 0xb6, 0xaf, 0xf4, 0x96, 0xd3, 0xfb, 0x33,
-	0xd5, 0x93, 0xd7, 0x0d, 0x51, 0x52, 0x21, 0xe4, 0xb8, 0x63, 0xb1, 0xa6, 0xf6, 0x05, 0x28, 0x24,
-	0x8b, 0xc7, 0x45, 0xc8, 0x19, 0x61, 0x18, 0x84, 0x68, 0x81, 0x07, 0xc6, 0x66, 0x43, 0xc4, 0xd6,
-	0xdd, 0x5d, 0x16, 0x5b, 0xff, 0x45, 0x4d, 0xd3, 0x34, 0xa1, 0x87, 0x47, 0x34, 0x8a, 0xf1, 0xcf,
-	0xc0, 0x2a, 0xe5, 0x2e, 0xe4, 0x1e, 0x53, 0xbb, 0xc7, 0x6b, 0x3a, 0xe6, 0x40, 0x0a, 0xc7, 0x7b,
-	0x79, 0x4b, 0x94, 0xa0, 0x49, 0xad, 0x47, 0x56, 0x52, 0x5d, 0xc9, 0xea, 0x63, 0x03, 0x56, 0xdd,
-	0xe1, 0x90, 0xf6, 0x5d, 0x27, 0x9e, 0x1e, 0x40, 0x6c, 0xd8, 0x7a, 0x52, 0xf2, 0xcc, 0x94, 0x8c,
-	0x64, 0x25, 0xed, 0x91, 0x0e, 0xf3, 0x16, 0xe4, 0x63, 0x5e, 0xde, 0x72, 0xdf, 0x2d, 0x6d, 0x97,
-	0x93, 0x88, 0xc3, 0x99, 0x44, 0x0a, 0xf1, 0x17, 0x40, 0x14, 0xcb, 0x3c, 0xb6, 0x4c, 0x1c, 0x62,
-	0x52, 0x03, 0x11, 0x21, 0xc7, 0x6f, 0x41, 0x65, 0x26, 0x3b, 0xf7, 0x39, 0x60, 0x19, 0x52, 0x9e,
-	0x4e, 0xb5, 0x7d, 0x7c, 0x05, 0x16, 0x03, 0x91, 0x0b, 0x79, 0xd4, 0x99, 0xac, 0x78, 0x36, 0x51,
-	0x92, 0x44, 0x8b, 0xc5, 0x86, 0x90, 0x46, 0x34, 0x3c, 0xa6, 0x7d, 0x36, 0xe8, 0x22, 0x1f, 0x14,
-	0x12, 0x56, 0xbd, 0xaf, 0xfd, 0x14, 0x2c, 0xa7, 0x10, 0x47, 0xa3, 0xc0, 0x8f, 0x28, 0xbe, 0x0c,
-	0xf9, 0x90, 0x9f, 0x77, 0x09, 0x2b, 0x96, 0x73, 0x4c, 0x45, 0x02, 0x22, 0x35, 0xb4, 0x3e, 0x2c,
-	0x0b, 0x0e, 0x8b, 0xdf, 0x7c, 0x27, 0xf1, 0x5b, 0x90, 0xa3, 0xac, 0x71, 0x62, 0x53, 0x48, 0xbb,
-	0xc6, 0xe5, 0x44, 0x48, 0xa7, 0x66, 0x51, 0x9f, 0x39, 0xcb, 0x7f, 0xaa, 0xb0, 0x2a, 0x57, 0xb9,
-	0xe3, 0xc4, 0xbd, 0xfd, 0x17, 0xd4, 0x1b, 0x7e, 0x14, 0x16, 0x19, 0xdf, 0x4d, 0x4f, 0xce, 0x1c,
-	0x7f, 0x48, 0x34, 0x98, 0x47, 0x38, 0x91, 0x3d, 0xb5, 0xfd, 0xb2, 0x7c, 0x2c, 0x3b, 0xd1, 0x54,
-	0xd5, 0x30, 0xc7, 0x71, 0xf2, 0xcf, 0x70, 0x9c, 0xc5, 0xb3, 0x38, 0x8e, 0xb6, 0x0b, 0x6b, 0xb3,
-	0x88, 0x4b, 0xe7, 0xf8, 0x31, 0x58, 0x14, 0x9b, 0x92, 0xc4, 0xc8, 0x79, 0xfb, 0x96, 0xa8, 0x68,
-	0x1f, 0xaa, 0xb0, 0x26, 0xc3, 0xd7, 0xa7, 0xe3, 0x1c, 0x4f, 0xe1, 0x9c, 0x3b, 0xd3, 0x01, 0x3d,
-	0xdb, 0xfe, 0x69, 0x35, 0x58, 0x3f, 0x81, 0xe3, 0x73, 0x1c, 0xd6, 0xef, 0x29, 0xb0, 0xb4, 0x43,
-	0x07, 0xae, 0xff, 0x82, 0xee, 0xc2, 0x14, 0xb8, 0xd9, 0x33, 0x39, 0xf1, 0x08, 0xca, 0xd2, 0x5e,
-	0x89, 0xd6, 0x69, 0xb4, 0x95, 0x79, 0xa7, 0xe5, 0x26, 0x2c, 0xc9, 0x0b, 0x08, 0xc7, 0x73, 0x9d,
-	0x28, 0xb5, 0xe7, 0xc4, 0x0d, 0x84, 0xce, 0x84, 0x44, 0xde, 0x55, 0x70, 0x42, 0xfb, 0x37, 0x05,
-	0xca, 0xb5, 0x60, 0x38, 0x74, 0xe3, 0x17, 0x14, 0xe3, 0xd3, 0x08, 0x65, 0xe7, 0xf9, 0xe3, 0xdb,
-	0x50, 0x49, 0xcc, 0x94, 0xd0, 0x9e, 0xc8, 0x34, 0xca, 0xa9, 0x4c, 0xf3, 0xef, 0x0a, 0x2c, 0x93,
-	0x40, 0x54, 0xf8, 0x2f, 0x37, 0x38, 0xd7, 0x00, 0x4d, 0x0c, 0x3d, 0x2b, 0x3c, 0xff, 0xa3, 0x40,
-	0xa5, 0x1d, 0xd2, 0x91, 0x13, 0xd2, 0x97, 0x1a, 0x1d, 0x56, 0xa6, 0xf7, 0x63, 0x59, 0xe0, 0x14,
-	0x09, 0x6f, 0x6b, 0x2b, 0xb0, 0x9c, 0xda, 0x2e, 0x00, 0xd3, 0xfe, 0x51, 0x81, 0x75, 0xe1, 0x62,
-	0x52, 0xd2, 0x7f, 0x41, 0x61, 0x49, 0xec, 0xcd, 0x4e, 0xd9, 0x5b, 0x85, 0x73, 0x27, 0x6d, 0x93,
-	0x66, 0x7f, 0x55, 0x85, 0xf3, 0x89, 0xf3, 0xbc, 0xe0, 0x86, 0xff, 0x00, 0xfe, 0xb0, 0x01, 0xd5,
-	0xd3, 0x20, 0x48, 0x84, 0xbe, 0xa1, 0x42, 0x55, 0x5c, 0xe2, 0x4c, 0xd5, 0x41, 0x2f, 0x8f, 0x6f,
-	0xe0, 0xb7, 0x61, 0x69, 0xe4, 0x84, 0xb1, 0xdb, 0x73, 0x47, 0x0e, 0xfb, 0x14, 0xcd, 0xf1, 0x32,
-	0xeb, 0xc4, 0x00, 0x33, 0x2a, 0xda, 0x05, 0x78, 0x6d, 0x0e, 0x22, 0x12, 0xaf, 0xff, 0x55, 0x00,
-	0x77, 0x62, 0x27, 0x8c, 0x3f, 0x05, 0x79, 0x69, 0xae, 0x33, 0xad, 0xc3, 0xea, 0x8c, 0xfd, 0xd3,
-	0xb8, 0xd0, 0xf8, 0x53, 0x91, 0x92, 0x3e, 0x16, 0x97, 0x69, 0xfb, 0x25, 0x2e, 0xff, 0xac, 0xc0,
-	0x46, 0x2d, 0x10, 0x17, 0xa2, 0x2f, 0xe5, 0x09, 0xd3, 0xde, 0x80, 0x0b, 0x73, 0x0d, 0x94, 0x00,
-	0xfc, 0x93, 0x02, 0xe7, 0x08, 0x75, 0xfa, 0x2f, 0xa7, 0xf1, 0x77, 0xe1, 0xfc, 0x29, 0xe3, 0x64,
-	0x8d, 0x72, 0x03, 0x0a, 0x43, 0x1a, 0x3b, 0xac, 0xc2, 0x95, 0x26, 0x6d, 0x24, 0xe3, 0x4e, 0xb4,
-	0x9b, 0x52, 0x83, 0xa4, 0xba, 0xda, 0x77, 0x55, 0x58, 0xe5, 0x75, 0xf6, 0xab, 0x8f, 0xbc, 0x33,
-	0xdd, 0xc2, 0xe4, 0x4f, 0x16, 0x7f, 0x4c, 0x61, 0x14, 0x52, 0x3b, 0xb9, 0x1d, 0x58, 0xe4, 0xbf,
-	0x3e, 0xc2, 0x28, 0xa4, 0x77, 0x05, 0x47, 0xfb, 0x1b, 0x05, 0xd6, 0x66, 0x21, 0x4e, 0xbf, 0x68,
-	0xfe, 0xbf, 0x6f, 0x5b, 0xe6, 0x84, 0x94, 0xcc, 0x59, 0x3e, 0x92, 0xb2, 0x67, 0xfe, 0x48, 0xfa,
-	0x5b, 0x15, 0xaa, 0xd3, 0xc6, 0xbc, 0xba, 0xd3, 0x99, 0xbd, 0xd3, 0xf9, 0x7e, 0x6f, 0xf9, 0xb4,
-	0xbf, 0x57, 0xe0, 0xb5, 0x39, 0x80, 0x7e, 0x7f, 0x2e, 0x32, 0x75, 0xb3, 0xa3, 0x3e, 0xf3, 0x66,
-	0xe7, 0x93, 0x77, 0x92, 0x7f, 0x50, 0x60, 0xad, 0x29, 0xee, 0xea, 0xc5, 0xcd, 0xc7, 0x8b, 0x1b,
-	0x83, 0xf9, 0x75, 0x7c, 0x76, 0xf2, 0x6b, 0x95, 0x56, 0x83, 0xf5, 0x13, 0xa6, 0x3d, 0xc7, 0x6d,
-	0xce, 0x7f, 0x2b, 0xb0, 0x22, 0x47, 0xd1, 0x5f, 0xd8, 0xf2, 0x65, 0x0e, 0x3a, 0xf8, 0x22, 0x64,
-	0xdc, 0x7e, 0x52, 0xf7, 0xce, 0xbe, 0x42, 0x60, 0x02, 0xed, 0x5d, 0xc0, 0xd3, 0x76, 0x3f, 0x07,
-	0x74, 0xff, 0xa1, 0xc2, 0x3a, 0x11, 0xd1, 0xf7, 0xd5, 0xef, 0x0b, 0x3f, 0xe8, 0xef, 0x0b, 0x4f,
-	0x4f, 0x5c, 0x1f, 0xf2, 0x62, 0x6a, 0x16, 0xea, 0x4f, 0x2e, 0x75, 0x9d, 0x48, 0xb4, 0x99, 0x53,
-	0x89, 0xf6, 0xf9, 0xe3, 0xd1, 0x87, 0x2a, 0x6c, 0x48, 0x43, 0x5e, 0xd5, 0x3a, 0x67, 0xf7, 0x88,
-	0xfc, 0x29, 0x8f, 0xf8, 0x2f, 0x05, 0x2e, 0xcc, 0x05, 0xf2, 0x87, 0x5e, 0xd1, 0x9c, 0xf0, 0x9e,
-	0xec, 0x33, 0xbd, 0x27, 0x77, 0x66, 0xef, 0xf9, 0xba, 0x0a, 0x15, 0x42, 0x3d, 0xea, 0x44, 0x2f,
-	0xf9, 0xed, 0xde, 0x09, 0x0c, 0x73, 0xa7, 0xee, 0x39, 0x57, 0x60, 0x39, 0x05, 0x42, 0x7e, 0x70,
-	0xf1, 0x0f, 0x74, 0x96, 0x07, 0xdf, 0xa3, 0x8e, 0x17, 0x27, 0x95, 0xa0, 0xf6, 0x27, 0x2a, 0x94,
-	0x09, 0xe3, 0xb8, 0x43, 0xda, 0x89, 0x9d, 0x38, 0xc2, 0x9f, 0x81, 0xa5, 0x7d, 0xae, 0x62, 0x4f,
-	0x3c, 0xa4, 0x48, 0x4a, 0x82, 0x27, 0x7e, 0x7d, 0xdc, 0x86, 0xf5, 0x88, 0xf6, 0x02, 0xbf, 0x1f,
-	0xd9, 0x0f, 0xe9, 0xbe, 0xeb, 0xf7, 0xed, 0xa1, 0x13, 0xc5, 0x34, 0xe4, 0xb0, 0x94, 0xc9, 0xaa,
-	0x14, 0xee, 0x70, 0x59, 0x93, 0x8b, 0xf0, 0x55, 0x58, 0x7b, 0xe8, 0xfa, 0x5e, 0x30, 0xb0, 0x47,
-	0x9e, 0x33, 0xa6, 0x61, 0x64, 0xf7, 0x82, 0x23, 0x5f, 0xe0, 0x91, 0x23, 0x58, 0xc8, 0xda, 0x42,
-	0x54, 0x63, 0x12, 0xfc, 0x3e, 0x5c, 0x9e, 0x3b, 0x8b, 0xfd, 0xc8, 0xf5, 0x62, 0x1a, 0xd2, 0xbe,
-	0x1d, 0xd2, 0x91, 0xe7, 0xf6, 0xc4, 0x0b, 0x2b, 0x01, 0xd4, 0xe7, 0xe7, 0x4c, 0xbd, 0x27, 0xd5,
-	0xc9, 0x44, 0x1b, 0x5f, 0x80, 0x62, 0x6f, 0x74, 0x64, 0x1f, 0xf1, 0x47, 0x0b, 0x0c, 0x3f, 0x85,
-	0x14, 0x7a, 0xa3, 0xa3, 0x2e, 0xa3, 0x31, 0x82, 0xcc, 0xe1, 0x48, 0x04, 0x67, 0x85, 0xb0, 0xa6,
-	0xf6, 0x3d, 0x05, 0x2a, 0xfa, 0x60, 0x10, 0xd2, 0x81, 0x13, 0x4b, 0x98, 0xae, 0xc2, 0x9a, 0x80,
-	0x64, 0x6c, 0x4b, 0x77, 0x15, 0xf6, 0x28, 0xc2, 0x1e, 0x29, 0x13, 0xbe, 0x2a, 0xec, 0xb9, 0x0e,
-	0xe7, 0x8e, 0xfc, 0xb9, 0x7d, 0x54, 0xde, 0x67, 0x2d, 0x95, 0x4e, 0xf7, 0xfa, 0x49, 0x78, 0x6d,
-	0x3e, 0x0a, 0x43, 0x57, 0xbc, 0x72, 0x2c, 0x93, 0x73, 0x73, 0x8c, 0x6e, 0xba, 0xfe, 0x53, 0xba,
-	0x3a, 0x1f, 0x70, 0xbc, 0x3e, 0xa6, 0xab, 0xf3, 0x81, 0xf6, 0x67, 0xe9, 0x6f, 0x8a, 0x89, 0xbb,
-	0xa4, 0x81, 0x23, 0x71, 0x64, 0xe5, 0x69, 0x8e, 0x5c, 0x85, 0x45, 0xe6, 0x8c, 0xae, 0x3f, 0xe0,
-	0xc6, 0x15, 0x48, 0x42, 0xe2, 0x0e, 0x7c, 0x5e, 0xda, 0x4e, 0x3f, 0x88, 0x69, 0xe8, 0x3b, 0x9e,
-	0x37, 0xb6, 0xc5, 0xf5, 0xa3, 0xcf, 0x1f, 0x94, 0xa5, 0xaf, 0x3e, 0x45, 0xf8, 0xf8, 0xac, 0xd0,
-	0x36, 0x52, 0x65, 0x92, 0xea, 0x5a, 0xe9, 0x7b, 0xd0, 0x2f, 0x41, 0x25, 0x94, 0x4e, 0x6c, 0x47,
-	0x6c, 0x7b, 0x64, 0xc8, 0x5d, 0x4b, 0x5e, 0x4d, 0x4c, 0x7b, 0x38, 0x29, 0x87, 0x33, 0x0e, 0xff,
-	0xdc, 0x01, 0xe7, 0x76, 0xb6, 0x90, 0x47, 0x8b, 0xda, 0x9f, 0x2b, 0xb0, 0x3a, 0xe7, 0xdb, 0x3d,
-	0xbd, 0x18, 0x50, 0xa6, 0xee, 0x1d, 0x7f, 0x1c, 0x72, 0xfc, 0x41, 0x8b, 0x7c, 0x43, 0x75, 0xfe,
-	0xf4, 0xa7, 0x3f, 0x7f, 0x7c, 0x42, 0x84, 0x16, 0x3b, 0x8b, 0xdc, 0x26, 0xf9, 0xda, 0x4e, 0x42,
-	0x52, 0x62, 0x3c, 0xf9, 0xc4, 0xee, 0xd4, 0x4d, 0x66, 0xf6, 0x99, 0x37, 0x99, 0x97, 0x7f, 0x3b,
-	0x03, 0xc5, 0xe6, 0xb8, 0x73, 0xe8, 0xed, 0x79, 0xce, 0x80, 0xbf, 0x0e, 0x69, 0xb6, 0xad, 0x07,
-	0x68, 0x01, 0xaf, 0x40, 0xd9, 0x6c, 0x59, 0xb6, 0xd9, 0x6d, 0x34, 0xec, 0xbd, 0x86, 0x7e, 0x0b,
-	0x29, 0x18, 0xc1, 0x52, 0x9b, 0xd4, 0xed, 0x3b, 0xc6, 0x03, 0xc1, 0x51, 0xf1, 0x2a, 0x2c, 0x77,
-	0xcd, 0xfa, 0xdd, 0xae, 0x31, 0x61, 0x66, 0xf1, 0x3a, 0xac, 0x34, 0xbb, 0x0d, 0xab, 0xde, 0x6e,
-	0x4c, 0xb1, 0x0b, 0xb8, 0x0c, 0xc5, 0x9d, 0x46, 0x6b, 0x47, 0x90, 0x88, 0x8d, 0xdf, 0x35, 0x3b,
-	0xf5, 0x5b, 0xa6, 0xb1, 0x2b, 0x58, 0x9b, 0x8c, 0xf5, 0xbe, 0x41, 0x5a, 0x7b, 0xf5, 0x64, 0xca,
-	0x77, 0x31, 0x82, 0xd2, 0x4e, 0xdd, 0xd4, 0x89, 0x1c, 0xe5, 0x89, 0x82, 0x2b, 0x50, 0x34, 0xcc,
-	0x6e, 0x53, 0xd2, 0x2a, 0xae, 0xc2, 0xaa, 0xde, 0xb5, 0x5a, 0x76, 0xdd, 0xac, 0x11, 0xa3, 0x69,
-	0x98, 0x96, 0x94, 0x64, 0xf1, 0x2a, 0x54, 0xac, 0x7a, 0xd3, 0xe8, 0x58, 0x7a, 0xb3, 0x2d, 0x99,
-	0x6c, 0x15, 0x85, 0x8e, 0x91, 0xe8, 0x20, 0xbc, 0x01, 0xeb, 0x66, 0xcb, 0x4e, 0x9e, 0xd6, 0xdd,
-	0xd3, 0x1b, 0x5d, 0x43, 0xca, 0x36, 0xf1, 0x79, 0xc0, 0x2d, 0xd3, 0xee, 0xb6, 0x77, 0x75, 0xcb,
-	0xb0, 0xcd, 0xd6, 0x7d, 0x29, 0x78, 0x17, 0x57, 0xa0, 0x30, 0x59, 0xc1, 0x13, 0x86, 0x42, 0xb9,
-	0xad, 0x13, 0x6b, 0x62, 0xec, 0x93, 0x27, 0x0c, 0x2c, 0xb8, 0x45, 0x5a, 0xdd, 0xf6, 0x44, 0x6d,
-	0x05, 0x4a, 0x12, 0x2c, 0xc9, 0xca, 0x32, 0xd6, 0x4e, 0xdd, 0xac, 0xa5, 0xeb, 0x7b, 0x52, 0xd8,
-	0x50, 0x91, 0x72, 0xf9, 0x00, 0xb2, 0x7c, 0x3b, 0x0a, 0x90, 0x35, 0x5b, 0xa6, 0x81, 0x16, 0xf0,
-	0x32, 0x40, 0xbd, 0x53, 0x37, 0x2d, 0xe3, 0x16, 0xd1, 0x1b, 0xcc, 0x6c, 0xce, 0x48, 0x00, 0x64,
-	0xd6, 0x2e, 0xc1, 0x62, 0xbd, 0xb3, 0xd7, 0x68, 0xe9, 0x96, 0x34, 0xb3, 0xde, 0xb9, 0xdb, 0x6d,
-	0x59, 0x4c, 0x88, 0x70, 0x09, 0xf2, 0xf5, 0x8e, 0x65, 0x7c, 0xc5, 0x62, 0x76, 0x71, 0x99, 0x40,
-	0x15, 0x3d, 0x79, 0xf7, 0xf2, 0xb7, 0x32, 0x90, 0xe5, 0xcf, 0xb9, 0xcb, 0x50, 0xe4, 0xbb, 0x6d,
-	0x3d, 0x68, 0xb3, 0x29, 0x8b, 0x90, 0xad, 0x9b, 0xd6, 0x4d, 0xf4, 0x73, 0x2a, 0x06, 0xc8, 0x75,
-	0x79, 0xfb, 0xe7, 0xf3, 0xac, 0x5d, 0x37, 0xad, 0xb7, 0x6f, 0xa0, 0xaf, 0xaa, 0x6c, 0xd8, 0xae,
-	0x20, 0x7e, 0x21, 0x11, 0x6c, 0x5f, 0x47, 0x5f, 0x4b, 0x05, 0xdb, 0xd7, 0xd1, 0x2f, 0x26, 0x82,
-	0x6b, 0xdb, 0xe8, 0xeb, 0xa9, 0xe0, 0xda, 0x36, 0xfa, 0xa5, 0x44, 0x70, 0xe3, 0x3a, 0xfa, 0xe5,
-	0x54, 0x70, 0xe3, 0x3a, 0xfa, 0x95, 0x3c, 0xb3, 0x85, 0x5b, 0x72, 0x6d, 0x1b, 0xfd, 0x6a, 0x21,
-	0xa5, 0x6e, 0x5c, 0x47, 0xbf, 0x56, 0x60, 0xfb, 0x9f, 0xee, 0x2a, 0xfa, 0x75, 0xc4, 0x96, 0xc9,
-	0x36, 0x08, 0xfd, 0x06, 0x6f, 0x32, 0x11, 0xfa, 0x4d, 0xc4, 0x6c, 0x64, 0x5c, 0x4e, 0x7e, 0x83,
-	0x4b, 0x1e, 0x18, 0x3a, 0x41, 0xbf, 0x95, 0x17, 0x8f, 0x3d, 0x6b, 0xf5, 0xa6, 0xde, 0x40, 0x98,
-	0xf7, 0x60, 0xa8, 0xfc, 0xce, 0x55, 0xd6, 0x64, 0xee, 0x89, 0x7e, 0xb7, 0xcd, 0x26, 0xbc, 0xa7,
-	0x93, 0xda, 0x7b, 0x3a, 0x41, 0xbf, 0x77, 0x95, 0x4d, 0x78, 0x4f, 0x27, 0x12, 0xaf, 0xdf, 0x6f,
-	0x33, 0x45, 0x2e, 0xfa, 0x83, 0xab, 0x6c, 0xd1, 0x92, 0xff, 0xcd, 0x36, 0x2e, 0x40, 0x66, 0xa7,
-	0x6e, 0xa1, 0x6f, 0xf1, 0xd9, 0x98, 0x8b, 0xa2, 0x3f, 0x44, 0x8c, 0xd9, 0x31, 0x2c, 0xf4, 0x6d,
-	0xc6, 0xcc, 0x59, 0xdd, 0x76, 0xc3, 0x40, 0xaf, 0xb3, 0xc5, 0xdd, 0x32, 0x5a, 0x4d, 0xc3, 0x22,
-	0x0f, 0xd0, 0x1f, 0x71, 0xf5, 0xdb, 0x9d, 0x96, 0x89, 0xbe, 0x83, 0x70, 0x05, 0xc0, 0xf8, 0x4a,
-	0x9b, 0x18, 0x9d, 0x4e, 0xbd, 0x65, 0xa2, 0x37, 0x2f, 0xef, 0x01, 0x3a, 0x19, 0x0e, 0x98, 0x01,
-	0x5d, 0xf3, 0x8e, 0xd9, 0xba, 0x6f, 0xa2, 0x05, 0x46, 0xb4, 0x89, 0xd1, 0xd6, 0x89, 0x81, 0x14,
-	0x0c, 0x90, 0x97, 0x4f, 0x48, 0x55, 0xbc, 0x04, 0x05, 0xd2, 0x6a, 0x34, 0x76, 0xf4, 0xda, 0x1d,
-	0x94, 0xd9, 0x31, 0xfe, 0xfa, 0xa3, 0x8b, 0xca, 0xdf, 0x7d, 0x74, 0x51, 0xf9, 0xee, 0x47, 0x17,
-	0x95, 0x6f, 0xfe, 0xeb, 0xc5, 0x05, 0x58, 0x76, 0x83, 0xad, 0x63, 0x37, 0xa6, 0x51, 0x24, 0xfe,
-	0x40, 0xf0, 0xbe, 0x26, 0x29, 0x37, 0xb8, 0x22, 0x5a, 0x57, 0x06, 0xc1, 0x95, 0xe3, 0xf8, 0x0a,
-	0x97, 0x5e, 0xe1, 0x11, 0xe4, 0x61, 0x9e, 0x13, 0xd7, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xc4,
-	0x64, 0x72, 0x89, 0x9e, 0x30, 0x00, 0x00,
+	// 3397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x5d, 0x70, 0x1b, 0x59,
+	0x56, 0x76, 0xb7, 0x7e, 0x2c, 0x1d, 0x59, 0xf2, 0xf5, 0xb5, 0x9d, 0x68, 0x3c, 0x33, 0x19, 0x6f,
+	0xef, 0xce, 0xae, 0x09, 0xe0, 0x64, 0x9c, 0x6c, 0x36, 0xcc, 0x2e, 0x30, 0x6d, 0xb9, 0xed, 0x51,
+	0x22, 0xb5, 0x94, 0xab, 0x56, 0xb2, 0x99, 0xa2, 0xaa, 0xab, 0x23, 0xdd, 0xc8, 0x5d, 0x6e, 0x75,
+	0x2b, 0xdd, 0x2d, 0x67, 0xfc, 0x16, 0x58, 0x96, 0xe5, 0x9f, 0xe5, 0x7f, 0x17, 0x8a, 0x2d, 0xaa,
+	0x78, 0xa0, 0x78, 0xe1, 0x99, 0x67, 0x1e, 0xa6, 0x28, 0xaa, 0xf8, 0x7b, 0x04, 0x1e, 0x58, 0x86,
+	0xa2, 0xe0, 0x89, 0xa2, 0x78, 0xe0, 0x81, 0x07, 0x8a, 0xba, 0x3f, 0xdd, 0x92, 0x6c, 0x4d, 0xe2,
+	0xcd, 0x32, 0xb5, 0x95, 0xcc, 0xbc, 0xdd, 0xf3, 0xd3, 0xf7, 0x9e, 0xf3, 0xdd, 0xd3, 0xe7, 0x9e,
+	0xbe, 0x3a, 0x82, 0xd2, 0xa3, 0x31, 0x0d, 0x4f, 0xb6, 0x47, 0x61, 0x10, 0x07, 0x38, 0xc7, 0x89,
+	0x8d, 0x4a, 0x1c, 0x8c, 0x82, 0xbe, 0x13, 0x3b, 0x82, 0xbd, 0x51, 0x3a, 0x8e, 0xc3, 0x51, 0x4f,
+	0x10, 0xda, 0xd7, 0x15, 0xc8, 0x5b, 0x4e, 0x38, 0xa0, 0x31, 0xde, 0x80, 0xc2, 0x11, 0x3d, 0x89,
+	0x46, 0x4e, 0x8f, 0x56, 0x95, 0x4d, 0x65, 0xab, 0x48, 0x52, 0x1a, 0xaf, 0x41, 0x2e, 0x3a, 0x74,
+	0xc2, 0x7e, 0x55, 0xe5, 0x02, 0x41, 0xe0, 0x2f, 0x42, 0x29, 0x76, 0x1e, 0x78, 0x34, 0xb6, 0xe3,
+	0x93, 0x11, 0xad, 0x66, 0x36, 0x95, 0xad, 0xca, 0xce, 0xda, 0x76, 0xba, 0x9e, 0xc5, 0x85, 0xd6,
+	0xc9, 0x88, 0x12, 0x88, 0xd3, 0x31, 0xc6, 0x90, 0xed, 0x51, 0xcf, 0xab, 0x66, 0xf9, 0x5c, 0x7c,
+	0xac, 0xed, 0x41, 0xe5, 0xae, 0x75, 0xe0, 0xc4, 0xb4, 0xe6, 0x78, 0x1e, 0x0d, 0xeb, 0x7b, 0xcc,
+	0x9c, 0x71, 0x44, 0x43, 0xdf, 0x19, 0xa6, 0xe6, 0x24, 0x34, 0xbe, 0x00, 0xf9, 0x41, 0x18, 0x8c,
+	0x47, 0x51, 0x55, 0xdd, 0xcc, 0x6c, 0x15, 0x89, 0xa4, 0xb4, 0x9f, 0x02, 0x30, 0x8e, 0xa9, 0x1f,
+	0x5b, 0xc1, 0x11, 0xf5, 0xf1, 0x6b, 0x50, 0x8c, 0xdd, 0x21, 0x8d, 0x62, 0x67, 0x38, 0xe2, 0x53,
+	0x64, 0xc8, 0x84, 0xf1, 0x11, 0x2e, 0x6d, 0x40, 0x61, 0x14, 0x44, 0x6e, 0xec, 0x06, 0x3e, 0xf7,
+	0xa7, 0x48, 0x52, 0x5a, 0xfb, 0x09, 0xc8, 0xdd, 0x75, 0xbc, 0x31, 0xc5, 0x6f, 0x40, 0x96, 0x3b,
+	0xac, 0x70, 0x87, 0x4b, 0xdb, 0x02, 0x74, 0xee, 0x27, 0x17, 0xb0, 0xb9, 0x8f, 0x99, 0x26, 0x9f,
+	0x7b, 0x89, 0x08, 0x42, 0x3b, 0x82, 0xa5, 0x5d, 0xd7, 0xef, 0xdf, 0x75, 0x42, 0x97, 0x81, 0xf1,
+	0x9c, 0xd3, 0xe0, 0xcf, 0x41, 0x9e, 0x0f, 0xa2, 0x6a, 0x66, 0x33, 0xb3, 0x55, 0xda, 0x59, 0x92,
+	0x0f, 0x72, 0xdb, 0x88, 0x94, 0x69, 0x7f, 0xae, 0x00, 0xec, 0x06, 0x63, 0xbf, 0x7f, 0x87, 0x09,
+	0x31, 0x82, 0x4c, 0xf4, 0xc8, 0x93, 0x40, 0xb2, 0x21, 0xbe, 0x0d, 0x95, 0x07, 0xae, 0xdf, 0xb7,
+	0x8f, 0xa5, 0x39, 0x02, 0xcb, 0xd2, 0xce, 0xe7, 0xe4, 0x74, 0x93, 0x87, 0xb7, 0xa7, 0xad, 0x8e,
+	0x0c, 0x3f, 0x0e, 0x4f, 0x48, 0xf9, 0xc1, 0x34, 0x6f, 0xa3, 0x0b, 0xf8, 0xac, 0x12, 0x5b, 0xf4,
+	0x88, 0x9e, 0x24, 0x8b, 0x1e, 0xd1, 0x13, 0xfc, 0x43, 0xd3, 0x1e, 0x95, 0x76, 0x56, 0x93, 0xb5,
+	0xa6, 0x9e, 0x95, 0x6e, 0xbe, 0xad, 0xde, 0x54, 0xb4, 0xbf, 0x2a, 0x40, 0xc5, 0x78, 0x9f, 0xf6,
+	0xc6, 0x31, 0x6d, 0x8d, 0xd8, 0x1e, 0x44, 0xb8, 0x09, 0xcb, 0xae, 0xdf, 0xf3, 0xc6, 0x7d, 0xda,
+	0xb7, 0x1f, 0xba, 0xd4, 0xeb, 0x47, 0x3c, 0x8e, 0x2a, 0xa9, 0xdd, 0xb3, 0xfa, 0xdb, 0x75, 0xa9,
+	0xbc, 0xcf, 0x75, 0x49, 0xc5, 0x9d, 0xa1, 0xf1, 0x65, 0x58, 0xe9, 0x79, 0x2e, 0xf5, 0x63, 0xfb,
+	0x21, 0xf3, 0xd7, 0x0e, 0x83, 0xc7, 0x51, 0x35, 0xb7, 0xa9, 0x6c, 0x15, 0xc8, 0xb2, 0x10, 0xec,
+	0x33, 0x3e, 0x09, 0x1e, 0x47, 0xf8, 0x6d, 0x28, 0x3c, 0x0e, 0xc2, 0x23, 0x2f, 0x70, 0xfa, 0xd5,
+	0x3c, 0x5f, 0xf3, 0xd2, 0xfc, 0x35, 0xef, 0x49, 0x2d, 0x92, 0xea, 0xe3, 0x2d, 0x40, 0xd1, 0x23,
+	0xcf, 0x8e, 0xa8, 0x47, 0x7b, 0xb1, 0xed, 0xb9, 0x43, 0x37, 0xae, 0x16, 0x78, 0x48, 0x56, 0xa2,
+	0x47, 0x5e, 0x87, 0xb3, 0x1b, 0x8c, 0x8b, 0x6d, 0x58, 0x8f, 0x43, 0xc7, 0x8f, 0x9c, 0x1e, 0x9b,
+	0xcc, 0x76, 0xa3, 0xc0, 0x73, 0xd8, 0xa8, 0x5a, 0xe4, 0x4b, 0x5e, 0x9e, 0xbf, 0xa4, 0x35, 0x79,
+	0xa4, 0x9e, 0x3c, 0x41, 0xd6, 0xe2, 0x39, 0x5c, 0xfc, 0x16, 0xac, 0x47, 0x47, 0xee, 0xc8, 0xe6,
+	0xf3, 0xd8, 0x23, 0xcf, 0xf1, 0xed, 0x9e, 0xd3, 0x3b, 0xa4, 0x55, 0xe0, 0x6e, 0x63, 0x26, 0xe4,
+	0xfb, 0xde, 0xf6, 0x1c, 0xbf, 0xc6, 0x24, 0x0c, 0x74, 0xa6, 0xe7, 0xd3, 0xd0, 0x3e, 0xa6, 0x61,
+	0xc4, 0xac, 0x29, 0x3d, 0x0d, 0xf4, 0xb6, 0x50, 0xbe, 0x2b, 0x74, 0x49, 0x65, 0x34, 0x43, 0xe3,
+	0x2f, 0xc2, 0xc5, 0x43, 0x27, 0xb2, 0x7b, 0x21, 0x75, 0x62, 0xda, 0xb7, 0x63, 0x3a, 0x1c, 0xd9,
+	0xb1, 0x88, 0xc1, 0x25, 0x6e, 0xc3, 0xda, 0xa1, 0x13, 0xd5, 0x84, 0xd4, 0xa2, 0xc3, 0x11, 0xcf,
+	0x23, 0x11, 0x33, 0x3c, 0xa4, 0x4e, 0xdf, 0x76, 0x1e, 0xc6, 0x34, 0xb4, 0x1f, 0x87, 0x6e, 0x4c,
+	0xed, 0x41, 0xec, 0xf6, 0xab, 0x65, 0x1e, 0x60, 0x98, 0x09, 0x75, 0x26, 0xbb, 0xc7, 0x44, 0x07,
+	0xb1, 0xdb, 0xc7, 0x5f, 0x82, 0xea, 0x99, 0x47, 0x58, 0x0a, 0x08, 0xc6, 0x71, 0xb5, 0xb2, 0xa9,
+	0x6c, 0x29, 0x64, 0x7d, 0xf6, 0x29, 0x4b, 0x08, 0xf1, 0x36, 0xac, 0x46, 0x34, 0x62, 0xd6, 0xda,
+	0x71, 0xe8, 0xf4, 0x8e, 0xf8, 0x42, 0x51, 0x75, 0x99, 0x9b, 0xb7, 0x22, 0x45, 0x16, 0x93, 0xb0,
+	0x75, 0x22, 0xed, 0xcb, 0x50, 0x99, 0x8d, 0x34, 0xbc, 0x02, 0x65, 0xeb, 0x7e, 0xdb, 0xb0, 0x75,
+	0x73, 0xcf, 0x36, 0xf5, 0xa6, 0x81, 0x16, 0x70, 0x19, 0x8a, 0x9c, 0xd5, 0x32, 0x1b, 0xf7, 0x91,
+	0x82, 0x17, 0x21, 0xa3, 0x37, 0x1a, 0x48, 0xd5, 0x6e, 0x42, 0x21, 0x09, 0x19, 0xbc, 0x0c, 0xa5,
+	0xae, 0xd9, 0x69, 0x1b, 0xb5, 0xfa, 0x7e, 0xdd, 0xd8, 0x43, 0x0b, 0xb8, 0x00, 0xd9, 0x56, 0xc3,
+	0x6a, 0x23, 0x45, 0x8c, 0xf4, 0x36, 0x52, 0xd9, 0x93, 0x7b, 0xbb, 0x3a, 0xca, 0x68, 0x7f, 0xac,
+	0xc0, 0xda, 0xbc, 0xad, 0xc7, 0x25, 0x58, 0xdc, 0x33, 0xf6, 0xf5, 0x6e, 0xc3, 0x42, 0x0b, 0x78,
+	0x15, 0x96, 0x89, 0xd1, 0x36, 0x74, 0x4b, 0xdf, 0x6d, 0x18, 0x36, 0x31, 0xf4, 0x3d, 0xa4, 0x60,
+	0x0c, 0x15, 0x36, 0xb2, 0x6b, 0xad, 0x66, 0xb3, 0x6e, 0x59, 0xc6, 0x1e, 0x52, 0xf1, 0x1a, 0x20,
+	0xce, 0xeb, 0x9a, 0x13, 0x6e, 0x06, 0x23, 0x58, 0xea, 0x18, 0xa4, 0xae, 0x37, 0xea, 0xef, 0xb1,
+	0x09, 0x50, 0x16, 0x7f, 0x06, 0x5e, 0xaf, 0xb5, 0xcc, 0x4e, 0xbd, 0x63, 0x19, 0xa6, 0x65, 0x77,
+	0x4c, 0xbd, 0xdd, 0x79, 0xb7, 0x65, 0xf1, 0x99, 0x85, 0x73, 0x39, 0x5c, 0x01, 0xd0, 0xbb, 0x56,
+	0x4b, 0xcc, 0x83, 0xf2, 0xda, 0x23, 0xa8, 0xcc, 0x46, 0x05, 0xb3, 0x4a, 0x9a, 0x68, 0xb7, 0x1b,
+	0xba, 0x69, 0x1a, 0x04, 0x2d, 0xe0, 0x3c, 0xa8, 0x77, 0xaf, 0x09, 0x5f, 0x0f, 0xa8, 0x7f, 0x1d,
+	0xa9, 0x6c, 0x22, 0x36, 0x3a, 0x08, 0x29, 0xed, 0x9f, 0xa0, 0x0c, 0xb3, 0x9b, 0xd1, 0x0d, 0xfa,
+	0x30, 0xde, 0x21, 0xee, 0xe0, 0x30, 0x46, 0x59, 0x66, 0x37, 0xe3, 0xdd, 0x73, 0xe3, 0xc3, 0x7d,
+	0xc7, 0xf3, 0x1e, 0x38, 0xbd, 0x23, 0x94, 0xbb, 0x95, 0x2d, 0x28, 0x48, 0xbd, 0x95, 0x2d, 0xa8,
+	0x28, 0x73, 0x2b, 0x5b, 0xc8, 0xa0, 0xac, 0xf6, 0x67, 0x2a, 0xe4, 0xf8, 0xf6, 0xb0, 0x33, 0x68,
+	0xea, 0x64, 0xe1, 0xe3, 0x34, 0x1f, 0xab, 0x4f, 0xc9, 0xc7, 0x3c, 0x4c, 0xe5, 0xc9, 0x20, 0x08,
+	0xfc, 0x2a, 0x14, 0x83, 0x70, 0x20, 0x02, 0x58, 0x9e, 0x69, 0x85, 0x20, 0x1c, 0xf0, 0xa0, 0x65,
+	0xe7, 0x09, 0x3b, 0x0a, 0x1f, 0x38, 0x11, 0xe5, 0x69, 0xa5, 0x48, 0x52, 0x1a, 0xbf, 0x02, 0x4c,
+	0xcf, 0xe6, 0x76, 0xe4, 0xb9, 0x6c, 0x31, 0x08, 0x07, 0x26, 0x33, 0xe5, 0xb3, 0x50, 0xee, 0x05,
+	0xde, 0x78, 0xe8, 0xdb, 0x1e, 0xf5, 0x07, 0xf1, 0x61, 0x75, 0x71, 0x53, 0xd9, 0x2a, 0x93, 0x25,
+	0xc1, 0x6c, 0x70, 0x1e, 0xae, 0xc2, 0x62, 0xef, 0xd0, 0x09, 0x23, 0x2a, 0x52, 0x49, 0x99, 0x24,
+	0x24, 0x5f, 0x95, 0xf6, 0xdc, 0xa1, 0xe3, 0x45, 0x3c, 0x6d, 0x94, 0x49, 0x4a, 0x33, 0x27, 0x1e,
+	0x7a, 0xce, 0x20, 0xe2, 0xaf, 0x7b, 0x99, 0x08, 0x02, 0xbf, 0x01, 0x25, 0xb9, 0x20, 0x87, 0xa0,
+	0xc4, 0xcd, 0x01, 0xc1, 0x62, 0x08, 0x68, 0x5f, 0x82, 0x0c, 0x09, 0x1e, 0xb3, 0x35, 0x85, 0x45,
+	0x51, 0x55, 0xd9, 0xcc, 0x6c, 0x61, 0x92, 0x90, 0xec, 0x4c, 0x96, 0xc7, 0x92, 0x38, 0xad, 0x92,
+	0x83, 0xe8, 0x6f, 0x14, 0x28, 0xf1, 0x74, 0x42, 0x68, 0x34, 0xf6, 0x62, 0x76, 0x7c, 0xc9, 0xbc,
+	0xad, 0xcc, 0x1c, 0x5f, 0x7c, 0x5f, 0x88, 0x94, 0x31, 0x00, 0x58, 0x2a, 0xb6, 0x9d, 0x87, 0x0f,
+	0x69, 0x2f, 0xa6, 0xe2, 0x94, 0xce, 0x92, 0x25, 0xc6, 0xd4, 0x25, 0x8f, 0x21, 0xef, 0xfa, 0x11,
+	0x0d, 0x63, 0xdb, 0xed, 0xf3, 0x3d, 0xc9, 0x92, 0x82, 0x60, 0xd4, 0xfb, 0xf8, 0x12, 0x64, 0x79,
+	0x32, 0xcf, 0xf2, 0x55, 0x40, 0xae, 0x42, 0x82, 0xc7, 0x84, 0xf3, 0xf1, 0x0e, 0xac, 0x27, 0x6f,
+	0x78, 0x14, 0x3b, 0x31, 0xb5, 0x7b, 0x87, 0x8e, 0x3f, 0xa0, 0x91, 0xdc, 0x8a, 0xe4, 0xf5, 0xef,
+	0x30, 0x59, 0x4d, 0x88, 0x6e, 0x65, 0x0b, 0x39, 0x94, 0xd7, 0xbe, 0x02, 0x4b, 0xdc, 0xa1, 0x7b,
+	0x4e, 0xe8, 0xbb, 0xfe, 0x80, 0xd7, 0x33, 0x41, 0x5f, 0xc4, 0x52, 0x99, 0xf0, 0x31, 0xc3, 0x69,
+	0x48, 0xa3, 0xc8, 0x19, 0x50, 0x59, 0x5f, 0x24, 0xa4, 0xf6, 0x87, 0x19, 0x28, 0x75, 0xe2, 0x90,
+	0x3a, 0x43, 0x5e, 0xaa, 0xe0, 0xaf, 0x00, 0xf0, 0xf5, 0x87, 0xd4, 0x8f, 0x13, 0x4c, 0x5e, 0x93,
+	0xd6, 0x4e, 0xe9, 0x6d, 0x77, 0x12, 0x25, 0x32, 0xa5, 0x8f, 0x77, 0xa0, 0x44, 0x99, 0xd8, 0x8e,
+	0x59, 0xc9, 0x23, 0x8f, 0xd5, 0x95, 0x24, 0x2b, 0xa7, 0xb5, 0x10, 0x01, 0x9a, 0x8e, 0x37, 0xbe,
+	0xa3, 0x42, 0x31, 0x9d, 0x0d, 0xeb, 0x50, 0xe8, 0x39, 0x31, 0x1d, 0x04, 0xe1, 0x89, 0xac, 0x44,
+	0xde, 0x7c, 0xda, 0xea, 0xdb, 0x35, 0xa9, 0x4c, 0xd2, 0xc7, 0xf0, 0xeb, 0x20, 0xca, 0x3b, 0x11,
+	0xca, 0xc2, 0xdf, 0x22, 0xe7, 0xf0, 0x60, 0x7e, 0x1b, 0xf0, 0x28, 0x74, 0x87, 0x4e, 0x78, 0x62,
+	0x1f, 0xd1, 0x93, 0xe4, 0xd4, 0xce, 0xcc, 0xd9, 0x7d, 0x24, 0xf5, 0x6e, 0xd3, 0x13, 0x99, 0x45,
+	0x6f, 0xce, 0x3e, 0x2b, 0x23, 0xec, 0xec, 0x9e, 0x4e, 0x3d, 0xc9, 0xeb, 0xa0, 0x28, 0xa9, 0x78,
+	0x72, 0x3c, 0x18, 0xd9, 0x50, 0xfb, 0x02, 0x14, 0x12, 0xe3, 0x71, 0x11, 0x72, 0x46, 0x18, 0x06,
+	0x21, 0x5a, 0xe0, 0xc9, 0xb4, 0xd9, 0x10, 0xf9, 0x78, 0x6f, 0x8f, 0xe5, 0xe3, 0x7f, 0x56, 0xd3,
+	0xb2, 0x83, 0xd0, 0x47, 0x63, 0x1a, 0xc5, 0xf8, 0x27, 0x61, 0x95, 0xf2, 0xb0, 0x73, 0x8f, 0xa9,
+	0xdd, 0xe3, 0x35, 0x2a, 0x0b, 0x3a, 0x85, 0xe3, 0xbd, 0xbc, 0x2d, 0x4a, 0xea, 0xa4, 0x76, 0x25,
+	0x2b, 0xa9, 0xae, 0x64, 0xf5, 0xb1, 0x01, 0xab, 0xee, 0x70, 0x48, 0xfb, 0x2e, 0x0f, 0xb5, 0x74,
+	0x02, 0xb1, 0x61, 0xeb, 0x49, 0x09, 0x37, 0x53, 0x02, 0x93, 0x95, 0xf4, 0x89, 0x74, 0x9a, 0x37,
+	0x21, 0x1f, 0xf3, 0x72, 0x9d, 0xc7, 0x7b, 0x69, 0xa7, 0x9c, 0x64, 0x29, 0xce, 0x24, 0x52, 0x88,
+	0xbf, 0x00, 0xa2, 0xf8, 0xe7, 0xf9, 0x68, 0x12, 0x10, 0x93, 0x9a, 0x8e, 0x08, 0x39, 0x7e, 0x13,
+	0x2a, 0x33, 0xd5, 0x46, 0x9f, 0x03, 0x96, 0x21, 0xe5, 0x29, 0x6e, 0xbd, 0x8f, 0xaf, 0xc0, 0x62,
+	0x20, 0xce, 0xf6, 0x6a, 0x7e, 0xc6, 0xe2, 0xd9, 0x83, 0x9f, 0x24, 0x5a, 0x2c, 0x9f, 0x84, 0x34,
+	0xa2, 0xe1, 0x31, 0xed, 0xb3, 0x49, 0x17, 0xf9, 0xa4, 0x90, 0xb0, 0xea, 0x7d, 0xed, 0xc7, 0x61,
+	0x39, 0x85, 0x38, 0x1a, 0x05, 0x7e, 0x44, 0xf1, 0x65, 0xc8, 0x87, 0x3c, 0x47, 0x48, 0x58, 0xb1,
+	0x5c, 0x63, 0x2a, 0x7b, 0x10, 0xa9, 0xa1, 0xf5, 0x61, 0x59, 0x70, 0x58, 0xce, 0xe7, 0x3b, 0x89,
+	0xdf, 0x84, 0x1c, 0x65, 0x83, 0x53, 0x9b, 0x42, 0xda, 0x35, 0x2e, 0x27, 0x42, 0x3a, 0xb5, 0x8a,
+	0xfa, 0xcc, 0x55, 0xfe, 0x53, 0x85, 0x55, 0x69, 0xe5, 0xae, 0x13, 0xf7, 0x0e, 0x5f, 0xd0, 0x68,
+	0xf8, 0x61, 0x58, 0x64, 0x7c, 0x37, 0x7d, 0x73, 0xe6, 0xc4, 0x43, 0xa2, 0xc1, 0x22, 0xc2, 0x89,
+	0xec, 0xa9, 0xed, 0x97, 0xe5, 0x70, 0xd9, 0x89, 0xa6, 0x2a, 0x8d, 0x39, 0x81, 0x93, 0x7f, 0x46,
+	0xe0, 0x2c, 0x9e, 0x27, 0x70, 0xb4, 0x3d, 0x58, 0x9b, 0x45, 0x5c, 0x06, 0xc7, 0x8f, 0xc0, 0xa2,
+	0xd8, 0x94, 0x24, 0x47, 0xce, 0xdb, 0xb7, 0x44, 0x45, 0xfb, 0x40, 0x85, 0x35, 0x99, 0xbe, 0x3e,
+	0x19, 0xef, 0xf1, 0x14, 0xce, 0xb9, 0x73, 0xbd, 0xa0, 0xe7, 0xdb, 0x3f, 0xad, 0x06, 0xeb, 0xa7,
+	0x70, 0x7c, 0x8e, 0x97, 0xf5, 0x3f, 0x14, 0x58, 0xda, 0xa5, 0x03, 0xd7, 0x7f, 0x41, 0x77, 0x61,
+	0x0a, 0xdc, 0xec, 0xb9, 0x82, 0x78, 0x04, 0x65, 0xe9, 0xaf, 0x44, 0xeb, 0x2c, 0xda, 0xca, 0xbc,
+	0xb7, 0xe5, 0x26, 0x2c, 0xc9, 0x0b, 0x15, 0xc7, 0x73, 0x9d, 0x28, 0xf5, 0xe7, 0xd4, 0x8d, 0x8a,
+	0xce, 0x84, 0xa4, 0x14, 0x4f, 0x08, 0xed, 0x5f, 0x15, 0x28, 0xd7, 0x82, 0xe1, 0xd0, 0x8d, 0x5f,
+	0x50, 0x8c, 0xcf, 0x22, 0x94, 0x9d, 0x17, 0x8f, 0x6f, 0x41, 0x25, 0x71, 0x53, 0x42, 0x7b, 0xea,
+	0xa4, 0x51, 0xce, 0x9c, 0x34, 0xff, 0xa6, 0xc0, 0x32, 0x09, 0xc4, 0x57, 0xc1, 0xcb, 0x0d, 0xce,
+	0x35, 0x40, 0x13, 0x47, 0xcf, 0x0b, 0xcf, 0xff, 0x28, 0x50, 0x69, 0x87, 0x74, 0xe4, 0x84, 0xf4,
+	0xa5, 0x46, 0x87, 0x95, 0xe9, 0xfd, 0x58, 0x16, 0x38, 0x45, 0xc2, 0xc7, 0xda, 0x0a, 0x2c, 0xa7,
+	0xbe, 0x0b, 0xc0, 0xb4, 0x7f, 0x50, 0x60, 0x5d, 0x84, 0x98, 0x94, 0xf4, 0x5f, 0x50, 0x58, 0x12,
+	0x7f, 0xb3, 0x53, 0xfe, 0x56, 0xe1, 0xc2, 0x69, 0xdf, 0xa4, 0xdb, 0x5f, 0x53, 0xe1, 0x62, 0x12,
+	0x3c, 0x2f, 0xb8, 0xe3, 0xdf, 0x47, 0x3c, 0x6c, 0x40, 0xf5, 0x2c, 0x08, 0x12, 0xa1, 0x6f, 0xaa,
+	0x50, 0x15, 0x97, 0x52, 0x53, 0x75, 0xd0, 0xcb, 0x13, 0x1b, 0xf8, 0x2d, 0x58, 0x1a, 0x39, 0x61,
+	0xec, 0xf6, 0xdc, 0x91, 0xc3, 0x3e, 0x45, 0x73, 0x9b, 0x99, 0xb3, 0x13, 0xcc, 0xa8, 0x68, 0xaf,
+	0xc2, 0x2b, 0x73, 0x10, 0x91, 0x78, 0xfd, 0xaf, 0x02, 0xb8, 0x13, 0x3b, 0x61, 0xfc, 0x09, 0x38,
+	0x97, 0xe6, 0x06, 0xd3, 0x3a, 0xac, 0xce, 0xf8, 0x3f, 0x8d, 0x0b, 0x8d, 0x3f, 0x11, 0x47, 0xd2,
+	0x47, 0xe2, 0x32, 0xed, 0xbf, 0xc4, 0xe5, 0x9f, 0x14, 0xd8, 0xa8, 0x05, 0xe2, 0x12, 0xf5, 0xa5,
+	0x7c, 0xc3, 0xb4, 0xd7, 0xe1, 0xd5, 0xb9, 0x0e, 0x4a, 0x00, 0xfe, 0x51, 0x81, 0x0b, 0x84, 0x3a,
+	0xfd, 0x97, 0xd3, 0xf9, 0x3b, 0x70, 0xf1, 0x8c, 0x73, 0xb2, 0x46, 0xb9, 0x01, 0x85, 0x21, 0x8d,
+	0x9d, 0xbe, 0x13, 0x3b, 0xd2, 0xa5, 0x8d, 0x64, 0xde, 0x89, 0x76, 0x53, 0x6a, 0x90, 0x54, 0x57,
+	0xfb, 0xae, 0x0a, 0xab, 0xbc, 0xce, 0xfe, 0xf4, 0x23, 0xef, 0x5c, 0xb7, 0x30, 0xf9, 0xd3, 0xc5,
+	0x1f, 0x53, 0x18, 0x85, 0xd4, 0x4e, 0x6e, 0x07, 0x16, 0xf9, 0xaf, 0xa9, 0x30, 0x0a, 0xe9, 0x1d,
+	0xc1, 0xd1, 0xfe, 0x52, 0x81, 0xb5, 0x59, 0x88, 0xd3, 0x2f, 0x9a, 0xff, 0xef, 0xdb, 0x96, 0x39,
+	0x29, 0x25, 0x73, 0x9e, 0x8f, 0xa4, 0xec, 0xb9, 0x3f, 0x92, 0xfe, 0x5a, 0x85, 0xea, 0xb4, 0x33,
+	0x9f, 0xde, 0xe9, 0xcc, 0xde, 0xe9, 0x7c, 0xaf, 0xb7, 0x7c, 0xda, 0xdf, 0x29, 0xf0, 0xca, 0x1c,
+	0x40, 0xbf, 0xb7, 0x10, 0x99, 0xba, 0xd9, 0x51, 0x9f, 0x79, 0xb3, 0xf3, 0xf1, 0x07, 0xc9, 0xdf,
+	0x2b, 0xb0, 0xd6, 0x14, 0x77, 0xf5, 0xe2, 0xe6, 0xe3, 0xc5, 0xcd, 0xc1, 0xfc, 0x3a, 0x3e, 0x3b,
+	0xf9, 0x85, 0x8b, 0xdd, 0xe6, 0x9c, 0x72, 0xed, 0x39, 0x6e, 0x73, 0xfe, 0x5b, 0x81, 0x15, 0x39,
+	0x8b, 0xde, 0x3b, 0x7a, 0x79, 0xd0, 0xc1, 0x97, 0x20, 0xe3, 0xf6, 0x93, 0xba, 0x77, 0xb6, 0xab,
+	0x82, 0x09, 0xb4, 0x77, 0x00, 0x4f, 0xfb, 0xfd, 0x1c, 0xd0, 0xfd, 0xbb, 0x0a, 0xeb, 0x44, 0x64,
+	0xdf, 0x4f, 0x7f, 0x5f, 0xf8, 0x7e, 0x7f, 0x5f, 0x78, 0xfa, 0xc1, 0xf5, 0x01, 0x2f, 0xa6, 0x66,
+	0xa1, 0xfe, 0xf8, 0x8e, 0xae, 0x53, 0x07, 0x6d, 0xe6, 0xcc, 0x41, 0xfb, 0xfc, 0xf9, 0xe8, 0x03,
+	0x15, 0x36, 0xa4, 0x23, 0x9f, 0xd6, 0x3a, 0xe7, 0x8f, 0x88, 0xfc, 0x99, 0x88, 0xf8, 0x2f, 0x05,
+	0x5e, 0x9d, 0x0b, 0xe4, 0x0f, 0xbc, 0xa2, 0x39, 0x15, 0x3d, 0xd9, 0x67, 0x46, 0x4f, 0xee, 0xdc,
+	0xd1, 0xf3, 0x0d, 0x15, 0x2a, 0x84, 0x7a, 0xd4, 0x89, 0x5e, 0xf2, 0xdb, 0xbd, 0x53, 0x18, 0xe6,
+	0xce, 0xdc, 0x73, 0xae, 0xc0, 0x72, 0x0a, 0x84, 0xfc, 0xe0, 0xe2, 0x1f, 0xe8, 0xec, 0x1c, 0x7c,
+	0x97, 0x3a, 0x5e, 0x9c, 0x54, 0x82, 0xda, 0x1f, 0xa9, 0x50, 0x26, 0x8c, 0xe3, 0x0e, 0x29, 0xfb,
+	0xdd, 0x3b, 0xc2, 0x9f, 0x81, 0xa5, 0x43, 0xae, 0x62, 0x4f, 0x22, 0xa4, 0x48, 0x4a, 0x82, 0x27,
+	0x7e, 0x7d, 0xe4, 0xed, 0x04, 0xbd, 0xc0, 0xef, 0x47, 0xf6, 0x03, 0x7a, 0xc8, 0x1a, 0xeb, 0x86,
+	0x4e, 0x14, 0xd3, 0x90, 0xc3, 0x52, 0x26, 0xab, 0x52, 0xb8, 0xcb, 0x65, 0x4d, 0x2e, 0xc2, 0x57,
+	0x61, 0xed, 0x81, 0xeb, 0x7b, 0xc1, 0x80, 0x75, 0x61, 0x9d, 0xd0, 0x30, 0xb2, 0x7b, 0xc1, 0xd8,
+	0x17, 0x78, 0xe4, 0x08, 0x16, 0xb2, 0xb6, 0x10, 0xd5, 0x98, 0x04, 0xbf, 0x07, 0x97, 0xe7, 0xae,
+	0x62, 0x3f, 0x74, 0xbd, 0x98, 0x86, 0xb4, 0x6f, 0x87, 0x74, 0xe4, 0xb9, 0x3d, 0xd1, 0x31, 0x26,
+	0x80, 0xfa, 0xfc, 0x9c, 0xa5, 0xf7, 0xa5, 0x3a, 0x99, 0x68, 0xb3, 0x6e, 0x8a, 0xde, 0x68, 0x6c,
+	0x8f, 0x79, 0xd3, 0x42, 0x8e, 0x37, 0x47, 0x15, 0x7a, 0xa3, 0x71, 0x97, 0xd1, 0xec, 0xd7, 0xf4,
+	0x47, 0x23, 0x91, 0x9c, 0x15, 0xc2, 0x86, 0xec, 0x47, 0x9d, 0x8a, 0x3e, 0x18, 0x84, 0x74, 0xe0,
+	0xc4, 0x12, 0xa6, 0xab, 0xb0, 0x26, 0x20, 0x39, 0xb1, 0x65, 0xb8, 0x0a, 0x7f, 0x14, 0xe1, 0x8f,
+	0x94, 0x89, 0x58, 0x15, 0xfe, 0x5c, 0x87, 0x0b, 0x63, 0x7f, 0xee, 0x33, 0x2a, 0x7f, 0x66, 0x6d,
+	0xec, 0xcf, 0x79, 0xea, 0xc7, 0xe0, 0x95, 0xf9, 0x28, 0x0c, 0x5d, 0xd1, 0xb5, 0x59, 0x26, 0x17,
+	0xe6, 0x38, 0xdd, 0x74, 0xfd, 0xa7, 0x3c, 0xea, 0xbc, 0x5f, 0xcd, 0x7e, 0xf4, 0xa3, 0xce, 0xfb,
+	0xda, 0x9f, 0xa4, 0xbf, 0x29, 0x26, 0xe1, 0x92, 0x26, 0x8e, 0x24, 0x90, 0x95, 0xa7, 0x05, 0x72,
+	0x15, 0x16, 0x59, 0x30, 0xba, 0xfe, 0x80, 0x3b, 0x57, 0x20, 0x09, 0x89, 0x3b, 0xf0, 0x79, 0xe9,
+	0x3b, 0x7d, 0x3f, 0xa6, 0xa1, 0xef, 0x78, 0xde, 0x89, 0x2d, 0xae, 0x1f, 0x7d, 0xde, 0x20, 0x97,
+	0x76, 0xb1, 0x8a, 0xf4, 0xf1, 0x59, 0xa1, 0x6d, 0xa4, 0xca, 0x24, 0xd5, 0xb5, 0x12, 0x55, 0xfc,
+	0x65, 0xa8, 0x84, 0x32, 0x88, 0x79, 0x83, 0x4b, 0x72, 0xe6, 0xac, 0x49, 0xeb, 0x66, 0x22, 0x9c,
+	0x94, 0xc3, 0x69, 0xf2, 0xf9, 0x13, 0xce, 0xad, 0x6c, 0x21, 0x8f, 0x16, 0xb5, 0x3f, 0x55, 0x60,
+	0x75, 0xce, 0xb7, 0x7b, 0x7a, 0x31, 0xa0, 0x4c, 0xdd, 0x3b, 0xfe, 0x28, 0xe4, 0x98, 0x7d, 0x49,
+	0xdf, 0xd5, 0xc5, 0xb3, 0x9f, 0xfe, 0xcc, 0x26, 0x4a, 0x84, 0x16, 0x7b, 0x17, 0xb9, 0x4f, 0xb2,
+	0x7b, 0x50, 0x42, 0x52, 0x62, 0x3c, 0xd9, 0x32, 0x78, 0xe6, 0x26, 0x33, 0xfb, 0xcc, 0x9b, 0xcc,
+	0xcb, 0xbf, 0x91, 0x81, 0x62, 0xf3, 0xa4, 0xf3, 0xc8, 0xdb, 0xf7, 0x9c, 0x01, 0xef, 0x0e, 0x69,
+	0xb6, 0xad, 0xfb, 0x68, 0x81, 0xb5, 0xf1, 0x99, 0x2d, 0xcb, 0x36, 0xbb, 0x8d, 0x86, 0xbd, 0xdf,
+	0xd0, 0x0f, 0x90, 0xc2, 0xfa, 0xe1, 0xda, 0xa4, 0x6e, 0xdf, 0x36, 0xee, 0x0b, 0x8e, 0xca, 0x5a,
+	0xd9, 0xba, 0x66, 0xfd, 0x4e, 0xd7, 0x98, 0x30, 0xb3, 0x78, 0x1d, 0x56, 0x9a, 0xdd, 0x86, 0x55,
+	0x6f, 0x37, 0xa6, 0xd8, 0x05, 0xd6, 0x04, 0xb8, 0xdb, 0x68, 0xed, 0x0a, 0x12, 0xb1, 0xf9, 0xbb,
+	0x66, 0xa7, 0x7e, 0x60, 0x1a, 0x7b, 0x82, 0xb5, 0xc9, 0x58, 0xef, 0x19, 0xa4, 0xb5, 0x5f, 0x4f,
+	0x96, 0x7c, 0x07, 0x23, 0x28, 0xed, 0xd6, 0x4d, 0x9d, 0xc8, 0x59, 0x9e, 0x28, 0xb8, 0x02, 0x45,
+	0xc3, 0xec, 0x36, 0x25, 0xad, 0xe2, 0x2a, 0xac, 0xb2, 0x7e, 0x3b, 0xbb, 0x6e, 0xd6, 0x88, 0xd1,
+	0x64, 0x6d, 0x79, 0x42, 0x92, 0xc5, 0xab, 0x50, 0xb1, 0xea, 0x4d, 0xa3, 0x63, 0xe9, 0xcd, 0xb6,
+	0x64, 0x32, 0x2b, 0x0a, 0x1d, 0x23, 0xd1, 0x41, 0x78, 0x03, 0xd6, 0xcd, 0x96, 0x9d, 0xb4, 0xe3,
+	0xdd, 0xd5, 0x1b, 0x5d, 0x43, 0xca, 0x36, 0xf1, 0x45, 0xc0, 0x2d, 0xd3, 0xee, 0xb6, 0xf7, 0x74,
+	0xcb, 0xb0, 0xcd, 0xd6, 0x3d, 0x29, 0x78, 0x07, 0x57, 0xa0, 0x30, 0xb1, 0xe0, 0x09, 0x43, 0xa1,
+	0xdc, 0xd6, 0x89, 0x35, 0x71, 0xf6, 0xc9, 0x13, 0x06, 0x16, 0x1c, 0x90, 0x56, 0xb7, 0x3d, 0x51,
+	0x5b, 0x81, 0x92, 0x04, 0x4b, 0xb2, 0xb2, 0x8c, 0xb5, 0x5b, 0x37, 0x6b, 0xa9, 0x7d, 0x4f, 0x0a,
+	0x1b, 0x2a, 0x52, 0x2e, 0x1f, 0x41, 0x96, 0x6f, 0x47, 0x01, 0xb2, 0x66, 0xcb, 0x64, 0x1d, 0x94,
+	0xcb, 0x00, 0xf5, 0x4e, 0xdd, 0xb4, 0x8c, 0x03, 0xa2, 0x37, 0x98, 0xdb, 0x9c, 0x91, 0x00, 0xc8,
+	0xbc, 0x5d, 0x82, 0xc5, 0x7a, 0x67, 0xbf, 0xd1, 0xd2, 0x2d, 0xe9, 0x66, 0xbd, 0x73, 0xa7, 0xdb,
+	0x62, 0x8d, 0x8c, 0x4f, 0x10, 0x2e, 0x41, 0x9e, 0xf5, 0x2c, 0x7e, 0xd5, 0x62, 0x7e, 0x71, 0x99,
+	0x40, 0x15, 0x3d, 0x79, 0xe7, 0xf2, 0xb7, 0x33, 0x90, 0xe5, 0xed, 0xe9, 0x65, 0x28, 0xf2, 0xdd,
+	0x66, 0xad, 0x9a, 0x68, 0x01, 0x17, 0x21, 0x5b, 0x37, 0xad, 0x9b, 0xe8, 0xa7, 0x55, 0x0c, 0x90,
+	0xeb, 0xf2, 0xf1, 0xcf, 0xe4, 0xd9, 0xb8, 0x6e, 0x5a, 0x6f, 0xdd, 0x40, 0x5f, 0x53, 0xd9, 0xb4,
+	0x5d, 0x41, 0xfc, 0x6c, 0x22, 0xd8, 0xb9, 0x8e, 0xbe, 0x9e, 0x0a, 0x76, 0xae, 0xa3, 0x9f, 0x4b,
+	0x04, 0xd7, 0x76, 0xd0, 0x37, 0x52, 0xc1, 0xb5, 0x1d, 0xf4, 0xf3, 0x89, 0xe0, 0xc6, 0x75, 0xf4,
+	0x0b, 0xa9, 0xe0, 0xc6, 0x75, 0xf4, 0x8b, 0x79, 0xe6, 0x0b, 0xf7, 0xe4, 0xda, 0x0e, 0xfa, 0xa5,
+	0x42, 0x4a, 0xdd, 0xb8, 0x8e, 0x7e, 0xb9, 0xc0, 0xf6, 0x3f, 0xdd, 0x55, 0xf4, 0x2b, 0x88, 0x99,
+	0xc9, 0x36, 0x08, 0xfd, 0x2a, 0x1f, 0x32, 0x11, 0xfa, 0x35, 0xc4, 0x7c, 0x64, 0x5c, 0x4e, 0x7e,
+	0x93, 0x4b, 0xee, 0x1b, 0x3a, 0x41, 0xbf, 0x9e, 0x17, 0x0d, 0xa2, 0xb5, 0x7a, 0x53, 0x6f, 0x20,
+	0xcc, 0x9f, 0x60, 0xa8, 0xfc, 0xe6, 0x55, 0x36, 0x64, 0xe1, 0x89, 0x7e, 0xab, 0xcd, 0x16, 0xbc,
+	0xab, 0x93, 0xda, 0xbb, 0x3a, 0x41, 0xbf, 0x7d, 0x95, 0x2d, 0x78, 0x57, 0x27, 0x12, 0xaf, 0xdf,
+	0x69, 0x33, 0x45, 0x2e, 0xfa, 0xdd, 0xab, 0xcc, 0x68, 0xc9, 0xff, 0x56, 0x1b, 0x17, 0x20, 0xb3,
+	0x5b, 0xb7, 0xd0, 0xb7, 0xf9, 0x6a, 0x2c, 0x44, 0xd1, 0xef, 0x21, 0xc6, 0xec, 0x18, 0x16, 0xfa,
+	0x7d, 0xc6, 0xcc, 0x59, 0xdd, 0x76, 0xc3, 0x40, 0xaf, 0x31, 0xe3, 0x0e, 0x8c, 0x56, 0xd3, 0xb0,
+	0xc8, 0x7d, 0xf4, 0x07, 0x5c, 0xfd, 0x56, 0xa7, 0x65, 0xa2, 0xef, 0x20, 0xd6, 0xf3, 0x69, 0x7c,
+	0xb5, 0x4d, 0x8c, 0x4e, 0xa7, 0xde, 0x32, 0xd1, 0x1b, 0x97, 0xf7, 0x01, 0x9d, 0x4e, 0x07, 0xcc,
+	0x81, 0xae, 0x79, 0xdb, 0x6c, 0xdd, 0x33, 0xd1, 0x02, 0x23, 0xda, 0xc4, 0x68, 0xeb, 0xc4, 0x40,
+	0x0a, 0x06, 0xc8, 0xcb, 0xb6, 0x53, 0x15, 0x2f, 0x41, 0x81, 0xb4, 0x1a, 0x8d, 0x5d, 0xbd, 0x76,
+	0x1b, 0x65, 0x76, 0x8d, 0xbf, 0xf8, 0xf0, 0x92, 0xf2, 0xb7, 0x1f, 0x5e, 0x52, 0xbe, 0xfb, 0xe1,
+	0x25, 0xe5, 0x5b, 0xff, 0x72, 0x69, 0x01, 0x96, 0xdd, 0x60, 0xfb, 0xd8, 0x8d, 0x69, 0x14, 0x89,
+	0x3f, 0x44, 0xbc, 0xa7, 0x49, 0xca, 0x0d, 0xae, 0x88, 0xd1, 0x95, 0x41, 0x70, 0xe5, 0x38, 0xbe,
+	0xc2, 0xa5, 0x57, 0x78, 0x06, 0x79, 0x90, 0xe7, 0xc4, 0xb5, 0xff, 0x1b, 0x00, 0x97, 0xaa, 0xf3,
+	0xc2, 0x6e, 0x31, 0x00, 0x00,
 }
 
 func (m *Target) Marshal() (dAtA []byte, err error) {
@@ -5403,6 +5450,29 @@ func (m *ExecuteOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SessionTrackGtids {
+		i--
+		if m.SessionTrackGtids {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ReadAfterWriteTimeout != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ReadAfterWriteTimeout))))
+		i--
+		dAtA[i] = 0x71
+	}
+	if len(m.ReadAfterWriteGtid) > 0 {
+		i -= len(m.ReadAfterWriteGtid)
+		copy(dAtA[i:], m.ReadAfterWriteGtid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReadAfterWriteGtid)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.HasCreatedTempTables {
 		i--
 		if m.HasCreatedTempTables {
@@ -5632,6 +5702,13 @@ func (m *QueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SessionStateChanges) > 0 {
+		i -= len(m.SessionStateChanges)
+		copy(dAtA[i:], m.SessionStateChanges)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SessionStateChanges)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Rows) > 0 {
 		for iNdEx := len(m.Rows) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -8908,6 +8985,16 @@ func (m *ExecuteOptions) Size() (n int) {
 	if m.HasCreatedTempTables {
 		n += 2
 	}
+	l = len(m.ReadAfterWriteGtid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ReadAfterWriteTimeout != 0 {
+		n += 9
+	}
+	if m.SessionTrackGtids {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9012,6 +9099,10 @@ func (m *QueryResult) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	l = len(m.SessionStateChanges)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11358,6 +11449,69 @@ func (m *ExecuteOptions) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.HasCreatedTempTables = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadAfterWriteGtid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadAfterWriteGtid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadAfterWriteTimeout", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ReadAfterWriteTimeout = float64(math.Float64frombits(v))
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionTrackGtids", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SessionTrackGtids = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12025,6 +12179,38 @@ func (m *QueryResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionStateChanges", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionStateChanges = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -60,14 +60,16 @@ type Status struct {
 	MasterPort          int32  `protobuf:"varint,6,opt,name=master_port,json=masterPort,proto3" json:"master_port,omitempty"`
 	MasterConnectRetry  int32  `protobuf:"varint,7,opt,name=master_connect_retry,json=masterConnectRetry,proto3" json:"master_connect_retry,omitempty"`
 	// RelayLogPosition will be empty for flavors that do not support returning the full GTIDSet from the relay log, such as MariaDB.
-	RelayLogPosition     string   `protobuf:"bytes,8,opt,name=relay_log_position,json=relayLogPosition,proto3" json:"relay_log_position,omitempty"`
-	FilePosition         string   `protobuf:"bytes,9,opt,name=file_position,json=filePosition,proto3" json:"file_position,omitempty"`
-	FileRelayLogPosition string   `protobuf:"bytes,10,opt,name=file_relay_log_position,json=fileRelayLogPosition,proto3" json:"file_relay_log_position,omitempty"`
-	MasterServerId       uint32   `protobuf:"varint,11,opt,name=master_server_id,json=masterServerId,proto3" json:"master_server_id,omitempty"`
-	MasterUuid           string   `protobuf:"bytes,12,opt,name=master_uuid,json=masterUuid,proto3" json:"master_uuid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RelayLogPosition     string `protobuf:"bytes,8,opt,name=relay_log_position,json=relayLogPosition,proto3" json:"relay_log_position,omitempty"`
+	FilePosition         string `protobuf:"bytes,9,opt,name=file_position,json=filePosition,proto3" json:"file_position,omitempty"`
+	FileRelayLogPosition string `protobuf:"bytes,10,opt,name=file_relay_log_position,json=fileRelayLogPosition,proto3" json:"file_relay_log_position,omitempty"`
+	MasterServerId       uint32 `protobuf:"varint,11,opt,name=master_server_id,json=masterServerId,proto3" json:"master_server_id,omitempty"`
+	MasterUuid           string `protobuf:"bytes,12,opt,name=master_uuid,json=masterUuid,proto3" json:"master_uuid,omitempty"`
+	// ReplicationLagUnknown is true when MySQL doesn't report the replication lag, e.g. because the IO thread can't connect to the master.
+	ReplicationLagUnknown bool     `protobuf:"varint,13,opt,name=replication_lag_unknown,json=replicationLagUnknown,proto3" json:"replication_lag_unknown,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *Status) Reset()         { *m = Status{} }
@@ -187,6 +189,13 @@ func (m *Status) GetMasterUuid() string {
 	return ""
 }
 
+func (m *Status) GetReplicationLagUnknown() bool {
+	if m != nil {
+		return m.ReplicationLagUnknown
+	}
+	return false
+}
+
 // StopReplicationStatus represents the replication status before calling StopReplication, and the replication status collected immediately after
 // calling StopReplication.
 type StopReplicationStatus struct {
@@ -310,38 +319,39 @@ func init() {
 func init() { proto.RegisterFile("replicationdata.proto", fileDescriptor_ee8ee22b8c4b9d06) }
 
 var fileDescriptor_ee8ee22b8c4b9d06 = []byte{
-	// 482 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc6, 0x97, 0xee, 0xdf, 0xfe, 0xbb, 0xb7, 0xdd, 0x16, 0xbc, 0x55, 0x8b, 0x38, 0x94, 0xaa,
-	0x5c, 0xa2, 0x69, 0x34, 0x68, 0x88, 0x13, 0x12, 0xd2, 0xc6, 0x90, 0x56, 0xa9, 0x5b, 0x47, 0x3a,
-	0x0e, 0x70, 0xb1, 0xd2, 0xda, 0x6d, 0x2d, 0x85, 0xbc, 0x99, 0xed, 0x14, 0xed, 0x9b, 0xf0, 0x91,
-	0x38, 0xf2, 0x11, 0x50, 0xf9, 0x18, 0x5c, 0x50, 0xec, 0xac, 0x2b, 0x19, 0x42, 0xdc, 0xe2, 0xe7,
-	0xf9, 0xc9, 0xf1, 0xfb, 0xf8, 0x31, 0xb4, 0x24, 0x4f, 0x63, 0x31, 0x89, 0xb4, 0xc0, 0x84, 0x45,
-	0x3a, 0xea, 0xa5, 0x12, 0x35, 0x92, 0xdd, 0x92, 0xdc, 0xfd, 0xb9, 0x09, 0xb5, 0x91, 0x8e, 0x74,
-	0xa6, 0xc8, 0x63, 0xa8, 0xa7, 0xa8, 0x44, 0x6e, 0x79, 0x4e, 0xc7, 0xf1, 0xb7, 0xc2, 0xd5, 0x9a,
-	0x1c, 0xc2, 0x23, 0x81, 0x54, 0xcf, 0x25, 0x8f, 0x18, 0x95, 0x59, 0x92, 0x88, 0x64, 0xe6, 0x55,
-	0x3a, 0x8e, 0x5f, 0x0f, 0x77, 0x05, 0x5e, 0x1b, 0x3d, 0xb4, 0x32, 0x39, 0x02, 0xa2, 0x6e, 0xe2,
-	0x32, 0xbc, 0x69, 0x60, 0x57, 0xdd, 0xc4, 0xbf, 0xd3, 0xc7, 0xd0, 0x52, 0x7c, 0x82, 0x09, 0x53,
-	0x74, 0xcc, 0xe7, 0x22, 0x61, 0xf4, 0x53, 0xa4, 0x34, 0x97, 0xde, 0x7f, 0x1d, 0xc7, 0xdf, 0x0e,
-	0xf7, 0x0a, 0xf3, 0xd4, 0x78, 0x17, 0xc6, 0x22, 0x4f, 0xa0, 0x61, 0x21, 0x3a, 0x47, 0xa5, 0xbd,
-	0xaa, 0x39, 0x2c, 0x58, 0xe9, 0x1c, 0x95, 0x5e, 0x03, 0x52, 0x94, 0xda, 0xab, 0x75, 0x1c, 0xbf,
-	0x7a, 0x07, 0x5c, 0xa1, 0xd4, 0xe4, 0x39, 0xec, 0x17, 0xc0, 0x04, 0x93, 0x84, 0x4f, 0x34, 0x95,
-	0x5c, 0xcb, 0x5b, 0xef, 0x7f, 0x43, 0x12, 0xeb, 0xbd, 0xb1, 0x56, 0x98, 0x3b, 0xf9, 0x54, 0x92,
-	0xc7, 0xd1, 0x2d, 0x8d, 0x71, 0x46, 0x57, 0x39, 0xd5, 0xcd, 0xaf, 0x5d, 0xe3, 0x0c, 0x70, 0x76,
-	0x75, 0x97, 0xd7, 0x53, 0xd8, 0x9e, 0x8a, 0x98, 0xdf, 0x83, 0x5b, 0x06, 0x6c, 0xe6, 0xe2, 0x0a,
-	0x7a, 0x09, 0x07, 0x06, 0xfa, 0xc3, 0xbe, 0x60, 0xf0, 0xfd, 0xdc, 0x0e, 0xcb, 0x7b, 0xfb, 0xe0,
-	0x16, 0x67, 0x57, 0x5c, 0x2e, 0xb8, 0xa4, 0x82, 0x79, 0x0d, 0x13, 0xd6, 0x8e, 0xd5, 0x47, 0x46,
-	0xee, 0xb3, 0xb5, 0x18, 0xb2, 0x4c, 0x30, 0xaf, 0xb9, 0x9e, 0xd3, 0xfb, 0x4c, 0xb0, 0xee, 0x67,
-	0x68, 0x8d, 0x34, 0xa6, 0xe1, 0x7d, 0x29, 0x8a, 0x2e, 0x04, 0x50, 0x1b, 0xf3, 0x29, 0x4a, 0x6e,
-	0x9a, 0xd0, 0x38, 0x3e, 0xe8, 0x95, 0xfb, 0x64, 0xc1, 0xb0, 0xc0, 0xc8, 0x33, 0xa8, 0x46, 0xd3,
-	0xfc, 0xda, 0x2a, 0x7f, 0xe7, 0x2d, 0xd5, 0x1d, 0x42, 0xd3, 0xde, 0xe5, 0x3f, 0x74, 0xef, 0x41,
-	0x96, 0x95, 0x87, 0x59, 0x1e, 0xbe, 0x82, 0xbd, 0xd2, 0x24, 0x17, 0xc8, 0x38, 0x21, 0xb0, 0xd3,
-	0x1f, 0x9e, 0x5c, 0x9e, 0x8d, 0xde, 0x0d, 0xae, 0xcf, 0xc3, 0xb7, 0x27, 0x67, 0xee, 0x06, 0x71,
-	0xa1, 0xd9, 0x1f, 0xda, 0xd5, 0xf0, 0x72, 0xf0, 0xc1, 0x75, 0x4e, 0x5f, 0x7f, 0x5d, 0xb6, 0x9d,
-	0x6f, 0xcb, 0xb6, 0xf3, 0x7d, 0xd9, 0x76, 0xbe, 0xfc, 0x68, 0x6f, 0x7c, 0x3c, 0x5a, 0x08, 0xcd,
-	0x95, 0xea, 0x09, 0x0c, 0xec, 0x57, 0x30, 0xc3, 0x60, 0xa1, 0x03, 0xf3, 0x8a, 0x82, 0xd2, 0x6c,
-	0xe3, 0x9a, 0x91, 0x5f, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x96, 0x76, 0xbc, 0xf8, 0x75, 0x03,
-	0x00, 0x00,
+	// 505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xd1, 0x6e, 0x12, 0x4f,
+	0x14, 0xc6, 0xbb, 0xfc, 0x0b, 0x7f, 0x7a, 0x80, 0x76, 0x9d, 0x96, 0xb0, 0xf1, 0x02, 0x09, 0xde,
+	0x90, 0xa6, 0xb2, 0xa6, 0x46, 0x6f, 0x4c, 0x4c, 0x5a, 0x6b, 0x52, 0x12, 0x5a, 0xea, 0xd2, 0x5e,
+	0xe8, 0xcd, 0x64, 0x61, 0x87, 0x65, 0xe2, 0x3a, 0x87, 0xce, 0xcc, 0xd2, 0xf4, 0x4d, 0x7c, 0x0f,
+	0x5f, 0xc2, 0x4b, 0x1f, 0xc1, 0xe0, 0x8b, 0x98, 0x9d, 0xa1, 0x14, 0xb7, 0xc6, 0x78, 0xc7, 0x7c,
+	0xdf, 0x2f, 0xc3, 0x9c, 0xef, 0x7c, 0x0b, 0x75, 0xc9, 0x66, 0x09, 0x1f, 0x87, 0x9a, 0xa3, 0x88,
+	0x42, 0x1d, 0x76, 0x67, 0x12, 0x35, 0x92, 0x9d, 0x9c, 0xdc, 0xfe, 0xba, 0x09, 0xa5, 0xa1, 0x0e,
+	0x75, 0xaa, 0xc8, 0x63, 0x28, 0xcf, 0x50, 0xf1, 0xcc, 0xf2, 0x9c, 0x96, 0xd3, 0xd9, 0x0a, 0x56,
+	0x67, 0xb2, 0x0f, 0x8f, 0x38, 0x52, 0x3d, 0x95, 0x2c, 0x8c, 0xa8, 0x4c, 0x85, 0xe0, 0x22, 0xf6,
+	0x0a, 0x2d, 0xa7, 0x53, 0x0e, 0x76, 0x38, 0x5e, 0x1a, 0x3d, 0xb0, 0x32, 0x39, 0x00, 0xa2, 0xae,
+	0x93, 0x3c, 0xfc, 0x9f, 0x81, 0x5d, 0x75, 0x9d, 0xfc, 0x4e, 0x1f, 0x42, 0x5d, 0xb1, 0x31, 0x8a,
+	0x48, 0xd1, 0x11, 0x9b, 0x72, 0x11, 0xd1, 0xcf, 0xa1, 0xd2, 0x4c, 0x7a, 0x9b, 0x2d, 0xa7, 0x53,
+	0x0b, 0x76, 0x97, 0xe6, 0xb1, 0xf1, 0xce, 0x8c, 0x45, 0x9e, 0x40, 0xc5, 0x42, 0x74, 0x8a, 0x4a,
+	0x7b, 0x45, 0xf3, 0x58, 0xb0, 0xd2, 0x29, 0x2a, 0xbd, 0x06, 0xcc, 0x50, 0x6a, 0xaf, 0xd4, 0x72,
+	0x3a, 0xc5, 0x3b, 0xe0, 0x02, 0xa5, 0x26, 0xcf, 0x61, 0x6f, 0x09, 0x8c, 0x51, 0x08, 0x36, 0xd6,
+	0x54, 0x32, 0x2d, 0x6f, 0xbd, 0xff, 0x0d, 0x49, 0xac, 0xf7, 0xd6, 0x5a, 0x41, 0xe6, 0x64, 0x53,
+	0x49, 0x96, 0x84, 0xb7, 0x34, 0xc1, 0x98, 0xae, 0x72, 0x2a, 0x9b, 0xbf, 0x76, 0x8d, 0xd3, 0xc7,
+	0xf8, 0xe2, 0x2e, 0xaf, 0xa7, 0x50, 0x9b, 0xf0, 0x84, 0xdd, 0x83, 0x5b, 0x06, 0xac, 0x66, 0xe2,
+	0x0a, 0x7a, 0x09, 0x0d, 0x03, 0xfd, 0xe1, 0x5e, 0x30, 0xf8, 0x5e, 0x66, 0x07, 0xf9, 0xbb, 0x3b,
+	0xe0, 0x2e, 0xdf, 0xae, 0x98, 0x9c, 0x33, 0x49, 0x79, 0xe4, 0x55, 0x4c, 0x58, 0xdb, 0x56, 0x1f,
+	0x1a, 0xb9, 0x17, 0xad, 0xc5, 0x90, 0xa6, 0x3c, 0xf2, 0xaa, 0xeb, 0x39, 0x5d, 0xa5, 0x3c, 0x22,
+	0xaf, 0xa0, 0xb1, 0x56, 0x08, 0x9a, 0x84, 0x31, 0x4d, 0xc5, 0x27, 0x81, 0x37, 0xc2, 0xab, 0x99,
+	0x7d, 0xad, 0xd7, 0xa8, 0x1f, 0xc6, 0x57, 0xd6, 0x6c, 0xdf, 0x40, 0x7d, 0xa8, 0x71, 0x16, 0xdc,
+	0x9b, 0xcb, 0x0e, 0xf9, 0x50, 0x1a, 0xb1, 0x09, 0x4a, 0x66, 0x1a, 0x54, 0x39, 0x6c, 0x74, 0xf3,
+	0x3d, 0xb4, 0x60, 0xb0, 0xc4, 0xc8, 0x33, 0x28, 0x86, 0x93, 0x6c, 0xdd, 0x85, 0xbf, 0xf3, 0x96,
+	0x6a, 0x0f, 0xa0, 0x6a, 0x3b, 0xf0, 0x0f, 0x9d, 0x7d, 0xb0, 0x83, 0xc2, 0xc3, 0x1d, 0xec, 0xbf,
+	0x86, 0xdd, 0xdc, 0x24, 0x67, 0x18, 0x31, 0x42, 0x60, 0xbb, 0x37, 0x38, 0x3a, 0x3f, 0x19, 0xbe,
+	0xef, 0x5f, 0x9e, 0x06, 0xef, 0x8e, 0x4e, 0xdc, 0x0d, 0xe2, 0x42, 0xb5, 0x37, 0xb0, 0xa7, 0xc1,
+	0x79, 0xff, 0x83, 0xeb, 0x1c, 0xbf, 0xf9, 0xb6, 0x68, 0x3a, 0xdf, 0x17, 0x4d, 0xe7, 0xc7, 0xa2,
+	0xe9, 0x7c, 0xf9, 0xd9, 0xdc, 0xf8, 0x78, 0x30, 0xe7, 0x9a, 0x29, 0xd5, 0xe5, 0xe8, 0xdb, 0x5f,
+	0x7e, 0x8c, 0xfe, 0x5c, 0xfb, 0xe6, 0xeb, 0xf3, 0x73, 0xb3, 0x8d, 0x4a, 0x46, 0x7e, 0xf1, 0x6b,
+	0x00, 0x21, 0xfe, 0x71, 0xe7, 0xad, 0x03, 0x00, 0x00,
 }
 
 func (m *Status) Marshal() (dAtA []byte, err error) {
@@ -368,6 +378,16 @@ func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ReplicationLagUnknown {
+		i--
+		if m.ReplicationLagUnknown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.MasterUuid) > 0 {
 		i -= len(m.MasterUuid)
 		copy(dAtA[i:], m.MasterUuid)
@@ -604,6 +624,9 @@ func (m *Status) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovReplicationdata(uint64(l))
 	}
+	if m.ReplicationLagUnknown {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -993,6 +1016,26 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 			}
 			m.MasterUuid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationLagUnknown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicationdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReplicationLagUnknown = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplicationdata(dAtA[iNdEx:])
@@ -14,6 +14,7 @@ import (
 	query "vitess.io/vitess/go/vt/proto/query"
 	replicationdata "vitess.io/vitess/go/vt/proto/replicationdata"
 	topodata "vitess.io/vitess/go/vt/proto/topodata"
+	vttime "vitess.io/vitess/go/vt/proto/vttime"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4261,8 +4262,11 @@ func (m *PromoteReplicaResponse) GetPosition() string {
 }
 
 type BackupRequest struct {
-	Concurrency          int64    `protobuf:"varint,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
-	AllowMaster          bool     `protobuf:"varint,2,opt,name=allowMaster,proto3" json:"allowMaster,omitempty"`
+	Concurrency int64 `protobuf:"varint,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	AllowMaster bool  `protobuf:"varint,2,opt,name=allowMaster,proto3" json:"allowMaster,omitempty"`
+	// incremental, if set, archives only the binary logs written since the
+	// most recent backup instead of taking a full backup.
+	Incremental          bool     `protobuf:"varint,3,opt,name=incremental,proto3" json:"incremental,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4315,6 +4319,13 @@ func (m *BackupRequest) GetAllowMaster() bool {
 	return false
 }
 
+func (m *BackupRequest) GetIncremental() bool {
+	if m != nil {
+		return m.Incremental
+	}
+	return false
+}
+
 type BackupResponse struct {
 	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -4363,9 +4374,17 @@ func (m *BackupResponse) GetEvent() *logutil.Event {
 }
 
 type RestoreFromBackupRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// restore_to_pos, if set, restores the latest full backup at or before
+	// this position, then replays binary logs from incremental backups up to
+	// and including it.
+	RestoreToPos string `protobuf:"bytes,1,opt,name=restore_to_pos,json=restoreToPos,proto3" json:"restore_to_pos,omitempty"`
+	// restore_to_timestamp, if set, restores the latest full backup taken at
+	// or before this time, then replays binary logs from incremental backups
+	// up to it.
+	RestoreToTimestamp   *vttime.Time `protobuf:"bytes,2,opt,name=restore_to_timestamp,json=restoreToTimestamp,proto3" json:"restore_to_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *RestoreFromBackupRequest) Reset()         { *m = RestoreFromBackupRequest{} }
@@ -4401,6 +4420,20 @@ func (m *RestoreFromBackupRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RestoreFromBackupRequest proto.InternalMessageInfo
 
+func (m *RestoreFromBackupRequest) GetRestoreToPos() string {
+	if m != nil {
+		return m.RestoreToPos
+	}
+	return ""
+}
+
+func (m *RestoreFromBackupRequest) GetRestoreToTimestamp() *vttime.Time {
+	if m != nil {
+		return m.RestoreToTimestamp
+	}
+	return nil
+}
+
 type RestoreFromBackupResponse struct {
 	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -4661,145 +4694,149 @@ func init() {
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor_ff9ac4f89e61ffa4) }
 
 var fileDescriptor_ff9ac4f89e61ffa4 = []byte{
-	// 2206 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x39, 0x4b, 0x73, 0xdb, 0xd6,
-	0xd5, 0x1f, 0xa8, 0x87, 0xa5, 0xc3, 0x87, 0x28, 0x90, 0x12, 0x21, 0xfa, 0x33, 0x2d, 0xc3, 0x4e,
-	0xe2, 0x49, 0xa6, 0x54, 0x23, 0x27, 0x99, 0x4c, 0xd2, 0x76, 0x22, 0xdb, 0x92, 0x9d, 0x58, 0x8e,
-	0x15, 0xc8, 0x8f, 0x4e, 0xa6, 0x53, 0x0c, 0x08, 0x5c, 0x91, 0x18, 0x81, 0xb8, 0xf0, 0xbd, 0x17,
-	0xa4, 0xb8, 0xe9, 0x4f, 0x68, 0xb7, 0x5d, 0x75, 0xd3, 0x99, 0x76, 0xdf, 0x1f, 0xd1, 0xe9, 0xb2,
-	0xab, 0x74, 0xdb, 0x71, 0x7f, 0x44, 0x17, 0x5d, 0xb4, 0x73, 0x1f, 0x20, 0x01, 0x02, 0xb2, 0x65,
-	0x8d, 0x3b, 0xd3, 0x8d, 0x06, 0xe7, 0xfd, 0xb8, 0xe7, 0x9e, 0x73, 0x2e, 0x05, 0x2d, 0xe6, 0xf4,
-	0x02, 0xc4, 0x86, 0x4e, 0xe8, 0xf4, 0x11, 0xf1, 0x1c, 0xe6, 0x74, 0x23, 0x82, 0x19, 0xd6, 0xd7,
-	0x73, 0x84, 0x76, 0xf9, 0x65, 0x8c, 0xc8, 0x44, 0xd2, 0xdb, 0x35, 0x86, 0x23, 0x3c, 0xe3, 0x6f,
-	0x6f, 0x10, 0x14, 0x05, 0xbe, 0xeb, 0x30, 0x1f, 0x87, 0x29, 0x74, 0x35, 0xc0, 0xfd, 0x98, 0xf9,
-	0x81, 0x04, 0xcd, 0x7f, 0x6b, 0xb0, 0xf6, 0x94, 0x2b, 0xbe, 0x8f, 0x4e, 0xfc, 0xd0, 0xe7, 0xcc,
-	0xba, 0x0e, 0x8b, 0xa1, 0x33, 0x44, 0x86, 0xb6, 0xad, 0xdd, 0x5e, 0xb5, 0xc4, 0xb7, 0xbe, 0x09,
-	0xcb, 0xd4, 0x1d, 0xa0, 0xa1, 0x63, 0x94, 0x04, 0x56, 0x41, 0xba, 0x01, 0x57, 0x5c, 0x1c, 0xc4,
-	0xc3, 0x90, 0x1a, 0x0b, 0xdb, 0x0b, 0xb7, 0x57, 0xad, 0x04, 0xd4, 0xbb, 0xd0, 0x88, 0x88, 0x3f,
-	0x74, 0xc8, 0xc4, 0x3e, 0x45, 0x13, 0x3b, 0xe1, 0x5a, 0x14, 0x5c, 0xeb, 0x8a, 0xf4, 0x08, 0x4d,
-	0xee, 0x29, 0x7e, 0x1d, 0x16, 0xd9, 0x24, 0x42, 0xc6, 0x92, 0xb4, 0xca, 0xbf, 0xf5, 0xeb, 0x50,
-	0xe6, 0xae, 0xdb, 0x01, 0x0a, 0xfb, 0x6c, 0x60, 0x2c, 0x6f, 0x6b, 0xb7, 0x17, 0x2d, 0xe0, 0xa8,
-	0x43, 0x81, 0xd1, 0xaf, 0xc2, 0x2a, 0xc1, 0x63, 0xdb, 0xc5, 0x71, 0xc8, 0x8c, 0x2b, 0x82, 0xbc,
-	0x42, 0xf0, 0xf8, 0x1e, 0x87, 0xf5, 0x5b, 0xb0, 0x7c, 0xe2, 0xa3, 0xc0, 0xa3, 0xc6, 0xca, 0xf6,
-	0xc2, 0xed, 0xf2, 0x6e, 0xa5, 0x2b, 0xf3, 0x75, 0xc0, 0x91, 0x96, 0xa2, 0x99, 0x7f, 0xd0, 0xa0,
-	0x7e, 0x2c, 0x82, 0x49, 0xa5, 0xe0, 0x03, 0x58, 0xe3, 0x56, 0x7a, 0x0e, 0x45, 0xb6, 0x8a, 0x5b,
-	0x66, 0xa3, 0x96, 0xa0, 0xa5, 0x88, 0xfe, 0x04, 0xe4, 0xb9, 0xd8, 0xde, 0x54, 0x98, 0x1a, 0x25,
-	0x61, 0xce, 0xec, 0xe6, 0x8f, 0x72, 0x2e, 0xd5, 0x56, 0x9d, 0x65, 0x11, 0x94, 0x27, 0x74, 0x84,
-	0x08, 0xf5, 0x71, 0x68, 0x2c, 0x08, 0x8b, 0x09, 0xc8, 0x1d, 0xd5, 0xa5, 0xd5, 0x7b, 0x03, 0x27,
-	0xec, 0x23, 0x0b, 0xd1, 0x38, 0x60, 0xfa, 0x43, 0xa8, 0xf6, 0xd0, 0x09, 0x26, 0x19, 0x47, 0xcb,
-	0xbb, 0x37, 0x0b, 0xac, 0xcf, 0x87, 0x69, 0x55, 0xa4, 0xa4, 0x8a, 0xe5, 0x00, 0x2a, 0xce, 0x09,
-	0x43, 0xc4, 0x4e, 0x9d, 0xf4, 0x05, 0x15, 0x95, 0x85, 0xa0, 0x44, 0x9b, 0xff, 0xd4, 0xa0, 0xf6,
-	0x8c, 0x22, 0x72, 0x84, 0xc8, 0xd0, 0xa7, 0x54, 0x95, 0xd4, 0x00, 0x53, 0x96, 0x94, 0x14, 0xff,
-	0xe6, 0xb8, 0x98, 0x22, 0xa2, 0x0a, 0x4a, 0x7c, 0xeb, 0x1f, 0xc1, 0x7a, 0xe4, 0x50, 0x3a, 0xc6,
-	0xc4, 0xb3, 0xdd, 0x01, 0x72, 0x4f, 0x69, 0x3c, 0x14, 0x79, 0x58, 0xb4, 0xea, 0x09, 0xe1, 0x9e,
-	0xc2, 0xeb, 0xdf, 0x01, 0x44, 0xc4, 0x1f, 0xf9, 0x01, 0xea, 0x23, 0x59, 0x58, 0xe5, 0xdd, 0x8f,
-	0x0b, 0xbc, 0xcd, 0xfa, 0xd2, 0x3d, 0x9a, 0xca, 0xec, 0x87, 0x8c, 0x4c, 0xac, 0x94, 0x92, 0xf6,
-	0x4f, 0x61, 0x6d, 0x8e, 0xac, 0xd7, 0x61, 0xe1, 0x14, 0x4d, 0x94, 0xe7, 0xfc, 0x53, 0x6f, 0xc2,
-	0xd2, 0xc8, 0x09, 0x62, 0xa4, 0x3c, 0x97, 0xc0, 0x17, 0xa5, 0xcf, 0x35, 0xf3, 0x07, 0x0d, 0x2a,
-	0xf7, 0x7b, 0x6f, 0x88, 0xbb, 0x06, 0x25, 0xaf, 0xa7, 0x64, 0x4b, 0x5e, 0x6f, 0x9a, 0x87, 0x85,
-	0x54, 0x1e, 0x9e, 0x14, 0x84, 0xb6, 0x53, 0x10, 0x5a, 0xda, 0xd8, 0x7f, 0x33, 0xb0, 0xdf, 0x6b,
-	0x50, 0x9e, 0x59, 0xa2, 0xfa, 0x21, 0xd4, 0xb9, 0x9f, 0x76, 0x34, 0xc3, 0x19, 0x9a, 0xf0, 0xf2,
-	0xc6, 0x1b, 0x0f, 0xc0, 0x5a, 0x8b, 0x33, 0x30, 0xd5, 0x0f, 0xa0, 0xe6, 0xf5, 0x32, 0xba, 0xe4,
-	0x0d, 0xba, 0xfe, 0x86, 0x88, 0xad, 0xaa, 0x97, 0x82, 0xa8, 0xf9, 0x01, 0x94, 0x8f, 0xfc, 0xb0,
-	0x6f, 0xa1, 0x97, 0x31, 0xa2, 0x8c, 0x5f, 0xa5, 0xc8, 0x99, 0x04, 0xd8, 0xf1, 0x54, 0x90, 0x09,
-	0x68, 0xde, 0x86, 0x8a, 0x64, 0xa4, 0x11, 0x0e, 0x29, 0x7a, 0x0d, 0xe7, 0x87, 0x50, 0x39, 0x0e,
-	0x10, 0x8a, 0x12, 0x9d, 0x6d, 0x58, 0xf1, 0x62, 0x22, 0x9a, 0xaa, 0x60, 0x5d, 0xb0, 0xa6, 0xb0,
-	0xb9, 0x06, 0x55, 0xc5, 0x2b, 0xd5, 0x9a, 0x7f, 0xd3, 0x40, 0xdf, 0x3f, 0x43, 0x6e, 0xcc, 0xd0,
-	0x43, 0x8c, 0x4f, 0x13, 0x1d, 0x45, 0xfd, 0xb5, 0x03, 0x10, 0x39, 0xc4, 0x19, 0x22, 0x86, 0x88,
-	0x0c, 0x7f, 0xd5, 0x4a, 0x61, 0xf4, 0x23, 0x58, 0x45, 0x67, 0x8c, 0x38, 0x36, 0x0a, 0x47, 0xa2,
-	0xd3, 0x96, 0x77, 0xef, 0x14, 0x64, 0x27, 0x6f, 0xad, 0xbb, 0xcf, 0xc5, 0xf6, 0xc3, 0x91, 0xac,
-	0x89, 0x15, 0xa4, 0xc0, 0xf6, 0x97, 0x50, 0xcd, 0x90, 0xde, 0xaa, 0x1e, 0x4e, 0xa0, 0x91, 0x31,
-	0xa5, 0xf2, 0x78, 0x1d, 0xca, 0xe8, 0xcc, 0x67, 0x36, 0x65, 0x0e, 0x8b, 0xa9, 0x4a, 0x10, 0x70,
-	0xd4, 0xb1, 0xc0, 0x88, 0x31, 0xc2, 0x3c, 0x1c, 0xb3, 0xe9, 0x18, 0x11, 0x90, 0xc2, 0x23, 0x92,
-	0xdc, 0x02, 0x05, 0x99, 0x23, 0xa8, 0x3f, 0x40, 0x4c, 0xf6, 0x95, 0x24, 0x7d, 0x9b, 0xb0, 0x2c,
-	0x02, 0x97, 0x15, 0xb7, 0x6a, 0x29, 0x48, 0xbf, 0x09, 0x55, 0x3f, 0x74, 0x83, 0xd8, 0x43, 0xf6,
-	0xc8, 0x47, 0x63, 0x2a, 0x4c, 0xac, 0x58, 0x15, 0x85, 0x7c, 0xce, 0x71, 0xfa, 0x7b, 0x50, 0x43,
-	0x67, 0x92, 0x49, 0x29, 0x91, 0x63, 0xab, 0xaa, 0xb0, 0xa2, 0x41, 0x53, 0x13, 0xc1, 0x7a, 0xca,
-	0xae, 0x8a, 0xee, 0x08, 0xd6, 0x65, 0x67, 0x4c, 0x35, 0xfb, 0xb7, 0xe9, 0xb6, 0x75, 0x3a, 0x87,
-	0x31, 0x5b, 0xb0, 0xf1, 0x00, 0xb1, 0x54, 0x09, 0xab, 0x18, 0xcd, 0xef, 0x61, 0x73, 0x9e, 0xa0,
-	0x9c, 0xf8, 0x0a, 0xca, 0xd9, 0x4b, 0xc7, 0xcd, 0x77, 0x0a, 0xcc, 0xa7, 0x85, 0xd3, 0x22, 0x66,
-	0x13, 0xf4, 0x63, 0xc4, 0x2c, 0xe4, 0x78, 0x4f, 0xc2, 0x60, 0x92, 0x58, 0xdc, 0x80, 0x46, 0x06,
-	0xab, 0x4a, 0x78, 0x86, 0x7e, 0x41, 0x7c, 0x86, 0x12, 0xee, 0x4d, 0x68, 0x66, 0xd1, 0x8a, 0xfd,
-	0x1b, 0x58, 0x97, 0xc3, 0xe9, 0xe9, 0x24, 0x4a, 0x98, 0xf5, 0x4f, 0xa1, 0x2c, 0xdd, 0xb3, 0xc5,
-	0x80, 0xe7, 0x2e, 0xd7, 0x76, 0x9b, 0xdd, 0xe9, 0xbe, 0x22, 0x72, 0xce, 0x84, 0x04, 0xb0, 0xe9,
-	0x37, 0xf7, 0x33, 0xad, 0x6b, 0xe6, 0x90, 0x85, 0x4e, 0x08, 0xa2, 0x03, 0x5e, 0x52, 0x69, 0x87,
-	0xb2, 0x68, 0xc5, 0xde, 0x82, 0x0d, 0x2b, 0x0e, 0x1f, 0x22, 0x27, 0x60, 0x03, 0x31, 0x38, 0x12,
-	0x01, 0x03, 0x36, 0xe7, 0x09, 0x4a, 0xe4, 0x13, 0x30, 0xbe, 0xee, 0x87, 0x98, 0x20, 0x49, 0xdc,
-	0x27, 0x04, 0x93, 0x4c, 0x4b, 0x61, 0x0c, 0x91, 0x70, 0xd6, 0x28, 0x04, 0x68, 0x5e, 0x85, 0xad,
-	0x02, 0x29, 0xa5, 0xf2, 0x0b, 0xee, 0x34, 0xef, 0x27, 0xd9, 0x4a, 0xbe, 0x09, 0xd5, 0xb1, 0xe3,
-	0x33, 0x3b, 0xc2, 0x74, 0x56, 0x4c, 0xab, 0x56, 0x85, 0x23, 0x8f, 0x14, 0x4e, 0x46, 0x96, 0x96,
-	0x55, 0x3a, 0x77, 0x61, 0xf3, 0x88, 0xa0, 0x93, 0xc0, 0xef, 0x0f, 0xe6, 0x2e, 0x08, 0xdf, 0xc9,
-	0x44, 0xe2, 0x92, 0x1b, 0x92, 0x80, 0x66, 0x1f, 0x5a, 0x39, 0x19, 0x55, 0x57, 0x87, 0x50, 0x93,
-	0x5c, 0x36, 0x11, 0x7b, 0x45, 0xd2, 0xcf, 0xdf, 0x3b, 0xb7, 0xb2, 0xd3, 0x5b, 0x88, 0x55, 0x75,
-	0x53, 0x10, 0x35, 0xff, 0xa5, 0x81, 0xbe, 0x17, 0x45, 0xc1, 0x24, 0xeb, 0x59, 0x1d, 0x16, 0xe8,
-	0xcb, 0x20, 0x69, 0x31, 0xf4, 0x65, 0xc0, 0x5b, 0xcc, 0x09, 0x26, 0x2e, 0x52, 0x97, 0x55, 0x02,
-	0x7c, 0x0d, 0x70, 0x82, 0x00, 0x8f, 0xed, 0xd4, 0x0e, 0x2b, 0x3a, 0xc3, 0x8a, 0x55, 0x17, 0x04,
-	0x6b, 0x86, 0xcf, 0x2f, 0x40, 0x8b, 0xef, 0x6a, 0x01, 0x5a, 0xba, 0xe4, 0x02, 0xf4, 0x47, 0x0d,
-	0x1a, 0x99, 0xe8, 0x55, 0x8e, 0xff, 0xf7, 0x56, 0xb5, 0x06, 0xac, 0x1f, 0x62, 0xf7, 0x54, 0x76,
-	0xbd, 0xe4, 0x6a, 0x34, 0x41, 0x4f, 0x23, 0x67, 0x17, 0xef, 0x59, 0x18, 0xe4, 0x98, 0x37, 0xa1,
-	0x99, 0x45, 0x2b, 0xf6, 0x3f, 0x69, 0x60, 0xa8, 0x11, 0x71, 0x80, 0x98, 0x3b, 0xd8, 0xa3, 0xf7,
-	0x7b, 0xd3, 0x3a, 0x68, 0xc2, 0x92, 0x58, 0xc5, 0x45, 0x02, 0x2a, 0x96, 0x04, 0xf4, 0x16, 0x5c,
-	0xf1, 0x7a, 0xb6, 0x18, 0x8d, 0x6a, 0x3a, 0x78, 0xbd, 0x6f, 0xf9, 0x70, 0xdc, 0x82, 0x95, 0xa1,
-	0x73, 0x66, 0x13, 0x3c, 0xa6, 0x6a, 0x19, 0xbc, 0x32, 0x74, 0xce, 0x2c, 0x3c, 0xa6, 0x62, 0x51,
-	0xf7, 0xa9, 0xd8, 0xc0, 0x7b, 0x7e, 0x18, 0xe0, 0x3e, 0x15, 0xc7, 0xbf, 0x62, 0xd5, 0x14, 0xfa,
-	0xae, 0xc4, 0xf2, 0xbb, 0x46, 0xc4, 0x35, 0x4a, 0x1f, 0xee, 0x8a, 0x55, 0x21, 0xa9, 0xbb, 0x65,
-	0x3e, 0x80, 0xad, 0x02, 0x9f, 0xd5, 0xe9, 0x7d, 0x08, 0xcb, 0xf2, 0x6a, 0xa8, 0x63, 0xd3, 0xd5,
-	0x73, 0xe2, 0x3b, 0xfe, 0x57, 0x5d, 0x03, 0xc5, 0x61, 0xfe, 0x5a, 0x83, 0x6b, 0x59, 0x4d, 0x7b,
-	0x41, 0xc0, 0x17, 0x30, 0xfa, 0xee, 0x53, 0x90, 0x8b, 0x6c, 0xb1, 0x20, 0xb2, 0x43, 0xe8, 0x9c,
-	0xe7, 0xcf, 0x25, 0xc2, 0x7b, 0x34, 0x7f, 0xb6, 0x7b, 0x51, 0xf4, 0xfa, 0xc0, 0xd2, 0xfe, 0x97,
-	0x32, 0xfe, 0xe7, 0x93, 0x2e, 0x94, 0x5d, 0xc2, 0xab, 0x36, 0x18, 0xa9, 0xbe, 0x20, 0x37, 0x8e,
-	0xa4, 0x4c, 0x0f, 0x61, 0xab, 0x80, 0xa6, 0x8c, 0xec, 0xf0, 0xed, 0x63, 0xba, 0xb1, 0x94, 0x77,
-	0x5b, 0xdd, 0xf9, 0xb7, 0xb3, 0x12, 0x50, 0x6c, 0xfc, 0x2e, 0x3c, 0x76, 0x28, 0xbf, 0x46, 0x19,
-	0x23, 0x8f, 0xa1, 0x99, 0x45, 0x2b, 0xfd, 0x9f, 0xce, 0xe9, 0xbf, 0x96, 0xd3, 0x9f, 0x11, 0x4b,
-	0xac, 0xb4, 0x60, 0x43, 0xe2, 0x93, 0x59, 0x90, 0xd8, 0xf9, 0x04, 0x36, 0xe7, 0x09, 0xca, 0x52,
-	0x1b, 0x56, 0xe6, 0x86, 0xc9, 0x14, 0xe6, 0x52, 0x2f, 0x1c, 0x9f, 0x1d, 0xe0, 0x79, 0x7d, 0xaf,
-	0x95, 0xda, 0x82, 0x56, 0x4e, 0x4a, 0x5d, 0x71, 0x03, 0x36, 0x8f, 0x19, 0x8e, 0x52, 0x79, 0x4d,
-	0x1c, 0xdc, 0x82, 0x56, 0x8e, 0xa2, 0x84, 0x7e, 0x09, 0xd7, 0xe6, 0x48, 0x8f, 0xfd, 0xd0, 0x1f,
-	0xc6, 0xc3, 0x0b, 0x38, 0xa3, 0xdf, 0x00, 0x31, 0x1b, 0x6d, 0xe6, 0x0f, 0x51, 0xb2, 0x44, 0x2e,
-	0x58, 0x65, 0x8e, 0x7b, 0x2a, 0x51, 0xe6, 0x4f, 0xa0, 0x73, 0x9e, 0xfe, 0x0b, 0xe4, 0x48, 0x38,
-	0xee, 0x10, 0x56, 0x10, 0x53, 0x1b, 0x8c, 0x3c, 0x49, 0x05, 0xd5, 0x83, 0x1b, 0xf3, 0xb4, 0x67,
-	0x21, 0xf3, 0x83, 0x3d, 0xde, 0x6a, 0xdf, 0x51, 0x60, 0xb7, 0xc0, 0x7c, 0x9d, 0x0d, 0xe5, 0x49,
-	0x13, 0xf4, 0x07, 0x28, 0xe1, 0x99, 0x16, 0xe6, 0x47, 0xd0, 0xc8, 0x60, 0x55, 0x26, 0x9a, 0xb0,
-	0xe4, 0x78, 0x1e, 0x49, 0xd6, 0x04, 0x09, 0xf0, 0x1c, 0x58, 0x88, 0xa2, 0x73, 0x72, 0x90, 0x27,
-	0x29, 0xcb, 0x3b, 0xd0, 0x7a, 0x9e, 0xc2, 0xf3, 0x2b, 0x5d, 0xd8, 0x12, 0x56, 0x55, 0x4b, 0x30,
-	0x0f, 0xc0, 0xc8, 0x0b, 0x5c, 0xaa, 0x19, 0x5d, 0x4b, 0xeb, 0x99, 0x55, 0x6b, 0x62, 0xbe, 0x06,
-	0x25, 0xdf, 0x53, 0x8f, 0x91, 0x92, 0xef, 0x65, 0x0e, 0xa2, 0x34, 0x57, 0x00, 0xdb, 0xd0, 0x39,
-	0x4f, 0x99, 0x8a, 0xb3, 0x01, 0xeb, 0x5f, 0x87, 0x3e, 0x93, 0x17, 0x30, 0x49, 0xcc, 0x8f, 0x41,
-	0x4f, 0x23, 0x2f, 0x50, 0x69, 0x3f, 0x68, 0xd0, 0x39, 0xc2, 0x51, 0x1c, 0x88, 0x6d, 0x35, 0x72,
-	0x08, 0x0a, 0xd9, 0x37, 0x38, 0x26, 0xa1, 0x13, 0x24, 0x7e, 0xbf, 0x0f, 0x6b, 0xbc, 0x1e, 0x6c,
-	0x97, 0x20, 0x87, 0x21, 0xcf, 0x0e, 0x93, 0x17, 0x55, 0x95, 0xa3, 0xef, 0x49, 0xec, 0xb7, 0x94,
-	0xbf, 0xba, 0x1c, 0x97, 0x2b, 0x4d, 0x0f, 0x0e, 0x90, 0x28, 0x31, 0x3c, 0x3e, 0x87, 0xca, 0x50,
-	0x78, 0x66, 0x3b, 0x81, 0xef, 0xc8, 0x01, 0x52, 0xde, 0xdd, 0x98, 0xdf, 0xc0, 0xf7, 0x38, 0xd1,
-	0x2a, 0x4b, 0x56, 0x01, 0xe8, 0x1f, 0x43, 0x33, 0xd5, 0xaa, 0x66, 0x8b, 0xea, 0xa2, 0xb0, 0xd1,
-	0x48, 0xd1, 0xa6, 0xfb, 0xea, 0x0d, 0xb8, 0x7e, 0x6e, 0x5c, 0x2a, 0x85, 0xbf, 0xd3, 0x64, 0xba,
-	0x54, 0xa2, 0x93, 0x78, 0x7f, 0x04, 0xcb, 0x92, 0x5f, 0x1d, 0xfa, 0x39, 0x0e, 0x2a, 0xa6, 0x73,
-	0x7d, 0x2b, 0x9d, 0xeb, 0x5b, 0x51, 0x46, 0x17, 0x0a, 0x32, 0xca, 0xfb, 0x7b, 0xc6, 0xbf, 0xd9,
-	0x0a, 0x74, 0x1f, 0x0d, 0x31, 0x43, 0xd9, 0xc3, 0xff, 0x8d, 0x06, 0xcd, 0x2c, 0x5e, 0x9d, 0xff,
-	0x1d, 0x68, 0x78, 0x28, 0x22, 0xc8, 0x15, 0xc6, 0xb2, 0xa5, 0x70, 0xb7, 0x64, 0x68, 0x96, 0x3e,
-	0x23, 0x4f, 0x7d, 0xbc, 0x0b, 0x55, 0x75, 0x58, 0x6a, 0x66, 0x94, 0x2e, 0x32, 0x33, 0xd4, 0x01,
-	0x4b, 0x88, 0x5f, 0xe1, 0x67, 0xa1, 0x87, 0x8b, 0x9c, 0x6d, 0x83, 0x91, 0x27, 0xa9, 0xf8, 0xae,
-	0x4e, 0x87, 0xe4, 0x0b, 0x87, 0x1e, 0x11, 0xcc, 0x59, 0xbc, 0x44, 0xf0, 0xff, 0xa1, 0x5d, 0x44,
-	0x54, 0xa2, 0x7f, 0xd6, 0xa0, 0x7e, 0x8c, 0xb2, 0xb7, 0xe2, 0x6d, 0x0f, 0xb4, 0xe0, 0x74, 0x4a,
-	0x45, 0xf5, 0xfe, 0x19, 0xb4, 0xc4, 0x33, 0x81, 0x27, 0x88, 0xb0, 0x82, 0x37, 0xc2, 0x86, 0x20,
-	0xcf, 0x77, 0xcb, 0xfc, 0x73, 0x6b, 0xb1, 0xe0, 0xb9, 0xd5, 0x80, 0xf5, 0x54, 0x1c, 0x2a, 0xba,
-	0x47, 0xe9, 0xd8, 0x2d, 0x24, 0xec, 0x4e, 0x33, 0xf3, 0x96, 0x61, 0x9a, 0xd7, 0xe0, 0x6a, 0xa1,
-	0x32, 0x65, 0xeb, 0x57, 0xbc, 0xcf, 0x67, 0x06, 0xd8, 0x5e, 0xe8, 0x3d, 0x40, 0x2c, 0xb3, 0x6a,
-	0xe8, 0x3f, 0x87, 0x0d, 0xca, 0x70, 0x94, 0x0e, 0xde, 0x1e, 0x62, 0x2f, 0x79, 0x5d, 0xdf, 0x2a,
-	0xd8, 0x60, 0xb2, 0x43, 0x11, 0x7b, 0xc8, 0x6a, 0xd0, 0x3c, 0x92, 0x3f, 0x5e, 0x6e, 0xbe, 0xd6,
-	0x81, 0xe9, 0x0f, 0x11, 0xd5, 0xc1, 0xa4, 0x47, 0x7c, 0xcf, 0xbe, 0xd0, 0xee, 0x24, 0xea, 0xbd,
-	0x22, 0x25, 0xd4, 0x8f, 0x41, 0x3f, 0x9b, 0xae, 0x45, 0xb2, 0xc4, 0xdf, 0x7f, 0x93, 0xd3, 0xf9,
-	0xfd, 0x48, 0xd5, 0x61, 0xb6, 0x91, 0xf0, 0x4d, 0x67, 0x9e, 0x70, 0x81, 0x8e, 0x7c, 0x0c, 0xd5,
-	0xbb, 0x8e, 0x7b, 0x1a, 0x4f, 0x37, 0xd9, 0x6d, 0x28, 0xbb, 0x38, 0x74, 0x63, 0x42, 0x50, 0xe8,
-	0x4e, 0x54, 0xef, 0x4d, 0xa3, 0x38, 0x87, 0x78, 0x8e, 0xca, 0x72, 0x51, 0x6f, 0xd8, 0x34, 0xca,
-	0xfc, 0x0c, 0x6a, 0x89, 0x52, 0xe5, 0xc2, 0x2d, 0x58, 0x42, 0xa3, 0x59, 0xb1, 0xd4, 0xba, 0xc9,
-	0x3f, 0x64, 0xf6, 0x39, 0xd6, 0x92, 0x44, 0x35, 0x69, 0x19, 0x26, 0xe8, 0x80, 0xe0, 0x61, 0xc6,
-	0x2f, 0x73, 0x8f, 0x5f, 0xd3, 0x1c, 0xed, 0xad, 0xd4, 0xff, 0x02, 0x2a, 0xcf, 0xdf, 0x38, 0xa1,
-	0x79, 0xb6, 0xc6, 0x98, 0x9c, 0x9e, 0x04, 0x78, 0x9c, 0x0c, 0xca, 0x04, 0xe6, 0xb4, 0x53, 0x34,
-	0xa1, 0x91, 0xe3, 0x22, 0xf5, 0x9b, 0xdd, 0x14, 0x36, 0xbf, 0x84, 0xea, 0xf3, 0xcb, 0x8e, 0xf3,
-	0xbb, 0x5f, 0xfd, 0xe5, 0x55, 0x47, 0xfb, 0xeb, 0xab, 0x8e, 0xf6, 0xf7, 0x57, 0x1d, 0xed, 0xb7,
-	0xff, 0xe8, 0xfc, 0xdf, 0xf7, 0xdd, 0x91, 0xcf, 0x10, 0xa5, 0x5d, 0x1f, 0xef, 0xc8, 0xaf, 0x9d,
-	0x3e, 0xde, 0x19, 0xb1, 0x1d, 0xf1, 0x1f, 0xac, 0x9d, 0xdc, 0x93, 0xb7, 0xb7, 0x2c, 0x08, 0x77,
-	0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x11, 0xbb, 0x4b, 0xec, 0x4b, 0x1b, 0x00, 0x00,
+	// 2268 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4b, 0x6f, 0xdc, 0xc8,
+	0x11, 0x0e, 0x47, 0x0f, 0x4b, 0x35, 0x0f, 0x49, 0x9c, 0x91, 0x86, 0x1a, 0xc7, 0xb2, 0x4c, 0x7b,
+	0x77, 0x8d, 0x5d, 0x64, 0x94, 0xd5, 0x3e, 0xb0, 0xd8, 0x4d, 0x82, 0x95, 0x6d, 0xc9, 0xde, 0xb5,
+	0xbc, 0xd6, 0xd2, 0xaf, 0x60, 0x11, 0x84, 0xe0, 0x90, 0xad, 0x11, 0x21, 0x92, 0x4d, 0x77, 0x37,
+	0x47, 0x9a, 0x4b, 0x90, 0x5f, 0x90, 0x5c, 0x73, 0xca, 0x25, 0x40, 0x72, 0xcf, 0x8f, 0x08, 0x72,
+	0xcc, 0x69, 0x73, 0x0d, 0x9c, 0x1f, 0x91, 0x43, 0x0e, 0x09, 0xfa, 0x35, 0x43, 0xce, 0x50, 0xb2,
+	0x2c, 0x18, 0x41, 0x2e, 0x02, 0xeb, 0xab, 0xaa, 0xae, 0x47, 0x57, 0x57, 0x57, 0x8f, 0xa0, 0xcd,
+	0xbc, 0x5e, 0x84, 0x58, 0xec, 0x25, 0x5e, 0x1f, 0x91, 0xc0, 0x63, 0x5e, 0x37, 0x25, 0x98, 0x61,
+	0x73, 0x65, 0x8a, 0xd1, 0xa9, 0xbe, 0xcc, 0x10, 0x19, 0x4a, 0x7e, 0xa7, 0xc1, 0x70, 0x8a, 0xc7,
+	0xf2, 0x9d, 0x55, 0x82, 0xd2, 0x28, 0xf4, 0x3d, 0x16, 0xe2, 0x24, 0x07, 0xd7, 0x23, 0xdc, 0xcf,
+	0x58, 0x18, 0x29, 0xb2, 0x36, 0x60, 0x2c, 0x8c, 0x91, 0xa4, 0xec, 0xff, 0x18, 0xb0, 0xf4, 0x94,
+	0x9b, 0xb9, 0x87, 0x0e, 0xc3, 0x24, 0xe4, 0xaa, 0xa6, 0x09, 0xb3, 0x89, 0x17, 0x23, 0xcb, 0xd8,
+	0x34, 0x6e, 0x2f, 0x3a, 0xe2, 0xdb, 0x5c, 0x83, 0x79, 0xea, 0x1f, 0xa1, 0xd8, 0xb3, 0x2a, 0x02,
+	0x55, 0x94, 0x69, 0xc1, 0x15, 0x1f, 0x47, 0x59, 0x9c, 0x50, 0x6b, 0x66, 0x73, 0xe6, 0xf6, 0xa2,
+	0xa3, 0x49, 0xb3, 0x0b, 0xcd, 0x94, 0x84, 0xb1, 0x47, 0x86, 0xee, 0x31, 0x1a, 0xba, 0x5a, 0x6a,
+	0x56, 0x48, 0xad, 0x28, 0xd6, 0x43, 0x34, 0xbc, 0xab, 0xe4, 0x4d, 0x98, 0x65, 0xc3, 0x14, 0x59,
+	0x73, 0xd2, 0x2a, 0xff, 0x36, 0xaf, 0x43, 0x95, 0x07, 0xe2, 0x46, 0x28, 0xe9, 0xb3, 0x23, 0x6b,
+	0x7e, 0xd3, 0xb8, 0x3d, 0xeb, 0x00, 0x87, 0xf6, 0x05, 0x62, 0x5e, 0x85, 0x45, 0x82, 0x4f, 0x5c,
+	0x1f, 0x67, 0x09, 0xb3, 0xae, 0x08, 0xf6, 0x02, 0xc1, 0x27, 0x77, 0x39, 0x6d, 0xde, 0x82, 0xf9,
+	0xc3, 0x10, 0x45, 0x01, 0xb5, 0x16, 0x36, 0x67, 0x6e, 0x57, 0xb7, 0x6b, 0x5d, 0x99, 0xbd, 0x3d,
+	0x0e, 0x3a, 0x8a, 0x67, 0xff, 0xd1, 0x80, 0xe5, 0x27, 0x22, 0x98, 0x5c, 0x0a, 0xde, 0x83, 0x25,
+	0x6e, 0xa5, 0xe7, 0x51, 0xe4, 0xaa, 0xb8, 0x65, 0x36, 0x1a, 0x1a, 0x96, 0x2a, 0xe6, 0x63, 0x90,
+	0xbb, 0xe4, 0x06, 0x23, 0x65, 0x6a, 0x55, 0x84, 0x39, 0xbb, 0x3b, 0xbd, 0xb1, 0x13, 0xa9, 0x76,
+	0x96, 0x59, 0x11, 0xa0, 0x3c, 0xa1, 0x03, 0x44, 0x68, 0x88, 0x13, 0x6b, 0x46, 0x58, 0xd4, 0x24,
+	0x77, 0xd4, 0x94, 0x56, 0xef, 0x1e, 0x79, 0x49, 0x1f, 0x39, 0x88, 0x66, 0x11, 0x33, 0x1f, 0x40,
+	0xbd, 0x87, 0x0e, 0x31, 0x29, 0x38, 0x5a, 0xdd, 0xbe, 0x59, 0x62, 0x7d, 0x32, 0x4c, 0xa7, 0x26,
+	0x35, 0x55, 0x2c, 0x7b, 0x50, 0xf3, 0x0e, 0x19, 0x22, 0x6e, 0x6e, 0xa7, 0x2f, 0xb8, 0x50, 0x55,
+	0x28, 0x4a, 0xd8, 0xfe, 0x97, 0x01, 0x8d, 0x67, 0x14, 0x91, 0x03, 0x44, 0xe2, 0x90, 0x52, 0x55,
+	0x52, 0x47, 0x98, 0x32, 0x5d, 0x52, 0xfc, 0x9b, 0x63, 0x19, 0x45, 0x44, 0x15, 0x94, 0xf8, 0x36,
+	0x3f, 0x80, 0x95, 0xd4, 0xa3, 0xf4, 0x04, 0x93, 0xc0, 0xf5, 0x8f, 0x90, 0x7f, 0x4c, 0xb3, 0x58,
+	0xe4, 0x61, 0xd6, 0x59, 0xd6, 0x8c, 0xbb, 0x0a, 0x37, 0xbf, 0x05, 0x48, 0x49, 0x38, 0x08, 0x23,
+	0xd4, 0x47, 0xb2, 0xb0, 0xaa, 0xdb, 0x1f, 0x96, 0x78, 0x5b, 0xf4, 0xa5, 0x7b, 0x30, 0xd2, 0xd9,
+	0x4d, 0x18, 0x19, 0x3a, 0xb9, 0x45, 0x3a, 0x3f, 0x85, 0xa5, 0x09, 0xb6, 0xb9, 0x0c, 0x33, 0xc7,
+	0x68, 0xa8, 0x3c, 0xe7, 0x9f, 0x66, 0x0b, 0xe6, 0x06, 0x5e, 0x94, 0x21, 0xe5, 0xb9, 0x24, 0x3e,
+	0xaf, 0x7c, 0x66, 0xd8, 0xdf, 0x1b, 0x50, 0xbb, 0xd7, 0x7b, 0x4d, 0xdc, 0x0d, 0xa8, 0x04, 0x3d,
+	0xa5, 0x5b, 0x09, 0x7a, 0xa3, 0x3c, 0xcc, 0xe4, 0xf2, 0xf0, 0xb8, 0x24, 0xb4, 0xad, 0x92, 0xd0,
+	0xee, 0xf5, 0xfe, 0x37, 0x81, 0xfd, 0xc1, 0x80, 0xea, 0xd8, 0x12, 0x35, 0xf7, 0x61, 0x99, 0xfb,
+	0xe9, 0xa6, 0x63, 0xcc, 0x32, 0x84, 0x97, 0x37, 0x5e, 0xbb, 0x01, 0xce, 0x52, 0x56, 0xa0, 0xa9,
+	0xb9, 0x07, 0x8d, 0xa0, 0x57, 0x58, 0x4b, 0x9e, 0xa0, 0xeb, 0xaf, 0x89, 0xd8, 0xa9, 0x07, 0x39,
+	0x8a, 0xda, 0xef, 0x41, 0xf5, 0x20, 0x4c, 0xfa, 0x0e, 0x7a, 0x99, 0x21, 0xca, 0xf8, 0x51, 0x4a,
+	0xbd, 0x61, 0x84, 0xbd, 0x40, 0x05, 0xa9, 0x49, 0xfb, 0x36, 0xd4, 0xa4, 0x20, 0x4d, 0x71, 0x42,
+	0xd1, 0x39, 0x92, 0xef, 0x43, 0xed, 0x49, 0x84, 0x50, 0xaa, 0xd7, 0xec, 0xc0, 0x42, 0x90, 0x11,
+	0xd1, 0x62, 0x85, 0xe8, 0x8c, 0x33, 0xa2, 0xed, 0x25, 0xa8, 0x2b, 0x59, 0xb9, 0xac, 0xfd, 0x77,
+	0x03, 0xcc, 0xdd, 0x53, 0xe4, 0x67, 0x0c, 0x3d, 0xc0, 0xf8, 0x58, 0xaf, 0x51, 0xd6, 0x5f, 0x37,
+	0x00, 0x52, 0x8f, 0x78, 0x31, 0x62, 0x88, 0xc8, 0xf0, 0x17, 0x9d, 0x1c, 0x62, 0x1e, 0xc0, 0x22,
+	0x3a, 0x65, 0xc4, 0x73, 0x51, 0x32, 0x10, 0x9d, 0xb6, 0xba, 0xfd, 0x51, 0x49, 0x76, 0xa6, 0xad,
+	0x75, 0x77, 0xb9, 0xda, 0x6e, 0x32, 0x90, 0x35, 0xb1, 0x80, 0x14, 0xd9, 0xf9, 0x02, 0xea, 0x05,
+	0xd6, 0x1b, 0xd5, 0xc3, 0x21, 0x34, 0x0b, 0xa6, 0x54, 0x1e, 0xaf, 0x43, 0x15, 0x9d, 0x86, 0xcc,
+	0xa5, 0xcc, 0x63, 0x19, 0x55, 0x09, 0x02, 0x0e, 0x3d, 0x11, 0x88, 0xb8, 0x46, 0x58, 0x80, 0x33,
+	0x36, 0xba, 0x46, 0x04, 0xa5, 0x70, 0x44, 0xf4, 0x29, 0x50, 0x94, 0x3d, 0x80, 0xe5, 0xfb, 0x88,
+	0xc9, 0xbe, 0xa2, 0xd3, 0xb7, 0x06, 0xf3, 0x22, 0x70, 0x59, 0x71, 0x8b, 0x8e, 0xa2, 0xcc, 0x9b,
+	0x50, 0x0f, 0x13, 0x3f, 0xca, 0x02, 0xe4, 0x0e, 0x42, 0x74, 0x42, 0x85, 0x89, 0x05, 0xa7, 0xa6,
+	0xc0, 0xe7, 0x1c, 0x33, 0xdf, 0x81, 0x06, 0x3a, 0x95, 0x42, 0x6a, 0x11, 0x79, 0x6d, 0xd5, 0x15,
+	0x2a, 0x1a, 0x34, 0xb5, 0x11, 0xac, 0xe4, 0xec, 0xaa, 0xe8, 0x0e, 0x60, 0x45, 0x76, 0xc6, 0x5c,
+	0xb3, 0x7f, 0x93, 0x6e, 0xbb, 0x4c, 0x27, 0x10, 0xbb, 0x0d, 0xab, 0xf7, 0x11, 0xcb, 0x95, 0xb0,
+	0x8a, 0xd1, 0xfe, 0x0e, 0xd6, 0x26, 0x19, 0xca, 0x89, 0x2f, 0xa1, 0x5a, 0x3c, 0x74, 0xdc, 0xfc,
+	0x46, 0x89, 0xf9, 0xbc, 0x72, 0x5e, 0xc5, 0x6e, 0x81, 0xf9, 0x04, 0x31, 0x07, 0x79, 0xc1, 0xe3,
+	0x24, 0x1a, 0x6a, 0x8b, 0xab, 0xd0, 0x2c, 0xa0, 0xaa, 0x84, 0xc7, 0xf0, 0x0b, 0x12, 0x32, 0xa4,
+	0xa5, 0xd7, 0xa0, 0x55, 0x84, 0x95, 0xf8, 0xd7, 0xb0, 0x22, 0x2f, 0xa7, 0xa7, 0xc3, 0x54, 0x0b,
+	0x9b, 0x9f, 0x40, 0x55, 0xba, 0xe7, 0x8a, 0x0b, 0x9e, 0xbb, 0xdc, 0xd8, 0x6e, 0x75, 0x47, 0xd3,
+	0x8b, 0xc8, 0x39, 0x13, 0x1a, 0xc0, 0x46, 0xdf, 0xdc, 0xcf, 0xfc, 0x5a, 0x63, 0x87, 0x1c, 0x74,
+	0x48, 0x10, 0x3d, 0xe2, 0x25, 0x95, 0x77, 0xa8, 0x08, 0x2b, 0xf1, 0x36, 0xac, 0x3a, 0x59, 0xf2,
+	0x00, 0x79, 0x11, 0x3b, 0x12, 0x17, 0x87, 0x56, 0xb0, 0x60, 0x6d, 0x92, 0xa1, 0x54, 0x3e, 0x06,
+	0xeb, 0xab, 0x7e, 0x82, 0x09, 0x92, 0xcc, 0x5d, 0x42, 0x30, 0x29, 0xb4, 0x14, 0xc6, 0x10, 0x49,
+	0xc6, 0x8d, 0x42, 0x90, 0xf6, 0x55, 0x58, 0x2f, 0xd1, 0x52, 0x4b, 0x7e, 0xce, 0x9d, 0xe6, 0xfd,
+	0xa4, 0x58, 0xc9, 0x37, 0xa1, 0x7e, 0xe2, 0x85, 0xcc, 0x4d, 0x31, 0x1d, 0x17, 0xd3, 0xa2, 0x53,
+	0xe3, 0xe0, 0x81, 0xc2, 0x64, 0x64, 0x79, 0x5d, 0xb5, 0xe6, 0x36, 0xac, 0x1d, 0x10, 0x74, 0x18,
+	0x85, 0xfd, 0xa3, 0x89, 0x03, 0xc2, 0x67, 0x32, 0x91, 0x38, 0x7d, 0x42, 0x34, 0x69, 0xf7, 0xa1,
+	0x3d, 0xa5, 0xa3, 0xea, 0x6a, 0x1f, 0x1a, 0x52, 0xca, 0x25, 0x62, 0xae, 0xd0, 0xfd, 0xfc, 0x9d,
+	0x33, 0x2b, 0x3b, 0x3f, 0x85, 0x38, 0x75, 0x3f, 0x47, 0x51, 0xfb, 0xdf, 0x06, 0x98, 0x3b, 0x69,
+	0x1a, 0x0d, 0x8b, 0x9e, 0x2d, 0xc3, 0x0c, 0x7d, 0x19, 0xe9, 0x16, 0x43, 0x5f, 0x46, 0xbc, 0xc5,
+	0x1c, 0x62, 0xe2, 0x23, 0x75, 0x58, 0x25, 0xc1, 0xc7, 0x00, 0x2f, 0x8a, 0xf0, 0x89, 0x9b, 0x9b,
+	0x68, 0x45, 0x67, 0x58, 0x70, 0x96, 0x05, 0xc3, 0x19, 0xe3, 0xd3, 0x03, 0xd0, 0xec, 0xdb, 0x1a,
+	0x80, 0xe6, 0x2e, 0x39, 0x00, 0xfd, 0xc9, 0x80, 0x66, 0x21, 0x7a, 0x95, 0xe3, 0xff, 0xbf, 0x51,
+	0xad, 0x09, 0x2b, 0xfb, 0xd8, 0x3f, 0x96, 0x5d, 0x4f, 0x1f, 0x8d, 0x16, 0x98, 0x79, 0x70, 0x7c,
+	0xf0, 0x9e, 0x25, 0xd1, 0x94, 0xf0, 0x1a, 0xb4, 0x8a, 0xb0, 0x12, 0xff, 0xb3, 0x01, 0x96, 0xba,
+	0x22, 0xf6, 0x10, 0xf3, 0x8f, 0x76, 0xe8, 0xbd, 0xde, 0xa8, 0x0e, 0x5a, 0x30, 0x27, 0x46, 0x71,
+	0x91, 0x80, 0x9a, 0x23, 0x09, 0xb3, 0x0d, 0x57, 0x82, 0x9e, 0x2b, 0xae, 0x46, 0x75, 0x3b, 0x04,
+	0xbd, 0x6f, 0xf8, 0xe5, 0xb8, 0x0e, 0x0b, 0xb1, 0x77, 0xea, 0x12, 0x7c, 0x42, 0xd5, 0x30, 0x78,
+	0x25, 0xf6, 0x4e, 0x1d, 0x7c, 0x42, 0xc5, 0xa0, 0x1e, 0x52, 0x31, 0x81, 0xf7, 0xc2, 0x24, 0xc2,
+	0x7d, 0x2a, 0xb6, 0x7f, 0xc1, 0x69, 0x28, 0xf8, 0x8e, 0x44, 0xf9, 0x59, 0x23, 0xe2, 0x18, 0xe5,
+	0x37, 0x77, 0xc1, 0xa9, 0x91, 0xdc, 0xd9, 0xb2, 0xef, 0xc3, 0x7a, 0x89, 0xcf, 0x6a, 0xf7, 0xde,
+	0x87, 0x79, 0x79, 0x34, 0xd4, 0xb6, 0x99, 0xea, 0x39, 0xf1, 0x2d, 0xff, 0xab, 0x8e, 0x81, 0x92,
+	0xb0, 0x7f, 0x63, 0xc0, 0xb5, 0xe2, 0x4a, 0x3b, 0x51, 0xc4, 0x07, 0x30, 0xfa, 0xf6, 0x53, 0x30,
+	0x15, 0xd9, 0x6c, 0x49, 0x64, 0xfb, 0xb0, 0x71, 0x96, 0x3f, 0x97, 0x08, 0xef, 0xe1, 0xe4, 0xde,
+	0xee, 0xa4, 0xe9, 0xf9, 0x81, 0xe5, 0xfd, 0xaf, 0x14, 0xfc, 0x9f, 0x4e, 0xba, 0x58, 0xec, 0x12,
+	0x5e, 0x75, 0xc0, 0xca, 0xf5, 0x05, 0x39, 0x71, 0xe8, 0x32, 0xdd, 0x87, 0xf5, 0x12, 0x9e, 0x32,
+	0xb2, 0xc5, 0xa7, 0x8f, 0xd1, 0xc4, 0x52, 0xdd, 0x6e, 0x77, 0x27, 0x5f, 0xd2, 0x4a, 0x41, 0x89,
+	0xf1, 0xb3, 0xf0, 0xc8, 0xa3, 0xfc, 0x18, 0x15, 0x8c, 0x3c, 0x82, 0x56, 0x11, 0x56, 0xeb, 0x7f,
+	0x32, 0xb1, 0xfe, 0xb5, 0xa9, 0xf5, 0x0b, 0x6a, 0xda, 0x4a, 0x1b, 0x56, 0x25, 0xae, 0xef, 0x02,
+	0x6d, 0xe7, 0x63, 0x58, 0x9b, 0x64, 0x28, 0x4b, 0x1d, 0x58, 0x98, 0xb8, 0x4c, 0x46, 0x34, 0xd7,
+	0x7a, 0xe1, 0x85, 0x6c, 0x0f, 0x4f, 0xae, 0x77, 0xae, 0xd6, 0x3a, 0xb4, 0xa7, 0xb4, 0xd4, 0x11,
+	0xb7, 0x60, 0xed, 0x09, 0xc3, 0x69, 0x2e, 0xaf, 0xda, 0xc1, 0x75, 0x68, 0x4f, 0x71, 0x94, 0xd2,
+	0x2f, 0xe1, 0xda, 0x04, 0xeb, 0x51, 0x98, 0x84, 0x71, 0x16, 0x5f, 0xc0, 0x19, 0xf3, 0x06, 0x88,
+	0xbb, 0xd1, 0x65, 0x61, 0x8c, 0xf4, 0x10, 0x39, 0xe3, 0x54, 0x39, 0xf6, 0x54, 0x42, 0xf6, 0x4f,
+	0x60, 0xe3, 0xac, 0xf5, 0x2f, 0x90, 0x23, 0xe1, 0xb8, 0x47, 0x58, 0x49, 0x4c, 0x1d, 0xb0, 0xa6,
+	0x59, 0x2a, 0xa8, 0x1e, 0xdc, 0x98, 0xe4, 0x3d, 0x4b, 0x58, 0x18, 0xed, 0xf0, 0x56, 0xfb, 0x96,
+	0x02, 0xbb, 0x05, 0xf6, 0x79, 0x36, 0x94, 0x27, 0x2d, 0x30, 0xef, 0x23, 0x2d, 0x33, 0x2a, 0xcc,
+	0x0f, 0xa0, 0x59, 0x40, 0x55, 0x26, 0x5a, 0x30, 0xe7, 0x05, 0x01, 0xd1, 0x63, 0x82, 0x24, 0x78,
+	0x0e, 0x1c, 0x44, 0xd1, 0x19, 0x39, 0x98, 0x66, 0x29, 0xcb, 0x5b, 0xd0, 0x7e, 0x9e, 0xc3, 0xf9,
+	0x91, 0x2e, 0x6d, 0x09, 0x8b, 0xaa, 0x25, 0xd8, 0x7b, 0x60, 0x4d, 0x2b, 0x5c, 0xaa, 0x19, 0x5d,
+	0xcb, 0xaf, 0x33, 0xae, 0x56, 0x6d, 0xbe, 0x01, 0x95, 0x30, 0x50, 0x8f, 0x91, 0x4a, 0x18, 0x14,
+	0x36, 0xa2, 0x32, 0x51, 0x00, 0x9b, 0xb0, 0x71, 0xd6, 0x62, 0x2a, 0xce, 0x26, 0xac, 0x7c, 0x95,
+	0x84, 0x4c, 0x1e, 0x40, 0x9d, 0x98, 0x1f, 0x83, 0x99, 0x07, 0x2f, 0x50, 0x69, 0xdf, 0x1b, 0xb0,
+	0x71, 0x80, 0xd3, 0x2c, 0x12, 0xd3, 0x6a, 0xea, 0x11, 0x94, 0xb0, 0xaf, 0x71, 0x46, 0x12, 0x2f,
+	0xd2, 0x7e, 0xbf, 0x0b, 0x4b, 0xbc, 0x1e, 0x5c, 0x9f, 0x20, 0x8f, 0xa1, 0xc0, 0x4d, 0xf4, 0x8b,
+	0xaa, 0xce, 0xe1, 0xbb, 0x12, 0xfd, 0x86, 0xf2, 0x57, 0x97, 0xe7, 0xf3, 0x45, 0xf3, 0x17, 0x07,
+	0x48, 0x48, 0x5c, 0x1e, 0x9f, 0x41, 0x2d, 0x16, 0x9e, 0xb9, 0x5e, 0x14, 0x7a, 0xf2, 0x02, 0xa9,
+	0x6e, 0xaf, 0x4e, 0x4e, 0xe0, 0x3b, 0x9c, 0xe9, 0x54, 0xa5, 0xa8, 0x20, 0xcc, 0x0f, 0xa1, 0x95,
+	0x6b, 0x55, 0xe3, 0x41, 0x75, 0x56, 0xd8, 0x68, 0xe6, 0x78, 0xa3, 0x79, 0xf5, 0x06, 0x5c, 0x3f,
+	0x33, 0x2e, 0x95, 0xc2, 0xdf, 0x1b, 0x32, 0x5d, 0x2a, 0xd1, 0x3a, 0xde, 0x1f, 0xc1, 0xbc, 0x94,
+	0xb7, 0x8c, 0xf3, 0x1c, 0x54, 0x42, 0x67, 0xfa, 0x56, 0x39, 0xd3, 0xb7, 0xb2, 0x8c, 0xce, 0x94,
+	0x64, 0x94, 0xf7, 0xf7, 0x82, 0x7f, 0xe3, 0x11, 0xe8, 0x1e, 0x8a, 0x31, 0x43, 0xc5, 0xcd, 0xff,
+	0xad, 0x01, 0xad, 0x22, 0xae, 0xf6, 0xff, 0x23, 0x68, 0x06, 0x28, 0x25, 0xc8, 0x17, 0xc6, 0x8a,
+	0xa5, 0x70, 0xa7, 0x62, 0x19, 0x8e, 0x39, 0x66, 0x8f, 0x7c, 0xbc, 0x03, 0x75, 0xb5, 0x59, 0xea,
+	0xce, 0xa8, 0x5c, 0xe4, 0xce, 0xa8, 0xc5, 0x39, 0x8a, 0x1f, 0xe1, 0x67, 0x49, 0x80, 0xcb, 0x9c,
+	0xed, 0x80, 0x35, 0xcd, 0x52, 0xf1, 0x5d, 0x1d, 0x5d, 0x92, 0x2f, 0x3c, 0x7a, 0x40, 0x30, 0x17,
+	0x09, 0xb4, 0xe2, 0x0f, 0xa1, 0x53, 0xc6, 0x54, 0xaa, 0x7f, 0xe1, 0xbf, 0xa2, 0xa2, 0xe2, 0xa9,
+	0x78, 0xd3, 0x0d, 0x2d, 0xd9, 0x9d, 0x4a, 0x59, 0xbd, 0x7f, 0x0a, 0x6d, 0xf1, 0x4c, 0xe0, 0x09,
+	0x22, 0xac, 0xe4, 0x8d, 0xb0, 0x2a, 0xd8, 0x93, 0xdd, 0x72, 0xfa, 0xb9, 0x35, 0x5b, 0xf2, 0xdc,
+	0x6a, 0xc2, 0x4a, 0x2e, 0x0e, 0x15, 0xdd, 0xc3, 0x7c, 0xec, 0x0e, 0x12, 0x76, 0x51, 0x70, 0xb9,
+	0x30, 0xed, 0x6b, 0x70, 0xb5, 0x74, 0x31, 0x65, 0xeb, 0x57, 0xbc, 0xcf, 0x17, 0x2e, 0xb0, 0x9d,
+	0x24, 0xe0, 0x3f, 0x46, 0xe4, 0x47, 0x0d, 0xf3, 0xe7, 0xb0, 0x4a, 0x19, 0x4e, 0xf3, 0xc1, 0xbb,
+	0x31, 0x0e, 0xf4, 0xeb, 0xfa, 0x56, 0xc9, 0x04, 0x53, 0xbc, 0x14, 0x71, 0x80, 0x9c, 0x26, 0x9d,
+	0x06, 0xf9, 0xe3, 0xe5, 0xe6, 0xb9, 0x0e, 0x8c, 0x7e, 0x88, 0xa8, 0x1f, 0x0d, 0x7b, 0x24, 0x0c,
+	0xdc, 0x0b, 0xcd, 0x4e, 0xa2, 0xde, 0x6b, 0x52, 0x43, 0x22, 0xe6, 0xcf, 0x46, 0x63, 0x91, 0x2c,
+	0xf1, 0x77, 0x5f, 0xe7, 0xf4, 0xf4, 0x7c, 0xa4, 0xea, 0xb0, 0xd8, 0x48, 0xf8, 0xa4, 0x33, 0xc9,
+	0xb8, 0x40, 0x47, 0xce, 0xa0, 0x7e, 0xc7, 0xf3, 0x8f, 0xb3, 0xd1, 0x24, 0xbb, 0x09, 0x55, 0x1f,
+	0x27, 0x7e, 0x46, 0x08, 0x4a, 0xfc, 0xa1, 0xea, 0xbd, 0x79, 0x88, 0x4b, 0x88, 0xe7, 0xa8, 0x2c,
+	0x17, 0xf5, 0x86, 0xcd, 0x43, 0x5c, 0x22, 0x4c, 0x7c, 0x82, 0x62, 0x94, 0x30, 0x2f, 0x52, 0xf5,
+	0x99, 0x87, 0xec, 0x4f, 0xa1, 0xa1, 0xcd, 0x2a, 0x27, 0x6f, 0xc1, 0x1c, 0x1a, 0x8c, 0xcb, 0xa9,
+	0xd1, 0xd5, 0xff, 0xc0, 0xd9, 0xe5, 0xa8, 0x23, 0x99, 0xf6, 0xaf, 0x0d, 0x71, 0x19, 0x33, 0x4c,
+	0xd0, 0x1e, 0xc1, 0x71, 0xd1, 0xf5, 0x5b, 0xd0, 0x20, 0x92, 0xe7, 0x32, 0xcc, 0x0b, 0x5e, 0xff,
+	0xb4, 0xa0, 0xd0, 0xa7, 0xf8, 0x00, 0xf3, 0x0d, 0x68, 0xe5, 0xa4, 0xf8, 0x21, 0xa3, 0xcc, 0x8b,
+	0x53, 0xb5, 0x1d, 0xb5, 0xae, 0xfa, 0x4f, 0x11, 0x9f, 0x40, 0x1c, 0x73, 0xa4, 0xf9, 0x54, 0xcb,
+	0xd9, 0x3b, 0xbc, 0x5f, 0x4c, 0x79, 0xf0, 0x46, 0x51, 0xfc, 0x02, 0x6a, 0xcf, 0x5f, 0x3b, 0x2a,
+	0xf0, 0x6d, 0x3b, 0xc1, 0xe4, 0xf8, 0x30, 0xc2, 0x27, 0xfa, 0xc6, 0xd6, 0x34, 0xe7, 0x1d, 0xa3,
+	0x21, 0x4d, 0x3d, 0x1f, 0xa9, 0x1f, 0x0f, 0x47, 0xb4, 0xfd, 0x05, 0xd4, 0x9f, 0x5f, 0x76, 0xae,
+	0xb8, 0xf3, 0xe5, 0x5f, 0x5f, 0x6d, 0x18, 0x7f, 0x7b, 0xb5, 0x61, 0xfc, 0xe3, 0xd5, 0x86, 0xf1,
+	0xbb, 0x7f, 0x6e, 0xfc, 0xe0, 0xbb, 0xee, 0x20, 0x64, 0x88, 0xd2, 0x6e, 0x88, 0xb7, 0xe4, 0xd7,
+	0x56, 0x1f, 0x6f, 0x0d, 0xd8, 0x96, 0xf8, 0x57, 0xda, 0xd6, 0xd4, 0xdb, 0xbb, 0x37, 0x2f, 0x18,
+	0x1f, 0xfd, 0x77, 0x00, 0xb7, 0xc6, 0x24, 0x25, 0xe2, 0x1b, 0x00, 0x00,
 }
 
 func (m *TableDefinition) Marshal() (dAtA []byte, err error) {
@@ -8053,6 +8090,16 @@ func (m *BackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Incremental {
+		i--
+		if m.Incremental {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.AllowMaster {
 		i--
 		if m.AllowMaster {
@@ -8134,6 +8181,25 @@ func (m *RestoreFromBackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RestoreToTimestamp != nil {
+		{
+			size, err := m.RestoreToTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTabletmanagerdata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RestoreToPos) > 0 {
+		i -= len(m.RestoreToPos)
+		copy(dAtA[i:], m.RestoreToPos)
+		i = encodeVarintTabletmanagerdata(dAtA, i, uint64(len(m.RestoreToPos)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -9739,6 +9805,9 @@ func (m *BackupRequest) Size() (n int) {
 	if m.AllowMaster {
 		n += 2
 	}
+	if m.Incremental {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9767,6 +9836,14 @@ func (m *RestoreFromBackupRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.RestoreToPos)
+	if l > 0 {
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
+	if m.RestoreToTimestamp != nil {
+		l = m.RestoreToTimestamp.Size()
+		n += 1 + l + sovTabletmanagerdata(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17820,6 +17897,26 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowMaster = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Incremental = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
@@ -17964,6 +18061,74 @@ func (m *RestoreFromBackupRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: RestoreFromBackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreToPos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestoreToPos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreToTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTabletmanagerdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTabletmanagerdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestoreToTimestamp == nil {
+				m.RestoreToTimestamp = &vttime.Time{}
+			}
+			if err := m.RestoreToTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTabletmanagerdata(dAtA[iNdEx:])
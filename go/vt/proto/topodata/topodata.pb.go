@@ -4,6 +4,7 @@
 package topodata
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -158,6 +159,53 @@ func (TabletType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_52c350cb619f972e, []int{2}
 }
 
+type VReplicationWorkflow_State int32
+
+const (
+	// UNKNOWN is used for workflows created before the state was tracked.
+	VReplicationWorkflow_UNKNOWN VReplicationWorkflow_State = 0
+	// COPYING means the streams are still copying the initial data.
+	VReplicationWorkflow_COPYING VReplicationWorkflow_State = 1
+	// RUNNING means the copy phase is done and the streams are replicating.
+	VReplicationWorkflow_RUNNING VReplicationWorkflow_State = 2
+	// READS_SWITCHED means reads are served by the target keyspace.
+	VReplicationWorkflow_READS_SWITCHED VReplicationWorkflow_State = 3
+	// WRITES_SWITCHED means writes are served by the target keyspace.
+	VReplicationWorkflow_WRITES_SWITCHED VReplicationWorkflow_State = 4
+	// COMPLETED means the workflow has been completed and cleaned up.
+	VReplicationWorkflow_COMPLETED VReplicationWorkflow_State = 5
+	// CANCELLED means the workflow has been cancelled.
+	VReplicationWorkflow_CANCELLED VReplicationWorkflow_State = 6
+)
+
+var VReplicationWorkflow_State_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "COPYING",
+	2: "RUNNING",
+	3: "READS_SWITCHED",
+	4: "WRITES_SWITCHED",
+	5: "COMPLETED",
+	6: "CANCELLED",
+}
+
+var VReplicationWorkflow_State_value = map[string]int32{
+	"UNKNOWN":         0,
+	"COPYING":         1,
+	"RUNNING":         2,
+	"READS_SWITCHED":  3,
+	"WRITES_SWITCHED": 4,
+	"COMPLETED":       5,
+	"CANCELLED":       6,
+}
+
+func (x VReplicationWorkflow_State) String() string {
+	return proto.EnumName(VReplicationWorkflow_State_name, int32(x))
+}
+
+func (VReplicationWorkflow_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_52c350cb619f972e, []int{16, 0}
+}
+
 // KeyRange describes a range of sharding keys, when range-based
 // sharding is used.
 type KeyRange struct {
@@ -482,10 +530,13 @@ type Shard struct {
 	TabletControls []*Shard_TabletControl `protobuf:"bytes,6,rep,name=tablet_controls,json=tabletControls,proto3" json:"tablet_controls,omitempty"`
 	// is_master_serving sets whether this shard master is serving traffic or not.
 	// The keyspace lock is always taken when changing this.
-	IsMasterServing      bool     `protobuf:"varint,7,opt,name=is_master_serving,json=isMasterServing,proto3" json:"is_master_serving,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	IsMasterServing bool `protobuf:"varint,7,opt,name=is_master_serving,json=isMasterServing,proto3" json:"is_master_serving,omitempty"`
+	// backup_retention_policy overrides the keyspace's backup retention policy
+	// for this shard.
+	BackupRetentionPolicy *BackupRetentionPolicy `protobuf:"bytes,9,opt,name=backup_retention_policy,json=backupRetentionPolicy,proto3" json:"backup_retention_policy,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}               `json:"-"`
+	XXX_unrecognized      []byte                 `json:"-"`
+	XXX_sizecache         int32                  `json:"-"`
 }
 
 func (m *Shard) Reset()         { *m = Shard{} }
@@ -570,6 +621,13 @@ func (m *Shard) GetIsMasterServing() bool {
 	return false
 }
 
+func (m *Shard) GetBackupRetentionPolicy() *BackupRetentionPolicy {
+	if m != nil {
+		return m.BackupRetentionPolicy
+	}
+	return nil
+}
+
 // ServedType is an entry in the served_types
 type Shard_ServedType struct {
 	TabletType           TabletType `protobuf:"varint,1,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
@@ -788,6 +846,79 @@ func (m *Shard_TabletControl) GetFrozen() bool {
 	return false
 }
 
+// BackupRetentionPolicy describes which backups of a shard are kept when old
+// backups are pruned. Only complete backups count towards the limits, and
+// incremental backups are kept as long as the full backup they build on.
+type BackupRetentionPolicy struct {
+	// keep_count is the number of most recent full backups to keep. 0 means
+	// backups are not pruned by count.
+	KeepCount uint32 `protobuf:"varint,1,opt,name=keep_count,json=keepCount,proto3" json:"keep_count,omitempty"`
+	// max_age is how long full backups are kept. Unset means backups are not
+	// pruned by age.
+	MaxAge *vttime.Duration `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// min_valid_count is the number of most recent complete full backups that
+	// are always kept, even if they are beyond keep_count or max_age. At least
+	// one is always kept.
+	MinValidCount        uint32   `protobuf:"varint,3,opt,name=min_valid_count,json=minValidCount,proto3" json:"min_valid_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupRetentionPolicy) Reset()         { *m = BackupRetentionPolicy{} }
+func (m *BackupRetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*BackupRetentionPolicy) ProtoMessage()    {}
+func (*BackupRetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52c350cb619f972e, []int{4}
+}
+func (m *BackupRetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupRetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupRetentionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupRetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupRetentionPolicy.Merge(m, src)
+}
+func (m *BackupRetentionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupRetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupRetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupRetentionPolicy proto.InternalMessageInfo
+
+func (m *BackupRetentionPolicy) GetKeepCount() uint32 {
+	if m != nil {
+		return m.KeepCount
+	}
+	return 0
+}
+
+func (m *BackupRetentionPolicy) GetMaxAge() *vttime.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return nil
+}
+
+func (m *BackupRetentionPolicy) GetMinValidCount() uint32 {
+	if m != nil {
+		return m.MinValidCount
+	}
+	return 0
+}
+
 // A Keyspace contains data about a keyspace.
 type Keyspace struct {
 	// name of the column used for sharding
@@ -810,17 +941,27 @@ type Keyspace struct {
 	// snapshot_time (in UTC) is a property of snapshot
 	// keyspaces which tells us what point in time
 	// the snapshot is of
-	SnapshotTime         *vttime.Time `protobuf:"bytes,7,opt,name=snapshot_time,json=snapshotTime,proto3" json:"snapshot_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	SnapshotTime *vttime.Time `protobuf:"bytes,7,opt,name=snapshot_time,json=snapshotTime,proto3" json:"snapshot_time,omitempty"`
+	// backup_retention_policy is the backup retention policy for all shards
+	// of the keyspace that don't have their own.
+	BackupRetentionPolicy *BackupRetentionPolicy `protobuf:"bytes,8,opt,name=backup_retention_policy,json=backupRetentionPolicy,proto3" json:"backup_retention_policy,omitempty"`
+	// durability_policy is the name of the durability policy used by the
+	// reparenting tools and vtorc for all shards of the keyspace. Empty means
+	// the default policy of the process doing the reparent.
+	DurabilityPolicy string `protobuf:"bytes,9,opt,name=durability_policy,json=durabilityPolicy,proto3" json:"durability_policy,omitempty"`
+	// throttled_apps are the app throttling rules applied by the tablet
+	// throttlers of all shards of the keyspace.
+	ThrottledApps        []*ThrottledAppRule `protobuf:"bytes,10,rep,name=throttled_apps,json=throttledApps,proto3" json:"throttled_apps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Keyspace) Reset()         { *m = Keyspace{} }
 func (m *Keyspace) String() string { return proto.CompactTextString(m) }
 func (*Keyspace) ProtoMessage()    {}
 func (*Keyspace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{4}
+	return fileDescriptor_52c350cb619f972e, []int{5}
 }
 func (m *Keyspace) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,6 +1032,27 @@ func (m *Keyspace) GetSnapshotTime() *vttime.Time {
 	return nil
 }
 
+func (m *Keyspace) GetBackupRetentionPolicy() *BackupRetentionPolicy {
+	if m != nil {
+		return m.BackupRetentionPolicy
+	}
+	return nil
+}
+
+func (m *Keyspace) GetDurabilityPolicy() string {
+	if m != nil {
+		return m.DurabilityPolicy
+	}
+	return ""
+}
+
+func (m *Keyspace) GetThrottledApps() []*ThrottledAppRule {
+	if m != nil {
+		return m.ThrottledApps
+	}
+	return nil
+}
+
 // ServedFrom indicates a relationship between a TabletType and the
 // keyspace name that's serving it.
 type Keyspace_ServedFrom struct {
@@ -909,7 +1071,7 @@ func (m *Keyspace_ServedFrom) Reset()         { *m = Keyspace_ServedFrom{} }
 func (m *Keyspace_ServedFrom) String() string { return proto.CompactTextString(m) }
 func (*Keyspace_ServedFrom) ProtoMessage()    {}
 func (*Keyspace_ServedFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{4, 0}
+	return fileDescriptor_52c350cb619f972e, []int{5, 0}
 }
 func (m *Keyspace_ServedFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -959,6 +1121,75 @@ func (m *Keyspace_ServedFrom) GetKeyspace() string {
 	return ""
 }
 
+// ThrottledAppRule throttles the checks of an app against the tablet
+// throttler, until it expires.
+type ThrottledAppRule struct {
+	// name of the app, e.g. "vreplication" or "online-ddl".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// ratio of the app's checks that are rejected, from 0.0 (none) to 1.0
+	// (all of them).
+	Ratio float64 `protobuf:"fixed64,2,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	// expires_at is the time at which the rule stops applying.
+	ExpiresAt            *vttime.Time `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ThrottledAppRule) Reset()         { *m = ThrottledAppRule{} }
+func (m *ThrottledAppRule) String() string { return proto.CompactTextString(m) }
+func (*ThrottledAppRule) ProtoMessage()    {}
+func (*ThrottledAppRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52c350cb619f972e, []int{6}
+}
+func (m *ThrottledAppRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThrottledAppRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThrottledAppRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThrottledAppRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrottledAppRule.Merge(m, src)
+}
+func (m *ThrottledAppRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThrottledAppRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrottledAppRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThrottledAppRule proto.InternalMessageInfo
+
+func (m *ThrottledAppRule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ThrottledAppRule) GetRatio() float64 {
+	if m != nil {
+		return m.Ratio
+	}
+	return 0
+}
+
+func (m *ThrottledAppRule) GetExpiresAt() *vttime.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
 // ShardReplication describes the MySQL replication relationships
 // whithin a cell.
 type ShardReplication struct {
@@ -974,7 +1205,7 @@ func (m *ShardReplication) Reset()         { *m = ShardReplication{} }
 func (m *ShardReplication) String() string { return proto.CompactTextString(m) }
 func (*ShardReplication) ProtoMessage()    {}
 func (*ShardReplication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{5}
+	return fileDescriptor_52c350cb619f972e, []int{7}
 }
 func (m *ShardReplication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1022,7 +1253,7 @@ func (m *ShardReplication_Node) Reset()         { *m = ShardReplication_Node{} }
 func (m *ShardReplication_Node) String() string { return proto.CompactTextString(m) }
 func (*ShardReplication_Node) ProtoMessage()    {}
 func (*ShardReplication_Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{5, 0}
+	return fileDescriptor_52c350cb619f972e, []int{7, 0}
 }
 func (m *ShardReplication_Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1072,7 +1303,7 @@ func (m *ShardReference) Reset()         { *m = ShardReference{} }
 func (m *ShardReference) String() string { return proto.CompactTextString(m) }
 func (*ShardReference) ProtoMessage()    {}
 func (*ShardReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{6}
+	return fileDescriptor_52c350cb619f972e, []int{8}
 }
 func (m *ShardReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1362,7 @@ func (m *ShardTabletControl) Reset()         { *m = ShardTabletControl{} }
 func (m *ShardTabletControl) String() string { return proto.CompactTextString(m) }
 func (*ShardTabletControl) ProtoMessage()    {}
 func (*ShardTabletControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{7}
+	return fileDescriptor_52c350cb619f972e, []int{9}
 }
 func (m *ShardTabletControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1198,7 +1429,7 @@ func (m *SrvKeyspace) Reset()         { *m = SrvKeyspace{} }
 func (m *SrvKeyspace) String() string { return proto.CompactTextString(m) }
 func (*SrvKeyspace) ProtoMessage()    {}
 func (*SrvKeyspace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{8}
+	return fileDescriptor_52c350cb619f972e, []int{10}
 }
 func (m *SrvKeyspace) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1502,7 @@ func (m *SrvKeyspace_KeyspacePartition) Reset()         { *m = SrvKeyspace_Keysp
 func (m *SrvKeyspace_KeyspacePartition) String() string { return proto.CompactTextString(m) }
 func (*SrvKeyspace_KeyspacePartition) ProtoMessage()    {}
 func (*SrvKeyspace_KeyspacePartition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{8, 0}
+	return fileDescriptor_52c350cb619f972e, []int{10, 0}
 }
 func (m *SrvKeyspace_KeyspacePartition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1337,7 +1568,7 @@ func (m *SrvKeyspace_ServedFrom) Reset()         { *m = SrvKeyspace_ServedFrom{}
 func (m *SrvKeyspace_ServedFrom) String() string { return proto.CompactTextString(m) }
 func (*SrvKeyspace_ServedFrom) ProtoMessage()    {}
 func (*SrvKeyspace_ServedFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{8, 1}
+	return fileDescriptor_52c350cb619f972e, []int{10, 1}
 }
 func (m *SrvKeyspace_ServedFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1401,7 +1632,7 @@ func (m *CellInfo) Reset()         { *m = CellInfo{} }
 func (m *CellInfo) String() string { return proto.CompactTextString(m) }
 func (*CellInfo) ProtoMessage()    {}
 func (*CellInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{9}
+	return fileDescriptor_52c350cb619f972e, []int{11}
 }
 func (m *CellInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1457,7 +1688,7 @@ func (m *CellsAlias) Reset()         { *m = CellsAlias{} }
 func (m *CellsAlias) String() string { return proto.CompactTextString(m) }
 func (*CellsAlias) ProtoMessage()    {}
 func (*CellsAlias) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{10}
+	return fileDescriptor_52c350cb619f972e, []int{12}
 }
 func (m *CellsAlias) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1506,7 +1737,7 @@ func (m *TopoConfig) Reset()         { *m = TopoConfig{} }
 func (m *TopoConfig) String() string { return proto.CompactTextString(m) }
 func (*TopoConfig) ProtoMessage()    {}
 func (*TopoConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{11}
+	return fileDescriptor_52c350cb619f972e, []int{13}
 }
 func (m *TopoConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1567,7 +1798,7 @@ func (m *ExternalVitessCluster) Reset()         { *m = ExternalVitessCluster{} }
 func (m *ExternalVitessCluster) String() string { return proto.CompactTextString(m) }
 func (*ExternalVitessCluster) ProtoMessage()    {}
 func (*ExternalVitessCluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{12}
+	return fileDescriptor_52c350cb619f972e, []int{14}
 }
 func (m *ExternalVitessCluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1615,7 +1846,7 @@ func (m *ExternalClusters) Reset()         { *m = ExternalClusters{} }
 func (m *ExternalClusters) String() string { return proto.CompactTextString(m) }
 func (*ExternalClusters) ProtoMessage()    {}
 func (*ExternalClusters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_52c350cb619f972e, []int{13}
+	return fileDescriptor_52c350cb619f972e, []int{15}
 }
 func (m *ExternalClusters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1651,10 +1882,96 @@ func (m *ExternalClusters) GetVitessCluster() []*ExternalVitessCluster {
 	return nil
 }
 
+// VReplicationWorkflow is the persisted lifecycle record of a vreplication
+// workflow (MoveTables, Reshard, Migrate). It is stored under the target
+// keyspace, and its name is the name of the workflow.
+type VReplicationWorkflow struct {
+	// workflow_type is the type of the workflow, e.g. MoveTables.
+	WorkflowType   string                     `protobuf:"bytes,1,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	SourceKeyspace string                     `protobuf:"bytes,2,opt,name=source_keyspace,json=sourceKeyspace,proto3" json:"source_keyspace,omitempty"`
+	State          VReplicationWorkflow_State `protobuf:"varint,3,opt,name=state,proto3,enum=topodata.VReplicationWorkflow_State" json:"state,omitempty"`
+	// created is the time the workflow was created.
+	Created *vttime.Time `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	// updated is the time of the last state change.
+	Updated              *vttime.Time `protobuf:"bytes,5,opt,name=updated,proto3" json:"updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *VReplicationWorkflow) Reset()         { *m = VReplicationWorkflow{} }
+func (m *VReplicationWorkflow) String() string { return proto.CompactTextString(m) }
+func (*VReplicationWorkflow) ProtoMessage()    {}
+func (*VReplicationWorkflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52c350cb619f972e, []int{16}
+}
+func (m *VReplicationWorkflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VReplicationWorkflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VReplicationWorkflow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VReplicationWorkflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VReplicationWorkflow.Merge(m, src)
+}
+func (m *VReplicationWorkflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *VReplicationWorkflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_VReplicationWorkflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VReplicationWorkflow proto.InternalMessageInfo
+
+func (m *VReplicationWorkflow) GetWorkflowType() string {
+	if m != nil {
+		return m.WorkflowType
+	}
+	return ""
+}
+
+func (m *VReplicationWorkflow) GetSourceKeyspace() string {
+	if m != nil {
+		return m.SourceKeyspace
+	}
+	return ""
+}
+
+func (m *VReplicationWorkflow) GetState() VReplicationWorkflow_State {
+	if m != nil {
+		return m.State
+	}
+	return VReplicationWorkflow_UNKNOWN
+}
+
+func (m *VReplicationWorkflow) GetCreated() *vttime.Time {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *VReplicationWorkflow) GetUpdated() *vttime.Time {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("topodata.KeyspaceType", KeyspaceType_name, KeyspaceType_value)
 	proto.RegisterEnum("topodata.KeyspaceIdType", KeyspaceIdType_name, KeyspaceIdType_value)
 	proto.RegisterEnum("topodata.TabletType", TabletType_name, TabletType_value)
+	proto.RegisterEnum("topodata.VReplicationWorkflow_State", VReplicationWorkflow_State_name, VReplicationWorkflow_State_value)
 	proto.RegisterType((*KeyRange)(nil), "topodata.KeyRange")
 	proto.RegisterType((*TabletAlias)(nil), "topodata.TabletAlias")
 	proto.RegisterType((*Tablet)(nil), "topodata.Tablet")
@@ -1664,8 +1981,10 @@ func init() {
 	proto.RegisterType((*Shard_ServedType)(nil), "topodata.Shard.ServedType")
 	proto.RegisterType((*Shard_SourceShard)(nil), "topodata.Shard.SourceShard")
 	proto.RegisterType((*Shard_TabletControl)(nil), "topodata.Shard.TabletControl")
+	proto.RegisterType((*BackupRetentionPolicy)(nil), "topodata.BackupRetentionPolicy")
 	proto.RegisterType((*Keyspace)(nil), "topodata.Keyspace")
 	proto.RegisterType((*Keyspace_ServedFrom)(nil), "topodata.Keyspace.ServedFrom")
+	proto.RegisterType((*ThrottledAppRule)(nil), "topodata.ThrottledAppRule")
 	proto.RegisterType((*ShardReplication)(nil), "topodata.ShardReplication")
 	proto.RegisterType((*ShardReplication_Node)(nil), "topodata.ShardReplication.Node")
 	proto.RegisterType((*ShardReference)(nil), "topodata.ShardReference")
@@ -1678,104 +1997,128 @@ func init() {
 	proto.RegisterType((*TopoConfig)(nil), "topodata.TopoConfig")
 	proto.RegisterType((*ExternalVitessCluster)(nil), "topodata.ExternalVitessCluster")
 	proto.RegisterType((*ExternalClusters)(nil), "topodata.ExternalClusters")
+	proto.RegisterType((*VReplicationWorkflow)(nil), "topodata.VReplicationWorkflow")
 }
 
 func init() { proto.RegisterFile("topodata.proto", fileDescriptor_52c350cb619f972e) }
 
 var fileDescriptor_52c350cb619f972e = []byte{
-	// 1470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0xdb, 0xc6,
-	0x12, 0x0f, 0xf5, 0xcf, 0xd2, 0x88, 0x92, 0x99, 0x8d, 0x63, 0x10, 0xca, 0x8b, 0x9f, 0xa1, 0x87,
-	0xe0, 0x19, 0x7e, 0x78, 0x72, 0xeb, 0x24, 0x6d, 0x90, 0xa2, 0x40, 0x14, 0x59, 0xa9, 0x1d, 0xdb,
-	0xb2, 0xb0, 0x92, 0xdb, 0x26, 0x17, 0x82, 0x96, 0xd6, 0x0e, 0x61, 0x8a, 0x54, 0xb8, 0x2b, 0xa1,
-	0xea, 0x57, 0xe8, 0xa1, 0x3d, 0x16, 0xfd, 0x06, 0xfd, 0x26, 0x3d, 0xf6, 0xd0, 0x63, 0x0f, 0xad,
-	0xfb, 0x35, 0x7a, 0x28, 0x76, 0x96, 0xa4, 0x28, 0xc9, 0x4e, 0x9d, 0xc2, 0xb7, 0x9d, 0xd9, 0x99,
-	0xd9, 0x99, 0xdf, 0xfe, 0x66, 0x96, 0x84, 0xb2, 0xf0, 0x87, 0x7e, 0xdf, 0x16, 0x76, 0x6d, 0x18,
-	0xf8, 0xc2, 0x27, 0xf9, 0x48, 0xae, 0xe8, 0x63, 0x21, 0x9c, 0x01, 0x53, 0xfa, 0xea, 0x36, 0xe4,
-	0xf7, 0xd9, 0x84, 0xda, 0xde, 0x19, 0x23, 0x2b, 0x90, 0xe5, 0xc2, 0x0e, 0x84, 0xa9, 0xad, 0x6b,
-	0x1b, 0x3a, 0x55, 0x02, 0x31, 0x20, 0xcd, 0xbc, 0xbe, 0x99, 0x42, 0x9d, 0x5c, 0x56, 0x1f, 0x42,
-	0xb1, 0x6b, 0x9f, 0xb8, 0x4c, 0xd4, 0x5d, 0xc7, 0xe6, 0x84, 0x40, 0xa6, 0xc7, 0x5c, 0x17, 0xbd,
-	0x0a, 0x14, 0xd7, 0xd2, 0x69, 0xe4, 0x28, 0xa7, 0x12, 0x95, 0xcb, 0xea, 0x9f, 0x19, 0xc8, 0x29,
-	0x2f, 0xf2, 0x3f, 0xc8, 0xda, 0xd2, 0x13, 0x3d, 0x8a, 0xdb, 0x77, 0x6b, 0x71, 0xae, 0x89, 0xb0,
-	0x54, 0xd9, 0x90, 0x0a, 0xe4, 0xdf, 0xf8, 0x5c, 0x78, 0xf6, 0x80, 0x61, 0xb8, 0x02, 0x8d, 0x65,
-	0xf2, 0x04, 0xf2, 0x43, 0x3f, 0x10, 0xd6, 0xc0, 0x1e, 0x9a, 0x99, 0xf5, 0xf4, 0x46, 0x71, 0xfb,
-	0xfe, 0x7c, 0xac, 0x5a, 0xdb, 0x0f, 0xc4, 0xa1, 0x3d, 0x6c, 0x7a, 0x22, 0x98, 0xd0, 0xa5, 0xa1,
-	0x92, 0x64, 0xd4, 0x73, 0x36, 0xe1, 0x43, 0xbb, 0xc7, 0xcc, 0xac, 0x8a, 0x1a, 0xc9, 0x08, 0xc3,
-	0x1b, 0x3b, 0xe8, 0x9b, 0x39, 0xdc, 0x50, 0x02, 0xd9, 0x82, 0xc2, 0x39, 0x9b, 0x58, 0x81, 0x44,
-	0xca, 0x5c, 0xc2, 0xc4, 0xc9, 0xf4, 0xb0, 0x08, 0x43, 0x0c, 0xa3, 0xd0, 0xdc, 0x80, 0x8c, 0x98,
-	0x0c, 0x99, 0x99, 0x5f, 0xd7, 0x36, 0xca, 0xdb, 0x2b, 0xf3, 0x89, 0x75, 0x27, 0x43, 0x46, 0xd1,
-	0x82, 0x6c, 0x80, 0xd1, 0x3f, 0xb1, 0x64, 0x45, 0x96, 0x3f, 0x66, 0x41, 0xe0, 0xf4, 0x99, 0x59,
-	0xc0, 0xb3, 0xcb, 0xfd, 0x93, 0x96, 0x3d, 0x60, 0x47, 0xa1, 0x96, 0xd4, 0x20, 0x23, 0xec, 0x33,
-	0x6e, 0x02, 0x16, 0x5b, 0x59, 0x28, 0xb6, 0x6b, 0x9f, 0x71, 0x55, 0x29, 0xda, 0x91, 0x07, 0x50,
-	0x1e, 0x4c, 0xf8, 0x5b, 0xd7, 0x8a, 0x21, 0xd4, 0x31, 0x6e, 0x09, 0xb5, 0xbb, 0x11, 0x8e, 0xf7,
-	0x01, 0x94, 0x99, 0x84, 0xc7, 0x2c, 0xad, 0x6b, 0x1b, 0x59, 0x5a, 0x40, 0x8d, 0x44, 0x8f, 0xd4,
-	0x61, 0x75, 0x60, 0x73, 0xc1, 0x02, 0x4b, 0xb0, 0x60, 0x60, 0x21, 0x2d, 0x2c, 0xc9, 0x21, 0xb3,
-	0x8c, 0x38, 0xe8, 0xb5, 0x90, 0x52, 0x5d, 0x67, 0xc0, 0xe8, 0x1d, 0x65, 0xdb, 0x65, 0xc1, 0xa0,
-	0x23, 0x2d, 0xa5, 0xb2, 0xf2, 0x14, 0xf4, 0xe4, 0x45, 0x48, 0x7e, 0x9c, 0xb3, 0x49, 0x48, 0x19,
-	0xb9, 0x94, 0xa8, 0x8f, 0x6d, 0x77, 0xa4, 0x2e, 0x39, 0x4b, 0x95, 0xf0, 0x34, 0xf5, 0x44, 0xab,
-	0x7c, 0x0c, 0x85, 0xb8, 0xae, 0xbf, 0x73, 0x2c, 0x24, 0x1c, 0x5f, 0x66, 0xf2, 0x69, 0x23, 0xf3,
-	0x32, 0x93, 0x2f, 0x1a, 0x7a, 0xf5, 0x97, 0x1c, 0x64, 0x3b, 0x78, 0x91, 0x4f, 0x40, 0x0f, 0xab,
-	0xb9, 0x06, 0x09, 0x8b, 0xca, 0x54, 0x11, 0xfd, 0x6a, 0x1c, 0xf2, 0xd7, 0xc4, 0x61, 0x96, 0x45,
-	0xa9, 0x6b, 0xb0, 0xe8, 0x53, 0xd0, 0x39, 0x0b, 0xc6, 0xac, 0x6f, 0x49, 0xaa, 0x70, 0x33, 0x3d,
-	0x7f, 0xf3, 0x58, 0x54, 0xad, 0x83, 0x36, 0xc8, 0xa9, 0x22, 0x8f, 0xd7, 0x9c, 0x3c, 0x83, 0x12,
-	0xf7, 0x47, 0x41, 0x8f, 0x59, 0xc8, 0x62, 0x1e, 0xb6, 0xc9, 0xbd, 0x05, 0x7f, 0x34, 0xc2, 0x35,
-	0xd5, 0xf9, 0x54, 0xe0, 0xe4, 0x05, 0x2c, 0x0b, 0x04, 0xc4, 0xea, 0xf9, 0x9e, 0x08, 0x7c, 0x97,
-	0x9b, 0xb9, 0xf9, 0x56, 0x53, 0x31, 0x14, 0x6e, 0x0d, 0x65, 0x45, 0xcb, 0x22, 0x29, 0x72, 0xb2,
-	0x09, 0xb7, 0x1d, 0x6e, 0x85, 0xf8, 0xc9, 0x14, 0x1d, 0xef, 0x0c, 0xfb, 0x28, 0x4f, 0x97, 0x1d,
-	0x7e, 0x88, 0xfa, 0x8e, 0x52, 0x57, 0x5e, 0x01, 0x4c, 0x0b, 0x22, 0x8f, 0xa1, 0x18, 0x66, 0x80,
-	0xfd, 0xa4, 0xbd, 0xa3, 0x9f, 0x40, 0xc4, 0x6b, 0xc9, 0x0b, 0x39, 0x8a, 0xb8, 0x99, 0x5a, 0x4f,
-	0x4b, 0x5e, 0xa0, 0x50, 0xf9, 0x41, 0x83, 0x62, 0xa2, 0xd8, 0x68, 0x50, 0x69, 0xf1, 0xa0, 0x9a,
-	0x19, 0x0d, 0xa9, 0xab, 0x46, 0x43, 0xfa, 0xca, 0xd1, 0x90, 0xb9, 0xc6, 0xa5, 0xae, 0x42, 0x0e,
-	0x13, 0xe5, 0x66, 0x16, 0x73, 0x0b, 0xa5, 0xca, 0x8f, 0x1a, 0x94, 0x66, 0x50, 0xbc, 0xd1, 0xda,
-	0xc9, 0xff, 0x81, 0x9c, 0xb8, 0x76, 0xef, 0xdc, 0x75, 0xb8, 0x90, 0x84, 0x52, 0x29, 0x64, 0xd0,
-	0xe4, 0x76, 0x62, 0x07, 0x83, 0x72, 0x99, 0xe5, 0x69, 0xe0, 0x7f, 0xcd, 0x3c, 0x9c, 0x90, 0x79,
-	0x1a, 0x4a, 0x71, 0x5b, 0x65, 0x8d, 0x5c, 0xf5, 0xd7, 0x34, 0xbe, 0x1f, 0x0a, 0x9d, 0x0f, 0x60,
-	0x05, 0x01, 0x71, 0xbc, 0x33, 0xab, 0xe7, 0xbb, 0xa3, 0x81, 0x87, 0x43, 0x2d, 0x6c, 0x56, 0x12,
-	0xed, 0x35, 0x70, 0x4b, 0xce, 0x35, 0xf2, 0x72, 0xd1, 0x03, 0xeb, 0x4c, 0x61, 0x9d, 0xe6, 0x0c,
-	0x88, 0x78, 0xc6, 0x9e, 0xe2, 0xf8, 0x5c, 0x2c, 0xac, 0xf9, 0x59, 0xdc, 0x29, 0xa7, 0x81, 0x3f,
-	0xe0, 0x8b, 0x0f, 0x42, 0x14, 0x23, 0x6c, 0x96, 0x17, 0x81, 0x3f, 0x88, 0x9a, 0x45, 0xae, 0x39,
-	0xf9, 0x04, 0x4a, 0xd1, 0x4d, 0xab, 0x34, 0xb2, 0x98, 0xc6, 0xea, 0x62, 0x08, 0x4c, 0x42, 0x3f,
-	0x4f, 0x48, 0xe4, 0x3f, 0x50, 0x3a, 0xb1, 0x39, 0xb3, 0x62, 0xee, 0xa8, 0xd7, 0x43, 0x97, 0xca,
-	0x18, 0xa1, 0x0f, 0xa1, 0xc4, 0x3d, 0x7b, 0xc8, 0xdf, 0xf8, 0xe1, 0xe0, 0x58, 0xba, 0x64, 0x70,
-	0xe8, 0x91, 0x09, 0x4e, 0xce, 0x51, 0xd4, 0x0b, 0x32, 0xc7, 0x9b, 0xe5, 0x43, 0x92, 0xe9, 0xe9,
-	0x59, 0xa6, 0xab, 0x4b, 0xae, 0x7e, 0xa3, 0x81, 0xa1, 0x86, 0x02, 0x1b, 0xba, 0x4e, 0xcf, 0x16,
-	0x8e, 0xef, 0x91, 0xc7, 0x90, 0xf5, 0xfc, 0x3e, 0x93, 0x93, 0x53, 0x22, 0xfc, 0xef, 0xb9, 0x39,
-	0x90, 0x30, 0xad, 0xb5, 0xfc, 0x3e, 0xa3, 0xca, 0xba, 0xf2, 0x0c, 0x32, 0x52, 0x94, 0xf3, 0x37,
-	0x2c, 0xe1, 0x3a, 0xf3, 0x57, 0x4c, 0x85, 0xea, 0x31, 0x94, 0xc3, 0x13, 0x4e, 0x59, 0xc0, 0xbc,
-	0x1e, 0x93, 0x9f, 0x1e, 0x09, 0x86, 0xe1, 0xfa, 0xbd, 0x47, 0x6c, 0xf5, 0x5b, 0x0d, 0x08, 0xc6,
-	0x9d, 0x6d, 0xbd, 0x9b, 0x88, 0x4d, 0x1e, 0xc1, 0xea, 0xdb, 0x11, 0x0b, 0x26, 0x6a, 0xe2, 0xf5,
-	0x98, 0xd5, 0x77, 0xb8, 0x3c, 0x45, 0x4d, 0x90, 0x3c, 0x5d, 0xc1, 0xdd, 0x8e, 0xda, 0xdc, 0x09,
-	0xf7, 0xaa, 0x17, 0x19, 0x28, 0x76, 0x82, 0x71, 0x4c, 0x9b, 0xcf, 0x00, 0x86, 0x76, 0x20, 0x1c,
-	0x89, 0x69, 0x04, 0xfb, 0x7f, 0x13, 0xb0, 0x4f, 0x4d, 0x63, 0x86, 0xb6, 0x23, 0x7b, 0x9a, 0x70,
-	0xbd, 0xb2, 0x43, 0x53, 0xef, 0xdd, 0xa1, 0xe9, 0x7f, 0xd0, 0xa1, 0x75, 0x28, 0x26, 0x3a, 0x34,
-	0x6c, 0xd0, 0xf5, 0xcb, 0xeb, 0x48, 0xf4, 0x28, 0x4c, 0x7b, 0xb4, 0xf2, 0xbb, 0x06, 0xb7, 0x17,
-	0x4a, 0x94, 0x5d, 0x91, 0x78, 0x24, 0xdf, 0xdd, 0x15, 0xd3, 0xd7, 0x91, 0x34, 0xc0, 0xc0, 0x2c,
-	0xad, 0x20, 0x22, 0x94, 0x6a, 0x90, 0x62, 0xb2, 0xae, 0x59, 0xc6, 0xd1, 0x65, 0x3e, 0x23, 0x73,
-	0xd2, 0x86, 0xbb, 0x2a, 0xc8, 0xfc, 0x2b, 0xa9, 0x5e, 0xea, 0x7f, 0xcd, 0x45, 0x9a, 0x7d, 0x24,
-	0xef, 0xf0, 0x05, 0x1d, 0xaf, 0x58, 0x37, 0xd1, 0xf1, 0xef, 0x78, 0xc5, 0xc2, 0xd1, 0xbd, 0x0f,
-	0xf9, 0x06, 0x73, 0xdd, 0x3d, 0xef, 0xd4, 0x97, 0xdf, 0x89, 0x88, 0x4b, 0x60, 0xd9, 0xfd, 0x7e,
-	0xc0, 0x38, 0x0f, 0x59, 0x5f, 0x52, 0xda, 0xba, 0x52, 0xca, 0x96, 0x08, 0x7c, 0x5f, 0x84, 0x01,
-	0x71, 0x1d, 0x0e, 0x8a, 0x2a, 0x80, 0x0c, 0xc6, 0xd5, 0x87, 0xd2, 0xa5, 0xe3, 0xa6, 0x7a, 0x0c,
-	0xd0, 0xf5, 0x87, 0x7e, 0xc3, 0xf7, 0x4e, 0x9d, 0x33, 0x72, 0x0f, 0x0a, 0xb2, 0x86, 0x69, 0x55,
-	0x05, 0x8a, 0xff, 0x28, 0x98, 0xfd, 0x2a, 0xe4, 0xd4, 0xc9, 0xe1, 0x51, 0xa1, 0x14, 0x27, 0x90,
-	0x9e, 0x26, 0x50, 0x6d, 0xc1, 0xdd, 0xe6, 0x57, 0x82, 0x05, 0x9e, 0xed, 0x7e, 0xee, 0x08, 0xc6,
-	0x79, 0xc3, 0x1d, 0xc9, 0x8f, 0x09, 0x44, 0x4e, 0x9e, 0xd0, 0xc3, 0x03, 0xc3, 0x39, 0x93, 0x44,
-	0x2e, 0x4e, 0x86, 0x82, 0x88, 0xd7, 0xd5, 0xd7, 0x60, 0x44, 0xf1, 0xc2, 0x48, 0xf2, 0x23, 0xa8,
-	0x3c, 0xc6, 0xd8, 0x56, 0x4f, 0xa9, 0x16, 0x67, 0xdf, 0xa5, 0x39, 0xd0, 0xd2, 0x38, 0x29, 0x6e,
-	0x6e, 0x80, 0x9e, 0x7c, 0x42, 0x08, 0x40, 0xae, 0x75, 0x44, 0x0f, 0xeb, 0x07, 0xc6, 0x2d, 0xa2,
-	0x43, 0xbe, 0xd3, 0xaa, 0xb7, 0x3b, 0xbb, 0x47, 0x5d, 0x43, 0xdb, 0xdc, 0x86, 0xf2, 0x6c, 0x47,
-	0x91, 0x02, 0x64, 0x8f, 0x5b, 0x9d, 0x66, 0xd7, 0xb8, 0x25, 0xdd, 0x8e, 0xf7, 0x5a, 0xdd, 0x8f,
-	0x1e, 0x19, 0x9a, 0x54, 0x3f, 0x7f, 0xd5, 0x6d, 0x76, 0x8c, 0xd4, 0xe6, 0x77, 0x1a, 0xc0, 0x94,
-	0x0e, 0xa4, 0x08, 0x4b, 0xc7, 0xad, 0xfd, 0xd6, 0xd1, 0x17, 0x2d, 0xe5, 0x72, 0x58, 0xef, 0x74,
-	0x9b, 0xd4, 0xd0, 0xe4, 0x06, 0x6d, 0xb6, 0x0f, 0xf6, 0x1a, 0x75, 0x23, 0x25, 0x37, 0xe8, 0xce,
-	0x51, 0xeb, 0xe0, 0x95, 0x91, 0xc6, 0x58, 0xf5, 0x6e, 0x63, 0x57, 0x2d, 0x3b, 0xed, 0x3a, 0x6d,
-	0x1a, 0x19, 0x62, 0x80, 0xde, 0xfc, 0xb2, 0xdd, 0xa4, 0x7b, 0x87, 0xcd, 0x56, 0xb7, 0x7e, 0x60,
-	0x64, 0xa5, 0xcf, 0xf3, 0x7a, 0x63, 0xff, 0xb8, 0x6d, 0xe4, 0x54, 0xb0, 0x4e, 0xf7, 0x88, 0x36,
-	0x8d, 0x25, 0x29, 0xec, 0xd0, 0xfa, 0x5e, 0xab, 0xb9, 0x63, 0xe4, 0x2b, 0x29, 0x43, 0x7b, 0xbe,
-	0xfb, 0xd3, 0xc5, 0x9a, 0xf6, 0xf3, 0xc5, 0x9a, 0xf6, 0xdb, 0xc5, 0x9a, 0xf6, 0xfd, 0x1f, 0x6b,
-	0xb7, 0x60, 0xd9, 0xf1, 0x6b, 0x0a, 0x14, 0xf5, 0x07, 0xfa, 0xfa, 0x41, 0x28, 0x39, 0xfe, 0x96,
-	0x5a, 0x6d, 0x9d, 0xf9, 0x5b, 0x63, 0xb1, 0x85, 0xbb, 0x5b, 0x11, 0xbe, 0x27, 0x39, 0x94, 0x1f,
-	0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0x0c, 0xd6, 0x3a, 0x79, 0xd9, 0x0e, 0x00, 0x00,
+	// 1829 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x72, 0xdb, 0xc8,
+	0x11, 0x36, 0xf8, 0x27, 0xb2, 0xf9, 0x23, 0x78, 0x6c, 0x29, 0x2c, 0x6e, 0xd6, 0x51, 0x31, 0xd9,
+	0x5d, 0xc5, 0xae, 0x50, 0x89, 0x76, 0x37, 0x71, 0x39, 0x95, 0x2a, 0xc3, 0x24, 0x76, 0x45, 0x5b,
+	0xa2, 0x58, 0x43, 0xca, 0x8a, 0xf7, 0x82, 0x02, 0xc9, 0x91, 0x8c, 0x12, 0x08, 0x60, 0x31, 0x43,
+	0xae, 0x99, 0x37, 0x48, 0xe5, 0x90, 0x1c, 0x53, 0xb9, 0xe7, 0x90, 0x43, 0xde, 0x23, 0xc7, 0x3c,
+	0x42, 0xe2, 0x1c, 0xf2, 0x12, 0x39, 0xa4, 0xa6, 0x07, 0x00, 0x41, 0x8a, 0x56, 0xb4, 0x59, 0xdf,
+	0xba, 0x7b, 0x7a, 0x1a, 0xdd, 0xdf, 0x74, 0x7f, 0x33, 0x24, 0xd4, 0x84, 0x1f, 0xf8, 0x13, 0x5b,
+	0xd8, 0xad, 0x20, 0xf4, 0x85, 0x4f, 0x8a, 0xb1, 0xde, 0xa8, 0xcc, 0x85, 0x70, 0xa6, 0x4c, 0xd9,
+	0x9b, 0x87, 0x50, 0x7c, 0xc1, 0x16, 0xd4, 0xf6, 0x2e, 0x19, 0xb9, 0x0f, 0x79, 0x2e, 0xec, 0x50,
+	0xd4, 0xb5, 0x3d, 0x6d, 0xbf, 0x42, 0x95, 0x42, 0x74, 0xc8, 0x32, 0x6f, 0x52, 0xcf, 0xa0, 0x4d,
+	0x8a, 0xcd, 0x4f, 0xa1, 0x3c, 0xb4, 0x47, 0x2e, 0x13, 0x86, 0xeb, 0xd8, 0x9c, 0x10, 0xc8, 0x8d,
+	0x99, 0xeb, 0xe2, 0xae, 0x12, 0x45, 0x59, 0x6e, 0x9a, 0x39, 0x6a, 0x53, 0x95, 0x4a, 0xb1, 0xf9,
+	0x9f, 0x1c, 0x14, 0xd4, 0x2e, 0xf2, 0x08, 0xf2, 0xb6, 0xdc, 0x89, 0x3b, 0xca, 0x87, 0x3b, 0xad,
+	0x24, 0xd7, 0x54, 0x58, 0xaa, 0x7c, 0x48, 0x03, 0x8a, 0xaf, 0x7d, 0x2e, 0x3c, 0x7b, 0xca, 0x30,
+	0x5c, 0x89, 0x26, 0x3a, 0x79, 0x0c, 0xc5, 0xc0, 0x0f, 0x85, 0x35, 0xb5, 0x83, 0x7a, 0x6e, 0x2f,
+	0xbb, 0x5f, 0x3e, 0xfc, 0x70, 0x3d, 0x56, 0xab, 0xef, 0x87, 0xe2, 0xc4, 0x0e, 0x4c, 0x4f, 0x84,
+	0x0b, 0xba, 0x15, 0x28, 0x4d, 0x46, 0xbd, 0x62, 0x0b, 0x1e, 0xd8, 0x63, 0x56, 0xcf, 0xab, 0xa8,
+	0xb1, 0x8e, 0x30, 0xbc, 0xb6, 0xc3, 0x49, 0xbd, 0x80, 0x0b, 0x4a, 0x21, 0x07, 0x50, 0xba, 0x62,
+	0x0b, 0x2b, 0x94, 0x48, 0xd5, 0xb7, 0x30, 0x71, 0xb2, 0xfc, 0x58, 0x8c, 0x21, 0x86, 0x41, 0x89,
+	0xec, 0x43, 0x4e, 0x2c, 0x02, 0x56, 0x2f, 0xee, 0x69, 0xfb, 0xb5, 0xc3, 0xfb, 0xeb, 0x89, 0x0d,
+	0x17, 0x01, 0xa3, 0xe8, 0x41, 0xf6, 0x41, 0x9f, 0x8c, 0x2c, 0x59, 0x91, 0xe5, 0xcf, 0x59, 0x18,
+	0x3a, 0x13, 0x56, 0x2f, 0xe1, 0xb7, 0x6b, 0x93, 0x51, 0xcf, 0x9e, 0xb2, 0xd3, 0xc8, 0x4a, 0x5a,
+	0x90, 0x13, 0xf6, 0x25, 0xaf, 0x03, 0x16, 0xdb, 0xb8, 0x56, 0xec, 0xd0, 0xbe, 0xe4, 0xaa, 0x52,
+	0xf4, 0x23, 0x1f, 0x41, 0x6d, 0xba, 0xe0, 0x5f, 0xbb, 0x56, 0x02, 0x61, 0x05, 0xe3, 0x56, 0xd1,
+	0x7a, 0x14, 0xe3, 0xf8, 0x21, 0x80, 0x72, 0x93, 0xf0, 0xd4, 0xab, 0x7b, 0xda, 0x7e, 0x9e, 0x96,
+	0xd0, 0x22, 0xd1, 0x23, 0x06, 0xec, 0x4e, 0x6d, 0x2e, 0x58, 0x68, 0x09, 0x16, 0x4e, 0x2d, 0x6c,
+	0x0b, 0x4b, 0xf6, 0x50, 0xbd, 0x86, 0x38, 0x54, 0x5a, 0x51, 0x4b, 0x0d, 0x9d, 0x29, 0xa3, 0xf7,
+	0x94, 0xef, 0x90, 0x85, 0xd3, 0x81, 0xf4, 0x94, 0xc6, 0xc6, 0x13, 0xa8, 0xa4, 0x0f, 0x42, 0xf6,
+	0xc7, 0x15, 0x5b, 0x44, 0x2d, 0x23, 0x45, 0x89, 0xfa, 0xdc, 0x76, 0x67, 0xea, 0x90, 0xf3, 0x54,
+	0x29, 0x4f, 0x32, 0x8f, 0xb5, 0xc6, 0x2f, 0xa0, 0x94, 0xd4, 0xf5, 0xbf, 0x36, 0x96, 0x52, 0x1b,
+	0x9f, 0xe7, 0x8a, 0x59, 0x3d, 0xf7, 0x3c, 0x57, 0x2c, 0xeb, 0x95, 0xe6, 0x5f, 0xb7, 0x20, 0x3f,
+	0xc0, 0x83, 0x7c, 0x0c, 0x95, 0xa8, 0x9a, 0x5b, 0x34, 0x61, 0x59, 0xb9, 0xa2, 0x72, 0x03, 0x0e,
+	0xc5, 0x5b, 0xe2, 0xb0, 0xda, 0x45, 0x99, 0x5b, 0x74, 0xd1, 0xaf, 0xa0, 0xc2, 0x59, 0x38, 0x67,
+	0x13, 0x4b, 0xb6, 0x0a, 0xaf, 0x67, 0xd7, 0x4f, 0x1e, 0x8b, 0x6a, 0x0d, 0xd0, 0x07, 0x7b, 0xaa,
+	0xcc, 0x13, 0x99, 0x93, 0xa7, 0x50, 0xe5, 0xfe, 0x2c, 0x1c, 0x33, 0x0b, 0xbb, 0x98, 0x47, 0x63,
+	0xf2, 0xc1, 0xb5, 0xfd, 0xe8, 0x84, 0x32, 0xad, 0xf0, 0xa5, 0xc2, 0xc9, 0x17, 0xb0, 0x2d, 0x10,
+	0x10, 0x6b, 0xec, 0x7b, 0x22, 0xf4, 0x5d, 0x5e, 0x2f, 0xac, 0x8f, 0x9a, 0x8a, 0xa1, 0x70, 0x6b,
+	0x2b, 0x2f, 0x5a, 0x13, 0x69, 0x95, 0x93, 0x87, 0x70, 0xd7, 0xe1, 0x56, 0x84, 0x9f, 0x4c, 0xd1,
+	0xf1, 0x2e, 0x71, 0x8e, 0x8a, 0x74, 0xdb, 0xe1, 0x27, 0x68, 0x1f, 0x28, 0x33, 0x39, 0x87, 0xef,
+	0x8d, 0xec, 0xf1, 0xd5, 0x2c, 0xb0, 0x42, 0x26, 0x98, 0x27, 0x1c, 0xdf, 0xb3, 0x02, 0xdf, 0x75,
+	0xc6, 0x0b, 0x9c, 0x8b, 0xf2, 0xe1, 0x0f, 0x96, 0xdf, 0x7e, 0x86, 0x8e, 0x34, 0xf6, 0xeb, 0xa3,
+	0x1b, 0xdd, 0x19, 0x6d, 0x32, 0x37, 0x5e, 0x01, 0x2c, 0x91, 0x22, 0x9f, 0x43, 0x39, 0x2a, 0x0d,
+	0x07, 0x55, 0xbb, 0x61, 0x50, 0x41, 0x24, 0xb2, 0x6c, 0x38, 0xc9, 0x71, 0xbc, 0x9e, 0xd9, 0xcb,
+	0xca, 0x86, 0x43, 0xa5, 0xf1, 0x27, 0x0d, 0xca, 0x29, 0x14, 0x63, 0x06, 0xd4, 0x12, 0x06, 0x5c,
+	0xe1, 0x9c, 0xcc, 0xbb, 0x38, 0x27, 0xfb, 0x4e, 0xce, 0xc9, 0xdd, 0xa2, 0x5b, 0x76, 0xa1, 0x80,
+	0x89, 0xf2, 0x7a, 0x1e, 0x73, 0x8b, 0xb4, 0xc6, 0x5f, 0x34, 0xa8, 0xae, 0x1c, 0xcf, 0x7b, 0xad,
+	0x9d, 0xfc, 0x04, 0xc8, 0xc8, 0xb5, 0xc7, 0x57, 0xae, 0xc3, 0x85, 0xec, 0x54, 0x95, 0x42, 0x0e,
+	0x5d, 0xee, 0xa6, 0x56, 0x30, 0x28, 0x97, 0x59, 0x5e, 0x84, 0xfe, 0x6f, 0x98, 0x87, 0xd4, 0x5b,
+	0xa4, 0x91, 0x96, 0xcc, 0x6b, 0x5e, 0x2f, 0x34, 0x7f, 0xab, 0xc1, 0xce, 0xc6, 0xa3, 0x95, 0x64,
+	0x75, 0xc5, 0x58, 0x60, 0x8d, 0xfd, 0x99, 0x27, 0x22, 0x7c, 0x4b, 0xd2, 0xd2, 0x96, 0x06, 0xf2,
+	0x63, 0xd8, 0x9a, 0xda, 0x6f, 0x2c, 0x3b, 0x99, 0x2f, 0x3d, 0x9e, 0xca, 0xce, 0x2c, 0xb4, 0x65,
+	0x1c, 0x5a, 0x98, 0xda, 0x6f, 0x8c, 0x4b, 0x46, 0x3e, 0x86, 0xed, 0xa9, 0xe3, 0x59, 0x73, 0xdb,
+	0x75, 0x26, 0x51, 0xb8, 0x2c, 0x86, 0xab, 0x4e, 0x1d, 0xef, 0xa5, 0xb4, 0x62, 0xc8, 0xe6, 0x9f,
+	0xf3, 0x78, 0x49, 0xaa, 0x93, 0xfa, 0x29, 0xdc, 0xc7, 0xc3, 0x71, 0xbc, 0x4b, 0x6b, 0xec, 0xbb,
+	0xb3, 0xa9, 0x87, 0xcc, 0x1d, 0x31, 0x12, 0x89, 0xd7, 0xda, 0xb8, 0x24, 0xc9, 0x9b, 0x3c, 0xbf,
+	0xbe, 0x03, 0x31, 0xcf, 0x20, 0xe6, 0xf5, 0x95, 0x03, 0xc5, 0x6f, 0x74, 0xd5, 0x20, 0xaf, 0xc5,
+	0x42, 0xfc, 0x9f, 0x26, 0x74, 0x70, 0x11, 0xfa, 0x53, 0x7e, 0xfd, 0xd6, 0x8b, 0x63, 0x44, 0x8c,
+	0xf0, 0x45, 0xe8, 0x4f, 0x63, 0x46, 0x90, 0x32, 0x27, 0xbf, 0x84, 0x6a, 0xdc, 0x75, 0x2a, 0x8d,
+	0x3c, 0xa6, 0xb1, 0x7b, 0x3d, 0x04, 0x26, 0x51, 0xb9, 0x4a, 0x69, 0xe4, 0x87, 0x50, 0x1d, 0xd9,
+	0x9c, 0x59, 0x49, 0x1f, 0xab, 0x2b, 0xb2, 0x22, 0x8d, 0x09, 0x42, 0x3f, 0x83, 0x2a, 0xf7, 0xec,
+	0x80, 0xbf, 0xf6, 0x23, 0x76, 0xdc, 0xda, 0xc0, 0x8e, 0x95, 0xd8, 0x45, 0x6a, 0x37, 0x0d, 0x7c,
+	0xf1, 0xbb, 0x0c, 0x3c, 0x79, 0x04, 0x77, 0x27, 0xb3, 0xd0, 0x1e, 0x39, 0xae, 0x23, 0x16, 0x69,
+	0x0e, 0x29, 0x51, 0x7d, 0xb9, 0x10, 0x39, 0x1b, 0x50, 0x13, 0xaf, 0x43, 0x5f, 0x08, 0x97, 0x4d,
+	0x2c, 0x3b, 0x08, 0x36, 0xdd, 0xb3, 0xf1, 0xba, 0x11, 0x04, 0x74, 0xe6, 0x32, 0x5a, 0x15, 0x29,
+	0x0b, 0x6f, 0xcc, 0x62, 0x82, 0x91, 0x60, 0xbf, 0xdf, 0x21, 0x4b, 0xd3, 0x47, 0x76, 0x95, 0x3e,
+	0xd4, 0xe4, 0x34, 0x1d, 0xd0, 0xd7, 0xf3, 0x93, 0x8f, 0xb3, 0x54, 0x7b, 0xa2, 0x2c, 0xe3, 0xe3,
+	0x24, 0x60, 0x07, 0x6a, 0x54, 0x29, 0xe4, 0x11, 0x00, 0x7b, 0x13, 0x38, 0x21, 0xe3, 0x96, 0xad,
+	0x06, 0x61, 0xfd, 0xcc, 0x4a, 0xd1, 0xba, 0x21, 0x9a, 0xbf, 0xd3, 0x40, 0x57, 0xb7, 0x05, 0x0b,
+	0x5c, 0x67, 0x8c, 0x73, 0x45, 0x3e, 0x87, 0xbc, 0xe7, 0x4f, 0x98, 0xbc, 0x52, 0xb3, 0xab, 0x67,
+	0xb6, 0xee, 0xda, 0xea, 0xf9, 0x13, 0x46, 0x95, 0x77, 0xe3, 0x29, 0xe4, 0xa4, 0x2a, 0x2f, 0xe6,
+	0x08, 0xad, 0xdb, 0x5c, 0xcc, 0x62, 0xa9, 0x34, 0xcf, 0xa0, 0x16, 0x7d, 0xe1, 0x82, 0x85, 0xcc,
+	0x1b, 0x6f, 0x2e, 0xfb, 0xdb, 0xde, 0xbd, 0xcd, 0xdf, 0x6b, 0x40, 0x30, 0xee, 0x2a, 0x75, 0xbe,
+	0x8f, 0xd8, 0xe4, 0x33, 0xd8, 0xfd, 0x7a, 0xc6, 0xc2, 0x85, 0xba, 0x0a, 0xc7, 0xcc, 0x9a, 0x38,
+	0x5c, 0x7e, 0x45, 0xdd, 0x00, 0x45, 0x7a, 0x1f, 0x57, 0x07, 0x6a, 0xb1, 0x13, 0xad, 0x35, 0xdf,
+	0xe6, 0xa0, 0x3c, 0x08, 0xe7, 0xc9, 0xa8, 0x7d, 0x09, 0x10, 0xd8, 0xa1, 0x70, 0x24, 0xa6, 0x31,
+	0xec, 0x9f, 0xa4, 0x60, 0x5f, 0xba, 0x26, 0x53, 0xdd, 0x8f, 0xfd, 0x69, 0x6a, 0xeb, 0x3b, 0x59,
+	0x2d, 0xf3, 0xad, 0x59, 0x2d, 0xfb, 0x7f, 0xb0, 0x9a, 0x01, 0xe5, 0x14, 0xab, 0x45, 0xa4, 0xb6,
+	0xb7, 0xb9, 0x8e, 0x14, 0xaf, 0xc1, 0x92, 0xd7, 0x1a, 0xff, 0xd4, 0xe0, 0xee, 0xb5, 0x12, 0xe5,
+	0x00, 0xa6, 0x5e, 0x4f, 0x37, 0x0f, 0xe0, 0xf2, 0xd9, 0x44, 0xda, 0xa0, 0x63, 0x96, 0x56, 0x18,
+	0x37, 0x94, 0x9a, 0xc5, 0x72, 0xba, 0xae, 0xd5, 0x8e, 0xa3, 0xdb, 0x7c, 0x45, 0xe7, 0xa4, 0x0f,
+	0x3b, 0x2a, 0xc8, 0xfa, 0xf3, 0x49, 0x3d, 0xe1, 0xbe, 0xbf, 0x16, 0x69, 0xf5, 0xf5, 0x74, 0x8f,
+	0x5f, 0xb3, 0xf1, 0x86, 0xf5, 0x3e, 0xc8, 0xe5, 0x86, 0x57, 0x48, 0x74, 0xf5, 0xbe, 0x80, 0x62,
+	0x9b, 0xb9, 0x6e, 0xd7, 0xbb, 0xf0, 0xe5, 0x0f, 0x08, 0xc4, 0x25, 0xb4, 0xec, 0xc9, 0x24, 0x64,
+	0x9c, 0x47, 0x5d, 0x5f, 0x55, 0x56, 0x43, 0x19, 0xe5, 0x48, 0x84, 0xbe, 0x2f, 0xa2, 0x80, 0x28,
+	0x47, 0x9c, 0xd4, 0x04, 0x90, 0xc1, 0xb8, 0x7a, 0x41, 0x6f, 0x64, 0xb6, 0xe6, 0x19, 0xc0, 0xd0,
+	0x0f, 0xfc, 0xb6, 0xef, 0x5d, 0x38, 0x97, 0xe4, 0x03, 0x28, 0xc9, 0x1a, 0x96, 0x55, 0x95, 0x28,
+	0xfe, 0x78, 0xc5, 0xec, 0x77, 0xa1, 0xa0, 0xbe, 0x1c, 0x7d, 0x2a, 0xd2, 0x92, 0x04, 0xb2, 0xcb,
+	0x04, 0x9a, 0x3d, 0xd8, 0x31, 0xdf, 0x08, 0x16, 0x7a, 0xb6, 0xfb, 0xd2, 0x11, 0x8c, 0xf3, 0xb6,
+	0x3b, 0x93, 0xaf, 0x4c, 0x44, 0x4e, 0x7e, 0x61, 0x8c, 0x1f, 0x8c, 0x78, 0x26, 0x8d, 0x5c, 0x92,
+	0x0c, 0x05, 0x91, 0xc8, 0xcd, 0xaf, 0x40, 0x8f, 0xe3, 0x45, 0x91, 0xe4, 0xeb, 0xb8, 0x36, 0xc7,
+	0xd8, 0xd6, 0x58, 0x99, 0xae, 0x73, 0xdf, 0xc6, 0x1c, 0x68, 0x75, 0x9e, 0x56, 0x9b, 0xff, 0xce,
+	0xc0, 0xfd, 0x97, 0x29, 0x82, 0x3c, 0xf7, 0xc3, 0xab, 0x0b, 0xd7, 0xff, 0x46, 0xde, 0xb8, 0xdf,
+	0x44, 0x72, 0x1a, 0x91, 0x4a, 0x6c, 0x44, 0x54, 0x3e, 0x81, 0xed, 0xe8, 0x95, 0xbf, 0x76, 0xb4,
+	0x35, 0x65, 0x4e, 0xf8, 0xe2, 0x09, 0xfe, 0xc2, 0x17, 0xf1, 0x94, 0xfe, 0x68, 0x99, 0xe5, 0xa6,
+	0x8f, 0xb7, 0x06, 0xd2, 0x97, 0xaa, 0x2d, 0xe4, 0x63, 0xd8, 0x1a, 0x87, 0xcc, 0x16, 0x6c, 0x52,
+	0xcf, 0x6d, 0xb8, 0x1c, 0xe2, 0x45, 0xe9, 0x37, 0x0b, 0x26, 0xe8, 0x97, 0xdf, 0xe4, 0x17, 0x2d,
+	0x36, 0x67, 0x90, 0xc7, 0xf8, 0xa4, 0x0c, 0x5b, 0x67, 0xbd, 0x17, 0xbd, 0xd3, 0xf3, 0x9e, 0x7e,
+	0x47, 0x2a, 0xed, 0xd3, 0xfe, 0xab, 0x6e, 0xef, 0x4b, 0x5d, 0x93, 0x0a, 0x3d, 0xeb, 0xf5, 0xa4,
+	0x92, 0x21, 0x04, 0x6a, 0xd4, 0x34, 0x3a, 0x03, 0x6b, 0x70, 0xde, 0x1d, 0xb6, 0x8f, 0xcc, 0x8e,
+	0x9e, 0x25, 0xf7, 0x60, 0xfb, 0x9c, 0x76, 0x87, 0x66, 0xca, 0x98, 0x23, 0x55, 0x28, 0xb5, 0x4f,
+	0x4f, 0xfa, 0xc7, 0xe6, 0xd0, 0xec, 0xe8, 0x79, 0x54, 0x8d, 0x5e, 0xdb, 0x3c, 0x3e, 0x36, 0x3b,
+	0x7a, 0xe1, 0xe1, 0x3e, 0x54, 0xd2, 0x0f, 0x1c, 0x02, 0x50, 0xe8, 0x9d, 0xd2, 0x13, 0xe3, 0x58,
+	0xbf, 0x43, 0x2a, 0x50, 0x1c, 0xf4, 0x8c, 0xfe, 0xe0, 0xe8, 0x74, 0xa8, 0x6b, 0x0f, 0x0f, 0xa1,
+	0xb6, 0xca, 0x5d, 0xa4, 0x04, 0xf9, 0xb3, 0xde, 0xc0, 0x1c, 0xea, 0x77, 0xe4, 0xb6, 0xb3, 0x6e,
+	0x6f, 0xf8, 0xf3, 0xcf, 0x74, 0x4d, 0x9a, 0x9f, 0xbd, 0x1a, 0x9a, 0x03, 0x3d, 0xf3, 0xf0, 0x0f,
+	0x1a, 0xc0, 0x72, 0xf0, 0x56, 0x4b, 0x03, 0x28, 0x9c, 0x18, 0x83, 0xa1, 0x49, 0xa3, 0xca, 0xcc,
+	0xfe, 0x71, 0xb7, 0x6d, 0xe8, 0x19, 0xb9, 0x40, 0x3b, 0xa7, 0xbd, 0xe3, 0x57, 0x7a, 0x16, 0x63,
+	0x19, 0xc3, 0xf6, 0x91, 0x12, 0x07, 0x7d, 0x83, 0x9a, 0x7a, 0x8e, 0xe8, 0x50, 0x31, 0x7f, 0xdd,
+	0x37, 0x69, 0xf7, 0xc4, 0xec, 0x0d, 0x8d, 0x63, 0x3d, 0x2f, 0xf7, 0x3c, 0x33, 0xda, 0x2f, 0xce,
+	0xfa, 0x7a, 0x41, 0x05, 0x1b, 0x0c, 0x4f, 0xa9, 0xa9, 0x6f, 0x49, 0xa5, 0x43, 0x8d, 0x6e, 0xcf,
+	0xec, 0xe8, 0xc5, 0x46, 0x46, 0xd7, 0x9e, 0x1d, 0xfd, 0xed, 0xed, 0x03, 0xed, 0xef, 0x6f, 0x1f,
+	0x68, 0xff, 0x78, 0xfb, 0x40, 0xfb, 0xe3, 0xbf, 0x1e, 0xdc, 0x81, 0x6d, 0xc7, 0x6f, 0xa9, 0xf6,
+	0x53, 0x7f, 0x02, 0x7d, 0xf5, 0x51, 0xa4, 0x39, 0xfe, 0x81, 0x92, 0x0e, 0x2e, 0xfd, 0x83, 0xb9,
+	0x38, 0xc0, 0xd5, 0x83, 0xb8, 0x47, 0x46, 0x05, 0xd4, 0x3f, 0xfd, 0xef, 0x00, 0x43, 0x13, 0xcb,
+	0x0e, 0x5c, 0x12, 0x00, 0x00,
 }
 
 func (m *KeyRange) Marshal() (dAtA []byte, err error) {
@@ -2026,6 +2369,18 @@ func (m *Shard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.BackupRetentionPolicy != nil {
+		{
+			size, err := m.BackupRetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopodata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.MasterTermStartTime != nil {
 		{
 			size, err := m.MasterTermStartTime.MarshalToSizedBuffer(dAtA[:i])
@@ -2285,7 +2640,7 @@ func (m *Shard_TabletControl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Keyspace) Marshal() (dAtA []byte, err error) {
+func (m *BackupRetentionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2295,12 +2650,12 @@ func (m *Keyspace) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Keyspace) MarshalTo(dAtA []byte) (int, error) {
+func (m *BackupRetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Keyspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BackupRetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2309,9 +2664,14 @@ func (m *Keyspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SnapshotTime != nil {
+	if m.MinValidCount != 0 {
+		i = encodeVarintTopodata(dAtA, i, uint64(m.MinValidCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxAge != nil {
 		{
-			size, err := m.SnapshotTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MaxAge.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2319,50 +2679,17 @@ func (m *Keyspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTopodata(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.BaseKeyspace) > 0 {
-		i -= len(m.BaseKeyspace)
-		copy(dAtA[i:], m.BaseKeyspace)
-		i = encodeVarintTopodata(dAtA, i, uint64(len(m.BaseKeyspace)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.KeyspaceType != 0 {
-		i = encodeVarintTopodata(dAtA, i, uint64(m.KeyspaceType))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.ServedFroms) > 0 {
-		for iNdEx := len(m.ServedFroms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ServedFroms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTopodata(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.ShardingColumnType != 0 {
-		i = encodeVarintTopodata(dAtA, i, uint64(m.ShardingColumnType))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.ShardingColumnName) > 0 {
-		i -= len(m.ShardingColumnName)
-		copy(dAtA[i:], m.ShardingColumnName)
-		i = encodeVarintTopodata(dAtA, i, uint64(len(m.ShardingColumnName)))
+	if m.KeepCount != 0 {
+		i = encodeVarintTopodata(dAtA, i, uint64(m.KeepCount))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Keyspace_ServedFrom) Marshal() (dAtA []byte, err error) {
+func (m *Keyspace) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2372,12 +2699,12 @@ func (m *Keyspace_ServedFrom) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Keyspace_ServedFrom) MarshalTo(dAtA []byte) (int, error) {
+func (m *Keyspace) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Keyspace_ServedFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Keyspace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2386,11 +2713,121 @@ func (m *Keyspace_ServedFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Keyspace) > 0 {
-		i -= len(m.Keyspace)
-		copy(dAtA[i:], m.Keyspace)
-		i = encodeVarintTopodata(dAtA, i, uint64(len(m.Keyspace)))
-		i--
+	if len(m.ThrottledApps) > 0 {
+		for iNdEx := len(m.ThrottledApps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ThrottledApps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTopodata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DurabilityPolicy) > 0 {
+		i -= len(m.DurabilityPolicy)
+		copy(dAtA[i:], m.DurabilityPolicy)
+		i = encodeVarintTopodata(dAtA, i, uint64(len(m.DurabilityPolicy)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BackupRetentionPolicy != nil {
+		{
+			size, err := m.BackupRetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopodata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SnapshotTime != nil {
+		{
+			size, err := m.SnapshotTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopodata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BaseKeyspace) > 0 {
+		i -= len(m.BaseKeyspace)
+		copy(dAtA[i:], m.BaseKeyspace)
+		i = encodeVarintTopodata(dAtA, i, uint64(len(m.BaseKeyspace)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.KeyspaceType != 0 {
+		i = encodeVarintTopodata(dAtA, i, uint64(m.KeyspaceType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ServedFroms) > 0 {
+		for iNdEx := len(m.ServedFroms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ServedFroms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTopodata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ShardingColumnType != 0 {
+		i = encodeVarintTopodata(dAtA, i, uint64(m.ShardingColumnType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ShardingColumnName) > 0 {
+		i -= len(m.ShardingColumnName)
+		copy(dAtA[i:], m.ShardingColumnName)
+		i = encodeVarintTopodata(dAtA, i, uint64(len(m.ShardingColumnName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Keyspace_ServedFrom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Keyspace_ServedFrom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Keyspace_ServedFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Keyspace) > 0 {
+		i -= len(m.Keyspace)
+		copy(dAtA[i:], m.Keyspace)
+		i = encodeVarintTopodata(dAtA, i, uint64(len(m.Keyspace)))
+		i--
 		dAtA[i] = 0x1a
 	}
 	if len(m.Cells) > 0 {
@@ -2410,6 +2847,58 @@ func (m *Keyspace_ServedFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ThrottledAppRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThrottledAppRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThrottledAppRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpiresAt != nil {
+		{
+			size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopodata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Ratio != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Ratio))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTopodata(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ShardReplication) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2963,6 +3452,76 @@ func (m *ExternalClusters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VReplicationWorkflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VReplicationWorkflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VReplicationWorkflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Updated != nil {
+		{
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopodata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopodata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.State != 0 {
+		i = encodeVarintTopodata(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SourceKeyspace) > 0 {
+		i -= len(m.SourceKeyspace)
+		copy(dAtA[i:], m.SourceKeyspace)
+		i = encodeVarintTopodata(dAtA, i, uint64(len(m.SourceKeyspace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WorkflowType) > 0 {
+		i -= len(m.WorkflowType)
+		copy(dAtA[i:], m.WorkflowType)
+		i = encodeVarintTopodata(dAtA, i, uint64(len(m.WorkflowType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTopodata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTopodata(v)
 	base := offset
@@ -3118,6 +3677,10 @@ func (m *Shard) Size() (n int) {
 		l = m.MasterTermStartTime.Size()
 		n += 1 + l + sovTopodata(uint64(l))
 	}
+	if m.BackupRetentionPolicy != nil {
+		l = m.BackupRetentionPolicy.Size()
+		n += 1 + l + sovTopodata(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3208,6 +3771,28 @@ func (m *Shard_TabletControl) Size() (n int) {
 	return n
 }
 
+func (m *BackupRetentionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeepCount != 0 {
+		n += 1 + sovTopodata(uint64(m.KeepCount))
+	}
+	if m.MaxAge != nil {
+		l = m.MaxAge.Size()
+		n += 1 + l + sovTopodata(uint64(l))
+	}
+	if m.MinValidCount != 0 {
+		n += 1 + sovTopodata(uint64(m.MinValidCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Keyspace) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3238,6 +3823,20 @@ func (m *Keyspace) Size() (n int) {
 		l = m.SnapshotTime.Size()
 		n += 1 + l + sovTopodata(uint64(l))
 	}
+	if m.BackupRetentionPolicy != nil {
+		l = m.BackupRetentionPolicy.Size()
+		n += 1 + l + sovTopodata(uint64(l))
+	}
+	l = len(m.DurabilityPolicy)
+	if l > 0 {
+		n += 1 + l + sovTopodata(uint64(l))
+	}
+	if len(m.ThrottledApps) > 0 {
+		for _, e := range m.ThrottledApps {
+			l = e.Size()
+			n += 1 + l + sovTopodata(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3269,6 +3868,29 @@ func (m *Keyspace_ServedFrom) Size() (n int) {
 	return n
 }
 
+func (m *ThrottledAppRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTopodata(uint64(l))
+	}
+	if m.Ratio != 0 {
+		n += 9
+	}
+	if m.ExpiresAt != nil {
+		l = m.ExpiresAt.Size()
+		n += 1 + l + sovTopodata(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ShardReplication) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3519,16 +4141,47 @@ func (m *ExternalClusters) Size() (n int) {
 	return n
 }
 
-func sovTopodata(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTopodata(x uint64) (n int) {
-	return sovTopodata(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *KeyRange) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+func (m *VReplicationWorkflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowType)
+	if l > 0 {
+		n += 1 + l + sovTopodata(uint64(l))
+	}
+	l = len(m.SourceKeyspace)
+	if l > 0 {
+		n += 1 + l + sovTopodata(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovTopodata(uint64(m.State))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovTopodata(uint64(l))
+	}
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovTopodata(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTopodata(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTopodata(x uint64) (n int) {
+	return sovTopodata(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *KeyRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -4611,6 +5264,42 @@ func (m *Shard) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupRetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BackupRetentionPolicy == nil {
+				m.BackupRetentionPolicy = &BackupRetentionPolicy{}
+			}
+			if err := m.BackupRetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTopodata(dAtA[iNdEx:])
@@ -5103,6 +5792,134 @@ func (m *Shard_TabletControl) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BackupRetentionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopodata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupRetentionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupRetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepCount", wireType)
+			}
+			m.KeepCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxAge == nil {
+				m.MaxAge = &vttime.Duration{}
+			}
+			if err := m.MaxAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidCount", wireType)
+			}
+			m.MinValidCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinValidCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopodata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Keyspace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5268,11 +6085,254 @@ func (m *Keyspace) Unmarshal(dAtA []byte) error {
 			}
 			m.BaseKeyspace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SnapshotTime == nil {
+				m.SnapshotTime = &vttime.Time{}
+			}
+			if err := m.SnapshotTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupRetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BackupRetentionPolicy == nil {
+				m.BackupRetentionPolicy = &BackupRetentionPolicy{}
+			}
+			if err := m.BackupRetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurabilityPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DurabilityPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottledApps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThrottledApps = append(m.ThrottledApps, &ThrottledAppRule{})
+			if err := m.ThrottledApps[len(m.ThrottledApps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopodata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Keyspace_ServedFrom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopodata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServedFrom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServedFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TabletType", wireType)
+			}
+			m.TabletType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TabletType |= TabletType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cells", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cells = append(m.Cells, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyspace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopodata
@@ -5282,27 +6342,23 @@ func (m *Keyspace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTopodata
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTopodata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SnapshotTime == nil {
-				m.SnapshotTime = &vttime.Time{}
-			}
-			if err := m.SnapshotTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Keyspace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5329,7 +6385,7 @@ func (m *Keyspace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Keyspace_ServedFrom) Unmarshal(dAtA []byte) error {
+func (m *ThrottledAppRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5352,34 +6408,15 @@ func (m *Keyspace_ServedFrom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServedFrom: wiretype end group for non-group")
+			return fmt.Errorf("proto: ThrottledAppRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServedFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ThrottledAppRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TabletType", wireType)
-			}
-			m.TabletType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTopodata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TabletType |= TabletType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cells", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5407,13 +6444,24 @@ func (m *Keyspace_ServedFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cells = append(m.Cells, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Ratio = float64(math.Float64frombits(v))
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keyspace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopodata
@@ -5423,23 +6471,27 @@ func (m *Keyspace_ServedFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTopodata
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTopodata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keyspace = string(dAtA[iNdEx:postIndex])
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = &vttime.Time{}
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6802,7 +7854,7 @@ func (m *ExternalClusters) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalVitessCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VitessCluster", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6859,6 +7911,215 @@ func (m *ExternalClusters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VReplicationWorkflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopodata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VReplicationWorkflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VReplicationWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceKeyspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceKeyspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= VReplicationWorkflow_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &vttime.Time{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopodata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Updated == nil {
+				m.Updated = &vttime.Time{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopodata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTopodata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTopodata(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -29,51 +29,69 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("vtctlservice.proto", fileDescriptor_27055cdbb1148d2b) }
 
 var fileDescriptor_27055cdbb1148d2b = []byte{
-	// 692 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xd1, 0x6e, 0xd3, 0x3e,
-	0x14, 0xc6, 0xd7, 0x8b, 0xff, 0xf4, 0xc7, 0x0c, 0x36, 0x19, 0x04, 0x52, 0xb7, 0x86, 0xad, 0x30,
-	0x60, 0x08, 0x5a, 0x34, 0x2e, 0xb9, 0xda, 0x4a, 0x29, 0xd5, 0xa4, 0x69, 0x74, 0xd5, 0x90, 0x26,
-	0xed, 0xc2, 0x4b, 0x0e, 0x6d, 0x44, 0xe2, 0x64, 0xb1, 0x1b, 0xad, 0xe2, 0x45, 0xb8, 0xe2, 0x79,
-	0xb8, 0xe4, 0x11, 0x50, 0x79, 0x11, 0xd4, 0xb8, 0x76, 0x1d, 0xc7, 0x69, 0x7b, 0xd7, 0x9e, 0xdf,
-	0x77, 0xbe, 0x63, 0xa7, 0xfe, 0xe2, 0x22, 0x9c, 0x72, 0x97, 0x07, 0x0c, 0x92, 0xd4, 0x77, 0xa1,
-	0x11, 0x27, 0x11, 0x8f, 0xf0, 0x86, 0x5e, 0xab, 0x6e, 0x66, 0xdf, 0x3c, 0xc2, 0x89, 0xc0, 0x87,
-	0x37, 0xe8, 0xbf, 0x8b, 0x69, 0x09, 0x0f, 0xd1, 0x83, 0xf6, 0x2d, 0xb8, 0x23, 0x0e, 0xd9, 0xf7,
-	0x56, 0x14, 0x86, 0x84, 0x7a, 0x78, 0xbf, 0x31, 0xef, 0xb0, 0xf0, 0x1e, 0xdc, 0x8c, 0x80, 0xf1,
-	0xea, 0xf3, 0x65, 0x32, 0x16, 0x47, 0x94, 0x41, 0x7d, 0xed, 0x6d, 0xe5, 0xf0, 0x27, 0x46, 0xeb,
-	0x19, 0xf4, 0xf0, 0x15, 0xda, 0x6a, 0x0d, 0x09, 0x1d, 0x40, 0x9f, 0x5c, 0x07, 0xc0, 0xfb, 0xe3,
-	0x18, 0x70, 0x5d, 0xb3, 0x32, 0xa1, 0x1c, 0xf7, 0x74, 0xa1, 0x46, 0xce, 0xc2, 0x5f, 0xd0, 0xfd,
-	0x56, 0x02, 0x84, 0xc3, 0x09, 0x8c, 0x59, 0x4c, 0x5c, 0xc0, 0xbb, 0x7a, 0x63, 0x0e, 0x49, 0xeb,
-	0xbd, 0x05, 0x0a, 0x65, 0x7c, 0x8a, 0xee, 0x0a, 0x76, 0x3e, 0x24, 0x89, 0x87, 0x6b, 0x85, 0x9e,
-	0xac, 0x2e, 0x2d, 0x9d, 0x32, 0xac, 0x2f, 0xf4, 0x03, 0x04, 0x50, 0xb2, 0xd0, 0x3c, 0xb2, 0x2d,
-	0xd4, 0x54, 0x28, 0xe3, 0xcf, 0x68, 0x43, 0xb0, 0x6c, 0x22, 0xc3, 0x4e, 0xa1, 0x49, 0x00, 0x69,
-	0xfa, 0xa4, 0x94, 0x2b, 0xcb, 0x3e, 0xba, 0x27, 0x88, 0x78, 0xe4, 0x0c, 0x17, 0x7b, 0x66, 0x44,
-	0x9a, 0xee, 0x96, 0x0b, 0x94, 0x6b, 0x84, 0x1e, 0xb5, 0x43, 0x48, 0x06, 0x40, 0xdd, 0x71, 0x0f,
-	0x62, 0x92, 0x00, 0xe5, 0xe2, 0xe1, 0xbe, 0xd4, 0x8f, 0x96, 0x55, 0x22, 0xe7, 0x1c, 0xac, 0xa0,
-	0x54, 0x03, 0x13, 0xf4, 0xf8, 0xa3, 0x4f, 0xbd, 0xa3, 0x20, 0x10, 0x3b, 0xec, 0x52, 0xf5, 0xec,
-	0x75, 0x9f, 0x12, 0x8d, 0x1c, 0xf9, 0x6a, 0x15, 0xa9, 0x9a, 0x79, 0x82, 0x50, 0x07, 0xf8, 0x31,
-	0x71, 0xbf, 0x8d, 0x62, 0x86, 0x77, 0xb4, 0xde, 0x79, 0x59, 0x3a, 0xd7, 0x4a, 0xa8, 0x32, 0xbb,
-	0x42, 0x5b, 0x1d, 0xe0, 0x2d, 0x08, 0x82, 0x2e, 0xfd, 0x1a, 0x9d, 0x92, 0x10, 0x58, 0x2e, 0x3b,
-	0x26, 0xb4, 0x65, 0xa7, 0xa8, 0xd1, 0x8f, 0xb8, 0x46, 0x71, 0xcd, 0xde, 0x65, 0x3b, 0xe2, 0x39,
-	0xac, 0xfc, 0x2e, 0xd1, 0xe6, 0x0c, 0xb0, 0xa3, 0xc0, 0x27, 0x0c, 0x18, 0xde, 0x2b, 0x36, 0x49,
-	0x26, 0x7d, 0xeb, 0x8b, 0x24, 0xc6, 0x5a, 0xd5, 0xef, 0x67, 0xac, 0xd5, 0xfc, 0xcd, 0x9c, 0x32,
-	0xac, 0xa7, 0x46, 0x03, 0xf9, 0xd4, 0xe8, 0xc0, 0x96, 0x9a, 0x3c, 0x57, 0x96, 0x9f, 0xd0, 0x9d,
-	0x0e, 0xf0, 0x73, 0x77, 0x08, 0x21, 0xc1, 0xdb, 0x79, 0xbd, 0xa8, 0x4a, 0xb3, 0x1d, 0x3b, 0x54,
-	0x4e, 0x6d, 0xf4, 0xff, 0xb4, 0x9c, 0x65, 0xa3, 0x6a, 0x68, 0xf5, 0x34, 0x6c, 0x5b, 0x99, 0x1e,
-	0xe3, 0x69, 0x35, 0x49, 0x2f, 0x66, 0x8b, 0x32, 0x36, 0x31, 0x27, 0xb6, 0x18, 0x1b, 0x02, 0x63,
-	0x9b, 0x22, 0xde, 0xe6, 0x36, 0x45, 0xb5, 0x64, 0x9b, 0x12, 0x1a, 0x59, 0x91, 0xef, 0x18, 0xab,
-	0xba, 0x2c, 0x2b, 0xc5, 0xb7, 0x8b, 0x30, 0x93, 0x3b, 0x35, 0xcc, 0x8c, 0x6d, 0xd6, 0x4a, 0xa8,
-	0x1e, 0xbc, 0x2e, 0xf5, 0xc5, 0x03, 0x3d, 0x4b, 0xfc, 0x90, 0x24, 0xe3, 0x5c, 0xf0, 0x4c, 0x68,
-	0x0b, 0x5e, 0x51, 0xa3, 0xec, 0x7d, 0xf4, 0xf0, 0x2c, 0x20, 0x94, 0x82, 0x97, 0x7f, 0x0f, 0xea,
-	0x57, 0xac, 0x4d, 0x20, 0xc7, 0xbc, 0x58, 0xaa, 0x53, 0xa3, 0x5c, 0x84, 0x7b, 0x10, 0x46, 0xa9,
-	0xba, 0x39, 0xa6, 0xf9, 0xc2, 0xcf, 0x34, 0x83, 0x22, 0x96, 0x63, 0xf6, 0x97, 0xa8, 0xf4, 0xe0,
-	0x0b, 0x9e, 0x4d, 0xcf, 0x26, 0xec, 0x15, 0x7a, 0x15, 0xb3, 0x05, 0xbf, 0x20, 0xd1, 0xef, 0x4d,
-	0xb9, 0xb7, 0xd9, 0x99, 0xdb, 0xcd, 0xf5, 0xe9, 0xc8, 0x76, 0x6f, 0x9a, 0x0a, 0x65, 0xfc, 0x1d,
-	0x55, 0x45, 0xad, 0x7d, 0xcb, 0x21, 0xa1, 0x24, 0x08, 0xd4, 0x45, 0x02, 0x1e, 0x7e, 0xad, 0x59,
-	0x94, 0xcb, 0xe4, 0xc0, 0x37, 0x2b, 0xaa, 0xe5, 0xf0, 0xe3, 0xf7, 0xbf, 0x26, 0x4e, 0xe5, 0xf7,
-	0xc4, 0xa9, 0xfc, 0x99, 0x38, 0x95, 0x1f, 0x7f, 0x9d, 0xb5, 0xcb, 0x83, 0xd4, 0xe7, 0xc0, 0x58,
-	0xc3, 0x8f, 0x9a, 0xe2, 0x53, 0x73, 0x10, 0x35, 0x53, 0xde, 0xcc, 0xfe, 0xc3, 0x35, 0xf5, 0x7f,
-	0x78, 0xd7, 0xeb, 0x59, 0xed, 0xdd, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x90, 0xec, 0xfc, 0x38,
-	0x0c, 0x0a, 0x00, 0x00,
+	// 987 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x97, 0xdf, 0x72, 0x1b, 0x35,
+	0x14, 0xc6, 0x9b, 0x8b, 0x06, 0x50, 0x0b, 0x6d, 0x55, 0x86, 0xb6, 0x6e, 0x6d, 0xf2, 0xa7, 0x05,
+	0xca, 0x40, 0xcc, 0x94, 0x4b, 0xb8, 0x71, 0xd2, 0x34, 0x64, 0x3a, 0x64, 0x82, 0x13, 0xd2, 0x99,
+	0xce, 0x74, 0x06, 0x65, 0xf7, 0x38, 0xde, 0xe9, 0xee, 0x6a, 0x2b, 0xc9, 0xa6, 0x1e, 0x5e, 0x84,
+	0x47, 0x82, 0x3b, 0x1e, 0x81, 0x09, 0x2f, 0xc2, 0x78, 0x65, 0xc9, 0x47, 0xda, 0xb3, 0x49, 0xee,
+	0xec, 0xf3, 0xfb, 0xf4, 0x1d, 0x69, 0x75, 0x8e, 0xb4, 0xcb, 0xf8, 0xd4, 0x24, 0x26, 0xd7, 0xa0,
+	0xa6, 0x59, 0x02, 0x5b, 0x95, 0x92, 0x46, 0xf2, 0x9b, 0x38, 0xd6, 0xb9, 0x55, 0xff, 0x4b, 0x85,
+	0x11, 0x16, 0x3f, 0x7b, 0xc7, 0xae, 0x9f, 0xcc, 0x43, 0x7c, 0xcc, 0xee, 0xee, 0xbe, 0x87, 0x64,
+	0x62, 0xa0, 0xfe, 0xbf, 0x23, 0x8b, 0x42, 0x94, 0x29, 0x7f, 0xb2, 0xb5, 0x1c, 0x41, 0xf0, 0x21,
+	0xbc, 0x9b, 0x80, 0x36, 0x9d, 0x2f, 0x2e, 0x93, 0xe9, 0x4a, 0x96, 0x1a, 0x36, 0xae, 0x7d, 0xb7,
+	0xf2, 0xec, 0xef, 0x2e, 0x5b, 0xad, 0x61, 0xca, 0x0f, 0xd8, 0x8d, 0x41, 0x55, 0xe5, 0xb3, 0xa3,
+	0x64, 0x0c, 0x85, 0xe0, 0x5d, 0xe4, 0x82, 0xe2, 0x2e, 0x49, 0xaf, 0x0d, 0x3b, 0x73, 0xfe, 0x0b,
+	0xbb, 0x59, 0x83, 0x93, 0x85, 0x61, 0x63, 0xc4, 0x49, 0xe8, 0xf8, 0x79, 0x2b, 0xf7, 0x96, 0x03,
+	0xb6, 0xba, 0x2d, 0x92, 0xb7, 0x93, 0x8a, 0xdf, 0x47, 0x62, 0x1b, 0x72, 0x36, 0x0f, 0x08, 0xb2,
+	0x5c, 0x30, 0x7f, 0xc3, 0x6e, 0xef, 0x8c, 0x45, 0x79, 0x06, 0xc7, 0xe2, 0x34, 0x07, 0x73, 0x3c,
+	0xab, 0x80, 0x6f, 0xa0, 0x21, 0x31, 0x74, 0xb6, 0x9b, 0x17, 0x6a, 0xfc, 0x0c, 0x5f, 0xb1, 0x4f,
+	0x76, 0x14, 0x08, 0x03, 0x2f, 0x61, 0xa6, 0x2b, 0x91, 0x00, 0x5f, 0xc3, 0x03, 0x03, 0xe4, 0xac,
+	0xd7, 0x2f, 0x50, 0x78, 0xe3, 0x03, 0x76, 0xc3, 0xb2, 0xa3, 0xb1, 0x50, 0x69, 0xb0, 0x3b, 0x28,
+	0x4e, 0xed, 0x4e, 0x80, 0xf1, 0x44, 0x9f, 0x43, 0x0e, 0x2d, 0x13, 0x0d, 0x11, 0x35, 0xd1, 0x58,
+	0x81, 0xb7, 0xdd, 0xb2, 0x3a, 0xa3, 0x0e, 0xb6, 0x1d, 0x03, 0x6a, 0xdb, 0x43, 0xee, 0x2d, 0x8f,
+	0xd9, 0xc7, 0x96, 0xd8, 0x47, 0xae, 0x79, 0x73, 0xcc, 0x82, 0x38, 0xd3, 0xb5, 0x76, 0x81, 0x77,
+	0x95, 0xec, 0xb3, 0xdd, 0x02, 0xd4, 0x19, 0x94, 0xc9, 0x6c, 0x08, 0x95, 0x50, 0x50, 0x1a, 0xfb,
+	0x70, 0xbf, 0xc2, 0x0d, 0x44, 0x4a, 0x5c, 0x9e, 0xa7, 0x57, 0x50, 0xfa, 0x84, 0x8a, 0xdd, 0x7b,
+	0x91, 0x95, 0xe9, 0x20, 0xcf, 0xed, 0x0a, 0xf7, 0x4b, 0xff, 0xec, 0xb1, 0x4f, 0x8b, 0xc6, 0xa5,
+	0xfc, 0xfa, 0x2a, 0x52, 0x9f, 0xf3, 0x25, 0x63, 0x7b, 0x60, 0x6c, 0x1f, 0x68, 0xfe, 0x08, 0x8d,
+	0x5d, 0x86, 0x9d, 0x73, 0xb7, 0x85, 0x7a, 0xb3, 0x37, 0xec, 0xf6, 0x1e, 0x98, 0x1d, 0xc8, 0xf3,
+	0xfd, 0x72, 0x24, 0x0f, 0x44, 0x01, 0x3a, 0xe8, 0x9d, 0x18, 0x52, 0xbd, 0xd3, 0xd4, 0xe0, 0x12,
+	0x47, 0x94, 0x77, 0xe9, 0x51, 0x54, 0x89, 0x07, 0xd8, 0xfb, 0xbd, 0x66, 0xb7, 0x16, 0x40, 0x0f,
+	0xf2, 0x4c, 0x68, 0xd0, 0x7c, 0xbd, 0x39, 0xc8, 0x31, 0xe7, 0xbb, 0x71, 0x91, 0x24, 0x9a, 0xab,
+	0xdf, 0xbf, 0x68, 0xae, 0xf1, 0x9e, 0xf5, 0xda, 0x30, 0xee, 0x1a, 0x04, 0xc2, 0xae, 0xc1, 0x80,
+	0xea, 0x9a, 0x90, 0x7b, 0xcb, 0x9f, 0xd8, 0x47, 0x7b, 0x60, 0x16, 0x87, 0xef, 0xc3, 0x50, 0x1f,
+	0x9e, 0xbc, 0x8f, 0x68, 0xe8, 0x9d, 0x76, 0xd9, 0x87, 0xf3, 0x70, 0xdd, 0x1b, 0x9d, 0x48, 0x8b,
+	0xbb, 0xe1, 0x21, 0xc9, 0x70, 0x1b, 0xcf, 0xa3, 0x6a, 0xea, 0x6e, 0x84, 0x68, 0x11, 0x4b, 0x42,
+	0xb5, 0x71, 0x24, 0x88, 0x96, 0x69, 0xdb, 0x3b, 0x5e, 0xa6, 0x8d, 0xb6, 0x2c, 0xd3, 0xc1, 0xa8,
+	0x57, 0xdc, 0x19, 0x43, 0xaa, 0xdb, 0x7a, 0xa5, 0x79, 0xba, 0x58, 0x33, 0xb7, 0xd2, 0xc8, 0x2c,
+	0x5a, 0x66, 0xb7, 0x85, 0x46, 0xd5, 0xf6, 0x4a, 0xaa, 0xb7, 0xa3, 0x5c, 0xfe, 0x1e, 0x57, 0x9b,
+	0x8b, 0xb7, 0x54, 0xdb, 0x12, 0x47, 0xd5, 0xe6, 0x40, 0xa3, 0xda, 0x3c, 0x68, 0xa9, 0x36, 0xc4,
+	0xbd, 0xe5, 0x88, 0xdd, 0x45, 0xe4, 0x50, 0xc9, 0x33, 0x05, 0x5a, 0x07, 0xaf, 0x2c, 0x04, 0xa7,
+	0x5e, 0x59, 0x48, 0x99, 0xcf, 0xf3, 0x1b, 0xbb, 0x83, 0x04, 0x47, 0x46, 0x98, 0x89, 0xe6, 0x9b,
+	0xf4, 0x70, 0x4b, 0x5d, 0x8e, 0xc7, 0x17, 0x8b, 0x5a, 0x56, 0x62, 0x31, 0xb4, 0xae, 0xc4, 0xf1,
+	0x4b, 0x56, 0xb2, 0x94, 0xe1, 0xd3, 0x74, 0xbf, 0xcc, 0x6c, 0x97, 0x1c, 0xaa, 0xac, 0x10, 0x6a,
+	0x16, 0x9c, 0xa6, 0x31, 0xa4, 0x4e, 0xd3, 0xa6, 0x06, 0x17, 0xe0, 0xcf, 0x72, 0x6a, 0xef, 0xbd,
+	0xb0, 0x9a, 0x97, 0x61, 0xaa, 0x00, 0x31, 0xf5, 0x66, 0x19, 0xfb, 0xf4, 0x30, 0x17, 0x65, 0x09,
+	0x69, 0x78, 0x53, 0xe2, 0xd5, 0x52, 0x02, 0x97, 0xe0, 0xcb, 0x4b, 0x75, 0x38, 0xd5, 0x10, 0x4e,
+	0x27, 0x59, 0x9e, 0xba, 0x43, 0x6d, 0x4f, 0x89, 0x6a, 0x1c, 0xa4, 0xa2, 0x04, 0x54, 0x2a, 0x5a,
+	0x87, 0xdb, 0x60, 0x08, 0x23, 0x05, 0x7a, 0x3c, 0xdf, 0x1e, 0x08, 0xda, 0x00, 0x03, 0xaa, 0x0d,
+	0x42, 0xee, 0x2d, 0x13, 0xc6, 0x87, 0x50, 0xc8, 0xa9, 0x7f, 0x33, 0x9a, 0xdf, 0x1f, 0xfc, 0x71,
+	0x30, 0x30, 0xc6, 0xce, 0xfe, 0xc9, 0x25, 0x2a, 0x7c, 0xb1, 0x59, 0x5e, 0x3f, 0xbb, 0x3a, 0xc3,
+	0x7a, 0x63, 0xac, 0x67, 0xd4, 0xc5, 0xd6, 0x90, 0xe0, 0xf7, 0x42, 0xb7, 0x33, 0x8b, 0x33, 0x75,
+	0x2d, 0x18, 0x87, 0x11, 0xf5, 0x5e, 0x18, 0x2b, 0xbc, 0xf1, 0x36, 0xfb, 0x60, 0x08, 0xba, 0xae,
+	0x9a, 0x07, 0x81, 0x5e, 0xe3, 0x42, 0xe9, 0x50, 0xc8, 0x7b, 0x9c, 0xb2, 0x3b, 0x43, 0xd0, 0x46,
+	0x2a, 0x78, 0xa1, 0x64, 0xb1, 0xf8, 0x14, 0xd8, 0x0c, 0x87, 0x84, 0x94, 0x6a, 0x7e, 0x42, 0x84,
+	0x3e, 0x10, 0xfe, 0x60, 0x1d, 0x3b, 0xf7, 0xdd, 0xf7, 0x06, 0x54, 0x29, 0xf2, 0xdc, 0xbf, 0xd0,
+	0x41, 0xca, 0xbf, 0x41, 0x3e, 0xed, 0x32, 0x97, 0xf5, 0xdb, 0x2b, 0xaa, 0xf1, 0x41, 0x7f, 0x3c,
+	0x56, 0xd2, 0x98, 0x1c, 0x06, 0x55, 0x15, 0x1c, 0xf4, 0x28, 0x4e, 0x1d, 0xf4, 0x01, 0xc6, 0x57,
+	0xee, 0xaf, 0xa5, 0x41, 0x8e, 0xb8, 0x84, 0x03, 0x42, 0x5d, 0xb9, 0x91, 0xc0, 0xbb, 0xfe, 0xc8,
+	0xae, 0x9f, 0x3c, 0xcf, 0x46, 0x23, 0x7e, 0x0f, 0x89, 0xeb, 0x88, 0x73, 0xb9, 0xdf, 0x04, 0xb8,
+	0xc2, 0xdc, 0xa9, 0x38, 0x48, 0x4c, 0x26, 0xcb, 0xa0, 0xc2, 0x42, 0x44, 0x55, 0x58, 0xac, 0x70,
+	0xc6, 0xdb, 0x3f, 0xfc, 0x75, 0xde, 0x5b, 0xf9, 0xe7, 0xbc, 0xb7, 0xf2, 0xef, 0x79, 0x6f, 0xe5,
+	0xcf, 0xff, 0x7a, 0xd7, 0x5e, 0x3f, 0x9d, 0x66, 0x06, 0xb4, 0xde, 0xca, 0x64, 0xdf, 0xfe, 0xea,
+	0x9f, 0xc9, 0xfe, 0xd4, 0xf4, 0xeb, 0xcf, 0xed, 0x3e, 0xfe, 0x18, 0x3f, 0x5d, 0xad, 0x63, 0xdf,
+	0xff, 0x3f, 0x00, 0x78, 0x69, 0x44, 0xb2, 0xb7, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -187,6 +205,14 @@ var _Vtctl_serviceDesc = grpc.ServiceDesc{
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type VtctldClient interface {
+	// ApplySchema applies a schema change to all the primaries of a keyspace.
+	ApplySchema(ctx context.Context, in *vtctldata.ApplySchemaRequest, opts ...grpc.CallOption) (*vtctldata.ApplySchemaResponse, error)
+	// ApplyVSchema applies a vschema to a keyspace, or a vschema DDL statement
+	// to its current vschema.
+	ApplyVSchema(ctx context.Context, in *vtctldata.ApplyVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ApplyVSchemaResponse, error)
+	// Backup uses the BackupEngine and BackupStorage services on the specified
+	// tablet to create and store a new backup, streaming back its progress.
+	Backup(ctx context.Context, in *vtctldata.BackupRequest, opts ...grpc.CallOption) (Vtctld_BackupClient, error)
 	// ChangeTabletType changes the db type for the specified tablet, if possible.
 	// This is used primarily to arrange replicas, and it will not convert a
 	// primary. For that, use InitShardPrimary.
@@ -244,6 +270,20 @@ type VtctldClient interface {
 	GetTablets(ctx context.Context, in *vtctldata.GetTabletsRequest, opts ...grpc.CallOption) (*vtctldata.GetTabletsResponse, error)
 	// GetVSchema returns the vschema for a keyspace.
 	GetVSchema(ctx context.Context, in *vtctldata.GetVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.GetVSchemaResponse, error)
+	// GetWorkflow returns the lifecycle record of a vreplication workflow.
+	GetWorkflow(ctx context.Context, in *vtctldata.GetWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowResponse, error)
+	// GetWorkflows returns the lifecycle records of all vreplication workflows
+	// targeting a keyspace.
+	GetWorkflows(ctx context.Context, in *vtctldata.GetWorkflowsRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowsResponse, error)
+	// GetWorkflowProgress returns the state, copy progress, lag and ETA of a
+	// vreplication workflow.
+	GetWorkflowProgress(ctx context.Context, in *vtctldata.GetWorkflowProgressRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowProgressResponse, error)
+	// GetWorkflowStatus returns the vreplication status of a workflow, read
+	// from the _vt.vreplication tables of the target shards.
+	GetWorkflowStatus(ctx context.Context, in *vtctldata.GetWorkflowStatusRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowStatusResponse, error)
+	// GetWorkflowStatuses returns the vreplication status of all the workflows
+	// targeting a keyspace.
+	GetWorkflowStatuses(ctx context.Context, in *vtctldata.GetWorkflowStatusesRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowStatusesResponse, error)
 	// InitShardPrimary sets the initial primary for a shard. Will make all other
 	// tablets in the shard replicas of the provided primary.
 	//
@@ -251,6 +291,9 @@ type VtctldClient interface {
 	// PlannedReparentShard or EmergencyReparentShard should be used in those
 	// cases instead.
 	InitShardPrimary(ctx context.Context, in *vtctldata.InitShardPrimaryRequest, opts ...grpc.CallOption) (*vtctldata.InitShardPrimaryResponse, error)
+	// MoveTables creates a workflow that moves tables from a source keyspace
+	// to a target keyspace.
+	MoveTables(ctx context.Context, in *vtctldata.MoveTablesRequest, opts ...grpc.CallOption) (*vtctldata.MoveTablesResponse, error)
 	// PlannedReparentShard reparents the shard to the new primary, or away from
 	// an old primary. Both the old and new primaries need to be reachable and
 	// running.
@@ -259,6 +302,13 @@ type VtctldClient interface {
 	// current shard primary is in for promotion unless NewPrimary is explicitly
 	// provided in the request.
 	PlannedReparentShard(ctx context.Context, in *vtctldata.PlannedReparentShardRequest, opts ...grpc.CallOption) (*vtctldata.PlannedReparentShardResponse, error)
+	// RebuildKeyspaceGraph rebuilds the serving data for a keyspace.
+	//
+	// NOTE: This command automatically updates the serving graph.
+	RebuildKeyspaceGraph(ctx context.Context, in *vtctldata.RebuildKeyspaceGraphRequest, opts ...grpc.CallOption) (*vtctldata.RebuildKeyspaceGraphResponse, error)
+	// RefreshState asks a tablet to reload its tablet record from the topo,
+	// and to update its serving state to match it.
+	RefreshState(ctx context.Context, in *vtctldata.RefreshStateRequest, opts ...grpc.CallOption) (*vtctldata.RefreshStateResponse, error)
 	// RemoveKeyspaceCell removes the specified cell from the Cells list for all
 	// shards in the specified keyspace, as well as from the SrvKeyspace for that
 	// keyspace in that cell.
@@ -270,6 +320,12 @@ type VtctldClient interface {
 	// only works if the current replica position matches the last known reparent
 	// action.
 	ReparentTablet(ctx context.Context, in *vtctldata.ReparentTabletRequest, opts ...grpc.CallOption) (*vtctldata.ReparentTabletResponse, error)
+	// Reshard creates a workflow that reshards a keyspace from the source
+	// shards to the target shards.
+	Reshard(ctx context.Context, in *vtctldata.ReshardRequest, opts ...grpc.CallOption) (*vtctldata.ReshardResponse, error)
+	// RestoreFromBackup stops mysqld on the specified tablet and restores the
+	// data from the latest backup, streaming back its progress.
+	RestoreFromBackup(ctx context.Context, in *vtctldata.RestoreFromBackupRequest, opts ...grpc.CallOption) (Vtctld_RestoreFromBackupClient, error)
 	// TabletExternallyReparented changes metadata in the topology server to
 	// acknowledge a shard primary change performed by an external tool (e.g.
 	// orchestrator).
@@ -277,6 +333,17 @@ type VtctldClient interface {
 	// See the Reparenting guide for more information:
 	// https://vitess.io/docs/user-guides/configuration-advanced/reparenting/#external-reparenting.
 	TabletExternallyReparented(ctx context.Context, in *vtctldata.TabletExternallyReparentedRequest, opts ...grpc.CallOption) (*vtctldata.TabletExternallyReparentedResponse, error)
+	// ThrottleApp throttles an app on the tablet throttlers of all shards of a
+	// keyspace, with a ratio and an expiry, by storing a rule in the keyspace
+	// record.
+	ThrottleApp(ctx context.Context, in *vtctldata.ThrottleAppRequest, opts ...grpc.CallOption) (*vtctldata.ThrottleAppResponse, error)
+	// UnthrottleApp removes the throttling rule of an app from a keyspace.
+	UnthrottleApp(ctx context.Context, in *vtctldata.UnthrottleAppRequest, opts ...grpc.CallOption) (*vtctldata.UnthrottleAppResponse, error)
+	// VDiff compares the source and the target tables of a workflow.
+	VDiff(ctx context.Context, in *vtctldata.VDiffRequest, opts ...grpc.CallOption) (*vtctldata.VDiffResponse, error)
+	// WorkflowAction starts, stops or deletes the vreplication streams of a
+	// workflow on all of its target primaries.
+	WorkflowAction(ctx context.Context, in *vtctldata.WorkflowActionRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowActionResponse, error)
 }
 
 type vtctldClient struct {
@@ -287,6 +354,56 @@ func NewVtctldClient(cc *grpc.ClientConn) VtctldClient {
 	return &vtctldClient{cc}
 }
 
+func (c *vtctldClient) ApplySchema(ctx context.Context, in *vtctldata.ApplySchemaRequest, opts ...grpc.CallOption) (*vtctldata.ApplySchemaResponse, error) {
+	out := new(vtctldata.ApplySchemaResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplySchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ApplyVSchema(ctx context.Context, in *vtctldata.ApplyVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ApplyVSchemaResponse, error) {
+	out := new(vtctldata.ApplyVSchemaResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplyVSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) Backup(ctx context.Context, in *vtctldata.BackupRequest, opts ...grpc.CallOption) (Vtctld_BackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[0], "/vtctlservice.Vtctld/Backup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_BackupClient interface {
+	Recv() (*vtctldata.BackupResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldBackupClient) Recv() (*vtctldata.BackupResponse, error) {
+	m := new(vtctldata.BackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *vtctldClient) ChangeTabletType(ctx context.Context, in *vtctldata.ChangeTabletTypeRequest, opts ...grpc.CallOption) (*vtctldata.ChangeTabletTypeResponse, error) {
 	out := new(vtctldata.ChangeTabletTypeResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ChangeTabletType", in, out, opts...)
@@ -467,6 +584,51 @@ func (c *vtctldClient) GetVSchema(ctx context.Context, in *vtctldata.GetVSchemaR
 	return out, nil
 }
 
+func (c *vtctldClient) GetWorkflow(ctx context.Context, in *vtctldata.GetWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowResponse, error) {
+	out := new(vtctldata.GetWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetWorkflows(ctx context.Context, in *vtctldata.GetWorkflowsRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowsResponse, error) {
+	out := new(vtctldata.GetWorkflowsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetWorkflowProgress(ctx context.Context, in *vtctldata.GetWorkflowProgressRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowProgressResponse, error) {
+	out := new(vtctldata.GetWorkflowProgressResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetWorkflowProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetWorkflowStatus(ctx context.Context, in *vtctldata.GetWorkflowStatusRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowStatusResponse, error) {
+	out := new(vtctldata.GetWorkflowStatusResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetWorkflowStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetWorkflowStatuses(ctx context.Context, in *vtctldata.GetWorkflowStatusesRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowStatusesResponse, error) {
+	out := new(vtctldata.GetWorkflowStatusesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetWorkflowStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) InitShardPrimary(ctx context.Context, in *vtctldata.InitShardPrimaryRequest, opts ...grpc.CallOption) (*vtctldata.InitShardPrimaryResponse, error) {
 	out := new(vtctldata.InitShardPrimaryResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/InitShardPrimary", in, out, opts...)
@@ -476,6 +638,15 @@ func (c *vtctldClient) InitShardPrimary(ctx context.Context, in *vtctldata.InitS
 	return out, nil
 }
 
+func (c *vtctldClient) MoveTables(ctx context.Context, in *vtctldata.MoveTablesRequest, opts ...grpc.CallOption) (*vtctldata.MoveTablesResponse, error) {
+	out := new(vtctldata.MoveTablesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/MoveTables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) PlannedReparentShard(ctx context.Context, in *vtctldata.PlannedReparentShardRequest, opts ...grpc.CallOption) (*vtctldata.PlannedReparentShardResponse, error) {
 	out := new(vtctldata.PlannedReparentShardResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/PlannedReparentShard", in, out, opts...)
@@ -485,6 +656,24 @@ func (c *vtctldClient) PlannedReparentShard(ctx context.Context, in *vtctldata.P
 	return out, nil
 }
 
+func (c *vtctldClient) RebuildKeyspaceGraph(ctx context.Context, in *vtctldata.RebuildKeyspaceGraphRequest, opts ...grpc.CallOption) (*vtctldata.RebuildKeyspaceGraphResponse, error) {
+	out := new(vtctldata.RebuildKeyspaceGraphResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RebuildKeyspaceGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) RefreshState(ctx context.Context, in *vtctldata.RefreshStateRequest, opts ...grpc.CallOption) (*vtctldata.RefreshStateResponse, error) {
+	out := new(vtctldata.RefreshStateResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RefreshState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) RemoveKeyspaceCell(ctx context.Context, in *vtctldata.RemoveKeyspaceCellRequest, opts ...grpc.CallOption) (*vtctldata.RemoveKeyspaceCellResponse, error) {
 	out := new(vtctldata.RemoveKeyspaceCellResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RemoveKeyspaceCell", in, out, opts...)
@@ -512,6 +701,47 @@ func (c *vtctldClient) ReparentTablet(ctx context.Context, in *vtctldata.Reparen
 	return out, nil
 }
 
+func (c *vtctldClient) Reshard(ctx context.Context, in *vtctldata.ReshardRequest, opts ...grpc.CallOption) (*vtctldata.ReshardResponse, error) {
+	out := new(vtctldata.ReshardResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/Reshard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) RestoreFromBackup(ctx context.Context, in *vtctldata.RestoreFromBackupRequest, opts ...grpc.CallOption) (Vtctld_RestoreFromBackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Vtctld_serviceDesc.Streams[1], "/vtctlservice.Vtctld/RestoreFromBackup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vtctldRestoreFromBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vtctld_RestoreFromBackupClient interface {
+	Recv() (*vtctldata.RestoreFromBackupResponse, error)
+	grpc.ClientStream
+}
+
+type vtctldRestoreFromBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *vtctldRestoreFromBackupClient) Recv() (*vtctldata.RestoreFromBackupResponse, error) {
+	m := new(vtctldata.RestoreFromBackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *vtctldClient) TabletExternallyReparented(ctx context.Context, in *vtctldata.TabletExternallyReparentedRequest, opts ...grpc.CallOption) (*vtctldata.TabletExternallyReparentedResponse, error) {
 	out := new(vtctldata.TabletExternallyReparentedResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/TabletExternallyReparented", in, out, opts...)
@@ -521,8 +751,52 @@ func (c *vtctldClient) TabletExternallyReparented(ctx context.Context, in *vtctl
 	return out, nil
 }
 
+func (c *vtctldClient) ThrottleApp(ctx context.Context, in *vtctldata.ThrottleAppRequest, opts ...grpc.CallOption) (*vtctldata.ThrottleAppResponse, error) {
+	out := new(vtctldata.ThrottleAppResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ThrottleApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) UnthrottleApp(ctx context.Context, in *vtctldata.UnthrottleAppRequest, opts ...grpc.CallOption) (*vtctldata.UnthrottleAppResponse, error) {
+	out := new(vtctldata.UnthrottleAppResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/UnthrottleApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) VDiff(ctx context.Context, in *vtctldata.VDiffRequest, opts ...grpc.CallOption) (*vtctldata.VDiffResponse, error) {
+	out := new(vtctldata.VDiffResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/VDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WorkflowAction(ctx context.Context, in *vtctldata.WorkflowActionRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowActionResponse, error) {
+	out := new(vtctldata.WorkflowActionResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/WorkflowAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VtctldServer is the server API for Vtctld service.
 type VtctldServer interface {
+	// ApplySchema applies a schema change to all the primaries of a keyspace.
+	ApplySchema(context.Context, *vtctldata.ApplySchemaRequest) (*vtctldata.ApplySchemaResponse, error)
+	// ApplyVSchema applies a vschema to a keyspace, or a vschema DDL statement
+	// to its current vschema.
+	ApplyVSchema(context.Context, *vtctldata.ApplyVSchemaRequest) (*vtctldata.ApplyVSchemaResponse, error)
+	// Backup uses the BackupEngine and BackupStorage services on the specified
+	// tablet to create and store a new backup, streaming back its progress.
+	Backup(*vtctldata.BackupRequest, Vtctld_BackupServer) error
 	// ChangeTabletType changes the db type for the specified tablet, if possible.
 	// This is used primarily to arrange replicas, and it will not convert a
 	// primary. For that, use InitShardPrimary.
@@ -580,6 +854,20 @@ type VtctldServer interface {
 	GetTablets(context.Context, *vtctldata.GetTabletsRequest) (*vtctldata.GetTabletsResponse, error)
 	// GetVSchema returns the vschema for a keyspace.
 	GetVSchema(context.Context, *vtctldata.GetVSchemaRequest) (*vtctldata.GetVSchemaResponse, error)
+	// GetWorkflow returns the lifecycle record of a vreplication workflow.
+	GetWorkflow(context.Context, *vtctldata.GetWorkflowRequest) (*vtctldata.GetWorkflowResponse, error)
+	// GetWorkflows returns the lifecycle records of all vreplication workflows
+	// targeting a keyspace.
+	GetWorkflows(context.Context, *vtctldata.GetWorkflowsRequest) (*vtctldata.GetWorkflowsResponse, error)
+	// GetWorkflowProgress returns the state, copy progress, lag and ETA of a
+	// vreplication workflow.
+	GetWorkflowProgress(context.Context, *vtctldata.GetWorkflowProgressRequest) (*vtctldata.GetWorkflowProgressResponse, error)
+	// GetWorkflowStatus returns the vreplication status of a workflow, read
+	// from the _vt.vreplication tables of the target shards.
+	GetWorkflowStatus(context.Context, *vtctldata.GetWorkflowStatusRequest) (*vtctldata.GetWorkflowStatusResponse, error)
+	// GetWorkflowStatuses returns the vreplication status of all the workflows
+	// targeting a keyspace.
+	GetWorkflowStatuses(context.Context, *vtctldata.GetWorkflowStatusesRequest) (*vtctldata.GetWorkflowStatusesResponse, error)
 	// InitShardPrimary sets the initial primary for a shard. Will make all other
 	// tablets in the shard replicas of the provided primary.
 	//
@@ -587,6 +875,9 @@ type VtctldServer interface {
 	// PlannedReparentShard or EmergencyReparentShard should be used in those
 	// cases instead.
 	InitShardPrimary(context.Context, *vtctldata.InitShardPrimaryRequest) (*vtctldata.InitShardPrimaryResponse, error)
+	// MoveTables creates a workflow that moves tables from a source keyspace
+	// to a target keyspace.
+	MoveTables(context.Context, *vtctldata.MoveTablesRequest) (*vtctldata.MoveTablesResponse, error)
 	// PlannedReparentShard reparents the shard to the new primary, or away from
 	// an old primary. Both the old and new primaries need to be reachable and
 	// running.
@@ -595,6 +886,13 @@ type VtctldServer interface {
 	// current shard primary is in for promotion unless NewPrimary is explicitly
 	// provided in the request.
 	PlannedReparentShard(context.Context, *vtctldata.PlannedReparentShardRequest) (*vtctldata.PlannedReparentShardResponse, error)
+	// RebuildKeyspaceGraph rebuilds the serving data for a keyspace.
+	//
+	// NOTE: This command automatically updates the serving graph.
+	RebuildKeyspaceGraph(context.Context, *vtctldata.RebuildKeyspaceGraphRequest) (*vtctldata.RebuildKeyspaceGraphResponse, error)
+	// RefreshState asks a tablet to reload its tablet record from the topo,
+	// and to update its serving state to match it.
+	RefreshState(context.Context, *vtctldata.RefreshStateRequest) (*vtctldata.RefreshStateResponse, error)
 	// RemoveKeyspaceCell removes the specified cell from the Cells list for all
 	// shards in the specified keyspace, as well as from the SrvKeyspace for that
 	// keyspace in that cell.
@@ -606,6 +904,12 @@ type VtctldServer interface {
 	// only works if the current replica position matches the last known reparent
 	// action.
 	ReparentTablet(context.Context, *vtctldata.ReparentTabletRequest) (*vtctldata.ReparentTabletResponse, error)
+	// Reshard creates a workflow that reshards a keyspace from the source
+	// shards to the target shards.
+	Reshard(context.Context, *vtctldata.ReshardRequest) (*vtctldata.ReshardResponse, error)
+	// RestoreFromBackup stops mysqld on the specified tablet and restores the
+	// data from the latest backup, streaming back its progress.
+	RestoreFromBackup(*vtctldata.RestoreFromBackupRequest, Vtctld_RestoreFromBackupServer) error
 	// TabletExternallyReparented changes metadata in the topology server to
 	// acknowledge a shard primary change performed by an external tool (e.g.
 	// orchestrator).
@@ -613,12 +917,32 @@ type VtctldServer interface {
 	// See the Reparenting guide for more information:
 	// https://vitess.io/docs/user-guides/configuration-advanced/reparenting/#external-reparenting.
 	TabletExternallyReparented(context.Context, *vtctldata.TabletExternallyReparentedRequest) (*vtctldata.TabletExternallyReparentedResponse, error)
+	// ThrottleApp throttles an app on the tablet throttlers of all shards of a
+	// keyspace, with a ratio and an expiry, by storing a rule in the keyspace
+	// record.
+	ThrottleApp(context.Context, *vtctldata.ThrottleAppRequest) (*vtctldata.ThrottleAppResponse, error)
+	// UnthrottleApp removes the throttling rule of an app from a keyspace.
+	UnthrottleApp(context.Context, *vtctldata.UnthrottleAppRequest) (*vtctldata.UnthrottleAppResponse, error)
+	// VDiff compares the source and the target tables of a workflow.
+	VDiff(context.Context, *vtctldata.VDiffRequest) (*vtctldata.VDiffResponse, error)
+	// WorkflowAction starts, stops or deletes the vreplication streams of a
+	// workflow on all of its target primaries.
+	WorkflowAction(context.Context, *vtctldata.WorkflowActionRequest) (*vtctldata.WorkflowActionResponse, error)
 }
 
 // UnimplementedVtctldServer can be embedded to have forward compatible implementations.
 type UnimplementedVtctldServer struct {
 }
 
+func (*UnimplementedVtctldServer) ApplySchema(ctx context.Context, req *vtctldata.ApplySchemaRequest) (*vtctldata.ApplySchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySchema not implemented")
+}
+func (*UnimplementedVtctldServer) ApplyVSchema(ctx context.Context, req *vtctldata.ApplyVSchemaRequest) (*vtctldata.ApplyVSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyVSchema not implemented")
+}
+func (*UnimplementedVtctldServer) Backup(req *vtctldata.BackupRequest, srv Vtctld_BackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
 func (*UnimplementedVtctldServer) ChangeTabletType(ctx context.Context, req *vtctldata.ChangeTabletTypeRequest) (*vtctldata.ChangeTabletTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeTabletType not implemented")
 }
@@ -679,12 +1003,36 @@ func (*UnimplementedVtctldServer) GetTablets(ctx context.Context, req *vtctldata
 func (*UnimplementedVtctldServer) GetVSchema(ctx context.Context, req *vtctldata.GetVSchemaRequest) (*vtctldata.GetVSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVSchema not implemented")
 }
+func (*UnimplementedVtctldServer) GetWorkflow(ctx context.Context, req *vtctldata.GetWorkflowRequest) (*vtctldata.GetWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflow not implemented")
+}
+func (*UnimplementedVtctldServer) GetWorkflows(ctx context.Context, req *vtctldata.GetWorkflowsRequest) (*vtctldata.GetWorkflowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflows not implemented")
+}
+func (*UnimplementedVtctldServer) GetWorkflowProgress(ctx context.Context, req *vtctldata.GetWorkflowProgressRequest) (*vtctldata.GetWorkflowProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowProgress not implemented")
+}
+func (*UnimplementedVtctldServer) GetWorkflowStatus(ctx context.Context, req *vtctldata.GetWorkflowStatusRequest) (*vtctldata.GetWorkflowStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowStatus not implemented")
+}
+func (*UnimplementedVtctldServer) GetWorkflowStatuses(ctx context.Context, req *vtctldata.GetWorkflowStatusesRequest) (*vtctldata.GetWorkflowStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowStatuses not implemented")
+}
 func (*UnimplementedVtctldServer) InitShardPrimary(ctx context.Context, req *vtctldata.InitShardPrimaryRequest) (*vtctldata.InitShardPrimaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitShardPrimary not implemented")
 }
+func (*UnimplementedVtctldServer) MoveTables(ctx context.Context, req *vtctldata.MoveTablesRequest) (*vtctldata.MoveTablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTables not implemented")
+}
 func (*UnimplementedVtctldServer) PlannedReparentShard(ctx context.Context, req *vtctldata.PlannedReparentShardRequest) (*vtctldata.PlannedReparentShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlannedReparentShard not implemented")
 }
+func (*UnimplementedVtctldServer) RebuildKeyspaceGraph(ctx context.Context, req *vtctldata.RebuildKeyspaceGraphRequest) (*vtctldata.RebuildKeyspaceGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildKeyspaceGraph not implemented")
+}
+func (*UnimplementedVtctldServer) RefreshState(ctx context.Context, req *vtctldata.RefreshStateRequest) (*vtctldata.RefreshStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshState not implemented")
+}
 func (*UnimplementedVtctldServer) RemoveKeyspaceCell(ctx context.Context, req *vtctldata.RemoveKeyspaceCellRequest) (*vtctldata.RemoveKeyspaceCellResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveKeyspaceCell not implemented")
 }
@@ -694,14 +1042,89 @@ func (*UnimplementedVtctldServer) RemoveShardCell(ctx context.Context, req *vtct
 func (*UnimplementedVtctldServer) ReparentTablet(ctx context.Context, req *vtctldata.ReparentTabletRequest) (*vtctldata.ReparentTabletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReparentTablet not implemented")
 }
+func (*UnimplementedVtctldServer) Reshard(ctx context.Context, req *vtctldata.ReshardRequest) (*vtctldata.ReshardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reshard not implemented")
+}
+func (*UnimplementedVtctldServer) RestoreFromBackup(req *vtctldata.RestoreFromBackupRequest, srv Vtctld_RestoreFromBackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreFromBackup not implemented")
+}
 func (*UnimplementedVtctldServer) TabletExternallyReparented(ctx context.Context, req *vtctldata.TabletExternallyReparentedRequest) (*vtctldata.TabletExternallyReparentedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TabletExternallyReparented not implemented")
 }
+func (*UnimplementedVtctldServer) ThrottleApp(ctx context.Context, req *vtctldata.ThrottleAppRequest) (*vtctldata.ThrottleAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ThrottleApp not implemented")
+}
+func (*UnimplementedVtctldServer) UnthrottleApp(ctx context.Context, req *vtctldata.UnthrottleAppRequest) (*vtctldata.UnthrottleAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnthrottleApp not implemented")
+}
+func (*UnimplementedVtctldServer) VDiff(ctx context.Context, req *vtctldata.VDiffRequest) (*vtctldata.VDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VDiff not implemented")
+}
+func (*UnimplementedVtctldServer) WorkflowAction(ctx context.Context, req *vtctldata.WorkflowActionRequest) (*vtctldata.WorkflowActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowAction not implemented")
+}
 
 func RegisterVtctldServer(s *grpc.Server, srv VtctldServer) {
 	s.RegisterService(&_Vtctld_serviceDesc, srv)
 }
 
+func _Vtctld_ApplySchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ApplySchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ApplySchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ApplySchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ApplySchema(ctx, req.(*vtctldata.ApplySchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ApplyVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ApplyVSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ApplyVSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ApplyVSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ApplyVSchema(ctx, req.(*vtctldata.ApplyVSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.BackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).Backup(m, &vtctldBackupServer{stream})
+}
+
+type Vtctld_BackupServer interface {
+	Send(*vtctldata.BackupResponse) error
+	grpc.ServerStream
+}
+
+type vtctldBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldBackupServer) Send(m *vtctldata.BackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Vtctld_ChangeTabletType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.ChangeTabletTypeRequest)
 	if err := dec(in); err != nil {
@@ -1062,6 +1485,96 @@ func _Vtctld_GetVSchema_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetWorkflow(ctx, req.(*vtctldata.GetWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetWorkflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetWorkflows(ctx, req.(*vtctldata.GetWorkflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetWorkflowProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetWorkflowProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetWorkflowProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetWorkflowProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetWorkflowProgress(ctx, req.(*vtctldata.GetWorkflowProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetWorkflowStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetWorkflowStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetWorkflowStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetWorkflowStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetWorkflowStatus(ctx, req.(*vtctldata.GetWorkflowStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetWorkflowStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetWorkflowStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetWorkflowStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetWorkflowStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetWorkflowStatuses(ctx, req.(*vtctldata.GetWorkflowStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_InitShardPrimary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.InitShardPrimaryRequest)
 	if err := dec(in); err != nil {
@@ -1080,6 +1593,24 @@ func _Vtctld_InitShardPrimary_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_MoveTables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.MoveTablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).MoveTables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/MoveTables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).MoveTables(ctx, req.(*vtctldata.MoveTablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_PlannedReparentShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.PlannedReparentShardRequest)
 	if err := dec(in); err != nil {
@@ -1098,6 +1629,42 @@ func _Vtctld_PlannedReparentShard_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_RebuildKeyspaceGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RebuildKeyspaceGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RebuildKeyspaceGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RebuildKeyspaceGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RebuildKeyspaceGraph(ctx, req.(*vtctldata.RebuildKeyspaceGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RefreshState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RefreshStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RefreshState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RefreshState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RefreshState(ctx, req.(*vtctldata.RefreshStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_RemoveKeyspaceCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.RemoveKeyspaceCellRequest)
 	if err := dec(in); err != nil {
@@ -1152,6 +1719,45 @@ func _Vtctld_ReparentTablet_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_Reshard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ReshardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).Reshard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/Reshard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).Reshard(ctx, req.(*vtctldata.ReshardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RestoreFromBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtctldata.RestoreFromBackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VtctldServer).RestoreFromBackup(m, &vtctldRestoreFromBackupServer{stream})
+}
+
+type Vtctld_RestoreFromBackupServer interface {
+	Send(*vtctldata.RestoreFromBackupResponse) error
+	grpc.ServerStream
+}
+
+type vtctldRestoreFromBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *vtctldRestoreFromBackupServer) Send(m *vtctldata.RestoreFromBackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Vtctld_TabletExternallyReparented_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.TabletExternallyReparentedRequest)
 	if err := dec(in); err != nil {
@@ -1170,10 +1776,90 @@ func _Vtctld_TabletExternallyReparented_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_ThrottleApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ThrottleAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ThrottleApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ThrottleApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ThrottleApp(ctx, req.(*vtctldata.ThrottleAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_UnthrottleApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.UnthrottleAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).UnthrottleApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/UnthrottleApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).UnthrottleApp(ctx, req.(*vtctldata.UnthrottleAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_VDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.VDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).VDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/VDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).VDiff(ctx, req.(*vtctldata.VDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WorkflowAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.WorkflowActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).WorkflowAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/WorkflowAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).WorkflowAction(ctx, req.(*vtctldata.WorkflowActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Vtctld_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "vtctlservice.Vtctld",
 	HandlerType: (*VtctldServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ApplySchema",
+			Handler:    _Vtctld_ApplySchema_Handler,
+		},
+		{
+			MethodName: "ApplyVSchema",
+			Handler:    _Vtctld_ApplyVSchema_Handler,
+		},
 		{
 			MethodName: "ChangeTabletType",
 			Handler:    _Vtctld_ChangeTabletType_Handler,
@@ -1254,14 +1940,46 @@ var _Vtctld_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetVSchema",
 			Handler:    _Vtctld_GetVSchema_Handler,
 		},
+		{
+			MethodName: "GetWorkflow",
+			Handler:    _Vtctld_GetWorkflow_Handler,
+		},
+		{
+			MethodName: "GetWorkflows",
+			Handler:    _Vtctld_GetWorkflows_Handler,
+		},
+		{
+			MethodName: "GetWorkflowProgress",
+			Handler:    _Vtctld_GetWorkflowProgress_Handler,
+		},
+		{
+			MethodName: "GetWorkflowStatus",
+			Handler:    _Vtctld_GetWorkflowStatus_Handler,
+		},
+		{
+			MethodName: "GetWorkflowStatuses",
+			Handler:    _Vtctld_GetWorkflowStatuses_Handler,
+		},
 		{
 			MethodName: "InitShardPrimary",
 			Handler:    _Vtctld_InitShardPrimary_Handler,
 		},
+		{
+			MethodName: "MoveTables",
+			Handler:    _Vtctld_MoveTables_Handler,
+		},
 		{
 			MethodName: "PlannedReparentShard",
 			Handler:    _Vtctld_PlannedReparentShard_Handler,
 		},
+		{
+			MethodName: "RebuildKeyspaceGraph",
+			Handler:    _Vtctld_RebuildKeyspaceGraph_Handler,
+		},
+		{
+			MethodName: "RefreshState",
+			Handler:    _Vtctld_RefreshState_Handler,
+		},
 		{
 			MethodName: "RemoveKeyspaceCell",
 			Handler:    _Vtctld_RemoveKeyspaceCell_Handler,
@@ -1274,11 +1992,42 @@ var _Vtctld_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReparentTablet",
 			Handler:    _Vtctld_ReparentTablet_Handler,
 		},
+		{
+			MethodName: "Reshard",
+			Handler:    _Vtctld_Reshard_Handler,
+		},
 		{
 			MethodName: "TabletExternallyReparented",
 			Handler:    _Vtctld_TabletExternallyReparented_Handler,
 		},
+		{
+			MethodName: "ThrottleApp",
+			Handler:    _Vtctld_ThrottleApp_Handler,
+		},
+		{
+			MethodName: "UnthrottleApp",
+			Handler:    _Vtctld_UnthrottleApp_Handler,
+		},
+		{
+			MethodName: "VDiff",
+			Handler:    _Vtctld_VDiff_Handler,
+		},
+		{
+			MethodName: "WorkflowAction",
+			Handler:    _Vtctld_WorkflowAction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Backup",
+			Handler:       _Vtctld_Backup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RestoreFromBackup",
+			Handler:       _Vtctld_RestoreFromBackup_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "vtctlservice.proto",
 }
@@ -161,7 +161,10 @@ type Session struct {
 	// Session UUID
 	SessionUUID string `protobuf:"bytes,22,opt,name=SessionUUID,proto3" json:"SessionUUID,omitempty"`
 	// enable_system_settings defines if we can use reserved connections.
-	EnableSystemSettings bool     `protobuf:"varint,23,opt,name=enable_system_settings,json=enableSystemSettings,proto3" json:"enable_system_settings,omitempty"`
+	EnableSystemSettings bool `protobuf:"varint,23,opt,name=enable_system_settings,json=enableSystemSettings,proto3" json:"enable_system_settings,omitempty"`
+	// max_replica_lag, in seconds, restricts replica reads to tablets
+	// reporting a replication lag within this bound. 0 means no restriction.
+	MaxReplicaLag        float64  `protobuf:"fixed64,24,opt,name=max_replica_lag,json=maxReplicaLag,proto3" json:"max_replica_lag,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -354,6 +357,13 @@ func (m *Session) GetEnableSystemSettings() bool {
 	return false
 }
 
+func (m *Session) GetMaxReplicaLag() float64 {
+	if m != nil {
+		return m.MaxReplicaLag
+	}
+	return 0
+}
+
 type Session_ShardSession struct {
 	Target        *query.Target         `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	TransactionId int64                 `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
@@ -429,12 +439,16 @@ func (m *Session_ShardSession) GetReservedId() int64 {
 // ReadAfterWrite contains information regarding gtid set and timeout
 // Also if the gtid information needs to be passed to client.
 type ReadAfterWrite struct {
-	ReadAfterWriteGtid    string   `protobuf:"bytes,1,opt,name=read_after_write_gtid,json=readAfterWriteGtid,proto3" json:"read_after_write_gtid,omitempty"`
-	ReadAfterWriteTimeout float64  `protobuf:"fixed64,2,opt,name=read_after_write_timeout,json=readAfterWriteTimeout,proto3" json:"read_after_write_timeout,omitempty"`
-	SessionTrackGtids     bool     `protobuf:"varint,3,opt,name=session_track_gtids,json=sessionTrackGtids,proto3" json:"session_track_gtids,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
-	XXX_unrecognized      []byte   `json:"-"`
-	XXX_sizecache         int32    `json:"-"`
+	ReadAfterWriteGtid    string  `protobuf:"bytes,1,opt,name=read_after_write_gtid,json=readAfterWriteGtid,proto3" json:"read_after_write_gtid,omitempty"`
+	ReadAfterWriteTimeout float64 `protobuf:"fixed64,2,opt,name=read_after_write_timeout,json=readAfterWriteTimeout,proto3" json:"read_after_write_timeout,omitempty"`
+	SessionTrackGtids     bool    `protobuf:"varint,3,opt,name=session_track_gtids,json=sessionTrackGtids,proto3" json:"session_track_gtids,omitempty"`
+	// shard_gtids maps keyspace/shard to the GTID set executed by its master when
+	// the session's last write to it finished. It is tracked if session_track_gtids
+	// is set, and replica reads of the shard wait for it.
+	ShardGtids           map[string]string `protobuf:"bytes,4,rep,name=shard_gtids,json=shardGtids,proto3" json:"shard_gtids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ReadAfterWrite) Reset()         { *m = ReadAfterWrite{} }
@@ -491,6 +505,13 @@ func (m *ReadAfterWrite) GetSessionTrackGtids() bool {
 	return false
 }
 
+func (m *ReadAfterWrite) GetShardGtids() map[string]string {
+	if m != nil {
+		return m.ShardGtids
+	}
+	return nil
+}
+
 // ExecuteRequest is the payload to Execute.
 type ExecuteRequest struct {
 	// caller_id identifies the caller. This is the effective caller ID,
@@ -1202,6 +1223,7 @@ func init() {
 	proto.RegisterMapType((map[string]*query.BindVariable)(nil), "vtgate.Session.UserDefinedVariablesEntry")
 	proto.RegisterType((*Session_ShardSession)(nil), "vtgate.Session.ShardSession")
 	proto.RegisterType((*ReadAfterWrite)(nil), "vtgate.ReadAfterWrite")
+	proto.RegisterMapType((map[string]string)(nil), "vtgate.ReadAfterWrite.ShardGtidsEntry")
 	proto.RegisterType((*ExecuteRequest)(nil), "vtgate.ExecuteRequest")
 	proto.RegisterType((*ExecuteResponse)(nil), "vtgate.ExecuteResponse")
 	proto.RegisterType((*ExecuteBatchRequest)(nil), "vtgate.ExecuteBatchRequest")
@@ -1217,95 +1239,99 @@ func init() {
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 1406 bytes of a gzipped FileDescriptorProto
+	// 1465 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0x1b, 0x37,
-	0x16, 0xce, 0xe8, 0x5f, 0x47, 0x7f, 0x63, 0x5a, 0x76, 0x26, 0xde, 0xac, 0x57, 0x50, 0x12, 0x44,
-	0xc9, 0x2e, 0xec, 0x5d, 0x6f, 0x8b, 0x06, 0x45, 0x8b, 0xd6, 0x96, 0x9d, 0x54, 0x81, 0x1d, 0xb9,
-	0x94, 0x6c, 0x03, 0x45, 0x8b, 0xc1, 0x58, 0x43, 0xcb, 0x84, 0xe5, 0xa1, 0x42, 0x52, 0x72, 0xf5,
-	0x14, 0xbd, 0x2d, 0xfa, 0x02, 0xbd, 0xe9, 0x7d, 0x5f, 0xa1, 0xe8, 0x55, 0xf3, 0x06, 0x45, 0xfa,
-	0x22, 0x05, 0xc9, 0x19, 0x79, 0xa4, 0xb8, 0x8d, 0x93, 0x20, 0x37, 0xc2, 0xf0, 0x7c, 0x87, 0x87,
-	0x87, 0xe7, 0x3b, 0x3f, 0x14, 0x14, 0xc7, 0xb2, 0xef, 0x49, 0xb2, 0x36, 0xe4, 0x4c, 0x32, 0x94,
-	0x31, 0xab, 0x15, 0xfb, 0x98, 0x06, 0x03, 0xd6, 0xf7, 0x3d, 0xe9, 0x19, 0x64, 0xa5, 0xf0, 0x7c,
-	0x44, 0xf8, 0x24, 0x5c, 0x94, 0x25, 0x1b, 0xb2, 0x38, 0x38, 0x96, 0x7c, 0xd8, 0x33, 0x8b, 0xfa,
-	0x8b, 0x02, 0x64, 0x3b, 0x44, 0x08, 0xca, 0x02, 0x74, 0x0f, 0xca, 0x34, 0x70, 0x25, 0xf7, 0x02,
-	0xe1, 0xf5, 0x24, 0x65, 0x81, 0x63, 0xd5, 0xac, 0x46, 0x0e, 0x97, 0x68, 0xd0, 0xbd, 0x14, 0xa2,
-	0x26, 0x94, 0xc5, 0xa9, 0xc7, 0x7d, 0x57, 0x98, 0x7d, 0xc2, 0x49, 0xd4, 0x92, 0x8d, 0xc2, 0xc6,
-	0xed, 0xb5, 0xd0, 0xbb, 0xd0, 0xde, 0x5a, 0x47, 0x69, 0x85, 0x0b, 0x5c, 0x12, 0xb1, 0x95, 0x40,
-	0xab, 0x00, 0xde, 0x48, 0xb2, 0x1e, 0x3b, 0x3f, 0xa7, 0xd2, 0x49, 0xe9, 0x73, 0x62, 0x12, 0x74,
-	0x07, 0x4a, 0xd2, 0xe3, 0x7d, 0x22, 0x5d, 0x21, 0x39, 0x0d, 0xfa, 0x4e, 0xba, 0x66, 0x35, 0xf2,
-	0xb8, 0x68, 0x84, 0x1d, 0x2d, 0x43, 0xeb, 0x90, 0x65, 0x43, 0xa9, 0x5d, 0xc8, 0xd4, 0xac, 0x46,
-	0x61, 0x63, 0x69, 0xcd, 0x5c, 0x7c, 0xe7, 0x5b, 0xd2, 0x1b, 0x49, 0xd2, 0x36, 0x20, 0x8e, 0xb4,
-	0xd0, 0x16, 0xd8, 0xb1, 0xeb, 0xb9, 0xe7, 0xcc, 0x27, 0x4e, 0xb6, 0x66, 0x35, 0xca, 0x1b, 0x37,
-	0x23, 0xe7, 0x63, 0x37, 0xdd, 0x63, 0x3e, 0xc1, 0x15, 0x39, 0x2b, 0x40, 0xeb, 0x90, 0xbb, 0xf0,
-	0x78, 0x40, 0x83, 0xbe, 0x70, 0x72, 0xfa, 0xe2, 0x8b, 0xe1, 0xa9, 0x5f, 0xaa, 0xdf, 0x23, 0x83,
-	0xe1, 0xa9, 0x12, 0xfa, 0x0c, 0x8a, 0x43, 0x4e, 0x2e, 0xa3, 0x95, 0xbf, 0x46, 0xb4, 0x0a, 0x43,
-	0x4e, 0xa6, 0xb1, 0xda, 0x84, 0xd2, 0x90, 0x09, 0x79, 0x69, 0x01, 0xae, 0x61, 0xa1, 0xa8, 0xb6,
-	0x4c, 0x4d, 0xdc, 0x85, 0xf2, 0xc0, 0x13, 0xd2, 0xa5, 0x81, 0x20, 0x5c, 0xba, 0xd4, 0x77, 0x0a,
-	0x35, 0xab, 0x91, 0xc2, 0x45, 0x25, 0x6d, 0x69, 0x61, 0xcb, 0x47, 0xff, 0x04, 0x38, 0x61, 0xa3,
-	0xc0, 0x77, 0x39, 0xbb, 0x10, 0x4e, 0x51, 0x6b, 0xe4, 0xb5, 0x04, 0xb3, 0x0b, 0x81, 0x5c, 0x58,
-	0x1e, 0x09, 0xc2, 0x5d, 0x9f, 0x9c, 0xd0, 0x80, 0xf8, 0xee, 0xd8, 0xe3, 0xd4, 0x3b, 0x1e, 0x10,
-	0xe1, 0x94, 0xb4, 0x43, 0x0f, 0xe6, 0x1d, 0x3a, 0x10, 0x84, 0x6f, 0x1b, 0xe5, 0xc3, 0x48, 0x77,
-	0x27, 0x90, 0x7c, 0x82, 0xab, 0xa3, 0x2b, 0x20, 0xd4, 0x06, 0x5b, 0x4c, 0x84, 0x24, 0xe7, 0x31,
-	0xd3, 0x65, 0x6d, 0xfa, 0xee, 0x2b, 0x77, 0xd5, 0x7a, 0x73, 0x56, 0x2b, 0x62, 0x56, 0x8a, 0xfe,
-	0x01, 0x79, 0xce, 0x2e, 0xdc, 0x1e, 0x1b, 0x05, 0xd2, 0xa9, 0xd4, 0xac, 0x46, 0x12, 0xe7, 0x38,
-	0xbb, 0x68, 0xaa, 0xb5, 0x4a, 0x41, 0xe1, 0x8d, 0xc9, 0x90, 0xd1, 0x40, 0x0a, 0xc7, 0xae, 0x25,
-	0x1b, 0x79, 0x1c, 0x93, 0xa0, 0x06, 0xd8, 0x34, 0x70, 0x39, 0x11, 0x84, 0x8f, 0x89, 0xef, 0xf6,
-	0x58, 0x10, 0x38, 0x0b, 0x3a, 0x51, 0xcb, 0x34, 0xc0, 0xa1, 0xb8, 0xc9, 0x82, 0x40, 0x31, 0x3c,
-	0x60, 0xbd, 0xb3, 0x88, 0x20, 0x07, 0xe9, 0x64, 0x7c, 0x0d, 0xc3, 0x6a, 0x47, 0x54, 0x79, 0x6b,
-	0xb0, 0xa8, 0xe9, 0xd1, 0x56, 0x4e, 0x89, 0xc7, 0xe5, 0x31, 0xf1, 0xa4, 0xb3, 0xa8, 0x3d, 0x5e,
-	0x50, 0xd0, 0x2e, 0xeb, 0x9d, 0x7d, 0x11, 0x01, 0xe8, 0x73, 0xb0, 0x39, 0xf1, 0x7c, 0xd7, 0x3b,
-	0x91, 0x84, 0xbb, 0x17, 0x9c, 0x4a, 0xe2, 0x54, 0xf5, 0xa1, 0xcb, 0xd1, 0xa1, 0x98, 0x78, 0xfe,
-	0xa6, 0x82, 0x8f, 0x14, 0x8a, 0xcb, 0x7c, 0x66, 0x8d, 0x6a, 0x50, 0xd8, 0xde, 0xde, 0xed, 0x48,
-	0xee, 0x49, 0xd2, 0x9f, 0x38, 0x4b, 0xba, 0xba, 0xe2, 0x22, 0xa5, 0x11, 0xba, 0x77, 0x70, 0xd0,
-	0xda, 0x76, 0x96, 0x8d, 0x46, 0x4c, 0x84, 0x3e, 0x80, 0x65, 0x12, 0xa8, 0x40, 0xbb, 0x21, 0x6b,
-	0x82, 0x48, 0xa9, 0xeb, 0xe2, 0xa6, 0x0e, 0x53, 0xd5, 0xa0, 0x86, 0xaa, 0x4e, 0x88, 0xad, 0xfc,
-	0x6c, 0x41, 0x31, 0x1e, 0x09, 0x74, 0x0f, 0x32, 0xa6, 0xaa, 0x75, 0xbb, 0x29, 0x6c, 0x94, 0xc2,
-	0x72, 0xea, 0x6a, 0x21, 0x0e, 0x41, 0xd5, 0x9d, 0xe2, 0xb5, 0x4b, 0x7d, 0x27, 0xa1, 0xc3, 0x53,
-	0x8a, 0x49, 0x5b, 0x3e, 0x7a, 0x04, 0x45, 0xa9, 0x4e, 0x95, 0xae, 0x37, 0xa0, 0x9e, 0x70, 0x92,
-	0x61, 0x63, 0x98, 0x36, 0xc1, 0xae, 0x46, 0x37, 0x15, 0x88, 0x0b, 0xf2, 0x72, 0x81, 0xfe, 0x05,
-	0x85, 0x29, 0xd9, 0xd4, 0xd7, 0x3d, 0x29, 0x89, 0x21, 0x12, 0xb5, 0xfc, 0x95, 0xaf, 0xe1, 0xd6,
-	0x5f, 0x66, 0x34, 0xb2, 0x21, 0x79, 0x46, 0x26, 0xfa, 0x0a, 0x79, 0xac, 0x3e, 0xd1, 0x03, 0x48,
-	0x8f, 0xbd, 0xc1, 0x88, 0x68, 0x3f, 0x2f, 0xbb, 0xc4, 0x16, 0x0d, 0xa6, 0x7b, 0xb1, 0xd1, 0xf8,
-	0x38, 0xf1, 0xc8, 0x5a, 0xd9, 0x82, 0xea, 0x55, 0x49, 0x7d, 0x85, 0xe1, 0x6a, 0xdc, 0x70, 0x3e,
-	0x66, 0xe3, 0x69, 0x2a, 0x97, 0xb4, 0x53, 0xf5, 0x9f, 0x2c, 0x28, 0xcf, 0xd2, 0x8f, 0xfe, 0x07,
-	0x4b, 0xf3, 0x09, 0xe3, 0xf6, 0x25, 0xf5, 0x43, 0xb3, 0x68, 0x36, 0x3b, 0x9e, 0x48, 0xea, 0xa3,
-	0x8f, 0xc0, 0x79, 0x65, 0x8b, 0xa4, 0xe7, 0x84, 0x8d, 0xa4, 0x3e, 0xd8, 0xc2, 0x4b, 0xb3, 0xbb,
-	0xba, 0x06, 0x54, 0xc9, 0x1c, 0x16, 0x82, 0x9a, 0x25, 0xbd, 0x33, 0x7d, 0x90, 0x21, 0x22, 0x87,
-	0x17, 0x42, 0xa8, 0xab, 0x10, 0x75, 0x8e, 0xa8, 0xff, 0x98, 0x80, 0x72, 0xd8, 0xb0, 0x31, 0x79,
-	0x3e, 0x22, 0x42, 0xa2, 0xff, 0x40, 0xbe, 0xe7, 0x0d, 0x06, 0x84, 0xbb, 0xa1, 0x8b, 0x85, 0x8d,
-	0xca, 0x9a, 0x19, 0x5b, 0x4d, 0x2d, 0x6f, 0x6d, 0xe3, 0x9c, 0xd1, 0x68, 0xf9, 0xe8, 0x01, 0x64,
-	0xa3, 0xca, 0x4b, 0x4c, 0x75, 0xe3, 0x95, 0x87, 0x23, 0x1c, 0xdd, 0x87, 0xb4, 0x66, 0x21, 0x4c,
-	0x8b, 0x85, 0x88, 0x13, 0xd5, 0xe3, 0x74, 0xfb, 0xc6, 0x06, 0x47, 0x1f, 0x42, 0x98, 0x1b, 0xae,
-	0x9c, 0x0c, 0x89, 0x4e, 0x86, 0xf2, 0x46, 0x75, 0x3e, 0x8b, 0xba, 0x93, 0x21, 0xc1, 0x20, 0xa7,
-	0xdf, 0x2a, 0x49, 0xcf, 0xc8, 0x44, 0x0c, 0xbd, 0x1e, 0x71, 0xf5, 0xc0, 0xd3, 0x83, 0x29, 0x8f,
-	0x4b, 0x91, 0x54, 0x67, 0x7e, 0x7c, 0x70, 0x65, 0xaf, 0x33, 0xb8, 0x9e, 0xa6, 0x72, 0x69, 0x3b,
-	0x53, 0xff, 0xce, 0x82, 0xca, 0x34, 0x52, 0x62, 0xc8, 0x02, 0xa1, 0x4e, 0x4c, 0x13, 0xce, 0x19,
-	0x9f, 0x0b, 0x13, 0xde, 0x6f, 0xee, 0x28, 0x31, 0x36, 0xe8, 0x9b, 0xc4, 0xe8, 0x21, 0x64, 0x38,
-	0x11, 0xa3, 0x81, 0x0c, 0x83, 0x84, 0xe2, 0xe3, 0x0d, 0x6b, 0x04, 0x87, 0x1a, 0xf5, 0x17, 0x09,
-	0x58, 0x0c, 0x3d, 0xda, 0xf2, 0x64, 0xef, 0xf4, 0xbd, 0x13, 0xf8, 0x6f, 0xc8, 0x2a, 0x6f, 0x28,
-	0x51, 0x09, 0x95, 0xbc, 0x9a, 0xc2, 0x48, 0xe3, 0x1d, 0x48, 0xf4, 0xc4, 0xcc, 0x3b, 0x28, 0x6d,
-	0xde, 0x41, 0x9e, 0x88, 0xbf, 0x83, 0xde, 0x13, 0xd7, 0xf5, 0x1f, 0x2c, 0xa8, 0xce, 0xc6, 0xf4,
-	0xbd, 0x51, 0xfd, 0x5f, 0xc8, 0x1a, 0x22, 0xa3, 0x68, 0x2e, 0x87, 0xbe, 0x19, 0x9a, 0x8f, 0xa8,
-	0x3c, 0x35, 0xa6, 0x23, 0x35, 0x55, 0xac, 0xd5, 0x8e, 0xe4, 0xc4, 0x3b, 0x7f, 0xa7, 0x92, 0x9d,
-	0xd6, 0x61, 0xe2, 0xcd, 0xea, 0x30, 0xf9, 0xd6, 0x75, 0x98, 0x7a, 0x0d, 0x37, 0xe9, 0x6b, 0x3d,
-	0x20, 0x63, 0xb1, 0xcd, 0xfc, 0x7d, 0x6c, 0xeb, 0x4d, 0x58, 0x9a, 0x0b, 0x54, 0x48, 0xe3, 0x65,
-	0x7d, 0x59, 0xaf, 0xad, 0xaf, 0x6f, 0xe0, 0x16, 0x26, 0x82, 0x0d, 0xc6, 0x24, 0x96, 0x79, 0x6f,
-	0x17, 0x72, 0x04, 0x29, 0x5f, 0x86, 0x53, 0x33, 0x8f, 0xf5, 0x77, 0xfd, 0x36, 0xac, 0x5c, 0x65,
-	0xde, 0x38, 0x5a, 0xff, 0xd5, 0x82, 0xf2, 0xa1, 0xb9, 0xc3, 0xdb, 0x1d, 0x39, 0x47, 0x5e, 0xe2,
-	0x9a, 0xe4, 0xdd, 0x87, 0xf4, 0x58, 0x0f, 0xa7, 0xa8, 0x49, 0xc7, 0xfe, 0xdf, 0x1c, 0xaa, 0x99,
-	0x81, 0x0d, 0xae, 0x22, 0x79, 0x42, 0x07, 0x92, 0x70, 0xcd, 0xae, 0x8a, 0x64, 0x4c, 0xf3, 0xb1,
-	0x46, 0x70, 0xa8, 0x51, 0xff, 0x14, 0x2a, 0xd3, 0xbb, 0x5c, 0x12, 0x41, 0xc6, 0x44, 0x3d, 0xfe,
-	0x2c, 0x9d, 0xfc, 0x33, 0xdb, 0x0f, 0x77, 0x14, 0x84, 0x43, 0x8d, 0x87, 0xdb, 0x50, 0x99, 0xfb,
-	0x67, 0x80, 0x2a, 0x50, 0x38, 0x78, 0xd6, 0xd9, 0xdf, 0x69, 0xb6, 0x1e, 0xb7, 0x76, 0xb6, 0xed,
-	0x1b, 0x08, 0x20, 0xd3, 0x69, 0x3d, 0x7b, 0xb2, 0xbb, 0x63, 0x5b, 0x28, 0x0f, 0xe9, 0xbd, 0x83,
-	0xdd, 0x6e, 0xcb, 0x4e, 0xa8, 0xcf, 0xee, 0x51, 0x7b, 0xbf, 0x69, 0x27, 0x1f, 0x7e, 0x02, 0x85,
-	0xa6, 0xfe, 0x7f, 0xd3, 0xe6, 0x3e, 0xe1, 0x6a, 0xc3, 0xb3, 0x36, 0xde, 0xdb, 0xdc, 0xb5, 0x6f,
-	0xa0, 0x2c, 0x24, 0xf7, 0xb1, 0xda, 0x99, 0x83, 0xd4, 0x7e, 0xbb, 0xd3, 0xb5, 0x13, 0xa8, 0x0c,
-	0xb0, 0x79, 0xd0, 0x6d, 0x37, 0xdb, 0x7b, 0x7b, 0xad, 0xae, 0x9d, 0xdc, 0x7a, 0xfc, 0xcb, 0xcb,
-	0x55, 0xeb, 0xb7, 0x97, 0xab, 0xd6, 0xef, 0x2f, 0x57, 0xad, 0xef, 0xff, 0x58, 0xbd, 0x01, 0x15,
-	0xca, 0xd6, 0xc6, 0x54, 0x12, 0x21, 0xcc, 0xdf, 0xb9, 0xaf, 0xee, 0x84, 0x2b, 0xca, 0xd6, 0xcd,
-	0xd7, 0x7a, 0x9f, 0xad, 0x8f, 0xe5, 0xba, 0x46, 0xd7, 0x4d, 0xaa, 0x1e, 0x67, 0xf4, 0xea, 0xff,
-	0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x21, 0x3c, 0xb1, 0x4e, 0x0e, 0x00, 0x00,
+	0x16, 0xce, 0xe8, 0x5f, 0x47, 0x7f, 0x63, 0x5a, 0x76, 0x26, 0xde, 0xac, 0x57, 0x50, 0xfe, 0x94,
+	0xec, 0xc2, 0xde, 0xf5, 0xb6, 0x68, 0x50, 0x34, 0x68, 0x6d, 0xd9, 0x49, 0x15, 0xd8, 0x91, 0x4b,
+	0xc9, 0x36, 0x50, 0xb4, 0x18, 0xd0, 0x1a, 0x5a, 0x1e, 0x58, 0x1a, 0x2a, 0x24, 0x25, 0x47, 0x4f,
+	0xd1, 0xdb, 0xa2, 0x2f, 0xd0, 0x17, 0x28, 0xd0, 0x57, 0x28, 0x7a, 0xd5, 0xbe, 0x41, 0x91, 0x3e,
+	0x45, 0xef, 0x0a, 0x92, 0x23, 0x79, 0xa4, 0xb8, 0x8d, 0x93, 0x20, 0x37, 0xc2, 0xf0, 0x7c, 0x87,
+	0x87, 0x87, 0xdf, 0xf9, 0xa3, 0x20, 0x3f, 0x92, 0x5d, 0x22, 0xe9, 0xda, 0x80, 0x33, 0xc9, 0x50,
+	0xca, 0xac, 0x56, 0xec, 0x63, 0x3f, 0xe8, 0xb1, 0xae, 0x47, 0x24, 0x31, 0xc8, 0x4a, 0xee, 0xf9,
+	0x90, 0xf2, 0x71, 0xb8, 0x28, 0x4a, 0x36, 0x60, 0x51, 0x70, 0x24, 0xf9, 0xa0, 0x63, 0x16, 0xd5,
+	0x3f, 0x72, 0x90, 0x6e, 0x51, 0x21, 0x7c, 0x16, 0xa0, 0x3b, 0x50, 0xf4, 0x03, 0x57, 0x72, 0x12,
+	0x08, 0xd2, 0x91, 0x3e, 0x0b, 0x1c, 0xab, 0x62, 0xd5, 0x32, 0xb8, 0xe0, 0x07, 0xed, 0x0b, 0x21,
+	0xaa, 0x43, 0x51, 0x9c, 0x12, 0xee, 0xb9, 0xc2, 0xec, 0x13, 0x4e, 0xac, 0x12, 0xaf, 0xe5, 0x36,
+	0x6e, 0xae, 0x85, 0xde, 0x85, 0xf6, 0xd6, 0x5a, 0x4a, 0x2b, 0x5c, 0xe0, 0x82, 0x88, 0xac, 0x04,
+	0x5a, 0x05, 0x20, 0x43, 0xc9, 0x3a, 0xac, 0xdf, 0xf7, 0xa5, 0x93, 0xd0, 0xe7, 0x44, 0x24, 0xe8,
+	0x16, 0x14, 0x24, 0xe1, 0x5d, 0x2a, 0x5d, 0x21, 0xb9, 0x1f, 0x74, 0x9d, 0x64, 0xc5, 0xaa, 0x65,
+	0x71, 0xde, 0x08, 0x5b, 0x5a, 0x86, 0xd6, 0x21, 0xcd, 0x06, 0x52, 0xbb, 0x90, 0xaa, 0x58, 0xb5,
+	0xdc, 0xc6, 0xd2, 0x9a, 0xb9, 0xf8, 0xce, 0x0b, 0xda, 0x19, 0x4a, 0xda, 0x34, 0x20, 0x9e, 0x68,
+	0xa1, 0x2d, 0xb0, 0x23, 0xd7, 0x73, 0xfb, 0xcc, 0xa3, 0x4e, 0xba, 0x62, 0xd5, 0x8a, 0x1b, 0xd7,
+	0x27, 0xce, 0x47, 0x6e, 0xba, 0xc7, 0x3c, 0x8a, 0x4b, 0x72, 0x56, 0x80, 0xd6, 0x21, 0x73, 0x4e,
+	0x78, 0xe0, 0x07, 0x5d, 0xe1, 0x64, 0xf4, 0xc5, 0x17, 0xc3, 0x53, 0xbf, 0x50, 0xbf, 0x47, 0x06,
+	0xc3, 0x53, 0x25, 0xf4, 0x29, 0xe4, 0x07, 0x9c, 0x5e, 0xb0, 0x95, 0xbd, 0x02, 0x5b, 0xb9, 0x01,
+	0xa7, 0x53, 0xae, 0x36, 0xa1, 0x30, 0x60, 0x42, 0x5e, 0x58, 0x80, 0x2b, 0x58, 0xc8, 0xab, 0x2d,
+	0x53, 0x13, 0xb7, 0xa1, 0xd8, 0x23, 0x42, 0xba, 0x7e, 0x20, 0x28, 0x97, 0xae, 0xef, 0x39, 0xb9,
+	0x8a, 0x55, 0x4b, 0xe0, 0xbc, 0x92, 0x36, 0xb4, 0xb0, 0xe1, 0xa1, 0x7f, 0x02, 0x9c, 0xb0, 0x61,
+	0xe0, 0xb9, 0x9c, 0x9d, 0x0b, 0x27, 0xaf, 0x35, 0xb2, 0x5a, 0x82, 0xd9, 0xb9, 0x40, 0x2e, 0x2c,
+	0x0f, 0x05, 0xe5, 0xae, 0x47, 0x4f, 0xfc, 0x80, 0x7a, 0xee, 0x88, 0x70, 0x9f, 0x1c, 0xf7, 0xa8,
+	0x70, 0x0a, 0xda, 0xa1, 0xfb, 0xf3, 0x0e, 0x1d, 0x08, 0xca, 0xb7, 0x8d, 0xf2, 0xe1, 0x44, 0x77,
+	0x27, 0x90, 0x7c, 0x8c, 0xcb, 0xc3, 0x4b, 0x20, 0xd4, 0x04, 0x5b, 0x8c, 0x85, 0xa4, 0xfd, 0x88,
+	0xe9, 0xa2, 0x36, 0x7d, 0xfb, 0x95, 0xbb, 0x6a, 0xbd, 0x39, 0xab, 0x25, 0x31, 0x2b, 0x45, 0xff,
+	0x80, 0x2c, 0x67, 0xe7, 0x6e, 0x87, 0x0d, 0x03, 0xe9, 0x94, 0x2a, 0x56, 0x2d, 0x8e, 0x33, 0x9c,
+	0x9d, 0xd7, 0xd5, 0x5a, 0xa5, 0xa0, 0x20, 0x23, 0x3a, 0x60, 0x7e, 0x20, 0x85, 0x63, 0x57, 0xe2,
+	0xb5, 0x2c, 0x8e, 0x48, 0x50, 0x0d, 0x6c, 0x3f, 0x70, 0x39, 0x15, 0x94, 0x8f, 0xa8, 0xe7, 0x76,
+	0x58, 0x10, 0x38, 0x0b, 0x3a, 0x51, 0x8b, 0x7e, 0x80, 0x43, 0x71, 0x9d, 0x05, 0x81, 0x8a, 0x70,
+	0x8f, 0x75, 0xce, 0x26, 0x01, 0x72, 0x50, 0xc5, 0x7a, 0x6d, 0x7c, 0x72, 0x6a, 0x47, 0xb8, 0x40,
+	0x6b, 0xb0, 0xa8, 0xc3, 0xa3, 0xad, 0x9c, 0x52, 0xc2, 0xe5, 0x31, 0x25, 0xd2, 0x59, 0xd4, 0x1e,
+	0x2f, 0x28, 0x68, 0x97, 0x75, 0xce, 0x3e, 0x9f, 0x00, 0xe8, 0x33, 0xb0, 0x39, 0x25, 0x9e, 0x4b,
+	0x4e, 0x24, 0xe5, 0xee, 0x39, 0xf7, 0x25, 0x75, 0xca, 0xfa, 0xd0, 0xe5, 0xc9, 0xa1, 0x98, 0x12,
+	0x6f, 0x53, 0xc1, 0x47, 0x0a, 0xc5, 0x45, 0x3e, 0xb3, 0x46, 0x15, 0xc8, 0x6d, 0x6f, 0xef, 0xb6,
+	0x24, 0x27, 0x92, 0x76, 0xc7, 0xce, 0x92, 0xae, 0xae, 0xa8, 0x48, 0x69, 0x84, 0xee, 0x1d, 0x1c,
+	0x34, 0xb6, 0x9d, 0x65, 0xa3, 0x11, 0x11, 0xa1, 0x0f, 0x60, 0x99, 0x06, 0x8a, 0x68, 0x37, 0x8c,
+	0x9a, 0xa0, 0x52, 0xea, 0xba, 0xb8, 0xae, 0x69, 0x2a, 0x1b, 0xd4, 0x84, 0xaa, 0x15, 0x62, 0xe8,
+	0x2e, 0x94, 0xfa, 0xe4, 0x85, 0xcb, 0xe9, 0xa0, 0xe7, 0x77, 0x88, 0xdb, 0x23, 0x5d, 0xc7, 0xa9,
+	0x58, 0x35, 0x0b, 0x17, 0xfa, 0xe4, 0x05, 0x36, 0xd2, 0x5d, 0xd2, 0x5d, 0xf9, 0xd1, 0x82, 0x7c,
+	0x94, 0x31, 0x74, 0x07, 0x52, 0xa6, 0xfa, 0x75, 0x5b, 0xca, 0x6d, 0x14, 0xc2, 0xb2, 0x6b, 0x6b,
+	0x21, 0x0e, 0x41, 0xd5, 0xc5, 0xa2, 0x35, 0xee, 0x7b, 0x4e, 0x4c, 0xd3, 0x58, 0x88, 0x48, 0x1b,
+	0x1e, 0x7a, 0x08, 0x79, 0xa9, 0xbc, 0x93, 0x2e, 0xe9, 0xf9, 0x44, 0x38, 0xf1, 0xb0, 0x81, 0x4c,
+	0x9b, 0x65, 0x5b, 0xa3, 0x9b, 0x0a, 0xc4, 0x39, 0x79, 0xb1, 0x40, 0xff, 0x82, 0xdc, 0x34, 0x29,
+	0x7c, 0x4f, 0xf7, 0xae, 0x38, 0x86, 0x89, 0xa8, 0xe1, 0xad, 0x7c, 0x05, 0x37, 0xfe, 0x32, 0xf3,
+	0x91, 0x0d, 0xf1, 0x33, 0x3a, 0xd6, 0x57, 0xc8, 0x62, 0xf5, 0x89, 0xee, 0x43, 0x72, 0x44, 0x7a,
+	0x43, 0xaa, 0xfd, 0xbc, 0xe8, 0x26, 0x5b, 0x7e, 0x30, 0xdd, 0x8b, 0x8d, 0xc6, 0xc7, 0xb1, 0x87,
+	0xd6, 0xca, 0x16, 0x94, 0x2f, 0x4b, 0xfe, 0x4b, 0x0c, 0x97, 0xa3, 0x86, 0xb3, 0x11, 0x1b, 0x4f,
+	0x13, 0x99, 0xb8, 0x9d, 0xa8, 0xfe, 0x10, 0x83, 0xe2, 0x6c, 0x9a, 0xa0, 0xff, 0xc1, 0xd2, 0x7c,
+	0x62, 0xb9, 0x5d, 0xe9, 0x7b, 0xa1, 0x59, 0x34, 0x9b, 0x45, 0x4f, 0xa4, 0xef, 0xa1, 0x8f, 0xc0,
+	0x79, 0x65, 0x8b, 0xf4, 0xfb, 0x94, 0x0d, 0xa5, 0x3e, 0xd8, 0xc2, 0x4b, 0xb3, 0xbb, 0xda, 0x06,
+	0x54, 0x49, 0x1f, 0x16, 0x8c, 0x9a, 0x39, 0x9d, 0x33, 0x7d, 0x90, 0x09, 0x44, 0x06, 0x2f, 0x84,
+	0x50, 0x5b, 0x21, 0xea, 0x1c, 0x81, 0x9e, 0x40, 0xce, 0xcc, 0x1d, 0xa3, 0x97, 0xd0, 0x8d, 0xe1,
+	0xee, 0xe5, 0xf9, 0x6e, 0x6a, 0x4d, 0xef, 0x33, 0xad, 0x01, 0xc4, 0x54, 0xb0, 0xf2, 0x08, 0x4a,
+	0x73, 0xf0, 0x9b, 0x90, 0x57, 0xfd, 0x3e, 0x06, 0xc5, 0x70, 0xc0, 0x60, 0xfa, 0x7c, 0x48, 0x85,
+	0x44, 0xff, 0x81, 0x6c, 0x87, 0xf4, 0x7a, 0x94, 0xbb, 0x21, 0x55, 0xb9, 0x8d, 0xd2, 0x9a, 0x19,
+	0xb3, 0x75, 0x2d, 0x6f, 0x6c, 0xe3, 0x8c, 0xd1, 0x68, 0x78, 0xe8, 0x3e, 0xa4, 0x27, 0x9d, 0x22,
+	0x36, 0xd5, 0x8d, 0x76, 0x0a, 0x3c, 0xc1, 0xd1, 0x3d, 0x48, 0xea, 0x6c, 0x08, 0xd3, 0x73, 0x61,
+	0x92, 0x1b, 0xaa, 0x27, 0xeb, 0x71, 0x83, 0x0d, 0x8e, 0x3e, 0x84, 0x30, 0x47, 0x5d, 0x39, 0x1e,
+	0x50, 0x9d, 0x94, 0xc5, 0x8d, 0xf2, 0x7c, 0x36, 0xb7, 0xc7, 0x03, 0x8a, 0x41, 0x4e, 0xbf, 0x55,
+	0xb1, 0x9c, 0xd1, 0xb1, 0x18, 0x90, 0x0e, 0x75, 0x35, 0x43, 0x7a, 0x90, 0x66, 0x71, 0x61, 0x22,
+	0xd5, 0x44, 0x45, 0x07, 0x6d, 0xfa, 0x2a, 0x83, 0xf6, 0x69, 0x22, 0x93, 0xb4, 0x53, 0xd5, 0x6f,
+	0x2c, 0x28, 0x4d, 0x99, 0x12, 0x03, 0x16, 0x08, 0x75, 0x62, 0x92, 0x72, 0xce, 0xf8, 0x1c, 0x4d,
+	0x78, 0xbf, 0xbe, 0xa3, 0xc4, 0xd8, 0xa0, 0x6f, 0xc2, 0xd1, 0x03, 0x48, 0x71, 0x2a, 0x86, 0x3d,
+	0x19, 0x92, 0x84, 0xa2, 0xe3, 0x18, 0x6b, 0x04, 0x87, 0x1a, 0xd5, 0x5f, 0x63, 0xb0, 0x18, 0x7a,
+	0xb4, 0x45, 0x64, 0xe7, 0xf4, 0xbd, 0x07, 0xf0, 0xdf, 0x90, 0x56, 0xde, 0xf8, 0x54, 0x25, 0x76,
+	0xfc, 0xf2, 0x10, 0x4e, 0x34, 0xde, 0x21, 0x88, 0x44, 0xcc, 0xbc, 0xdb, 0x92, 0xe6, 0xdd, 0x46,
+	0x44, 0xf4, 0xdd, 0xf6, 0x9e, 0x62, 0x5d, 0xfd, 0xce, 0x82, 0xf2, 0x2c, 0xa7, 0xef, 0x2d, 0xd4,
+	0xff, 0x85, 0xb4, 0x09, 0xe4, 0x84, 0xcd, 0xe5, 0xd0, 0x37, 0x13, 0xe6, 0x23, 0x5f, 0x9e, 0x1a,
+	0xd3, 0x13, 0x35, 0x55, 0xac, 0xe5, 0x96, 0xe4, 0x94, 0xf4, 0xdf, 0xa9, 0x64, 0xa7, 0x75, 0x18,
+	0x7b, 0xb3, 0x3a, 0x8c, 0xbf, 0x75, 0x1d, 0x26, 0x5e, 0x13, 0x9b, 0xe4, 0x95, 0x1e, 0xbc, 0x11,
+	0x6e, 0x53, 0x7f, 0xcf, 0x6d, 0xb5, 0x0e, 0x4b, 0x73, 0x44, 0x85, 0x61, 0xbc, 0xa8, 0x2f, 0xeb,
+	0xb5, 0xf5, 0xf5, 0x35, 0xdc, 0xc0, 0x54, 0xb0, 0xde, 0x88, 0x46, 0x32, 0xef, 0xed, 0x28, 0x47,
+	0x90, 0xf0, 0x64, 0x38, 0xbd, 0xb3, 0x58, 0x7f, 0x57, 0x6f, 0xc2, 0xca, 0x65, 0xe6, 0x8d, 0xa3,
+	0xd5, 0x9f, 0x2d, 0x28, 0x1e, 0x9a, 0x3b, 0xbc, 0xdd, 0x91, 0x73, 0xc1, 0x8b, 0x5d, 0x31, 0x78,
+	0xf7, 0x20, 0x39, 0xd2, 0x43, 0x72, 0xd2, 0xa4, 0x23, 0xff, 0xc7, 0x0e, 0xd5, 0x90, 0xc1, 0x06,
+	0x57, 0x4c, 0x9e, 0xf8, 0x3d, 0x49, 0xb9, 0x93, 0x08, 0x99, 0x8c, 0x68, 0x3e, 0xd6, 0x08, 0x0e,
+	0x35, 0xaa, 0x8f, 0xa0, 0x34, 0xbd, 0xcb, 0x45, 0x20, 0xe8, 0x88, 0xaa, 0xc7, 0xaa, 0x55, 0x89,
+	0xcf, 0x6f, 0x3f, 0xdc, 0x51, 0x10, 0x0e, 0x35, 0x1e, 0x6c, 0x43, 0x69, 0xee, 0x9f, 0x0c, 0x2a,
+	0x41, 0xee, 0xe0, 0x59, 0x6b, 0x7f, 0xa7, 0xde, 0x78, 0xdc, 0xd8, 0xd9, 0xb6, 0xaf, 0x21, 0x80,
+	0x54, 0xab, 0xf1, 0xec, 0xc9, 0xee, 0x8e, 0x6d, 0xa1, 0x2c, 0x24, 0xf7, 0x0e, 0x76, 0xdb, 0x0d,
+	0x3b, 0xa6, 0x3e, 0xdb, 0x47, 0xcd, 0xfd, 0xba, 0x1d, 0x7f, 0xf0, 0x09, 0xe4, 0xea, 0xfa, 0xff,
+	0x58, 0x93, 0x7b, 0x94, 0xab, 0x0d, 0xcf, 0x9a, 0x78, 0x6f, 0x73, 0xd7, 0xbe, 0x86, 0xd2, 0x10,
+	0xdf, 0xc7, 0x6a, 0x67, 0x06, 0x12, 0xfb, 0xcd, 0x56, 0xdb, 0x8e, 0xa1, 0x22, 0xc0, 0xe6, 0x41,
+	0xbb, 0x59, 0x6f, 0xee, 0xed, 0x35, 0xda, 0x76, 0x7c, 0xeb, 0xf1, 0x4f, 0x2f, 0x57, 0xad, 0x5f,
+	0x5e, 0xae, 0x5a, 0xbf, 0xbd, 0x5c, 0xb5, 0xbe, 0xfd, 0x7d, 0xf5, 0x1a, 0x94, 0x7c, 0xb6, 0x36,
+	0xf2, 0x25, 0x15, 0xc2, 0xfc, 0xfd, 0xfc, 0xf2, 0x56, 0xb8, 0xf2, 0xd9, 0xba, 0xf9, 0x5a, 0xef,
+	0xb2, 0xf5, 0x91, 0x5c, 0xd7, 0xe8, 0xba, 0x49, 0xd5, 0xe3, 0x94, 0x5e, 0xfd, 0xff, 0xcf, 0x01,
+	0x00, 0x3b, 0x1c, 0xbc, 0xaf, 0xfe, 0x0e, 0x00, 0x00,
 }
 
 func (m *Session) Marshal() (dAtA []byte, err error) {
@@ -1332,6 +1358,14 @@ func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MaxReplicaLag != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxReplicaLag))))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc1
+	}
 	if m.EnableSystemSettings {
 		i--
 		if m.EnableSystemSettings {
@@ -1668,6 +1702,25 @@ func (m *ReadAfterWrite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ShardGtids) > 0 {
+		for k := range m.ShardGtids {
+			v := m.ShardGtids[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintVtgate(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintVtgate(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintVtgate(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.SessionTrackGtids {
 		i--
 		if m.SessionTrackGtids {
@@ -2435,6 +2488,9 @@ func (m *Session) Size() (n int) {
 	if m.EnableSystemSettings {
 		n += 3
 	}
+	if m.MaxReplicaLag != 0 {
+		n += 10
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2483,6 +2539,14 @@ func (m *ReadAfterWrite) Size() (n int) {
 	if m.SessionTrackGtids {
 		n += 2
 	}
+	if len(m.ShardGtids) > 0 {
+		for k, v := range m.ShardGtids {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovVtgate(uint64(len(k))) + 1 + len(v) + sovVtgate(uint64(len(v)))
+			n += mapEntrySize + 1 + sovVtgate(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3580,6 +3644,17 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableSystemSettings = bool(v != 0)
+		case 24:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicaLag", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MaxReplicaLag = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVtgate(dAtA[iNdEx:])
@@ -3861,6 +3936,133 @@ func (m *ReadAfterWrite) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SessionTrackGtids = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardGtids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShardGtids == nil {
+				m.ShardGtids = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVtgate
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVtgate
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthVtgate
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthVtgate
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVtgate
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthVtgate
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthVtgate
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipVtgate(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthVtgate
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ShardGtids[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVtgate(dAtA[iNdEx:])
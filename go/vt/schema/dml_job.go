@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+const (
+	// DMLJobsTableName is the name of the _vt table in which background DML jobs are tracked
+	DMLJobsTableName = "dml_jobs"
+)
+
+// DMLJobStatus is an indicator to a background DML job status
+type DMLJobStatus string
+
+const (
+	DMLJobStatusQueued    DMLJobStatus = "queued"
+	DMLJobStatusRunning   DMLJobStatus = "running"
+	DMLJobStatusComplete  DMLJobStatus = "complete"
+	DMLJobStatusFailed    DMLJobStatus = "failed"
+	DMLJobStatusCancelled DMLJobStatus = "cancelled"
+)
+
+// CreateDMLJobUUID creates a globally unique ID for a background DML job, in the same
+// format as online DDL UUIDs, e.g. a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a
+func CreateDMLJobUUID() (string, error) {
+	return createUUID("_")
+}
+
+// BackgroundDMLTable returns the single table a background DML job operates on. Background DML
+// jobs split the statement into batches by primary key, and so only single table UPDATE and
+// DELETE statements, with no ORDER BY or LIMIT clauses, are supported.
+func BackgroundDMLTable(stmt sqlparser.Statement) (*sqlparser.AliasedTableExpr, error) {
+	var tableExprs sqlparser.TableExprs
+	switch stmt := stmt.(type) {
+	case *sqlparser.Update:
+		if len(stmt.OrderBy) > 0 || stmt.Limit != nil {
+			return nil, fmt.Errorf("background DML does not support ORDER BY or LIMIT: %s", sqlparser.String(stmt))
+		}
+		tableExprs = stmt.TableExprs
+	case *sqlparser.Delete:
+		if len(stmt.OrderBy) > 0 || stmt.Limit != nil {
+			return nil, fmt.Errorf("background DML does not support ORDER BY or LIMIT: %s", sqlparser.String(stmt))
+		}
+		if len(stmt.Targets) > 0 || len(stmt.Partitions) > 0 {
+			return nil, fmt.Errorf("background DML does not support multi-table or partition deletes: %s", sqlparser.String(stmt))
+		}
+		tableExprs = stmt.TableExprs
+	default:
+		return nil, fmt.Errorf("background DML only supports UPDATE and DELETE statements: %s", sqlparser.String(stmt))
+	}
+	if len(tableExprs) != 1 {
+		return nil, fmt.Errorf("background DML only supports single table statements: %s", sqlparser.String(stmt))
+	}
+	tableExpr, ok := tableExprs[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, fmt.Errorf("background DML only supports single table statements: %s", sqlparser.String(stmt))
+	}
+	if _, ok := tableExpr.Expr.(sqlparser.TableName); !ok {
+		return nil, fmt.Errorf("background DML does not support derived tables: %s", sqlparser.String(stmt))
+	}
+	return tableExpr, nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestCreateDMLJobUUID(t *testing.T) {
+	uuid, err := CreateDMLJobUUID()
+	assert.NoError(t, err)
+	assert.True(t, IsOnlineDDLUUID(uuid))
+}
+
+func TestBackgroundDMLTable(t *testing.T) {
+	tt := []struct {
+		sql   string
+		table string
+		isErr bool
+	}{
+		{
+			sql:   "delete from events where created_at < '2020-01-01'",
+			table: "events",
+		},
+		{
+			sql:   "update ks.events set archived = 1 where created_at < '2020-01-01'",
+			table: "events",
+		},
+		{
+			sql:   "delete from events where id < 100 limit 10",
+			isErr: true,
+		},
+		{
+			sql:   "update events set archived = 1 order by id",
+			isErr: true,
+		},
+		{
+			sql:   "delete events from events join users on events.user_id = users.id",
+			isErr: true,
+		},
+		{
+			sql:   "update events, users set events.archived = 1 where events.user_id = users.id",
+			isErr: true,
+		},
+		{
+			sql:   "insert into events (id) values (1)",
+			isErr: true,
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.sql, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(ts.sql)
+			require.NoError(t, err)
+
+			tableExpr, err := BackgroundDMLTable(stmt)
+			if ts.isErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, ts.table, tableExpr.Expr.(sqlparser.TableName).Name.String())
+		})
+	}
+}
@@ -74,6 +74,9 @@ type ExecuteResult struct {
 	Sqls           []string
 	ExecutorErr    string
 	TotalTimeSpent time.Duration
+	// UUIDs are the UUIDs of the online DDL migrations submitted by the
+	// executor, if any.
+	UUIDs []string
 }
 
 // ShardWithError contains information why a shard failed to execute given sql
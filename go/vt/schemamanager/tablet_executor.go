@@ -311,6 +311,8 @@ func (exec *TabletExecutor) executeOnlineDDL(
 	err = onlineDDL.WriteTopo(ctx, conn, schema.MigrationRequestsPath())
 	if err != nil {
 		execResult.ExecutorErr = err.Error()
+	} else {
+		execResult.UUIDs = append(execResult.UUIDs, onlineDDL.UUID)
 	}
 	exec.wr.Logger().Infof("UUID=%+v", onlineDDL.UUID)
 	exec.wr.Logger().Printf("%s\n", onlineDDL.UUID)
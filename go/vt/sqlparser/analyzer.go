@@ -82,7 +82,7 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtDDL
 	case *Use:
 		return StmtUse
-	case *OtherRead, *OtherAdmin, *Load:
+	case *OtherRead, *OtherAdmin, *Load, *CancelDMLJob:
 		return StmtOther
 	case Explain:
 		return StmtExplain
@@ -211,7 +211,7 @@ func Preview(sql string) StatementType {
 		return StmtUse
 	case "describe", "desc", "explain":
 		return StmtExplain
-	case "analyze", "repair", "optimize":
+	case "analyze", "repair", "optimize", "cancel":
 		return StmtOther
 	case "grant", "revoke":
 		return StmtPriv
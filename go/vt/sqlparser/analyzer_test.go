@@ -74,6 +74,7 @@ func TestPreview(t *testing.T) {
 		{"explain", StmtExplain},
 		{"repair", StmtOther},
 		{"optimize", StmtOther},
+		{"cancel vitess_dml_job 'a'", StmtOther},
 		{"grant", StmtPriv},
 		{"revoke", StmtPriv},
 		{"truncate", StmtDDL},
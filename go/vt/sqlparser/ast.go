@@ -519,6 +519,12 @@ type (
 		Params Exprs
 	}
 
+	// CancelDMLJob represents a CANCEL VITESS_DML_JOB statement, which
+	// cancels a background DML job on all the shards it runs on.
+	CancelDMLJob struct {
+		UUID string
+	}
+
 	// LockType is an enum for Lock Types
 	LockType int8
 
@@ -605,6 +611,7 @@ func (*DropView) iStatement()          {}
 func (*TruncateTable) iStatement()     {}
 func (*RenameTable) iStatement()       {}
 func (*CallProc) iStatement()          {}
+func (*CancelDMLJob) iStatement()      {}
 func (*ExplainStmt) iStatement()       {}
 func (*ExplainTab) iStatement()        {}
 
@@ -2554,6 +2561,11 @@ func (node *CallProc) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "call %v(%v)", node.Name, node.Params)
 }
 
+// Format formats the node.
+func (node *CancelDMLJob) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "cancel vitess_dml_job %v", NewStrLiteral([]byte(node.UUID)))
+}
+
 // Format formats the node.
 func (node *OtherRead) Format(buf *TrackedBuffer) {
 	buf.WriteString("otherread")
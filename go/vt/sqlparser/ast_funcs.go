@@ -1283,6 +1283,8 @@ func (ty ShowCommandType) ToString() string {
 		return VariableSessionStr
 	case Keyspace:
 		return KeyspaceStr
+	case VitessDMLJobs:
+		return VitessDMLJobsStr
 	default:
 		return "" +
 			"Unknown ShowCommandType"
@@ -257,7 +257,8 @@ func (er *expressionRewriter) sysVarRewrite(cursor *Cursor, node *ColName) {
 		sysvars.ReadAfterWriteTimeOut.Name,
 		sysvars.Version.Name,
 		sysvars.VersionComment.Name,
-		sysvars.SessionTrackGTIDs.Name:
+		sysvars.SessionTrackGTIDs.Name,
+		sysvars.MaxReplicaLag.Name:
 		cursor.Replace(bindVarExpression("__vt" + lowered))
 		er.bindVars.AddSysVar(lowered)
 	}
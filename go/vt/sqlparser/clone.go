@@ -319,6 +319,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return in
 	case *CallProc:
 		return CloneRefOfCallProc(in)
+	case *CancelDMLJob:
+		return CloneRefOfCancelDMLJob(in)
 	case *CaseExpr:
 		return CloneRefOfCaseExpr(in)
 	case *ChangeColumn:
@@ -661,6 +663,8 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfBegin(in)
 	case *CallProc:
 		return CloneRefOfCallProc(in)
+	case *CancelDMLJob:
+		return CloneRefOfCancelDMLJob(in)
 	case *Commit:
 		return CloneRefOfCommit(in)
 	case *CreateDatabase:
@@ -1515,6 +1519,15 @@ func CloneRefOfCallProc(n *CallProc) *CallProc {
 	return &out
 }
 
+// CloneRefOfCancelDMLJob creates a deep clone of the input.
+func CloneRefOfCancelDMLJob(n *CancelDMLJob) *CancelDMLJob {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
 // CloneColIdent creates a deep clone of the input.
 func CloneColIdent(n ColIdent) ColIdent {
 	return *CloneRefOfColIdent(&n)
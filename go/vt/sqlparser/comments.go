@@ -36,6 +36,10 @@ const (
 	DirectiveIgnoreMaxPayloadSize = "IGNORE_MAX_PAYLOAD_SIZE"
 	// DirectiveIgnoreMaxMemoryRows skips memory row validation when set.
 	DirectiveIgnoreMaxMemoryRows = "IGNORE_MAX_MEMORY_ROWS"
+	// DirectiveBackgroundDML runs an UPDATE or DELETE as a throttled, batched background job.
+	DirectiveBackgroundDML = "BACKGROUND_DML"
+	// DirectiveWorkloadPool names the vttablet workload pool a query runs in.
+	DirectiveWorkloadPool = "WORKLOAD_POOL"
 )
 
 func isNonSpace(r rune) bool {
@@ -347,3 +351,23 @@ func IgnoreMaxMaxMemoryRowsDirective(stmt Statement) bool {
 		return false
 	}
 }
+
+// WorkloadPoolDirective returns the name of the workload pool requested
+// by the query, or an empty string if there is none.
+func WorkloadPoolDirective(stmt Statement) string {
+	var comments Comments
+	switch stmt := stmt.(type) {
+	case *Select:
+		comments = stmt.Comments
+	case *Insert:
+		comments = stmt.Comments
+	case *Update:
+		comments = stmt.Comments
+	case *Delete:
+		comments = stmt.Comments
+	default:
+		return ""
+	}
+	name, _ := ExtractCommentDirectives(comments)[DirectiveWorkloadPool].(string)
+	return name
+}
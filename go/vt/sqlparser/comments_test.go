@@ -440,3 +440,26 @@ func TestIgnoreMaxMaxMemoryRowsDirective(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkloadPoolDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{"select /*vt+ WORKLOAD_POOL=analytics */ * from users", "analytics"},
+		{"select * from users", ""},
+		{"insert /*vt+ WORKLOAD_POOL=batch */ into user(id) values (1), (2)", "batch"},
+		{"update /*vt+ WORKLOAD_POOL=batch */ users set name=1", "batch"},
+		{"delete /*vt+ WORKLOAD_POOL=batch */ from users", "batch"},
+		{"select /*vt+ WORKLOAD_POOL */ * from users", ""},
+		{"select /*vt+ WORKLOAD_POOL=1 */ * from users", ""},
+		{"show /*vt+ WORKLOAD_POOL=analytics */ create table users", ""},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, _ := Parse(test.query)
+			assert.Equal(t, test.expected, WorkloadPoolDirective(stmt))
+		})
+	}
+}
@@ -236,6 +236,7 @@ const (
 	VariableGlobalStr  = " global variables"
 	VariableSessionStr = " variables"
 	KeyspaceStr        = " keyspaces"
+	VitessDMLJobsStr   = " vitess_dml_jobs"
 
 	// DropKeyType strings
 	PrimaryKeyTypeStr = "primary key"
@@ -491,6 +492,7 @@ const (
 	VariableGlobal
 	VariableSession
 	Keyspace
+	VitessDMLJobs
 )
 
 // DropKeyType constants
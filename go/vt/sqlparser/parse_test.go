@@ -1497,6 +1497,10 @@ var (
 	}, {
 		input:  "show vitess_keyspaces like '%'",
 		output: "show keyspaces like '%'",
+	}, {
+		input: "show vitess_dml_jobs",
+	}, {
+		input: "show vitess_dml_jobs where job_status = 'running'",
 	}, {
 		input: "show vitess_shards",
 	}, {
@@ -1868,6 +1872,11 @@ var (
 		input: "call proc(1, 'foo')",
 	}, {
 		input: "call proc(@param)",
+	}, {
+		input: "cancel vitess_dml_job 'aa89f255_8d68_11eb_815f_f875a4d24e90'",
+	}, {
+		input:  "CANCEL VITESS_DML_JOB \"aa89f255_8d68_11eb_815f_f875a4d24e90\"",
+		output: "cancel vitess_dml_job 'aa89f255_8d68_11eb_815f_f875a4d24e90'",
 	}}
 )
 
@@ -152,6 +152,7 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.Params, func(newNode, parent SQLNode) {
 			parent.(*CallProc).Params = newNode.(Exprs)
 		})
+	case *CancelDMLJob:
 	case *CaseExpr:
 		a.apply(node, n.Expr, func(newNode, parent SQLNode) {
 			parent.(*CaseExpr).Expr = newNode.(Expr)
@@ -563,29 +563,32 @@ const SLOW = 57766
 const CHANNEL = 57767
 const RELAY = 57768
 const EXPORT = 57769
-const AVG_ROW_LENGTH = 57770
-const CONNECTION = 57771
-const CHECKSUM = 57772
-const DELAY_KEY_WRITE = 57773
-const ENCRYPTION = 57774
-const ENGINE = 57775
-const INSERT_METHOD = 57776
-const MAX_ROWS = 57777
-const MIN_ROWS = 57778
-const PACK_KEYS = 57779
-const PASSWORD = 57780
-const FIXED = 57781
-const DYNAMIC = 57782
-const COMPRESSED = 57783
-const REDUNDANT = 57784
-const COMPACT = 57785
-const ROW_FORMAT = 57786
-const STATS_AUTO_RECALC = 57787
-const STATS_PERSISTENT = 57788
-const STATS_SAMPLE_PAGES = 57789
-const STORAGE = 57790
-const MEMORY = 57791
-const DISK = 57792
+const CANCEL = 57770
+const VITESS_DML_JOB = 57771
+const VITESS_DML_JOBS = 57772
+const AVG_ROW_LENGTH = 57773
+const CONNECTION = 57774
+const CHECKSUM = 57775
+const DELAY_KEY_WRITE = 57776
+const ENCRYPTION = 57777
+const ENGINE = 57778
+const INSERT_METHOD = 57779
+const MAX_ROWS = 57780
+const MIN_ROWS = 57781
+const PACK_KEYS = 57782
+const PASSWORD = 57783
+const FIXED = 57784
+const DYNAMIC = 57785
+const COMPRESSED = 57786
+const REDUNDANT = 57787
+const COMPACT = 57788
+const ROW_FORMAT = 57789
+const STATS_AUTO_RECALC = 57790
+const STATS_PERSISTENT = 57791
+const STATS_SAMPLE_PAGES = 57792
+const STORAGE = 57793
+const MEMORY = 57794
+const DISK = 57795
 
 var yyToknames = [...]string{
 	"$end",
@@ -1032,6 +1035,9 @@ var yyToknames = [...]string{
 	"CHANNEL",
 	"RELAY",
 	"EXPORT",
+	"CANCEL",
+	"VITESS_DML_JOB",
+	"VITESS_DML_JOBS",
 	"AVG_ROW_LENGTH",
 	"CONNECTION",
 	"CHECKSUM",
@@ -1069,2936 +1075,2958 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 43,
-	163, 923,
-	-2, 90,
 	-1, 44,
-	1, 111,
-	468, 111,
-	-2, 117,
+	163, 925,
+	-2, 91,
 	-1, 45,
-	143, 117,
-	254, 117,
-	306, 117,
-	-2, 324,
-	-1, 52,
-	34, 465,
-	164, 465,
-	176, 465,
-	209, 479,
-	210, 479,
-	-2, 467,
-	-1, 57,
-	166, 489,
-	-2, 487,
-	-1, 82,
-	56, 556,
-	-2, 564,
-	-1, 107,
 	1, 112,
-	468, 112,
-	-2, 117,
-	-1, 117,
-	169, 229,
-	170, 229,
-	-2, 318,
-	-1, 136,
-	143, 117,
-	254, 117,
-	306, 117,
-	-2, 333,
-	-1, 570,
-	150, 944,
-	-2, 940,
-	-1, 571,
-	150, 945,
-	-2, 941,
-	-1, 589,
-	56, 557,
-	-2, 569,
-	-1, 590,
+	471, 112,
+	-2, 118,
+	-1, 46,
+	143, 118,
+	254, 118,
+	306, 118,
+	-2, 325,
+	-1, 53,
+	34, 467,
+	164, 467,
+	176, 467,
+	209, 481,
+	210, 481,
+	-2, 469,
+	-1, 58,
+	166, 491,
+	-2, 489,
+	-1, 84,
 	56, 558,
-	-2, 570,
-	-1, 610,
-	118, 1283,
-	-2, 83,
-	-1, 611,
-	118, 1166,
-	-2, 84,
+	-2, 566,
+	-1, 109,
+	1, 113,
+	471, 113,
+	-2, 118,
+	-1, 119,
+	169, 230,
+	170, 230,
+	-2, 319,
+	-1, 138,
+	143, 118,
+	254, 118,
+	306, 118,
+	-2, 334,
+	-1, 576,
+	150, 947,
+	-2, 943,
+	-1, 577,
+	150, 948,
+	-2, 944,
+	-1, 596,
+	56, 559,
+	-2, 571,
+	-1, 597,
+	56, 560,
+	-2, 572,
 	-1, 617,
-	118, 1216,
-	-2, 917,
-	-1, 754,
-	118, 1104,
-	-2, 914,
-	-1, 789,
-	175, 37,
-	180, 37,
-	-2, 240,
-	-1, 868,
-	1, 371,
-	468, 371,
-	-2, 117,
-	-1, 1104,
-	1, 267,
-	468, 267,
-	-2, 117,
-	-1, 1182,
-	169, 229,
-	170, 229,
-	-2, 318,
-	-1, 1191,
+	118, 1287,
+	-2, 84,
+	-1, 618,
+	118, 1170,
+	-2, 85,
+	-1, 624,
+	118, 1220,
+	-2, 919,
+	-1, 761,
+	118, 1108,
+	-2, 916,
+	-1, 796,
 	175, 38,
 	180, 38,
 	-2, 241,
-	-1, 1399,
-	150, 947,
-	-2, 943,
-	-1, 1491,
-	74, 65,
-	82, 65,
-	-2, 69,
-	-1, 1512,
+	-1, 875,
+	1, 372,
+	471, 372,
+	-2, 118,
+	-1, 1113,
 	1, 268,
-	468, 268,
-	-2, 117,
-	-1, 1920,
-	5, 811,
-	18, 811,
-	20, 811,
-	32, 811,
-	83, 811,
-	-2, 595,
-	-1, 2132,
-	46, 885,
-	-2, 883,
+	471, 268,
+	-2, 118,
+	-1, 1191,
+	169, 230,
+	170, 230,
+	-2, 319,
+	-1, 1200,
+	175, 39,
+	180, 39,
+	-2, 242,
+	-1, 1408,
+	150, 950,
+	-2, 946,
+	-1, 1500,
+	74, 66,
+	82, 66,
+	-2, 70,
+	-1, 1521,
+	1, 269,
+	471, 269,
+	-2, 118,
+	-1, 1929,
+	5, 813,
+	18, 813,
+	20, 813,
+	32, 813,
+	83, 813,
+	-2, 597,
+	-1, 2141,
+	46, 887,
+	-2, 885,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 27976
+const yyLast = 28184
 
 var yyAct = [...]int{
-	570, 2213, 2200, 1972, 2177, 1833, 1802, 1723, 514, 2141,
-	2132, 2083, 2061, 926, 81, 3, 1900, 1575, 543, 1690,
-	1509, 1901, 582, 1436, 1969, 1059, 1724, 1007, 529, 1897,
-	1542, 1806, 1710, 512, 1527, 1166, 1547, 758, 1787, 1488,
-	1788, 1912, 1859, 145, 1650, 1786, 880, 1625, 176, 1393,
-	1573, 188, 1300, 477, 188, 907, 1052, 1189, 79, 493,
-	819, 188, 131, 1385, 1549, 1096, 1780, 1089, 1477, 188,
-	784, 1470, 1062, 1057, 1438, 1082, 1045, 1419, 615, 576,
-	591, 1362, 32, 516, 943, 1080, 797, 1161, 765, 762,
-	493, 1165, 505, 493, 188, 493, 1196, 1079, 770, 790,
-	1279, 1453, 1086, 612, 766, 1538, 785, 786, 1095, 1069,
-	1493, 77, 924, 787, 1093, 1305, 1207, 774, 874, 861,
-	1181, 500, 8, 114, 115, 7, 108, 109, 148, 76,
-	6, 1825, 1824, 1604, 2085, 1847, 1848, 1351, 175, 1020,
-	1266, 1433, 1434, 1528, 1350, 1349, 1021, 177, 178, 179,
-	1348, 82, 1347, 1346, 503, 1339, 504, 597, 601, 1688,
-	577, 2129, 509, 759, 2169, 2040, 116, 2107, 2106, 1946,
-	823, 188, 2056, 110, 822, 2057, 2219, 2174, 2212, 1167,
-	824, 188, 78, 873, 501, 2152, 188, 84, 85, 86,
-	87, 88, 89, 944, 821, 2203, 453, 177, 178, 179,
-	1552, 1973, 1592, 1640, 609, 801, 2173, 835, 836, 1876,
-	839, 840, 841, 842, 2004, 776, 845, 846, 847, 848,
-	849, 850, 851, 852, 853, 854, 855, 856, 857, 858,
-	859, 832, 800, 616, 778, 777, 110, 944, 555, 2151,
-	561, 562, 559, 560, 102, 558, 557, 556, 1097, 174,
-	1098, 825, 826, 827, 779, 563, 564, 470, 954, 1927,
-	1928, 1504, 1505, 1435, 34, 1494, 469, 70, 38, 39,
-	1611, 1689, 1754, 1926, 1610, 1753, 467, 1846, 1755, 1551,
-	169, 105, 1638, 182, 183, 1503, 914, 900, 916, 177,
-	178, 179, 837, 481, 893, 887, 888, 899, 574, 105,
-	838, 97, 954, 876, 110, 111, 100, 133, 922, 99,
-	98, 573, 1771, 780, 1521, 464, 153, 2154, 1835, 1340,
-	1341, 1342, 1995, 1993, 475, 913, 915, 491, 1338, 495,
-	489, 1256, 1807, 942, 105, 170, 1396, 885, 103, 69,
-	1574, 886, 887, 888, 1607, 480, 1829, 143, 950, 1280,
-	2202, 1288, 132, 1289, 1830, 1290, 103, 862, 904, 905,
-	902, 903, 1285, 920, 906, 1619, 869, 481, 1838, 844,
-	150, 843, 151, 1257, 2103, 1258, 901, 120, 121, 142,
-	141, 168, 1837, 894, 2170, 1282, 781, 921, 2051, 808,
-	1836, 1576, 950, 1284, 454, 456, 457, 1175, 473, 474,
-	482, 806, 1471, 818, 471, 472, 483, 458, 459, 487,
-	486, 481, 463, 460, 462, 468, 1286, 817, 816, 480,
-	466, 484, 815, 912, 481, 814, 911, 917, 2220, 137,
-	118, 144, 125, 117, 1283, 138, 139, 1945, 104, 154,
-	813, 812, 910, 811, 810, 805, 1553, 2052, 1494, 159,
-	126, 1624, 763, 188, 897, 2189, 104, 793, 173, 481,
-	763, 1195, 1194, 480, 129, 127, 122, 123, 124, 128,
-	107, 918, 1768, 1763, 119, 792, 480, 875, 493, 493,
-	493, 809, 775, 130, 949, 946, 947, 948, 953, 955,
-	952, 104, 951, 807, 603, 1839, 493, 493, 919, 945,
-	2217, 883, 1598, 889, 890, 891, 892, 1293, 2150, 930,
-	1609, 480, 936, 799, 828, 763, 1764, 1796, 2142, 761,
-	1606, 1691, 1693, 923, 1639, 799, 2155, 1885, 949, 946,
-	947, 948, 953, 955, 952, 1884, 951, 1883, 1766, 773,
-	772, 1761, 771, 945, 1627, 485, 1817, 1627, 872, 1626,
-	769, 146, 1626, 1762, 452, 180, 1860, 1618, 992, 993,
-	1617, 2136, 1510, 478, 2024, 1925, 1268, 1267, 1269, 1270,
-	1271, 834, 1715, 1658, 188, 1584, 1669, 799, 479, 1499,
-	1073, 1594, 1005, 878, 896, 866, 71, 980, 1750, 1449,
-	884, 927, 928, 1050, 970, 990, 898, 980, 799, 1862,
-	493, 908, 1049, 188, 140, 188, 188, 1666, 493, 177,
-	178, 179, 1769, 1767, 493, 799, 134, 1692, 1335, 135,
-	939, 612, 882, 937, 960, 2111, 92, 1008, 938, 820,
-	2119, 969, 968, 978, 979, 971, 972, 973, 974, 975,
-	976, 977, 970, 799, 1078, 980, 868, 2215, 798, 1046,
-	2216, 1910, 2214, 1306, 802, 792, 1281, 1864, 1099, 1868,
-	798, 1863, 940, 1861, 803, 863, 1878, 864, 1866, 1776,
-	865, 93, 1063, 867, 177, 178, 179, 1865, 1387, 1420,
-	994, 995, 996, 997, 998, 999, 1000, 1001, 1002, 1003,
-	1867, 1869, 957, 1591, 1043, 1665, 1023, 1025, 1027, 1029,
-	1031, 1033, 1034, 1024, 1026, 1593, 1030, 1032, 960, 1035,
-	1172, 1420, 798, 1676, 833, 992, 993, 909, 1589, 1765,
-	147, 152, 149, 155, 156, 157, 158, 160, 161, 162,
-	163, 1051, 808, 798, 1388, 881, 164, 165, 166, 167,
-	792, 795, 796, 806, 763, 1369, 992, 993, 789, 793,
-	798, 616, 1586, 1586, 1454, 1455, 802, 792, 188, 1367,
-	1368, 1366, 1157, 1451, 2204, 1930, 803, 788, 2039, 1307,
-	172, 2221, 1168, 1169, 1170, 1171, 1590, 1588, 798, 958,
-	959, 957, 959, 957, 804, 792, 795, 796, 493, 763,
-	1191, 69, 2205, 789, 793, 1066, 1664, 960, 1200, 960,
-	2038, 1951, 1204, 1365, 1663, 493, 493, 1784, 493, 1201,
-	493, 493, 1783, 493, 493, 493, 493, 493, 493, 973,
-	974, 975, 976, 977, 970, 1187, 1450, 980, 493, 958,
-	959, 957, 188, 1240, 1235, 1236, 958, 959, 957, 2222,
-	1173, 1174, 2194, 2120, 1556, 1276, 1180, 960, 1253, 602,
-	1261, 958, 959, 957, 960, 1199, 958, 959, 957, 493,
-	958, 959, 957, 1094, 1880, 1237, 768, 188, 1260, 960,
-	2195, 1643, 1644, 1645, 960, 188, 1259, 1299, 960, 188,
-	1275, 1887, 1197, 1197, 1357, 1359, 1360, 1164, 1163, 1251,
-	177, 178, 179, 1198, 1757, 188, 1358, 1273, 1243, 1244,
-	1156, 1245, 188, 1177, 1249, 1250, 1178, 1176, 599, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 493, 493,
-	493, 1263, 1209, 1242, 1210, 1061, 1212, 1214, 1190, 1888,
-	1218, 1220, 1222, 1224, 1226, 1785, 1241, 604, 605, 1274,
-	1310, 1308, 1309, 188, 177, 178, 179, 1314, 1568, 1316,
-	1317, 1318, 1319, 607, 1321, 1313, 1272, 1302, 1216, 958,
-	959, 957, 1320, 2207, 971, 972, 973, 974, 975, 976,
-	977, 970, 1238, 506, 980, 2206, 2196, 960, 2185, 1832,
-	1262, 1386, 1363, 532, 531, 534, 535, 536, 537, 2074,
-	1389, 1294, 533, 2036, 538, 778, 777, 110, 177, 178,
-	179, 2012, 1566, 1933, 493, 177, 178, 179, 1889, 1254,
-	1793, 1312, 177, 178, 179, 1781, 78, 1634, 1602, 1397,
-	1601, 1303, 1408, 1411, 1264, 1252, 1390, 1391, 1421, 1248,
-	1331, 1332, 1333, 1247, 1403, 1246, 586, 493, 493, 1958,
-	2188, 1958, 2148, 1958, 2137, 1345, 2101, 1364, 188, 1958,
-	586, 1958, 2109, 2054, 586, 1586, 586, 1495, 1398, 2022,
-	586, 493, 1958, 1963, 1943, 1942, 1939, 1940, 188, 1939,
-	1938, 493, 1444, 2100, 1008, 188, 1443, 188, 1462, 586,
-	1494, 1826, 1456, 1495, 1399, 188, 188, 1397, 1711, 1427,
-	1428, 1971, 493, 1711, 586, 493, 1160, 1811, 34, 1489,
-	1804, 1805, 1474, 586, 1809, 612, 493, 1898, 612, 956,
-	586, 1160, 1159, 1105, 1104, 34, 1909, 80, 1400, 1496,
-	1795, 2041, 1587, 1718, 1518, 1361, 1468, 1498, 1370, 1371,
-	1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381,
-	1382, 1383, 1384, 1514, 1464, 1496, 1719, 1463, 1513, 1909,
-	1744, 2019, 1399, 1494, 1529, 1530, 1531, 1474, 1494, 1473,
-	956, 493, 1909, 2090, 2110, 188, 1231, 34, 493, 2042,
-	2043, 2044, 1958, 69, 1565, 1567, 1517, 1586, 1941, 1474,
-	1466, 1502, 1681, 1462, 1680, 1423, 1544, 493, 1492, 1462,
-	69, 579, 1586, 493, 1569, 1550, 1452, 1200, 1497, 1200,
-	1522, 1501, 1523, 1524, 1525, 1526, 1500, 1585, 1431, 1343,
-	1474, 1292, 1516, 1515, 1232, 1233, 1234, 1462, 1534, 1535,
-	1536, 1537, 1091, 783, 1572, 782, 2140, 69, 2063, 1970,
-	2030, 1162, 1543, 1831, 1579, 616, 1539, 493, 616, 1386,
-	1533, 1532, 69, 1278, 1386, 1386, 1545, 1192, 1188, 1158,
-	94, 1790, 2045, 174, 1582, 1789, 1583, 1834, 1540, 1541,
-	1561, 1562, 1563, 1555, 1557, 1554, 69, 1913, 1914, 1228,
-	2064, 571, 801, 1167, 1595, 2209, 2201, 1916, 1898, 188,
-	1545, 1578, 1197, 188, 188, 188, 188, 188, 1596, 1800,
-	1577, 1799, 1581, 188, 188, 188, 188, 2046, 2047, 800,
-	1790, 1798, 1597, 1559, 1336, 1295, 188, 1599, 1600, 1919,
-	1918, 1735, 1732, 188, 1229, 1230, 1736, 1479, 1482, 1483,
-	1484, 1480, 189, 1481, 1485, 189, 1733, 1913, 1914, 1731,
-	494, 1734, 189, 2191, 2172, 1060, 1890, 188, 493, 1737,
-	189, 1483, 1484, 1700, 2023, 1961, 1404, 1405, 1709, 1708,
-	1410, 1413, 1414, 2160, 2157, 1629, 1630, 2193, 2176, 2178,
-	1632, 494, 96, 2184, 494, 189, 494, 1633, 101, 2183,
-	1479, 1482, 1483, 1484, 1480, 1426, 1481, 1485, 1429, 1430,
-	2133, 1605, 2131, 1291, 572, 1363, 978, 979, 971, 972,
-	973, 974, 975, 976, 977, 970, 592, 1622, 980, 969,
-	968, 978, 979, 971, 972, 973, 974, 975, 976, 977,
-	970, 593, 181, 980, 1698, 171, 1794, 961, 184, 830,
-	829, 1053, 1699, 1982, 1789, 1845, 1660, 929, 1637, 592,
-	1416, 2088, 188, 1054, 1064, 1065, 595, 2017, 594, 1422,
-	188, 1819, 189, 1818, 593, 1417, 111, 1935, 1646, 1934,
-	1364, 1580, 189, 506, 1206, 1205, 1193, 189, 1651, 1454,
-	1455, 1564, 1018, 1447, 188, 1298, 2102, 589, 590, 595,
-	2058, 594, 1487, 580, 581, 188, 188, 188, 188, 188,
-	1659, 1707, 1725, 577, 1642, 1720, 583, 188, 2198, 1706,
-	2197, 188, 1055, 1058, 188, 188, 1675, 2181, 188, 188,
-	188, 1697, 1716, 1713, 2161, 1742, 2016, 1046, 1687, 1957,
-	1570, 1756, 584, 1704, 1695, 80, 2015, 1893, 1711, 2211,
-	2210, 585, 1670, 1667, 1074, 1067, 1703, 2211, 579, 1775,
-	1647, 1648, 1649, 1745, 2134, 1712, 1932, 1747, 1448, 78,
-	83, 75, 1, 465, 1432, 1714, 1044, 476, 1774, 2199,
-	1777, 1778, 1779, 1265, 1738, 1255, 1759, 1974, 1772, 1773,
-	188, 1727, 1728, 1743, 1730, 2060, 1748, 1964, 1751, 1548,
-	791, 493, 1726, 136, 1511, 1729, 1512, 493, 1302, 2144,
-	493, 91, 1200, 1808, 1550, 1760, 1812, 493, 756, 90,
-	794, 895, 1571, 2055, 1770, 1520, 1111, 1109, 1814, 1823,
-	1782, 586, 1110, 1108, 1113, 1112, 1107, 188, 1337, 490,
-	1486, 1100, 1792, 1791, 1068, 831, 455, 1944, 1822, 1334,
-	1603, 461, 988, 1705, 1752, 188, 613, 542, 1821, 606,
-	1904, 2182, 1180, 2158, 2156, 2130, 2084, 1398, 2159, 1813,
-	2128, 2192, 2175, 1519, 1446, 1056, 1820, 969, 968, 978,
-	979, 971, 972, 973, 974, 975, 976, 977, 970, 493,
-	2014, 980, 1892, 1399, 1674, 1386, 1017, 1418, 1083, 1841,
-	1840, 515, 1856, 1442, 1356, 530, 527, 528, 187, 1457,
-	1717, 488, 962, 513, 1843, 1857, 507, 1844, 187, 1075,
-	1478, 1476, 1849, 1475, 1296, 493, 187, 1087, 1915, 1877,
-	1911, 1081, 1461, 1608, 1828, 941, 188, 1855, 1871, 588,
-	502, 95, 600, 600, 1415, 2118, 493, 1858, 1641, 2003,
-	587, 187, 493, 493, 189, 60, 1899, 1725, 1870, 1856,
-	37, 1655, 1656, 497, 2168, 932, 596, 31, 1902, 1896,
-	30, 29, 28, 23, 22, 188, 21, 20, 19, 494,
-	494, 494, 1673, 25, 18, 17, 1908, 16, 106, 47,
-	44, 42, 113, 112, 45, 41, 870, 494, 494, 27,
-	26, 1917, 15, 14, 13, 12, 1921, 11, 1923, 10,
-	1924, 9, 5, 4, 1922, 935, 24, 1006, 2, 0,
-	0, 1304, 0, 1936, 1937, 1952, 0, 188, 187, 188,
-	188, 188, 1929, 0, 0, 493, 0, 0, 187, 0,
-	0, 1886, 0, 187, 1851, 1852, 1960, 0, 188, 0,
-	0, 0, 0, 0, 0, 0, 0, 1948, 1947, 1872,
-	1873, 0, 1874, 1875, 1965, 1975, 493, 493, 493, 1907,
-	188, 0, 1959, 1881, 1882, 189, 0, 1550, 1962, 1983,
-	1949, 1950, 1968, 1967, 2007, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1352, 1353, 1354, 1355, 0,
-	0, 494, 0, 0, 189, 0, 189, 189, 0, 494,
-	1986, 0, 0, 0, 0, 494, 0, 0, 0, 0,
-	1980, 1981, 0, 0, 0, 1991, 0, 0, 0, 0,
-	0, 969, 968, 978, 979, 971, 972, 973, 974, 975,
-	976, 977, 970, 0, 0, 980, 0, 0, 1725, 0,
-	1406, 1407, 0, 2013, 0, 0, 1931, 0, 0, 2018,
-	0, 0, 0, 0, 2026, 0, 2027, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2032, 1988, 1989,
-	0, 1990, 0, 0, 1992, 2033, 1994, 506, 493, 493,
-	169, 2034, 0, 0, 2049, 0, 0, 0, 0, 0,
-	0, 493, 0, 2035, 493, 2037, 0, 2059, 2048, 0,
-	0, 0, 0, 0, 0, 111, 0, 2062, 0, 0,
-	2067, 0, 0, 0, 0, 0, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1508, 493,
-	493, 493, 188, 1984, 0, 2077, 2079, 2080, 0, 0,
-	0, 0, 0, 493, 2066, 493, 2073, 0, 0, 189,
-	0, 493, 0, 0, 0, 2081, 2091, 2096, 1902, 0,
-	2093, 2089, 1902, 2065, 2087, 0, 0, 2082, 0, 2095,
-	150, 0, 151, 188, 0, 2097, 2098, 0, 2099, 494,
-	0, 168, 0, 0, 493, 188, 0, 1546, 0, 0,
-	2112, 0, 2105, 2108, 0, 0, 494, 494, 0, 494,
-	187, 494, 494, 0, 494, 494, 494, 494, 494, 494,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 494,
-	0, 2127, 0, 189, 0, 0, 0, 2135, 0, 1902,
-	0, 493, 493, 0, 0, 0, 0, 2138, 0, 154,
-	0, 0, 0, 2143, 2062, 2145, 0, 0, 0, 159,
-	494, 0, 0, 0, 0, 0, 0, 493, 189, 0,
-	2153, 493, 2162, 1725, 0, 0, 189, 2167, 2164, 0,
-	189, 0, 0, 0, 2171, 0, 0, 0, 0, 0,
-	2179, 2068, 2069, 2070, 2071, 2072, 189, 2180, 0, 2075,
-	2076, 0, 0, 189, 2001, 2190, 0, 0, 0, 0,
-	189, 189, 189, 189, 189, 189, 189, 189, 189, 494,
-	494, 494, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 2208, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2218, 189, 2006, 600, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 964, 0, 967, 0,
-	187, 146, 187, 1090, 981, 982, 983, 984, 985, 986,
-	987, 0, 965, 966, 963, 969, 968, 978, 979, 971,
-	972, 973, 974, 975, 976, 977, 970, 0, 0, 980,
-	0, 0, 969, 968, 978, 979, 971, 972, 973, 974,
-	975, 976, 977, 970, 0, 494, 980, 0, 0, 0,
-	0, 169, 0, 969, 968, 978, 979, 971, 972, 973,
-	974, 975, 976, 977, 970, 0, 0, 980, 0, 0,
-	0, 0, 2165, 0, 0, 0, 111, 0, 494, 494,
-	0, 0, 0, 0, 0, 0, 0, 153, 0, 189,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1677,
-	0, 0, 494, 0, 0, 0, 0, 0, 0, 189,
-	0, 0, 494, 0, 0, 0, 189, 0, 189, 0,
-	0, 0, 0, 0, 0, 0, 189, 189, 1758, 1701,
-	1702, 1058, 2000, 494, 0, 0, 494, 0, 0, 0,
-	0, 150, 0, 151, 0, 0, 0, 494, 0, 0,
-	0, 0, 168, 0, 0, 187, 968, 978, 979, 971,
-	972, 973, 974, 975, 976, 977, 970, 0, 0, 980,
-	147, 152, 149, 155, 156, 157, 158, 160, 161, 162,
-	163, 0, 0, 0, 0, 0, 164, 165, 166, 167,
-	0, 0, 0, 0, 0, 0, 0, 0, 1203, 0,
-	0, 0, 494, 0, 0, 0, 189, 0, 0, 494,
-	154, 0, 0, 0, 0, 0, 1401, 1402, 0, 0,
-	159, 0, 0, 1203, 1203, 0, 0, 0, 494, 187,
-	0, 0, 0, 0, 494, 0, 0, 0, 0, 0,
-	0, 969, 968, 978, 979, 971, 972, 973, 974, 975,
-	976, 977, 970, 1999, 0, 980, 0, 0, 0, 1850,
-	1445, 0, 0, 0, 187, 0, 0, 0, 0, 0,
-	0, 0, 187, 0, 0, 0, 1301, 0, 494, 969,
-	968, 978, 979, 971, 972, 973, 974, 975, 976, 977,
-	970, 0, 187, 980, 0, 0, 0, 0, 0, 187,
-	0, 0, 0, 0, 0, 0, 1322, 1323, 187, 187,
-	187, 187, 187, 187, 187, 0, 0, 0, 0, 0,
-	189, 0, 146, 0, 189, 189, 189, 189, 189, 0,
-	541, 0, 0, 0, 189, 189, 189, 189, 1998, 0,
-	187, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 0, 189, 1879, 0, 0, 0, 0,
-	0, 0, 969, 968, 978, 979, 971, 972, 973, 974,
-	975, 976, 977, 970, 0, 0, 980, 0, 189, 494,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 492,
-	1894, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 600, 1301, 0, 0, 0, 600, 600, 0,
-	0, 600, 600, 600, 0, 0, 0, 1203, 0, 0,
-	614, 0, 0, 760, 0, 767, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 600, 600, 600, 600,
-	600, 0, 0, 0, 0, 1440, 0, 969, 968, 978,
-	979, 971, 972, 973, 974, 975, 976, 977, 970, 0,
-	0, 980, 0, 0, 0, 187, 0, 0, 0, 0,
-	0, 1301, 187, 189, 187, 0, 0, 0, 0, 0,
-	0, 189, 187, 187, 0, 0, 0, 0, 0, 0,
-	0, 147, 152, 149, 155, 156, 157, 158, 160, 161,
-	162, 163, 0, 0, 0, 189, 0, 164, 165, 166,
-	167, 0, 0, 0, 0, 0, 189, 189, 189, 189,
-	189, 0, 1652, 0, 0, 0, 0, 0, 189, 0,
-	0, 0, 189, 0, 0, 189, 189, 0, 0, 189,
-	189, 189, 969, 968, 978, 979, 971, 972, 973, 974,
-	975, 976, 977, 970, 0, 0, 980, 0, 0, 2005,
-	0, 0, 187, 969, 968, 978, 979, 971, 972, 973,
-	974, 975, 976, 977, 970, 0, 0, 980, 0, 0,
-	0, 0, 506, 0, 0, 0, 0, 0, 0, 2028,
-	0, 0, 2029, 0, 0, 2031, 0, 0, 0, 1653,
-	0, 189, 0, 1654, 0, 0, 0, 0, 0, 0,
-	0, 0, 494, 0, 1661, 1662, 0, 0, 494, 0,
-	1668, 494, 0, 1671, 1672, 0, 0, 0, 494, 0,
-	0, 1678, 0, 1679, 0, 0, 1682, 1683, 1684, 1685,
-	1686, 0, 0, 0, 0, 0, 0, 0, 189, 0,
-	0, 0, 1696, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 0, 0, 0,
-	187, 187, 187, 187, 187, 0, 0, 0, 0, 0,
-	187, 187, 187, 187, 2086, 506, 0, 0, 1740, 1741,
-	494, 0, 0, 187, 0, 0, 0, 0, 0, 0,
-	187, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 0, 494, 0, 0, 0,
+	576, 2209, 2222, 1981, 2141, 1842, 2186, 1732, 1811, 2092,
+	2070, 520, 2150, 934, 549, 1584, 1909, 1699, 1518, 83,
+	3, 1445, 589, 1910, 1978, 1015, 1061, 535, 1906, 1551,
+	1815, 1556, 1175, 1733, 1536, 518, 1796, 1921, 1719, 1797,
+	1402, 1068, 1497, 826, 1868, 1659, 1795, 622, 147, 178,
+	1634, 606, 190, 887, 483, 190, 133, 1198, 1789, 1394,
+	499, 1582, 190, 1558, 1309, 915, 1105, 1098, 1486, 598,
+	190, 1479, 1071, 765, 791, 81, 1089, 1066, 1428, 1447,
+	1091, 1053, 522, 33, 1371, 583, 951, 1095, 773, 1088,
+	792, 511, 499, 1205, 1174, 499, 190, 499, 772, 797,
+	777, 769, 794, 793, 79, 619, 1288, 1104, 881, 1462,
+	1502, 804, 1078, 781, 110, 150, 1314, 512, 111, 1547,
+	868, 1190, 1216, 932, 1537, 1102, 116, 506, 1028, 8,
+	1834, 1833, 177, 7, 6, 1170, 1029, 78, 1613, 582,
+	117, 1856, 2094, 1857, 1442, 1443, 1360, 1359, 1358, 1357,
+	179, 180, 181, 1356, 1355, 1348, 509, 1275, 510, 604,
+	608, 112, 2178, 584, 1697, 2138, 1955, 2049, 118, 766,
+	2116, 2115, 2065, 190, 830, 2066, 2221, 829, 458, 828,
+	2228, 2183, 2161, 190, 80, 880, 2212, 831, 190, 1982,
+	1601, 507, 842, 843, 2182, 846, 847, 848, 849, 2160,
+	1649, 852, 853, 854, 855, 856, 857, 858, 859, 860,
+	861, 862, 863, 864, 865, 866, 623, 616, 1885, 2013,
+	783, 1936, 1937, 807, 785, 112, 1698, 107, 784, 184,
+	185, 35, 808, 952, 72, 39, 40, 786, 1935, 84,
+	1176, 1855, 832, 833, 834, 1647, 561, 104, 567, 568,
+	565, 566, 952, 564, 563, 562, 1512, 922, 839, 924,
+	908, 1561, 1503, 569, 570, 845, 1444, 1405, 1620, 1513,
+	1514, 1106, 1619, 1107, 787, 844, 86, 87, 88, 89,
+	90, 91, 1763, 487, 105, 1762, 901, 930, 1764, 894,
+	895, 907, 580, 112, 579, 883, 921, 923, 962, 1780,
+	1530, 2163, 107, 2004, 99, 1844, 71, 2002, 497, 102,
+	892, 171, 101, 100, 893, 894, 895, 962, 1347, 1349,
+	1350, 1351, 2128, 977, 976, 986, 987, 979, 980, 981,
+	982, 983, 984, 985, 978, 486, 113, 988, 135, 495,
+	1560, 501, 1816, 1583, 2211, 1265, 1838, 155, 1616, 909,
+	1294, 1297, 1289, 1298, 1839, 1299, 176, 869, 928, 105,
+	914, 179, 180, 181, 912, 913, 929, 910, 911, 179,
+	180, 181, 1847, 950, 1628, 902, 487, 1845, 145, 876,
+	851, 850, 2179, 134, 106, 487, 1293, 1266, 958, 1267,
+	1846, 2112, 1291, 2060, 920, 815, 1585, 919, 925, 813,
+	1480, 152, 824, 153, 1295, 823, 822, 958, 122, 123,
+	144, 143, 170, 918, 821, 820, 819, 818, 817, 812,
+	788, 476, 1184, 825, 2061, 770, 770, 1292, 486, 768,
+	475, 800, 2229, 2198, 1954, 770, 109, 486, 1204, 1203,
+	473, 107, 172, 799, 1700, 1702, 882, 2226, 782, 610,
+	1848, 1603, 1607, 1302, 938, 1805, 1503, 835, 190, 106,
+	139, 120, 146, 127, 119, 1615, 140, 141, 2159, 1894,
+	156, 806, 1893, 1892, 780, 779, 841, 926, 778, 470,
+	161, 128, 806, 1826, 499, 499, 499, 816, 481, 806,
+	905, 814, 879, 776, 457, 131, 129, 124, 125, 126,
+	130, 927, 499, 499, 182, 121, 1627, 1562, 1618, 1626,
+	2164, 1431, 2145, 890, 132, 896, 897, 898, 899, 900,
+	2151, 1648, 1633, 944, 957, 954, 955, 956, 961, 963,
+	960, 487, 959, 1000, 1001, 2129, 931, 2033, 1934, 487,
+	1701, 1724, 953, 957, 954, 955, 956, 961, 963, 960,
+	1869, 959, 1636, 73, 1667, 1593, 1519, 1635, 459, 461,
+	462, 953, 479, 480, 488, 175, 969, 1508, 477, 478,
+	489, 463, 464, 493, 492, 1602, 469, 466, 468, 474,
+	190, 806, 148, 486, 472, 490, 1277, 1276, 1278, 1279,
+	1280, 486, 806, 1871, 2224, 592, 1082, 2225, 106, 2223,
+	998, 1678, 512, 891, 1013, 1059, 805, 499, 935, 936,
+	190, 1026, 190, 190, 875, 499, 885, 805, 1636, 840,
+	904, 499, 988, 1635, 805, 1058, 978, 1759, 619, 988,
+	809, 799, 906, 947, 1016, 142, 1675, 945, 946, 1458,
+	810, 1344, 1064, 1067, 1777, 1772, 968, 136, 967, 965,
+	137, 1873, 916, 1877, 1087, 1872, 94, 1870, 811, 2120,
+	1054, 889, 1875, 827, 873, 968, 1919, 1315, 1072, 1290,
+	1887, 1874, 977, 976, 986, 987, 979, 980, 981, 982,
+	983, 984, 985, 978, 1876, 1878, 988, 1108, 1773, 948,
+	874, 1031, 1033, 1035, 1037, 1039, 1041, 1042, 1429, 1032,
+	1034, 95, 1038, 1040, 965, 1043, 1051, 806, 1600, 491,
+	1775, 1181, 1429, 1770, 1685, 1598, 805, 179, 180, 181,
+	968, 1396, 809, 799, 465, 1771, 815, 805, 813, 1595,
+	484, 1660, 810, 1939, 799, 802, 803, 1075, 770, 623,
+	1000, 1001, 796, 800, 870, 485, 871, 2048, 1460, 872,
+	179, 180, 181, 1599, 149, 154, 151, 157, 158, 159,
+	160, 162, 163, 164, 165, 190, 2213, 174, 917, 1166,
+	166, 167, 168, 169, 888, 1000, 1001, 1397, 2230, 1177,
+	1178, 1179, 1180, 1316, 1778, 1776, 979, 980, 981, 982,
+	983, 984, 985, 978, 2214, 499, 988, 1200, 981, 982,
+	983, 984, 985, 978, 1595, 1209, 988, 2047, 1960, 1213,
+	1785, 1459, 499, 499, 1103, 499, 1284, 499, 499, 1210,
+	499, 499, 499, 499, 499, 499, 1060, 1793, 1597, 1792,
+	1182, 1183, 1652, 1653, 1654, 499, 966, 967, 965, 190,
+	1249, 2203, 805, 1282, 1244, 1245, 2231, 1189, 1272, 799,
+	802, 803, 71, 770, 968, 1262, 1378, 796, 800, 966,
+	967, 965, 1208, 1070, 1374, 775, 499, 1889, 1196, 2204,
+	1376, 1377, 1375, 1565, 190, 1283, 795, 968, 1285, 179,
+	180, 181, 190, 1766, 1308, 1270, 190, 1269, 1252, 1253,
+	1173, 1774, 1268, 1260, 1258, 1259, 1172, 1207, 1254, 1463,
+	1464, 1165, 1281, 190, 1251, 609, 614, 1271, 1246, 1896,
+	190, 1186, 1250, 1225, 1206, 1206, 1187, 190, 190, 190,
+	190, 190, 190, 190, 190, 190, 499, 499, 499, 1199,
+	1185, 2216, 2215, 1311, 2205, 1218, 2194, 1219, 1674, 1221,
+	1223, 1313, 1673, 1227, 1229, 1231, 1233, 1235, 2083, 2045,
+	1672, 190, 966, 967, 965, 1319, 1841, 1897, 1317, 1318,
+	1247, 2021, 1323, 1942, 1325, 1326, 1327, 1328, 1898, 1330,
+	968, 1802, 171, 1322, 1790, 966, 967, 965, 1643, 1794,
+	1329, 966, 967, 965, 593, 1611, 1372, 1610, 1303, 1395,
+	785, 112, 1312, 968, 784, 611, 612, 113, 1398, 968,
+	179, 180, 181, 966, 967, 965, 1273, 1261, 155, 179,
+	180, 181, 499, 1577, 1257, 1256, 1361, 1362, 1363, 1364,
+	1321, 968, 966, 967, 965, 1255, 1057, 1406, 2110, 1366,
+	1368, 1369, 2109, 1417, 1420, 1907, 1399, 1400, 1354, 1430,
+	968, 1367, 1967, 2197, 1918, 499, 499, 1967, 2157, 1340,
+	1341, 1342, 1967, 2146, 1373, 80, 190, 1980, 1412, 1967,
+	593, 1408, 152, 1818, 153, 179, 180, 181, 1804, 1575,
+	499, 1415, 1416, 170, 1967, 2118, 1527, 190, 1407, 1753,
+	499, 1453, 1452, 1016, 190, 1596, 190, 1503, 1436, 1437,
+	1918, 1465, 2063, 593, 190, 190, 1406, 2028, 179, 180,
+	181, 499, 1263, 964, 499, 1595, 593, 82, 512, 2031,
+	593, 1967, 1972, 1498, 619, 499, 2119, 619, 1967, 1409,
+	986, 987, 979, 980, 981, 982, 983, 984, 985, 978,
+	1408, 156, 988, 593, 538, 537, 540, 541, 542, 543,
+	1595, 161, 1473, 539, 1720, 544, 1720, 1477, 976, 986,
+	987, 979, 980, 981, 982, 983, 984, 985, 978, 1504,
+	1517, 988, 1522, 1538, 1539, 1540, 1952, 1951, 1948, 1949,
+	499, 1948, 1947, 1471, 190, 1471, 593, 499, 1503, 1835,
+	1169, 1820, 1501, 1574, 1576, 593, 1813, 1814, 1523, 1526,
+	1483, 593, 1475, 1482, 1553, 35, 499, 964, 593, 1169,
+	1168, 1559, 499, 1114, 1113, 1525, 1209, 1506, 1209, 1510,
+	1950, 1483, 1511, 1483, 1472, 1918, 1594, 1690, 1524, 1555,
+	1689, 1505, 1471, 1595, 1578, 623, 1509, 1461, 623, 1507,
+	1440, 977, 976, 986, 987, 979, 980, 981, 982, 983,
+	984, 985, 978, 148, 1483, 988, 499, 1352, 1395, 586,
+	1301, 1100, 790, 1395, 1395, 789, 2149, 1531, 1563, 1532,
+	1533, 1534, 1535, 1591, 71, 1592, 2072, 1554, 1504, 1581,
+	71, 577, 1570, 1571, 1572, 1543, 1544, 1545, 1546, 1566,
+	1564, 1549, 1550, 1843, 1471, 1413, 1414, 1979, 190, 1419,
+	1422, 1423, 190, 190, 190, 190, 190, 807, 1590, 1586,
+	1587, 1554, 190, 190, 190, 190, 808, 1606, 1605, 2039,
+	1171, 1552, 1608, 1609, 1435, 190, 1206, 1438, 1439, 1604,
+	1840, 1588, 190, 191, 71, 1548, 191, 1542, 1859, 35,
+	1505, 500, 2050, 191, 1541, 1287, 35, 1240, 1503, 1201,
+	1197, 191, 1167, 96, 1798, 2016, 190, 499, 977, 976,
+	986, 987, 979, 980, 981, 982, 983, 984, 985, 978,
+	1799, 1727, 988, 500, 2054, 176, 500, 191, 500, 1922,
+	1923, 1638, 1639, 2073, 1176, 1614, 1641, 2099, 2218, 2210,
+	2051, 2052, 2053, 1642, 1728, 1241, 1242, 1243, 1925, 1799,
+	1372, 1631, 977, 976, 986, 987, 979, 980, 981, 982,
+	983, 984, 985, 978, 71, 1237, 988, 1928, 1907, 2055,
+	2056, 71, 1809, 1808, 2015, 149, 154, 151, 157, 158,
+	159, 160, 162, 163, 164, 165, 1807, 1568, 1345, 1304,
+	1927, 166, 167, 168, 169, 1669, 1741, 1744, 2200, 1646,
+	1742, 190, 1745, 1740, 191, 1743, 2181, 1899, 1069, 190,
+	1238, 1239, 1709, 1746, 191, 1492, 1493, 2032, 1373, 191,
+	1655, 977, 976, 986, 987, 979, 980, 981, 982, 983,
+	984, 985, 978, 190, 1970, 988, 1718, 1717, 2169, 2166,
+	1706, 1686, 2202, 2185, 190, 190, 190, 190, 190, 2187,
+	1707, 98, 1713, 584, 1734, 1668, 190, 2192, 1708, 1729,
+	190, 2193, 103, 190, 190, 2142, 1684, 190, 190, 190,
+	1300, 1710, 1711, 1067, 2140, 1722, 578, 1725, 1803, 1751,
+	1765, 1054, 1696, 837, 836, 1991, 1410, 1411, 1704, 1798,
+	1488, 1491, 1492, 1493, 1489, 1062, 1490, 1494, 1784, 1712,
+	1922, 1923, 183, 1723, 1721, 1754, 1854, 1063, 937, 1756,
+	173, 1425, 1828, 186, 1827, 1783, 1311, 1786, 1787, 1788,
+	113, 1736, 1737, 1747, 1739, 2097, 1426, 1781, 1782, 190,
+	1768, 1454, 1752, 1735, 1944, 1757, 1738, 1943, 1760, 1589,
+	499, 1488, 1491, 1492, 1493, 1489, 499, 1490, 1494, 499,
+	1559, 1209, 1215, 1214, 1202, 1817, 499, 1821, 1769, 2026,
+	599, 1791, 1463, 1464, 1801, 599, 1456, 1823, 1832, 1573,
+	1307, 2111, 2067, 1496, 1716, 600, 190, 587, 588, 1651,
+	600, 1800, 1715, 590, 2207, 1831, 2206, 2190, 2170, 2025,
+	1966, 1579, 591, 82, 190, 1189, 1830, 2024, 1073, 1074,
+	602, 1408, 601, 596, 597, 602, 1902, 601, 1822, 1720,
+	2220, 2219, 586, 1679, 1676, 1083, 1076, 2220, 1407, 80,
+	2143, 1941, 1457, 85, 77, 1, 1829, 471, 499, 2010,
+	1441, 1664, 1665, 1052, 1395, 482, 2208, 1274, 1264, 1850,
+	1983, 1865, 1849, 2069, 1973, 1557, 798, 138, 1520, 1521,
+	2153, 93, 1682, 763, 92, 801, 1867, 903, 1580, 2064,
+	1779, 1529, 1858, 1852, 499, 1120, 1853, 1864, 1118, 1119,
+	1866, 1117, 1122, 1121, 1116, 190, 1346, 496, 1495, 1880,
+	1109, 1077, 838, 460, 1886, 499, 1953, 1343, 1612, 191,
+	467, 499, 499, 1879, 996, 1908, 1714, 1888, 1865, 1734,
+	1761, 620, 613, 1911, 1913, 2191, 2167, 2165, 1905, 2139,
+	2093, 2168, 2137, 2201, 190, 500, 500, 500, 2184, 1528,
+	1455, 1065, 2023, 1901, 1683, 1025, 1427, 1092, 521, 1451,
+	1365, 1917, 1903, 500, 500, 536, 1926, 533, 977, 976,
+	986, 987, 979, 980, 981, 982, 983, 984, 985, 978,
+	534, 1930, 988, 1932, 1466, 1933, 1931, 1726, 970, 519,
+	1945, 1946, 513, 1084, 1961, 1487, 190, 1485, 190, 190,
+	190, 1484, 1305, 1096, 499, 1924, 1938, 1920, 1090, 1470,
+	1617, 1837, 949, 595, 508, 97, 1895, 190, 1969, 1424,
+	2127, 1650, 2012, 594, 1957, 61, 1956, 38, 503, 2177,
+	940, 1974, 1958, 1959, 1984, 499, 499, 499, 603, 190,
+	32, 191, 1971, 1559, 1916, 31, 30, 29, 1992, 28,
+	1977, 23, 22, 21, 1976, 977, 976, 986, 987, 979,
+	980, 981, 982, 983, 984, 985, 978, 20, 500, 988,
+	19, 191, 25, 191, 191, 18, 500, 1968, 17, 1995,
+	16, 108, 500, 48, 45, 43, 115, 114, 46, 42,
+	877, 27, 26, 2000, 15, 14, 1989, 1990, 13, 12,
+	1662, 11, 10, 9, 1663, 5, 4, 943, 24, 1014,
+	2, 0, 0, 0, 0, 1670, 1671, 0, 0, 0,
+	1734, 1677, 2022, 0, 1680, 1681, 0, 2027, 0, 0,
+	0, 2014, 1687, 0, 1688, 0, 0, 1691, 1692, 1693,
+	1694, 1695, 2036, 0, 0, 0, 0, 0, 0, 2035,
+	0, 0, 0, 1705, 512, 0, 0, 499, 499, 2043,
+	2042, 2037, 2041, 0, 2038, 2058, 0, 2040, 0, 0,
+	499, 0, 2044, 499, 2046, 0, 0, 2057, 2068, 0,
+	0, 0, 0, 0, 0, 0, 2071, 0, 0, 2076,
+	0, 0, 0, 0, 0, 1997, 1998, 0, 1999, 1749,
+	1750, 2001, 0, 2003, 0, 0, 0, 0, 499, 499,
+	499, 190, 2074, 0, 0, 0, 2086, 2088, 2089, 0,
+	0, 0, 499, 2075, 499, 2082, 191, 0, 0, 0,
+	499, 0, 2090, 1911, 0, 0, 0, 1911, 2105, 2102,
+	2100, 2096, 2098, 0, 0, 0, 2091, 0, 2104, 0,
+	0, 0, 190, 2107, 2106, 2108, 500, 0, 0, 0,
+	0, 0, 0, 499, 190, 0, 2095, 512, 0, 2114,
+	0, 2121, 0, 500, 500, 0, 500, 2117, 500, 500,
+	0, 500, 500, 500, 500, 500, 500, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 500, 0, 2136, 0,
+	191, 0, 0, 0, 1911, 2009, 2144, 0, 0, 0,
+	499, 499, 0, 0, 0, 0, 0, 0, 0, 0,
+	2152, 2147, 0, 2071, 2154, 0, 0, 500, 0, 0,
+	0, 0, 0, 0, 0, 191, 499, 2162, 0, 0,
+	499, 2171, 0, 191, 0, 1734, 0, 191, 2176, 0,
+	2173, 0, 0, 2180, 0, 0, 0, 0, 0, 0,
+	2189, 2188, 0, 0, 191, 0, 1862, 1863, 0, 0,
+	0, 191, 0, 0, 2199, 548, 0, 0, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 500, 500, 500,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2217, 0, 0, 0, 0, 0, 0, 0, 171, 0,
+	0, 0, 191, 2227, 977, 976, 986, 987, 979, 980,
+	981, 982, 983, 984, 985, 978, 0, 189, 988, 0,
+	494, 0, 1914, 113, 0, 0, 0, 189, 0, 0,
+	0, 0, 0, 0, 155, 189, 0, 0, 0, 0,
+	0, 0, 0, 1929, 0, 0, 0, 0, 0, 0,
+	0, 0, 607, 607, 0, 0, 0, 0, 0, 0,
+	0, 189, 0, 500, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1767, 0, 0, 0, 2008,
+	0, 0, 0, 0, 0, 0, 0, 0, 152, 0,
+	153, 0, 0, 0, 0, 0, 500, 500, 0, 170,
+	0, 0, 0, 0, 0, 0, 0, 191, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 547,
+	2007, 500, 0, 0, 0, 0, 0, 0, 191, 0,
+	0, 500, 0, 0, 0, 191, 0, 191, 189, 0,
+	0, 0, 0, 0, 0, 191, 191, 0, 189, 0,
+	0, 0, 500, 189, 0, 500, 1994, 156, 0, 0,
+	1996, 0, 0, 0, 0, 0, 500, 161, 0, 0,
+	0, 2005, 2006, 0, 0, 0, 0, 0, 0, 498,
+	0, 0, 0, 0, 0, 0, 0, 2020, 977, 976,
+	986, 987, 979, 980, 981, 982, 983, 984, 985, 978,
+	0, 0, 988, 0, 2029, 2030, 0, 0, 2034, 0,
+	0, 621, 0, 0, 767, 0, 774, 0, 0, 0,
+	0, 500, 0, 0, 0, 191, 0, 0, 500, 977,
+	976, 986, 987, 979, 980, 981, 982, 983, 984, 985,
+	978, 0, 0, 988, 0, 0, 0, 500, 0, 0,
+	0, 0, 0, 500, 35, 36, 37, 72, 39, 40,
+	0, 0, 515, 0, 0, 2062, 0, 0, 0, 148,
+	0, 0, 0, 0, 76, 0, 0, 0, 0, 41,
+	67, 68, 0, 65, 69, 0, 0, 0, 0, 0,
+	66, 0, 0, 0, 0, 0, 0, 500, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2087,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 54,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 71,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 191,
+	0, 0, 0, 191, 191, 191, 191, 191, 0, 0,
+	0, 0, 0, 191, 191, 191, 191, 0, 0, 0,
+	0, 0, 0, 0, 171, 0, 191, 0, 0, 2123,
+	2124, 2125, 2126, 191, 2130, 1810, 2131, 2132, 2133, 0,
+	2134, 2135, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 135, 0, 0, 0, 0, 0, 191, 500, 0,
+	155, 44, 47, 50, 49, 52, 0, 64, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2158,
+	0, 0, 0, 189, 0, 0, 0, 0, 0, 0,
+	0, 145, 53, 75, 74, 0, 134, 62, 63, 51,
+	0, 149, 154, 151, 157, 158, 159, 160, 162, 163,
+	164, 165, 0, 0, 152, 0, 153, 166, 167, 168,
+	169, 1192, 1193, 144, 143, 170, 2195, 2196, 0, 0,
+	0, 0, 0, 0, 55, 56, 0, 57, 58, 59,
+	60, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 1194, 146, 0, 1191, 0, 140,
+	141, 0, 0, 156, 191, 0, 0, 0, 0, 0,
+	0, 0, 0, 161, 0, 191, 191, 191, 191, 191,
+	0, 0, 0, 0, 0, 189, 0, 191, 0, 0,
+	0, 191, 0, 0, 191, 191, 0, 0, 191, 191,
+	191, 607, 1661, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 189, 73, 189, 1099, 0,
+	0, 0, 977, 976, 986, 987, 979, 980, 981, 982,
+	983, 984, 985, 978, 0, 0, 988, 0, 0, 0,
+	0, 0, 0, 621, 621, 621, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	191, 939, 941, 0, 0, 0, 0, 0, 0, 0,
+	0, 500, 0, 0, 0, 148, 0, 500, 0, 0,
+	500, 0, 0, 0, 0, 0, 0, 500, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 191, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 191, 0, 0, 142, 0,
+	0, 0, 0, 70, 0, 0, 0, 0, 0, 0,
+	136, 0, 0, 137, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 500,
+	0, 0, 0, 0, 0, 0, 1080, 0, 0, 0,
+	189, 0, 0, 0, 621, 0, 0, 0, 0, 0,
+	1110, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 500, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 191, 0, 0, 0,
+	0, 0, 0, 1212, 0, 0, 500, 0, 0, 0,
+	0, 0, 500, 500, 0, 0, 1002, 1003, 1004, 1005,
+	1006, 1007, 1008, 1009, 1010, 1011, 0, 0, 1212, 1212,
+	0, 0, 0, 0, 189, 191, 0, 149, 154, 151,
+	157, 158, 159, 160, 162, 163, 164, 165, 0, 0,
+	0, 0, 0, 166, 167, 168, 169, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
 	0, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 494, 0, 0,
-	0, 0, 0, 494, 494, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1310, 0, 0, 0, 0, 0, 191, 0, 191,
+	191, 191, 0, 0, 0, 500, 0, 0, 189, 0,
+	0, 0, 0, 0, 0, 189, 0, 0, 191, 0,
+	0, 0, 1331, 1332, 189, 189, 189, 189, 189, 189,
+	189, 0, 0, 0, 0, 0, 500, 500, 500, 0,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 767, 0, 189, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1211, 0, 0,
+	0, 1217, 1217, 0, 1217, 0, 1217, 1217, 0, 1226,
+	1217, 1217, 1217, 1217, 1217, 0, 0, 972, 0, 975,
+	0, 0, 1211, 1211, 767, 989, 990, 991, 992, 993,
+	994, 995, 0, 973, 974, 971, 977, 976, 986, 987,
+	979, 980, 981, 982, 983, 984, 985, 978, 607, 1310,
+	988, 0, 0, 607, 607, 1286, 0, 607, 607, 607,
+	0, 0, 0, 1212, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 607, 607, 607, 607, 607, 0, 500, 500,
+	0, 1449, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 500, 0, 0, 500, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 0, 621, 621, 621, 1310, 189,
+	0, 189, 550, 34, 0, 0, 0, 0, 0, 189,
+	189, 0, 0, 0, 0, 0, 0, 0, 0, 500,
+	500, 500, 191, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 500, 0, 500, 0, 34, 0, 0,
+	0, 500, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 191, 0, 0, 0, 0, 0, 1137,
+	0, 1055, 0, 0, 500, 191, 0, 0, 0, 0,
+	0, 1401, 585, 621, 0, 0, 0, 0, 0, 189,
+	0, 0, 0, 0, 0, 0, 0, 1211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1433, 1434, 0, 0, 0, 0,
+	0, 500, 500, 188, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 502, 0, 0, 0, 0, 0, 1467,
+	0, 581, 0, 0, 0, 0, 0, 500, 0, 1080,
+	0, 500, 621, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 771, 0, 0,
+	621, 0, 0, 621, 0, 0, 0, 0, 0, 0,
+	0, 0, 1125, 1370, 767, 0, 1379, 1380, 1381, 1382,
+	1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392,
+	1393, 0, 0, 189, 0, 0, 0, 189, 189, 189,
+	189, 189, 0, 0, 0, 0, 0, 189, 189, 189,
+	189, 0, 0, 0, 0, 1138, 0, 0, 0, 0,
+	189, 0, 0, 0, 0, 0, 0, 189, 0, 774,
+	0, 0, 0, 1432, 867, 0, 1569, 0, 0, 0,
+	0, 0, 0, 0, 878, 0, 0, 0, 0, 884,
+	0, 189, 0, 0, 0, 767, 0, 0, 0, 0,
+	0, 774, 1151, 1154, 1155, 1156, 1157, 1158, 1159, 0,
+	1160, 1161, 1162, 1163, 1164, 1139, 1140, 1141, 1142, 1123,
+	1124, 1152, 0, 1126, 0, 1127, 1128, 1129, 1130, 1131,
+	1132, 1133, 1134, 1135, 1136, 1143, 1144, 1145, 1146, 1147,
+	1148, 1149, 1150, 0, 0, 767, 0, 0, 0, 607,
+	607, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	607, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 189, 0, 0, 0,
-	0, 0, 600, 600, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 614, 614,
-	614, 0, 0, 600, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 931, 933, 0, 187,
-	0, 0, 0, 0, 0, 0, 0, 1440, 189, 0,
-	189, 189, 189, 0, 0, 0, 494, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	600, 187, 0, 0, 0, 1853, 1854, 0, 0, 0,
-	0, 1203, 187, 187, 187, 187, 187, 494, 494, 494,
-	0, 189, 0, 0, 1739, 0, 0, 0, 187, 0,
-	0, 187, 187, 0, 0, 187, 1749, 1301, 0, 0,
-	0, 0, 0, 0, 1047, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1071, 1905, 0, 0, 0, 0, 0, 0, 614, 0,
-	0, 0, 0, 0, 1101, 0, 0, 0, 0, 0,
-	0, 0, 1920, 0, 0, 186, 0, 187, 0, 0,
-	0, 0, 0, 0, 0, 496, 0, 0, 0, 0,
-	0, 0, 1203, 575, 0, 0, 0, 0, 0, 0,
-	0, 0, 1301, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 764, 494,
-	494, 0, 0, 0, 187, 0, 0, 0, 0, 0,
-	0, 0, 494, 0, 0, 494, 0, 0, 0, 0,
-	0, 0, 187, 544, 33, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	494, 494, 494, 189, 0, 600, 0, 33, 0, 0,
-	0, 0, 0, 0, 494, 1985, 494, 0, 0, 1987,
-	0, 0, 494, 0, 0, 860, 0, 0, 0, 0,
-	1996, 1997, 0, 0, 0, 871, 0, 0, 0, 0,
-	877, 0, 0, 0, 189, 0, 2011, 0, 0, 0,
-	0, 578, 0, 187, 0, 494, 189, 0, 0, 0,
-	0, 0, 0, 2020, 2021, 0, 1203, 2025, 760, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1202, 0, 0, 0, 1208, 1208, 0, 1208, 0,
-	1208, 1208, 187, 1217, 1208, 1208, 1208, 1208, 1208, 0,
-	0, 0, 494, 494, 0, 0, 1202, 1202, 760, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2053, 0, 0, 0, 494, 0,
-	0, 0, 494, 0, 0, 0, 0, 0, 0, 1277,
-	0, 0, 0, 0, 187, 0, 187, 187, 187, 0,
-	0, 0, 0, 0, 0, 1203, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 0, 0, 2078, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 614, 614,
-	614, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2114, 2115,
-	2116, 2117, 0, 2121, 0, 2122, 2123, 2124, 0, 2125,
-	2126, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1203, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2149, 0,
-	0, 0, 0, 0, 1392, 0, 614, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1202, 0, 0, 0, 0, 0, 0, 879, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1424, 1425, 0,
-	0, 0, 0, 0, 0, 2186, 2187, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 169, 0, 0,
-	0, 1458, 0, 0, 0, 0, 0, 0, 1801, 0,
-	0, 1071, 0, 0, 614, 1128, 0, 0, 0, 1440,
-	0, 0, 111, 0, 133, 0, 0, 0, 0, 0,
-	0, 0, 614, 153, 0, 614, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 760, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 0, 0, 0, 143, 0, 0, 0, 0, 132,
-	0, 0, 187, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 150, 0, 151,
-	0, 0, 0, 0, 1183, 1184, 142, 141, 168, 0,
-	0, 767, 0, 0, 0, 0, 0, 0, 1560, 0,
-	0, 925, 925, 925, 0, 0, 0, 1077, 0, 0,
-	1088, 0, 0, 0, 0, 0, 0, 760, 1116, 0,
-	0, 33, 0, 767, 0, 0, 0, 0, 0, 0,
-	0, 0, 1203, 0, 989, 991, 137, 1185, 144, 0,
-	1182, 0, 138, 139, 0, 0, 154, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 0, 0,
-	0, 1129, 0, 0, 0, 1004, 0, 760, 0, 1009,
-	1010, 1011, 1012, 1013, 1014, 1015, 1016, 0, 1019, 1022,
-	1022, 1022, 1028, 1022, 1022, 1028, 1022, 1036, 1037, 1038,
-	1039, 1040, 1041, 1042, 0, 0, 0, 0, 0, 1048,
-	0, 0, 33, 0, 0, 0, 0, 0, 1142, 1145,
-	1146, 1147, 1148, 1149, 1150, 0, 1151, 1152, 1153, 1154,
-	1155, 1130, 1131, 1132, 1133, 1114, 1115, 1143, 1084, 1117,
-	0, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126,
-	1127, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 146, 0,
-	0, 0, 1106, 0, 0, 0, 0, 0, 1636, 0,
+	0, 0, 0, 0, 1449, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1153, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 607, 189, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1212, 189,
+	189, 189, 189, 189, 0, 0, 0, 0, 0, 0,
+	0, 1748, 0, 0, 0, 189, 1645, 0, 189, 189,
+	0, 0, 189, 1758, 1310, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 140, 0, 1144, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 134, 0, 0, 135, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1239, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 189, 0, 933, 933, 933, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1212,
+	0, 0, 0, 0, 0, 0, 34, 0, 0, 1310,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 997,
+	999, 0, 0, 0, 0, 0, 0, 0, 0, 886,
+	0, 189, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	1012, 0, 1211, 0, 1017, 1018, 1019, 1020, 1021, 1022,
+	1023, 1024, 0, 1027, 1030, 1030, 1030, 1036, 1030, 1030,
+	1036, 1030, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 0,
+	0, 0, 607, 0, 1056, 0, 0, 0, 34, 1656,
+	1657, 1658, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1093, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1287, 0, 0, 0, 0, 0, 0, 0, 1297,
+	189, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1212, 0, 0, 0, 0, 0, 1812,
+	0, 0, 0, 1211, 0, 1819, 0, 0, 1812, 0,
+	0, 0, 0, 621, 0, 1824, 0, 0, 0, 189,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1311,
-	0, 0, 0, 0, 1202, 0, 1315, 0, 0, 0,
-	0, 0, 0, 0, 0, 1324, 1325, 1326, 1327, 1328,
-	1329, 1330, 0, 0, 0, 0, 0, 147, 152, 149,
-	155, 156, 157, 158, 160, 161, 162, 163, 0, 0,
-	0, 0, 0, 164, 165, 166, 167, 1088, 0, 0,
-	0, 34, 35, 36, 70, 38, 39, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 169, 0,
-	0, 74, 0, 0, 0, 0, 40, 66, 67, 1179,
-	64, 68, 0, 0, 0, 0, 0, 65, 0, 0,
-	0, 0, 0, 111, 0, 133, 0, 0, 0, 0,
-	0, 1803, 0, 0, 153, 1202, 0, 1810, 0, 0,
-	1803, 0, 0, 0, 0, 614, 53, 1815, 0, 0,
-	0, 0, 0, 0, 0, 0, 69, 0, 0, 0,
-	0, 0, 0, 0, 0, 143, 0, 0, 0, 0,
-	132, 925, 925, 925, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 150, 0,
-	151, 0, 0, 0, 0, 1183, 1184, 142, 141, 168,
-	0, 0, 1465, 0, 0, 0, 0, 0, 0, 1469,
-	0, 1472, 0, 0, 0, 0, 0, 0, 0, 614,
-	1491, 0, 0, 0, 0, 0, 0, 0, 43, 46,
-	49, 48, 51, 0, 63, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 137, 1185, 144,
-	0, 1182, 0, 138, 139, 1208, 0, 154, 0, 52,
-	73, 72, 0, 0, 61, 62, 50, 159, 0, 0,
-	0, 0, 0, 0, 0, 0, 614, 0, 0, 1202,
-	0, 0, 1906, 1208, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1558,
-	0, 54, 55, 0, 56, 57, 58, 59, 0, 0,
+	0, 1086, 0, 0, 1097, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1490, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 189, 0, 189, 189, 189, 0, 0, 0, 0,
+	0, 0, 1212, 0, 0, 0, 0, 621, 0, 0,
+	0, 0, 189, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 760, 0, 0, 1202, 146,
+	0, 0, 0, 0, 189, 0, 0, 0, 0, 0,
+	0, 0, 0, 1217, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 621, 0, 0, 1211, 0, 0,
+	1915, 1217, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1976, 1977, 1978, 0,
-	0, 0, 0, 71, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1088, 0, 0, 0, 1612, 1613, 1614,
-	1615, 1616, 140, 0, 0, 0, 0, 1620, 1621, 1088,
-	1623, 0, 0, 0, 134, 0, 0, 135, 0, 0,
-	1628, 0, 0, 0, 0, 0, 0, 1631, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1212, 0, 1115, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1202, 1635, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1188, 0, 1860, 1861, 0, 0, 0, 0, 0,
+	0, 0, 0, 767, 0, 113, 1211, 135, 1881, 1882,
+	0, 1883, 1884, 0, 0, 0, 155, 0, 0, 0,
+	0, 0, 1890, 1891, 0, 0, 0, 0, 0, 0,
+	1248, 0, 0, 0, 1985, 1986, 1987, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 145, 933, 933,
+	933, 0, 134, 0, 0, 0, 1449, 0, 0, 0,
+	0, 0, 0, 0, 0, 1296, 0, 0, 0, 0,
+	152, 0, 153, 1306, 0, 0, 0, 1192, 1193, 144,
+	143, 170, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1320, 0, 0, 189, 0, 0,
+	0, 1324, 0, 0, 0, 1940, 0, 0, 1211, 189,
+	1333, 1334, 1335, 1336, 1337, 1338, 1339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	1194, 146, 0, 1191, 0, 140, 141, 0, 0, 156,
+	0, 0, 1097, 0, 0, 0, 0, 0, 0, 161,
+	0, 0, 0, 0, 0, 0, 1812, 2059, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1812,
+	0, 0, 621, 0, 0, 0, 0, 0, 0, 1212,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1993, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1812, 1812, 1812,
+	0, 0, 0, 0, 0, 0, 1499, 0, 0, 0,
+	0, 2101, 0, 2103, 0, 0, 0, 0, 0, 1812,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1803, 2050,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1803, 0, 0, 614, 0, 0, 0, 147, 152,
-	149, 155, 156, 157, 158, 160, 161, 162, 163, 0,
-	0, 0, 0, 0, 164, 165, 166, 167, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1803,
-	1803, 1803, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2092, 0, 2094, 0, 0, 0, 0,
-	0, 1803, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 148, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1812, 0, 0, 0, 0, 0, 1474, 0,
+	0, 0, 0, 0, 0, 1478, 0, 1481, 0, 0,
+	0, 0, 0, 0, 0, 0, 1500, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 142, 0, 0, 0, 0, 621,
+	621, 0, 0, 0, 0, 0, 136, 0, 0, 137,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1803, 0, 0, 0, 0, 1746,
+	0, 0, 0, 1211, 0, 2172, 0, 0, 0, 1812,
+	2077, 2078, 2079, 2080, 2081, 0, 0, 0, 2084, 2085,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1567, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1657, 0, 0, 578, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 614, 614, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1694, 0, 0, 1797, 1202, 0, 2163, 0, 0,
-	0, 1803, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1084, 0, 0,
-	0, 0, 0, 0, 1721, 1722, 0, 0, 1084, 1084,
-	1084, 1084, 1084, 0, 0, 0, 0, 0, 0, 0,
-	0, 1827, 0, 0, 1490, 0, 0, 1084, 0, 0,
-	0, 1084, 0, 0, 0, 0, 0, 0, 0, 1842,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 149, 154, 151, 157, 158, 159, 160,
+	162, 163, 164, 165, 0, 0, 0, 0, 0, 166,
+	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1097,
+	0, 2174, 0, 1621, 1622, 1623, 1624, 1625, 0, 0,
+	0, 0, 0, 1629, 1630, 1097, 1632, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1637, 0, 0, 0,
+	0, 0, 0, 1640, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1666,
+	0, 0, 585, 0, 0, 0, 0, 1644, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1703,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1891, 1816, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1093, 0, 0, 0, 0,
+	0, 0, 1730, 1731, 0, 0, 1093, 1093, 1093, 1093,
+	1093, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1499, 0, 0, 1093, 0, 0, 0, 1093,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1755, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1825,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1953, 0, 1954, 1955, 1956, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1966, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1903, 1979, 33, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1084, 0,
+	1806, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1836, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1851, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1912, 0, 34, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1093, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1900, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2002, 0, 0, 0, 0, 0, 0, 2008, 2009, 2010,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2104, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2113,
+	0, 0, 0, 0, 0, 0, 0, 1962, 0, 1963,
+	1964, 1965, 0, 0, 0, 0, 0, 0, 2011, 0,
+	0, 0, 0, 0, 0, 2017, 2018, 2019, 1975, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1988, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1903, 0, 33, 0, 1903, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 33, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1903, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 738, 725, 33, 2139, 674, 741,
-	645, 663, 750, 665, 668, 708, 625, 687, 331, 660,
-	0, 649, 621, 656, 622, 647, 676, 241, 680, 644,
-	727, 690, 740, 289, 0, 627, 650, 345, 710, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 747, 293, 697, 0, 391, 316, 0,
-	0, 0, 678, 730, 685, 721, 673, 709, 634, 696,
-	742, 661, 705, 743, 279, 225, 195, 328, 392, 255,
-	0, 0, 0, 177, 178, 179, 0, 2146, 2147, 0,
-	0, 0, 0, 0, 217, 0, 223, 702, 737, 658,
-	704, 237, 277, 243, 236, 407, 707, 753, 620, 699,
-	0, 623, 626, 749, 733, 653, 654, 0, 0, 0,
-	0, 0, 0, 0, 677, 686, 718, 671, 0, 0,
-	0, 0, 0, 0, 0, 0, 651, 0, 695, 0,
-	0, 0, 630, 624, 0, 0, 0, 0, 675, 0,
-	0, 0, 633, 0, 652, 719, 0, 618, 263, 628,
-	317, 723, 732, 672, 438, 736, 670, 669, 739, 714,
-	631, 729, 664, 288, 629, 285, 191, 205, 0, 662,
-	327, 366, 372, 728, 648, 657, 228, 655, 370, 341,
-	424, 213, 253, 363, 346, 368, 694, 712, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 643, 724, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	716, 752, 340, 371, 219, 426, 390, 638, 642, 636,
-	637, 688, 689, 639, 744, 745, 746, 720, 632, 0,
-	640, 641, 0, 726, 734, 735, 693, 190, 203, 291,
-	748, 360, 256, 449, 433, 429, 619, 635, 234, 646,
-	0, 0, 659, 666, 667, 679, 681, 682, 683, 684,
-	692, 700, 701, 703, 711, 713, 715, 717, 722, 731,
-	751, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 691, 698, 301, 250, 267, 276, 706, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 738, 725, 0,
-	0, 674, 741, 645, 663, 750, 665, 668, 708, 625,
-	687, 331, 660, 0, 649, 621, 656, 622, 647, 676,
-	241, 680, 644, 727, 690, 740, 289, 0, 627, 650,
-	345, 710, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 747, 293, 697, 0,
-	391, 316, 0, 0, 0, 678, 730, 685, 721, 673,
-	709, 634, 696, 742, 661, 705, 743, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	702, 737, 658, 704, 237, 277, 243, 236, 407, 707,
-	753, 620, 699, 0, 623, 626, 749, 733, 653, 654,
-	0, 0, 0, 0, 0, 0, 0, 677, 686, 718,
-	671, 0, 0, 0, 0, 0, 0, 1895, 0, 651,
-	0, 695, 0, 0, 0, 630, 624, 0, 0, 0,
-	0, 675, 0, 0, 0, 633, 0, 652, 719, 0,
-	618, 263, 628, 317, 723, 732, 672, 438, 736, 670,
-	669, 739, 714, 631, 729, 664, 288, 629, 285, 191,
-	205, 0, 662, 327, 366, 372, 728, 648, 657, 228,
-	655, 370, 341, 424, 213, 253, 363, 346, 368, 694,
-	712, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 643, 724, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 716, 752, 340, 371, 219, 426, 390,
-	638, 642, 636, 637, 688, 689, 639, 744, 745, 746,
-	720, 632, 0, 640, 641, 0, 726, 734, 735, 693,
-	190, 203, 291, 748, 360, 256, 449, 433, 429, 619,
-	635, 234, 646, 0, 0, 659, 666, 667, 679, 681,
-	682, 683, 684, 692, 700, 701, 703, 711, 713, 715,
-	717, 722, 731, 751, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 691, 698, 301, 250, 267,
-	276, 706, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	738, 725, 0, 0, 674, 741, 645, 663, 750, 665,
-	668, 708, 625, 687, 331, 660, 0, 649, 621, 656,
-	622, 647, 676, 241, 680, 644, 727, 690, 740, 289,
-	0, 627, 650, 345, 710, 382, 227, 298, 296, 410,
-	251, 244, 240, 226, 273, 304, 343, 400, 337, 747,
-	293, 697, 0, 391, 316, 0, 0, 0, 678, 730,
-	685, 721, 673, 709, 634, 696, 742, 661, 705, 743,
-	279, 225, 195, 328, 392, 255, 0, 0, 0, 177,
-	178, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 223, 702, 737, 658, 704, 237, 277, 243,
-	236, 407, 707, 753, 620, 699, 0, 623, 626, 749,
-	733, 653, 654, 0, 0, 0, 0, 0, 0, 0,
-	677, 686, 718, 671, 0, 0, 0, 0, 0, 0,
-	1750, 0, 651, 0, 695, 0, 0, 0, 630, 624,
-	0, 0, 0, 0, 675, 0, 0, 0, 633, 0,
-	652, 719, 0, 618, 263, 628, 317, 723, 732, 672,
-	438, 736, 670, 669, 739, 714, 631, 729, 664, 288,
-	629, 285, 191, 205, 0, 662, 327, 366, 372, 728,
-	648, 657, 228, 655, 370, 341, 424, 213, 253, 363,
-	346, 368, 694, 712, 369, 294, 412, 358, 422, 439,
-	440, 235, 321, 430, 404, 436, 448, 206, 232, 335,
-	397, 427, 388, 314, 408, 409, 284, 387, 261, 194,
-	292, 198, 399, 420, 218, 380, 0, 0, 0, 200,
-	418, 396, 311, 281, 282, 199, 0, 362, 239, 259,
-	230, 330, 415, 416, 229, 450, 208, 435, 202, 209,
-	434, 323, 411, 419, 312, 303, 201, 417, 310, 302,
-	287, 249, 269, 356, 297, 357, 270, 319, 318, 320,
-	0, 196, 0, 393, 428, 451, 215, 643, 724, 406,
-	444, 447, 0, 359, 216, 260, 248, 355, 258, 290,
-	443, 445, 446, 214, 353, 266, 334, 423, 252, 431,
-	322, 210, 272, 389, 286, 295, 716, 752, 340, 371,
-	219, 426, 390, 638, 642, 636, 637, 688, 689, 639,
-	744, 745, 746, 720, 632, 0, 640, 641, 0, 726,
-	734, 735, 693, 190, 203, 291, 748, 360, 256, 449,
-	433, 429, 619, 635, 234, 646, 0, 0, 659, 666,
-	667, 679, 681, 682, 683, 684, 692, 700, 701, 703,
-	711, 713, 715, 717, 722, 731, 751, 192, 193, 204,
-	212, 221, 233, 246, 254, 264, 268, 271, 274, 275,
-	278, 283, 300, 305, 306, 307, 308, 324, 325, 326,
-	329, 332, 333, 336, 338, 339, 342, 348, 349, 350,
-	351, 352, 354, 361, 365, 373, 374, 375, 376, 377,
-	378, 379, 383, 384, 385, 386, 394, 398, 413, 414,
-	425, 437, 441, 265, 421, 442, 0, 299, 691, 698,
-	301, 250, 267, 276, 706, 432, 395, 207, 367, 257,
-	197, 224, 211, 231, 245, 247, 280, 309, 315, 344,
-	347, 262, 242, 222, 364, 220, 381, 401, 402, 403,
-	405, 313, 238, 738, 725, 0, 0, 674, 741, 645,
-	663, 750, 665, 668, 708, 625, 687, 331, 660, 0,
-	649, 621, 656, 622, 647, 676, 241, 680, 644, 727,
-	690, 740, 289, 0, 627, 650, 345, 710, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 747, 293, 697, 0, 391, 316, 0, 0,
-	0, 678, 730, 685, 721, 673, 709, 634, 696, 742,
-	661, 705, 743, 279, 225, 195, 328, 392, 255, 0,
-	0, 0, 177, 178, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 223, 702, 737, 658, 704,
-	237, 277, 243, 236, 407, 707, 753, 620, 699, 0,
-	623, 626, 749, 733, 653, 654, 0, 0, 0, 0,
-	0, 0, 0, 677, 686, 718, 671, 0, 0, 0,
-	0, 0, 0, 1467, 0, 651, 0, 695, 0, 0,
-	0, 630, 624, 0, 0, 0, 0, 675, 0, 0,
-	0, 633, 0, 652, 719, 0, 618, 263, 628, 317,
-	723, 732, 672, 438, 736, 670, 669, 739, 714, 631,
-	729, 664, 288, 629, 285, 191, 205, 0, 662, 327,
-	366, 372, 728, 648, 657, 228, 655, 370, 341, 424,
-	213, 253, 363, 346, 368, 694, 712, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	643, 724, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 716,
-	752, 340, 371, 219, 426, 390, 638, 642, 636, 637,
-	688, 689, 639, 744, 745, 746, 720, 632, 0, 640,
-	641, 0, 726, 734, 735, 693, 190, 203, 291, 748,
-	360, 256, 449, 433, 429, 619, 635, 234, 646, 0,
-	0, 659, 666, 667, 679, 681, 682, 683, 684, 692,
-	700, 701, 703, 711, 713, 715, 717, 722, 731, 751,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 691, 698, 301, 250, 267, 276, 706, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 738, 725, 0, 0,
-	674, 741, 645, 663, 750, 665, 668, 708, 625, 687,
-	331, 660, 0, 649, 621, 656, 622, 647, 676, 241,
-	680, 644, 727, 690, 740, 289, 0, 627, 650, 345,
-	710, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 747, 293, 697, 0, 391,
-	316, 0, 0, 0, 678, 730, 685, 721, 673, 709,
-	634, 696, 742, 661, 705, 743, 279, 225, 195, 328,
-	392, 255, 69, 0, 0, 177, 178, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 702,
-	737, 658, 704, 237, 277, 243, 236, 407, 707, 753,
-	620, 699, 0, 623, 626, 749, 733, 653, 654, 0,
-	0, 0, 0, 0, 0, 0, 677, 686, 718, 671,
-	0, 0, 0, 0, 0, 0, 0, 0, 651, 0,
-	695, 0, 0, 0, 630, 624, 0, 0, 0, 0,
-	675, 0, 0, 0, 633, 0, 652, 719, 0, 618,
-	263, 628, 317, 723, 732, 672, 438, 736, 670, 669,
-	739, 714, 631, 729, 664, 288, 629, 285, 191, 205,
-	0, 662, 327, 366, 372, 728, 648, 657, 228, 655,
-	370, 341, 424, 213, 253, 363, 346, 368, 694, 712,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 643, 724, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 716, 752, 340, 371, 219, 426, 390, 638,
-	642, 636, 637, 688, 689, 639, 744, 745, 746, 720,
-	632, 0, 640, 641, 0, 726, 734, 735, 693, 190,
-	203, 291, 748, 360, 256, 449, 433, 429, 619, 635,
-	234, 646, 0, 0, 659, 666, 667, 679, 681, 682,
-	683, 684, 692, 700, 701, 703, 711, 713, 715, 717,
-	722, 731, 751, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 691, 698, 301, 250, 267, 276,
-	706, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 738,
-	725, 0, 0, 674, 741, 645, 663, 750, 665, 668,
-	708, 625, 687, 331, 660, 0, 649, 621, 656, 622,
-	647, 676, 241, 680, 644, 727, 690, 740, 289, 0,
-	627, 650, 345, 710, 382, 227, 298, 296, 410, 251,
-	244, 240, 226, 273, 304, 343, 400, 337, 747, 293,
-	697, 0, 391, 316, 0, 0, 0, 678, 730, 685,
-	721, 673, 709, 634, 696, 742, 661, 705, 743, 279,
-	225, 195, 328, 392, 255, 0, 0, 0, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 223, 702, 737, 658, 704, 237, 277, 243, 236,
-	407, 707, 753, 620, 699, 0, 623, 626, 749, 733,
-	653, 654, 0, 0, 0, 0, 0, 0, 0, 677,
-	686, 718, 671, 0, 0, 0, 0, 0, 0, 0,
-	0, 651, 0, 695, 0, 0, 0, 630, 624, 0,
-	0, 0, 0, 675, 0, 0, 0, 633, 0, 652,
-	719, 0, 618, 263, 628, 317, 723, 732, 672, 438,
-	736, 670, 669, 739, 714, 631, 729, 664, 288, 629,
-	285, 191, 205, 0, 662, 327, 366, 372, 728, 648,
-	657, 228, 655, 370, 341, 424, 213, 253, 363, 346,
-	368, 694, 712, 369, 294, 412, 358, 422, 439, 440,
-	235, 321, 430, 404, 436, 448, 206, 232, 335, 397,
-	427, 388, 314, 408, 409, 284, 387, 261, 194, 292,
-	198, 399, 420, 218, 380, 0, 0, 0, 200, 418,
-	396, 311, 281, 282, 199, 0, 362, 239, 259, 230,
-	330, 415, 416, 229, 450, 208, 435, 202, 209, 434,
-	323, 411, 419, 312, 303, 201, 417, 310, 302, 287,
-	249, 269, 356, 297, 357, 270, 319, 318, 320, 0,
-	196, 0, 393, 428, 451, 215, 643, 724, 406, 444,
-	447, 0, 359, 216, 260, 248, 355, 258, 290, 443,
-	445, 446, 214, 353, 266, 334, 423, 252, 431, 322,
-	210, 272, 389, 286, 295, 716, 752, 340, 371, 219,
-	426, 390, 638, 642, 636, 637, 688, 689, 639, 744,
-	745, 746, 720, 632, 0, 640, 641, 0, 726, 734,
-	735, 693, 190, 203, 291, 748, 360, 256, 449, 433,
-	429, 619, 635, 234, 646, 0, 0, 659, 666, 667,
-	679, 681, 682, 683, 684, 692, 700, 701, 703, 711,
-	713, 715, 717, 722, 731, 751, 192, 193, 204, 212,
-	221, 233, 246, 254, 264, 268, 271, 274, 275, 278,
-	283, 300, 305, 306, 307, 308, 324, 325, 326, 329,
-	332, 333, 336, 338, 339, 342, 348, 349, 350, 351,
-	352, 354, 361, 365, 373, 374, 375, 376, 377, 378,
-	379, 383, 384, 385, 386, 394, 398, 413, 414, 425,
-	437, 441, 265, 421, 442, 0, 299, 691, 698, 301,
-	250, 267, 276, 706, 432, 395, 207, 367, 257, 197,
-	224, 211, 231, 245, 247, 280, 309, 315, 344, 347,
-	262, 242, 222, 364, 220, 381, 401, 402, 403, 405,
-	313, 238, 738, 725, 0, 0, 674, 741, 645, 663,
-	750, 665, 668, 708, 625, 687, 331, 660, 0, 649,
-	621, 656, 622, 647, 676, 241, 680, 644, 727, 690,
-	740, 289, 0, 627, 650, 345, 710, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 747, 293, 697, 0, 391, 316, 0, 0, 0,
-	678, 730, 685, 721, 673, 709, 634, 696, 742, 661,
-	705, 743, 279, 225, 195, 328, 392, 255, 0, 0,
-	0, 177, 178, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 223, 702, 737, 658, 704, 237,
-	277, 243, 236, 407, 707, 753, 620, 699, 0, 623,
-	626, 749, 733, 653, 654, 0, 0, 0, 0, 0,
-	0, 0, 677, 686, 718, 671, 0, 0, 0, 0,
-	0, 0, 0, 0, 651, 0, 695, 0, 0, 0,
-	630, 624, 0, 0, 0, 0, 675, 0, 0, 0,
-	633, 0, 652, 719, 0, 618, 263, 628, 317, 723,
-	732, 672, 438, 736, 670, 669, 739, 714, 631, 729,
-	664, 288, 629, 285, 191, 205, 0, 662, 327, 366,
-	372, 728, 648, 657, 228, 655, 370, 341, 424, 213,
-	253, 363, 346, 368, 694, 712, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 755, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 643,
-	724, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 617, 754, 611, 610, 286, 295, 716, 752,
-	340, 371, 219, 426, 390, 638, 642, 636, 637, 688,
-	689, 639, 744, 745, 746, 720, 632, 0, 640, 641,
-	0, 726, 734, 735, 693, 190, 203, 291, 748, 360,
-	256, 449, 433, 429, 619, 635, 234, 646, 0, 0,
-	659, 666, 667, 679, 681, 682, 683, 684, 692, 700,
-	701, 703, 711, 713, 715, 717, 722, 731, 751, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	691, 698, 301, 250, 267, 276, 706, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 738, 725, 0, 0, 674,
-	741, 645, 663, 750, 665, 668, 708, 625, 687, 331,
-	660, 0, 649, 621, 656, 622, 647, 676, 241, 680,
-	644, 727, 690, 740, 289, 0, 627, 650, 345, 710,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 747, 293, 697, 0, 391, 316,
-	0, 0, 0, 678, 730, 685, 721, 673, 709, 634,
-	696, 742, 661, 705, 743, 279, 225, 195, 328, 392,
-	255, 0, 0, 0, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 223, 702, 737,
-	658, 704, 237, 277, 243, 236, 407, 707, 753, 620,
-	699, 0, 623, 626, 749, 733, 653, 654, 0, 0,
-	0, 0, 0, 0, 0, 677, 686, 718, 671, 0,
-	0, 0, 0, 0, 0, 0, 0, 651, 0, 695,
-	0, 0, 0, 630, 624, 0, 0, 0, 0, 675,
-	0, 0, 0, 633, 0, 652, 719, 0, 618, 263,
-	628, 317, 723, 732, 672, 438, 736, 670, 669, 739,
-	714, 631, 729, 664, 288, 629, 285, 191, 205, 0,
-	662, 327, 366, 372, 728, 648, 657, 228, 655, 370,
-	341, 424, 213, 253, 363, 346, 368, 694, 712, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 1092, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 755, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 643, 724, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 617, 754, 611, 610, 286,
-	295, 716, 752, 340, 371, 219, 426, 390, 638, 642,
-	636, 637, 688, 689, 639, 744, 745, 746, 720, 632,
-	0, 640, 641, 0, 726, 734, 735, 693, 190, 203,
-	291, 748, 360, 256, 449, 433, 429, 619, 635, 234,
-	646, 0, 0, 659, 666, 667, 679, 681, 682, 683,
-	684, 692, 700, 701, 703, 711, 713, 715, 717, 722,
-	731, 751, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 265, 421,
-	442, 0, 299, 691, 698, 301, 250, 267, 276, 706,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 738, 725,
-	0, 0, 674, 741, 645, 663, 750, 665, 668, 708,
-	625, 687, 331, 660, 0, 649, 621, 656, 622, 647,
-	676, 241, 680, 644, 727, 690, 740, 289, 0, 627,
-	650, 345, 710, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 747, 293, 697,
-	0, 391, 316, 0, 0, 0, 678, 730, 685, 721,
-	673, 709, 634, 696, 742, 661, 705, 743, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 702, 737, 658, 704, 237, 277, 243, 236, 407,
-	707, 753, 620, 699, 0, 623, 626, 749, 733, 653,
-	654, 0, 0, 0, 0, 0, 0, 0, 677, 686,
-	718, 671, 0, 0, 0, 0, 0, 0, 0, 0,
-	651, 0, 695, 0, 0, 0, 630, 624, 0, 0,
-	0, 0, 675, 0, 0, 0, 633, 0, 652, 719,
-	0, 618, 263, 628, 317, 723, 732, 672, 438, 736,
-	670, 669, 739, 714, 631, 729, 664, 288, 629, 285,
-	191, 205, 0, 662, 327, 366, 372, 728, 648, 657,
-	228, 655, 370, 341, 424, 213, 253, 363, 346, 368,
-	694, 712, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 608, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 755, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 643, 724, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 617, 754,
-	611, 610, 286, 295, 716, 752, 340, 371, 219, 426,
-	390, 638, 642, 636, 637, 688, 689, 639, 744, 745,
-	746, 720, 632, 0, 640, 641, 0, 726, 734, 735,
-	693, 190, 203, 291, 748, 360, 256, 449, 433, 429,
-	619, 635, 234, 646, 0, 0, 659, 666, 667, 679,
-	681, 682, 683, 684, 692, 700, 701, 703, 711, 713,
-	715, 717, 722, 731, 751, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 691, 698, 301, 250,
-	267, 276, 706, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 1394, 0, 511, 0, 0, 0,
-	241, 0, 510, 0, 0, 0, 289, 0, 0, 1395,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 554, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 545, 546, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 69, 0, 0, 177, 178, 179, 532,
-	531, 534, 535, 536, 537, 0, 0, 217, 533, 223,
-	538, 539, 540, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 508, 525, 0, 553, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 522, 523, 598, 0, 0,
-	0, 568, 0, 524, 0, 0, 517, 518, 520, 519,
-	521, 526, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 567, 0, 0, 438, 0, 0,
-	565, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	555, 566, 561, 562, 559, 560, 0, 558, 557, 556,
-	569, 547, 548, 549, 550, 552, 0, 563, 564, 551,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 0, 0, 0, 511, 0, 0, 0, 241,
-	0, 510, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 554, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 545, 546, 0, 0,
-	0, 0, 0, 0, 1506, 0, 279, 225, 195, 328,
-	392, 255, 69, 0, 0, 177, 178, 179, 532, 531,
-	534, 535, 536, 537, 0, 0, 217, 533, 223, 538,
-	539, 540, 1507, 237, 277, 243, 236, 407, 0, 0,
-	0, 508, 525, 0, 553, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 522, 523, 0, 0, 0, 0,
-	568, 0, 524, 0, 0, 517, 518, 520, 519, 521,
-	526, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 567, 0, 0, 438, 0, 0, 565,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 555,
-	566, 561, 562, 559, 560, 0, 558, 557, 556, 569,
-	547, 548, 549, 550, 552, 0, 563, 564, 551, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 331,
-	0, 0, 0, 0, 511, 0, 0, 0, 241, 0,
-	510, 0, 0, 0, 289, 0, 0, 0, 345, 0,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 554, 293, 0, 0, 391, 316,
-	0, 0, 0, 0, 0, 545, 546, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 225, 195, 328, 392,
-	255, 69, 0, 586, 177, 178, 179, 532, 531, 534,
-	535, 536, 537, 0, 0, 217, 533, 223, 538, 539,
-	540, 0, 237, 277, 243, 236, 407, 0, 0, 0,
-	508, 525, 0, 553, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 522, 523, 0, 0, 0, 0, 568,
-	0, 524, 0, 0, 517, 518, 520, 519, 521, 526,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
-	0, 317, 567, 0, 0, 438, 0, 0, 565, 0,
-	0, 0, 0, 0, 288, 0, 285, 191, 205, 0,
-	0, 327, 366, 372, 0, 0, 0, 228, 0, 370,
-	341, 424, 213, 253, 363, 346, 368, 0, 0, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 420, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 209, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 0, 0, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 322, 210, 272, 389, 286,
-	295, 0, 0, 340, 371, 219, 426, 390, 555, 566,
-	561, 562, 559, 560, 0, 558, 557, 556, 569, 547,
-	548, 549, 550, 552, 0, 563, 564, 551, 190, 203,
-	291, 0, 360, 256, 449, 433, 429, 0, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 265, 421,
-	442, 0, 299, 0, 0, 301, 250, 267, 276, 0,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 331, 0,
-	0, 0, 0, 511, 0, 0, 0, 241, 0, 510,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 554, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 545, 546, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	69, 0, 0, 177, 178, 179, 532, 531, 534, 535,
-	536, 537, 0, 0, 217, 533, 223, 538, 539, 540,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 508,
-	525, 0, 553, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 522, 523, 598, 0, 0, 0, 568, 0,
-	524, 0, 0, 517, 518, 520, 519, 521, 526, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 567, 0, 0, 438, 0, 0, 565, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 555, 566, 561,
-	562, 559, 560, 0, 558, 557, 556, 569, 547, 548,
-	549, 550, 552, 0, 563, 564, 551, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 0,
-	0, 0, 511, 0, 0, 0, 241, 0, 510, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 554, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 545, 546, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 69,
-	0, 0, 177, 178, 179, 532, 1412, 534, 535, 536,
-	537, 0, 0, 217, 533, 223, 538, 539, 540, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 508, 525,
-	0, 553, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 522, 523, 598, 0, 0, 0, 568, 0, 524,
-	0, 0, 517, 518, 520, 519, 521, 526, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	567, 0, 0, 438, 0, 0, 565, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 555, 566, 561, 562,
-	559, 560, 0, 558, 557, 556, 569, 547, 548, 549,
-	550, 552, 0, 563, 564, 551, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 331, 0, 0, 0,
-	0, 511, 0, 0, 0, 241, 0, 510, 0, 0,
-	0, 289, 0, 0, 0, 345, 0, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 554, 293, 0, 0, 391, 316, 0, 0, 0,
-	0, 0, 545, 546, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 225, 195, 328, 392, 255, 69, 0,
-	0, 177, 178, 179, 532, 1409, 534, 535, 536, 537,
-	0, 0, 217, 533, 223, 538, 539, 540, 0, 237,
-	277, 243, 236, 407, 0, 0, 0, 508, 525, 0,
-	553, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	522, 523, 598, 0, 0, 0, 568, 0, 524, 0,
-	0, 517, 518, 520, 519, 521, 526, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 263, 0, 317, 567,
-	0, 0, 438, 0, 0, 565, 0, 0, 0, 0,
-	0, 288, 0, 285, 191, 205, 0, 0, 327, 366,
-	372, 0, 0, 0, 228, 0, 370, 341, 424, 213,
-	253, 363, 346, 368, 0, 0, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 209, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 0,
-	0, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 322, 210, 272, 389, 286, 295, 0, 0,
-	340, 371, 219, 426, 390, 555, 566, 561, 562, 559,
-	560, 0, 558, 557, 556, 569, 547, 548, 549, 550,
-	552, 0, 563, 564, 551, 190, 203, 291, 0, 360,
-	256, 449, 433, 429, 0, 0, 234, 0, 0, 0,
+	0, 1912, 0, 34, 0, 1912, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	0, 0, 301, 250, 267, 276, 0, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 579, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 331, 0,
-	0, 0, 0, 511, 0, 0, 0, 241, 0, 510,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 554, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 545, 546, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	69, 0, 0, 177, 178, 179, 532, 531, 534, 535,
-	536, 537, 0, 0, 217, 533, 223, 538, 539, 540,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 508,
-	525, 0, 553, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 522, 523, 0, 0, 0, 0, 568, 0,
-	524, 0, 0, 517, 518, 520, 519, 521, 526, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 567, 0, 0, 438, 0, 0, 565, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 555, 566, 561,
-	562, 559, 560, 0, 558, 557, 556, 569, 547, 548,
-	549, 550, 552, 0, 563, 564, 551, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 0,
-	0, 0, 511, 0, 0, 0, 241, 0, 510, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 554, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 545, 546, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 69,
-	0, 0, 177, 178, 179, 532, 531, 534, 535, 536,
-	537, 0, 0, 217, 533, 223, 538, 539, 540, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 508, 525,
-	0, 553, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 522, 523, 0, 0, 0, 0, 568, 0, 524,
-	0, 0, 517, 518, 520, 519, 521, 526, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	567, 0, 0, 438, 0, 0, 565, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 555, 566, 561, 562,
-	559, 560, 0, 558, 557, 556, 569, 547, 548, 549,
-	550, 552, 0, 563, 564, 551, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 331, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
-	0, 289, 0, 0, 0, 345, 0, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 554, 293, 0, 0, 391, 316, 0, 0, 0,
-	0, 0, 545, 546, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 225, 195, 328, 392, 255, 69, 0,
-	0, 177, 178, 179, 532, 531, 534, 535, 536, 537,
-	0, 0, 217, 533, 223, 538, 539, 540, 0, 237,
-	277, 243, 236, 407, 0, 0, 0, 0, 525, 0,
-	553, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	522, 523, 0, 0, 0, 0, 568, 0, 524, 0,
-	0, 517, 518, 520, 519, 521, 526, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 263, 0, 317, 567,
-	0, 0, 438, 0, 0, 565, 0, 0, 0, 0,
-	0, 288, 0, 285, 191, 205, 0, 0, 327, 366,
-	372, 0, 0, 0, 228, 0, 370, 341, 424, 213,
-	253, 363, 346, 368, 2166, 0, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 209, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 0,
-	0, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 322, 210, 272, 389, 286, 295, 0, 0,
-	340, 371, 219, 426, 390, 555, 566, 561, 562, 559,
-	560, 0, 558, 557, 556, 569, 547, 548, 549, 550,
-	552, 0, 563, 564, 551, 190, 203, 291, 0, 360,
-	256, 449, 433, 429, 0, 0, 234, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	0, 0, 301, 250, 267, 276, 0, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 331, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 0, 0, 0, 0, 0,
-	289, 0, 0, 0, 345, 0, 382, 227, 298, 296,
-	410, 251, 244, 240, 226, 273, 304, 343, 400, 337,
-	554, 293, 0, 0, 391, 316, 0, 0, 0, 0,
-	0, 545, 546, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 225, 195, 328, 392, 255, 69, 0, 586,
-	177, 178, 179, 532, 531, 534, 535, 536, 537, 0,
-	0, 217, 533, 223, 538, 539, 540, 0, 237, 277,
-	243, 236, 407, 0, 0, 0, 0, 525, 0, 553,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 522,
-	523, 0, 0, 0, 0, 568, 0, 524, 0, 0,
-	517, 518, 520, 519, 521, 526, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 263, 0, 317, 567, 0,
-	0, 438, 0, 0, 565, 0, 0, 0, 0, 0,
-	288, 0, 285, 191, 205, 0, 0, 327, 366, 372,
-	0, 0, 0, 228, 0, 370, 341, 424, 213, 253,
-	363, 346, 368, 0, 0, 369, 294, 412, 358, 422,
-	439, 440, 235, 321, 430, 404, 436, 448, 206, 232,
-	335, 397, 427, 388, 314, 408, 409, 284, 387, 261,
-	194, 292, 198, 399, 420, 218, 380, 0, 0, 0,
-	200, 418, 396, 311, 281, 282, 199, 0, 362, 239,
-	259, 230, 330, 415, 416, 229, 450, 208, 435, 202,
-	209, 434, 323, 411, 419, 312, 303, 201, 417, 310,
-	302, 287, 249, 269, 356, 297, 357, 270, 319, 318,
-	320, 0, 196, 0, 393, 428, 451, 215, 0, 0,
-	406, 444, 447, 0, 359, 216, 260, 248, 355, 258,
-	290, 443, 445, 446, 214, 353, 266, 334, 423, 252,
-	431, 322, 210, 272, 389, 286, 295, 0, 0, 340,
-	371, 219, 426, 390, 555, 566, 561, 562, 559, 560,
-	0, 558, 557, 556, 569, 547, 548, 549, 550, 552,
-	0, 563, 564, 551, 190, 203, 291, 0, 360, 256,
-	449, 433, 429, 0, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 193,
-	204, 212, 221, 233, 246, 254, 264, 268, 271, 274,
-	275, 278, 283, 300, 305, 306, 307, 308, 324, 325,
-	326, 329, 332, 333, 336, 338, 339, 342, 348, 349,
-	350, 351, 352, 354, 361, 365, 373, 374, 375, 376,
-	377, 378, 379, 383, 384, 385, 386, 394, 398, 413,
-	414, 425, 437, 441, 265, 421, 442, 0, 299, 0,
-	0, 301, 250, 267, 276, 0, 432, 395, 207, 367,
-	257, 197, 224, 211, 231, 245, 247, 280, 309, 315,
-	344, 347, 262, 242, 222, 364, 220, 381, 401, 402,
-	403, 405, 313, 238, 331, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 0, 0, 0, 0, 0, 289,
-	0, 0, 0, 345, 0, 382, 227, 298, 296, 410,
-	251, 244, 240, 226, 273, 304, 343, 400, 337, 554,
-	293, 0, 0, 391, 316, 0, 0, 0, 0, 0,
-	545, 546, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 225, 195, 328, 392, 255, 69, 0, 0, 177,
-	178, 179, 532, 531, 534, 535, 536, 537, 0, 0,
-	217, 533, 223, 538, 539, 540, 0, 237, 277, 243,
-	236, 407, 0, 0, 0, 0, 525, 0, 553, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 522, 523,
-	0, 0, 0, 0, 568, 0, 524, 0, 0, 517,
-	518, 520, 519, 521, 526, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 263, 0, 317, 567, 0, 0,
-	438, 0, 0, 565, 0, 0, 0, 0, 0, 288,
-	0, 285, 191, 205, 0, 0, 327, 366, 372, 0,
-	0, 0, 228, 0, 370, 341, 424, 213, 253, 363,
-	346, 368, 0, 0, 369, 294, 412, 358, 422, 439,
-	440, 235, 321, 430, 404, 436, 448, 206, 232, 335,
-	397, 427, 388, 314, 408, 409, 284, 387, 261, 194,
-	292, 198, 399, 420, 218, 380, 0, 0, 0, 200,
-	418, 396, 311, 281, 282, 199, 0, 362, 239, 259,
-	230, 330, 415, 416, 229, 450, 208, 435, 202, 209,
-	434, 323, 411, 419, 312, 303, 201, 417, 310, 302,
-	287, 249, 269, 356, 297, 357, 270, 319, 318, 320,
-	0, 196, 0, 393, 428, 451, 215, 0, 0, 406,
-	444, 447, 0, 359, 216, 260, 248, 355, 258, 290,
-	443, 445, 446, 214, 353, 266, 334, 423, 252, 431,
-	322, 210, 272, 389, 286, 295, 0, 0, 340, 371,
-	219, 426, 390, 555, 566, 561, 562, 559, 560, 0,
-	558, 557, 556, 569, 547, 548, 549, 550, 552, 0,
-	563, 564, 551, 190, 203, 291, 0, 360, 256, 449,
-	433, 429, 0, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 192, 193, 204,
-	212, 221, 233, 246, 254, 264, 268, 271, 274, 275,
-	278, 283, 300, 305, 306, 307, 308, 324, 325, 326,
-	329, 332, 333, 336, 338, 339, 342, 348, 349, 350,
-	351, 352, 354, 361, 365, 373, 374, 375, 376, 377,
-	378, 379, 383, 384, 385, 386, 394, 398, 413, 414,
-	425, 437, 441, 265, 421, 442, 0, 299, 0, 0,
-	301, 250, 267, 276, 0, 432, 395, 207, 367, 257,
-	197, 224, 211, 231, 245, 247, 280, 309, 315, 344,
-	347, 262, 242, 222, 364, 220, 381, 401, 402, 403,
-	405, 313, 238, 331, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 0, 0, 0, 0, 0, 289, 0,
-	0, 0, 345, 0, 382, 227, 298, 296, 410, 251,
-	244, 240, 226, 273, 304, 343, 400, 337, 0, 293,
-	0, 0, 391, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	225, 195, 328, 392, 255, 0, 0, 0, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 223, 0, 0, 0, 0, 237, 277, 243, 236,
-	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 969, 968, 978, 979, 971, 972, 973,
-	974, 975, 976, 977, 970, 0, 0, 980, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 263, 0, 317, 0, 0, 0, 438,
-	0, 0, 0, 0, 0, 0, 0, 0, 288, 0,
-	285, 191, 205, 0, 0, 327, 366, 372, 0, 0,
-	0, 228, 0, 370, 341, 424, 213, 253, 363, 346,
-	368, 0, 0, 369, 294, 412, 358, 422, 439, 440,
-	235, 321, 430, 404, 436, 448, 206, 232, 335, 397,
-	427, 388, 314, 408, 409, 284, 387, 261, 194, 292,
-	198, 399, 420, 218, 380, 0, 0, 0, 200, 418,
-	396, 311, 281, 282, 199, 0, 362, 239, 259, 230,
-	330, 415, 416, 229, 450, 208, 435, 202, 209, 434,
-	323, 411, 419, 312, 303, 201, 417, 310, 302, 287,
-	249, 269, 356, 297, 357, 270, 319, 318, 320, 0,
-	196, 0, 393, 428, 451, 215, 0, 0, 406, 444,
-	447, 0, 359, 216, 260, 248, 355, 258, 290, 443,
-	445, 446, 214, 353, 266, 334, 423, 252, 431, 322,
-	210, 272, 389, 286, 295, 0, 0, 340, 371, 219,
-	426, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 190, 203, 291, 0, 360, 256, 449, 433,
-	429, 0, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 193, 204, 212,
-	221, 233, 246, 254, 264, 268, 271, 274, 275, 278,
-	283, 300, 305, 306, 307, 308, 324, 325, 326, 329,
-	332, 333, 336, 338, 339, 342, 348, 349, 350, 351,
-	352, 354, 361, 365, 373, 374, 375, 376, 377, 378,
-	379, 383, 384, 385, 386, 394, 398, 413, 414, 425,
-	437, 441, 265, 421, 442, 0, 299, 0, 0, 301,
-	250, 267, 276, 0, 432, 395, 207, 367, 257, 197,
-	224, 211, 231, 245, 247, 280, 309, 315, 344, 347,
-	262, 242, 222, 364, 220, 381, 401, 402, 403, 405,
-	313, 238, 331, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 799, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 798, 438, 0,
-	0, 0, 0, 0, 0, 795, 796, 288, 763, 285,
-	191, 205, 789, 793, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 0, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 0, 1070, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	1072, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 958,
-	959, 957, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 960, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
 	34, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 331, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 0, 0, 0, 0, 0, 289, 0,
-	0, 0, 345, 0, 382, 227, 298, 296, 410, 251,
-	244, 240, 226, 273, 304, 343, 400, 337, 0, 293,
-	0, 0, 391, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	225, 195, 328, 392, 255, 69, 0, 586, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 223, 0, 0, 0, 0, 237, 277, 243, 236,
-	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 263, 0, 317, 0, 0, 0, 438,
-	0, 0, 0, 0, 0, 0, 0, 0, 288, 0,
-	285, 191, 205, 0, 0, 327, 366, 372, 0, 0,
-	0, 228, 0, 370, 341, 424, 213, 253, 363, 346,
-	368, 0, 0, 369, 294, 412, 358, 422, 439, 440,
-	235, 321, 430, 404, 436, 448, 206, 232, 335, 397,
-	427, 388, 314, 408, 409, 284, 387, 261, 194, 292,
-	198, 399, 420, 218, 380, 0, 0, 0, 200, 418,
-	396, 311, 281, 282, 199, 0, 362, 239, 259, 230,
-	330, 415, 416, 229, 450, 208, 435, 202, 209, 434,
-	323, 411, 419, 312, 303, 201, 417, 310, 302, 287,
-	249, 269, 356, 297, 357, 270, 319, 318, 320, 0,
-	196, 0, 393, 428, 451, 215, 0, 0, 406, 444,
-	447, 0, 359, 216, 260, 248, 355, 258, 290, 443,
-	445, 446, 214, 353, 266, 334, 423, 252, 431, 322,
-	210, 272, 389, 286, 295, 0, 0, 340, 371, 219,
-	426, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 190, 203, 291, 0, 360, 256, 449, 433,
-	429, 0, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 193, 204, 212,
-	221, 233, 246, 254, 264, 268, 271, 274, 275, 278,
-	283, 300, 305, 306, 307, 308, 324, 325, 326, 329,
-	332, 333, 336, 338, 339, 342, 348, 349, 350, 351,
-	352, 354, 361, 365, 373, 374, 375, 376, 377, 378,
-	379, 383, 384, 385, 386, 394, 398, 413, 414, 425,
-	437, 441, 265, 421, 442, 0, 299, 0, 0, 301,
-	250, 267, 276, 0, 432, 395, 207, 367, 257, 197,
-	224, 211, 231, 245, 247, 280, 309, 315, 344, 347,
-	262, 242, 222, 364, 220, 381, 401, 402, 403, 405,
-	313, 238, 331, 0, 0, 0, 1439, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 1441, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 0, 438, 0,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	191, 205, 0, 0, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 1437, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 757, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 763, 285, 191,
-	205, 761, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 0, 0, 1439, 0, 0, 0, 0, 241,
-	0, 0, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 0, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 225, 195, 328,
-	392, 255, 0, 0, 0, 177, 178, 179, 0, 1441,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 0,
-	0, 0, 0, 237, 277, 243, 236, 407, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 0, 0, 0, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 34,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 331, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 69, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 0, 438, 0,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	191, 205, 0, 0, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 0, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 1459, 0, 0, 1460, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	0, 1103, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 0, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 225, 195, 328,
-	392, 255, 0, 0, 0, 177, 178, 179, 0, 1102,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 0,
-	0, 0, 0, 237, 277, 243, 236, 407, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 0, 0, 0, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 331,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 0,
-	0, 0, 0, 0, 289, 0, 0, 0, 345, 0,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 0, 293, 0, 0, 391, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 225, 195, 328, 392,
-	255, 0, 0, 586, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 223, 0, 0,
-	0, 0, 237, 277, 243, 236, 407, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
-	0, 317, 0, 0, 0, 438, 0, 0, 0, 0,
-	0, 0, 0, 0, 288, 0, 285, 191, 205, 0,
-	0, 327, 366, 372, 0, 0, 0, 228, 0, 370,
-	341, 424, 213, 253, 363, 346, 368, 0, 0, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 420, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 209, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 0, 0, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 322, 210, 272, 389, 286,
-	295, 0, 0, 340, 371, 219, 426, 390, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 190, 203,
-	291, 0, 360, 256, 449, 433, 429, 0, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 265, 421,
-	442, 0, 299, 0, 0, 301, 250, 267, 276, 0,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 331, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 0, 0,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 0, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	69, 0, 0, 177, 178, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 223, 0, 0, 0,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 0, 0, 0, 438, 0, 0, 0, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 0, 0, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 0, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 0,
-	0, 0, 177, 178, 179, 0, 1441, 0, 0, 0,
-	0, 0, 0, 217, 0, 223, 0, 0, 0, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	0, 0, 0, 438, 0, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 331, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
-	0, 289, 0, 0, 0, 345, 0, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 0, 293, 0, 0, 391, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 225, 195, 328, 392, 255, 0, 0,
-	0, 177, 178, 179, 0, 1072, 0, 0, 0, 0,
-	0, 0, 217, 0, 223, 0, 0, 0, 0, 237,
-	277, 243, 236, 407, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 263, 0, 317, 0,
-	0, 0, 438, 0, 0, 0, 0, 0, 0, 0,
-	0, 288, 0, 285, 191, 205, 0, 0, 327, 366,
-	372, 0, 0, 0, 228, 0, 370, 341, 424, 213,
-	253, 363, 346, 368, 0, 0, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 209, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 0,
-	0, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 322, 210, 272, 389, 286, 295, 0, 0,
-	340, 371, 219, 426, 390, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 190, 203, 291, 0, 360,
-	256, 449, 433, 429, 0, 0, 234, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1912, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 34, 2148, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2113, 0, 0, 0, 0, 0, 0,
+	0, 0, 745, 732, 0, 2122, 681, 748, 652, 670,
+	757, 672, 675, 715, 632, 694, 334, 667, 0, 656,
+	628, 663, 629, 654, 683, 244, 687, 651, 734, 697,
+	747, 292, 0, 634, 657, 348, 717, 385, 230, 301,
+	299, 413, 254, 247, 243, 229, 276, 307, 346, 403,
+	340, 754, 296, 704, 0, 394, 319, 0, 0, 0,
+	685, 737, 692, 728, 680, 716, 641, 703, 749, 668,
+	712, 750, 282, 228, 197, 331, 395, 258, 0, 0,
+	0, 179, 180, 181, 0, 2155, 2156, 0, 0, 0,
+	0, 0, 220, 0, 226, 709, 744, 665, 711, 240,
+	280, 246, 239, 410, 714, 760, 627, 706, 0, 630,
+	633, 756, 740, 660, 661, 0, 0, 0, 0, 0,
+	0, 0, 684, 693, 725, 678, 0, 0, 0, 0,
+	0, 0, 0, 0, 658, 0, 702, 0, 0, 0,
+	637, 631, 0, 0, 0, 0, 682, 0, 0, 0,
+	640, 0, 659, 726, 0, 625, 266, 635, 320, 730,
+	739, 679, 441, 743, 677, 676, 746, 721, 638, 736,
+	671, 291, 636, 288, 193, 208, 0, 669, 330, 369,
+	375, 735, 655, 664, 231, 662, 373, 344, 427, 216,
+	256, 366, 349, 371, 701, 719, 372, 297, 415, 361,
+	425, 442, 443, 238, 324, 433, 407, 439, 453, 209,
+	235, 338, 400, 430, 391, 317, 411, 412, 287, 390,
+	264, 196, 295, 200, 402, 423, 221, 383, 0, 0,
+	0, 202, 421, 399, 314, 284, 285, 201, 0, 365,
+	242, 262, 233, 333, 418, 419, 232, 455, 211, 438,
+	204, 212, 437, 326, 414, 422, 315, 306, 203, 420,
+	313, 305, 290, 252, 272, 359, 300, 360, 273, 322,
+	321, 323, 0, 198, 0, 396, 431, 456, 218, 650,
+	731, 409, 449, 452, 0, 362, 219, 263, 251, 358,
+	261, 293, 448, 450, 451, 217, 356, 269, 337, 426,
+	255, 434, 325, 213, 275, 392, 289, 298, 723, 759,
+	343, 374, 222, 429, 393, 645, 649, 643, 644, 695,
+	696, 646, 751, 752, 753, 727, 639, 0, 647, 648,
+	0, 733, 741, 742, 700, 192, 205, 294, 755, 363,
+	259, 454, 436, 432, 626, 642, 237, 653, 0, 0,
+	666, 673, 674, 686, 688, 689, 690, 691, 699, 707,
+	708, 710, 718, 720, 722, 724, 729, 738, 758, 194,
+	195, 206, 215, 224, 236, 249, 257, 267, 271, 274,
+	277, 278, 281, 286, 303, 308, 309, 310, 311, 327,
+	328, 329, 332, 335, 336, 339, 341, 342, 345, 351,
+	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
+	379, 380, 381, 382, 386, 387, 388, 389, 397, 401,
+	416, 417, 428, 440, 444, 268, 424, 445, 0, 302,
+	698, 705, 304, 253, 270, 279, 713, 435, 398, 210,
+	370, 260, 207, 446, 447, 199, 227, 214, 234, 248,
+	250, 283, 312, 318, 347, 350, 265, 245, 225, 367,
+	223, 384, 404, 405, 406, 408, 316, 241, 745, 732,
+	0, 0, 681, 748, 652, 670, 757, 672, 675, 715,
+	632, 694, 334, 667, 0, 656, 628, 663, 629, 654,
+	683, 244, 687, 651, 734, 697, 747, 292, 0, 634,
+	657, 348, 717, 385, 230, 301, 299, 413, 254, 247,
+	243, 229, 276, 307, 346, 403, 340, 754, 296, 704,
+	0, 394, 319, 0, 0, 0, 685, 737, 692, 728,
+	680, 716, 641, 703, 749, 668, 712, 750, 282, 228,
+	197, 331, 395, 258, 0, 0, 0, 179, 180, 181,
+	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
+	226, 709, 744, 665, 711, 240, 280, 246, 239, 410,
+	714, 760, 627, 706, 0, 630, 633, 756, 740, 660,
+	661, 0, 0, 0, 0, 0, 0, 0, 684, 693,
+	725, 678, 0, 0, 0, 0, 0, 0, 1904, 0,
+	658, 0, 702, 0, 0, 0, 637, 631, 0, 0,
+	0, 0, 682, 0, 0, 0, 640, 0, 659, 726,
+	0, 625, 266, 635, 320, 730, 739, 679, 441, 743,
+	677, 676, 746, 721, 638, 736, 671, 291, 636, 288,
+	193, 208, 0, 669, 330, 369, 375, 735, 655, 664,
+	231, 662, 373, 344, 427, 216, 256, 366, 349, 371,
+	701, 719, 372, 297, 415, 361, 425, 442, 443, 238,
+	324, 433, 407, 439, 453, 209, 235, 338, 400, 430,
+	391, 317, 411, 412, 287, 390, 264, 196, 295, 200,
+	402, 423, 221, 383, 0, 0, 0, 202, 421, 399,
+	314, 284, 285, 201, 0, 365, 242, 262, 233, 333,
+	418, 419, 232, 455, 211, 438, 204, 212, 437, 326,
+	414, 422, 315, 306, 203, 420, 313, 305, 290, 252,
+	272, 359, 300, 360, 273, 322, 321, 323, 0, 198,
+	0, 396, 431, 456, 218, 650, 731, 409, 449, 452,
+	0, 362, 219, 263, 251, 358, 261, 293, 448, 450,
+	451, 217, 356, 269, 337, 426, 255, 434, 325, 213,
+	275, 392, 289, 298, 723, 759, 343, 374, 222, 429,
+	393, 645, 649, 643, 644, 695, 696, 646, 751, 752,
+	753, 727, 639, 0, 647, 648, 0, 733, 741, 742,
+	700, 192, 205, 294, 755, 363, 259, 454, 436, 432,
+	626, 642, 237, 653, 0, 0, 666, 673, 674, 686,
+	688, 689, 690, 691, 699, 707, 708, 710, 718, 720,
+	722, 724, 729, 738, 758, 194, 195, 206, 215, 224,
+	236, 249, 257, 267, 271, 274, 277, 278, 281, 286,
+	303, 308, 309, 310, 311, 327, 328, 329, 332, 335,
+	336, 339, 341, 342, 345, 351, 352, 353, 354, 355,
+	357, 364, 368, 376, 377, 378, 379, 380, 381, 382,
+	386, 387, 388, 389, 397, 401, 416, 417, 428, 440,
+	444, 268, 424, 445, 0, 302, 698, 705, 304, 253,
+	270, 279, 713, 435, 398, 210, 370, 260, 207, 446,
+	447, 199, 227, 214, 234, 248, 250, 283, 312, 318,
+	347, 350, 265, 245, 225, 367, 223, 384, 404, 405,
+	406, 408, 316, 241, 745, 732, 0, 0, 681, 748,
+	652, 670, 757, 672, 675, 715, 632, 694, 334, 667,
+	0, 656, 628, 663, 629, 654, 683, 244, 687, 651,
+	734, 697, 747, 292, 0, 634, 657, 348, 717, 385,
+	230, 301, 299, 413, 254, 247, 243, 229, 276, 307,
+	346, 403, 340, 754, 296, 704, 0, 394, 319, 0,
+	0, 0, 685, 737, 692, 728, 680, 716, 641, 703,
+	749, 668, 712, 750, 282, 228, 197, 331, 395, 258,
+	0, 0, 0, 179, 180, 181, 0, 0, 0, 0,
+	0, 0, 0, 0, 220, 0, 226, 709, 744, 665,
+	711, 240, 280, 246, 239, 410, 714, 760, 627, 706,
+	0, 630, 633, 756, 740, 660, 661, 0, 0, 0,
+	0, 0, 0, 0, 684, 693, 725, 678, 0, 0,
+	0, 0, 0, 0, 1759, 0, 658, 0, 702, 0,
+	0, 0, 637, 631, 0, 0, 0, 0, 682, 0,
+	0, 0, 640, 0, 659, 726, 0, 625, 266, 635,
+	320, 730, 739, 679, 441, 743, 677, 676, 746, 721,
+	638, 736, 671, 291, 636, 288, 193, 208, 0, 669,
+	330, 369, 375, 735, 655, 664, 231, 662, 373, 344,
+	427, 216, 256, 366, 349, 371, 701, 719, 372, 297,
+	415, 361, 425, 442, 443, 238, 324, 433, 407, 439,
+	453, 209, 235, 338, 400, 430, 391, 317, 411, 412,
+	287, 390, 264, 196, 295, 200, 402, 423, 221, 383,
+	0, 0, 0, 202, 421, 399, 314, 284, 285, 201,
+	0, 365, 242, 262, 233, 333, 418, 419, 232, 455,
+	211, 438, 204, 212, 437, 326, 414, 422, 315, 306,
+	203, 420, 313, 305, 290, 252, 272, 359, 300, 360,
+	273, 322, 321, 323, 0, 198, 0, 396, 431, 456,
+	218, 650, 731, 409, 449, 452, 0, 362, 219, 263,
+	251, 358, 261, 293, 448, 450, 451, 217, 356, 269,
+	337, 426, 255, 434, 325, 213, 275, 392, 289, 298,
+	723, 759, 343, 374, 222, 429, 393, 645, 649, 643,
+	644, 695, 696, 646, 751, 752, 753, 727, 639, 0,
+	647, 648, 0, 733, 741, 742, 700, 192, 205, 294,
+	755, 363, 259, 454, 436, 432, 626, 642, 237, 653,
+	0, 0, 666, 673, 674, 686, 688, 689, 690, 691,
+	699, 707, 708, 710, 718, 720, 722, 724, 729, 738,
+	758, 194, 195, 206, 215, 224, 236, 249, 257, 267,
+	271, 274, 277, 278, 281, 286, 303, 308, 309, 310,
+	311, 327, 328, 329, 332, 335, 336, 339, 341, 342,
+	345, 351, 352, 353, 354, 355, 357, 364, 368, 376,
+	377, 378, 379, 380, 381, 382, 386, 387, 388, 389,
+	397, 401, 416, 417, 428, 440, 444, 268, 424, 445,
+	0, 302, 698, 705, 304, 253, 270, 279, 713, 435,
+	398, 210, 370, 260, 207, 446, 447, 199, 227, 214,
+	234, 248, 250, 283, 312, 318, 347, 350, 265, 245,
+	225, 367, 223, 384, 404, 405, 406, 408, 316, 241,
+	745, 732, 0, 0, 681, 748, 652, 670, 757, 672,
+	675, 715, 632, 694, 334, 667, 0, 656, 628, 663,
+	629, 654, 683, 244, 687, 651, 734, 697, 747, 292,
+	0, 634, 657, 348, 717, 385, 230, 301, 299, 413,
+	254, 247, 243, 229, 276, 307, 346, 403, 340, 754,
+	296, 704, 0, 394, 319, 0, 0, 0, 685, 737,
+	692, 728, 680, 716, 641, 703, 749, 668, 712, 750,
+	282, 228, 197, 331, 395, 258, 0, 0, 0, 179,
+	180, 181, 0, 0, 0, 0, 0, 0, 0, 0,
+	220, 0, 226, 709, 744, 665, 711, 240, 280, 246,
+	239, 410, 714, 760, 627, 706, 0, 630, 633, 756,
+	740, 660, 661, 0, 0, 0, 0, 0, 0, 0,
+	684, 693, 725, 678, 0, 0, 0, 0, 0, 0,
+	1476, 0, 658, 0, 702, 0, 0, 0, 637, 631,
+	0, 0, 0, 0, 682, 0, 0, 0, 640, 0,
+	659, 726, 0, 625, 266, 635, 320, 730, 739, 679,
+	441, 743, 677, 676, 746, 721, 638, 736, 671, 291,
+	636, 288, 193, 208, 0, 669, 330, 369, 375, 735,
+	655, 664, 231, 662, 373, 344, 427, 216, 256, 366,
+	349, 371, 701, 719, 372, 297, 415, 361, 425, 442,
+	443, 238, 324, 433, 407, 439, 453, 209, 235, 338,
+	400, 430, 391, 317, 411, 412, 287, 390, 264, 196,
+	295, 200, 402, 423, 221, 383, 0, 0, 0, 202,
+	421, 399, 314, 284, 285, 201, 0, 365, 242, 262,
+	233, 333, 418, 419, 232, 455, 211, 438, 204, 212,
+	437, 326, 414, 422, 315, 306, 203, 420, 313, 305,
+	290, 252, 272, 359, 300, 360, 273, 322, 321, 323,
+	0, 198, 0, 396, 431, 456, 218, 650, 731, 409,
+	449, 452, 0, 362, 219, 263, 251, 358, 261, 293,
+	448, 450, 451, 217, 356, 269, 337, 426, 255, 434,
+	325, 213, 275, 392, 289, 298, 723, 759, 343, 374,
+	222, 429, 393, 645, 649, 643, 644, 695, 696, 646,
+	751, 752, 753, 727, 639, 0, 647, 648, 0, 733,
+	741, 742, 700, 192, 205, 294, 755, 363, 259, 454,
+	436, 432, 626, 642, 237, 653, 0, 0, 666, 673,
+	674, 686, 688, 689, 690, 691, 699, 707, 708, 710,
+	718, 720, 722, 724, 729, 738, 758, 194, 195, 206,
+	215, 224, 236, 249, 257, 267, 271, 274, 277, 278,
+	281, 286, 303, 308, 309, 310, 311, 327, 328, 329,
+	332, 335, 336, 339, 341, 342, 345, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	381, 382, 386, 387, 388, 389, 397, 401, 416, 417,
+	428, 440, 444, 268, 424, 445, 0, 302, 698, 705,
+	304, 253, 270, 279, 713, 435, 398, 210, 370, 260,
+	207, 446, 447, 199, 227, 214, 234, 248, 250, 283,
+	312, 318, 347, 350, 265, 245, 225, 367, 223, 384,
+	404, 405, 406, 408, 316, 241, 745, 732, 0, 0,
+	681, 748, 652, 670, 757, 672, 675, 715, 632, 694,
+	334, 667, 0, 656, 628, 663, 629, 654, 683, 244,
+	687, 651, 734, 697, 747, 292, 0, 634, 657, 348,
+	717, 385, 230, 301, 299, 413, 254, 247, 243, 229,
+	276, 307, 346, 403, 340, 754, 296, 704, 0, 394,
+	319, 0, 0, 0, 685, 737, 692, 728, 680, 716,
+	641, 703, 749, 668, 712, 750, 282, 228, 197, 331,
+	395, 258, 71, 0, 0, 179, 180, 181, 0, 0,
+	0, 0, 0, 0, 0, 0, 220, 0, 226, 709,
+	744, 665, 711, 240, 280, 246, 239, 410, 714, 760,
+	627, 706, 0, 630, 633, 756, 740, 660, 661, 0,
+	0, 0, 0, 0, 0, 0, 684, 693, 725, 678,
+	0, 0, 0, 0, 0, 0, 0, 0, 658, 0,
+	702, 0, 0, 0, 637, 631, 0, 0, 0, 0,
+	682, 0, 0, 0, 640, 0, 659, 726, 0, 625,
+	266, 635, 320, 730, 739, 679, 441, 743, 677, 676,
+	746, 721, 638, 736, 671, 291, 636, 288, 193, 208,
+	0, 669, 330, 369, 375, 735, 655, 664, 231, 662,
+	373, 344, 427, 216, 256, 366, 349, 371, 701, 719,
+	372, 297, 415, 361, 425, 442, 443, 238, 324, 433,
+	407, 439, 453, 209, 235, 338, 400, 430, 391, 317,
+	411, 412, 287, 390, 264, 196, 295, 200, 402, 423,
+	221, 383, 0, 0, 0, 202, 421, 399, 314, 284,
+	285, 201, 0, 365, 242, 262, 233, 333, 418, 419,
+	232, 455, 211, 438, 204, 212, 437, 326, 414, 422,
+	315, 306, 203, 420, 313, 305, 290, 252, 272, 359,
+	300, 360, 273, 322, 321, 323, 0, 198, 0, 396,
+	431, 456, 218, 650, 731, 409, 449, 452, 0, 362,
+	219, 263, 251, 358, 261, 293, 448, 450, 451, 217,
+	356, 269, 337, 426, 255, 434, 325, 213, 275, 392,
+	289, 298, 723, 759, 343, 374, 222, 429, 393, 645,
+	649, 643, 644, 695, 696, 646, 751, 752, 753, 727,
+	639, 0, 647, 648, 0, 733, 741, 742, 700, 192,
+	205, 294, 755, 363, 259, 454, 436, 432, 626, 642,
+	237, 653, 0, 0, 666, 673, 674, 686, 688, 689,
+	690, 691, 699, 707, 708, 710, 718, 720, 722, 724,
+	729, 738, 758, 194, 195, 206, 215, 224, 236, 249,
+	257, 267, 271, 274, 277, 278, 281, 286, 303, 308,
+	309, 310, 311, 327, 328, 329, 332, 335, 336, 339,
+	341, 342, 345, 351, 352, 353, 354, 355, 357, 364,
+	368, 376, 377, 378, 379, 380, 381, 382, 386, 387,
+	388, 389, 397, 401, 416, 417, 428, 440, 444, 268,
+	424, 445, 0, 302, 698, 705, 304, 253, 270, 279,
+	713, 435, 398, 210, 370, 260, 207, 446, 447, 199,
+	227, 214, 234, 248, 250, 283, 312, 318, 347, 350,
+	265, 245, 225, 367, 223, 384, 404, 405, 406, 408,
+	316, 241, 745, 732, 0, 0, 681, 748, 652, 670,
+	757, 672, 675, 715, 632, 694, 334, 667, 0, 656,
+	628, 663, 629, 654, 683, 244, 687, 651, 734, 697,
+	747, 292, 0, 634, 657, 348, 717, 385, 230, 301,
+	299, 413, 254, 247, 243, 229, 276, 307, 346, 403,
+	340, 754, 296, 704, 0, 394, 319, 0, 0, 0,
+	685, 737, 692, 728, 680, 716, 641, 703, 749, 668,
+	712, 750, 282, 228, 197, 331, 395, 258, 0, 0,
+	0, 179, 180, 181, 0, 0, 0, 0, 0, 0,
+	0, 0, 220, 0, 226, 709, 744, 665, 711, 240,
+	280, 246, 239, 410, 714, 760, 627, 706, 0, 630,
+	633, 756, 740, 660, 661, 0, 0, 0, 0, 0,
+	0, 0, 684, 693, 725, 678, 0, 0, 0, 0,
+	0, 0, 0, 0, 658, 0, 702, 0, 0, 0,
+	637, 631, 0, 0, 0, 0, 682, 0, 0, 0,
+	640, 0, 659, 726, 0, 625, 266, 635, 320, 730,
+	739, 679, 441, 743, 677, 676, 746, 721, 638, 736,
+	671, 291, 636, 288, 193, 208, 0, 669, 330, 369,
+	375, 735, 655, 664, 231, 662, 373, 344, 427, 216,
+	256, 366, 349, 371, 701, 719, 372, 297, 415, 361,
+	425, 442, 443, 238, 324, 433, 407, 439, 453, 209,
+	235, 338, 400, 430, 391, 317, 411, 412, 287, 390,
+	264, 196, 295, 200, 402, 423, 221, 383, 0, 0,
+	0, 202, 421, 399, 314, 284, 285, 201, 0, 365,
+	242, 262, 233, 333, 418, 419, 232, 455, 211, 438,
+	204, 212, 437, 326, 414, 422, 315, 306, 203, 420,
+	313, 305, 290, 252, 272, 359, 300, 360, 273, 322,
+	321, 323, 0, 198, 0, 396, 431, 456, 218, 650,
+	731, 409, 449, 452, 0, 362, 219, 263, 251, 358,
+	261, 293, 448, 450, 451, 217, 356, 269, 337, 426,
+	255, 434, 325, 213, 275, 392, 289, 298, 723, 759,
+	343, 374, 222, 429, 393, 645, 649, 643, 644, 695,
+	696, 646, 751, 752, 753, 727, 639, 0, 647, 648,
+	0, 733, 741, 742, 700, 192, 205, 294, 755, 363,
+	259, 454, 436, 432, 626, 642, 237, 653, 0, 0,
+	666, 673, 674, 686, 688, 689, 690, 691, 699, 707,
+	708, 710, 718, 720, 722, 724, 729, 738, 758, 194,
+	195, 206, 215, 224, 236, 249, 257, 267, 271, 274,
+	277, 278, 281, 286, 303, 308, 309, 310, 311, 327,
+	328, 329, 332, 335, 336, 339, 341, 342, 345, 351,
+	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
+	379, 380, 381, 382, 386, 387, 388, 389, 397, 401,
+	416, 417, 428, 440, 444, 268, 424, 445, 0, 302,
+	698, 705, 304, 253, 270, 279, 713, 435, 398, 210,
+	370, 260, 207, 446, 447, 199, 227, 214, 234, 248,
+	250, 283, 312, 318, 347, 350, 265, 245, 225, 367,
+	223, 384, 404, 405, 406, 408, 316, 241, 745, 732,
+	0, 0, 681, 748, 652, 670, 757, 672, 675, 715,
+	632, 694, 334, 667, 0, 656, 628, 663, 629, 654,
+	683, 244, 687, 651, 734, 697, 747, 292, 0, 634,
+	657, 348, 717, 385, 230, 301, 299, 413, 254, 247,
+	243, 229, 276, 307, 346, 403, 340, 754, 296, 704,
+	0, 394, 319, 0, 0, 0, 685, 737, 692, 728,
+	680, 716, 641, 703, 749, 668, 712, 750, 282, 228,
+	197, 331, 395, 258, 0, 0, 0, 179, 180, 181,
+	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
+	226, 709, 744, 665, 711, 240, 280, 246, 239, 410,
+	714, 760, 627, 706, 0, 630, 633, 756, 740, 660,
+	661, 0, 0, 0, 0, 0, 0, 0, 684, 693,
+	725, 678, 0, 0, 0, 0, 0, 0, 0, 0,
+	658, 0, 702, 0, 0, 0, 637, 631, 0, 0,
+	0, 0, 682, 0, 0, 0, 640, 0, 659, 726,
+	0, 625, 266, 635, 320, 730, 739, 679, 441, 743,
+	677, 676, 746, 721, 638, 736, 671, 291, 636, 288,
+	193, 208, 0, 669, 330, 369, 375, 735, 655, 664,
+	231, 662, 373, 344, 427, 216, 256, 366, 349, 371,
+	701, 719, 372, 297, 415, 361, 425, 442, 443, 238,
+	324, 433, 407, 439, 453, 209, 235, 338, 400, 430,
+	391, 317, 411, 412, 287, 390, 264, 196, 295, 200,
+	402, 423, 221, 383, 0, 0, 0, 202, 421, 399,
+	314, 284, 285, 201, 0, 365, 242, 262, 233, 333,
+	418, 419, 232, 455, 211, 438, 204, 762, 437, 326,
+	414, 422, 315, 306, 203, 420, 313, 305, 290, 252,
+	272, 359, 300, 360, 273, 322, 321, 323, 0, 198,
+	0, 396, 431, 456, 218, 650, 731, 409, 449, 452,
+	0, 362, 219, 263, 251, 358, 261, 293, 448, 450,
+	451, 217, 356, 269, 337, 426, 255, 434, 624, 761,
+	618, 617, 289, 298, 723, 759, 343, 374, 222, 429,
+	393, 645, 649, 643, 644, 695, 696, 646, 751, 752,
+	753, 727, 639, 0, 647, 648, 0, 733, 741, 742,
+	700, 192, 205, 294, 755, 363, 259, 454, 436, 432,
+	626, 642, 237, 653, 0, 0, 666, 673, 674, 686,
+	688, 689, 690, 691, 699, 707, 708, 710, 718, 720,
+	722, 724, 729, 738, 758, 194, 195, 206, 215, 224,
+	236, 249, 257, 267, 271, 274, 277, 278, 281, 286,
+	303, 308, 309, 310, 311, 327, 328, 329, 332, 335,
+	336, 339, 341, 342, 345, 351, 352, 353, 354, 355,
+	357, 364, 368, 376, 377, 378, 379, 380, 381, 382,
+	386, 387, 388, 389, 397, 401, 416, 417, 428, 440,
+	444, 268, 424, 445, 0, 302, 698, 705, 304, 253,
+	270, 279, 713, 435, 398, 210, 370, 260, 207, 446,
+	447, 199, 227, 214, 234, 248, 250, 283, 312, 318,
+	347, 350, 265, 245, 225, 367, 223, 384, 404, 405,
+	406, 408, 316, 241, 745, 732, 0, 0, 681, 748,
+	652, 670, 757, 672, 675, 715, 632, 694, 334, 667,
+	0, 656, 628, 663, 629, 654, 683, 244, 687, 651,
+	734, 697, 747, 292, 0, 634, 657, 348, 717, 385,
+	230, 301, 299, 413, 254, 247, 243, 229, 276, 307,
+	346, 403, 340, 754, 296, 704, 0, 394, 319, 0,
+	0, 0, 685, 737, 692, 728, 680, 716, 641, 703,
+	749, 668, 712, 750, 282, 228, 197, 331, 395, 258,
+	0, 0, 0, 179, 180, 181, 0, 0, 0, 0,
+	0, 0, 0, 0, 220, 0, 226, 709, 744, 665,
+	711, 240, 280, 246, 239, 410, 714, 760, 627, 706,
+	0, 630, 633, 756, 740, 660, 661, 0, 0, 0,
+	0, 0, 0, 0, 684, 693, 725, 678, 0, 0,
+	0, 0, 0, 0, 0, 0, 658, 0, 702, 0,
+	0, 0, 637, 631, 0, 0, 0, 0, 682, 0,
+	0, 0, 640, 0, 659, 726, 0, 625, 266, 635,
+	320, 730, 739, 679, 441, 743, 677, 676, 746, 721,
+	638, 736, 671, 291, 636, 288, 193, 208, 0, 669,
+	330, 369, 375, 735, 655, 664, 231, 662, 373, 344,
+	427, 216, 256, 366, 349, 371, 701, 719, 372, 297,
+	415, 361, 425, 442, 443, 238, 324, 433, 407, 439,
+	453, 209, 235, 338, 400, 430, 391, 317, 411, 412,
+	287, 390, 264, 196, 295, 200, 402, 1101, 221, 383,
+	0, 0, 0, 202, 421, 399, 314, 284, 285, 201,
+	0, 365, 242, 262, 233, 333, 418, 419, 232, 455,
+	211, 438, 204, 762, 437, 326, 414, 422, 315, 306,
+	203, 420, 313, 305, 290, 252, 272, 359, 300, 360,
+	273, 322, 321, 323, 0, 198, 0, 396, 431, 456,
+	218, 650, 731, 409, 449, 452, 0, 362, 219, 263,
+	251, 358, 261, 293, 448, 450, 451, 217, 356, 269,
+	337, 426, 255, 434, 624, 761, 618, 617, 289, 298,
+	723, 759, 343, 374, 222, 429, 393, 645, 649, 643,
+	644, 695, 696, 646, 751, 752, 753, 727, 639, 0,
+	647, 648, 0, 733, 741, 742, 700, 192, 205, 294,
+	755, 363, 259, 454, 436, 432, 626, 642, 237, 653,
+	0, 0, 666, 673, 674, 686, 688, 689, 690, 691,
+	699, 707, 708, 710, 718, 720, 722, 724, 729, 738,
+	758, 194, 195, 206, 215, 224, 236, 249, 257, 267,
+	271, 274, 277, 278, 281, 286, 303, 308, 309, 310,
+	311, 327, 328, 329, 332, 335, 336, 339, 341, 342,
+	345, 351, 352, 353, 354, 355, 357, 364, 368, 376,
+	377, 378, 379, 380, 381, 382, 386, 387, 388, 389,
+	397, 401, 416, 417, 428, 440, 444, 268, 424, 445,
+	0, 302, 698, 705, 304, 253, 270, 279, 713, 435,
+	398, 210, 370, 260, 207, 446, 447, 199, 227, 214,
+	234, 248, 250, 283, 312, 318, 347, 350, 265, 245,
+	225, 367, 223, 384, 404, 405, 406, 408, 316, 241,
+	745, 732, 0, 0, 681, 748, 652, 670, 757, 672,
+	675, 715, 632, 694, 334, 667, 0, 656, 628, 663,
+	629, 654, 683, 244, 687, 651, 734, 697, 747, 292,
+	0, 634, 657, 348, 717, 385, 230, 301, 299, 413,
+	254, 247, 243, 229, 276, 307, 346, 403, 340, 754,
+	296, 704, 0, 394, 319, 0, 0, 0, 685, 737,
+	692, 728, 680, 716, 641, 703, 749, 668, 712, 750,
+	282, 228, 197, 331, 395, 258, 0, 0, 0, 179,
+	180, 181, 0, 0, 0, 0, 0, 0, 0, 0,
+	220, 0, 226, 709, 744, 665, 711, 240, 280, 246,
+	239, 410, 714, 760, 627, 706, 0, 630, 633, 756,
+	740, 660, 661, 0, 0, 0, 0, 0, 0, 0,
+	684, 693, 725, 678, 0, 0, 0, 0, 0, 0,
+	0, 0, 658, 0, 702, 0, 0, 0, 637, 631,
+	0, 0, 0, 0, 682, 0, 0, 0, 640, 0,
+	659, 726, 0, 625, 266, 635, 320, 730, 739, 679,
+	441, 743, 677, 676, 746, 721, 638, 736, 671, 291,
+	636, 288, 193, 208, 0, 669, 330, 369, 375, 735,
+	655, 664, 231, 662, 373, 344, 427, 216, 256, 366,
+	349, 371, 701, 719, 372, 297, 415, 361, 425, 442,
+	443, 238, 324, 433, 407, 439, 453, 209, 235, 338,
+	400, 430, 391, 317, 411, 412, 287, 390, 264, 196,
+	295, 200, 402, 615, 221, 383, 0, 0, 0, 202,
+	421, 399, 314, 284, 285, 201, 0, 365, 242, 262,
+	233, 333, 418, 419, 232, 455, 211, 438, 204, 762,
+	437, 326, 414, 422, 315, 306, 203, 420, 313, 305,
+	290, 252, 272, 359, 300, 360, 273, 322, 321, 323,
+	0, 198, 0, 396, 431, 456, 218, 650, 731, 409,
+	449, 452, 0, 362, 219, 263, 251, 358, 261, 293,
+	448, 450, 451, 217, 356, 269, 337, 426, 255, 434,
+	624, 761, 618, 617, 289, 298, 723, 759, 343, 374,
+	222, 429, 393, 645, 649, 643, 644, 695, 696, 646,
+	751, 752, 753, 727, 639, 0, 647, 648, 0, 733,
+	741, 742, 700, 192, 205, 294, 755, 363, 259, 454,
+	436, 432, 626, 642, 237, 653, 0, 0, 666, 673,
+	674, 686, 688, 689, 690, 691, 699, 707, 708, 710,
+	718, 720, 722, 724, 729, 738, 758, 194, 195, 206,
+	215, 224, 236, 249, 257, 267, 271, 274, 277, 278,
+	281, 286, 303, 308, 309, 310, 311, 327, 328, 329,
+	332, 335, 336, 339, 341, 342, 345, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	381, 382, 386, 387, 388, 389, 397, 401, 416, 417,
+	428, 440, 444, 268, 424, 445, 0, 302, 698, 705,
+	304, 253, 270, 279, 713, 435, 398, 210, 370, 260,
+	207, 446, 447, 199, 227, 214, 234, 248, 250, 283,
+	312, 318, 347, 350, 265, 245, 225, 367, 223, 384,
+	404, 405, 406, 408, 316, 241, 334, 0, 0, 1403,
+	0, 517, 0, 0, 0, 244, 0, 516, 0, 0,
+	0, 292, 0, 0, 1404, 348, 0, 385, 230, 301,
+	299, 413, 254, 247, 243, 229, 276, 307, 346, 403,
+	340, 560, 296, 0, 0, 394, 319, 0, 0, 0,
+	0, 0, 551, 552, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 228, 197, 331, 395, 258, 71, 0,
+	0, 179, 180, 181, 538, 537, 540, 541, 542, 543,
+	0, 0, 220, 539, 226, 544, 545, 546, 0, 240,
+	280, 246, 239, 410, 0, 0, 0, 514, 531, 0,
+	559, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	528, 529, 605, 0, 0, 0, 574, 0, 530, 0,
+	0, 523, 524, 526, 525, 527, 532, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 266, 0, 320, 573,
+	0, 0, 441, 0, 0, 571, 0, 0, 0, 0,
+	0, 291, 0, 288, 193, 208, 0, 0, 330, 369,
+	375, 0, 0, 0, 231, 0, 373, 344, 427, 216,
+	256, 366, 349, 371, 0, 0, 372, 297, 415, 361,
+	425, 442, 443, 238, 324, 433, 407, 439, 453, 209,
+	235, 338, 400, 430, 391, 317, 411, 412, 287, 390,
+	264, 196, 295, 200, 402, 423, 221, 383, 0, 0,
+	0, 202, 421, 399, 314, 284, 285, 201, 0, 365,
+	242, 262, 233, 333, 418, 419, 232, 455, 211, 438,
+	204, 212, 437, 326, 414, 422, 315, 306, 203, 420,
+	313, 305, 290, 252, 272, 359, 300, 360, 273, 322,
+	321, 323, 0, 198, 0, 396, 431, 456, 218, 0,
+	0, 409, 449, 452, 0, 362, 219, 263, 251, 358,
+	261, 293, 448, 450, 451, 217, 356, 269, 337, 426,
+	255, 434, 325, 213, 275, 392, 289, 298, 0, 0,
+	343, 374, 222, 429, 393, 561, 572, 567, 568, 565,
+	566, 0, 564, 563, 562, 575, 553, 554, 555, 556,
+	558, 0, 569, 570, 557, 192, 205, 294, 0, 363,
+	259, 454, 436, 432, 0, 0, 237, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 194,
+	195, 206, 215, 224, 236, 249, 257, 267, 271, 274,
+	277, 278, 281, 286, 303, 308, 309, 310, 311, 327,
+	328, 329, 332, 335, 336, 339, 341, 342, 345, 351,
+	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
+	379, 380, 381, 382, 386, 387, 388, 389, 397, 401,
+	416, 417, 428, 440, 444, 268, 424, 445, 0, 302,
+	0, 0, 304, 253, 270, 279, 0, 435, 398, 210,
+	370, 260, 207, 446, 447, 199, 227, 214, 234, 248,
+	250, 283, 312, 318, 347, 350, 265, 245, 225, 367,
+	223, 384, 404, 405, 406, 408, 316, 241, 334, 0,
+	0, 0, 0, 517, 0, 0, 0, 244, 0, 516,
+	0, 0, 0, 292, 0, 0, 0, 348, 0, 385,
+	230, 301, 299, 413, 254, 247, 243, 229, 276, 307,
+	346, 403, 340, 560, 296, 0, 0, 394, 319, 0,
+	0, 0, 0, 0, 551, 552, 0, 0, 0, 0,
+	0, 0, 1515, 0, 282, 228, 197, 331, 395, 258,
+	71, 0, 0, 179, 180, 181, 538, 537, 540, 541,
+	542, 543, 0, 0, 220, 539, 226, 544, 545, 546,
+	1516, 240, 280, 246, 239, 410, 0, 0, 0, 514,
+	531, 0, 559, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 528, 529, 0, 0, 0, 0, 574, 0,
+	530, 0, 0, 523, 524, 526, 525, 527, 532, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 266, 0,
+	320, 573, 0, 0, 441, 0, 0, 571, 0, 0,
+	0, 0, 0, 291, 0, 288, 193, 208, 0, 0,
+	330, 369, 375, 0, 0, 0, 231, 0, 373, 344,
+	427, 216, 256, 366, 349, 371, 0, 0, 372, 297,
+	415, 361, 425, 442, 443, 238, 324, 433, 407, 439,
+	453, 209, 235, 338, 400, 430, 391, 317, 411, 412,
+	287, 390, 264, 196, 295, 200, 402, 423, 221, 383,
+	0, 0, 0, 202, 421, 399, 314, 284, 285, 201,
+	0, 365, 242, 262, 233, 333, 418, 419, 232, 455,
+	211, 438, 204, 212, 437, 326, 414, 422, 315, 306,
+	203, 420, 313, 305, 290, 252, 272, 359, 300, 360,
+	273, 322, 321, 323, 0, 198, 0, 396, 431, 456,
+	218, 0, 0, 409, 449, 452, 0, 362, 219, 263,
+	251, 358, 261, 293, 448, 450, 451, 217, 356, 269,
+	337, 426, 255, 434, 325, 213, 275, 392, 289, 298,
+	0, 0, 343, 374, 222, 429, 393, 561, 572, 567,
+	568, 565, 566, 0, 564, 563, 562, 575, 553, 554,
+	555, 556, 558, 0, 569, 570, 557, 192, 205, 294,
+	0, 363, 259, 454, 436, 432, 0, 0, 237, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 194, 195, 206, 215, 224, 236, 249, 257, 267,
+	271, 274, 277, 278, 281, 286, 303, 308, 309, 310,
+	311, 327, 328, 329, 332, 335, 336, 339, 341, 342,
+	345, 351, 352, 353, 354, 355, 357, 364, 368, 376,
+	377, 378, 379, 380, 381, 382, 386, 387, 388, 389,
+	397, 401, 416, 417, 428, 440, 444, 268, 424, 445,
+	0, 302, 0, 0, 304, 253, 270, 279, 0, 435,
+	398, 210, 370, 260, 207, 446, 447, 199, 227, 214,
+	234, 248, 250, 283, 312, 318, 347, 350, 265, 245,
+	225, 367, 223, 384, 404, 405, 406, 408, 316, 241,
+	334, 0, 0, 0, 0, 517, 0, 0, 0, 244,
+	0, 516, 0, 0, 0, 292, 0, 0, 0, 348,
+	0, 385, 230, 301, 299, 413, 254, 247, 243, 229,
+	276, 307, 346, 403, 340, 560, 296, 0, 0, 394,
+	319, 0, 0, 0, 0, 0, 551, 552, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 228, 197, 331,
+	395, 258, 71, 0, 593, 179, 180, 181, 538, 537,
+	540, 541, 542, 543, 0, 0, 220, 539, 226, 544,
+	545, 546, 0, 240, 280, 246, 239, 410, 0, 0,
+	0, 514, 531, 0, 559, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 528, 529, 0, 0, 0, 0,
+	574, 0, 530, 0, 0, 523, 524, 526, 525, 527,
+	532, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 0, 320, 573, 0, 0, 441, 0, 0, 571,
+	0, 0, 0, 0, 0, 291, 0, 288, 193, 208,
+	0, 0, 330, 369, 375, 0, 0, 0, 231, 0,
+	373, 344, 427, 216, 256, 366, 349, 371, 0, 0,
+	372, 297, 415, 361, 425, 442, 443, 238, 324, 433,
+	407, 439, 453, 209, 235, 338, 400, 430, 391, 317,
+	411, 412, 287, 390, 264, 196, 295, 200, 402, 423,
+	221, 383, 0, 0, 0, 202, 421, 399, 314, 284,
+	285, 201, 0, 365, 242, 262, 233, 333, 418, 419,
+	232, 455, 211, 438, 204, 212, 437, 326, 414, 422,
+	315, 306, 203, 420, 313, 305, 290, 252, 272, 359,
+	300, 360, 273, 322, 321, 323, 0, 198, 0, 396,
+	431, 456, 218, 0, 0, 409, 449, 452, 0, 362,
+	219, 263, 251, 358, 261, 293, 448, 450, 451, 217,
+	356, 269, 337, 426, 255, 434, 325, 213, 275, 392,
+	289, 298, 0, 0, 343, 374, 222, 429, 393, 561,
+	572, 567, 568, 565, 566, 0, 564, 563, 562, 575,
+	553, 554, 555, 556, 558, 0, 569, 570, 557, 192,
+	205, 294, 0, 363, 259, 454, 436, 432, 0, 0,
+	237, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 194, 195, 206, 215, 224, 236, 249,
+	257, 267, 271, 274, 277, 278, 281, 286, 303, 308,
+	309, 310, 311, 327, 328, 329, 332, 335, 336, 339,
+	341, 342, 345, 351, 352, 353, 354, 355, 357, 364,
+	368, 376, 377, 378, 379, 380, 381, 382, 386, 387,
+	388, 389, 397, 401, 416, 417, 428, 440, 444, 268,
+	424, 445, 0, 302, 0, 0, 304, 253, 270, 279,
+	0, 435, 398, 210, 370, 260, 207, 446, 447, 199,
+	227, 214, 234, 248, 250, 283, 312, 318, 347, 350,
+	265, 245, 225, 367, 223, 384, 404, 405, 406, 408,
+	316, 241, 334, 0, 0, 0, 0, 517, 0, 0,
+	0, 244, 0, 516, 0, 0, 0, 292, 0, 0,
+	0, 348, 0, 385, 230, 301, 299, 413, 254, 247,
+	243, 229, 276, 307, 346, 403, 340, 560, 296, 0,
+	0, 394, 319, 0, 0, 0, 0, 0, 551, 552,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 228,
+	197, 331, 395, 258, 71, 0, 0, 179, 180, 181,
+	538, 537, 540, 541, 542, 543, 0, 0, 220, 539,
+	226, 544, 545, 546, 0, 240, 280, 246, 239, 410,
+	0, 0, 0, 514, 531, 0, 559, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 528, 529, 605, 0,
+	0, 0, 574, 0, 530, 0, 0, 523, 524, 526,
+	525, 527, 532, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 266, 0, 320, 573, 0, 0, 441, 0,
+	0, 571, 0, 0, 0, 0, 0, 291, 0, 288,
+	193, 208, 0, 0, 330, 369, 375, 0, 0, 0,
+	231, 0, 373, 344, 427, 216, 256, 366, 349, 371,
+	0, 0, 372, 297, 415, 361, 425, 442, 443, 238,
+	324, 433, 407, 439, 453, 209, 235, 338, 400, 430,
+	391, 317, 411, 412, 287, 390, 264, 196, 295, 200,
+	402, 423, 221, 383, 0, 0, 0, 202, 421, 399,
+	314, 284, 285, 201, 0, 365, 242, 262, 233, 333,
+	418, 419, 232, 455, 211, 438, 204, 212, 437, 326,
+	414, 422, 315, 306, 203, 420, 313, 305, 290, 252,
+	272, 359, 300, 360, 273, 322, 321, 323, 0, 198,
+	0, 396, 431, 456, 218, 0, 0, 409, 449, 452,
+	0, 362, 219, 263, 251, 358, 261, 293, 448, 450,
+	451, 217, 356, 269, 337, 426, 255, 434, 325, 213,
+	275, 392, 289, 298, 0, 0, 343, 374, 222, 429,
+	393, 561, 572, 567, 568, 565, 566, 0, 564, 563,
+	562, 575, 553, 554, 555, 556, 558, 0, 569, 570,
+	557, 192, 205, 294, 0, 363, 259, 454, 436, 432,
+	0, 0, 237, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 194, 195, 206, 215, 224,
+	236, 249, 257, 267, 271, 274, 277, 278, 281, 286,
+	303, 308, 309, 310, 311, 327, 328, 329, 332, 335,
+	336, 339, 341, 342, 345, 351, 352, 353, 354, 355,
+	357, 364, 368, 376, 377, 378, 379, 380, 381, 382,
+	386, 387, 388, 389, 397, 401, 416, 417, 428, 440,
+	444, 268, 424, 445, 0, 302, 0, 0, 304, 253,
+	270, 279, 0, 435, 398, 210, 370, 260, 207, 446,
+	447, 199, 227, 214, 234, 248, 250, 283, 312, 318,
+	347, 350, 265, 245, 225, 367, 223, 384, 404, 405,
+	406, 408, 316, 241, 334, 0, 0, 0, 0, 517,
+	0, 0, 0, 244, 0, 516, 0, 0, 0, 292,
+	0, 0, 0, 348, 0, 385, 230, 301, 299, 413,
+	254, 247, 243, 229, 276, 307, 346, 403, 340, 560,
+	296, 0, 0, 394, 319, 0, 0, 0, 0, 0,
+	551, 552, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 228, 197, 331, 395, 258, 71, 0, 0, 179,
+	180, 181, 538, 1421, 540, 541, 542, 543, 0, 0,
+	220, 539, 226, 544, 545, 546, 0, 240, 280, 246,
+	239, 410, 0, 0, 0, 514, 531, 0, 559, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 528, 529,
+	605, 0, 0, 0, 574, 0, 530, 0, 0, 523,
+	524, 526, 525, 527, 532, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 266, 0, 320, 573, 0, 0,
+	441, 0, 0, 571, 0, 0, 0, 0, 0, 291,
+	0, 288, 193, 208, 0, 0, 330, 369, 375, 0,
+	0, 0, 231, 0, 373, 344, 427, 216, 256, 366,
+	349, 371, 0, 0, 372, 297, 415, 361, 425, 442,
+	443, 238, 324, 433, 407, 439, 453, 209, 235, 338,
+	400, 430, 391, 317, 411, 412, 287, 390, 264, 196,
+	295, 200, 402, 423, 221, 383, 0, 0, 0, 202,
+	421, 399, 314, 284, 285, 201, 0, 365, 242, 262,
+	233, 333, 418, 419, 232, 455, 211, 438, 204, 212,
+	437, 326, 414, 422, 315, 306, 203, 420, 313, 305,
+	290, 252, 272, 359, 300, 360, 273, 322, 321, 323,
+	0, 198, 0, 396, 431, 456, 218, 0, 0, 409,
+	449, 452, 0, 362, 219, 263, 251, 358, 261, 293,
+	448, 450, 451, 217, 356, 269, 337, 426, 255, 434,
+	325, 213, 275, 392, 289, 298, 0, 0, 343, 374,
+	222, 429, 393, 561, 572, 567, 568, 565, 566, 0,
+	564, 563, 562, 575, 553, 554, 555, 556, 558, 0,
+	569, 570, 557, 192, 205, 294, 0, 363, 259, 454,
+	436, 432, 0, 0, 237, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 194, 195, 206,
+	215, 224, 236, 249, 257, 267, 271, 274, 277, 278,
+	281, 286, 303, 308, 309, 310, 311, 327, 328, 329,
+	332, 335, 336, 339, 341, 342, 345, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	381, 382, 386, 387, 388, 389, 397, 401, 416, 417,
+	428, 440, 444, 268, 424, 445, 0, 302, 0, 0,
+	304, 253, 270, 279, 0, 435, 398, 210, 370, 260,
+	207, 446, 447, 199, 227, 214, 234, 248, 250, 283,
+	312, 318, 347, 350, 265, 245, 225, 367, 223, 384,
+	404, 405, 406, 408, 316, 241, 334, 0, 0, 0,
+	0, 517, 0, 0, 0, 244, 0, 516, 0, 0,
+	0, 292, 0, 0, 0, 348, 0, 385, 230, 301,
+	299, 413, 254, 247, 243, 229, 276, 307, 346, 403,
+	340, 560, 296, 0, 0, 394, 319, 0, 0, 0,
+	0, 0, 551, 552, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 228, 197, 331, 395, 258, 71, 0,
+	0, 179, 180, 181, 538, 1418, 540, 541, 542, 543,
+	0, 0, 220, 539, 226, 544, 545, 546, 0, 240,
+	280, 246, 239, 410, 0, 0, 0, 514, 531, 0,
+	559, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	528, 529, 605, 0, 0, 0, 574, 0, 530, 0,
+	0, 523, 524, 526, 525, 527, 532, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 266, 0, 320, 573,
+	0, 0, 441, 0, 0, 571, 0, 0, 0, 0,
+	0, 291, 0, 288, 193, 208, 0, 0, 330, 369,
+	375, 0, 0, 0, 231, 0, 373, 344, 427, 216,
+	256, 366, 349, 371, 0, 0, 372, 297, 415, 361,
+	425, 442, 443, 238, 324, 433, 407, 439, 453, 209,
+	235, 338, 400, 430, 391, 317, 411, 412, 287, 390,
+	264, 196, 295, 200, 402, 423, 221, 383, 0, 0,
+	0, 202, 421, 399, 314, 284, 285, 201, 0, 365,
+	242, 262, 233, 333, 418, 419, 232, 455, 211, 438,
+	204, 212, 437, 326, 414, 422, 315, 306, 203, 420,
+	313, 305, 290, 252, 272, 359, 300, 360, 273, 322,
+	321, 323, 0, 198, 0, 396, 431, 456, 218, 0,
+	0, 409, 449, 452, 0, 362, 219, 263, 251, 358,
+	261, 293, 448, 450, 451, 217, 356, 269, 337, 426,
+	255, 434, 325, 213, 275, 392, 289, 298, 0, 0,
+	343, 374, 222, 429, 393, 561, 572, 567, 568, 565,
+	566, 0, 564, 563, 562, 575, 553, 554, 555, 556,
+	558, 0, 569, 570, 557, 192, 205, 294, 0, 363,
+	259, 454, 436, 432, 0, 0, 237, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 194,
+	195, 206, 215, 224, 236, 249, 257, 267, 271, 274,
+	277, 278, 281, 286, 303, 308, 309, 310, 311, 327,
+	328, 329, 332, 335, 336, 339, 341, 342, 345, 351,
+	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
+	379, 380, 381, 382, 386, 387, 388, 389, 397, 401,
+	416, 417, 428, 440, 444, 268, 424, 445, 0, 302,
+	0, 0, 304, 253, 270, 279, 0, 435, 398, 210,
+	370, 260, 207, 446, 447, 199, 227, 214, 234, 248,
+	250, 283, 312, 318, 347, 350, 265, 245, 225, 367,
+	223, 384, 404, 405, 406, 408, 316, 241, 586, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 334, 0, 0, 0, 0, 517, 0, 0, 0,
+	244, 0, 516, 0, 0, 0, 292, 0, 0, 0,
+	348, 0, 385, 230, 301, 299, 413, 254, 247, 243,
+	229, 276, 307, 346, 403, 340, 560, 296, 0, 0,
+	394, 319, 0, 0, 0, 0, 0, 551, 552, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 228, 197,
+	331, 395, 258, 71, 0, 0, 179, 180, 181, 538,
+	537, 540, 541, 542, 543, 0, 0, 220, 539, 226,
+	544, 545, 546, 0, 240, 280, 246, 239, 410, 0,
+	0, 0, 514, 531, 0, 559, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 528, 529, 0, 0, 0,
+	0, 574, 0, 530, 0, 0, 523, 524, 526, 525,
+	527, 532, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 0, 320, 573, 0, 0, 441, 0, 0,
+	571, 0, 0, 0, 0, 0, 291, 0, 288, 193,
+	208, 0, 0, 330, 369, 375, 0, 0, 0, 231,
+	0, 373, 344, 427, 216, 256, 366, 349, 371, 0,
+	0, 372, 297, 415, 361, 425, 442, 443, 238, 324,
+	433, 407, 439, 453, 209, 235, 338, 400, 430, 391,
+	317, 411, 412, 287, 390, 264, 196, 295, 200, 402,
+	423, 221, 383, 0, 0, 0, 202, 421, 399, 314,
+	284, 285, 201, 0, 365, 242, 262, 233, 333, 418,
+	419, 232, 455, 211, 438, 204, 212, 437, 326, 414,
+	422, 315, 306, 203, 420, 313, 305, 290, 252, 272,
+	359, 300, 360, 273, 322, 321, 323, 0, 198, 0,
+	396, 431, 456, 218, 0, 0, 409, 449, 452, 0,
+	362, 219, 263, 251, 358, 261, 293, 448, 450, 451,
+	217, 356, 269, 337, 426, 255, 434, 325, 213, 275,
+	392, 289, 298, 0, 0, 343, 374, 222, 429, 393,
+	561, 572, 567, 568, 565, 566, 0, 564, 563, 562,
+	575, 553, 554, 555, 556, 558, 0, 569, 570, 557,
+	192, 205, 294, 0, 363, 259, 454, 436, 432, 0,
+	0, 237, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 194, 195, 206, 215, 224, 236,
+	249, 257, 267, 271, 274, 277, 278, 281, 286, 303,
+	308, 309, 310, 311, 327, 328, 329, 332, 335, 336,
+	339, 341, 342, 345, 351, 352, 353, 354, 355, 357,
+	364, 368, 376, 377, 378, 379, 380, 381, 382, 386,
+	387, 388, 389, 397, 401, 416, 417, 428, 440, 444,
+	268, 424, 445, 0, 302, 0, 0, 304, 253, 270,
+	279, 0, 435, 398, 210, 370, 260, 207, 446, 447,
+	199, 227, 214, 234, 248, 250, 283, 312, 318, 347,
+	350, 265, 245, 225, 367, 223, 384, 404, 405, 406,
+	408, 316, 241, 334, 0, 0, 0, 0, 517, 0,
+	0, 0, 244, 0, 516, 0, 0, 0, 292, 0,
+	0, 0, 348, 0, 385, 230, 301, 299, 413, 254,
+	247, 243, 229, 276, 307, 346, 403, 340, 560, 296,
+	0, 0, 394, 319, 0, 0, 0, 0, 0, 551,
+	552, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	228, 197, 331, 395, 258, 71, 0, 0, 179, 180,
+	181, 538, 537, 540, 541, 542, 543, 0, 0, 220,
+	539, 226, 544, 545, 546, 0, 240, 280, 246, 239,
+	410, 0, 0, 0, 514, 531, 0, 559, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 528, 529, 0,
+	0, 0, 0, 574, 0, 530, 0, 0, 523, 524,
+	526, 525, 527, 532, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 266, 0, 320, 573, 0, 0, 441,
+	0, 0, 571, 0, 0, 0, 0, 0, 291, 0,
+	288, 193, 208, 0, 0, 330, 369, 375, 0, 0,
+	0, 231, 0, 373, 344, 427, 216, 256, 366, 349,
+	371, 0, 0, 372, 297, 415, 361, 425, 442, 443,
+	238, 324, 433, 407, 439, 453, 209, 235, 338, 400,
+	430, 391, 317, 411, 412, 287, 390, 264, 196, 295,
+	200, 402, 423, 221, 383, 0, 0, 0, 202, 421,
+	399, 314, 284, 285, 201, 0, 365, 242, 262, 233,
+	333, 418, 419, 232, 455, 211, 438, 204, 212, 437,
+	326, 414, 422, 315, 306, 203, 420, 313, 305, 290,
+	252, 272, 359, 300, 360, 273, 322, 321, 323, 0,
+	198, 0, 396, 431, 456, 218, 0, 0, 409, 449,
+	452, 0, 362, 219, 263, 251, 358, 261, 293, 448,
+	450, 451, 217, 356, 269, 337, 426, 255, 434, 325,
+	213, 275, 392, 289, 298, 0, 0, 343, 374, 222,
+	429, 393, 561, 572, 567, 568, 565, 566, 0, 564,
+	563, 562, 575, 553, 554, 555, 556, 558, 0, 569,
+	570, 557, 192, 205, 294, 0, 363, 259, 454, 436,
+	432, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 194, 195, 206, 215,
+	224, 236, 249, 257, 267, 271, 274, 277, 278, 281,
+	286, 303, 308, 309, 310, 311, 327, 328, 329, 332,
+	335, 336, 339, 341, 342, 345, 351, 352, 353, 354,
+	355, 357, 364, 368, 376, 377, 378, 379, 380, 381,
+	382, 386, 387, 388, 389, 397, 401, 416, 417, 428,
+	440, 444, 268, 424, 445, 0, 302, 0, 0, 304,
+	253, 270, 279, 0, 435, 398, 210, 370, 260, 207,
+	446, 447, 199, 227, 214, 234, 248, 250, 283, 312,
+	318, 347, 350, 265, 245, 225, 367, 223, 384, 404,
+	405, 406, 408, 316, 241, 334, 0, 0, 0, 0,
+	0, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	292, 0, 0, 0, 348, 0, 385, 230, 301, 299,
+	413, 254, 247, 243, 229, 276, 307, 346, 403, 340,
+	560, 296, 0, 0, 394, 319, 0, 0, 0, 0,
+	0, 551, 552, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 228, 197, 331, 395, 258, 71, 0, 0,
+	179, 180, 181, 538, 537, 540, 541, 542, 543, 0,
+	0, 220, 539, 226, 544, 545, 546, 0, 240, 280,
+	246, 239, 410, 0, 0, 0, 0, 531, 0, 559,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 528,
+	529, 0, 0, 0, 0, 574, 0, 530, 0, 0,
+	523, 524, 526, 525, 527, 532, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 266, 0, 320, 573, 0,
+	0, 441, 0, 0, 571, 0, 0, 0, 0, 0,
+	291, 0, 288, 193, 208, 0, 0, 330, 369, 375,
+	0, 0, 0, 231, 0, 373, 344, 427, 216, 256,
+	366, 349, 371, 2175, 0, 372, 297, 415, 361, 425,
+	442, 443, 238, 324, 433, 407, 439, 453, 209, 235,
+	338, 400, 430, 391, 317, 411, 412, 287, 390, 264,
+	196, 295, 200, 402, 423, 221, 383, 0, 0, 0,
+	202, 421, 399, 314, 284, 285, 201, 0, 365, 242,
+	262, 233, 333, 418, 419, 232, 455, 211, 438, 204,
+	212, 437, 326, 414, 422, 315, 306, 203, 420, 313,
+	305, 290, 252, 272, 359, 300, 360, 273, 322, 321,
+	323, 0, 198, 0, 396, 431, 456, 218, 0, 0,
+	409, 449, 452, 0, 362, 219, 263, 251, 358, 261,
+	293, 448, 450, 451, 217, 356, 269, 337, 426, 255,
+	434, 325, 213, 275, 392, 289, 298, 0, 0, 343,
+	374, 222, 429, 393, 561, 572, 567, 568, 565, 566,
+	0, 564, 563, 562, 575, 553, 554, 555, 556, 558,
+	0, 569, 570, 557, 192, 205, 294, 0, 363, 259,
+	454, 436, 432, 0, 0, 237, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 194, 195,
+	206, 215, 224, 236, 249, 257, 267, 271, 274, 277,
+	278, 281, 286, 303, 308, 309, 310, 311, 327, 328,
+	329, 332, 335, 336, 339, 341, 342, 345, 351, 352,
+	353, 354, 355, 357, 364, 368, 376, 377, 378, 379,
+	380, 381, 382, 386, 387, 388, 389, 397, 401, 416,
+	417, 428, 440, 444, 268, 424, 445, 0, 302, 0,
+	0, 304, 253, 270, 279, 0, 435, 398, 210, 370,
+	260, 207, 446, 447, 199, 227, 214, 234, 248, 250,
+	283, 312, 318, 347, 350, 265, 245, 225, 367, 223,
+	384, 404, 405, 406, 408, 316, 241, 334, 0, 0,
+	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
+	0, 0, 292, 0, 0, 0, 348, 0, 385, 230,
+	301, 299, 413, 254, 247, 243, 229, 276, 307, 346,
+	403, 340, 560, 296, 0, 0, 394, 319, 0, 0,
+	0, 0, 0, 551, 552, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 228, 197, 331, 395, 258, 71,
+	0, 593, 179, 180, 181, 538, 537, 540, 541, 542,
+	543, 0, 0, 220, 539, 226, 544, 545, 546, 0,
+	240, 280, 246, 239, 410, 0, 0, 0, 0, 531,
+	0, 559, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 528, 529, 0, 0, 0, 0, 574, 0, 530,
+	0, 0, 523, 524, 526, 525, 527, 532, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 266, 0, 320,
+	573, 0, 0, 441, 0, 0, 571, 0, 0, 0,
+	0, 0, 291, 0, 288, 193, 208, 0, 0, 330,
+	369, 375, 0, 0, 0, 231, 0, 373, 344, 427,
+	216, 256, 366, 349, 371, 0, 0, 372, 297, 415,
+	361, 425, 442, 443, 238, 324, 433, 407, 439, 453,
+	209, 235, 338, 400, 430, 391, 317, 411, 412, 287,
+	390, 264, 196, 295, 200, 402, 423, 221, 383, 0,
+	0, 0, 202, 421, 399, 314, 284, 285, 201, 0,
+	365, 242, 262, 233, 333, 418, 419, 232, 455, 211,
+	438, 204, 212, 437, 326, 414, 422, 315, 306, 203,
+	420, 313, 305, 290, 252, 272, 359, 300, 360, 273,
+	322, 321, 323, 0, 198, 0, 396, 431, 456, 218,
+	0, 0, 409, 449, 452, 0, 362, 219, 263, 251,
+	358, 261, 293, 448, 450, 451, 217, 356, 269, 337,
+	426, 255, 434, 325, 213, 275, 392, 289, 298, 0,
+	0, 343, 374, 222, 429, 393, 561, 572, 567, 568,
+	565, 566, 0, 564, 563, 562, 575, 553, 554, 555,
+	556, 558, 0, 569, 570, 557, 192, 205, 294, 0,
+	363, 259, 454, 436, 432, 0, 0, 237, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	194, 195, 206, 215, 224, 236, 249, 257, 267, 271,
+	274, 277, 278, 281, 286, 303, 308, 309, 310, 311,
+	327, 328, 329, 332, 335, 336, 339, 341, 342, 345,
+	351, 352, 353, 354, 355, 357, 364, 368, 376, 377,
+	378, 379, 380, 381, 382, 386, 387, 388, 389, 397,
+	401, 416, 417, 428, 440, 444, 268, 424, 445, 0,
+	302, 0, 0, 304, 253, 270, 279, 0, 435, 398,
+	210, 370, 260, 207, 446, 447, 199, 227, 214, 234,
+	248, 250, 283, 312, 318, 347, 350, 265, 245, 225,
+	367, 223, 384, 404, 405, 406, 408, 316, 241, 334,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 292, 0, 0, 0, 348, 0,
+	385, 230, 301, 299, 413, 254, 247, 243, 229, 276,
+	307, 346, 403, 340, 560, 296, 0, 0, 394, 319,
+	0, 0, 0, 0, 0, 551, 552, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 228, 197, 331, 395,
+	258, 71, 0, 0, 179, 180, 181, 538, 537, 540,
+	541, 542, 543, 0, 0, 220, 539, 226, 544, 545,
+	546, 0, 240, 280, 246, 239, 410, 0, 0, 0,
+	0, 531, 0, 559, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 528, 529, 0, 0, 0, 0, 574,
+	0, 530, 0, 0, 523, 524, 526, 525, 527, 532,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	0, 320, 573, 0, 0, 441, 0, 0, 571, 0,
+	0, 0, 0, 0, 291, 0, 288, 193, 208, 0,
+	0, 330, 369, 375, 0, 0, 0, 231, 0, 373,
+	344, 427, 216, 256, 366, 349, 371, 0, 0, 372,
+	297, 415, 361, 425, 442, 443, 238, 324, 433, 407,
+	439, 453, 209, 235, 338, 400, 430, 391, 317, 411,
+	412, 287, 390, 264, 196, 295, 200, 402, 423, 221,
+	383, 0, 0, 0, 202, 421, 399, 314, 284, 285,
+	201, 0, 365, 242, 262, 233, 333, 418, 419, 232,
+	455, 211, 438, 204, 212, 437, 326, 414, 422, 315,
+	306, 203, 420, 313, 305, 290, 252, 272, 359, 300,
+	360, 273, 322, 321, 323, 0, 198, 0, 396, 431,
+	456, 218, 0, 0, 409, 449, 452, 0, 362, 219,
+	263, 251, 358, 261, 293, 448, 450, 451, 217, 356,
+	269, 337, 426, 255, 434, 325, 213, 275, 392, 289,
+	298, 0, 0, 343, 374, 222, 429, 393, 561, 572,
+	567, 568, 565, 566, 0, 564, 563, 562, 575, 553,
+	554, 555, 556, 558, 0, 569, 570, 557, 192, 205,
+	294, 0, 363, 259, 454, 436, 432, 0, 0, 237,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 194, 195, 206, 215, 224, 236, 249, 257,
+	267, 271, 274, 277, 278, 281, 286, 303, 308, 309,
+	310, 311, 327, 328, 329, 332, 335, 336, 339, 341,
+	342, 345, 351, 352, 353, 354, 355, 357, 364, 368,
+	376, 377, 378, 379, 380, 381, 382, 386, 387, 388,
+	389, 397, 401, 416, 417, 428, 440, 444, 268, 424,
+	445, 0, 302, 0, 0, 304, 253, 270, 279, 0,
+	435, 398, 210, 370, 260, 207, 446, 447, 199, 227,
+	214, 234, 248, 250, 283, 312, 318, 347, 350, 265,
+	245, 225, 367, 223, 384, 404, 405, 406, 408, 316,
+	241, 334, 0, 0, 0, 0, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 292, 0, 0, 0,
+	348, 0, 385, 230, 301, 299, 413, 254, 247, 243,
+	229, 276, 307, 346, 403, 340, 0, 296, 0, 0,
+	394, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 228, 197,
+	331, 395, 258, 0, 0, 0, 179, 180, 181, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 0, 226,
+	0, 0, 0, 0, 240, 280, 246, 239, 410, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 977, 976, 986, 987, 979, 980, 981, 982, 983,
+	984, 985, 978, 0, 0, 988, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 0, 320, 0, 0, 0, 441, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 288, 193,
+	208, 0, 0, 330, 369, 375, 0, 0, 0, 231,
+	0, 373, 344, 427, 216, 256, 366, 349, 371, 0,
+	0, 372, 297, 415, 361, 425, 442, 443, 238, 324,
+	433, 407, 439, 453, 209, 235, 338, 400, 430, 391,
+	317, 411, 412, 287, 390, 264, 196, 295, 200, 402,
+	423, 221, 383, 0, 0, 0, 202, 421, 399, 314,
+	284, 285, 201, 0, 365, 242, 262, 233, 333, 418,
+	419, 232, 455, 211, 438, 204, 212, 437, 326, 414,
+	422, 315, 306, 203, 420, 313, 305, 290, 252, 272,
+	359, 300, 360, 273, 322, 321, 323, 0, 198, 0,
+	396, 431, 456, 218, 0, 0, 409, 449, 452, 0,
+	362, 219, 263, 251, 358, 261, 293, 448, 450, 451,
+	217, 356, 269, 337, 426, 255, 434, 325, 213, 275,
+	392, 289, 298, 0, 0, 343, 374, 222, 429, 393,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	192, 205, 294, 0, 363, 259, 454, 436, 432, 0,
+	0, 237, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 194, 195, 206, 215, 224, 236,
+	249, 257, 267, 271, 274, 277, 278, 281, 286, 303,
+	308, 309, 310, 311, 327, 328, 329, 332, 335, 336,
+	339, 341, 342, 345, 351, 352, 353, 354, 355, 357,
+	364, 368, 376, 377, 378, 379, 380, 381, 382, 386,
+	387, 388, 389, 397, 401, 416, 417, 428, 440, 444,
+	268, 424, 445, 0, 302, 0, 0, 304, 253, 270,
+	279, 0, 435, 398, 210, 370, 260, 207, 446, 447,
+	199, 227, 214, 234, 248, 250, 283, 312, 318, 347,
+	350, 265, 245, 225, 367, 223, 384, 404, 405, 406,
+	408, 316, 241, 334, 0, 0, 0, 0, 0, 0,
+	0, 0, 244, 806, 0, 0, 0, 0, 292, 0,
+	0, 0, 348, 0, 385, 230, 301, 299, 413, 254,
+	247, 243, 229, 276, 307, 346, 403, 340, 0, 296,
+	0, 0, 394, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	228, 197, 331, 395, 258, 0, 0, 0, 179, 180,
+	181, 0, 0, 0, 0, 0, 0, 0, 0, 220,
+	0, 226, 0, 0, 0, 0, 240, 280, 246, 239,
+	410, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 266, 0, 320, 0, 0, 805, 441,
+	0, 0, 0, 0, 0, 0, 802, 803, 291, 770,
+	288, 193, 208, 796, 800, 330, 369, 375, 0, 0,
+	0, 231, 0, 373, 344, 427, 216, 256, 366, 349,
+	371, 0, 0, 372, 297, 415, 361, 425, 442, 443,
+	238, 324, 433, 407, 439, 453, 209, 235, 338, 400,
+	430, 391, 317, 411, 412, 287, 390, 264, 196, 295,
+	200, 402, 423, 221, 383, 0, 0, 0, 202, 421,
+	399, 314, 284, 285, 201, 0, 365, 242, 262, 233,
+	333, 418, 419, 232, 455, 211, 438, 204, 212, 437,
+	326, 414, 422, 315, 306, 203, 420, 313, 305, 290,
+	252, 272, 359, 300, 360, 273, 322, 321, 323, 0,
+	198, 0, 396, 431, 456, 218, 0, 0, 409, 449,
+	452, 0, 362, 219, 263, 251, 358, 261, 293, 448,
+	450, 451, 217, 356, 269, 337, 426, 255, 434, 325,
+	213, 275, 392, 289, 298, 0, 0, 343, 374, 222,
+	429, 393, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 192, 205, 294, 0, 363, 259, 454, 436,
+	432, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 194, 195, 206, 215,
+	224, 236, 249, 257, 267, 271, 274, 277, 278, 281,
+	286, 303, 308, 309, 310, 311, 327, 328, 329, 332,
+	335, 336, 339, 341, 342, 345, 351, 352, 353, 354,
+	355, 357, 364, 368, 376, 377, 378, 379, 380, 381,
+	382, 386, 387, 388, 389, 397, 401, 416, 417, 428,
+	440, 444, 268, 424, 445, 0, 302, 0, 0, 304,
+	253, 270, 279, 0, 435, 398, 210, 370, 260, 207,
+	446, 447, 199, 227, 214, 234, 248, 250, 283, 312,
+	318, 347, 350, 265, 245, 225, 367, 223, 384, 404,
+	405, 406, 408, 316, 241, 334, 0, 0, 0, 1079,
+	0, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	292, 0, 0, 0, 348, 0, 385, 230, 301, 299,
+	413, 254, 247, 243, 229, 276, 307, 346, 403, 340,
+	0, 296, 0, 0, 394, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 228, 197, 331, 395, 258, 0, 0, 0,
+	179, 180, 181, 0, 1081, 0, 0, 0, 0, 0,
+	0, 220, 0, 226, 0, 0, 0, 0, 240, 280,
+	246, 239, 410, 966, 967, 965, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 968, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 266, 0, 320, 0, 0,
+	0, 441, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 0, 288, 193, 208, 0, 0, 330, 369, 375,
+	0, 0, 0, 231, 0, 373, 344, 427, 216, 256,
+	366, 349, 371, 0, 0, 372, 297, 415, 361, 425,
+	442, 443, 238, 324, 433, 407, 439, 453, 209, 235,
+	338, 400, 430, 391, 317, 411, 412, 287, 390, 264,
+	196, 295, 200, 402, 423, 221, 383, 0, 0, 0,
+	202, 421, 399, 314, 284, 285, 201, 0, 365, 242,
+	262, 233, 333, 418, 419, 232, 455, 211, 438, 204,
+	212, 437, 326, 414, 422, 315, 306, 203, 420, 313,
+	305, 290, 252, 272, 359, 300, 360, 273, 322, 321,
+	323, 0, 198, 0, 396, 431, 456, 218, 0, 0,
+	409, 449, 452, 0, 362, 219, 263, 251, 358, 261,
+	293, 448, 450, 451, 217, 356, 269, 337, 426, 255,
+	434, 325, 213, 275, 392, 289, 298, 0, 0, 343,
+	374, 222, 429, 393, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 192, 205, 294, 0, 363, 259,
+	454, 436, 432, 0, 0, 237, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 194, 195,
+	206, 215, 224, 236, 249, 257, 267, 271, 274, 277,
+	278, 281, 286, 303, 308, 309, 310, 311, 327, 328,
+	329, 332, 335, 336, 339, 341, 342, 345, 351, 352,
+	353, 354, 355, 357, 364, 368, 376, 377, 378, 379,
+	380, 381, 382, 386, 387, 388, 389, 397, 401, 416,
+	417, 428, 440, 444, 268, 424, 445, 0, 302, 0,
+	0, 304, 253, 270, 279, 0, 435, 398, 210, 370,
+	260, 207, 446, 447, 199, 227, 214, 234, 248, 250,
+	283, 312, 318, 347, 350, 265, 245, 225, 367, 223,
+	384, 404, 405, 406, 408, 316, 241, 35, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	334, 0, 0, 0, 0, 0, 0, 0, 0, 244,
+	0, 0, 0, 0, 0, 292, 0, 0, 0, 348,
+	0, 385, 230, 301, 299, 413, 254, 247, 243, 229,
+	276, 307, 346, 403, 340, 0, 296, 0, 0, 394,
+	319, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 228, 197, 331,
+	395, 258, 71, 0, 593, 179, 180, 181, 0, 0,
+	0, 0, 0, 0, 0, 0, 220, 0, 226, 0,
+	0, 0, 0, 240, 280, 246, 239, 410, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 0, 320, 0, 0, 0, 441, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 288, 193, 208,
+	0, 0, 330, 369, 375, 0, 0, 0, 231, 0,
+	373, 344, 427, 216, 256, 366, 349, 371, 0, 0,
+	372, 297, 415, 361, 425, 442, 443, 238, 324, 433,
+	407, 439, 453, 209, 235, 338, 400, 430, 391, 317,
+	411, 412, 287, 390, 264, 196, 295, 200, 402, 423,
+	221, 383, 0, 0, 0, 202, 421, 399, 314, 284,
+	285, 201, 0, 365, 242, 262, 233, 333, 418, 419,
+	232, 455, 211, 438, 204, 212, 437, 326, 414, 422,
+	315, 306, 203, 420, 313, 305, 290, 252, 272, 359,
+	300, 360, 273, 322, 321, 323, 0, 198, 0, 396,
+	431, 456, 218, 0, 0, 409, 449, 452, 0, 362,
+	219, 263, 251, 358, 261, 293, 448, 450, 451, 217,
+	356, 269, 337, 426, 255, 434, 325, 213, 275, 392,
+	289, 298, 0, 0, 343, 374, 222, 429, 393, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	0, 0, 301, 250, 267, 276, 0, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 331, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 0, 0, 0, 0, 0,
-	289, 0, 0, 0, 345, 0, 382, 227, 298, 296,
-	410, 251, 244, 240, 226, 273, 304, 343, 400, 337,
-	0, 293, 0, 0, 391, 316, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 225, 195, 328, 392, 255, 0, 0, 0,
-	177, 178, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 217, 0, 223, 0, 0, 0, 0, 237, 277,
-	243, 236, 407, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 263, 0, 317, 0, 0,
-	0, 438, 0, 0, 0, 0, 0, 0, 0, 0,
-	288, 0, 285, 191, 205, 0, 0, 327, 366, 372,
-	0, 0, 0, 228, 0, 370, 341, 424, 213, 253,
-	363, 346, 368, 0, 0, 369, 294, 412, 358, 422,
-	439, 440, 235, 321, 430, 404, 436, 448, 206, 232,
-	335, 397, 427, 388, 314, 408, 409, 284, 387, 261,
-	194, 292, 198, 399, 420, 218, 380, 0, 0, 0,
-	200, 418, 396, 311, 281, 282, 199, 0, 362, 239,
-	259, 230, 330, 415, 416, 229, 450, 208, 435, 202,
-	209, 434, 323, 411, 419, 312, 303, 201, 417, 310,
-	302, 287, 249, 269, 356, 297, 357, 270, 319, 318,
-	320, 0, 196, 0, 393, 428, 451, 215, 0, 0,
-	406, 444, 447, 0, 359, 216, 260, 248, 355, 258,
-	290, 443, 445, 446, 214, 353, 266, 334, 423, 252,
-	431, 322, 210, 272, 389, 286, 295, 0, 0, 340,
-	371, 219, 426, 390, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 190, 203, 291, 1344, 360, 256,
-	449, 433, 429, 0, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 192, 193,
-	204, 212, 221, 233, 246, 254, 264, 268, 271, 274,
-	275, 278, 283, 300, 305, 306, 307, 308, 324, 325,
-	326, 329, 332, 333, 336, 338, 339, 342, 348, 349,
-	350, 351, 352, 354, 361, 365, 373, 374, 375, 376,
-	377, 378, 379, 383, 384, 385, 386, 394, 398, 413,
-	414, 425, 437, 441, 265, 421, 442, 0, 299, 0,
-	0, 301, 250, 267, 276, 0, 432, 395, 207, 367,
-	257, 197, 224, 211, 231, 245, 247, 280, 309, 315,
-	344, 347, 262, 242, 222, 364, 220, 381, 401, 402,
-	403, 405, 313, 238, 331, 0, 1227, 0, 0, 0,
-	0, 0, 0, 241, 0, 0, 0, 0, 0, 289,
-	0, 0, 0, 345, 0, 382, 227, 298, 296, 410,
-	251, 244, 240, 226, 273, 304, 343, 400, 337, 0,
-	293, 0, 0, 391, 316, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 225, 195, 328, 392, 255, 0, 0, 0, 177,
-	178, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	217, 0, 223, 0, 0, 0, 0, 237, 277, 243,
-	236, 407, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 263, 0, 317, 0, 0, 0,
-	438, 0, 0, 0, 0, 0, 0, 0, 0, 288,
-	0, 285, 191, 205, 0, 0, 327, 366, 372, 0,
-	0, 0, 228, 0, 370, 341, 424, 213, 253, 363,
-	346, 368, 0, 0, 369, 294, 412, 358, 422, 439,
-	440, 235, 321, 430, 404, 436, 448, 206, 232, 335,
-	397, 427, 388, 314, 408, 409, 284, 387, 261, 194,
-	292, 198, 399, 420, 218, 380, 0, 0, 0, 200,
-	418, 396, 311, 281, 282, 199, 0, 362, 239, 259,
-	230, 330, 415, 416, 229, 450, 208, 435, 202, 209,
-	434, 323, 411, 419, 312, 303, 201, 417, 310, 302,
-	287, 249, 269, 356, 297, 357, 270, 319, 318, 320,
-	0, 196, 0, 393, 428, 451, 215, 0, 0, 406,
-	444, 447, 0, 359, 216, 260, 248, 355, 258, 290,
-	443, 445, 446, 214, 353, 266, 334, 423, 252, 431,
-	322, 210, 272, 389, 286, 295, 0, 0, 340, 371,
-	219, 426, 390, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 190, 203, 291, 0, 360, 256, 449,
-	433, 429, 0, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 192, 193, 204,
-	212, 221, 233, 246, 254, 264, 268, 271, 274, 275,
-	278, 283, 300, 305, 306, 307, 308, 324, 325, 326,
-	329, 332, 333, 336, 338, 339, 342, 348, 349, 350,
-	351, 352, 354, 361, 365, 373, 374, 375, 376, 377,
-	378, 379, 383, 384, 385, 386, 394, 398, 413, 414,
-	425, 437, 441, 265, 421, 442, 0, 299, 0, 0,
-	301, 250, 267, 276, 0, 432, 395, 207, 367, 257,
-	197, 224, 211, 231, 245, 247, 280, 309, 315, 344,
-	347, 262, 242, 222, 364, 220, 381, 401, 402, 403,
-	405, 313, 238, 331, 0, 1225, 0, 0, 0, 0,
-	0, 0, 241, 0, 0, 0, 0, 0, 289, 0,
-	0, 0, 345, 0, 382, 227, 298, 296, 410, 251,
-	244, 240, 226, 273, 304, 343, 400, 337, 0, 293,
-	0, 0, 391, 316, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	225, 195, 328, 392, 255, 0, 0, 0, 177, 178,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 217,
-	0, 223, 0, 0, 0, 0, 237, 277, 243, 236,
-	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 263, 0, 317, 0, 0, 0, 438,
-	0, 0, 0, 0, 0, 0, 0, 0, 288, 0,
-	285, 191, 205, 0, 0, 327, 366, 372, 0, 0,
-	0, 228, 0, 370, 341, 424, 213, 253, 363, 346,
-	368, 0, 0, 369, 294, 412, 358, 422, 439, 440,
-	235, 321, 430, 404, 436, 448, 206, 232, 335, 397,
-	427, 388, 314, 408, 409, 284, 387, 261, 194, 292,
-	198, 399, 420, 218, 380, 0, 0, 0, 200, 418,
-	396, 311, 281, 282, 199, 0, 362, 239, 259, 230,
-	330, 415, 416, 229, 450, 208, 435, 202, 209, 434,
-	323, 411, 419, 312, 303, 201, 417, 310, 302, 287,
-	249, 269, 356, 297, 357, 270, 319, 318, 320, 0,
-	196, 0, 393, 428, 451, 215, 0, 0, 406, 444,
-	447, 0, 359, 216, 260, 248, 355, 258, 290, 443,
-	445, 446, 214, 353, 266, 334, 423, 252, 431, 322,
-	210, 272, 389, 286, 295, 0, 0, 340, 371, 219,
-	426, 390, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 190, 203, 291, 0, 360, 256, 449, 433,
-	429, 0, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 192, 193, 204, 212,
-	221, 233, 246, 254, 264, 268, 271, 274, 275, 278,
-	283, 300, 305, 306, 307, 308, 324, 325, 326, 329,
-	332, 333, 336, 338, 339, 342, 348, 349, 350, 351,
-	352, 354, 361, 365, 373, 374, 375, 376, 377, 378,
-	379, 383, 384, 385, 386, 394, 398, 413, 414, 425,
-	437, 441, 265, 421, 442, 0, 299, 0, 0, 301,
-	250, 267, 276, 0, 432, 395, 207, 367, 257, 197,
-	224, 211, 231, 245, 247, 280, 309, 315, 344, 347,
-	262, 242, 222, 364, 220, 381, 401, 402, 403, 405,
-	313, 238, 331, 0, 1223, 0, 0, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 0, 438, 0,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	191, 205, 0, 0, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 0, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 1221, 0, 0, 0, 0, 0, 0,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 1219, 0, 0, 0, 0, 0, 0, 241,
-	0, 0, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 0, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 225, 195, 328,
-	392, 255, 0, 0, 0, 177, 178, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 0,
-	0, 0, 0, 237, 277, 243, 236, 407, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 0, 0, 0, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 331,
-	0, 1215, 0, 0, 0, 0, 0, 0, 241, 0,
-	0, 0, 0, 0, 289, 0, 0, 0, 345, 0,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 0, 293, 0, 0, 391, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 225, 195, 328, 392,
-	255, 0, 0, 0, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 223, 0, 0,
-	0, 0, 237, 277, 243, 236, 407, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
-	0, 317, 0, 0, 0, 438, 0, 0, 0, 0,
-	0, 0, 0, 0, 288, 0, 285, 191, 205, 0,
-	0, 327, 366, 372, 0, 0, 0, 228, 0, 370,
-	341, 424, 213, 253, 363, 346, 368, 0, 0, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 420, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 209, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 0, 0, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 322, 210, 272, 389, 286,
-	295, 0, 0, 340, 371, 219, 426, 390, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 190, 203,
-	291, 0, 360, 256, 449, 433, 429, 0, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 265, 421,
-	442, 0, 299, 0, 0, 301, 250, 267, 276, 0,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 331, 0,
-	1213, 0, 0, 0, 0, 0, 0, 241, 0, 0,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 0, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	0, 0, 0, 177, 178, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 223, 0, 0, 0,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 0, 0, 0, 438, 0, 0, 0, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 1211,
-	0, 0, 0, 0, 0, 0, 241, 0, 0, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 0, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 0,
-	0, 0, 177, 178, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 223, 0, 0, 0, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	0, 0, 0, 438, 0, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238, 331, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
-	0, 289, 0, 0, 0, 345, 0, 382, 227, 298,
-	296, 410, 251, 244, 240, 226, 273, 304, 343, 400,
-	337, 0, 293, 0, 0, 391, 316, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 225, 195, 328, 392, 255, 1186, 0,
-	0, 177, 178, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 217, 0, 223, 0, 0, 0, 0, 237,
-	277, 243, 236, 407, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 263, 0, 317, 0,
-	0, 0, 438, 0, 0, 0, 0, 0, 0, 0,
-	0, 288, 0, 285, 191, 205, 0, 0, 327, 366,
-	372, 0, 0, 0, 228, 0, 370, 341, 424, 213,
-	253, 363, 346, 368, 0, 0, 369, 294, 412, 358,
-	422, 439, 440, 235, 321, 430, 404, 436, 448, 206,
-	232, 335, 397, 427, 388, 314, 408, 409, 284, 387,
-	261, 194, 292, 198, 399, 420, 218, 380, 0, 0,
-	0, 200, 418, 396, 311, 281, 282, 199, 0, 362,
-	239, 259, 230, 330, 415, 416, 229, 450, 208, 435,
-	202, 209, 434, 323, 411, 419, 312, 303, 201, 417,
-	310, 302, 287, 249, 269, 356, 297, 357, 270, 319,
-	318, 320, 0, 196, 0, 393, 428, 451, 215, 0,
-	0, 406, 444, 447, 0, 359, 216, 260, 248, 355,
-	258, 290, 443, 445, 446, 214, 353, 266, 334, 423,
-	252, 431, 322, 210, 272, 389, 286, 295, 0, 0,
-	340, 371, 219, 426, 390, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 190, 203, 291, 0, 360,
-	256, 449, 433, 429, 0, 0, 234, 0, 0, 0,
+	205, 294, 0, 363, 259, 454, 436, 432, 0, 0,
+	237, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 194, 195, 206, 215, 224, 236, 249,
+	257, 267, 271, 274, 277, 278, 281, 286, 303, 308,
+	309, 310, 311, 327, 328, 329, 332, 335, 336, 339,
+	341, 342, 345, 351, 352, 353, 354, 355, 357, 364,
+	368, 376, 377, 378, 379, 380, 381, 382, 386, 387,
+	388, 389, 397, 401, 416, 417, 428, 440, 444, 268,
+	424, 445, 0, 302, 0, 0, 304, 253, 270, 279,
+	0, 435, 398, 210, 370, 260, 207, 446, 447, 199,
+	227, 214, 234, 248, 250, 283, 312, 318, 347, 350,
+	265, 245, 225, 367, 223, 384, 404, 405, 406, 408,
+	316, 241, 334, 0, 0, 0, 1448, 0, 0, 0,
+	0, 244, 0, 0, 0, 0, 0, 292, 0, 0,
+	0, 348, 0, 385, 230, 301, 299, 413, 254, 247,
+	243, 229, 276, 307, 346, 403, 340, 0, 296, 0,
+	0, 394, 319, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 228,
+	197, 331, 395, 258, 0, 0, 0, 179, 180, 181,
+	0, 1450, 0, 0, 0, 0, 0, 0, 220, 0,
+	226, 0, 0, 0, 0, 240, 280, 246, 239, 410,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 266, 0, 320, 0, 0, 0, 441, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 288,
+	193, 208, 0, 0, 330, 369, 375, 0, 0, 0,
+	231, 0, 373, 344, 427, 216, 256, 366, 349, 371,
+	0, 1446, 372, 297, 415, 361, 425, 442, 443, 238,
+	324, 433, 407, 439, 453, 209, 235, 338, 400, 430,
+	391, 317, 411, 412, 287, 390, 264, 196, 295, 200,
+	402, 423, 221, 383, 0, 0, 0, 202, 421, 399,
+	314, 284, 285, 201, 0, 365, 242, 262, 233, 333,
+	418, 419, 232, 455, 211, 438, 204, 212, 437, 326,
+	414, 422, 315, 306, 203, 420, 313, 305, 290, 252,
+	272, 359, 300, 360, 273, 322, 321, 323, 0, 198,
+	0, 396, 431, 456, 218, 0, 0, 409, 449, 452,
+	0, 362, 219, 263, 251, 358, 261, 293, 448, 450,
+	451, 217, 356, 269, 337, 426, 255, 434, 325, 213,
+	275, 392, 289, 298, 0, 0, 343, 374, 222, 429,
+	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 192, 205, 294, 0, 363, 259, 454, 436, 432,
+	0, 0, 237, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 194, 195, 206, 215, 224,
+	236, 249, 257, 267, 271, 274, 277, 278, 281, 286,
+	303, 308, 309, 310, 311, 327, 328, 329, 332, 335,
+	336, 339, 341, 342, 345, 351, 352, 353, 354, 355,
+	357, 364, 368, 376, 377, 378, 379, 380, 381, 382,
+	386, 387, 388, 389, 397, 401, 416, 417, 428, 440,
+	444, 268, 424, 445, 0, 302, 0, 0, 304, 253,
+	270, 279, 0, 435, 398, 210, 370, 260, 207, 446,
+	447, 199, 227, 214, 234, 248, 250, 283, 312, 318,
+	347, 350, 265, 245, 225, 367, 223, 384, 404, 405,
+	406, 408, 316, 241, 334, 0, 0, 0, 0, 0,
+	0, 0, 0, 244, 0, 0, 0, 0, 0, 292,
+	0, 0, 0, 348, 0, 385, 230, 301, 299, 413,
+	254, 247, 243, 229, 276, 307, 346, 403, 340, 0,
+	296, 0, 0, 394, 319, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 228, 197, 331, 395, 258, 0, 0, 0, 179,
+	180, 181, 0, 0, 0, 0, 0, 0, 0, 0,
+	220, 0, 226, 0, 0, 0, 0, 240, 280, 246,
+	239, 410, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 764, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 266, 0, 320, 0, 0, 0,
+	441, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	770, 288, 193, 208, 768, 0, 330, 369, 375, 0,
+	0, 0, 231, 0, 373, 344, 427, 216, 256, 366,
+	349, 371, 0, 0, 372, 297, 415, 361, 425, 442,
+	443, 238, 324, 433, 407, 439, 453, 209, 235, 338,
+	400, 430, 391, 317, 411, 412, 287, 390, 264, 196,
+	295, 200, 402, 423, 221, 383, 0, 0, 0, 202,
+	421, 399, 314, 284, 285, 201, 0, 365, 242, 262,
+	233, 333, 418, 419, 232, 455, 211, 438, 204, 212,
+	437, 326, 414, 422, 315, 306, 203, 420, 313, 305,
+	290, 252, 272, 359, 300, 360, 273, 322, 321, 323,
+	0, 198, 0, 396, 431, 456, 218, 0, 0, 409,
+	449, 452, 0, 362, 219, 263, 251, 358, 261, 293,
+	448, 450, 451, 217, 356, 269, 337, 426, 255, 434,
+	325, 213, 275, 392, 289, 298, 0, 0, 343, 374,
+	222, 429, 393, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 192, 205, 294, 0, 363, 259, 454,
+	436, 432, 0, 0, 237, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 194, 195, 206,
+	215, 224, 236, 249, 257, 267, 271, 274, 277, 278,
+	281, 286, 303, 308, 309, 310, 311, 327, 328, 329,
+	332, 335, 336, 339, 341, 342, 345, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	381, 382, 386, 387, 388, 389, 397, 401, 416, 417,
+	428, 440, 444, 268, 424, 445, 0, 302, 0, 0,
+	304, 253, 270, 279, 0, 435, 398, 210, 370, 260,
+	207, 446, 447, 199, 227, 214, 234, 248, 250, 283,
+	312, 318, 347, 350, 265, 245, 225, 367, 223, 384,
+	404, 405, 406, 408, 316, 241, 334, 0, 0, 0,
+	1448, 0, 0, 0, 0, 244, 0, 0, 0, 0,
+	0, 292, 0, 0, 0, 348, 0, 385, 230, 301,
+	299, 413, 254, 247, 243, 229, 276, 307, 346, 403,
+	340, 0, 296, 0, 0, 394, 319, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 228, 197, 331, 395, 258, 0, 0,
+	0, 179, 180, 181, 0, 1450, 0, 0, 0, 0,
+	0, 0, 220, 0, 226, 0, 0, 0, 0, 240,
+	280, 246, 239, 410, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 266, 0, 320, 0,
+	0, 0, 441, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 288, 193, 208, 0, 0, 330, 369,
+	375, 0, 0, 0, 231, 0, 373, 344, 427, 216,
+	256, 366, 349, 371, 0, 0, 372, 297, 415, 361,
+	425, 442, 443, 238, 324, 433, 407, 439, 453, 209,
+	235, 338, 400, 430, 391, 317, 411, 412, 287, 390,
+	264, 196, 295, 200, 402, 423, 221, 383, 0, 0,
+	0, 202, 421, 399, 314, 284, 285, 201, 0, 365,
+	242, 262, 233, 333, 418, 419, 232, 455, 211, 438,
+	204, 212, 437, 326, 414, 422, 315, 306, 203, 420,
+	313, 305, 290, 252, 272, 359, 300, 360, 273, 322,
+	321, 323, 0, 198, 0, 396, 431, 456, 218, 0,
+	0, 409, 449, 452, 0, 362, 219, 263, 251, 358,
+	261, 293, 448, 450, 451, 217, 356, 269, 337, 426,
+	255, 434, 325, 213, 275, 392, 289, 298, 0, 0,
+	343, 374, 222, 429, 393, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 192, 205, 294, 0, 363,
+	259, 454, 436, 432, 0, 0, 237, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 194,
+	195, 206, 215, 224, 236, 249, 257, 267, 271, 274,
+	277, 278, 281, 286, 303, 308, 309, 310, 311, 327,
+	328, 329, 332, 335, 336, 339, 341, 342, 345, 351,
+	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
+	379, 380, 381, 382, 386, 387, 388, 389, 397, 401,
+	416, 417, 428, 440, 444, 268, 424, 445, 0, 302,
+	0, 0, 304, 253, 270, 279, 0, 435, 398, 210,
+	370, 260, 207, 446, 447, 199, 227, 214, 234, 248,
+	250, 283, 312, 318, 347, 350, 265, 245, 225, 367,
+	223, 384, 404, 405, 406, 408, 316, 241, 35, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 334, 0, 0, 0, 0, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 292, 0, 0, 0,
+	348, 0, 385, 230, 301, 299, 413, 254, 247, 243,
+	229, 276, 307, 346, 403, 340, 0, 296, 0, 0,
+	394, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 228, 197,
+	331, 395, 258, 71, 0, 0, 179, 180, 181, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 0, 226,
+	0, 0, 0, 0, 240, 280, 246, 239, 410, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 0, 320, 0, 0, 0, 441, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 288, 193,
+	208, 0, 0, 330, 369, 375, 0, 0, 0, 231,
+	0, 373, 344, 427, 216, 256, 366, 349, 371, 0,
+	0, 372, 297, 415, 361, 425, 442, 443, 238, 324,
+	433, 407, 439, 453, 209, 235, 338, 400, 430, 391,
+	317, 411, 412, 287, 390, 264, 196, 295, 200, 402,
+	423, 221, 383, 0, 0, 0, 202, 421, 399, 314,
+	284, 285, 201, 0, 365, 242, 262, 233, 333, 418,
+	419, 232, 455, 211, 438, 204, 212, 437, 326, 414,
+	422, 315, 306, 203, 420, 313, 305, 290, 252, 272,
+	359, 300, 360, 273, 322, 321, 323, 0, 198, 0,
+	396, 431, 456, 218, 0, 0, 409, 449, 452, 0,
+	362, 219, 263, 251, 358, 261, 293, 448, 450, 451,
+	217, 356, 269, 337, 426, 255, 434, 325, 213, 275,
+	392, 289, 298, 0, 0, 343, 374, 222, 429, 393,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	192, 205, 294, 0, 363, 259, 454, 436, 432, 0,
+	0, 237, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 194, 195, 206, 215, 224, 236,
+	249, 257, 267, 271, 274, 277, 278, 281, 286, 303,
+	308, 309, 310, 311, 327, 328, 329, 332, 335, 336,
+	339, 341, 342, 345, 351, 352, 353, 354, 355, 357,
+	364, 368, 376, 377, 378, 379, 380, 381, 382, 386,
+	387, 388, 389, 397, 401, 416, 417, 428, 440, 444,
+	268, 424, 445, 0, 302, 0, 0, 304, 253, 270,
+	279, 0, 435, 398, 210, 370, 260, 207, 446, 447,
+	199, 227, 214, 234, 248, 250, 283, 312, 318, 347,
+	350, 265, 245, 225, 367, 223, 384, 404, 405, 406,
+	408, 316, 241, 334, 0, 0, 0, 0, 0, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 292, 0,
+	0, 0, 348, 0, 385, 230, 301, 299, 413, 254,
+	247, 243, 229, 276, 307, 346, 403, 340, 0, 296,
+	0, 0, 394, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	228, 197, 331, 395, 258, 0, 0, 0, 179, 180,
+	181, 0, 0, 1468, 0, 0, 1469, 0, 0, 220,
+	0, 226, 0, 0, 0, 0, 240, 280, 246, 239,
+	410, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 266, 0, 320, 0, 0, 0, 441,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
+	288, 193, 208, 0, 0, 330, 369, 375, 0, 0,
+	0, 231, 0, 373, 344, 427, 216, 256, 366, 349,
+	371, 0, 0, 372, 297, 415, 361, 425, 442, 443,
+	238, 324, 433, 407, 439, 453, 209, 235, 338, 400,
+	430, 391, 317, 411, 412, 287, 390, 264, 196, 295,
+	200, 402, 423, 221, 383, 0, 0, 0, 202, 421,
+	399, 314, 284, 285, 201, 0, 365, 242, 262, 233,
+	333, 418, 419, 232, 455, 211, 438, 204, 212, 437,
+	326, 414, 422, 315, 306, 203, 420, 313, 305, 290,
+	252, 272, 359, 300, 360, 273, 322, 321, 323, 0,
+	198, 0, 396, 431, 456, 218, 0, 0, 409, 449,
+	452, 0, 362, 219, 263, 251, 358, 261, 293, 448,
+	450, 451, 217, 356, 269, 337, 426, 255, 434, 325,
+	213, 275, 392, 289, 298, 0, 0, 343, 374, 222,
+	429, 393, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 192, 205, 294, 0, 363, 259, 454, 436,
+	432, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 194, 195, 206, 215,
+	224, 236, 249, 257, 267, 271, 274, 277, 278, 281,
+	286, 303, 308, 309, 310, 311, 327, 328, 329, 332,
+	335, 336, 339, 341, 342, 345, 351, 352, 353, 354,
+	355, 357, 364, 368, 376, 377, 378, 379, 380, 381,
+	382, 386, 387, 388, 389, 397, 401, 416, 417, 428,
+	440, 444, 268, 424, 445, 0, 302, 0, 0, 304,
+	253, 270, 279, 0, 435, 398, 210, 370, 260, 207,
+	446, 447, 199, 227, 214, 234, 248, 250, 283, 312,
+	318, 347, 350, 265, 245, 225, 367, 223, 384, 404,
+	405, 406, 408, 316, 241, 334, 0, 0, 0, 0,
+	0, 0, 0, 0, 244, 0, 1112, 0, 0, 0,
+	292, 0, 0, 0, 348, 0, 385, 230, 301, 299,
+	413, 254, 247, 243, 229, 276, 307, 346, 403, 340,
+	0, 296, 0, 0, 394, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 228, 197, 331, 395, 258, 0, 0, 0,
+	179, 180, 181, 0, 1111, 0, 0, 0, 0, 0,
+	0, 220, 0, 226, 0, 0, 0, 0, 240, 280,
+	246, 239, 410, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 266, 0, 320, 0, 0,
+	0, 441, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 0, 288, 193, 208, 0, 0, 330, 369, 375,
+	0, 0, 0, 231, 0, 373, 344, 427, 216, 256,
+	366, 349, 371, 0, 0, 372, 297, 415, 361, 425,
+	442, 443, 238, 324, 433, 407, 439, 453, 209, 235,
+	338, 400, 430, 391, 317, 411, 412, 287, 390, 264,
+	196, 295, 200, 402, 423, 221, 383, 0, 0, 0,
+	202, 421, 399, 314, 284, 285, 201, 0, 365, 242,
+	262, 233, 333, 418, 419, 232, 455, 211, 438, 204,
+	212, 437, 326, 414, 422, 315, 306, 203, 420, 313,
+	305, 290, 252, 272, 359, 300, 360, 273, 322, 321,
+	323, 0, 198, 0, 396, 431, 456, 218, 0, 0,
+	409, 449, 452, 0, 362, 219, 263, 251, 358, 261,
+	293, 448, 450, 451, 217, 356, 269, 337, 426, 255,
+	434, 325, 213, 275, 392, 289, 298, 0, 0, 343,
+	374, 222, 429, 393, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 192, 205, 294, 0, 363, 259,
+	454, 436, 432, 0, 0, 237, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 194, 195,
+	206, 215, 224, 236, 249, 257, 267, 271, 274, 277,
+	278, 281, 286, 303, 308, 309, 310, 311, 327, 328,
+	329, 332, 335, 336, 339, 341, 342, 345, 351, 352,
+	353, 354, 355, 357, 364, 368, 376, 377, 378, 379,
+	380, 381, 382, 386, 387, 388, 389, 397, 401, 416,
+	417, 428, 440, 444, 268, 424, 445, 0, 302, 0,
+	0, 304, 253, 270, 279, 0, 435, 398, 210, 370,
+	260, 207, 446, 447, 199, 227, 214, 234, 248, 250,
+	283, 312, 318, 347, 350, 265, 245, 225, 367, 223,
+	384, 404, 405, 406, 408, 316, 241, 334, 0, 0,
+	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
+	0, 0, 292, 0, 0, 0, 348, 0, 385, 230,
+	301, 299, 413, 254, 247, 243, 229, 276, 307, 346,
+	403, 340, 0, 296, 0, 0, 394, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 228, 197, 331, 395, 258, 0,
+	0, 593, 179, 180, 181, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 0, 226, 0, 0, 0, 0,
+	240, 280, 246, 239, 410, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 266, 0, 320,
+	0, 0, 0, 441, 0, 0, 0, 0, 0, 0,
+	0, 0, 291, 0, 288, 193, 208, 0, 0, 330,
+	369, 375, 0, 0, 0, 231, 0, 373, 344, 427,
+	216, 256, 366, 349, 371, 0, 0, 372, 297, 415,
+	361, 425, 442, 443, 238, 324, 433, 407, 439, 453,
+	209, 235, 338, 400, 430, 391, 317, 411, 412, 287,
+	390, 264, 196, 295, 200, 402, 423, 221, 383, 0,
+	0, 0, 202, 421, 399, 314, 284, 285, 201, 0,
+	365, 242, 262, 233, 333, 418, 419, 232, 455, 211,
+	438, 204, 212, 437, 326, 414, 422, 315, 306, 203,
+	420, 313, 305, 290, 252, 272, 359, 300, 360, 273,
+	322, 321, 323, 0, 198, 0, 396, 431, 456, 218,
+	0, 0, 409, 449, 452, 0, 362, 219, 263, 251,
+	358, 261, 293, 448, 450, 451, 217, 356, 269, 337,
+	426, 255, 434, 325, 213, 275, 392, 289, 298, 0,
+	0, 343, 374, 222, 429, 393, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 192, 205, 294, 0,
+	363, 259, 454, 436, 432, 0, 0, 237, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	194, 195, 206, 215, 224, 236, 249, 257, 267, 271,
+	274, 277, 278, 281, 286, 303, 308, 309, 310, 311,
+	327, 328, 329, 332, 335, 336, 339, 341, 342, 345,
+	351, 352, 353, 354, 355, 357, 364, 368, 376, 377,
+	378, 379, 380, 381, 382, 386, 387, 388, 389, 397,
+	401, 416, 417, 428, 440, 444, 268, 424, 445, 0,
+	302, 0, 0, 304, 253, 270, 279, 0, 435, 398,
+	210, 370, 260, 207, 446, 447, 199, 227, 214, 234,
+	248, 250, 283, 312, 318, 347, 350, 265, 245, 225,
+	367, 223, 384, 404, 405, 406, 408, 316, 241, 334,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 292, 0, 0, 0, 348, 0,
+	385, 230, 301, 299, 413, 254, 247, 243, 229, 276,
+	307, 346, 403, 340, 0, 296, 0, 0, 394, 319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 228, 197, 331, 395,
+	258, 71, 0, 0, 179, 180, 181, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 0, 226, 0, 0,
+	0, 0, 240, 280, 246, 239, 410, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	0, 320, 0, 0, 0, 441, 0, 0, 0, 0,
+	0, 0, 0, 0, 291, 0, 288, 193, 208, 0,
+	0, 330, 369, 375, 0, 0, 0, 231, 0, 373,
+	344, 427, 216, 256, 366, 349, 371, 0, 0, 372,
+	297, 415, 361, 425, 442, 443, 238, 324, 433, 407,
+	439, 453, 209, 235, 338, 400, 430, 391, 317, 411,
+	412, 287, 390, 264, 196, 295, 200, 402, 423, 221,
+	383, 0, 0, 0, 202, 421, 399, 314, 284, 285,
+	201, 0, 365, 242, 262, 233, 333, 418, 419, 232,
+	455, 211, 438, 204, 212, 437, 326, 414, 422, 315,
+	306, 203, 420, 313, 305, 290, 252, 272, 359, 300,
+	360, 273, 322, 321, 323, 0, 198, 0, 396, 431,
+	456, 218, 0, 0, 409, 449, 452, 0, 362, 219,
+	263, 251, 358, 261, 293, 448, 450, 451, 217, 356,
+	269, 337, 426, 255, 434, 325, 213, 275, 392, 289,
+	298, 0, 0, 343, 374, 222, 429, 393, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 192, 205,
+	294, 0, 363, 259, 454, 436, 432, 0, 0, 237,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 194, 195, 206, 215, 224, 236, 249, 257,
+	267, 271, 274, 277, 278, 281, 286, 303, 308, 309,
+	310, 311, 327, 328, 329, 332, 335, 336, 339, 341,
+	342, 345, 351, 352, 353, 354, 355, 357, 364, 368,
+	376, 377, 378, 379, 380, 381, 382, 386, 387, 388,
+	389, 397, 401, 416, 417, 428, 440, 444, 268, 424,
+	445, 0, 302, 0, 0, 304, 253, 270, 279, 0,
+	435, 398, 210, 370, 260, 207, 446, 447, 199, 227,
+	214, 234, 248, 250, 283, 312, 318, 347, 350, 265,
+	245, 225, 367, 223, 384, 404, 405, 406, 408, 316,
+	241, 334, 0, 0, 0, 0, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 292, 0, 0, 0,
+	348, 0, 385, 230, 301, 299, 413, 254, 247, 243,
+	229, 276, 307, 346, 403, 340, 0, 296, 0, 0,
+	394, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 228, 197,
+	331, 395, 258, 0, 0, 0, 179, 180, 181, 0,
+	1450, 0, 0, 0, 0, 0, 0, 220, 0, 226,
+	0, 0, 0, 0, 240, 280, 246, 239, 410, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 0, 320, 0, 0, 0, 441, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 288, 193,
+	208, 0, 0, 330, 369, 375, 0, 0, 0, 231,
+	0, 373, 344, 427, 216, 256, 366, 349, 371, 0,
+	0, 372, 297, 415, 361, 425, 442, 443, 238, 324,
+	433, 407, 439, 453, 209, 235, 338, 400, 430, 391,
+	317, 411, 412, 287, 390, 264, 196, 295, 200, 402,
+	423, 221, 383, 0, 0, 0, 202, 421, 399, 314,
+	284, 285, 201, 0, 365, 242, 262, 233, 333, 418,
+	419, 232, 455, 211, 438, 204, 212, 437, 326, 414,
+	422, 315, 306, 203, 420, 313, 305, 290, 252, 272,
+	359, 300, 360, 273, 322, 321, 323, 0, 198, 0,
+	396, 431, 456, 218, 0, 0, 409, 449, 452, 0,
+	362, 219, 263, 251, 358, 261, 293, 448, 450, 451,
+	217, 356, 269, 337, 426, 255, 434, 325, 213, 275,
+	392, 289, 298, 0, 0, 343, 374, 222, 429, 393,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	192, 205, 294, 0, 363, 259, 454, 436, 432, 0,
+	0, 237, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 194, 195, 206, 215, 224, 236,
+	249, 257, 267, 271, 274, 277, 278, 281, 286, 303,
+	308, 309, 310, 311, 327, 328, 329, 332, 335, 336,
+	339, 341, 342, 345, 351, 352, 353, 354, 355, 357,
+	364, 368, 376, 377, 378, 379, 380, 381, 382, 386,
+	387, 388, 389, 397, 401, 416, 417, 428, 440, 444,
+	268, 424, 445, 0, 302, 0, 0, 304, 253, 270,
+	279, 0, 435, 398, 210, 370, 260, 207, 446, 447,
+	199, 227, 214, 234, 248, 250, 283, 312, 318, 347,
+	350, 265, 245, 225, 367, 223, 384, 404, 405, 406,
+	408, 316, 241, 334, 0, 0, 0, 0, 0, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 292, 0,
+	0, 0, 348, 0, 385, 230, 301, 299, 413, 254,
+	247, 243, 229, 276, 307, 346, 403, 340, 0, 296,
+	0, 0, 394, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	228, 197, 331, 395, 258, 0, 0, 0, 179, 180,
+	181, 0, 1081, 0, 0, 0, 0, 0, 0, 220,
+	0, 226, 0, 0, 0, 0, 240, 280, 246, 239,
+	410, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 266, 0, 320, 0, 0, 0, 441,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
+	288, 193, 208, 0, 0, 330, 369, 375, 0, 0,
+	0, 231, 0, 373, 344, 427, 216, 256, 366, 349,
+	371, 0, 0, 372, 297, 415, 361, 425, 442, 443,
+	238, 324, 433, 407, 439, 453, 209, 235, 338, 400,
+	430, 391, 317, 411, 412, 287, 390, 264, 196, 295,
+	200, 402, 423, 221, 383, 0, 0, 0, 202, 421,
+	399, 314, 284, 285, 201, 0, 365, 242, 262, 233,
+	333, 418, 419, 232, 455, 211, 438, 204, 212, 437,
+	326, 414, 422, 315, 306, 203, 420, 313, 305, 290,
+	252, 272, 359, 300, 360, 273, 322, 321, 323, 0,
+	198, 0, 396, 431, 456, 218, 0, 0, 409, 449,
+	452, 0, 362, 219, 263, 251, 358, 261, 293, 448,
+	450, 451, 217, 356, 269, 337, 426, 255, 434, 325,
+	213, 275, 392, 289, 298, 0, 0, 343, 374, 222,
+	429, 393, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 192, 205, 294, 0, 363, 259, 454, 436,
+	432, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 194, 195, 206, 215,
+	224, 236, 249, 257, 267, 271, 274, 277, 278, 281,
+	286, 303, 308, 309, 310, 311, 327, 328, 329, 332,
+	335, 336, 339, 341, 342, 345, 351, 352, 353, 354,
+	355, 357, 364, 368, 376, 377, 378, 379, 380, 381,
+	382, 386, 387, 388, 389, 397, 401, 416, 417, 428,
+	440, 444, 268, 424, 445, 0, 302, 0, 0, 304,
+	253, 270, 279, 0, 435, 398, 210, 370, 260, 207,
+	446, 447, 199, 227, 214, 234, 248, 250, 283, 312,
+	318, 347, 350, 265, 245, 225, 367, 223, 384, 404,
+	405, 406, 408, 316, 241, 334, 0, 0, 0, 0,
+	0, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	292, 0, 0, 0, 348, 0, 385, 230, 301, 299,
+	413, 254, 247, 243, 229, 276, 307, 346, 403, 340,
+	0, 296, 0, 0, 394, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 228, 197, 331, 395, 258, 0, 0, 0,
+	179, 180, 181, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 0, 226, 0, 0, 0, 0, 240, 280,
+	246, 239, 410, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 266, 0, 320, 0, 0,
+	0, 441, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 0, 288, 193, 208, 0, 0, 330, 369, 375,
+	0, 0, 0, 231, 0, 373, 344, 427, 216, 256,
+	366, 349, 371, 0, 0, 372, 297, 415, 361, 425,
+	442, 443, 238, 324, 433, 407, 439, 453, 209, 235,
+	338, 400, 430, 391, 317, 411, 412, 287, 390, 264,
+	196, 295, 200, 402, 423, 221, 383, 0, 0, 0,
+	202, 421, 399, 314, 284, 285, 201, 0, 365, 242,
+	262, 233, 333, 418, 419, 232, 455, 211, 438, 204,
+	212, 437, 326, 414, 422, 315, 306, 203, 420, 313,
+	305, 290, 252, 272, 359, 300, 360, 273, 322, 321,
+	323, 0, 198, 0, 396, 431, 456, 218, 0, 0,
+	409, 449, 452, 0, 362, 219, 263, 251, 358, 261,
+	293, 448, 450, 451, 217, 356, 269, 337, 426, 255,
+	434, 325, 213, 275, 392, 289, 298, 0, 0, 343,
+	374, 222, 429, 393, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 192, 205, 294, 1353, 363, 259,
+	454, 436, 432, 0, 0, 237, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 194, 195,
+	206, 215, 224, 236, 249, 257, 267, 271, 274, 277,
+	278, 281, 286, 303, 308, 309, 310, 311, 327, 328,
+	329, 332, 335, 336, 339, 341, 342, 345, 351, 352,
+	353, 354, 355, 357, 364, 368, 376, 377, 378, 379,
+	380, 381, 382, 386, 387, 388, 389, 397, 401, 416,
+	417, 428, 440, 444, 268, 424, 445, 0, 302, 0,
+	0, 304, 253, 270, 279, 0, 435, 398, 210, 370,
+	260, 207, 446, 447, 199, 227, 214, 234, 248, 250,
+	283, 312, 318, 347, 350, 265, 245, 225, 367, 223,
+	384, 404, 405, 406, 408, 316, 241, 334, 0, 1236,
+	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
+	0, 0, 292, 0, 0, 0, 348, 0, 385, 230,
+	301, 299, 413, 254, 247, 243, 229, 276, 307, 346,
+	403, 340, 0, 296, 0, 0, 394, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 228, 197, 331, 395, 258, 0,
+	0, 0, 179, 180, 181, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 0, 226, 0, 0, 0, 0,
+	240, 280, 246, 239, 410, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 266, 0, 320,
+	0, 0, 0, 441, 0, 0, 0, 0, 0, 0,
+	0, 0, 291, 0, 288, 193, 208, 0, 0, 330,
+	369, 375, 0, 0, 0, 231, 0, 373, 344, 427,
+	216, 256, 366, 349, 371, 0, 0, 372, 297, 415,
+	361, 425, 442, 443, 238, 324, 433, 407, 439, 453,
+	209, 235, 338, 400, 430, 391, 317, 411, 412, 287,
+	390, 264, 196, 295, 200, 402, 423, 221, 383, 0,
+	0, 0, 202, 421, 399, 314, 284, 285, 201, 0,
+	365, 242, 262, 233, 333, 418, 419, 232, 455, 211,
+	438, 204, 212, 437, 326, 414, 422, 315, 306, 203,
+	420, 313, 305, 290, 252, 272, 359, 300, 360, 273,
+	322, 321, 323, 0, 198, 0, 396, 431, 456, 218,
+	0, 0, 409, 449, 452, 0, 362, 219, 263, 251,
+	358, 261, 293, 448, 450, 451, 217, 356, 269, 337,
+	426, 255, 434, 325, 213, 275, 392, 289, 298, 0,
+	0, 343, 374, 222, 429, 393, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 192, 205, 294, 0,
+	363, 259, 454, 436, 432, 0, 0, 237, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	194, 195, 206, 215, 224, 236, 249, 257, 267, 271,
+	274, 277, 278, 281, 286, 303, 308, 309, 310, 311,
+	327, 328, 329, 332, 335, 336, 339, 341, 342, 345,
+	351, 352, 353, 354, 355, 357, 364, 368, 376, 377,
+	378, 379, 380, 381, 382, 386, 387, 388, 389, 397,
+	401, 416, 417, 428, 440, 444, 268, 424, 445, 0,
+	302, 0, 0, 304, 253, 270, 279, 0, 435, 398,
+	210, 370, 260, 207, 446, 447, 199, 227, 214, 234,
+	248, 250, 283, 312, 318, 347, 350, 265, 245, 225,
+	367, 223, 384, 404, 405, 406, 408, 316, 241, 334,
+	0, 1234, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 292, 0, 0, 0, 348, 0,
+	385, 230, 301, 299, 413, 254, 247, 243, 229, 276,
+	307, 346, 403, 340, 0, 296, 0, 0, 394, 319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 228, 197, 331, 395,
+	258, 0, 0, 0, 179, 180, 181, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 0, 226, 0, 0,
+	0, 0, 240, 280, 246, 239, 410, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	0, 320, 0, 0, 0, 441, 0, 0, 0, 0,
+	0, 0, 0, 0, 291, 0, 288, 193, 208, 0,
+	0, 330, 369, 375, 0, 0, 0, 231, 0, 373,
+	344, 427, 216, 256, 366, 349, 371, 0, 0, 372,
+	297, 415, 361, 425, 442, 443, 238, 324, 433, 407,
+	439, 453, 209, 235, 338, 400, 430, 391, 317, 411,
+	412, 287, 390, 264, 196, 295, 200, 402, 423, 221,
+	383, 0, 0, 0, 202, 421, 399, 314, 284, 285,
+	201, 0, 365, 242, 262, 233, 333, 418, 419, 232,
+	455, 211, 438, 204, 212, 437, 326, 414, 422, 315,
+	306, 203, 420, 313, 305, 290, 252, 272, 359, 300,
+	360, 273, 322, 321, 323, 0, 198, 0, 396, 431,
+	456, 218, 0, 0, 409, 449, 452, 0, 362, 219,
+	263, 251, 358, 261, 293, 448, 450, 451, 217, 356,
+	269, 337, 426, 255, 434, 325, 213, 275, 392, 289,
+	298, 0, 0, 343, 374, 222, 429, 393, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 192, 205,
+	294, 0, 363, 259, 454, 436, 432, 0, 0, 237,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 194, 195, 206, 215, 224, 236, 249, 257,
+	267, 271, 274, 277, 278, 281, 286, 303, 308, 309,
+	310, 311, 327, 328, 329, 332, 335, 336, 339, 341,
+	342, 345, 351, 352, 353, 354, 355, 357, 364, 368,
+	376, 377, 378, 379, 380, 381, 382, 386, 387, 388,
+	389, 397, 401, 416, 417, 428, 440, 444, 268, 424,
+	445, 0, 302, 0, 0, 304, 253, 270, 279, 0,
+	435, 398, 210, 370, 260, 207, 446, 447, 199, 227,
+	214, 234, 248, 250, 283, 312, 318, 347, 350, 265,
+	245, 225, 367, 223, 384, 404, 405, 406, 408, 316,
+	241, 334, 0, 1232, 0, 0, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 292, 0, 0, 0,
+	348, 0, 385, 230, 301, 299, 413, 254, 247, 243,
+	229, 276, 307, 346, 403, 340, 0, 296, 0, 0,
+	394, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 228, 197,
+	331, 395, 258, 0, 0, 0, 179, 180, 181, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 0, 226,
+	0, 0, 0, 0, 240, 280, 246, 239, 410, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 0, 320, 0, 0, 0, 441, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 288, 193,
+	208, 0, 0, 330, 369, 375, 0, 0, 0, 231,
+	0, 373, 344, 427, 216, 256, 366, 349, 371, 0,
+	0, 372, 297, 415, 361, 425, 442, 443, 238, 324,
+	433, 407, 439, 453, 209, 235, 338, 400, 430, 391,
+	317, 411, 412, 287, 390, 264, 196, 295, 200, 402,
+	423, 221, 383, 0, 0, 0, 202, 421, 399, 314,
+	284, 285, 201, 0, 365, 242, 262, 233, 333, 418,
+	419, 232, 455, 211, 438, 204, 212, 437, 326, 414,
+	422, 315, 306, 203, 420, 313, 305, 290, 252, 272,
+	359, 300, 360, 273, 322, 321, 323, 0, 198, 0,
+	396, 431, 456, 218, 0, 0, 409, 449, 452, 0,
+	362, 219, 263, 251, 358, 261, 293, 448, 450, 451,
+	217, 356, 269, 337, 426, 255, 434, 325, 213, 275,
+	392, 289, 298, 0, 0, 343, 374, 222, 429, 393,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	192, 205, 294, 0, 363, 259, 454, 436, 432, 0,
+	0, 237, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 194, 195, 206, 215, 224, 236,
+	249, 257, 267, 271, 274, 277, 278, 281, 286, 303,
+	308, 309, 310, 311, 327, 328, 329, 332, 335, 336,
+	339, 341, 342, 345, 351, 352, 353, 354, 355, 357,
+	364, 368, 376, 377, 378, 379, 380, 381, 382, 386,
+	387, 388, 389, 397, 401, 416, 417, 428, 440, 444,
+	268, 424, 445, 0, 302, 0, 0, 304, 253, 270,
+	279, 0, 435, 398, 210, 370, 260, 207, 446, 447,
+	199, 227, 214, 234, 248, 250, 283, 312, 318, 347,
+	350, 265, 245, 225, 367, 223, 384, 404, 405, 406,
+	408, 316, 241, 334, 0, 1230, 0, 0, 0, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 292, 0,
+	0, 0, 348, 0, 385, 230, 301, 299, 413, 254,
+	247, 243, 229, 276, 307, 346, 403, 340, 0, 296,
+	0, 0, 394, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	228, 197, 331, 395, 258, 0, 0, 0, 179, 180,
+	181, 0, 0, 0, 0, 0, 0, 0, 0, 220,
+	0, 226, 0, 0, 0, 0, 240, 280, 246, 239,
+	410, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 266, 0, 320, 0, 0, 0, 441,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
+	288, 193, 208, 0, 0, 330, 369, 375, 0, 0,
+	0, 231, 0, 373, 344, 427, 216, 256, 366, 349,
+	371, 0, 0, 372, 297, 415, 361, 425, 442, 443,
+	238, 324, 433, 407, 439, 453, 209, 235, 338, 400,
+	430, 391, 317, 411, 412, 287, 390, 264, 196, 295,
+	200, 402, 423, 221, 383, 0, 0, 0, 202, 421,
+	399, 314, 284, 285, 201, 0, 365, 242, 262, 233,
+	333, 418, 419, 232, 455, 211, 438, 204, 212, 437,
+	326, 414, 422, 315, 306, 203, 420, 313, 305, 290,
+	252, 272, 359, 300, 360, 273, 322, 321, 323, 0,
+	198, 0, 396, 431, 456, 218, 0, 0, 409, 449,
+	452, 0, 362, 219, 263, 251, 358, 261, 293, 448,
+	450, 451, 217, 356, 269, 337, 426, 255, 434, 325,
+	213, 275, 392, 289, 298, 0, 0, 343, 374, 222,
+	429, 393, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 192, 205, 294, 0, 363, 259, 454, 436,
+	432, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 194, 195, 206, 215,
+	224, 236, 249, 257, 267, 271, 274, 277, 278, 281,
+	286, 303, 308, 309, 310, 311, 327, 328, 329, 332,
+	335, 336, 339, 341, 342, 345, 351, 352, 353, 354,
+	355, 357, 364, 368, 376, 377, 378, 379, 380, 381,
+	382, 386, 387, 388, 389, 397, 401, 416, 417, 428,
+	440, 444, 268, 424, 445, 0, 302, 0, 0, 304,
+	253, 270, 279, 0, 435, 398, 210, 370, 260, 207,
+	446, 447, 199, 227, 214, 234, 248, 250, 283, 312,
+	318, 347, 350, 265, 245, 225, 367, 223, 384, 404,
+	405, 406, 408, 316, 241, 334, 0, 1228, 0, 0,
+	0, 0, 0, 0, 244, 0, 0, 0, 0, 0,
+	292, 0, 0, 0, 348, 0, 385, 230, 301, 299,
+	413, 254, 247, 243, 229, 276, 307, 346, 403, 340,
+	0, 296, 0, 0, 394, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 228, 197, 331, 395, 258, 0, 0, 0,
+	179, 180, 181, 0, 0, 0, 0, 0, 0, 0,
+	0, 220, 0, 226, 0, 0, 0, 0, 240, 280,
+	246, 239, 410, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 266, 0, 320, 0, 0,
+	0, 441, 0, 0, 0, 0, 0, 0, 0, 0,
+	291, 0, 288, 193, 208, 0, 0, 330, 369, 375,
+	0, 0, 0, 231, 0, 373, 344, 427, 216, 256,
+	366, 349, 371, 0, 0, 372, 297, 415, 361, 425,
+	442, 443, 238, 324, 433, 407, 439, 453, 209, 235,
+	338, 400, 430, 391, 317, 411, 412, 287, 390, 264,
+	196, 295, 200, 402, 423, 221, 383, 0, 0, 0,
+	202, 421, 399, 314, 284, 285, 201, 0, 365, 242,
+	262, 233, 333, 418, 419, 232, 455, 211, 438, 204,
+	212, 437, 326, 414, 422, 315, 306, 203, 420, 313,
+	305, 290, 252, 272, 359, 300, 360, 273, 322, 321,
+	323, 0, 198, 0, 396, 431, 456, 218, 0, 0,
+	409, 449, 452, 0, 362, 219, 263, 251, 358, 261,
+	293, 448, 450, 451, 217, 356, 269, 337, 426, 255,
+	434, 325, 213, 275, 392, 289, 298, 0, 0, 343,
+	374, 222, 429, 393, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 192, 205, 294, 0, 363, 259,
+	454, 436, 432, 0, 0, 237, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 194, 195,
+	206, 215, 224, 236, 249, 257, 267, 271, 274, 277,
+	278, 281, 286, 303, 308, 309, 310, 311, 327, 328,
+	329, 332, 335, 336, 339, 341, 342, 345, 351, 352,
+	353, 354, 355, 357, 364, 368, 376, 377, 378, 379,
+	380, 381, 382, 386, 387, 388, 389, 397, 401, 416,
+	417, 428, 440, 444, 268, 424, 445, 0, 302, 0,
+	0, 304, 253, 270, 279, 0, 435, 398, 210, 370,
+	260, 207, 446, 447, 199, 227, 214, 234, 248, 250,
+	283, 312, 318, 347, 350, 265, 245, 225, 367, 223,
+	384, 404, 405, 406, 408, 316, 241, 334, 0, 1224,
+	0, 0, 0, 0, 0, 0, 244, 0, 0, 0,
+	0, 0, 292, 0, 0, 0, 348, 0, 385, 230,
+	301, 299, 413, 254, 247, 243, 229, 276, 307, 346,
+	403, 340, 0, 296, 0, 0, 394, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 228, 197, 331, 395, 258, 0,
+	0, 0, 179, 180, 181, 0, 0, 0, 0, 0,
+	0, 0, 0, 220, 0, 226, 0, 0, 0, 0,
+	240, 280, 246, 239, 410, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 266, 0, 320,
+	0, 0, 0, 441, 0, 0, 0, 0, 0, 0,
+	0, 0, 291, 0, 288, 193, 208, 0, 0, 330,
+	369, 375, 0, 0, 0, 231, 0, 373, 344, 427,
+	216, 256, 366, 349, 371, 0, 0, 372, 297, 415,
+	361, 425, 442, 443, 238, 324, 433, 407, 439, 453,
+	209, 235, 338, 400, 430, 391, 317, 411, 412, 287,
+	390, 264, 196, 295, 200, 402, 423, 221, 383, 0,
+	0, 0, 202, 421, 399, 314, 284, 285, 201, 0,
+	365, 242, 262, 233, 333, 418, 419, 232, 455, 211,
+	438, 204, 212, 437, 326, 414, 422, 315, 306, 203,
+	420, 313, 305, 290, 252, 272, 359, 300, 360, 273,
+	322, 321, 323, 0, 198, 0, 396, 431, 456, 218,
+	0, 0, 409, 449, 452, 0, 362, 219, 263, 251,
+	358, 261, 293, 448, 450, 451, 217, 356, 269, 337,
+	426, 255, 434, 325, 213, 275, 392, 289, 298, 0,
+	0, 343, 374, 222, 429, 393, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 192, 205, 294, 0,
+	363, 259, 454, 436, 432, 0, 0, 237, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	194, 195, 206, 215, 224, 236, 249, 257, 267, 271,
+	274, 277, 278, 281, 286, 303, 308, 309, 310, 311,
+	327, 328, 329, 332, 335, 336, 339, 341, 342, 345,
+	351, 352, 353, 354, 355, 357, 364, 368, 376, 377,
+	378, 379, 380, 381, 382, 386, 387, 388, 389, 397,
+	401, 416, 417, 428, 440, 444, 268, 424, 445, 0,
+	302, 0, 0, 304, 253, 270, 279, 0, 435, 398,
+	210, 370, 260, 207, 446, 447, 199, 227, 214, 234,
+	248, 250, 283, 312, 318, 347, 350, 265, 245, 225,
+	367, 223, 384, 404, 405, 406, 408, 316, 241, 334,
+	0, 1222, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 292, 0, 0, 0, 348, 0,
+	385, 230, 301, 299, 413, 254, 247, 243, 229, 276,
+	307, 346, 403, 340, 0, 296, 0, 0, 394, 319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 228, 197, 331, 395,
+	258, 0, 0, 0, 179, 180, 181, 0, 0, 0,
+	0, 0, 0, 0, 0, 220, 0, 226, 0, 0,
+	0, 0, 240, 280, 246, 239, 410, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 266,
+	0, 320, 0, 0, 0, 441, 0, 0, 0, 0,
+	0, 0, 0, 0, 291, 0, 288, 193, 208, 0,
+	0, 330, 369, 375, 0, 0, 0, 231, 0, 373,
+	344, 427, 216, 256, 366, 349, 371, 0, 0, 372,
+	297, 415, 361, 425, 442, 443, 238, 324, 433, 407,
+	439, 453, 209, 235, 338, 400, 430, 391, 317, 411,
+	412, 287, 390, 264, 196, 295, 200, 402, 423, 221,
+	383, 0, 0, 0, 202, 421, 399, 314, 284, 285,
+	201, 0, 365, 242, 262, 233, 333, 418, 419, 232,
+	455, 211, 438, 204, 212, 437, 326, 414, 422, 315,
+	306, 203, 420, 313, 305, 290, 252, 272, 359, 300,
+	360, 273, 322, 321, 323, 0, 198, 0, 396, 431,
+	456, 218, 0, 0, 409, 449, 452, 0, 362, 219,
+	263, 251, 358, 261, 293, 448, 450, 451, 217, 356,
+	269, 337, 426, 255, 434, 325, 213, 275, 392, 289,
+	298, 0, 0, 343, 374, 222, 429, 393, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 192, 205,
+	294, 0, 363, 259, 454, 436, 432, 0, 0, 237,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 194, 195, 206, 215, 224, 236, 249, 257,
+	267, 271, 274, 277, 278, 281, 286, 303, 308, 309,
+	310, 311, 327, 328, 329, 332, 335, 336, 339, 341,
+	342, 345, 351, 352, 353, 354, 355, 357, 364, 368,
+	376, 377, 378, 379, 380, 381, 382, 386, 387, 388,
+	389, 397, 401, 416, 417, 428, 440, 444, 268, 424,
+	445, 0, 302, 0, 0, 304, 253, 270, 279, 0,
+	435, 398, 210, 370, 260, 207, 446, 447, 199, 227,
+	214, 234, 248, 250, 283, 312, 318, 347, 350, 265,
+	245, 225, 367, 223, 384, 404, 405, 406, 408, 316,
+	241, 334, 0, 1220, 0, 0, 0, 0, 0, 0,
+	244, 0, 0, 0, 0, 0, 292, 0, 0, 0,
+	348, 0, 385, 230, 301, 299, 413, 254, 247, 243,
+	229, 276, 307, 346, 403, 340, 0, 296, 0, 0,
+	394, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 228, 197,
+	331, 395, 258, 0, 0, 0, 179, 180, 181, 0,
+	0, 0, 0, 0, 0, 0, 0, 220, 0, 226,
+	0, 0, 0, 0, 240, 280, 246, 239, 410, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 266, 0, 320, 0, 0, 0, 441, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 288, 193,
+	208, 0, 0, 330, 369, 375, 0, 0, 0, 231,
+	0, 373, 344, 427, 216, 256, 366, 349, 371, 0,
+	0, 372, 297, 415, 361, 425, 442, 443, 238, 324,
+	433, 407, 439, 453, 209, 235, 338, 400, 430, 391,
+	317, 411, 412, 287, 390, 264, 196, 295, 200, 402,
+	423, 221, 383, 0, 0, 0, 202, 421, 399, 314,
+	284, 285, 201, 0, 365, 242, 262, 233, 333, 418,
+	419, 232, 455, 211, 438, 204, 212, 437, 326, 414,
+	422, 315, 306, 203, 420, 313, 305, 290, 252, 272,
+	359, 300, 360, 273, 322, 321, 323, 0, 198, 0,
+	396, 431, 456, 218, 0, 0, 409, 449, 452, 0,
+	362, 219, 263, 251, 358, 261, 293, 448, 450, 451,
+	217, 356, 269, 337, 426, 255, 434, 325, 213, 275,
+	392, 289, 298, 0, 0, 343, 374, 222, 429, 393,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	192, 205, 294, 0, 363, 259, 454, 436, 432, 0,
+	0, 237, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 194, 195, 206, 215, 224, 236,
+	249, 257, 267, 271, 274, 277, 278, 281, 286, 303,
+	308, 309, 310, 311, 327, 328, 329, 332, 335, 336,
+	339, 341, 342, 345, 351, 352, 353, 354, 355, 357,
+	364, 368, 376, 377, 378, 379, 380, 381, 382, 386,
+	387, 388, 389, 397, 401, 416, 417, 428, 440, 444,
+	268, 424, 445, 0, 302, 0, 0, 304, 253, 270,
+	279, 0, 435, 398, 210, 370, 260, 207, 446, 447,
+	199, 227, 214, 234, 248, 250, 283, 312, 318, 347,
+	350, 265, 245, 225, 367, 223, 384, 404, 405, 406,
+	408, 316, 241, 334, 0, 0, 0, 0, 0, 0,
+	0, 0, 244, 0, 0, 0, 0, 0, 292, 0,
+	0, 0, 348, 0, 385, 230, 301, 299, 413, 254,
+	247, 243, 229, 276, 307, 346, 403, 340, 0, 296,
+	0, 0, 394, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	228, 197, 331, 395, 258, 1195, 0, 0, 179, 180,
+	181, 0, 0, 0, 0, 0, 0, 0, 0, 220,
+	0, 226, 0, 0, 0, 0, 240, 280, 246, 239,
+	410, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 266, 0, 320, 0, 0, 0, 441,
+	0, 0, 0, 0, 0, 0, 0, 0, 291, 0,
+	288, 193, 208, 0, 0, 330, 369, 375, 0, 0,
+	0, 231, 0, 373, 344, 427, 216, 256, 366, 349,
+	371, 0, 0, 372, 297, 415, 361, 425, 442, 443,
+	238, 324, 433, 407, 439, 453, 209, 235, 338, 400,
+	430, 391, 317, 411, 412, 287, 390, 264, 196, 295,
+	200, 402, 423, 221, 383, 0, 0, 0, 202, 421,
+	399, 314, 284, 285, 201, 0, 365, 242, 262, 233,
+	333, 418, 419, 232, 455, 211, 438, 204, 212, 437,
+	326, 414, 422, 315, 306, 203, 420, 313, 305, 290,
+	252, 272, 359, 300, 360, 273, 322, 321, 323, 0,
+	198, 0, 396, 431, 456, 218, 0, 0, 409, 449,
+	452, 0, 362, 219, 263, 251, 358, 261, 293, 448,
+	450, 451, 217, 356, 269, 337, 426, 255, 434, 325,
+	213, 275, 392, 289, 298, 0, 0, 343, 374, 222,
+	429, 393, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 192, 205, 294, 0, 363, 259, 454, 436,
+	432, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 194, 195, 206, 215,
+	224, 236, 249, 257, 267, 271, 274, 277, 278, 281,
+	286, 303, 308, 309, 310, 311, 327, 328, 329, 332,
+	335, 336, 339, 341, 342, 345, 351, 352, 353, 354,
+	355, 357, 364, 368, 376, 377, 378, 379, 380, 381,
+	382, 386, 387, 388, 389, 397, 401, 416, 417, 428,
+	440, 444, 268, 424, 445, 0, 302, 0, 0, 304,
+	253, 270, 279, 0, 435, 398, 210, 370, 260, 207,
+	446, 447, 199, 227, 214, 234, 248, 250, 283, 312,
+	318, 347, 350, 265, 245, 225, 367, 223, 384, 404,
+	405, 406, 408, 316, 241, 1094, 0, 0, 0, 0,
+	0, 0, 334, 0, 0, 0, 0, 0, 0, 0,
+	0, 244, 0, 0, 0, 0, 0, 292, 0, 0,
+	0, 348, 0, 385, 230, 301, 299, 413, 254, 247,
+	243, 229, 276, 307, 346, 403, 340, 0, 296, 0,
+	0, 394, 319, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 228,
+	197, 331, 395, 258, 0, 0, 0, 179, 180, 181,
+	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
+	226, 0, 0, 0, 0, 240, 280, 246, 239, 410,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 266, 0, 320, 0, 0, 0, 441, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 288,
+	193, 208, 0, 0, 330, 369, 375, 0, 0, 0,
+	231, 0, 373, 344, 427, 216, 256, 366, 349, 371,
+	0, 0, 372, 297, 415, 361, 425, 442, 443, 238,
+	324, 433, 407, 439, 453, 209, 235, 338, 400, 430,
+	391, 317, 411, 412, 287, 390, 264, 196, 295, 200,
+	402, 423, 221, 383, 0, 0, 0, 202, 421, 399,
+	314, 284, 285, 201, 0, 365, 242, 262, 233, 333,
+	418, 419, 232, 455, 211, 438, 204, 212, 437, 326,
+	414, 422, 315, 306, 203, 420, 313, 305, 290, 252,
+	272, 359, 300, 360, 273, 322, 321, 323, 0, 198,
+	0, 396, 431, 456, 218, 0, 0, 409, 449, 452,
+	0, 362, 219, 263, 251, 358, 261, 293, 448, 450,
+	451, 217, 356, 269, 337, 426, 255, 434, 325, 213,
+	275, 392, 289, 298, 0, 0, 343, 374, 222, 429,
+	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 192, 205, 294, 0, 363, 259, 454, 436, 432,
+	0, 0, 237, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 194, 195, 206, 215, 224,
+	236, 249, 257, 267, 271, 274, 277, 278, 281, 286,
+	303, 308, 309, 310, 311, 327, 328, 329, 332, 335,
+	336, 339, 341, 342, 345, 351, 352, 353, 354, 355,
+	357, 364, 368, 376, 377, 378, 379, 380, 381, 382,
+	386, 387, 388, 389, 397, 401, 416, 417, 428, 440,
+	444, 268, 424, 445, 0, 302, 0, 0, 304, 253,
+	270, 279, 0, 435, 398, 210, 370, 260, 207, 446,
+	447, 199, 227, 214, 234, 248, 250, 283, 312, 318,
+	347, 350, 265, 245, 225, 367, 223, 384, 404, 405,
+	406, 408, 316, 241, 334, 0, 0, 0, 0, 0,
+	0, 0, 1085, 244, 0, 0, 0, 0, 0, 292,
+	0, 0, 0, 348, 0, 385, 230, 301, 299, 413,
+	254, 247, 243, 229, 276, 307, 346, 403, 340, 0,
+	296, 0, 0, 394, 319, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 228, 197, 331, 395, 258, 0, 0, 0, 179,
+	180, 181, 0, 0, 0, 0, 0, 0, 0, 0,
+	220, 0, 226, 0, 0, 0, 0, 240, 280, 246,
+	239, 410, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 266, 0, 320, 0, 0, 0,
+	441, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 288, 193, 208, 0, 0, 330, 369, 375, 0,
+	0, 0, 231, 0, 373, 344, 427, 216, 256, 366,
+	349, 371, 0, 0, 372, 297, 415, 361, 425, 442,
+	443, 238, 324, 433, 407, 439, 453, 209, 235, 338,
+	400, 430, 391, 317, 411, 412, 287, 390, 264, 196,
+	295, 200, 402, 423, 221, 383, 0, 0, 0, 202,
+	421, 399, 314, 284, 285, 201, 0, 365, 242, 262,
+	233, 333, 418, 419, 232, 455, 211, 438, 204, 212,
+	437, 326, 414, 422, 315, 306, 203, 420, 313, 305,
+	290, 252, 272, 359, 300, 360, 273, 322, 321, 323,
+	0, 198, 0, 396, 431, 456, 218, 0, 0, 409,
+	449, 452, 0, 362, 219, 263, 251, 358, 261, 293,
+	448, 450, 451, 217, 356, 269, 337, 426, 255, 434,
+	325, 213, 275, 392, 289, 298, 0, 0, 343, 374,
+	222, 429, 393, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 192, 205, 294, 0, 363, 259, 454,
+	436, 432, 0, 0, 237, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 194, 195, 206,
+	215, 224, 236, 249, 257, 267, 271, 274, 277, 278,
+	281, 286, 303, 308, 309, 310, 311, 327, 328, 329,
+	332, 335, 336, 339, 341, 342, 345, 351, 352, 353,
+	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
+	381, 382, 386, 387, 388, 389, 397, 401, 416, 417,
+	428, 440, 444, 268, 424, 445, 0, 302, 0, 0,
+	304, 253, 270, 279, 0, 435, 398, 210, 370, 260,
+	207, 446, 447, 199, 227, 214, 234, 248, 250, 283,
+	312, 318, 347, 350, 265, 245, 225, 367, 223, 384,
+	404, 405, 406, 408, 316, 241, 334, 0, 0, 0,
+	0, 0, 0, 0, 0, 244, 0, 0, 0, 0,
+	0, 292, 0, 0, 0, 348, 0, 385, 230, 301,
+	299, 413, 254, 247, 243, 229, 276, 307, 346, 403,
+	340, 0, 296, 0, 0, 394, 319, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 228, 197, 331, 395, 258, 0, 0,
+	0, 179, 180, 181, 0, 942, 0, 0, 0, 0,
+	0, 0, 220, 0, 226, 0, 0, 0, 0, 240,
+	280, 246, 239, 410, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 266, 0, 320, 0,
+	0, 0, 441, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 288, 193, 208, 0, 0, 330, 369,
+	375, 0, 0, 0, 231, 0, 373, 344, 427, 216,
+	256, 366, 349, 371, 0, 0, 372, 297, 415, 361,
+	425, 442, 443, 238, 324, 433, 407, 439, 453, 209,
+	235, 338, 400, 430, 391, 317, 411, 412, 287, 390,
+	264, 196, 295, 200, 402, 423, 221, 383, 0, 0,
+	0, 202, 421, 399, 314, 284, 285, 201, 0, 365,
+	242, 262, 233, 333, 418, 419, 232, 455, 211, 438,
+	204, 212, 437, 326, 414, 422, 315, 306, 203, 420,
+	313, 305, 290, 252, 272, 359, 300, 360, 273, 322,
+	321, 323, 0, 198, 0, 396, 431, 456, 218, 0,
+	0, 409, 449, 452, 0, 362, 219, 263, 251, 358,
+	261, 293, 448, 450, 451, 217, 356, 269, 337, 426,
+	255, 434, 325, 213, 275, 392, 289, 298, 0, 0,
+	343, 374, 222, 429, 393, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 192, 205, 294, 0, 363,
+	259, 454, 436, 432, 0, 0, 237, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 194,
+	195, 206, 215, 224, 236, 249, 257, 267, 271, 274,
+	277, 278, 281, 286, 303, 308, 309, 310, 311, 327,
+	328, 329, 332, 335, 336, 339, 341, 342, 345, 351,
+	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
+	379, 380, 381, 382, 386, 387, 388, 389, 397, 401,
+	416, 417, 428, 440, 444, 268, 424, 445, 0, 302,
+	0, 0, 304, 253, 270, 279, 0, 435, 398, 210,
+	370, 260, 207, 446, 447, 199, 227, 214, 234, 248,
+	250, 283, 312, 318, 347, 350, 265, 245, 225, 367,
+	223, 384, 404, 405, 406, 408, 316, 241, 334, 0,
+	0, 0, 0, 0, 0, 0, 0, 244, 0, 0,
+	0, 0, 0, 292, 0, 0, 0, 348, 0, 385,
+	230, 301, 299, 413, 254, 247, 243, 229, 276, 307,
+	346, 403, 340, 0, 296, 0, 0, 394, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 228, 197, 331, 395, 258,
+	0, 0, 0, 179, 180, 181, 0, 0, 0, 0,
+	0, 0, 0, 0, 220, 0, 226, 0, 0, 0,
+	0, 240, 280, 246, 239, 410, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 505, 0, 266, 0,
+	320, 0, 0, 0, 441, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 0, 288, 193, 208, 0, 0,
+	330, 369, 375, 0, 0, 0, 231, 0, 373, 344,
+	427, 216, 256, 366, 349, 371, 0, 0, 372, 297,
+	415, 361, 425, 442, 443, 238, 324, 433, 407, 439,
+	453, 209, 235, 338, 400, 430, 391, 317, 411, 412,
+	287, 390, 264, 196, 295, 200, 402, 423, 221, 383,
+	0, 0, 0, 202, 421, 399, 314, 284, 285, 201,
+	0, 365, 242, 262, 233, 333, 418, 419, 232, 455,
+	211, 438, 204, 212, 437, 326, 414, 422, 315, 306,
+	203, 420, 313, 305, 290, 252, 272, 359, 300, 360,
+	273, 322, 321, 323, 0, 198, 0, 396, 431, 456,
+	218, 0, 0, 409, 449, 452, 0, 362, 219, 263,
+	251, 358, 261, 293, 448, 450, 451, 217, 356, 269,
+	337, 426, 255, 434, 325, 213, 275, 392, 289, 298,
+	0, 0, 343, 374, 222, 429, 393, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 192, 205, 294,
+	0, 363, 259, 454, 436, 432, 0, 0, 237, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 194, 195, 206, 215, 224, 236, 249, 257, 267,
+	271, 274, 277, 278, 281, 286, 303, 308, 309, 310,
+	311, 327, 328, 329, 332, 335, 336, 339, 341, 342,
+	345, 351, 352, 353, 354, 355, 357, 364, 368, 376,
+	377, 378, 379, 380, 381, 382, 386, 387, 388, 389,
+	397, 401, 416, 417, 428, 440, 444, 504, 424, 445,
+	0, 302, 0, 0, 304, 253, 270, 279, 0, 435,
+	398, 210, 370, 260, 207, 446, 447, 199, 227, 214,
+	234, 248, 250, 283, 312, 318, 347, 350, 265, 245,
+	225, 367, 223, 384, 404, 405, 406, 408, 316, 241,
+	334, 0, 0, 0, 0, 0, 0, 0, 0, 244,
+	0, 0, 0, 0, 0, 292, 0, 0, 0, 348,
+	0, 385, 230, 301, 299, 413, 254, 247, 243, 229,
+	276, 307, 346, 403, 340, 0, 296, 0, 0, 394,
+	319, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 228, 197, 331,
+	395, 258, 0, 0, 0, 179, 180, 181, 0, 0,
+	0, 0, 0, 0, 0, 0, 220, 0, 226, 0,
+	0, 0, 0, 240, 280, 246, 239, 410, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	266, 0, 320, 0, 187, 0, 441, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 288, 193, 208,
+	0, 0, 330, 369, 375, 0, 0, 0, 231, 0,
+	373, 344, 427, 216, 256, 366, 349, 371, 0, 0,
+	372, 297, 415, 361, 425, 442, 443, 238, 324, 433,
+	407, 439, 453, 209, 235, 338, 400, 430, 391, 317,
+	411, 412, 287, 390, 264, 196, 295, 200, 402, 423,
+	221, 383, 0, 0, 0, 202, 421, 399, 314, 284,
+	285, 201, 0, 365, 242, 262, 233, 333, 418, 419,
+	232, 455, 211, 438, 204, 212, 437, 326, 414, 422,
+	315, 306, 203, 420, 313, 305, 290, 252, 272, 359,
+	300, 360, 273, 322, 321, 323, 0, 198, 0, 396,
+	431, 456, 218, 0, 0, 409, 449, 452, 0, 362,
+	219, 263, 251, 358, 261, 293, 448, 450, 451, 217,
+	356, 269, 337, 426, 255, 434, 325, 213, 275, 392,
+	289, 298, 0, 0, 343, 374, 222, 429, 393, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
-	193, 204, 212, 221, 233, 246, 254, 264, 268, 271,
-	274, 275, 278, 283, 300, 305, 306, 307, 308, 324,
-	325, 326, 329, 332, 333, 336, 338, 339, 342, 348,
-	349, 350, 351, 352, 354, 361, 365, 373, 374, 375,
-	376, 377, 378, 379, 383, 384, 385, 386, 394, 398,
-	413, 414, 425, 437, 441, 265, 421, 442, 0, 299,
-	0, 0, 301, 250, 267, 276, 0, 432, 395, 207,
-	367, 257, 197, 224, 211, 231, 245, 247, 280, 309,
-	315, 344, 347, 262, 242, 222, 364, 220, 381, 401,
-	402, 403, 405, 313, 238, 1085, 0, 0, 0, 0,
-	0, 0, 331, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 0, 0, 0, 0, 0, 289, 0, 0,
-	0, 345, 0, 382, 227, 298, 296, 410, 251, 244,
-	240, 226, 273, 304, 343, 400, 337, 0, 293, 0,
-	0, 391, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 225,
-	195, 328, 392, 255, 0, 0, 0, 177, 178, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
-	223, 0, 0, 0, 0, 237, 277, 243, 236, 407,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 263, 0, 317, 0, 0, 0, 438, 0,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	191, 205, 0, 0, 327, 366, 372, 0, 0, 0,
-	228, 0, 370, 341, 424, 213, 253, 363, 346, 368,
-	0, 0, 369, 294, 412, 358, 422, 439, 440, 235,
-	321, 430, 404, 436, 448, 206, 232, 335, 397, 427,
-	388, 314, 408, 409, 284, 387, 261, 194, 292, 198,
-	399, 420, 218, 380, 0, 0, 0, 200, 418, 396,
-	311, 281, 282, 199, 0, 362, 239, 259, 230, 330,
-	415, 416, 229, 450, 208, 435, 202, 209, 434, 323,
-	411, 419, 312, 303, 201, 417, 310, 302, 287, 249,
-	269, 356, 297, 357, 270, 319, 318, 320, 0, 196,
-	0, 393, 428, 451, 215, 0, 0, 406, 444, 447,
-	0, 359, 216, 260, 248, 355, 258, 290, 443, 445,
-	446, 214, 353, 266, 334, 423, 252, 431, 322, 210,
-	272, 389, 286, 295, 0, 0, 340, 371, 219, 426,
-	390, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 190, 203, 291, 0, 360, 256, 449, 433, 429,
-	0, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 192, 193, 204, 212, 221,
-	233, 246, 254, 264, 268, 271, 274, 275, 278, 283,
-	300, 305, 306, 307, 308, 324, 325, 326, 329, 332,
-	333, 336, 338, 339, 342, 348, 349, 350, 351, 352,
-	354, 361, 365, 373, 374, 375, 376, 377, 378, 379,
-	383, 384, 385, 386, 394, 398, 413, 414, 425, 437,
-	441, 265, 421, 442, 0, 299, 0, 0, 301, 250,
-	267, 276, 0, 432, 395, 207, 367, 257, 197, 224,
-	211, 231, 245, 247, 280, 309, 315, 344, 347, 262,
-	242, 222, 364, 220, 381, 401, 402, 403, 405, 313,
-	238, 331, 0, 0, 0, 0, 0, 0, 0, 1076,
-	241, 0, 0, 0, 0, 0, 289, 0, 0, 0,
-	345, 0, 382, 227, 298, 296, 410, 251, 244, 240,
-	226, 273, 304, 343, 400, 337, 0, 293, 0, 0,
-	391, 316, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 225, 195,
-	328, 392, 255, 0, 0, 0, 177, 178, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 217, 0, 223,
-	0, 0, 0, 0, 237, 277, 243, 236, 407, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 263, 0, 317, 0, 0, 0, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 288, 0, 285, 191,
-	205, 0, 0, 327, 366, 372, 0, 0, 0, 228,
-	0, 370, 341, 424, 213, 253, 363, 346, 368, 0,
-	0, 369, 294, 412, 358, 422, 439, 440, 235, 321,
-	430, 404, 436, 448, 206, 232, 335, 397, 427, 388,
-	314, 408, 409, 284, 387, 261, 194, 292, 198, 399,
-	420, 218, 380, 0, 0, 0, 200, 418, 396, 311,
-	281, 282, 199, 0, 362, 239, 259, 230, 330, 415,
-	416, 229, 450, 208, 435, 202, 209, 434, 323, 411,
-	419, 312, 303, 201, 417, 310, 302, 287, 249, 269,
-	356, 297, 357, 270, 319, 318, 320, 0, 196, 0,
-	393, 428, 451, 215, 0, 0, 406, 444, 447, 0,
-	359, 216, 260, 248, 355, 258, 290, 443, 445, 446,
-	214, 353, 266, 334, 423, 252, 431, 322, 210, 272,
-	389, 286, 295, 0, 0, 340, 371, 219, 426, 390,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	190, 203, 291, 0, 360, 256, 449, 433, 429, 0,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 192, 193, 204, 212, 221, 233,
-	246, 254, 264, 268, 271, 274, 275, 278, 283, 300,
-	305, 306, 307, 308, 324, 325, 326, 329, 332, 333,
-	336, 338, 339, 342, 348, 349, 350, 351, 352, 354,
-	361, 365, 373, 374, 375, 376, 377, 378, 379, 383,
-	384, 385, 386, 394, 398, 413, 414, 425, 437, 441,
-	265, 421, 442, 0, 299, 0, 0, 301, 250, 267,
-	276, 0, 432, 395, 207, 367, 257, 197, 224, 211,
-	231, 245, 247, 280, 309, 315, 344, 347, 262, 242,
-	222, 364, 220, 381, 401, 402, 403, 405, 313, 238,
-	331, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	0, 0, 0, 0, 0, 289, 0, 0, 0, 345,
-	0, 382, 227, 298, 296, 410, 251, 244, 240, 226,
-	273, 304, 343, 400, 337, 0, 293, 0, 0, 391,
-	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 225, 195, 328,
-	392, 255, 0, 0, 0, 177, 178, 179, 0, 934,
-	0, 0, 0, 0, 0, 0, 217, 0, 223, 0,
-	0, 0, 0, 237, 277, 243, 236, 407, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 317, 0, 0, 0, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 191, 205,
-	0, 0, 327, 366, 372, 0, 0, 0, 228, 0,
-	370, 341, 424, 213, 253, 363, 346, 368, 0, 0,
-	369, 294, 412, 358, 422, 439, 440, 235, 321, 430,
-	404, 436, 448, 206, 232, 335, 397, 427, 388, 314,
-	408, 409, 284, 387, 261, 194, 292, 198, 399, 420,
-	218, 380, 0, 0, 0, 200, 418, 396, 311, 281,
-	282, 199, 0, 362, 239, 259, 230, 330, 415, 416,
-	229, 450, 208, 435, 202, 209, 434, 323, 411, 419,
-	312, 303, 201, 417, 310, 302, 287, 249, 269, 356,
-	297, 357, 270, 319, 318, 320, 0, 196, 0, 393,
-	428, 451, 215, 0, 0, 406, 444, 447, 0, 359,
-	216, 260, 248, 355, 258, 290, 443, 445, 446, 214,
-	353, 266, 334, 423, 252, 431, 322, 210, 272, 389,
-	286, 295, 0, 0, 340, 371, 219, 426, 390, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
-	203, 291, 0, 360, 256, 449, 433, 429, 0, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 192, 193, 204, 212, 221, 233, 246,
-	254, 264, 268, 271, 274, 275, 278, 283, 300, 305,
-	306, 307, 308, 324, 325, 326, 329, 332, 333, 336,
-	338, 339, 342, 348, 349, 350, 351, 352, 354, 361,
-	365, 373, 374, 375, 376, 377, 378, 379, 383, 384,
-	385, 386, 394, 398, 413, 414, 425, 437, 441, 265,
-	421, 442, 0, 299, 0, 0, 301, 250, 267, 276,
-	0, 432, 395, 207, 367, 257, 197, 224, 211, 231,
-	245, 247, 280, 309, 315, 344, 347, 262, 242, 222,
-	364, 220, 381, 401, 402, 403, 405, 313, 238, 331,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 0,
-	0, 0, 0, 0, 289, 0, 0, 0, 345, 0,
-	382, 227, 298, 296, 410, 251, 244, 240, 226, 273,
-	304, 343, 400, 337, 0, 293, 0, 0, 391, 316,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 225, 195, 328, 392,
-	255, 0, 0, 0, 177, 178, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 217, 0, 223, 0, 0,
-	0, 0, 237, 277, 243, 236, 407, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 499, 0, 263,
-	0, 317, 0, 0, 0, 438, 0, 0, 0, 0,
-	0, 0, 0, 0, 288, 0, 285, 191, 205, 0,
-	0, 327, 366, 372, 0, 0, 0, 228, 0, 370,
-	341, 424, 213, 253, 363, 346, 368, 0, 0, 369,
-	294, 412, 358, 422, 439, 440, 235, 321, 430, 404,
-	436, 448, 206, 232, 335, 397, 427, 388, 314, 408,
-	409, 284, 387, 261, 194, 292, 198, 399, 420, 218,
-	380, 0, 0, 0, 200, 418, 396, 311, 281, 282,
-	199, 0, 362, 239, 259, 230, 330, 415, 416, 229,
-	450, 208, 435, 202, 209, 434, 323, 411, 419, 312,
-	303, 201, 417, 310, 302, 287, 249, 269, 356, 297,
-	357, 270, 319, 318, 320, 0, 196, 0, 393, 428,
-	451, 215, 0, 0, 406, 444, 447, 0, 359, 216,
-	260, 248, 355, 258, 290, 443, 445, 446, 214, 353,
-	266, 334, 423, 252, 431, 322, 210, 272, 389, 286,
-	295, 0, 0, 340, 371, 219, 426, 390, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 190, 203,
-	291, 0, 360, 256, 449, 433, 429, 0, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 192, 193, 204, 212, 221, 233, 246, 254,
-	264, 268, 271, 274, 275, 278, 283, 300, 305, 306,
-	307, 308, 324, 325, 326, 329, 332, 333, 336, 338,
-	339, 342, 348, 349, 350, 351, 352, 354, 361, 365,
-	373, 374, 375, 376, 377, 378, 379, 383, 384, 385,
-	386, 394, 398, 413, 414, 425, 437, 441, 498, 421,
-	442, 0, 299, 0, 0, 301, 250, 267, 276, 0,
-	432, 395, 207, 367, 257, 197, 224, 211, 231, 245,
-	247, 280, 309, 315, 344, 347, 262, 242, 222, 364,
-	220, 381, 401, 402, 403, 405, 313, 238, 331, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 0, 0,
-	0, 0, 0, 289, 0, 0, 0, 345, 0, 382,
-	227, 298, 296, 410, 251, 244, 240, 226, 273, 304,
-	343, 400, 337, 0, 293, 0, 0, 391, 316, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 225, 195, 328, 392, 255,
-	0, 0, 0, 177, 178, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 217, 0, 223, 0, 0, 0,
-	0, 237, 277, 243, 236, 407, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
-	317, 0, 185, 0, 438, 0, 0, 0, 0, 0,
-	0, 0, 0, 288, 0, 285, 191, 205, 0, 0,
-	327, 366, 372, 0, 0, 0, 228, 0, 370, 341,
-	424, 213, 253, 363, 346, 368, 0, 0, 369, 294,
-	412, 358, 422, 439, 440, 235, 321, 430, 404, 436,
-	448, 206, 232, 335, 397, 427, 388, 314, 408, 409,
-	284, 387, 261, 194, 292, 198, 399, 420, 218, 380,
-	0, 0, 0, 200, 418, 396, 311, 281, 282, 199,
-	0, 362, 239, 259, 230, 330, 415, 416, 229, 450,
-	208, 435, 202, 209, 434, 323, 411, 419, 312, 303,
-	201, 417, 310, 302, 287, 249, 269, 356, 297, 357,
-	270, 319, 318, 320, 0, 196, 0, 393, 428, 451,
-	215, 0, 0, 406, 444, 447, 0, 359, 216, 260,
-	248, 355, 258, 290, 443, 445, 446, 214, 353, 266,
-	334, 423, 252, 431, 322, 210, 272, 389, 286, 295,
-	0, 0, 340, 371, 219, 426, 390, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 190, 203, 291,
-	0, 360, 256, 449, 433, 429, 0, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 192, 193, 204, 212, 221, 233, 246, 254, 264,
-	268, 271, 274, 275, 278, 283, 300, 305, 306, 307,
-	308, 324, 325, 326, 329, 332, 333, 336, 338, 339,
-	342, 348, 349, 350, 351, 352, 354, 361, 365, 373,
-	374, 375, 376, 377, 378, 379, 383, 384, 385, 386,
-	394, 398, 413, 414, 425, 437, 441, 265, 421, 442,
-	0, 299, 0, 0, 301, 250, 267, 276, 0, 432,
-	395, 207, 367, 257, 197, 224, 211, 231, 245, 247,
-	280, 309, 315, 344, 347, 262, 242, 222, 364, 220,
-	381, 401, 402, 403, 405, 313, 238, 331, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 0, 0, 0,
-	0, 0, 289, 0, 0, 0, 345, 0, 382, 227,
-	298, 296, 410, 251, 244, 240, 226, 273, 304, 343,
-	400, 337, 0, 293, 0, 0, 391, 316, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 225, 195, 328, 392, 255, 0,
-	0, 0, 177, 178, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 217, 0, 223, 0, 0, 0, 0,
-	237, 277, 243, 236, 407, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 317,
-	0, 0, 0, 438, 0, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 285, 191, 205, 0, 0, 327,
-	366, 372, 0, 0, 0, 228, 0, 370, 341, 424,
-	213, 253, 363, 346, 368, 0, 0, 369, 294, 412,
-	358, 422, 439, 440, 235, 321, 430, 404, 436, 448,
-	206, 232, 335, 397, 427, 388, 314, 408, 409, 284,
-	387, 261, 194, 292, 198, 399, 420, 218, 380, 0,
-	0, 0, 200, 418, 396, 311, 281, 282, 199, 0,
-	362, 239, 259, 230, 330, 415, 416, 229, 450, 208,
-	435, 202, 209, 434, 323, 411, 419, 312, 303, 201,
-	417, 310, 302, 287, 249, 269, 356, 297, 357, 270,
-	319, 318, 320, 0, 196, 0, 393, 428, 451, 215,
-	0, 0, 406, 444, 447, 0, 359, 216, 260, 248,
-	355, 258, 290, 443, 445, 446, 214, 353, 266, 334,
-	423, 252, 431, 322, 210, 272, 389, 286, 295, 0,
-	0, 340, 371, 219, 426, 390, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 190, 203, 291, 0,
-	360, 256, 449, 433, 429, 0, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	192, 193, 204, 212, 221, 233, 246, 254, 264, 268,
-	271, 274, 275, 278, 283, 300, 305, 306, 307, 308,
-	324, 325, 326, 329, 332, 333, 336, 338, 339, 342,
-	348, 349, 350, 351, 352, 354, 361, 365, 373, 374,
-	375, 376, 377, 378, 379, 383, 384, 385, 386, 394,
-	398, 413, 414, 425, 437, 441, 265, 421, 442, 0,
-	299, 0, 0, 301, 250, 267, 276, 0, 432, 395,
-	207, 367, 257, 197, 224, 211, 231, 245, 247, 280,
-	309, 315, 344, 347, 262, 242, 222, 364, 220, 381,
-	401, 402, 403, 405, 313, 238,
+	205, 294, 0, 363, 259, 454, 436, 432, 0, 0,
+	237, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 194, 195, 206, 215, 224, 236, 249,
+	257, 267, 271, 274, 277, 278, 281, 286, 303, 308,
+	309, 310, 311, 327, 328, 329, 332, 335, 336, 339,
+	341, 342, 345, 351, 352, 353, 354, 355, 357, 364,
+	368, 376, 377, 378, 379, 380, 381, 382, 386, 387,
+	388, 389, 397, 401, 416, 417, 428, 440, 444, 268,
+	424, 445, 0, 302, 0, 0, 304, 253, 270, 279,
+	0, 435, 398, 210, 370, 260, 207, 446, 447, 199,
+	227, 214, 234, 248, 250, 283, 312, 318, 347, 350,
+	265, 245, 225, 367, 223, 384, 404, 405, 406, 408,
+	316, 241, 334, 0, 0, 0, 0, 0, 0, 0,
+	0, 244, 0, 0, 0, 0, 0, 292, 0, 0,
+	0, 348, 0, 385, 230, 301, 299, 413, 254, 247,
+	243, 229, 276, 307, 346, 403, 340, 0, 296, 0,
+	0, 394, 319, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 228,
+	197, 331, 395, 258, 0, 0, 0, 179, 180, 181,
+	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
+	226, 0, 0, 0, 0, 240, 280, 246, 239, 410,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 266, 0, 320, 0, 0, 0, 441, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 288,
+	193, 208, 0, 0, 330, 369, 375, 0, 0, 0,
+	231, 0, 373, 344, 427, 216, 256, 366, 349, 371,
+	0, 0, 372, 297, 415, 361, 425, 442, 443, 238,
+	324, 433, 407, 439, 453, 209, 235, 338, 400, 430,
+	391, 317, 411, 412, 287, 390, 264, 196, 295, 200,
+	402, 423, 221, 383, 0, 0, 0, 202, 421, 399,
+	314, 284, 285, 201, 0, 365, 242, 262, 233, 333,
+	418, 419, 232, 455, 211, 438, 204, 212, 437, 326,
+	414, 422, 315, 306, 203, 420, 313, 305, 290, 252,
+	272, 359, 300, 360, 273, 322, 321, 323, 0, 198,
+	0, 396, 431, 456, 218, 0, 0, 409, 449, 452,
+	0, 362, 219, 263, 251, 358, 261, 293, 448, 450,
+	451, 217, 356, 269, 337, 426, 255, 434, 325, 213,
+	275, 392, 289, 298, 0, 0, 343, 374, 222, 429,
+	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 192, 205, 294, 0, 363, 259, 454, 436, 432,
+	0, 0, 237, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 194, 195, 206, 215, 224,
+	236, 249, 257, 267, 271, 274, 277, 278, 281, 286,
+	303, 308, 309, 310, 311, 327, 328, 329, 332, 335,
+	336, 339, 341, 342, 345, 351, 352, 353, 354, 355,
+	357, 364, 368, 376, 377, 378, 379, 380, 381, 382,
+	386, 387, 388, 389, 397, 401, 416, 417, 428, 440,
+	444, 268, 424, 445, 0, 302, 0, 0, 304, 253,
+	270, 279, 0, 435, 398, 210, 370, 260, 207, 446,
+	447, 199, 227, 214, 234, 248, 250, 283, 312, 318,
+	347, 350, 265, 245, 225, 367, 223, 384, 404, 405,
+	406, 408, 316, 241,
 }
 
 var yyPact = [...]int{
-	4075, -1000, -339, 1534, -1000, -1000, -1000, -1000, -1000, -1000,
+	2468, -1000, -334, 1654, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1617, 1189, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 575, 1262, 140, 1530, 306, 279, 916, 341,
+	65, 27261, 331, 277, 27713, -1000, 111, -1000, 75, 27713,
+	110, 26809, -1000, -1000, -276, 12764, 1475, 10, 8, 27713,
+	-307, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1243,
+	1596, 1605, 1615, 1050, 1583, -1000, 10943, 10943, 282, 282,
+	282, 9135, -1000, -1000, 16845, 27713, 27713, 1288, 330, 916,
+	314, 311, 310, 280, -108, -1000, -1000, -1000, -1000, 1530,
+	-1000, -1000, 131, -1000, 224, 1173, -1000, 1170, -1000, 678,
+	460, 221, 293, 289, 220, 219, 218, 217, 216, 208,
+	207, 204, 228, -1000, 545, 545, -168, -171, 967, 272,
+	272, 272, 291, 1490, 1489, -1000, 453, -1000, 545, 545,
+	122, 545, 545, 545, 545, 175, 174, 545, 545, 545,
+	545, 545, 545, 545, 545, 545, 545, 545, 545, 545,
+	545, 545, 27713, -1000, 144, 591, 572, 1530, 171, -1000,
+	-1000, -1000, 27713, 329, 916, 278, 278, 27713, -1000, 466,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1499, 1161, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 545, 1169, 137, 1416, 275, 172, 928, 392, 119,
-	27059, 391, 113, 27508, -1000, 102, -1000, 94, 27508, 98,
-	26610, -1000, -1000, -278, 12658, 1343, 27, 14, 27508, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1185, 1452, 1468,
-	1495, 1011, 1407, -1000, 10849, 10849, 327, 327, 327, 9053,
-	-1000, -1000, 16712, 27508, 27508, 1176, 387, 928, 378, 376,
-	375, 314, -113, -1000, -1000, -1000, -1000, 1416, -1000, -1000,
-	170, -1000, 190, 1143, -1000, 1141, -1000, 569, 586, 247,
-	295, 283, 246, 245, 243, 242, 227, 224, 220, 219,
-	208, -1000, 511, 511, -171, -175, 1955, 304, 304, 304,
-	348, 1386, 1385, -1000, 548, -1000, 511, 511, 157, 511,
-	511, 511, 511, 165, 163, 511, 511, 511, 511, 511,
-	511, 511, 511, 511, 511, 511, 511, 511, 511, 511,
-	27508, -1000, 144, 512, 555, 1416, 158, -1000, -1000, -1000,
-	27508, 385, 928, 309, 309, 27508, -1000, 433, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4024,24 +4052,26 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 27713, 648, 648,
+	26, 648, 648, 648, 648, 648, 77, 456, 7, -1000,
+	51, 158, 155, 151, 640, 94, 66, -1000, -1000, 148,
+	82, -1000, 648, 7271, 7271, 7271, -1000, 1517, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 288, -1000, -1000, -1000,
+	-1000, 27713, 26357, 225, 571, -1000, -1000, -1000, 89, -1000,
+	-1000, 1021, 845, -1000, 12764, 3057, 1183, 1183, -1000, -1000,
+	382, -1000, -1000, 14120, 14120, 14120, 14120, 14120, 14120, 14120,
+	14120, 14120, 14120, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1183, 454, -1000,
+	12312, 1183, 1183, 1183, 1183, 1183, 1183, 1183, 1183, 12764,
+	1183, 1183, 1183, 1183, 1183, 1183, 1183, 1183, 1183, 1183,
+	1183, 1183, 1183, 1183, 1183, 1183, -1000, -1000, -1000, 27713,
+	-1000, 1183, 938, 1617, -1000, 1189, -1000, -1000, -1000, 1515,
+	12764, 12764, 1617, -1000, 1392, 10943, -1000, -1000, 1578, -1000,
+	-1000, -1000, -1000, 643, 1644, -1000, 15476, 446, 1643, 25905,
+	-1000, 19570, 25453, 1169, 8669, -38, -1000, -1000, -1000, 569,
+	18666, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 27508, 609, 609, 53, 609, 609, 609, 609,
-	85, 420, 13, -1000, 78, 151, 149, 155, 589, 123,
-	63, -1000, -1000, 153, 103, -1000, 609, 7201, 7201, 7201,
-	-1000, 1396, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	343, -1000, -1000, -1000, -1000, 27508, 26161, 258, 544, -1000,
-	-1000, -1000, 49, -1000, -1000, 1078, 753, -1000, 12658, 2116,
-	1146, 1146, -1000, -1000, 407, -1000, -1000, 14005, 14005, 14005,
-	14005, 14005, 14005, 14005, 14005, 14005, 14005, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1146, 432, -1000, 12209, 1146, 1146, 1146, 1146, 1146,
-	1146, 1146, 1146, 12658, 1146, 1146, 1146, 1146, 1146, 1146,
-	1146, 1146, 1146, 1146, 1146, 1146, 1146, 1146, 1146, 1146,
-	-1000, -1000, -1000, 27508, -1000, 1146, 1499, -1000, 1161, -1000,
-	-1000, -1000, 1401, 12658, 12658, 1499, -1000, 1279, 10849, -1000,
-	-1000, 1374, -1000, -1000, -1000, -1000, 701, 1513, -1000, 15352,
-	430, 1512, 25712, -1000, 19419, 25263, 1140, 8590, -61, -1000,
-	-1000, -1000, 540, 18521, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4053,311 +4083,285 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1517, 1121, 27713, -1000, -1000, 3308, 916, -1000,
+	1261, -1000, 1117, -1000, 1229, 144, 280, 1300, 916, 916,
+	916, 916, 601, -1000, -1000, -1000, 545, 545, 227, 306,
+	4125, -1000, -1000, -1000, 24994, 1259, 916, -1000, 1258, -1000,
+	1565, 269, 442, 442, 916, -1000, -1000, 27713, 916, 1564,
+	1563, 27713, 27713, -1000, 24542, -1000, 24090, 23638, 824, 27713,
+	23186, 22734, 22282, 21830, 21378, -1000, 1375, -1000, 1307, -1000,
+	-1000, -1000, 27713, 27713, 27713, 29, -1000, -1000, 27713, 916,
+	-1000, -1000, 823, 815, 545, 545, 809, 937, 927, 926,
+	545, 545, 804, 919, 1014, 164, 803, 798, 796, 818,
+	918, 127, 813, 786, 789, 27713, 1254, -1000, 137, 551,
+	188, 223, 187, 27713, 135, 1530, 1469, 1168, 287, 278,
+	1356, 27713, 1586, 916, -1000, 7737, -1000, -1000, 904, 12764,
+	-1000, 655, 640, 640, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 648, 27713, 655, -1000, -1000, -1000, 640, 648, 27713,
+	648, 648, 648, 648, 640, 648, 27713, 27713, 27713, 27713,
+	27713, 27713, 27713, 27713, 27713, 7271, 7271, 7271, 515, -1000,
+	1355, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 87, -1000,
+	-1000, -1000, -1000, -1000, 1654, -1000, -1000, -1000, -110, 1165,
+	20926, -1000, -281, -282, -286, -287, -1000, -1000, -1000, -288,
+	-289, -1000, -1000, -1000, 12764, 12764, 12764, 12764, 931, 521,
+	14120, 771, 744, 14120, 14120, 14120, 14120, 14120, 14120, 14120,
+	14120, 14120, 14120, 14120, 14120, 14120, 14120, 14120, 633, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 916, -1000, 1646,
+	1047, 1047, 479, 479, 479, 479, 479, 479, 479, 479,
+	479, 14572, 9587, 7737, 1050, 1115, 1617, 10943, 10943, 12764,
+	12764, 11847, 11395, 10943, 1529, 584, 845, 27713, -1000, -1000,
+	13668, -1000, -1000, -1000, -1000, -1000, 901, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 27713, 27713, 10943, 10943, 10943, 10943,
+	10943, -1000, 1148, -1000, -167, 16393, 12764, -1000, 1605, 1050,
+	1578, 1579, 1652, 511, 729, 1145, -1000, 874, 1605, 18214,
+	1202, -1000, 1578, -1000, -1000, -1000, 27713, -1000, -1000, 20474,
+	-1000, -1000, 6805, 27713, 202, 27713, -1000, 1162, 1518, -1000,
+	-1000, -1000, 1590, 17762, 27713, 1256, 1147, -1000, -1000, 417,
+	8203, -38, -1000, 8203, 1130, -1000, -54, -43, 10039, 413,
+	-1000, -1000, -1000, 967, 15024, 993, -1000, 21, -1000, -1000,
+	-1000, 1229, -1000, 1229, 1229, 1229, 1229, 29, 29, 29,
+	29, -1000, -1000, -1000, -1000, -1000, 1253, 1246, -1000, 1229,
+	1229, 1229, 1229, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1244, 1244, 1244, 1230, 1230, 260, -1000, 12764, 166, 27713,
+	1577, 784, 137, 27713, 1354, -1000, 27713, 1300, 1300, 1300,
+	-1000, 1585, 981, 925, -1000, 1142, -1000, -1000, 1614, -1000,
+	-1000, 563, 622, 620, 552, 27713, 120, 198, -1000, 251,
+	-1000, 27713, 1240, 1550, 442, 916, -1000, 916, -1000, -1000,
+	-1000, -1000, 405, -1000, -1000, 916, 1141, -1000, 1058, 722,
+	609, 647, 602, 1141, -1000, -1000, -151, 1141, -1000, 1141,
+	-1000, 1141, -1000, 1141, -1000, 1141, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 420, 27713, 120, 633, -1000, 286,
+	-1000, -1000, 633, 633, -1000, -1000, -1000, -1000, 899, 897,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1396, 1031, 27508, -1000, -1000,
-	3604, 928, -1000, 1168, -1000, 1029, -1000, 1150, 144, 314,
-	1199, 928, 928, 928, 928, 600, -1000, -1000, -1000, 511,
-	511, 202, 275, 4093, -1000, -1000, -1000, 24807, 1167, 928,
-	-1000, 1166, -1000, 1427, 292, 496, 496, 928, -1000, -1000,
-	27508, 928, 1426, 1425, 27508, 27508, -1000, 24358, -1000, 23909,
-	23460, 869, 27508, 23011, 22562, 22113, 21664, 21215, -1000, 1239,
-	-1000, 1136, -1000, -1000, -1000, 27508, 27508, 27508, 39, -1000,
-	-1000, 27508, 928, -1000, -1000, 847, 834, 511, 511, 812,
-	947, 945, 941, 511, 511, 800, 937, 921, 150, 787,
-	779, 761, 891, 936, 110, 867, 850, 756, 27508, 1162,
-	-1000, 134, 538, 181, 230, 199, 27508, 135, 1416, 1342,
-	1129, 341, 309, 1232, 27508, 1441, 928, -1000, 7664, -1000,
-	-1000, 933, 12658, -1000, 641, 589, 589, -1000, -1000, -1000,
-	-1000, -1000, -1000, 609, 27508, 641, -1000, -1000, -1000, 589,
-	609, 27508, 609, 609, 609, 609, 589, 609, 27508, 27508,
-	27508, 27508, 27508, 27508, 27508, 27508, 27508, 7201, 7201, 7201,
-	492, -1000, 1231, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	97, -1000, -1000, -1000, -1000, -1000, 1534, -1000, -1000, -1000,
-	-110, 1127, 20766, -1000, -282, -283, -285, -290, -1000, -1000,
-	-1000, -291, -298, -1000, -1000, -1000, 12658, 12658, 12658, 12658,
-	786, 499, 14005, 710, 633, 14005, 14005, 14005, 14005, 14005,
-	14005, 14005, 14005, 14005, 14005, 14005, 14005, 14005, 14005, 14005,
-	590, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 928,
-	-1000, 1522, 896, 896, 444, 444, 444, 444, 444, 444,
-	444, 444, 444, 14454, 9502, 7664, 1011, 1027, 1499, 10849,
-	10849, 12658, 12658, 11747, 11298, 10849, 1408, 565, 753, 27508,
-	-1000, -1000, 13556, -1000, -1000, -1000, -1000, -1000, 953, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 27508, 27508, 10849, 10849,
-	10849, 10849, 10849, -1000, 1126, -1000, -170, 16263, 12658, 1468,
-	1011, 1374, 1436, 1528, 461, 744, 1114, -1000, 729, 1468,
-	18072, 1135, -1000, 1374, -1000, -1000, -1000, 27508, -1000, -1000,
-	20317, -1000, -1000, 6738, 27508, 204, 27508, -1000, 1128, 1307,
-	-1000, -1000, -1000, 1449, 17623, 27508, 1071, 1045, -1000, -1000,
-	429, 8127, -61, -1000, 8127, 1099, -1000, -25, -51, 9951,
-	419, -1000, -1000, -1000, 1955, 14903, 1041, -1000, 35, -1000,
-	-1000, -1000, 1150, -1000, 1150, 1150, 1150, 1150, 39, 39,
-	39, 39, -1000, -1000, -1000, -1000, -1000, 1160, 1159, -1000,
-	1150, 1150, 1150, 1150, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1155, 1155, 1155, 1151, 1151, 285, -1000, 12658, 105,
-	27508, 1434, 755, 134, 27508, 1230, -1000, 27508, 1199, 1199,
-	1199, -1000, 1437, 914, 860, -1000, 1112, -1000, -1000, 1493,
-	-1000, -1000, 614, 637, 626, 484, 27508, 117, 193, -1000,
-	277, -1000, 27508, 1153, 1422, 496, 928, -1000, 928, -1000,
-	-1000, -1000, -1000, 425, -1000, -1000, 928, 1110, -1000, 1095,
-	671, 612, 670, 587, 1110, -1000, -1000, -139, 1110, -1000,
-	1110, -1000, 1110, -1000, 1110, -1000, 1110, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 550, 27508, 117, 590, -1000,
-	336, -1000, -1000, 590, 590, -1000, -1000, -1000, -1000, 932,
-	930, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -330, 27713, 300, 126,
+	184, 27713, 27713, 27713, 27713, 27713, 351, -1000, -1000, -1000,
+	168, 27713, 27713, 27713, 27713, 440, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 845, 27713, -1000, -1000, 648, 648, -1000,
+	-1000, 27713, 648, -1000, -1000, -1000, -1000, -1000, -1000, 648,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -332, 27508, 355,
-	122, 186, 27508, 27508, 27508, 27508, 27508, 402, -1000, -1000,
-	-1000, 159, 27508, 27508, 27508, 27508, 369, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 753, 27508, -1000, -1000, 609, 609,
-	-1000, -1000, 27508, 609, -1000, -1000, -1000, -1000, -1000, -1000,
-	609, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 929, 27508, 27508, -1000, -1000,
-	-1000, -1000, -1000, 93, -29, 183, -1000, -1000, -1000, -1000,
-	1464, -1000, 753, 499, 674, 583, -1000, -1000, 773, -1000,
-	-1000, 2664, -1000, -1000, -1000, -1000, 710, 14005, 14005, 14005,
-	1270, 2664, 2643, 1255, 2256, 444, 684, 684, 454, 454,
-	454, 454, 454, 831, 831, -1000, -1000, -1000, -1000, 953,
-	-1000, -1000, -1000, 953, 10849, 10849, 1107, 1146, 423, -1000,
-	1185, -1000, -1000, 1468, 996, 996, 722, 672, 595, 1511,
-	996, 564, 1510, 996, 996, 10849, -1000, -1000, 597, -1000,
-	12658, 953, -1000, 1518, 1102, 1100, 996, 953, 953, 996,
-	996, 27508, -1000, -273, -1000, -41, 450, 1146, -1000, 19868,
-	-1000, -1000, 953, 1078, 1401, -1000, -1000, 1375, -1000, 1285,
-	12658, 12658, 12658, -1000, -1000, -1000, 1401, 1469, -1000, 1295,
-	1294, 1505, 10849, 19419, 1374, -1000, -1000, -1000, 422, 1505,
-	1092, 1146, -1000, 27508, 19419, 19419, 19419, 19419, 19419, -1000,
-	1266, 1249, -1000, 1263, 1248, 1276, 27508, -1000, 1020, 1011,
-	17623, 204, 1076, 19419, 27508, -1000, -1000, 19419, 27508, 6275,
-	-1000, 1099, -61, -39, -1000, -1000, -1000, -1000, 753, -1000,
-	806, -1000, 2276, -1000, 340, -1000, -1000, -1000, -1000, 443,
-	32, -1000, -1000, 39, 39, -1000, -1000, 419, 525, 419,
-	419, 419, 927, 927, -1000, -1000, -1000, -1000, -1000, 723,
-	-1000, -1000, -1000, 718, -1000, -1000, 852, 1223, 105, -1000,
-	-1000, 511, 922, 1378, -1000, -1000, 1037, 352, -1000, 27508,
-	-1000, 1228, 1218, 1216, -1000, -1000, -1000, -1000, -1000, 3612,
-	27508, 1018, -1000, 108, 27508, 1021, 27508, -1000, 1014, 27508,
-	-1000, 928, -1000, -1000, 7664, -1000, 27508, 1146, -1000, -1000,
-	-1000, -1000, 383, 1413, 1411, 117, 108, 419, 928, -1000,
-	-1000, -1000, -1000, -1000, -335, 998, 27508, 133, -1000, 1152,
-	894, -1000, 1183, -1000, -1000, -1000, -1000, 114, 178, 161,
-	329, -1000, 366, 1223, 27508, -1000, -1000, -1000, 589, -1000,
-	-1000, 589, -1000, -1000, -1000, -1000, -1000, -1000, 1393, -34,
-	-309, -1000, -306, -1000, -1000, -1000, -1000, 1270, 2664, 2380,
-	-1000, 14005, 14005, -1000, -1000, 996, 996, 10849, 7664, 1499,
-	1401, -1000, -1000, 412, 590, 412, 14005, 14005, -1000, 14005,
-	14005, -1000, -129, 1101, 549, -1000, 12658, 749, -1000, -1000,
-	14005, 14005, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 373, 371, 363, 27508, -1000, -1000, -1000, 841, 920,
-	1277, 753, 753, -1000, -1000, 27508, -1000, -1000, -1000, -1000,
-	1503, 12658, -1000, 1097, -1000, 5812, 1468, 1205, 27508, 1146,
-	1534, 15814, 27508, 1080, -1000, 533, 1307, 1194, 1204, 1254,
-	-1000, -1000, -1000, -1000, 1247, -1000, 1246, -1000, -1000, -1000,
-	-1000, -1000, 1011, 1505, 19419, 1075, -1000, 1075, -1000, 415,
-	-1000, -1000, -1000, -38, -56, -1000, -1000, -1000, 1955, -1000,
-	-1000, -1000, 667, 14005, 1526, -1000, 915, 1420, -1000, 1418,
-	-1000, -1000, 419, 419, -1000, -1000, -1000, -1000, -1000, -1000,
-	987, -1000, 984, 1096, 982, 59, -1000, 1174, 1392, 511,
-	511, -1000, 712, -1000, 928, -1000, 27508, -1000, 27508, 27508,
-	27508, 1492, 1090, -1000, 27508, -1000, -1000, 27508, -1000, -1000,
-	1291, 105, 980, -1000, -1000, -1000, 193, 27508, -1000, 896,
-	108, -1000, -1000, -1000, -1000, -1000, -1000, 1148, -1000, -1000,
-	-1000, 1008, -1000, -140, 928, 27508, 27508, 27508, -1000, 27508,
-	-1000, -1000, -1000, 609, 609, -1000, 1391, -1000, 928, -1000,
-	14005, 2664, 2664, -1000, -1000, 953, -1000, 1468, -1000, 953,
-	1150, 1150, -1000, 1150, 1151, -1000, 1150, 84, 1150, 83,
-	953, 953, 2548, 2463, 2342, 2154, 1146, -120, -1000, 753,
-	12658, 2133, 1772, 1146, 1146, 1146, 973, 913, 39, -1000,
-	-1000, -1000, 1501, 1489, 753, -1000, -1000, -1000, 1409, 1034,
-	1069, -1000, -1000, 10400, 977, 1290, 414, 973, 1499, 27508,
-	12658, -1000, -1000, 12658, 1149, -1000, 12658, -1000, -1000, -1000,
-	1499, 1499, 1075, -1000, -1000, 453, -1000, -1000, -1000, -1000,
-	-1000, 2664, -80, -1000, -1000, -1000, -1000, -1000, 39, 905,
-	39, 711, -1000, 679, -1000, -1000, -213, -1000, -1000, 1091,
-	1222, -1000, -1000, 1148, -1000, -1000, -1000, 27508, 27508, -1000,
-	-1000, 188, -1000, 265, 971, -1000, -169, -1000, -1000, 1447,
-	27508, -1000, -1000, 7664, -1000, -1000, 1147, 1196, -1000, -1000,
-	-1000, -1000, -1000, -1000, 2664, -1000, 1401, -1000, -1000, 205,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 14005, 14005,
-	14005, 14005, 14005, 1468, 901, 753, 14005, 14005, 18970, 27508,
-	27508, 17161, 39, 6, -1000, 12658, 12658, 1402, -1000, 1146,
-	-1000, 1109, 27508, 1146, 27508, -1000, 1468, -1000, 753, 753,
-	27508, 753, 1468, -1000, -1000, 419, -1000, 419, 990, 963,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1443, 1090,
-	-1000, 173, 27508, -1000, 193, -1000, -177, -178, 1161, 969,
-	1082, -1000, 507, 27508, 27508, -1000, -1000, -1000, 1518, 1518,
-	1518, 1518, 502, 953, -1000, 1518, 1518, 967, -1000, 967,
-	967, 450, -267, -1000, 1339, 1336, 753, 1078, 1524, -1000,
-	1146, 1534, 411, 1069, -1000, -1000, 961, -1000, -1000, -1000,
-	-1000, -1000, 1161, 1146, 1145, -1000, -1000, -1000, 177, -1000,
-	7664, 5349, 959, -1000, -1000, -1000, -1000, -1000, 953, 169,
-	-157, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6, 269,
-	-1000, 1302, 1300, 1487, 27508, 1069, 27508, -1000, 177, 13107,
-	27508, -1000, -48, -1000, -1000, -1000, -1000, -1000, 1183, -1000,
-	1275, -134, -166, 1308, 1310, 1310, 1336, 1480, 1324, 1317,
-	-1000, 890, 1067, -1000, -1000, 1518, 953, 957, 280, -1000,
-	-1000, -140, -1000, 1274, -1000, 1306, 769, -1000, -1000, -1000,
-	-1000, 888, -1000, 1473, 1471, -1000, -1000, -1000, 1203, 136,
-	-1000, -146, -1000, 691, -1000, -1000, -1000, 887, 875, 1202,
-	-1000, 1509, -1000, -164, -1000, -1000, -1000, -1000, -1000, 1517,
-	469, 469, -167, -1000, -1000, -1000, 251, 741, -1000, -1000,
-	-1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 890, 27713, 27713, -1000, -1000, -1000,
+	-1000, -1000, 108, -66, 180, -1000, -1000, -1000, -1000, 1599,
+	-1000, 845, 521, 540, 595, -1000, -1000, 734, -1000, -1000,
+	1736, -1000, -1000, -1000, -1000, 771, 14120, 14120, 14120, 543,
+	1736, 2673, 989, 1018, 479, 663, 663, 486, 486, 486,
+	486, 486, 653, 653, -1000, -1000, -1000, -1000, 901, -1000,
+	-1000, -1000, 901, 10943, 10943, 1140, 1183, 404, -1000, 1243,
+	-1000, -1000, 1605, 1093, 1093, 868, 915, 624, 1642, 1093,
+	589, 1641, 1093, 1093, 10943, -1000, -1000, 598, -1000, 12764,
+	901, -1000, 1102, 1138, 1135, 1093, 901, 901, 1093, 1093,
+	27713, -1000, -268, -1000, -86, 373, 1183, -1000, 20022, -1000,
+	-1000, 901, 1021, 1515, -1000, -1000, 1451, -1000, 1394, 12764,
+	12764, 12764, -1000, -1000, -1000, 1515, 1602, -1000, 1423, 1422,
+	1636, 10943, 19570, 1578, -1000, -1000, -1000, 391, 1636, 1330,
+	1183, -1000, 27713, 19570, 19570, 19570, 19570, 19570, -1000, 1380,
+	1373, -1000, 1377, 1374, 1390, 27713, -1000, 1108, 1050, 17762,
+	202, 1005, 19570, 27713, -1000, -1000, 19570, 27713, 6339, -1000,
+	1130, -38, -29, -1000, -1000, -1000, -1000, 845, -1000, 795,
+	-1000, 2213, -1000, 250, -1000, -1000, -1000, -1000, 615, 19,
+	-1000, -1000, 29, 29, -1000, -1000, 413, 666, 413, 413,
+	413, 886, 886, -1000, -1000, -1000, -1000, -1000, 740, -1000,
+	-1000, -1000, 738, -1000, -1000, 896, 1312, 166, -1000, -1000,
+	545, 883, 1480, -1000, -1000, 985, 290, -1000, 27713, -1000,
+	1353, 1340, 1339, -1000, -1000, -1000, -1000, -1000, 2579, 27713,
+	1104, -1000, 118, 27713, 980, 27713, -1000, 1098, 27713, -1000,
+	916, -1000, -1000, 7737, -1000, 27713, 1183, -1000, -1000, -1000,
+	-1000, 320, 1524, 1522, 120, 118, 413, 916, -1000, -1000,
+	-1000, -1000, -1000, -339, 1096, 27713, 133, -1000, 1239, 871,
+	-1000, 1209, -1000, -1000, -1000, -1000, 101, 186, 165, 284,
+	-1000, 374, 1312, 27713, -1000, -1000, -1000, 640, -1000, -1000,
+	640, -1000, -1000, -1000, -1000, -1000, -1000, 1514, -70, -303,
+	-1000, -299, -1000, -1000, -1000, -1000, 543, 1736, 1219, -1000,
+	14120, 14120, -1000, -1000, 1093, 1093, 10943, 7737, 1617, 1515,
+	-1000, -1000, 406, 633, 406, 14120, 14120, -1000, 14120, 14120,
+	-1000, -120, 1091, 553, -1000, 12764, 752, -1000, -1000, 14120,
+	14120, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	309, 308, 305, 27713, -1000, -1000, -1000, 869, 880, 1388,
+	845, 845, -1000, -1000, 27713, -1000, -1000, -1000, -1000, 1632,
+	12764, -1000, 1129, -1000, 5873, 1605, 1335, 27713, 1183, 1654,
+	15941, 27713, 1133, -1000, 548, 1518, 1296, 1315, 1467, -1000,
+	-1000, -1000, -1000, 1367, -1000, 1344, -1000, -1000, -1000, -1000,
+	-1000, 1050, 1636, 19570, 1131, -1000, 1131, -1000, 388, -1000,
+	-1000, -1000, -73, -94, -1000, -1000, -1000, 967, -1000, -1000,
+	-1000, 635, 14120, 1651, -1000, 875, 1548, -1000, 1545, -1000,
+	-1000, 413, 413, -1000, -1000, -1000, -1000, -1000, -1000, 1089,
+	-1000, 1086, 1128, 1084, 56, -1000, 1283, 1497, 545, 545,
+	-1000, 719, -1000, 916, -1000, 27713, -1000, 27713, 27713, 27713,
+	1613, 1036, -1000, 27713, -1000, -1000, 27713, -1000, -1000, 1420,
+	166, 1029, -1000, -1000, -1000, 198, 27713, -1000, 1047, 118,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1206, -1000, -1000, -1000,
+	974, -1000, -152, 916, 27713, 27713, 27713, -1000, 27713, -1000,
+	-1000, -1000, 648, 648, -1000, 1493, -1000, 916, -1000, 14120,
+	1736, 1736, -1000, -1000, 901, -1000, 1605, -1000, 901, 1229,
+	1229, -1000, 1229, 1230, -1000, 1229, 68, 1229, 64, 901,
+	901, 2320, 2279, 2095, 1649, 1183, -115, -1000, 845, 12764,
+	1332, 1263, 1183, 1183, 1183, 1023, 873, 29, -1000, -1000,
+	-1000, 1622, 1612, 845, -1000, -1000, -1000, 1571, 962, 1015,
+	-1000, -1000, 10491, 1027, 1403, 387, 1023, 1617, 27713, 12764,
+	-1000, -1000, 12764, 1228, -1000, 12764, -1000, -1000, -1000, 1617,
+	1617, 1131, -1000, -1000, 492, -1000, -1000, -1000, -1000, -1000,
+	1736, -72, -1000, -1000, -1000, -1000, -1000, 29, 861, 29,
+	718, -1000, 658, -1000, -1000, -211, -1000, -1000, 1302, 1334,
+	-1000, -1000, 1206, -1000, -1000, -1000, 27713, 27713, -1000, -1000,
+	193, -1000, 242, 1010, -1000, -169, -1000, -1000, 1589, 27713,
+	-1000, -1000, 7737, -1000, -1000, 1185, 1299, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1736, -1000, 1515, -1000, -1000, 285, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 14120, 14120, 14120,
+	14120, 14120, 1605, 860, 845, 14120, 14120, 19118, 27713, 27713,
+	17297, 29, 0, -1000, 12764, 12764, 1536, -1000, 1183, -1000,
+	1323, 27713, 1183, 27713, -1000, 1605, -1000, 845, 845, 27713,
+	845, 1605, -1000, -1000, 413, -1000, 413, 949, 945, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1588, 1036, -1000,
+	190, 27713, -1000, 198, -1000, -174, -175, 1189, 992, 1034,
+	-1000, 541, 27713, 27713, -1000, -1000, -1000, 1102, 1102, 1102,
+	1102, 194, 901, -1000, 1102, 1102, 977, -1000, 977, 977,
+	373, -263, -1000, 1471, 1461, 845, 1021, 1650, -1000, 1183,
+	1654, 362, 1015, -1000, -1000, 970, -1000, -1000, -1000, -1000,
+	-1000, 1189, 1183, 1175, -1000, -1000, -1000, 179, -1000, 7737,
+	5407, 965, -1000, -1000, -1000, -1000, -1000, 901, 129, -160,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 0, 253, -1000,
+	1427, 1425, 1611, 27713, 1015, 27713, -1000, 179, 13216, 27713,
+	-1000, -50, -1000, -1000, -1000, -1000, -1000, 1209, -1000, 1387,
+	-146, -162, 1433, 1440, 1440, 1461, 1610, 1452, 1455, -1000,
+	848, 1008, -1000, -1000, 1102, 901, 960, 258, -1000, -1000,
+	-152, -1000, 1379, -1000, 1431, 768, -1000, -1000, -1000, -1000,
+	846, -1000, 1609, 1607, -1000, -1000, -1000, 1306, 130, -1000,
+	-155, -1000, 693, -1000, -1000, -1000, 844, 843, 1305, -1000,
+	1640, -1000, -166, -1000, -1000, -1000, -1000, -1000, 1647, 416,
+	416, -163, -1000, -1000, -1000, 255, 748, -1000, -1000, -1000,
+	-1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 1788, 1787, 14, 82, 79, 1786, 1785, 1783, 1782,
-	130, 125, 122, 1781, 1779, 1777, 1775, 1774, 1773, 1772,
-	1770, 1769, 1766, 1765, 1764, 62, 120, 38, 40, 124,
-	1763, 1762, 45, 1761, 1760, 1759, 127, 126, 470, 1758,
-	128, 1757, 1755, 1754, 1753, 1748, 1747, 1746, 1744, 1743,
-	1742, 1741, 1740, 1737, 151, 1736, 1735, 9, 1734, 47,
-	1733, 1730, 1725, 1720, 1719, 84, 1718, 1715, 1714, 111,
-	1711, 1710, 44, 336, 49, 72, 1709, 1705, 80, 770,
-	1704, 100, 119, 1703, 908, 1702, 39, 97, 85, 1701,
-	41, 1700, 1698, 102, 1697, 1694, 1693, 68, 1691, 1690,
-	3134, 1689, 67, 75, 19, 32, 1686, 1683, 1682, 1680,
-	33, 162, 1679, 1677, 28, 1676, 1675, 146, 1674, 81,
-	27, 1673, 16, 18, 21, 1671, 83, 1668, 8, 50,
-	31, 1667, 77, 1666, 1664, 1662, 1660, 25, 1645, 73,
-	101, 22, 1644, 1643, 4, 11, 1642, 1641, 1640, 1638,
-	1636, 1635, 10, 1634, 1633, 1631, 56, 1630, 6, 24,
-	71, 116, 29, 7, 1629, 143, 1626, 26, 114, 65,
-	108, 1624, 1623, 1622, 849, 55, 134, 1621, 1620, 46,
-	1619, 118, 117, 1617, 1362, 1616, 1615, 78, 1271, 2560,
-	13, 109, 1614, 1611, 1627, 52, 74, 23, 1610, 1609,
-	1608, 121, 112, 63, 863, 42, 1606, 1605, 1604, 1603,
-	1602, 1597, 1596, 87, 34, 20, 105, 30, 1595, 1594,
-	1593, 66, 37, 1592, 107, 106, 70, 86, 1591, 115,
-	96, 57, 1590, 60, 1589, 1588, 1581, 1579, 43, 1576,
-	1574, 1573, 1570, 104, 88, 64, 35, 1569, 36, 91,
-	99, 89, 1567, 17, 123, 12, 1565, 3, 0, 1557,
-	5, 138, 1368, 113, 1555, 1553, 1, 1549, 2, 1547,
-	1546, 76, 1544, 1543, 1542, 1541, 3253, 1439, 110, 1540,
-	139,
+	0, 1920, 1919, 19, 83, 85, 1918, 1917, 1916, 1915,
+	134, 133, 129, 1913, 1912, 1911, 1909, 1908, 1905, 1904,
+	1902, 1901, 1900, 1899, 1898, 56, 121, 36, 39, 140,
+	1897, 1896, 46, 1895, 1894, 1893, 118, 114, 436, 1891,
+	115, 1890, 1888, 1885, 1882, 1880, 1877, 1863, 1862, 1861,
+	1859, 1857, 1856, 1855, 1850, 239, 1848, 1840, 12, 1839,
+	50, 1838, 1837, 1835, 1833, 1832, 86, 1831, 1830, 1829,
+	104, 1825, 1824, 45, 267, 40, 72, 1823, 1822, 69,
+	767, 1821, 106, 120, 1820, 51, 1819, 42, 89, 76,
+	1818, 37, 1817, 1815, 87, 1813, 1812, 1811, 68, 1807,
+	1805, 3341, 1803, 67, 80, 17, 38, 1802, 1799, 1798,
+	1797, 35, 2482, 1794, 1790, 27, 1777, 1775, 136, 1770,
+	84, 25, 1769, 16, 14, 23, 1768, 82, 1767, 11,
+	61, 30, 1766, 78, 1765, 1764, 1763, 1762, 41, 1761,
+	77, 109, 22, 1760, 1759, 6, 9, 1758, 1753, 1752,
+	1751, 1750, 1749, 4, 1747, 1746, 1745, 26, 1744, 8,
+	24, 71, 122, 28, 7, 1742, 124, 1741, 33, 125,
+	66, 107, 1740, 1736, 1734, 905, 65, 142, 1730, 1728,
+	53, 1727, 108, 113, 1726, 1491, 1723, 1722, 47, 1271,
+	2339, 13, 112, 1721, 1720, 2185, 64, 79, 21, 1718,
+	1717, 1716, 127, 123, 59, 814, 44, 1714, 1713, 1712,
+	1711, 1709, 1708, 1705, 135, 34, 18, 119, 29, 1701,
+	1700, 1699, 58, 73, 1698, 103, 90, 74, 111, 1697,
+	116, 93, 57, 1695, 43, 1694, 1693, 1691, 1690, 48,
+	1689, 1688, 1687, 1686, 88, 98, 63, 32, 1685, 31,
+	94, 99, 101, 1684, 15, 126, 10, 1683, 3, 0,
+	1680, 5, 132, 1502, 102, 1678, 1677, 2, 1676, 1,
+	1675, 1673, 81, 1670, 1667, 1665, 1664, 3272, 511, 110,
+	1663, 128,
 }
 
 var yyR1 = [...]int{
-	0, 274, 275, 275, 1, 1, 1, 1, 1, 1,
+	0, 275, 276, 276, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 258, 258, 258, 261, 261, 21,
-	50, 3, 3, 3, 3, 2, 2, 8, 9, 4,
-	5, 5, 10, 10, 61, 61, 11, 12, 12, 12,
-	12, 278, 278, 95, 95, 93, 93, 94, 94, 160,
-	160, 13, 14, 14, 170, 170, 169, 169, 169, 171,
-	171, 171, 171, 204, 204, 15, 15, 15, 15, 15,
-	70, 70, 260, 260, 259, 257, 257, 256, 256, 255,
-	23, 24, 33, 33, 33, 33, 34, 35, 262, 262,
-	234, 39, 39, 38, 38, 38, 38, 40, 40, 37,
-	37, 36, 36, 236, 236, 223, 223, 235, 235, 235,
-	235, 235, 235, 235, 222, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 206, 206, 206, 206,
-	209, 209, 207, 207, 207, 207, 207, 207, 207, 207,
-	207, 208, 208, 208, 208, 208, 210, 210, 210, 210,
-	210, 211, 211, 211, 211, 211, 211, 211, 211, 211,
-	211, 211, 211, 211, 211, 211, 212, 212, 212, 212,
-	212, 212, 212, 212, 221, 221, 213, 213, 216, 216,
-	217, 217, 217, 218, 218, 219, 219, 214, 214, 214,
-	215, 215, 215, 224, 248, 248, 247, 247, 245, 245,
-	245, 245, 233, 233, 242, 242, 242, 242, 242, 232,
-	232, 228, 228, 228, 229, 229, 230, 230, 227, 227,
-	231, 231, 244, 244, 243, 225, 225, 226, 226, 250,
-	250, 250, 250, 251, 267, 268, 266, 266, 266, 266,
-	266, 59, 59, 59, 183, 183, 183, 240, 240, 239,
-	239, 239, 241, 241, 238, 238, 238, 238, 238, 238,
-	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
-	238, 238, 238, 238, 238, 238, 238, 238, 238, 238,
-	238, 238, 238, 178, 178, 178, 265, 265, 265, 265,
-	265, 265, 264, 264, 264, 237, 237, 237, 263, 263,
-	129, 129, 130, 130, 30, 30, 30, 30, 30, 30,
-	29, 29, 29, 25, 25, 25, 25, 25, 25, 25,
+	1, 1, 1, 1, 1, 259, 259, 259, 262, 262,
+	21, 50, 3, 3, 3, 3, 2, 2, 8, 9,
+	4, 5, 5, 10, 10, 62, 62, 11, 12, 12,
+	12, 12, 279, 279, 96, 96, 94, 94, 95, 95,
+	161, 161, 13, 14, 14, 171, 171, 170, 170, 170,
+	172, 172, 172, 172, 205, 205, 15, 15, 15, 15,
+	15, 71, 71, 261, 261, 260, 258, 258, 257, 257,
+	256, 23, 24, 33, 33, 33, 33, 34, 35, 263,
+	263, 235, 39, 39, 38, 38, 38, 38, 40, 40,
+	37, 37, 36, 36, 237, 237, 224, 224, 236, 236,
+	236, 236, 236, 236, 236, 223, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 207, 207, 207,
+	207, 210, 210, 208, 208, 208, 208, 208, 208, 208,
+	208, 208, 209, 209, 209, 209, 209, 211, 211, 211,
+	211, 211, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 212, 212, 212, 212, 212, 213, 213, 213,
+	213, 213, 213, 213, 213, 222, 222, 214, 214, 217,
+	217, 218, 218, 218, 219, 219, 220, 220, 215, 215,
+	215, 216, 216, 216, 225, 249, 249, 248, 248, 246,
+	246, 246, 246, 234, 234, 243, 243, 243, 243, 243,
+	233, 233, 229, 229, 229, 230, 230, 231, 231, 228,
+	228, 232, 232, 245, 245, 244, 226, 226, 227, 227,
+	251, 251, 251, 251, 252, 268, 269, 267, 267, 267,
+	267, 267, 60, 60, 60, 184, 184, 184, 241, 241,
+	240, 240, 240, 242, 242, 239, 239, 239, 239, 239,
+	239, 239, 239, 239, 239, 239, 239, 239, 239, 239,
+	239, 239, 239, 239, 239, 239, 239, 239, 239, 239,
+	239, 239, 239, 239, 179, 179, 179, 266, 266, 266,
+	266, 266, 266, 265, 265, 265, 238, 238, 238, 264,
+	264, 130, 130, 131, 131, 30, 30, 30, 30, 30,
+	30, 29, 29, 29, 25, 25, 25, 25, 25, 25,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 31, 31, 26, 26, 26,
-	26, 26, 26, 26, 26, 26, 16, 16, 16, 16,
+	25, 25, 25, 25, 25, 25, 31, 31, 26, 26,
+	26, 26, 26, 26, 26, 26, 26, 16, 16, 16,
 	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	16, 254, 254, 254, 254, 254, 254, 254, 254, 254,
-	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
-	254, 254, 254, 220, 220, 220, 252, 252, 253, 253,
-	17, 22, 22, 18, 18, 18, 18, 19, 19, 41,
+	16, 16, 255, 255, 255, 255, 255, 255, 255, 255,
+	255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
+	255, 255, 255, 255, 221, 221, 221, 253, 253, 254,
+	254, 17, 22, 22, 18, 18, 18, 18, 19, 19,
+	41, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 269, 269, 177, 177, 185, 185, 176,
-	176, 175, 175, 175, 179, 179, 179, 180, 180, 273,
-	273, 273, 43, 43, 45, 45, 46, 47, 47, 199,
-	199, 200, 200, 48, 49, 60, 60, 60, 60, 60,
-	60, 62, 62, 62, 7, 7, 7, 7, 56, 56,
-	56, 6, 6, 44, 44, 51, 270, 270, 271, 272,
-	272, 272, 272, 52, 20, 20, 20, 20, 20, 20,
-	77, 77, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 71, 71, 71, 66, 66, 279,
-	54, 55, 55, 69, 69, 69, 63, 63, 63, 68,
-	68, 68, 74, 74, 76, 76, 76, 76, 76, 78,
-	78, 78, 78, 78, 78, 73, 73, 75, 75, 75,
-	75, 192, 192, 192, 191, 191, 85, 85, 86, 86,
-	87, 87, 88, 88, 88, 127, 103, 103, 159, 159,
-	158, 158, 161, 161, 89, 89, 89, 89, 90, 90,
-	91, 91, 92, 92, 198, 198, 197, 197, 197, 196,
-	196, 96, 96, 96, 98, 97, 97, 97, 97, 99,
-	99, 101, 101, 100, 100, 102, 104, 104, 104, 104,
-	104, 105, 105, 84, 84, 84, 84, 84, 84, 84,
-	84, 173, 173, 107, 107, 106, 106, 106, 106, 106,
-	106, 106, 106, 106, 106, 118, 118, 118, 118, 118,
-	118, 108, 108, 108, 108, 108, 108, 108, 72, 72,
-	119, 119, 119, 126, 120, 120, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	115, 115, 115, 115, 113, 113, 113, 113, 113, 113,
-	113, 113, 113, 113, 113, 113, 113, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 280, 280, 117, 116, 116, 116, 116,
-	116, 116, 116, 67, 67, 67, 67, 67, 203, 203,
-	203, 205, 205, 205, 205, 205, 205, 205, 205, 205,
-	205, 205, 205, 205, 133, 133, 64, 64, 131, 131,
-	132, 134, 134, 128, 128, 128, 110, 110, 110, 110,
-	110, 110, 110, 110, 112, 112, 112, 135, 135, 136,
-	136, 137, 137, 138, 138, 139, 140, 140, 140, 141,
-	141, 141, 141, 32, 32, 32, 32, 32, 27, 27,
-	27, 27, 28, 28, 28, 79, 79, 79, 79, 81,
-	81, 80, 80, 57, 57, 58, 58, 58, 82, 82,
-	83, 83, 83, 83, 156, 156, 156, 142, 142, 142,
-	142, 148, 148, 148, 144, 144, 146, 146, 146, 147,
-	147, 147, 145, 151, 151, 153, 153, 152, 152, 150,
-	150, 155, 155, 154, 154, 149, 149, 109, 109, 109,
-	109, 109, 157, 157, 157, 157, 162, 162, 122, 122,
-	124, 124, 123, 125, 163, 163, 167, 164, 164, 168,
-	168, 168, 168, 168, 165, 165, 166, 166, 193, 193,
-	193, 172, 172, 184, 184, 181, 181, 182, 182, 174,
-	174, 186, 186, 186, 53, 121, 121, 249, 249, 246,
-	189, 189, 190, 190, 194, 194, 195, 195, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	42, 42, 42, 42, 42, 270, 270, 178, 178, 186,
+	186, 177, 177, 176, 176, 176, 180, 180, 180, 181,
+	181, 274, 274, 274, 43, 43, 45, 45, 46, 47,
+	47, 200, 200, 201, 201, 48, 49, 61, 61, 61,
+	61, 61, 61, 63, 63, 63, 7, 7, 7, 7,
+	57, 57, 57, 6, 6, 44, 44, 51, 271, 271,
+	272, 273, 273, 273, 273, 52, 20, 20, 20, 20,
+	20, 20, 78, 78, 66, 66, 66, 66, 66, 66,
+	66, 66, 66, 66, 66, 66, 72, 72, 72, 67,
+	67, 280, 55, 56, 56, 70, 70, 70, 64, 64,
+	64, 69, 69, 69, 75, 75, 77, 77, 77, 77,
+	77, 79, 79, 79, 79, 79, 79, 74, 74, 76,
+	76, 76, 76, 193, 193, 193, 192, 192, 86, 86,
+	87, 87, 88, 88, 89, 89, 89, 128, 104, 104,
+	160, 160, 159, 159, 162, 162, 90, 90, 90, 90,
+	91, 91, 92, 92, 93, 93, 199, 199, 198, 198,
+	198, 197, 197, 97, 97, 97, 99, 98, 98, 98,
+	98, 100, 100, 102, 102, 101, 101, 103, 105, 105,
+	105, 105, 105, 106, 106, 85, 85, 85, 85, 85,
+	85, 85, 85, 174, 174, 108, 108, 107, 107, 107,
+	107, 107, 107, 107, 107, 107, 107, 119, 119, 119,
+	119, 119, 119, 109, 109, 109, 109, 109, 109, 109,
+	73, 73, 120, 120, 120, 127, 121, 121, 112, 112,
+	112, 112, 112, 112, 112, 112, 112, 112, 112, 112,
+	112, 112, 112, 112, 112, 112, 112, 112, 112, 112,
+	112, 112, 112, 112, 112, 112, 112, 112, 112, 112,
+	112, 112, 116, 116, 116, 116, 114, 114, 114, 114,
+	114, 114, 114, 114, 114, 114, 114, 114, 114, 115,
+	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
+	115, 115, 115, 115, 115, 281, 281, 118, 117, 117,
+	117, 117, 117, 117, 117, 68, 68, 68, 68, 68,
+	204, 204, 204, 206, 206, 206, 206, 206, 206, 206,
+	206, 206, 206, 206, 206, 206, 134, 134, 65, 65,
+	132, 132, 133, 135, 135, 129, 129, 129, 111, 111,
+	111, 111, 111, 111, 111, 111, 113, 113, 113, 136,
+	136, 137, 137, 138, 138, 139, 139, 140, 141, 141,
+	141, 142, 142, 142, 142, 32, 32, 32, 32, 32,
+	27, 27, 27, 27, 28, 28, 28, 80, 80, 80,
+	80, 82, 82, 81, 81, 58, 58, 59, 59, 59,
+	83, 83, 84, 84, 84, 84, 157, 157, 157, 143,
+	143, 143, 143, 149, 149, 149, 145, 145, 147, 147,
+	147, 148, 148, 148, 146, 152, 152, 154, 154, 153,
+	153, 151, 151, 156, 156, 155, 155, 150, 150, 110,
+	110, 110, 110, 110, 158, 158, 158, 158, 163, 163,
+	123, 123, 125, 125, 124, 126, 164, 164, 168, 165,
+	165, 169, 169, 169, 169, 169, 166, 166, 167, 167,
+	194, 194, 194, 173, 173, 185, 185, 182, 182, 183,
+	183, 175, 175, 187, 187, 187, 53, 54, 122, 122,
+	250, 250, 247, 190, 190, 191, 191, 195, 195, 196,
+	196, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
@@ -4370,107 +4374,132 @@ var yyR1 = [...]int{
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 276, 277, 201, 202,
-	202, 202,
+	188, 188, 188, 188, 188, 188, 188, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 277, 278, 202, 203, 203, 203,
 }
 
 var yyR2 = [...]int{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 1, 1, 0, 1, 2,
-	3, 5, 6, 6, 7, 4, 6, 5, 7, 8,
-	1, 3, 7, 8, 1, 1, 9, 9, 8, 7,
-	7, 1, 1, 1, 3, 1, 3, 1, 3, 0,
-	4, 3, 5, 4, 1, 3, 3, 2, 2, 2,
-	2, 2, 1, 1, 1, 2, 2, 6, 11, 2,
-	0, 2, 0, 2, 1, 0, 2, 1, 3, 3,
-	5, 3, 6, 7, 7, 7, 4, 2, 1, 1,
-	4, 0, 1, 1, 1, 2, 2, 0, 1, 4,
-	4, 4, 4, 2, 4, 1, 3, 1, 1, 3,
-	4, 3, 3, 3, 3, 0, 2, 3, 3, 4,
-	2, 3, 3, 2, 3, 2, 3, 1, 1, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 2, 2, 2, 1, 2, 2, 2,
-	2, 4, 4, 2, 2, 3, 3, 3, 3, 1,
-	1, 1, 1, 1, 6, 6, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 3, 0, 3, 0, 5,
-	0, 3, 5, 0, 1, 0, 1, 0, 2, 2,
-	0, 2, 2, 5, 0, 1, 1, 2, 1, 3,
-	2, 3, 0, 1, 3, 3, 3, 4, 2, 0,
-	2, 1, 1, 1, 1, 1, 0, 1, 1, 1,
-	0, 1, 1, 3, 3, 3, 1, 3, 1, 10,
-	11, 11, 12, 5, 3, 3, 1, 1, 2, 2,
-	2, 0, 1, 1, 0, 1, 2, 0, 1, 1,
-	3, 2, 1, 2, 3, 3, 4, 4, 3, 3,
-	3, 3, 4, 4, 3, 3, 3, 3, 3, 3,
+	1, 1, 1, 1, 0, 1, 1, 1, 0, 1,
+	2, 3, 5, 6, 6, 7, 4, 6, 5, 7,
+	8, 1, 3, 7, 8, 1, 1, 9, 9, 8,
+	7, 7, 1, 1, 1, 3, 1, 3, 1, 3,
+	0, 4, 3, 5, 4, 1, 3, 3, 2, 2,
+	2, 2, 2, 1, 1, 1, 2, 2, 6, 11,
+	2, 0, 2, 0, 2, 1, 0, 2, 1, 3,
+	3, 5, 3, 6, 7, 7, 7, 4, 2, 1,
+	1, 4, 0, 1, 1, 1, 2, 2, 0, 1,
+	4, 4, 4, 4, 2, 4, 1, 3, 1, 1,
+	3, 4, 3, 3, 3, 3, 0, 2, 3, 3,
+	4, 2, 3, 3, 2, 3, 2, 3, 1, 1,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 2, 2, 2, 2, 1, 2, 2,
+	2, 2, 4, 4, 2, 2, 3, 3, 3, 3,
+	1, 1, 1, 1, 1, 6, 6, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 0, 3, 0,
+	5, 0, 3, 5, 0, 1, 0, 1, 0, 2,
+	2, 0, 2, 2, 5, 0, 1, 1, 2, 1,
+	3, 2, 3, 0, 1, 3, 3, 3, 4, 2,
+	0, 2, 1, 1, 1, 1, 1, 0, 1, 1,
+	1, 0, 1, 1, 3, 3, 3, 1, 3, 1,
+	10, 11, 11, 12, 5, 3, 3, 1, 1, 2,
+	2, 2, 0, 1, 1, 0, 1, 2, 0, 1,
+	1, 3, 2, 1, 2, 3, 3, 4, 4, 3,
+	3, 3, 3, 4, 4, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 4, 5, 0, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
-	0, 2, 0, 2, 0, 1, 5, 1, 3, 7,
-	1, 3, 3, 1, 2, 2, 2, 5, 5, 5,
-	6, 6, 5, 5, 2, 2, 2, 2, 3, 3,
-	3, 4, 1, 3, 5, 1, 3, 3, 3, 3,
-	3, 3, 3, 3, 2, 2, 2, 4, 4, 2,
-	10, 3, 6, 7, 5, 5, 5, 12, 7, 5,
-	9, 5, 3, 7, 4, 4, 4, 4, 3, 3,
-	3, 7, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 2, 0, 2, 2, 1, 3, 8, 8,
-	3, 3, 5, 6, 6, 5, 4, 3, 2, 3,
-	3, 3, 7, 3, 3, 3, 3, 4, 7, 5,
-	2, 4, 4, 4, 4, 4, 5, 5, 4, 4,
-	4, 4, 4, 4, 4, 4, 4, 4, 4, 2,
-	4, 2, 4, 5, 4, 3, 3, 5, 2, 3,
-	3, 3, 3, 1, 1, 0, 1, 0, 1, 1,
-	1, 0, 2, 2, 0, 2, 2, 0, 2, 0,
-	1, 1, 2, 1, 1, 2, 1, 1, 5, 0,
-	1, 0, 1, 2, 3, 0, 3, 3, 3, 3,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
-	1, 3, 3, 2, 2, 3, 1, 3, 2, 1,
-	2, 1, 2, 2, 3, 3, 6, 4, 7, 6,
-	1, 3, 2, 2, 2, 2, 1, 1, 1, 3,
-	2, 1, 1, 1, 0, 1, 1, 0, 3, 0,
-	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
-	1, 1, 0, 1, 0, 1, 2, 3, 4, 1,
-	1, 1, 1, 1, 1, 1, 3, 1, 2, 3,
-	5, 0, 1, 2, 1, 1, 0, 2, 1, 3,
-	1, 1, 1, 3, 3, 3, 3, 7, 0, 3,
-	1, 3, 1, 3, 4, 4, 4, 3, 2, 4,
-	0, 1, 0, 2, 0, 1, 0, 1, 2, 1,
-	1, 1, 2, 2, 1, 2, 3, 2, 3, 2,
-	2, 2, 1, 1, 3, 3, 0, 5, 4, 5,
-	5, 0, 2, 1, 3, 3, 3, 2, 3, 1,
-	2, 0, 3, 1, 1, 3, 3, 4, 4, 5,
-	3, 4, 5, 6, 2, 1, 2, 1, 2, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 0, 2,
-	1, 1, 1, 3, 1, 3, 1, 1, 1, 1,
-	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 3, 1, 1, 1, 1,
-	4, 5, 5, 6, 4, 4, 6, 6, 6, 8,
-	8, 8, 8, 9, 8, 5, 4, 2, 2, 2,
+	3, 3, 4, 5, 0, 2, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
+	1, 0, 2, 0, 2, 0, 1, 5, 1, 3,
+	7, 1, 3, 3, 1, 2, 2, 2, 5, 5,
+	5, 6, 6, 5, 5, 2, 2, 2, 2, 3,
+	3, 3, 4, 1, 3, 5, 1, 3, 3, 3,
+	3, 3, 3, 3, 3, 2, 2, 2, 4, 4,
+	2, 10, 3, 6, 7, 5, 5, 5, 12, 7,
+	5, 9, 5, 3, 7, 4, 4, 4, 4, 3,
+	3, 3, 7, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 2, 0, 2, 2, 1, 3, 8,
+	8, 3, 3, 5, 6, 6, 5, 4, 3, 2,
+	3, 3, 3, 7, 3, 3, 3, 3, 3, 4,
+	7, 5, 2, 4, 4, 4, 4, 4, 5, 5,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 2, 4, 2, 4, 5, 4, 3, 3, 5,
+	2, 3, 3, 3, 3, 1, 1, 0, 1, 0,
+	1, 1, 1, 0, 2, 2, 0, 2, 2, 0,
+	2, 0, 1, 1, 2, 1, 1, 2, 1, 1,
+	5, 0, 1, 0, 1, 2, 3, 0, 3, 3,
+	3, 3, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 1, 1, 3, 3, 2, 2, 3, 1, 3,
+	2, 1, 2, 1, 2, 2, 3, 3, 6, 4,
+	7, 6, 1, 3, 2, 2, 2, 2, 1, 1,
+	1, 3, 2, 1, 1, 1, 0, 1, 1, 0,
+	3, 0, 2, 0, 2, 1, 2, 2, 0, 1,
+	1, 0, 1, 1, 0, 1, 0, 1, 2, 3,
+	4, 1, 1, 1, 1, 1, 1, 1, 3, 1,
+	2, 3, 5, 0, 1, 2, 1, 1, 0, 2,
+	1, 3, 1, 1, 1, 3, 3, 3, 3, 7,
+	0, 3, 1, 3, 1, 3, 4, 4, 4, 3,
+	2, 4, 0, 1, 0, 2, 0, 1, 0, 1,
+	2, 1, 1, 1, 2, 2, 1, 2, 3, 2,
+	3, 2, 2, 2, 1, 1, 3, 3, 0, 5,
+	4, 5, 5, 0, 2, 1, 3, 3, 3, 2,
+	3, 1, 2, 0, 3, 1, 1, 3, 3, 4,
+	4, 5, 3, 4, 5, 6, 2, 1, 2, 1,
+	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	0, 2, 1, 1, 1, 3, 1, 3, 1, 1,
+	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 3, 1, 1,
+	1, 1, 4, 5, 5, 6, 4, 4, 6, 6,
+	6, 8, 8, 8, 8, 9, 8, 5, 4, 2,
 	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 8, 8, 0, 2, 3, 4, 4, 4, 4,
-	4, 4, 4, 0, 3, 4, 7, 3, 1, 1,
-	1, 2, 3, 3, 1, 2, 2, 1, 2, 1,
-	2, 2, 1, 2, 0, 1, 0, 2, 1, 2,
-	4, 0, 2, 1, 3, 5, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 0, 3, 0,
-	2, 0, 3, 1, 3, 2, 0, 1, 1, 0,
-	2, 4, 4, 0, 2, 2, 1, 1, 3, 3,
-	3, 3, 3, 3, 3, 0, 3, 3, 3, 0,
-	3, 1, 1, 0, 4, 0, 1, 1, 0, 3,
-	1, 3, 2, 1, 0, 2, 4, 0, 9, 3,
-	5, 0, 3, 3, 0, 1, 0, 2, 2, 0,
-	2, 2, 2, 0, 3, 0, 3, 0, 3, 0,
-	4, 0, 3, 0, 4, 0, 1, 2, 1, 5,
-	4, 4, 1, 3, 3, 5, 0, 5, 1, 3,
-	1, 2, 3, 1, 1, 3, 3, 1, 3, 3,
-	3, 3, 3, 2, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 0, 2, 0, 3, 0,
-	1, 0, 1, 1, 5, 0, 1, 0, 1, 2,
+	2, 2, 2, 8, 8, 0, 2, 3, 4, 4,
+	4, 4, 4, 4, 4, 0, 3, 4, 7, 3,
+	1, 1, 1, 2, 3, 3, 1, 2, 2, 1,
+	2, 1, 2, 2, 1, 2, 0, 1, 0, 2,
+	1, 2, 4, 0, 2, 1, 3, 5, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 0,
+	3, 0, 2, 0, 3, 1, 3, 2, 0, 1,
+	1, 0, 2, 4, 4, 0, 2, 2, 1, 1,
+	3, 3, 3, 3, 3, 3, 3, 0, 3, 3,
+	3, 0, 3, 1, 1, 0, 4, 0, 1, 1,
+	0, 3, 1, 3, 2, 1, 0, 2, 4, 0,
+	9, 3, 5, 0, 3, 3, 0, 1, 0, 2,
+	2, 0, 2, 2, 2, 0, 3, 0, 3, 0,
+	3, 0, 4, 0, 3, 0, 4, 0, 1, 2,
+	1, 5, 4, 4, 1, 3, 3, 5, 0, 5,
+	1, 3, 1, 2, 3, 1, 1, 3, 3, 1,
+	3, 3, 3, 3, 3, 2, 1, 2, 1, 1,
+	1, 1, 1, 1, 1, 0, 1, 0, 2, 0,
+	3, 0, 1, 0, 1, 1, 5, 3, 0, 1,
+	0, 1, 2, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -4511,460 +4540,461 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 0,
-	1, 1,
+	1, 1, 1, 1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -274, -1, -3, -8, -9, -10, -11, -12, -13,
+	-1000, -275, -1, -3, -8, -9, -10, -11, -12, -13,
 	-14, -15, -16, -17, -18, -19, -41, -42, -43, -45,
 	-46, -47, -48, -49, -6, -44, -20, -21, -50, -51,
-	-52, -53, -4, -276, 6, 7, 8, -61, 10, 11,
-	31, -23, -33, 153, -34, -24, 154, -35, 156, 155,
-	191, 157, 184, 71, 226, 227, 229, 230, 231, 232,
-	-62, 189, 190, 159, 35, 42, 32, 33, 36, 81,
-	9, 328, 186, 185, 26, -275, 468, -69, 5, -137,
-	16, -3, -54, -279, -54, -54, -54, -54, -54, -54,
-	-234, -236, 81, 126, 81, -70, -184, 164, 173, 172,
-	169, -262, 107, 219, 319, 162, -39, -38, -37, -36,
-	-40, 30, -30, -31, -254, -29, -26, 158, 155, 199,
-	102, 103, 191, 192, 193, 157, 175, 190, 194, 189,
-	208, -25, 77, 32, 341, 344, -241, 154, 160, 161,
-	329, 105, 104, 72, 156, -238, 276, 445, -40, 447,
-	95, 97, 446, 41, 164, 448, 449, 450, 451, 174,
-	452, 453, 454, 455, 461, 462, 463, 464, 106, 5,
-	163, -262, -79, 286, 77, -261, -258, 84, 85, 86,
-	163, -184, 164, 165, -262, 163, -100, -194, -258, -188,
-	338, 177, 372, 373, 224, 77, 276, 445, 226, 240,
-	234, 261, 253, 339, 374, 178, 212, 442, 251, 254,
-	306, 447, 375, 192, 298, 281, 289, 95, 229, 315,
-	460, 376, 458, 97, 446, 76, 48, 41, 187, 249,
-	245, 448, 213, 377, 349, 206, 105, 102, 467, 243,
-	47, 28, 457, 104, 46, 449, 378, 450, 291, 266,
-	436, 45, 303, 193, 379, 80, 343, 444, 293, 244,
-	290, 223, 456, 159, 380, 428, 300, 437, 381, 267,
-	271, 382, 307, 49, 383, 384, 438, 103, 385, 75,
-	451, 238, 239, 386, 221, 176, 309, 265, 174, 34,
-	294, 340, 225, 55, 200, 310, 43, 269, 42, 432,
-	387, 435, 264, 260, 50, 388, 389, 390, 391, 452,
-	263, 237, 259, 466, 218, 453, 59, 161, 273, 272,
-	274, 207, 305, 256, 392, 393, 394, 181, 78, 395,
-	246, 19, 396, 397, 301, 214, 398, 53, 399, 400,
-	313, 190, 401, 51, 454, 38, 195, 455, 402, 403,
-	404, 405, 406, 299, 407, 292, 268, 270, 202, 288,
-	342, 408, 242, 194, 459, 409, 182, 443, 196, 199,
-	189, 314, 183, 410, 411, 412, 413, 414, 415, 416,
-	230, 461, 40, 417, 418, 419, 420, 222, 217, 308,
-	317, 58, 79, 278, 421, 441, 236, 215, 422, 227,
-	52, 462, 463, 464, 209, 465, 284, 106, 219, 220,
-	44, 257, 201, 423, 424, 247, 248, 262, 235, 258,
-	228, 429, 203, 302, 191, 425, 316, 216, 279, 346,
-	208, 304, 440, 345, 255, 252, 210, 426, 165, 204,
-	205, 427, 430, 295, 285, 296, 297, 286, 211, 344,
-	250, 280, 163, -165, 281, -185, 282, 283, 294, 295,
-	300, -177, 301, 299, 202, -273, 307, 163, 302, 153,
-	144, 291, 292, 285, 286, 211, -269, -258, 450, 465,
-	306, 254, 287, 293, 308, 432, 297, 296, -194, 228,
-	-199, 233, -189, -258, -188, 231, -100, -60, 428, 157,
-	-201, -201, -71, 432, 434, -120, -84, -106, 110, -111,
-	30, 24, -110, -107, -128, -125, -126, 144, 145, 147,
-	146, 148, 133, 134, 141, 111, 149, -115, -113, -114,
-	-116, 88, 87, 96, 89, 90, 91, 92, 98, 99,
-	100, -189, -194, -123, -276, 65, 66, 329, 330, 331,
-	332, 337, 333, 113, 54, 318, 327, 326, 325, 322,
-	323, 320, 321, 335, 336, 168, 319, 162, 139, 328,
-	-258, -188, 41, 284, 284, -100, -5, -4, -276, 6,
-	21, 22, -141, 18, 17, -277, 83, -63, -76, 60,
-	61, -78, 22, 37, 64, 62, -55, -75, 135, -84,
-	-194, -75, -174, 167, -174, -174, -164, -204, 228, -168,
-	308, 307, -190, -166, -189, -187, -165, 305, 158, 347,
-	109, 23, 25, 112, 144, 17, 113, 36, 160, 175,
-	143, 171, 329, 153, 69, 348, 320, 321, 318, 324,
-	331, 332, 319, 282, 30, 11, 350, 26, 185, 22,
-	37, 137, 155, 116, 117, 188, 24, 186, 100, 353,
-	20, 72, 180, 12, 173, 14, 354, 355, 15, 168,
-	167, 128, 164, 67, 9, 149, 27, 125, 63, 356,
-	29, 357, 358, 359, 360, 65, 126, 18, 322, 323,
-	32, 433, 361, 337, 197, 139, 70, 56, 434, 110,
-	362, 363, 98, 364, 101, 73, 439, 107, 16, 68,
-	39, 365, 198, 366, 170, 367, 311, 368, 127, 156,
-	328, 66, 369, 162, 283, 6, 334, 31, 184, 172,
-	64, 370, 163, 115, 335, 336, 166, 99, 5, 169,
-	33, 10, 71, 74, 325, 326, 327, 54, 341, 114,
-	13, 371, 312, 108, 306, 254, -235, 126, -222, -226,
-	-189, 179, -251, 175, -100, -244, -243, -189, -79, 163,
-	-258, 164, 164, 164, -182, 168, 328, -36, -37, -165,
-	143, 196, 82, 82, -226, -225, -224, -263, 198, 179,
-	-250, -242, 171, 180, -232, 172, 173, -227, 164, 29,
-	-263, -227, 170, 180, 198, 198, 106, 198, 106, 198,
-	198, 198, 198, 198, 198, 198, 198, 198, 195, -233,
-	118, -233, 345, 345, -238, -263, -263, -263, 166, 34,
-	34, -186, -227, 166, 23, -233, -233, -165, 143, -233,
-	-233, -233, -233, 206, 206, -233, -233, -233, -233, -233,
-	-233, -233, -233, -233, -233, -233, -233, -233, -233, -233,
-	-100, -82, 213, 153, 155, 158, 73, 118, -38, 208,
-	-22, -100, 163, -258, -181, 168, -181, -100, 150, -100,
-	-179, 126, 13, -179, -176, 284, 288, 289, 290, -179,
-	-179, -179, -179, 209, 298, -228, 164, 34, 176, 284,
-	209, 298, 209, 210, 209, 210, 209, -175, 12, 128,
-	319, 303, 300, 202, 163, 203, 165, 304, -258, 435,
-	210, 284, 205, -179, -202, -276, -190, -202, -202, 31,
-	166, -189, -56, -189, 88, -7, -3, -11, -10, -12,
-	118, -77, 284, -65, 144, 450, 436, 437, 438, 435,
-	299, 443, 441, 439, 209, 440, 82, 109, 107, 108,
-	125, -84, -108, 128, 110, 126, 127, 112, 130, 129,
-	140, 133, 134, 135, 136, 137, 138, 139, 131, 132,
-	143, 118, 119, 120, 121, 122, 123, 124, -173, -276,
-	-126, -276, 151, 152, -111, -111, -111, -111, -111, -111,
-	-111, -111, -111, -111, -276, 150, -2, -120, -4, -276,
-	-276, -276, -276, -276, -276, -276, -276, -133, -84, -276,
-	-280, -117, -276, -280, -117, -280, -117, -280, -276, -280,
-	-117, -280, -117, -280, -280, -117, -276, -276, -276, -276,
-	-276, -276, -276, -201, -270, -271, -103, -100, -276, -137,
-	-3, -54, -156, 20, 32, -84, -138, -139, -84, -137,
-	56, -73, -75, -78, 60, 61, 94, 12, -192, -191,
-	23, -189, 88, 150, 12, -101, 27, -100, -86, -87,
-	-88, -89, -103, -127, -276, 12, -93, -94, -100, -102,
-	-194, 82, 228, -168, -204, -170, -169, 309, 311, 118,
-	-193, -189, 88, 30, 83, 82, -100, -206, -209, -211,
-	-210, -212, -207, -208, 251, 252, 144, 255, 257, 258,
-	259, 260, 261, 262, 263, 264, 265, 266, 31, 187,
-	247, 248, 249, 250, 267, 268, 269, 270, 271, 272,
-	273, 274, 234, 253, 339, 235, 236, 237, 238, 239,
-	240, 242, 243, 244, 245, 246, -261, -258, 81, 83,
-	82, -213, 81, -82, -182, -249, -246, 74, -258, -258,
-	-258, -258, 110, -233, -233, 195, -29, -26, -254, 16,
-	-25, -26, 158, 102, 103, 155, 81, -222, 81, -231,
-	-261, -258, 81, 29, 170, 169, -230, -227, -230, -231,
-	-258, -128, -189, -194, -258, 29, 29, -161, -189, -161,
-	-161, 21, -161, 21, -161, 21, 89, -189, -161, 21,
-	-161, 21, -161, 21, -161, 21, -161, 21, 30, 75,
-	76, 30, 78, 79, 80, -128, -128, -222, -165, -100,
-	-258, 89, 89, -233, -233, 89, 88, 88, 88, -233,
-	-233, 89, 88, -258, 88, -264, 181, 223, 225, 89,
-	89, 89, 89, 30, 88, -265, 30, 457, 456, 458,
-	459, 460, 89, 30, 89, 30, 89, -189, 81, -81,
-	215, 118, 204, 204, 163, 163, 217, -100, 216, 218,
-	220, 41, 82, 166, -181, 73, -95, -100, 24, -258,
-	-195, -194, -187, 88, -84, -229, 12, 128, -175, -175,
-	-179, -100, -229, -175, -179, -100, -179, -179, -179, -179,
-	-175, -179, -194, -194, -100, -100, -100, -100, -100, -100,
-	-100, -202, -202, -202, -180, 126, 73, -200, 231, 265,
-	429, 430, 431, 82, 341, -93, 435, 435, 435, 435,
-	435, 435, -84, -84, -84, -84, -118, 98, 110, 99,
-	100, -111, -119, -123, -126, 93, 128, 126, 127, 112,
-	-111, -111, -111, -111, -111, -111, -111, -111, -111, -111,
-	-111, -111, -111, -111, -111, -203, -258, 88, 144, -258,
-	-110, -110, -189, -74, 22, 37, -73, -190, -195, -187,
-	-69, -277, -277, -137, -73, -73, -84, -84, -128, 88,
-	-73, -128, 88, -73, -73, -68, 22, 37, -131, -132,
-	114, -128, -277, -111, -189, -189, -73, -74, -74, -73,
-	-73, 82, -272, 311, 312, 433, -197, 198, -196, 23,
-	-194, 88, -121, -120, -141, -277, -142, 27, 10, 128,
-	82, 19, 82, -140, 25, 26, -141, -112, -189, 89,
-	92, -85, 82, 12, -78, -100, -191, 135, -195, -100,
-	-160, 198, -100, 31, 82, -96, -98, -97, -99, 63,
-	67, 69, 64, 65, 66, 70, -198, 23, -86, -3,
-	-276, -100, -93, -278, 82, 12, 74, -278, 82, 150,
-	-168, -170, 82, 310, 312, 313, 73, 101, -84, -215,
-	143, -240, -239, -238, -222, -224, -225, -226, 83, -143,
-	-218, 279, -213, -213, -213, -213, -213, -214, -165, -214,
-	-214, -214, 81, 81, -213, -213, -213, -213, -216, 81,
-	-216, -216, -217, 81, -217, -251, -84, -248, -247, -245,
-	-246, 174, 95, 341, -243, -140, 89, -81, -100, 73,
-	-189, -249, -249, -249, 24, -258, 88, -258, 88, 82,
-	17, -223, -222, -129, 223, -253, 198, -250, -244, 81,
-	29, -230, -231, -231, 150, -258, 82, 27, 106, 106,
-	106, 106, 341, 155, 31, -222, -129, -203, 166, -203,
-	-203, 88, 88, -178, 465, -93, 165, 222, -83, 324,
-	88, 84, -100, -100, -100, -100, -100, 158, 155, 206,
-	-100, -100, -93, -100, 82, -59, 183, 178, -100, -179,
-	-179, -100, -179, -179, 88, -100, -189, -65, 311, 341,
-	20, -66, 20, 98, 99, 100, -119, -111, -111, -111,
-	-72, 188, 109, -277, -277, -73, -73, -276, 150, -5,
-	-141, -277, -277, 82, 74, 23, 12, 12, -277, 12,
-	12, -277, -277, -73, -134, -132, 116, -84, -277, -277,
-	82, 82, -277, -277, -277, -277, -277, -271, 432, 312,
-	-104, 71, 167, 72, -276, -196, -277, -156, 39, 47,
-	58, -84, -84, -139, -156, -172, 20, 12, 54, 54,
-	-105, 13, -75, -86, -78, 150, -105, -109, 31, 54,
-	-3, -276, -276, -163, -167, -128, -87, -88, -88, -87,
-	-88, 63, 63, 63, 68, 63, 68, 63, -97, -194,
-	-277, -277, -3, -160, 74, -86, -100, -86, -102, -194,
-	135, -169, -171, 314, 311, 317, -258, 88, 82, -238,
-	-226, 98, 110, 30, 73, 276, 95, 170, 29, 169,
-	-219, 280, -214, -214, -215, -258, 144, -215, -215, -215,
-	-221, 88, -221, 89, 89, 83, -32, -27, -28, 32,
-	77, -245, -233, 88, 38, 83, 165, -100, 73, 73,
-	73, 16, -158, -189, 82, 83, -130, 224, -128, 83,
-	-189, 83, -158, -231, -190, -189, -276, 163, 30, 30,
-	-129, -130, -215, -258, 467, 466, 83, -100, -80, 213,
-	221, 81, 85, -260, 74, 204, 276, 204, 207, 166,
-	-59, -32, -100, -175, -175, 32, 311, 444, 442, -72,
-	109, -111, -111, -277, -277, -74, -190, -137, -156, -205,
-	144, 251, 187, 249, 245, 265, 256, 278, 247, 279,
-	-203, -205, -111, -111, -111, -111, 338, -137, 117, -84,
-	115, -111, -111, 164, 164, 164, -161, 40, 88, 88,
-	59, -100, -135, 14, -84, 135, -141, -162, 73, -163,
-	-122, -124, -123, -276, -157, -277, -189, -161, -105, 82,
-	118, -91, -90, 73, 74, -92, 73, -90, 63, 63,
-	-277, -105, -86, -105, -105, 150, 311, 315, 316, -238,
-	98, -111, 10, 88, 29, 29, -215, -215, 83, 82,
-	83, 82, 83, 82, -183, 378, 110, -28, -27, -233,
-	-233, 89, -258, -100, -100, -100, -100, 17, 82, -222,
-	-128, 54, -248, 83, -252, -253, -100, -110, -130, -159,
-	81, 83, -257, 341, -259, -258, -189, -189, -189, -100,
-	-179, -179, 32, -258, -111, -277, -141, -277, -213, -213,
-	-213, -217, -213, 239, -213, 239, -277, -277, 20, 20,
-	20, 20, -276, -64, 334, -84, 82, 82, -276, -276,
-	-276, -277, 88, -214, -136, 15, 17, 28, -162, 82,
-	-277, -277, 82, 54, 150, -277, -137, -167, -84, -84,
-	81, -84, -137, -105, -114, -214, 88, -214, 89, 89,
-	378, 30, 78, 79, 80, 30, 75, 76, -159, -158,
-	-189, 200, 182, -277, 82, -220, 341, 344, 23, -158,
-	-256, -255, -190, 81, 74, -156, -214, -258, -111, -111,
-	-111, -111, -111, -141, 88, -111, -111, -158, -277, -158,
-	-158, -197, -214, -145, -150, -176, -84, -120, 29, -124,
-	54, -3, -189, -122, -189, -141, -158, -141, -215, -215,
-	83, 83, 23, 201, -100, -253, 345, 345, -3, 83,
-	82, 118, -158, -100, -277, -277, -277, -277, -67, 128,
-	341, -277, -277, -277, -277, -277, -277, -104, -148, 428,
-	-151, 43, -152, 44, 10, -122, 150, 83, -3, -276,
-	81, -57, 341, -255, -237, -190, 88, 89, 83, -277,
-	339, 70, 342, -145, 48, 257, -153, 52, -154, -149,
-	53, 17, -163, -189, -57, -111, 197, -158, -58, 212,
-	432, -260, 59, 340, 343, -146, 50, -144, 49, -144,
-	-152, 17, -155, 45, 46, 88, -277, -277, 83, 175,
-	-257, 59, -147, 51, 73, 101, 88, 17, 17, -267,
-	-268, 73, 214, 341, 73, 101, 88, 88, -268, 73,
-	11, 10, 342, -266, 183, 178, 181, 31, -266, 343,
-	177, 30, 98,
+	-52, -53, -54, -4, -277, 6, 7, 8, -62, 10,
+	11, 31, -23, -33, 153, -34, -24, 154, -35, 156,
+	155, 191, 157, 184, 71, 226, 227, 229, 230, 231,
+	232, -63, 189, 190, 159, 35, 42, 32, 33, 36,
+	445, 81, 9, 328, 186, 185, 26, -276, 471, -70,
+	5, -138, 16, -3, -55, -280, -55, -55, -55, -55,
+	-55, -55, -235, -237, 81, 126, 81, -71, -185, 164,
+	173, 172, 169, -263, 107, 219, 319, 162, -39, -38,
+	-37, -36, -40, 30, -30, -31, -255, -29, -26, 158,
+	155, 199, 102, 103, 191, 192, 193, 157, 175, 190,
+	194, 189, 208, -25, 77, 32, 341, 344, -242, 154,
+	160, 161, 329, 105, 104, 72, 156, -239, 276, 448,
+	-40, 450, 95, 97, 449, 41, 164, 451, 452, 453,
+	454, 174, 455, 456, 457, 458, 464, 465, 466, 467,
+	106, 5, 163, -263, -80, 286, 77, -262, -259, 84,
+	85, 86, 163, -185, 164, 165, -263, 163, -101, -195,
+	-259, -189, 338, 177, 372, 373, 224, 77, 276, 448,
+	226, 240, 234, 261, 253, 339, 374, 445, 178, 212,
+	442, 251, 254, 306, 450, 375, 192, 298, 281, 289,
+	95, 229, 315, 463, 376, 461, 97, 449, 76, 48,
+	41, 187, 249, 245, 451, 213, 377, 349, 206, 105,
+	102, 470, 243, 47, 28, 460, 104, 46, 452, 378,
+	453, 291, 266, 436, 45, 303, 193, 379, 80, 343,
+	444, 293, 244, 290, 223, 459, 159, 380, 428, 300,
+	437, 381, 267, 271, 382, 307, 49, 383, 384, 438,
+	103, 385, 75, 454, 238, 239, 386, 221, 176, 309,
+	265, 174, 34, 294, 340, 225, 55, 200, 310, 43,
+	269, 42, 432, 387, 435, 264, 260, 50, 388, 389,
+	390, 391, 455, 263, 237, 259, 469, 218, 456, 59,
+	161, 273, 272, 274, 207, 305, 256, 392, 393, 394,
+	181, 78, 395, 246, 19, 396, 397, 301, 214, 398,
+	53, 399, 400, 313, 190, 401, 51, 457, 38, 195,
+	458, 402, 403, 404, 405, 406, 299, 407, 292, 268,
+	270, 202, 288, 342, 408, 242, 194, 462, 409, 182,
+	443, 196, 199, 189, 314, 183, 410, 411, 412, 413,
+	414, 415, 416, 230, 464, 40, 417, 418, 419, 420,
+	222, 217, 308, 317, 58, 79, 278, 421, 441, 236,
+	215, 422, 227, 52, 465, 466, 467, 209, 468, 284,
+	106, 219, 220, 44, 257, 201, 423, 424, 247, 248,
+	262, 235, 258, 228, 429, 203, 302, 191, 425, 316,
+	216, 279, 346, 208, 304, 440, 345, 255, 252, 210,
+	426, 165, 204, 205, 427, 430, 446, 447, 295, 285,
+	296, 297, 286, 211, 344, 250, 280, 163, -166, 281,
+	-186, 282, 283, 294, 295, 447, 300, -178, 301, 299,
+	202, -274, 307, 163, 302, 153, 144, 291, 292, 285,
+	286, 211, -270, -259, 453, 468, 306, 254, 287, 293,
+	308, 432, 297, 296, -195, 228, -200, 233, -190, -259,
+	-189, 231, -101, -61, 428, 157, -202, -202, -72, 432,
+	434, -121, -85, -107, 110, -112, 30, 24, -111, -108,
+	-129, -126, -127, 144, 145, 147, 146, 148, 133, 134,
+	141, 111, 149, -116, -114, -115, -117, 88, 87, 96,
+	89, 90, 91, 92, 98, 99, 100, -190, -195, -124,
+	-277, 65, 66, 329, 330, 331, 332, 337, 333, 113,
+	54, 318, 327, 326, 325, 322, 323, 320, 321, 335,
+	336, 168, 319, 162, 139, 328, -259, -189, 41, 284,
+	284, -101, 446, -5, -4, -277, 6, 21, 22, -142,
+	18, 17, -278, 83, -64, -77, 60, 61, -79, 22,
+	37, 64, 62, -56, -76, 135, -85, -195, -76, -175,
+	167, -175, -175, -165, -205, 228, -169, 308, 307, -191,
+	-167, -190, -188, -166, 305, 158, 347, 109, 23, 25,
+	112, 144, 17, 113, 36, 160, 175, 143, 171, 329,
+	153, 69, 348, 320, 321, 318, 324, 331, 332, 319,
+	282, 30, 11, 350, 26, 185, 22, 37, 137, 155,
+	116, 117, 188, 24, 186, 100, 353, 20, 72, 180,
+	12, 173, 14, 354, 355, 15, 168, 167, 128, 164,
+	67, 9, 149, 27, 125, 63, 356, 29, 357, 358,
+	359, 360, 65, 126, 18, 322, 323, 32, 433, 361,
+	337, 197, 139, 70, 56, 434, 110, 362, 363, 98,
+	364, 101, 73, 439, 107, 16, 68, 39, 365, 198,
+	366, 170, 367, 311, 368, 127, 156, 328, 66, 369,
+	162, 283, 6, 334, 31, 184, 172, 64, 370, 163,
+	115, 335, 336, 166, 99, 5, 169, 33, 10, 71,
+	74, 325, 326, 327, 54, 341, 114, 13, 371, 312,
+	108, 306, 254, -236, 126, -223, -227, -190, 179, -252,
+	175, -101, -245, -244, -190, -80, 163, -259, 164, 164,
+	164, -183, 168, 328, -36, -37, -166, 143, 196, 82,
+	82, -227, -226, -225, -264, 198, 179, -251, -243, 171,
+	180, -233, 172, 173, -228, 164, 29, -264, -228, 170,
+	180, 198, 198, 106, 198, 106, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 195, -234, 118, -234, 345,
+	345, -239, -264, -264, -264, 166, 34, 34, -187, -228,
+	166, 23, -234, -234, -166, 143, -234, -234, -234, -234,
+	206, 206, -234, -234, -234, -234, -234, -234, -234, -234,
+	-234, -234, -234, -234, -234, -234, -234, -101, -83, 213,
+	153, 155, 158, 73, 118, -38, 208, -22, -101, 163,
+	-259, -182, 168, -182, -101, 150, -101, -180, 126, 13,
+	-180, -177, 284, 288, 289, 290, -180, -180, -180, -180,
+	-180, 209, 298, -229, 164, 34, 176, 284, 209, 298,
+	209, 210, 209, 210, 209, -176, 12, 128, 319, 303,
+	300, 202, 163, 203, 165, 304, -259, 435, 210, 284,
+	205, -180, -203, -277, -191, -203, -203, 31, 166, -190,
+	-57, -190, 88, -7, -3, -11, -10, -12, 118, -78,
+	284, -66, 144, 453, 436, 437, 438, 435, 299, 443,
+	441, 439, 209, 440, 82, 109, 107, 108, 125, -85,
+	-109, 128, 110, 126, 127, 112, 130, 129, 140, 133,
+	134, 135, 136, 137, 138, 139, 131, 132, 143, 118,
+	119, 120, 121, 122, 123, 124, -174, -277, -127, -277,
+	151, 152, -112, -112, -112, -112, -112, -112, -112, -112,
+	-112, -112, -277, 150, -2, -121, -4, -277, -277, -277,
+	-277, -277, -277, -277, -277, -134, -85, -277, -281, -118,
+	-277, -281, -118, -281, -118, -281, -277, -281, -118, -281,
+	-118, -281, -281, -118, -277, -277, -277, -277, -277, -277,
+	-277, -202, -271, -272, -104, -101, -277, 88, -138, -3,
+	-55, -157, 20, 32, -85, -139, -140, -85, -138, 56,
+	-74, -76, -79, 60, 61, 94, 12, -193, -192, 23,
+	-190, 88, 150, 12, -102, 27, -101, -87, -88, -89,
+	-90, -104, -128, -277, 12, -94, -95, -101, -103, -195,
+	82, 228, -169, -205, -171, -170, 309, 311, 118, -194,
+	-190, 88, 30, 83, 82, -101, -207, -210, -212, -211,
+	-213, -208, -209, 251, 252, 144, 255, 257, 258, 259,
+	260, 261, 262, 263, 264, 265, 266, 31, 187, 247,
+	248, 249, 250, 267, 268, 269, 270, 271, 272, 273,
+	274, 234, 253, 339, 235, 236, 237, 238, 239, 240,
+	242, 243, 244, 245, 246, -262, -259, 81, 83, 82,
+	-214, 81, -83, -183, -250, -247, 74, -259, -259, -259,
+	-259, 110, -234, -234, 195, -29, -26, -255, 16, -25,
+	-26, 158, 102, 103, 155, 81, -223, 81, -232, -262,
+	-259, 81, 29, 170, 169, -231, -228, -231, -232, -259,
+	-129, -190, -195, -259, 29, 29, -162, -190, -162, -162,
+	21, -162, 21, -162, 21, 89, -190, -162, 21, -162,
+	21, -162, 21, -162, 21, -162, 21, 30, 75, 76,
+	30, 78, 79, 80, -129, -129, -223, -166, -101, -259,
+	89, 89, -234, -234, 89, 88, 88, 88, -234, -234,
+	89, 88, -259, 88, -265, 181, 223, 225, 89, 89,
+	89, 89, 30, 88, -266, 30, 460, 459, 461, 462,
+	463, 89, 30, 89, 30, 89, -190, 81, -82, 215,
+	118, 204, 204, 163, 163, 217, -101, 216, 218, 220,
+	41, 82, 166, -182, 73, -96, -101, 24, -259, -196,
+	-195, -188, 88, -85, -230, 12, 128, -176, -176, -180,
+	-101, -230, -176, -180, -101, -180, -180, -180, -180, -176,
+	-180, -195, -195, -101, -101, -101, -101, -101, -101, -101,
+	-203, -203, -203, -181, 126, 73, -201, 231, 265, 429,
+	430, 431, 82, 341, -94, 435, 435, 435, 435, 435,
+	435, -85, -85, -85, -85, -119, 98, 110, 99, 100,
+	-112, -120, -124, -127, 93, 128, 126, 127, 112, -112,
+	-112, -112, -112, -112, -112, -112, -112, -112, -112, -112,
+	-112, -112, -112, -112, -204, -259, 88, 144, -259, -111,
+	-111, -190, -75, 22, 37, -74, -191, -196, -188, -70,
+	-278, -278, -138, -74, -74, -85, -85, -129, 88, -74,
+	-129, 88, -74, -74, -69, 22, 37, -132, -133, 114,
+	-129, -278, -112, -190, -190, -74, -75, -75, -74, -74,
+	82, -273, 311, 312, 433, -198, 198, -197, 23, -195,
+	88, -122, -121, -142, -278, -143, 27, 10, 128, 82,
+	19, 82, -141, 25, 26, -142, -113, -190, 89, 92,
+	-86, 82, 12, -79, -101, -192, 135, -196, -101, -161,
+	198, -101, 31, 82, -97, -99, -98, -100, 63, 67,
+	69, 64, 65, 66, 70, -199, 23, -87, -3, -277,
+	-101, -94, -279, 82, 12, 74, -279, 82, 150, -169,
+	-171, 82, 310, 312, 313, 73, 101, -85, -216, 143,
+	-241, -240, -239, -223, -225, -226, -227, 83, -144, -219,
+	279, -214, -214, -214, -214, -214, -215, -166, -215, -215,
+	-215, 81, 81, -214, -214, -214, -214, -217, 81, -217,
+	-217, -218, 81, -218, -252, -85, -249, -248, -246, -247,
+	174, 95, 341, -244, -141, 89, -82, -101, 73, -190,
+	-250, -250, -250, 24, -259, 88, -259, 88, 82, 17,
+	-224, -223, -130, 223, -254, 198, -251, -245, 81, 29,
+	-231, -232, -232, 150, -259, 82, 27, 106, 106, 106,
+	106, 341, 155, 31, -223, -130, -204, 166, -204, -204,
+	88, 88, -179, 468, -94, 165, 222, -84, 324, 88,
+	84, -101, -101, -101, -101, -101, 158, 155, 206, -101,
+	-101, -94, -101, 82, -60, 183, 178, -101, -180, -180,
+	-101, -180, -180, 88, -101, -190, -66, 311, 341, 20,
+	-67, 20, 98, 99, 100, -120, -112, -112, -112, -73,
+	188, 109, -278, -278, -74, -74, -277, 150, -5, -142,
+	-278, -278, 82, 74, 23, 12, 12, -278, 12, 12,
+	-278, -278, -74, -135, -133, 116, -85, -278, -278, 82,
+	82, -278, -278, -278, -278, -278, -272, 432, 312, -105,
+	71, 167, 72, -277, -197, -278, -157, 39, 47, 58,
+	-85, -85, -140, -157, -173, 20, 12, 54, 54, -106,
+	13, -76, -87, -79, 150, -106, -110, 31, 54, -3,
+	-277, -277, -164, -168, -129, -88, -89, -89, -88, -89,
+	63, 63, 63, 68, 63, 68, 63, -98, -195, -278,
+	-278, -3, -161, 74, -87, -101, -87, -103, -195, 135,
+	-170, -172, 314, 311, 317, -259, 88, 82, -239, -227,
+	98, 110, 30, 73, 276, 95, 170, 29, 169, -220,
+	280, -215, -215, -216, -259, 144, -216, -216, -216, -222,
+	88, -222, 89, 89, 83, -32, -27, -28, 32, 77,
+	-246, -234, 88, 38, 83, 165, -101, 73, 73, 73,
+	16, -159, -190, 82, 83, -131, 224, -129, 83, -190,
+	83, -159, -232, -191, -190, -277, 163, 30, 30, -130,
+	-131, -216, -259, 470, 469, 83, -101, -81, 213, 221,
+	81, 85, -261, 74, 204, 276, 204, 207, 166, -60,
+	-32, -101, -176, -176, 32, 311, 444, 442, -73, 109,
+	-112, -112, -278, -278, -75, -191, -138, -157, -206, 144,
+	251, 187, 249, 245, 265, 256, 278, 247, 279, -204,
+	-206, -112, -112, -112, -112, 338, -138, 117, -85, 115,
+	-112, -112, 164, 164, 164, -162, 40, 88, 88, 59,
+	-101, -136, 14, -85, 135, -142, -163, 73, -164, -123,
+	-125, -124, -277, -158, -278, -190, -162, -106, 82, 118,
+	-92, -91, 73, 74, -93, 73, -91, 63, 63, -278,
+	-106, -87, -106, -106, 150, 311, 315, 316, -239, 98,
+	-112, 10, 88, 29, 29, -216, -216, 83, 82, 83,
+	82, 83, 82, -184, 378, 110, -28, -27, -234, -234,
+	89, -259, -101, -101, -101, -101, 17, 82, -223, -129,
+	54, -249, 83, -253, -254, -101, -111, -131, -160, 81,
+	83, -258, 341, -260, -259, -190, -190, -190, -101, -180,
+	-180, 32, -259, -112, -278, -142, -278, -214, -214, -214,
+	-218, -214, 239, -214, 239, -278, -278, 20, 20, 20,
+	20, -277, -65, 334, -85, 82, 82, -277, -277, -277,
+	-278, 88, -215, -137, 15, 17, 28, -163, 82, -278,
+	-278, 82, 54, 150, -278, -138, -168, -85, -85, 81,
+	-85, -138, -106, -115, -215, 88, -215, 89, 89, 378,
+	30, 78, 79, 80, 30, 75, 76, -160, -159, -190,
+	200, 182, -278, 82, -221, 341, 344, 23, -159, -257,
+	-256, -191, 81, 74, -157, -215, -259, -112, -112, -112,
+	-112, -112, -142, 88, -112, -112, -159, -278, -159, -159,
+	-198, -215, -146, -151, -177, -85, -121, 29, -125, 54,
+	-3, -190, -123, -190, -142, -159, -142, -216, -216, 83,
+	83, 23, 201, -101, -254, 345, 345, -3, 83, 82,
+	118, -159, -101, -278, -278, -278, -278, -68, 128, 341,
+	-278, -278, -278, -278, -278, -278, -105, -149, 428, -152,
+	43, -153, 44, 10, -123, 150, 83, -3, -277, 81,
+	-58, 341, -256, -238, -191, 88, 89, 83, -278, 339,
+	70, 342, -146, 48, 257, -154, 52, -155, -150, 53,
+	17, -164, -190, -58, -112, 197, -159, -59, 212, 432,
+	-261, 59, 340, 343, -147, 50, -145, 49, -145, -153,
+	17, -156, 45, 46, 88, -278, -278, 83, 175, -258,
+	59, -148, 51, 73, 101, 88, 17, 17, -268, -269,
+	73, 214, 341, 73, 101, 88, 88, -269, 73, 11,
+	10, 342, -267, 183, 178, 181, 31, -267, 343, 177,
+	30, 98,
 }
 
 var yyDef = [...]int{
-	33, -2, 2, 4, 5, 6, 7, 8, 9, 10,
+	34, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 811, 0, 549, 549, 549, 549, 549, 549,
-	549, 0, 0, -2, -2, -2, 835, 37, 0, 923,
-	0, 0, -2, 483, 484, 0, 486, -2, 0, 0,
-	495, 1348, 1348, 544, 0, 0, 0, 0, 0, 1346,
-	54, 55, 501, 502, 503, 1, 3, 0, 553, 819,
-	0, 0, -2, 551, 0, 0, 929, 929, 929, 0,
-	85, 86, 0, 0, 0, 835, 0, 0, 0, 0,
-	0, 927, 0, 924, 108, 109, 89, -2, 113, 114,
-	0, 118, 366, 327, 369, 325, 355, -2, 318, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 330, 222, 222, 0, 0, -2, 318, 318, 318,
-	0, 0, 0, 352, 931, 272, 222, 222, 0, 222,
-	222, 222, 222, 0, 0, 222, 222, 222, 222, 222,
-	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
-	0, 107, 848, 0, 0, 117, 38, 34, 35, 36,
-	0, 0, 0, 925, 925, 0, 418, 633, 944, 945,
-	1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093,
-	1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103,
-	1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113,
-	1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123,
-	1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133,
-	1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143,
-	1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153,
-	1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163,
-	1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173,
-	1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183,
-	1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193,
-	1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203,
-	1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213,
-	1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223,
-	1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233,
-	1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243,
-	1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253,
-	1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263,
-	1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273,
-	1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283,
-	1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293,
-	1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303,
-	1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313,
-	1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323,
-	1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333,
-	1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343,
-	1344, 1345, 0, 474, 474, 0, 474, 474, 474, 474,
-	0, 0, 0, 430, 0, 0, 0, 0, 471, 0,
-	0, 449, 451, 0, 0, 458, 474, 1349, 1349, 1349,
-	914, 0, 468, 466, 480, 481, 463, 464, 482, 485,
-	0, 490, 493, 940, 941, 0, 508, 0, 1159, 500,
-	513, 514, 0, 545, 546, 39, 684, 643, 0, 649,
-	651, 0, 686, 687, 688, 689, 690, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 716, 717, 718,
-	719, 796, 797, 798, 799, 800, 801, 802, 803, 653,
-	654, 793, 0, 903, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 784, 0, 753, 753, 753, 753, 753,
-	753, 753, 753, 0, 0, 0, 0, 0, 0, 0,
-	-2, -2, 1348, 0, 523, 0, 811, 50, 0, 549,
-	554, 555, 854, 0, 0, 811, 1347, 0, 0, -2,
-	-2, 565, 571, 572, 573, 574, 550, 0, 577, 581,
-	0, 0, 0, 930, 0, 0, 71, 0, 1314, 907,
-	-2, -2, 0, 0, 942, 943, 916, -2, 948, 949,
-	950, 951, 952, 953, 954, 955, 956, 957, 958, 959,
-	960, 961, 962, 963, 964, 965, 966, 967, 968, 969,
-	970, 971, 972, 973, 974, 975, 976, 977, 978, 979,
-	980, 981, 982, 983, 984, 985, 986, 987, 988, 989,
-	990, 991, 992, 993, 994, 995, 996, 997, 998, 999,
-	1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009,
-	1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019,
-	1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029,
-	1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039,
-	1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
-	1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059,
-	1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069,
-	1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079,
-	1080, 1081, 1082, 1083, -2, 1103, 0, 0, 127, 128,
-	0, 37, 248, 0, 123, 0, 242, 196, 848, 927,
-	937, 0, 0, 0, 0, 0, 91, 115, 116, 222,
-	222, 0, 117, 117, 334, 335, 336, 0, 0, -2,
-	246, 0, 319, 0, 0, 236, 236, 240, 238, 239,
-	0, 0, 0, 0, 0, 0, 346, 0, 347, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 402, 0,
-	223, 0, 364, 365, 273, 0, 0, 0, 0, 344,
-	345, 0, 0, 932, 933, 0, 0, 222, 222, 0,
-	0, 0, 0, 222, 222, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	101, 839, 0, 0, 0, 0, 0, 0, -2, 0,
-	410, 0, 925, 0, 0, 0, 0, 417, 0, 419,
-	420, 0, 0, 421, 0, 471, 471, 469, 470, 423,
-	424, 425, 426, 474, 0, 0, 231, 232, 233, 471,
-	474, 0, 474, 474, 474, 474, 471, 474, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1349, 1349, 1349,
-	477, 455, 456, 459, 460, 1350, 1351, 461, 462, 915,
-	491, 494, 511, 509, 510, 512, 504, 505, 506, 507,
-	0, 524, 525, 530, 0, 0, 0, 0, 536, 537,
-	538, 0, 0, 541, 542, 543, 0, 0, 0, 0,
-	0, 647, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 672, 673, 674, 675, 676, 677, 650, 0,
-	664, 0, 0, 0, 706, 707, 708, 709, 710, 711,
-	712, 713, 714, 0, 562, 0, 0, 0, 811, 0,
-	0, 0, 0, 0, 0, 0, 559, 0, 785, 0,
-	737, 745, 0, 738, 746, 739, 747, 740, 0, 741,
-	748, 742, 749, 743, 744, 750, 0, 0, 0, 562,
-	562, 0, 0, 40, 515, 516, 0, 616, 935, 819,
-	0, 564, 857, 0, 0, 820, 812, 813, 816, 819,
-	0, 586, 575, 566, 569, 570, 552, 0, 578, 582,
-	0, 584, 585, 0, 0, 69, 0, 632, 0, 588,
-	590, 591, 592, 614, 0, 0, 0, 0, 65, 67,
-	633, 0, 1314, 913, 0, 73, 74, 0, 0, 0,
-	210, 918, 919, 920, -2, 229, 0, 135, 203, 147,
-	148, 149, 196, 151, 196, 196, 196, 196, 207, 207,
-	207, 207, 179, 180, 181, 182, 183, 0, 0, 166,
-	196, 196, 196, 196, 186, 187, 188, 189, 190, 191,
-	192, 193, 152, 153, 154, 155, 156, 157, 158, 159,
-	160, 198, 198, 198, 200, 200, 0, 38, 0, 214,
-	0, 816, 0, 839, 0, 0, 938, 0, 937, 937,
-	937, 106, 0, 0, 0, 367, 328, 356, 368, 0,
-	331, 332, -2, 0, 0, 318, 0, 320, 0, 230,
-	0, -2, 0, 0, 0, 236, 240, 237, 240, 228,
-	241, 348, 793, 0, 349, 350, 0, 382, 602, 0,
-	0, 0, 0, 0, 388, 389, 390, 0, 392, 393,
-	394, 395, 396, 397, 398, 399, 400, 401, 357, 358,
-	359, 360, 361, 362, 363, 0, 0, 320, 0, 353,
-	0, 274, 275, 0, 0, 278, 279, 280, 281, 0,
-	0, 284, 285, 286, 287, 288, 312, 313, 314, 289,
-	290, 291, 292, 293, 294, 295, 306, 307, 308, 309,
-	310, 311, 296, 297, 298, 299, 300, 303, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 836, 837,
-	838, 0, 0, 0, 0, 0, 261, 63, 926, 416,
-	634, 946, 947, 475, 476, 0, 234, 235, 474, 474,
-	427, 450, 0, 474, 431, 452, 432, 434, 433, 435,
-	474, 438, 472, 473, 439, 440, 441, 442, 443, 444,
-	445, 446, 447, 448, 454, 0, 0, 0, 492, 496,
-	497, 498, 499, 0, 0, 527, 532, 533, 534, 535,
-	547, 540, 685, 644, 645, 646, 648, 665, 0, 667,
-	669, 655, 656, 680, 681, 682, 0, 0, 0, 0,
-	678, 660, 0, 691, 692, 693, 694, 695, 696, 697,
-	698, 699, 700, 701, 702, 705, 768, 769, 770, 0,
-	703, 704, 715, 0, 0, 0, 563, 794, 0, -2,
-	0, 683, 902, 819, 0, 0, 0, 0, 688, 796,
-	0, 688, 796, 0, 0, 0, 560, 561, 791, 788,
-	0, 0, 754, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 518, 519, 521, 0, 636, 0, 617, 0,
-	619, 620, 0, 936, 854, 51, 41, 0, 855, 0,
-	0, 0, 0, 815, 817, 818, 854, 0, 804, 0,
-	0, 641, 0, 0, 567, 47, 583, 579, 0, 641,
-	0, 0, 631, 0, 0, 0, 0, 0, 0, 621,
-	0, 0, 624, 0, 0, 0, 0, 615, 0, 0,
-	0, -2, 0, 0, 0, 61, 62, 0, 0, 0,
-	908, 72, 0, 0, 77, 78, 909, 910, 911, 912,
-	0, 110, -2, 269, 129, 131, 132, 133, 124, 134,
-	205, 204, 150, 207, 207, 173, 174, 210, 0, 210,
-	210, 210, 0, 0, 167, 168, 169, 170, 161, 0,
-	162, 163, 164, 0, 165, 247, 0, 823, 215, 216,
-	218, 222, 0, 0, 243, 244, 0, 0, 100, 0,
-	939, 0, 0, 0, 928, 119, 120, 121, 122, 117,
-	0, 0, 125, 322, 0, 0, 0, 245, 0, 0,
-	224, 240, 225, 226, 0, 351, 0, 0, 384, 385,
-	386, 387, 0, 0, 0, 320, 322, 210, 0, 276,
-	277, 282, 283, 301, 0, 0, 0, 0, 849, 850,
-	0, 853, 92, 374, 376, 375, 379, 0, 0, 0,
-	0, 411, 261, 823, 0, 415, 262, 263, 471, 437,
-	453, 471, 429, 436, 478, 457, 488, 531, 0, 0,
-	0, 539, 0, 666, 668, 670, 657, 678, 661, 0,
-	658, 0, 0, 652, 720, 0, 0, 562, 0, 811,
-	854, 724, 725, 0, 0, 0, 0, 0, 761, 0,
-	0, 762, 0, 811, 0, 789, 0, 0, 736, 755,
-	0, 0, 756, 757, 758, 759, 760, 517, 520, 522,
-	596, 0, 0, 0, 0, 618, 934, 43, 0, 0,
-	0, 821, 822, 814, 42, 0, 921, 922, 805, 806,
-	807, 0, 576, 587, 568, 0, 819, 896, 0, 0,
-	888, 0, 0, 641, 904, 0, 589, 610, 612, 0,
-	607, 622, 623, 625, 0, 627, 0, 629, 630, 593,
-	594, 595, 0, 641, 0, 641, 66, 641, 68, 0,
-	635, 75, 76, 0, 0, 82, 211, 212, 117, 271,
-	130, 136, 0, 0, 0, 140, 0, 0, 143, 145,
-	146, 206, 210, 210, 175, 208, 209, 176, 177, 178,
-	0, 194, 0, 0, 0, 264, 87, 827, 826, 222,
-	222, 217, 0, 220, 0, 197, 0, 102, 0, 0,
-	0, 0, 326, 600, 0, 337, 338, 0, 321, 381,
-	0, 214, 0, 227, 794, 603, 0, 0, 339, 0,
-	322, 342, 343, 354, 304, 305, 302, 598, 840, 841,
-	842, 0, 852, 95, 0, 0, 0, 0, 372, 0,
-	413, 414, 64, 474, 474, 526, 0, 529, 0, 659,
-	0, 679, 662, 721, 722, 0, 795, 819, 45, 0,
-	196, 196, 774, 196, 200, 777, 196, 779, 196, 782,
-	0, 0, 0, 0, 0, 0, 0, 786, 735, 792,
-	0, 0, 0, 0, 0, 0, 0, 0, 207, 859,
-	856, 44, 809, 0, 642, 580, 48, 52, 0, 896,
-	887, 898, 900, 0, 0, 0, 892, 0, 811, 0,
-	0, 604, 611, 0, 0, 605, 0, 606, 626, 628,
-	-2, 811, 641, 59, 60, 0, 79, 80, 81, 270,
-	137, 138, 0, 141, 142, 144, 171, 172, 207, 0,
-	207, 0, 201, 0, 253, 265, 0, 824, 825, 0,
-	0, 219, 221, 598, 103, 104, 105, 0, 0, 126,
-	323, 0, 213, 0, 0, 406, 403, 340, 341, 0,
-	0, 851, 373, 0, 93, 94, 0, 0, 378, 412,
-	422, 428, 528, 548, 663, 723, 854, 726, 771, 207,
-	775, 776, 778, 780, 781, 783, 728, 727, 0, 0,
-	0, 0, 0, 819, 0, 790, 0, 0, 0, 0,
-	0, 616, 207, 879, 49, 0, 0, 0, 53, 0,
-	901, 0, 0, 0, 0, 70, 819, 905, 906, 608,
-	0, 613, 819, 58, 139, 210, 195, 210, 0, 0,
-	266, 828, 829, 830, 831, 832, 833, 834, 0, 329,
-	601, 0, 0, 383, 0, 391, 0, 0, 0, 0,
-	96, 97, 0, 0, 0, 46, 772, 773, 0, 0,
-	0, 0, 763, 0, 787, 0, 0, 0, 638, 0,
-	0, 636, 861, 860, 873, 877, 810, 808, 0, 899,
-	0, 891, 894, 890, 893, 56, 0, 57, 184, 185,
-	199, 202, 0, 0, 0, 407, 404, 405, 843, 599,
-	0, 0, 0, 380, 729, 731, 730, 732, 0, 0,
-	0, 734, 751, 752, 637, 639, 640, 597, 879, 0,
-	872, 875, -2, 0, 0, 889, 0, 609, 843, 0,
-	0, 370, 845, 98, 99, 315, 316, 317, 92, 733,
-	0, 0, 0, 866, 864, 864, 877, 0, 881, 0,
-	886, 0, 897, 895, 88, 0, 0, 0, 0, 846,
-	847, 95, 764, 0, 767, 869, 0, 862, 865, 863,
-	874, 0, 880, 0, 0, 878, 408, 409, 249, 0,
-	377, 765, 858, 0, 867, 868, 876, 0, 0, 250,
-	251, 0, 844, 0, 870, 871, 882, 884, 252, 0,
-	0, 0, 0, 254, 256, 257, 0, 0, 255, 766,
-	258, 259, 260,
+	31, 32, 33, 813, 0, 551, 551, 551, 551, 551,
+	551, 551, 0, 0, -2, -2, -2, 837, 38, 0,
+	925, 0, 0, -2, 485, 486, 0, 488, -2, 0,
+	0, 497, 1354, 1354, 546, 0, 0, 0, 0, 0,
+	0, 1352, 55, 56, 503, 504, 505, 1, 3, 0,
+	555, 821, 0, 0, -2, 553, 0, 0, 931, 931,
+	931, 0, 86, 87, 0, 0, 0, 837, 0, 0,
+	0, 0, 0, 929, 0, 926, 109, 110, 90, -2,
+	114, 115, 0, 119, 367, 328, 370, 326, 356, -2,
+	319, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 331, 223, 223, 0, 0, -2, 319,
+	319, 319, 0, 0, 0, 353, 933, 273, 223, 223,
+	0, 223, 223, 223, 223, 0, 0, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 0, 108, 850, 0, 0, 118, 39, 35,
+	36, 37, 0, 0, 0, 927, 927, 0, 419, 635,
+	947, 948, 1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094,
+	1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104,
+	1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114,
+	1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124,
+	1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134,
+	1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144,
+	1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154,
+	1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164,
+	1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174,
+	1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184,
+	1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194,
+	1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204,
+	1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214,
+	1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224,
+	1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234,
+	1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244,
+	1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254,
+	1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264,
+	1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274,
+	1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284,
+	1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294,
+	1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304,
+	1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314,
+	1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324,
+	1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334,
+	1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344,
+	1345, 1346, 1347, 1348, 1349, 1350, 1351, 0, 476, 476,
+	0, 476, 476, 476, 476, 476, 0, 0, 0, 432,
+	0, 0, 0, 0, 473, 0, 0, 451, 453, 0,
+	0, 460, 476, 1355, 1355, 1355, 916, 0, 470, 468,
+	482, 483, 465, 466, 484, 487, 0, 492, 495, 943,
+	944, 0, 510, 0, 1163, 502, 515, 516, 0, 547,
+	548, 40, 686, 645, 0, 651, 653, 0, 688, 689,
+	690, 691, 692, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 718, 719, 720, 721, 798, 799, 800,
+	801, 802, 803, 804, 805, 655, 656, 795, 0, 905,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 786,
+	0, 755, 755, 755, 755, 755, 755, 755, 755, 0,
+	0, 0, 0, 0, 0, 0, -2, -2, 1354, 0,
+	525, 0, 0, 813, 51, 0, 551, 556, 557, 856,
+	0, 0, 813, 1353, 0, 0, -2, -2, 567, 573,
+	574, 575, 576, 552, 0, 579, 583, 0, 0, 0,
+	932, 0, 0, 72, 0, 1318, 909, -2, -2, 0,
+	0, 945, 946, 918, -2, 951, 952, 953, 954, 955,
+	956, 957, 958, 959, 960, 961, 962, 963, 964, 965,
+	966, 967, 968, 969, 970, 971, 972, 973, 974, 975,
+	976, 977, 978, 979, 980, 981, 982, 983, 984, 985,
+	986, 987, 988, 989, 990, 991, 992, 993, 994, 995,
+	996, 997, 998, 999, 1000, 1001, 1002, 1003, 1004, 1005,
+	1006, 1007, 1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015,
+	1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025,
+	1026, 1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035,
+	1036, 1037, 1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045,
+	1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055,
+	1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065,
+	1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075,
+	1076, 1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085,
+	1086, -2, 1107, 0, 0, 128, 129, 0, 38, 249,
+	0, 124, 0, 243, 197, 850, 929, 940, 0, 0,
+	0, 0, 0, 92, 116, 117, 223, 223, 0, 118,
+	118, 335, 336, 337, 0, 0, -2, 247, 0, 320,
+	0, 0, 237, 237, 241, 239, 240, 0, 0, 0,
+	0, 0, 0, 347, 0, 348, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 403, 0, 224, 0, 365,
+	366, 274, 0, 0, 0, 0, 345, 346, 0, 0,
+	934, 935, 0, 0, 223, 223, 0, 0, 0, 0,
+	223, 223, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 102, 841, 0,
+	0, 0, 0, 0, 0, -2, 0, 411, 0, 927,
+	0, 0, 0, 0, 418, 0, 420, 421, 0, 0,
+	422, 0, 473, 473, 471, 472, 424, 425, 426, 427,
+	428, 476, 0, 0, 232, 233, 234, 473, 476, 0,
+	476, 476, 476, 476, 473, 476, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1355, 1355, 1355, 479, 457,
+	458, 461, 462, 1356, 1357, 463, 464, 917, 493, 496,
+	513, 511, 512, 514, 506, 507, 508, 509, 0, 526,
+	527, 532, 0, 0, 0, 0, 538, 539, 540, 0,
+	0, 543, 544, 545, 0, 0, 0, 0, 0, 649,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 673,
+	674, 675, 676, 677, 678, 679, 652, 0, 666, 0,
+	0, 0, 708, 709, 710, 711, 712, 713, 714, 715,
+	716, 0, 564, 0, 0, 0, 813, 0, 0, 0,
+	0, 0, 0, 0, 561, 0, 787, 0, 739, 747,
+	0, 740, 748, 741, 749, 742, 0, 743, 750, 744,
+	751, 745, 746, 752, 0, 0, 0, 564, 564, 0,
+	0, 41, 517, 518, 0, 618, 938, 937, 821, 0,
+	566, 859, 0, 0, 822, 814, 815, 818, 821, 0,
+	588, 577, 568, 571, 572, 554, 0, 580, 584, 0,
+	586, 587, 0, 0, 70, 0, 634, 0, 590, 592,
+	593, 594, 616, 0, 0, 0, 0, 66, 68, 635,
+	0, 1318, 915, 0, 74, 75, 0, 0, 0, 211,
+	920, 921, 922, -2, 230, 0, 136, 204, 148, 149,
+	150, 197, 152, 197, 197, 197, 197, 208, 208, 208,
+	208, 180, 181, 182, 183, 184, 0, 0, 167, 197,
+	197, 197, 197, 187, 188, 189, 190, 191, 192, 193,
+	194, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	199, 199, 199, 201, 201, 0, 39, 0, 215, 0,
+	818, 0, 841, 0, 0, 941, 0, 940, 940, 940,
+	107, 0, 0, 0, 368, 329, 357, 369, 0, 332,
+	333, -2, 0, 0, 319, 0, 321, 0, 231, 0,
+	-2, 0, 0, 0, 237, 241, 238, 241, 229, 242,
+	349, 795, 0, 350, 351, 0, 383, 604, 0, 0,
+	0, 0, 0, 389, 390, 391, 0, 393, 394, 395,
+	396, 397, 398, 399, 400, 401, 402, 358, 359, 360,
+	361, 362, 363, 364, 0, 0, 321, 0, 354, 0,
+	275, 276, 0, 0, 279, 280, 281, 282, 0, 0,
+	285, 286, 287, 288, 289, 313, 314, 315, 290, 291,
+	292, 293, 294, 295, 296, 307, 308, 309, 310, 311,
+	312, 297, 298, 299, 300, 301, 304, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 838, 839, 840,
+	0, 0, 0, 0, 0, 262, 64, 928, 417, 636,
+	949, 950, 477, 478, 0, 235, 236, 476, 476, 429,
+	452, 0, 476, 433, 454, 434, 436, 435, 437, 476,
+	440, 474, 475, 441, 442, 443, 444, 445, 446, 447,
+	448, 449, 450, 456, 0, 0, 0, 494, 498, 499,
+	500, 501, 0, 0, 529, 534, 535, 536, 537, 549,
+	542, 687, 646, 647, 648, 650, 667, 0, 669, 671,
+	657, 658, 682, 683, 684, 0, 0, 0, 0, 680,
+	662, 0, 693, 694, 695, 696, 697, 698, 699, 700,
+	701, 702, 703, 704, 707, 770, 771, 772, 0, 705,
+	706, 717, 0, 0, 0, 565, 796, 0, -2, 0,
+	685, 904, 821, 0, 0, 0, 0, 690, 798, 0,
+	690, 798, 0, 0, 0, 562, 563, 793, 790, 0,
+	0, 756, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 520, 521, 523, 0, 638, 0, 619, 0, 621,
+	622, 0, 939, 856, 52, 42, 0, 857, 0, 0,
+	0, 0, 817, 819, 820, 856, 0, 806, 0, 0,
+	643, 0, 0, 569, 48, 585, 581, 0, 643, 0,
+	0, 633, 0, 0, 0, 0, 0, 0, 623, 0,
+	0, 626, 0, 0, 0, 0, 617, 0, 0, 0,
+	-2, 0, 0, 0, 62, 63, 0, 0, 0, 910,
+	73, 0, 0, 78, 79, 911, 912, 913, 914, 0,
+	111, -2, 270, 130, 132, 133, 134, 125, 135, 206,
+	205, 151, 208, 208, 174, 175, 211, 0, 211, 211,
+	211, 0, 0, 168, 169, 170, 171, 162, 0, 163,
+	164, 165, 0, 166, 248, 0, 825, 216, 217, 219,
+	223, 0, 0, 244, 245, 0, 0, 101, 0, 942,
+	0, 0, 0, 930, 120, 121, 122, 123, 118, 0,
+	0, 126, 323, 0, 0, 0, 246, 0, 0, 225,
+	241, 226, 227, 0, 352, 0, 0, 385, 386, 387,
+	388, 0, 0, 0, 321, 323, 211, 0, 277, 278,
+	283, 284, 302, 0, 0, 0, 0, 851, 852, 0,
+	855, 93, 375, 377, 376, 380, 0, 0, 0, 0,
+	412, 262, 825, 0, 416, 263, 264, 473, 439, 455,
+	473, 431, 438, 480, 459, 490, 533, 0, 0, 0,
+	541, 0, 668, 670, 672, 659, 680, 663, 0, 660,
+	0, 0, 654, 722, 0, 0, 564, 0, 813, 856,
+	726, 727, 0, 0, 0, 0, 0, 763, 0, 0,
+	764, 0, 813, 0, 791, 0, 0, 738, 757, 0,
+	0, 758, 759, 760, 761, 762, 519, 522, 524, 598,
+	0, 0, 0, 0, 620, 936, 44, 0, 0, 0,
+	823, 824, 816, 43, 0, 923, 924, 807, 808, 809,
+	0, 578, 589, 570, 0, 821, 898, 0, 0, 890,
+	0, 0, 643, 906, 0, 591, 612, 614, 0, 609,
+	624, 625, 627, 0, 629, 0, 631, 632, 595, 596,
+	597, 0, 643, 0, 643, 67, 643, 69, 0, 637,
+	76, 77, 0, 0, 83, 212, 213, 118, 272, 131,
+	137, 0, 0, 0, 141, 0, 0, 144, 146, 147,
+	207, 211, 211, 176, 209, 210, 177, 178, 179, 0,
+	195, 0, 0, 0, 265, 88, 829, 828, 223, 223,
+	218, 0, 221, 0, 198, 0, 103, 0, 0, 0,
+	0, 327, 602, 0, 338, 339, 0, 322, 382, 0,
+	215, 0, 228, 796, 605, 0, 0, 340, 0, 323,
+	343, 344, 355, 305, 306, 303, 600, 842, 843, 844,
+	0, 854, 96, 0, 0, 0, 0, 373, 0, 414,
+	415, 65, 476, 476, 528, 0, 531, 0, 661, 0,
+	681, 664, 723, 724, 0, 797, 821, 46, 0, 197,
+	197, 776, 197, 201, 779, 197, 781, 197, 784, 0,
+	0, 0, 0, 0, 0, 0, 788, 737, 794, 0,
+	0, 0, 0, 0, 0, 0, 0, 208, 861, 858,
+	45, 811, 0, 644, 582, 49, 53, 0, 898, 889,
+	900, 902, 0, 0, 0, 894, 0, 813, 0, 0,
+	606, 613, 0, 0, 607, 0, 608, 628, 630, -2,
+	813, 643, 60, 61, 0, 80, 81, 82, 271, 138,
+	139, 0, 142, 143, 145, 172, 173, 208, 0, 208,
+	0, 202, 0, 254, 266, 0, 826, 827, 0, 0,
+	220, 222, 600, 104, 105, 106, 0, 0, 127, 324,
+	0, 214, 0, 0, 407, 404, 341, 342, 0, 0,
+	853, 374, 0, 94, 95, 0, 0, 379, 413, 423,
+	430, 530, 550, 665, 725, 856, 728, 773, 208, 777,
+	778, 780, 782, 783, 785, 730, 729, 0, 0, 0,
+	0, 0, 821, 0, 792, 0, 0, 0, 0, 0,
+	618, 208, 881, 50, 0, 0, 0, 54, 0, 903,
+	0, 0, 0, 0, 71, 821, 907, 908, 610, 0,
+	615, 821, 59, 140, 211, 196, 211, 0, 0, 267,
+	830, 831, 832, 833, 834, 835, 836, 0, 330, 603,
+	0, 0, 384, 0, 392, 0, 0, 0, 0, 97,
+	98, 0, 0, 0, 47, 774, 775, 0, 0, 0,
+	0, 765, 0, 789, 0, 0, 0, 640, 0, 0,
+	638, 863, 862, 875, 879, 812, 810, 0, 901, 0,
+	893, 896, 892, 895, 57, 0, 58, 185, 186, 200,
+	203, 0, 0, 0, 408, 405, 406, 845, 601, 0,
+	0, 0, 381, 731, 733, 732, 734, 0, 0, 0,
+	736, 753, 754, 639, 641, 642, 599, 881, 0, 874,
+	877, -2, 0, 0, 891, 0, 611, 845, 0, 0,
+	371, 847, 99, 100, 316, 317, 318, 93, 735, 0,
+	0, 0, 868, 866, 866, 879, 0, 883, 0, 888,
+	0, 899, 897, 89, 0, 0, 0, 0, 848, 849,
+	96, 766, 0, 769, 871, 0, 864, 867, 865, 876,
+	0, 882, 0, 0, 880, 409, 410, 250, 0, 378,
+	767, 860, 0, 869, 870, 878, 0, 0, 251, 252,
+	0, 846, 0, 872, 873, 884, 886, 253, 0, 0,
+	0, 0, 255, 257, 258, 0, 0, 256, 768, 259,
+	260, 261,
 }
 
 var yyTok1 = [...]int{
@@ -4973,7 +5003,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 111, 3, 3, 3, 138, 130, 3,
 	81, 83, 135, 133, 82, 134, 150, 136, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 468,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 471,
 	119, 118, 120, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -5051,7 +5081,8 @@ var yyTok3 = [...]int{
 	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
 	57780, 455, 57781, 456, 57782, 457, 57783, 458, 57784, 459,
 	57785, 460, 57786, 461, 57787, 462, 57788, 463, 57789, 464,
-	57790, 465, 57791, 466, 57792, 467, 0,
+	57790, 465, 57791, 466, 57792, 467, 57793, 468, 57794, 469,
+	57795, 470, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5393,77 +5424,77 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:409
+//line sql.y:412
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:414
+//line sql.y:417
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:415
+//line sql.y:418
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:419
+//line sql.y:422
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 33:
+	case 34:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:451
+//line sql.y:455
 		{
 			setParseTree(yylex, nil)
 		}
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:457
+//line sql.y:461
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), NoAt)
 		}
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:461
+//line sql.y:465
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), SingleAt)
 		}
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:465
+//line sql.y:469
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), DoubleAt)
 		}
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:470
+//line sql.y:474
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:474
+//line sql.y:478
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:480
+//line sql.y:484
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:486
+//line sql.y:490
 		{
 			yyVAL.statement = &Load{}
 		}
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:492
+//line sql.y:496
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -5472,27 +5503,27 @@ yydefault:
 			sel.Into = yyDollar[5].selectInto
 			yyVAL.selStmt = sel
 		}
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:501
+//line sql.y:505
 		{
 			yyVAL.selStmt = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmt}, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].lock}
 		}
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:505
+//line sql.y:509
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].boolean, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].lock)
 		}
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:509
+//line sql.y:513
 		{
 			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), SelectExprs{&Nextval{Expr: yyDollar[5].expr}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:532
+//line sql.y:536
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -5500,45 +5531,45 @@ yydefault:
 			sel.Lock = yyDollar[4].lock
 			yyVAL.selStmt = sel
 		}
-	case 46:
+	case 47:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:540
+//line sql.y:544
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].boolean, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].lock)
 		}
-	case 47:
+	case 48:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:546
+//line sql.y:550
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 48:
+	case 49:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:552
+//line sql.y:556
 		{
 			yyVAL.statement = &VStream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].expr), Limit: yyDollar[7].limit}
 		}
-	case 49:
+	case 50:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:560
+//line sql.y:564
 		{
 			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), yyDollar[4].selectExprs /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprs /*from*/, NewWhere(WhereClause, yyDollar[6].expr), GroupBy(yyDollar[7].exprs), NewWhere(HavingClause, yyDollar[8].expr))
 		}
-	case 50:
+	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:566
+//line sql.y:570
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 51:
+	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:570
+//line sql.y:574
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 52:
+	case 53:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:577
+//line sql.y:581
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -5550,9 +5581,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 53:
+	case 54:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:589
+//line sql.y:593
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -5562,41 +5593,35 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].insertAction, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 54:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:601
-		{
-			yyVAL.insertAction = InsertAct
-		}
 	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:605
 		{
-			yyVAL.insertAction = ReplaceAct
+			yyVAL.insertAction = InsertAct
 		}
 	case 56:
-		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:611
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:609
 		{
-			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereClause, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
+			yyVAL.insertAction = ReplaceAct
 		}
 	case 57:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:617
+//line sql.y:615
 		{
-			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitions, Where: NewWhere(WhereClause, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
+			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereClause, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
 	case 58:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-9 : yypt+1]
 //line sql.y:621
 		{
-			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[5].tableNames, TableExprs: yyDollar[7].tableExprs, Where: NewWhere(WhereClause, yyDollar[8].expr)}
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitions, Where: NewWhere(WhereClause, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
 	case 59:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-8 : yypt+1]
 //line sql.y:625
 		{
-			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereClause, yyDollar[7].expr)}
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[5].tableNames, TableExprs: yyDollar[7].tableExprs, Where: NewWhere(WhereClause, yyDollar[8].expr)}
 		}
 	case 60:
 		yyDollar = yyS[yypt-7 : yypt+1]
@@ -5605,167 +5630,173 @@ yydefault:
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereClause, yyDollar[7].expr)}
 		}
 	case 61:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:634
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:633
 		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereClause, yyDollar[7].expr)}
 		}
 	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:635
+//line sql.y:638
 		{
 		}
 	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:639
 		{
-			yyVAL.tableNames = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
 	case 64:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:643
 		{
-			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName.ToViewName())
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
 	case 65:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:647
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName.ToViewName())
+		}
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:649
+//line sql.y:653
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 66:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:653
+//line sql.y:657
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 67:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:659
+//line sql.y:663
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 68:
+	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:663
+//line sql.y:667
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 69:
+	case 70:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:668
+//line sql.y:672
 		{
 			yyVAL.partitions = nil
 		}
-	case 70:
+	case 71:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:672
+//line sql.y:676
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 71:
+	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:678
+//line sql.y:682
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 72:
+	case 73:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:684
+//line sql.y:688
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].scope, Characteristics: yyDollar[5].characteristics}
 		}
-	case 73:
+	case 74:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:688
+//line sql.y:692
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Characteristics: yyDollar[4].characteristics, Scope: ImplicitScope}
 		}
-	case 74:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:694
-		{
-			yyVAL.characteristics = []Characteristic{yyDollar[1].characteristic}
-		}
 	case 75:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:698
 		{
-			yyVAL.characteristics = append(yyVAL.characteristics, yyDollar[3].characteristic)
+			yyVAL.characteristics = []Characteristic{yyDollar[1].characteristic}
 		}
 	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:704
+//line sql.y:702
 		{
-			yyVAL.characteristic = yyDollar[3].isolationLevel
+			yyVAL.characteristics = append(yyVAL.characteristics, yyDollar[3].characteristic)
 		}
 	case 77:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:708
 		{
-			yyVAL.characteristic = ReadWrite
+			yyVAL.characteristic = yyDollar[3].isolationLevel
 		}
 	case 78:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:712
 		{
-			yyVAL.characteristic = ReadOnly
+			yyVAL.characteristic = ReadWrite
 		}
 	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:718
+//line sql.y:716
 		{
-			yyVAL.isolationLevel = RepeatableRead
+			yyVAL.characteristic = ReadOnly
 		}
 	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:722
 		{
-			yyVAL.isolationLevel = ReadCommitted
+			yyVAL.isolationLevel = RepeatableRead
 		}
 	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:726
 		{
-			yyVAL.isolationLevel = ReadUncommitted
+			yyVAL.isolationLevel = ReadCommitted
 		}
 	case 82:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:730
 		{
-			yyVAL.isolationLevel = Serializable
+			yyVAL.isolationLevel = ReadUncommitted
 		}
 	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:736
+//line sql.y:734
 		{
-			yyVAL.scope = SessionScope
+			yyVAL.isolationLevel = Serializable
 		}
 	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:740
 		{
-			yyVAL.scope = GlobalScope
+			yyVAL.scope = SessionScope
 		}
 	case 85:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:744
+		{
+			yyVAL.scope = GlobalScope
+		}
+	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:746
+//line sql.y:750
 		{
 			yyDollar[1].createTable.TableSpec = yyDollar[2].TableSpec
 			yyDollar[1].createTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].createTable
 		}
-	case 86:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:752
+//line sql.y:756
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTable.OptLike = yyDollar[2].optLike
 			yyDollar[1].createTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].createTable
 		}
-	case 87:
+	case 88:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:759
+//line sql.y:763
 		{
 			indexDef := yyDollar[1].alterTable.AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumns
@@ -5774,162 +5805,156 @@ yydefault:
 			yyDollar[1].alterTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 88:
+	case 89:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:768
+//line sql.y:772
 		{
 			yyVAL.statement = &CreateView{ViewName: yyDollar[7].tableName.ToViewName(), IsReplace: yyDollar[2].boolean, Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columns, Select: yyDollar[10].selStmt, CheckOption: yyDollar[11].str}
 		}
-	case 89:
+	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:772
+//line sql.y:776
 		{
 			yyDollar[1].createDatabase.FullyParsed = true
 			yyDollar[1].createDatabase.CreateOptions = yyDollar[2].collateAndCharsets
 			yyVAL.statement = yyDollar[1].createDatabase
 		}
-	case 90:
+	case 91:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:779
+//line sql.y:783
 		{
 			yyVAL.boolean = false
 		}
-	case 91:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:783
+//line sql.y:787
 		{
 			yyVAL.boolean = true
 		}
-	case 92:
+	case 93:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:788
+//line sql.y:792
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 93:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:792
+//line sql.y:796
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 94:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:798
+//line sql.y:802
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 95:
+	case 96:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:803
+//line sql.y:807
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 96:
+	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:808
+//line sql.y:812
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 97:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:814
+//line sql.y:818
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 98:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:819
+//line sql.y:823
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 99:
+	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:825
+//line sql.y:829
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 100:
+	case 101:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:831
+//line sql.y:835
 		{
 			yyVAL.createTable = &CreateTable{Table: yyDollar[5].tableName, IfNotExists: yyDollar[4].boolean, Temp: yyDollar[2].boolean}
 			setDDL(yylex, yyVAL.createTable)
 		}
-	case 101:
+	case 102:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:838
+//line sql.y:842
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[3].tableName}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 102:
+	case 103:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:845
+//line sql.y:849
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[6].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[3].colIdent, Type: string(yyDollar[2].bytes)}, Options: yyDollar[4].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 103:
+	case 104:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:850
+//line sql.y:854
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Fulltext: true}, Options: yyDollar[5].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 104:
+	case 105:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:855
+//line sql.y:859
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Spatial: true}, Options: yyDollar[5].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 105:
+	case 106:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:860
+//line sql.y:864
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Unique: true}, Options: yyDollar[5].indexOptions}}}}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 106:
+	case 107:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:867
+//line sql.y:871
 		{
 			yyVAL.createDatabase = &CreateDatabase{DBName: string(yyDollar[4].colIdent.String()), IfNotExists: yyDollar[3].boolean}
 			setDDL(yylex, yyVAL.createDatabase)
 		}
-	case 107:
+	case 108:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:874
+//line sql.y:878
 		{
 			yyVAL.alterDatabase = &AlterDatabase{}
 			setDDL(yylex, yyVAL.alterDatabase)
 		}
-	case 110:
+	case 111:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:885
+//line sql.y:889
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].tableOptions
 		}
-	case 111:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:891
-		{
-			yyVAL.collateAndCharsets = nil
-		}
 	case 112:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:895
 		{
-			yyVAL.collateAndCharsets = yyDollar[1].collateAndCharsets
+			yyVAL.collateAndCharsets = nil
 		}
 	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:901
+//line sql.y:899
 		{
-			yyVAL.collateAndCharsets = []CollateAndCharset{yyDollar[1].collateAndCharset}
+			yyVAL.collateAndCharsets = yyDollar[1].collateAndCharsets
 		}
 	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
@@ -5938,10 +5963,10 @@ yydefault:
 			yyVAL.collateAndCharsets = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 	case 115:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:909
 		{
-			yyVAL.collateAndCharsets = append(yyDollar[1].collateAndCharsets, yyDollar[2].collateAndCharset)
+			yyVAL.collateAndCharsets = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
@@ -5950,103 +5975,103 @@ yydefault:
 			yyVAL.collateAndCharsets = append(yyDollar[1].collateAndCharsets, yyDollar[2].collateAndCharset)
 		}
 	case 117:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:917
+		{
+			yyVAL.collateAndCharsets = append(yyDollar[1].collateAndCharsets, yyDollar[2].collateAndCharset)
+		}
+	case 118:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:918
+//line sql.y:922
 		{
 			yyVAL.boolean = false
 		}
-	case 118:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:922
+//line sql.y:926
 		{
 			yyVAL.boolean = true
 		}
-	case 119:
+	case 120:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:928
+//line sql.y:932
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].boolean}
 		}
-	case 120:
+	case 121:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:932
+//line sql.y:936
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: ("'" + string(yyDollar[4].bytes) + "'"), IsDefault: yyDollar[1].boolean}
 		}
-	case 121:
+	case 122:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:938
+//line sql.y:942
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].boolean}
 		}
-	case 122:
+	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:942
+//line sql.y:946
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: ("'" + string(yyDollar[4].bytes) + "'"), IsDefault: yyDollar[1].boolean}
 		}
-	case 123:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:949
+//line sql.y:953
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 124:
+	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:953
+//line sql.y:957
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 125:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:959
+//line sql.y:963
 		{
 			yyVAL.columnDefinitions = []*ColumnDefinition{yyDollar[1].columnDefinition}
 		}
-	case 126:
+	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:963
+//line sql.y:967
 		{
 			yyVAL.columnDefinitions = append(yyDollar[1].columnDefinitions, yyDollar[3].columnDefinition)
 		}
-	case 127:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:969
+//line sql.y:973
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 128:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:974
+//line sql.y:978
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddConstraint(yyDollar[1].constraintDefinition)
 		}
-	case 129:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:979
+//line sql.y:983
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 130:
+	case 131:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:983
+//line sql.y:987
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 			yyVAL.TableSpec.AddConstraint(yyDollar[4].constraintDefinition)
 		}
-	case 131:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:988
-		{
-			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
-		}
 	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:992
 		{
-			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
+			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
 	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
@@ -6056,113 +6081,113 @@ yydefault:
 		}
 	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1002
+//line sql.y:1000
+		{
+			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
+		}
+	case 135:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1006
 		{
 			yyDollar[2].columnType.Options = yyDollar[3].columnTypeOptions
 			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
-	case 135:
+	case 136:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1012
+//line sql.y:1016
 		{
 			yyVAL.columnTypeOptions = &ColumnTypeOptions{NotNull: false, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil}
 		}
-	case 136:
+	case 137:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1016
+//line sql.y:1020
 		{
 			yyDollar[1].columnTypeOptions.NotNull = false
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 137:
+	case 138:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1021
+//line sql.y:1025
 		{
 			yyDollar[1].columnTypeOptions.NotNull = true
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 138:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1026
+//line sql.y:1030
 		{
 			yyDollar[1].columnTypeOptions.Default = yyDollar[3].expr
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 139:
+	case 140:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1031
+//line sql.y:1035
 		{
 			yyDollar[1].columnTypeOptions.OnUpdate = yyDollar[4].expr
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 140:
+	case 141:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1036
+//line sql.y:1040
 		{
 			yyDollar[1].columnTypeOptions.Autoincrement = true
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 141:
+	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1041
+//line sql.y:1045
 		{
 			yyDollar[1].columnTypeOptions.Comment = NewStrLiteral(yyDollar[3].bytes)
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 142:
+	case 143:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1046
+//line sql.y:1050
 		{
 			yyDollar[1].columnTypeOptions.KeyOpt = colKeyPrimary
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 143:
+	case 144:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1051
+//line sql.y:1055
 		{
 			yyDollar[1].columnTypeOptions.KeyOpt = colKey
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 144:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1056
+//line sql.y:1060
 		{
 			yyDollar[1].columnTypeOptions.KeyOpt = colKeyUniqueKey
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 145:
+	case 146:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1061
+//line sql.y:1065
 		{
 			yyDollar[1].columnTypeOptions.KeyOpt = colKeyUnique
 			yyVAL.columnTypeOptions = yyDollar[1].columnTypeOptions
 		}
-	case 146:
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1068
+//line sql.y:1072
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolean
 			yyVAL.columnType.Zerofill = yyDollar[3].boolean
 		}
-	case 150:
+	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1079
+//line sql.y:1083
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literal
 		}
-	case 151:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1084
-		{
-			yyVAL.columnType = yyDollar[1].columnType
-		}
 	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1090
+//line sql.y:1088
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
@@ -6213,16 +6238,14 @@ yydefault:
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 161:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1128
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1126
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1134
+//line sql.y:1132
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -6230,7 +6253,7 @@ yydefault:
 		}
 	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1140
+//line sql.y:1138
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -6238,7 +6261,7 @@ yydefault:
 		}
 	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1146
+//line sql.y:1144
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -6246,23 +6269,25 @@ yydefault:
 		}
 	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1152
+//line sql.y:1150
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 166:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1160
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1156
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 167:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1164
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
@@ -6283,10 +6308,10 @@ yydefault:
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
 	case 171:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1182
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1180
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
 	case 172:
 		yyDollar = yyS[yypt-4 : yypt+1]
@@ -6295,10 +6320,10 @@ yydefault:
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 173:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:1190
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
@@ -6307,10 +6332,10 @@ yydefault:
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
 	case 175:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:1198
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
 	case 176:
 		yyDollar = yyS[yypt-3 : yypt+1]
@@ -6331,10 +6356,10 @@ yydefault:
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 179:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1214
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
@@ -6361,22 +6386,22 @@ yydefault:
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 184:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1234
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 185:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1239
+//line sql.y:1238
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 186:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1245
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1243
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
@@ -6422,241 +6447,241 @@ yydefault:
 		}
 	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1279
+//line sql.y:1277
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 195:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1283
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 195:
+	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1284
+//line sql.y:1288
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 196:
+	case 197:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1289
+//line sql.y:1293
 		{
 			yyVAL.literal = nil
 		}
-	case 197:
+	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1293
+//line sql.y:1297
 		{
 			yyVAL.literal = NewIntLiteral(yyDollar[2].bytes)
 		}
-	case 198:
+	case 199:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1298
+//line sql.y:1302
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 199:
+	case 200:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1302
+//line sql.y:1306
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 				Scale:  NewIntLiteral(yyDollar[4].bytes),
 			}
 		}
-	case 200:
+	case 201:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1310
+//line sql.y:1314
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 201:
+	case 202:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1314
+//line sql.y:1318
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 			}
 		}
-	case 202:
+	case 203:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1320
+//line sql.y:1324
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 				Scale:  NewIntLiteral(yyDollar[4].bytes),
 			}
 		}
-	case 203:
+	case 204:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1328
+//line sql.y:1332
 		{
 			yyVAL.boolean = false
 		}
-	case 204:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1332
+//line sql.y:1336
 		{
 			yyVAL.boolean = true
 		}
-	case 205:
+	case 206:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1337
+//line sql.y:1341
 		{
 			yyVAL.boolean = false
 		}
-	case 206:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1341
+//line sql.y:1345
 		{
 			yyVAL.boolean = true
 		}
-	case 207:
+	case 208:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1346
+//line sql.y:1350
 		{
 			yyVAL.str = ""
 		}
-	case 208:
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1350
+//line sql.y:1354
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 209:
+	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1354
+//line sql.y:1358
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 210:
+	case 211:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1359
+//line sql.y:1363
 		{
 			yyVAL.str = ""
 		}
-	case 211:
+	case 212:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1363
+//line sql.y:1367
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 212:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1367
+//line sql.y:1371
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 213:
+	case 214:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1374
+//line sql.y:1378
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 214:
+	case 215:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1379
+//line sql.y:1383
 		{
 			yyVAL.indexOptions = nil
 		}
-	case 215:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1383
+//line sql.y:1387
 		{
 			yyVAL.indexOptions = yyDollar[1].indexOptions
 		}
-	case 216:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1389
+//line sql.y:1393
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 217:
+	case 218:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1393
+//line sql.y:1397
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 218:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1399
+//line sql.y:1403
 		{
 			yyVAL.indexOption = yyDollar[1].indexOption
 		}
-	case 219:
+	case 220:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1403
+//line sql.y:1407
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 220:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1408
+//line sql.y:1412
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[2].bytes)}
 		}
-	case 221:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1412
+//line sql.y:1416
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), String: yyDollar[3].colIdent.String()}
 		}
-	case 222:
+	case 223:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1418
+//line sql.y:1422
 		{
 			yyVAL.str = ""
 		}
-	case 223:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1422
+//line sql.y:1426
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 224:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1428
-		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
-		}
 	case 225:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1432
 		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
 	case 226:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1436
 		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
 	case 227:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1440
 		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
 	case 228:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:1444
 		{
-			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
 	case 229:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1449
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1448
 		{
-			yyVAL.str = ""
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 	case 230:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:1453
 		{
-			yyVAL.str = yyDollar[2].str
+			yyVAL.str = ""
 		}
 	case 231:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1459
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1457
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.str = yyDollar[2].str
 		}
 	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
@@ -6672,7 +6697,7 @@ yydefault:
 		}
 	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1474
+//line sql.y:1471
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
@@ -6683,22 +6708,22 @@ yydefault:
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 236:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1483
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1482
 		{
-			yyVAL.str = "key"
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 237:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:1487
 		{
-			yyVAL.str = yyDollar[1].str
+			yyVAL.str = "key"
 		}
 	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1493
+//line sql.y:1491
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.str = yyDollar[1].str
 		}
 	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
@@ -6707,142 +6732,142 @@ yydefault:
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 240:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1502
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1501
 		{
-			yyVAL.str = ""
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 241:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:1506
 		{
-			yyVAL.str = string(yyDollar[1].colIdent.String())
+			yyVAL.str = ""
 		}
 	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1512
+//line sql.y:1510
 		{
-			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
+			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
 	case 243:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1516
 		{
-			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
+			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
 	case 244:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1522
+//line sql.y:1520
 		{
-			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literal, Direction: yyDollar[3].orderDirection}
+			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
 	case 245:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1528
+//line sql.y:1526
 		{
-			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
+			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literal, Direction: yyDollar[3].orderDirection}
 		}
 	case 246:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1532
 		{
-			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
 	case 247:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1538
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1536
 		{
-			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
 	case 248:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1542
 		{
-			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
 	case 249:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1548
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1546
 		{
-			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
 	case 250:
-		yyDollar = yyS[yypt-11 : yypt+1]
+		yyDollar = yyS[yypt-10 : yypt+1]
 //line sql.y:1552
 		{
-			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
 	case 251:
 		yyDollar = yyS[yypt-11 : yypt+1]
 //line sql.y:1556
 		{
-			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
 	case 252:
-		yyDollar = yyS[yypt-12 : yypt+1]
+		yyDollar = yyS[yypt-11 : yypt+1]
 //line sql.y:1560
 		{
-			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
 	case 253:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1566
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:1564
 		{
-			yyVAL.constraintInfo = &CheckConstraintDefinition{Expr: yyDollar[3].expr, Enforced: yyDollar[5].boolean}
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
 	case 254:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1572
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1570
 		{
-			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
+			yyVAL.constraintInfo = &CheckConstraintDefinition{Expr: yyDollar[3].expr, Enforced: yyDollar[5].boolean}
 		}
 	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1578
+//line sql.y:1576
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
 	case 256:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1584
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1582
 		{
-			yyVAL.ReferenceAction = Restrict
+			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
 	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1588
 		{
-			yyVAL.ReferenceAction = Cascade
+			yyVAL.ReferenceAction = Restrict
 		}
 	case 258:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1592
 		{
-			yyVAL.ReferenceAction = NoAction
+			yyVAL.ReferenceAction = Cascade
 		}
 	case 259:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:1596
 		{
-			yyVAL.ReferenceAction = SetDefault
+			yyVAL.ReferenceAction = NoAction
 		}
 	case 260:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:1600
 		{
-			yyVAL.ReferenceAction = SetNull
+			yyVAL.ReferenceAction = SetDefault
 		}
 	case 261:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1605
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1604
 		{
-			yyVAL.str = ""
+			yyVAL.ReferenceAction = SetNull
 		}
 	case 262:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:1609
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.str = ""
 		}
 	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
@@ -6851,70 +6876,70 @@ yydefault:
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 264:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1618
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1617
 		{
-			yyVAL.boolean = true
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 265:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:1622
 		{
 			yyVAL.boolean = true
 		}
 	case 266:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1626
 		{
-			yyVAL.boolean = false
+			yyVAL.boolean = true
 		}
 	case 267:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1631
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1630
 		{
-			yyVAL.tableOptions = nil
+			yyVAL.boolean = false
 		}
 	case 268:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:1635
 		{
-			yyVAL.tableOptions = yyDollar[1].tableOptions
+			yyVAL.tableOptions = nil
 		}
 	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1641
+//line sql.y:1639
 		{
-			yyVAL.tableOptions = TableOptions{yyDollar[1].tableOption}
+			yyVAL.tableOptions = yyDollar[1].tableOptions
 		}
 	case 270:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1645
 		{
-			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[3].tableOption)
+			yyVAL.tableOptions = TableOptions{yyDollar[1].tableOption}
 		}
 	case 271:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1649
 		{
-			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[2].tableOption)
+			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[3].tableOption)
 		}
 	case 272:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1655
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1653
 		{
-			yyVAL.tableOptions = TableOptions{yyDollar[1].tableOption}
+			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[2].tableOption)
 		}
 	case 273:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1659
 		{
-			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[2].tableOption)
+			yyVAL.tableOptions = TableOptions{yyDollar[1].tableOption}
 		}
 	case 274:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1665
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1663
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
+			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[2].tableOption)
 		}
 	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
@@ -6923,28 +6948,28 @@ yydefault:
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
 	case 276:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1673
 		{
-			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[2].bytes)), String: yyDollar[4].str}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
 	case 277:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:1677
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[2].bytes), String: yyDollar[4].str}
+			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[2].bytes)), String: yyDollar[4].str}
 		}
 	case 278:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:1681
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[2].bytes), String: yyDollar[4].str}
 		}
 	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1685
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
 	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
@@ -6959,10 +6984,10 @@ yydefault:
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
 	case 282:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1697
 		{
-			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)), Value: NewStrLiteral(yyDollar[4].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
 	case 283:
 		yyDollar = yyS[yypt-4 : yypt+1]
@@ -6971,40 +6996,40 @@ yydefault:
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)), Value: NewStrLiteral(yyDollar[4].bytes)}
 		}
 	case 284:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:1705
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)), Value: NewStrLiteral(yyDollar[4].bytes)}
 		}
 	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1709
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
 	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1713
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: yyDollar[3].colIdent.String()}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
 	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1717
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: yyDollar[3].colIdent.String()}
 		}
 	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1721
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
 	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1725
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
 	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
@@ -7028,73 +7053,73 @@ yydefault:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1741
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
 	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1745
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
 	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1749
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
 	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1753
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
 	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1757
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
 	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1761
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
 	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1765
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
 	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1769
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
 	case 301:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1773
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
 	case 302:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:1777
 		{
-			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Tables: yyDollar[4].tableNames}
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
 	case 303:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1782
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1781
 		{
-			yyVAL.str = ""
+			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Tables: yyDollar[4].tableNames}
 		}
 	case 304:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:1786
 		{
-			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
+			yyVAL.str = ""
 		}
 	case 305:
 		yyDollar = yyS[yypt-2 : yypt+1]
@@ -7102,101 +7127,101 @@ yydefault:
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 315:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1809
+	case 306:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1794
 		{
-			yyVAL.str = yyDollar[1].colIdent.String()
+			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
 	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1813
 		{
-			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
+			yyVAL.str = yyDollar[1].colIdent.String()
 		}
 	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1817
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
 	case 318:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1821
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 319:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1822
+//line sql.y:1826
 		{
 			yyVAL.bytes = []byte("")
 		}
-	case 320:
+	case 321:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1828
+//line sql.y:1832
 		{
 			yyVAL.colName = nil
 		}
-	case 321:
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1832
+//line sql.y:1836
 		{
 			yyVAL.colName = yyDollar[2].colName
 		}
-	case 322:
+	case 323:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1837
+//line sql.y:1841
 		{
 			yyVAL.colName = nil
 		}
-	case 323:
+	case 324:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1841
+//line sql.y:1845
 		{
 			yyVAL.colName = yyDollar[2].colName
 		}
-	case 324:
+	case 325:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1846
+//line sql.y:1850
 		{
 			yyVAL.alterOptions = nil
 		}
-	case 325:
+	case 326:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1850
+//line sql.y:1854
 		{
 			yyVAL.alterOptions = yyDollar[1].alterOptions
 		}
-	case 326:
+	case 327:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1854
+//line sql.y:1858
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, &OrderByOption{Cols: yyDollar[5].columns})
 		}
-	case 327:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1858
+//line sql.y:1862
 		{
 			yyVAL.alterOptions = yyDollar[1].alterOptions
 		}
-	case 328:
+	case 329:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1862
+//line sql.y:1866
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOptions...)
 		}
-	case 329:
+	case 330:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1866
+//line sql.y:1870
 		{
 			yyVAL.alterOptions = append(append(yyDollar[1].alterOptions, yyDollar[3].alterOptions...), &OrderByOption{Cols: yyDollar[7].columns})
 		}
-	case 330:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1872
-		{
-			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
-		}
 	case 331:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1876
 		{
-			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
+			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
 	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
@@ -7205,16 +7230,16 @@ yydefault:
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
 	case 333:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1886
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1884
 		{
-			yyVAL.alterOption = yyDollar[1].tableOptions
+			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
 	case 334:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1890
 		{
-			yyVAL.alterOption = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinition}
+			yyVAL.alterOption = yyDollar[1].tableOptions
 		}
 	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
@@ -7226,133 +7251,133 @@ yydefault:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:1898
 		{
-			yyVAL.alterOption = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinition}
+			yyVAL.alterOption = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinition}
 		}
 	case 337:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:1902
 		{
-			yyVAL.alterOption = &AddColumns{Columns: yyDollar[4].columnDefinitions}
+			yyVAL.alterOption = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinition}
 		}
 	case 338:
 		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:1906
 		{
-			yyVAL.alterOption = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinition}, First: yyDollar[4].colName, After: yyDollar[5].colName}
+			yyVAL.alterOption = &AddColumns{Columns: yyDollar[4].columnDefinitions}
 		}
 	case 339:
 		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:1910
 		{
-			yyVAL.alterOption = &AlterColumn{Column: yyDollar[3].colName, DropDefault: true}
+			yyVAL.alterOption = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinition}, First: yyDollar[4].colName, After: yyDollar[5].colName}
 		}
 	case 340:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:1914
 		{
-			yyVAL.alterOption = &AlterColumn{Column: yyDollar[3].colName, DropDefault: false, DefaultVal: yyDollar[6].expr}
+			yyVAL.alterOption = &AlterColumn{Column: yyDollar[3].colName, DropDefault: true}
 		}
 	case 341:
 		yyDollar = yyS[yypt-6 : yypt+1]
 //line sql.y:1918
 		{
-			yyVAL.alterOption = &ChangeColumn{OldColumn: yyDollar[3].colName, NewColDefinition: yyDollar[4].columnDefinition, First: yyDollar[5].colName, After: yyDollar[6].colName}
+			yyVAL.alterOption = &AlterColumn{Column: yyDollar[3].colName, DropDefault: false, DefaultVal: yyDollar[6].expr}
 		}
 	case 342:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 //line sql.y:1922
 		{
-			yyVAL.alterOption = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinition, First: yyDollar[4].colName, After: yyDollar[5].colName}
+			yyVAL.alterOption = &ChangeColumn{OldColumn: yyDollar[3].colName, NewColDefinition: yyDollar[4].columnDefinition, First: yyDollar[5].colName, After: yyDollar[6].colName}
 		}
 	case 343:
 		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:1926
 		{
-			yyVAL.alterOption = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
+			yyVAL.alterOption = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinition, First: yyDollar[4].colName, After: yyDollar[5].colName}
 		}
 	case 344:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:1930
 		{
-			yyVAL.alterOption = &KeyState{Enable: false}
+			yyVAL.alterOption = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
 	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:1934
 		{
-			yyVAL.alterOption = &KeyState{Enable: true}
+			yyVAL.alterOption = &KeyState{Enable: false}
 		}
 	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:1938
 		{
-			yyVAL.alterOption = &TablespaceOperation{Import: false}
+			yyVAL.alterOption = &KeyState{Enable: true}
 		}
 	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:1942
 		{
-			yyVAL.alterOption = &TablespaceOperation{Import: true}
+			yyVAL.alterOption = &TablespaceOperation{Import: false}
 		}
 	case 348:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:1946
 		{
-			yyVAL.alterOption = &DropColumn{Name: yyDollar[3].colName}
+			yyVAL.alterOption = &TablespaceOperation{Import: true}
 		}
 	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1950
 		{
-			yyVAL.alterOption = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent.String()}
+			yyVAL.alterOption = &DropColumn{Name: yyDollar[3].colName}
 		}
 	case 350:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1954
 		{
-			yyVAL.alterOption = &DropKey{Type: PrimaryKeyType}
+			yyVAL.alterOption = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent.String()}
 		}
 	case 351:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1958
 		{
-			yyVAL.alterOption = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent.String()}
+			yyVAL.alterOption = &DropKey{Type: PrimaryKeyType}
 		}
 	case 352:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:1962
 		{
-			yyVAL.alterOption = &Force{}
+			yyVAL.alterOption = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent.String()}
 		}
 	case 353:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1966
 		{
-			yyVAL.alterOption = &RenameTableName{Table: yyDollar[3].tableName}
+			yyVAL.alterOption = &Force{}
 		}
 	case 354:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1970
 		{
-			yyVAL.alterOption = &RenameIndex{OldName: yyDollar[3].colIdent.String(), NewName: yyDollar[5].colIdent.String()}
+			yyVAL.alterOption = &RenameTableName{Table: yyDollar[3].tableName}
 		}
 	case 355:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1976
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1974
 		{
-			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
+			yyVAL.alterOption = &RenameIndex{OldName: yyDollar[3].colIdent.String(), NewName: yyDollar[5].colIdent.String()}
 		}
 	case 356:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:1980
 		{
-			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
+			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
 	case 357:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1986
+//line sql.y:1984
 		{
-			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
+			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
 	case 358:
 		yyDollar = yyS[yypt-3 : yypt+1]
@@ -7370,99 +7395,105 @@ yydefault:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:1998
 		{
-			yyVAL.alterOption = &LockOption{Type: DefaultType}
+			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
 	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2002
 		{
-			yyVAL.alterOption = &LockOption{Type: NoneType}
+			yyVAL.alterOption = &LockOption{Type: DefaultType}
 		}
 	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2006
 		{
-			yyVAL.alterOption = &LockOption{Type: SharedType}
+			yyVAL.alterOption = &LockOption{Type: NoneType}
 		}
 	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2010
 		{
-			yyVAL.alterOption = &LockOption{Type: ExclusiveType}
+			yyVAL.alterOption = &LockOption{Type: SharedType}
 		}
 	case 364:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2014
 		{
-			yyVAL.alterOption = &Validation{With: true}
+			yyVAL.alterOption = &LockOption{Type: ExclusiveType}
 		}
 	case 365:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2018
 		{
-			yyVAL.alterOption = &Validation{With: false}
+			yyVAL.alterOption = &Validation{With: true}
 		}
 	case 366:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2024
+//line sql.y:2022
+		{
+			yyVAL.alterOption = &Validation{With: false}
+		}
+	case 367:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2028
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 367:
+	case 368:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2030
+//line sql.y:2034
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyDollar[1].alterTable.PartitionSpec = &PartitionSpec{Action: RemoveAction}
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 368:
+	case 369:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2037
+//line sql.y:2041
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyDollar[1].alterTable.PartitionSpec = yyDollar[4].partSpec
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 369:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2044
+//line sql.y:2048
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.PartitionSpec = yyDollar[2].partSpec
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 370:
+	case 371:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2050
+//line sql.y:2054
 		{
 			yyVAL.statement = &AlterView{ViewName: yyDollar[6].tableName.ToViewName(), Algorithm: yyDollar[2].str, Definer: yyDollar[3].str, Security: yyDollar[4].str, Columns: yyDollar[7].columns, Select: yyDollar[9].selStmt, CheckOption: yyDollar[10].str}
 		}
-	case 371:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2054
+//line sql.y:2058
 		{
 			yyDollar[1].alterDatabase.FullyParsed = true
 			yyDollar[1].alterDatabase.DBName = yyDollar[2].colIdent.String()
 			yyDollar[1].alterDatabase.AlterOptions = yyDollar[3].collateAndCharsets
 			yyVAL.statement = yyDollar[1].alterDatabase
 		}
-	case 372:
+	case 373:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2061
+//line sql.y:2065
 		{
 			yyDollar[1].alterDatabase.FullyParsed = true
 			yyDollar[1].alterDatabase.DBName = yyDollar[2].colIdent.String()
 			yyDollar[1].alterDatabase.UpdateDataDirectory = true
 			yyVAL.statement = yyDollar[1].alterDatabase
 		}
-	case 373:
+	case 374:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2068
+//line sql.y:2072
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -7474,9 +7505,9 @@ yydefault:
 				},
 			}
 		}
-	case 374:
+	case 375:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2080
+//line sql.y:2084
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -7486,21 +7517,21 @@ yydefault:
 				},
 			}
 		}
-	case 375:
+	case 376:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2090
+//line sql.y:2094
 		{
 			yyVAL.statement = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 376:
+	case 377:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2094
+//line sql.y:2098
 		{
 			yyVAL.statement = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 377:
+	case 378:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2098
+//line sql.y:2102
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -7513,9 +7544,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 378:
+	case 379:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2111
+//line sql.y:2115
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -7525,15 +7556,15 @@ yydefault:
 				},
 			}
 		}
-	case 379:
+	case 380:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2121
+//line sql.y:2125
 		{
 			yyVAL.statement = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 380:
+	case 381:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2125
+//line sql.y:2129
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -7544,146 +7575,140 @@ yydefault:
 				},
 			}
 		}
-	case 381:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2138
-		{
-			yyVAL.partSpec = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDef}}
-		}
 	case 382:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:2142
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitions}
+			yyVAL.partSpec = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDef}}
 		}
 	case 383:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2146
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitions, Definitions: yyDollar[6].partDefs}
+			yyVAL.partSpec = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitions}
 		}
 	case 384:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-7 : yypt+1]
 //line sql.y:2150
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitions}
+			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitions, Definitions: yyDollar[6].partDefs}
 		}
 	case 385:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2154
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: DiscardAction, IsAll: true}
+			yyVAL.partSpec = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitions}
 		}
 	case 386:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2158
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitions}
+			yyVAL.partSpec = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
 	case 387:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2162
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: ImportAction, IsAll: true}
+			yyVAL.partSpec = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitions}
 		}
 	case 388:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2166
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitions}
+			yyVAL.partSpec = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
 	case 389:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2170
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: TruncateAction, IsAll: true}
+			yyVAL.partSpec = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitions}
 		}
 	case 390:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2174
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].bytes)}
+			yyVAL.partSpec = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
 	case 391:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2178
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].boolean}
+			yyVAL.partSpec = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].bytes)}
 		}
 	case 392:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-7 : yypt+1]
 //line sql.y:2182
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitions}
+			yyVAL.partSpec = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].boolean}
 		}
 	case 393:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2186
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
+			yyVAL.partSpec = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitions}
 		}
 	case 394:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2190
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitions}
+			yyVAL.partSpec = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
 	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2194
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: CheckAction, IsAll: true}
+			yyVAL.partSpec = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitions}
 		}
 	case 396:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2198
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitions}
+			yyVAL.partSpec = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
 	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2202
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: OptimizeAction, IsAll: true}
+			yyVAL.partSpec = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitions}
 		}
 	case 398:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2206
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitions}
+			yyVAL.partSpec = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
 	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2210
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: RebuildAction, IsAll: true}
+			yyVAL.partSpec = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitions}
 		}
 	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2214
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitions}
+			yyVAL.partSpec = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
 	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2218
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: RepairAction, IsAll: true}
+			yyVAL.partSpec = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitions}
 		}
 	case 402:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2222
 		{
-			yyVAL.partSpec = &PartitionSpec{Action: UpgradeAction}
+			yyVAL.partSpec = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
 	case 403:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2227
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2226
 		{
-			yyVAL.boolean = false
+			yyVAL.partSpec = &PartitionSpec{Action: UpgradeAction}
 		}
 	case 404:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-0 : yypt+1]
 //line sql.y:2231
 		{
 			yyVAL.boolean = false
@@ -7692,59 +7717,65 @@ yydefault:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2235
 		{
-			yyVAL.boolean = true
+			yyVAL.boolean = false
 		}
 	case 406:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2239
+		{
+			yyVAL.boolean = true
+		}
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2242
+//line sql.y:2246
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 407:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2246
+//line sql.y:2250
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 408:
+	case 409:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2252
+//line sql.y:2256
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 409:
+	case 410:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2256
+//line sql.y:2260
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 410:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2262
+//line sql.y:2266
 		{
 			yyVAL.statement = &RenameTable{TablePairs: yyDollar[3].renameTablePairs}
 		}
-	case 411:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2268
+//line sql.y:2272
 		{
 			yyVAL.renameTablePairs = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
-	case 412:
+	case 413:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2272
+//line sql.y:2276
 		{
 			yyVAL.renameTablePairs = append(yyDollar[1].renameTablePairs, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 413:
+	case 414:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2278
+//line sql.y:2282
 		{
 			yyVAL.statement = &DropTable{FromTables: yyDollar[5].tableNames, IfExists: yyDollar[4].boolean, Temp: yyDollar[2].boolean}
 		}
-	case 414:
+	case 415:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2282
+//line sql.y:2286
 		{
 			// Change this to an alter statement
 			if yyDollar[3].colIdent.Lowered() == "primary" {
@@ -7753,59 +7784,53 @@ yydefault:
 				yyVAL.statement = &AlterTable{Table: yyDollar[5].tableName, AlterOptions: append([]AlterOption{&DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent.String()}}, yyDollar[6].alterOptions...)}
 			}
 		}
-	case 415:
+	case 416:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2291
+//line sql.y:2295
 		{
 			yyVAL.statement = &DropView{FromTables: yyDollar[4].tableNames, IfExists: yyDollar[3].boolean}
 		}
-	case 416:
+	case 417:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2295
+//line sql.y:2299
 		{
 			yyVAL.statement = &DropDatabase{DBName: string(yyDollar[4].colIdent.String()), IfExists: yyDollar[3].boolean}
 		}
-	case 417:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2301
+//line sql.y:2305
 		{
 			yyVAL.statement = &TruncateTable{Table: yyDollar[3].tableName}
 		}
-	case 418:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2305
+//line sql.y:2309
 		{
 			yyVAL.statement = &TruncateTable{Table: yyDollar[2].tableName}
 		}
-	case 419:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2310
-		{
-			yyVAL.statement = &OtherRead{}
-		}
 	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2316
+//line sql.y:2314
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilter}}
+			yyVAL.statement = &OtherRead{}
 		}
 	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2320
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilter}}
 		}
 	case 422:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2324
 		{
-			yyVAL.statement = &Show{&ShowBasic{Full: yyDollar[2].boolean, Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilter}}
 		}
 	case 423:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-7 : yypt+1]
 //line sql.y:2328
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Full: yyDollar[2].boolean, Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}}
 		}
 	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
@@ -7817,7 +7842,7 @@ yydefault:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2336
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
 		}
 	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
@@ -7826,130 +7851,130 @@ yydefault:
 			yyVAL.statement = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilter}}
 		}
 	case 427:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2344
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilter}}
 		}
 	case 428:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2348
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Command: VitessDMLJobs, Filter: yyDollar[3].showFilter}}
 		}
 	case 429:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2352
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilter}}
 		}
 	case 430:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-7 : yypt+1]
 //line sql.y:2356
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Privilege}}
+			yyVAL.statement = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}}
 		}
 	case 431:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:2360
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
 		}
 	case 432:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2364
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Command: Privilege}}
 		}
 	case 433:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2368
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilter}}
 		}
 	case 434:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2372
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilter}}
 		}
 	case 435:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2376
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilter}}
 		}
 	case 436:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2380
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilter}}
 		}
 	case 437:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2384
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].boolean, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilter}}
 		}
 	case 438:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:2388
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].str, Filter: yyDollar[4].showFilter}}
+			yyVAL.statement = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
 		}
 	case 439:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 //line sql.y:2392
 		{
-			yyVAL.statement = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
+			yyVAL.statement = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].boolean, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
 		}
 	case 440:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2396
 		{
-			yyVAL.statement = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
+			yyVAL.statement = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].str, Filter: yyDollar[4].showFilter}}
 		}
 	case 441:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2400
 		{
-			yyVAL.statement = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
+			yyVAL.statement = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
 	case 442:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2404
 		{
-			yyVAL.statement = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
+			yyVAL.statement = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
 	case 443:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2408
 		{
-			yyVAL.statement = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
+			yyVAL.statement = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
 	case 444:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2412
 		{
-			yyVAL.statement = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
+			yyVAL.statement = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
 	case 445:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2416
 		{
-			yyVAL.statement = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
+			yyVAL.statement = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
 	case 446:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2420
 		{
-			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
+			yyVAL.statement = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
 	case 447:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2424
 		{
-			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
+			yyVAL.statement = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
 	case 448:
 		yyDollar = yyS[yypt-4 : yypt+1]
@@ -7958,16 +7983,16 @@ yydefault:
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
 	case 449:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2432
 		{
-			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
 	case 450:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2436
 		{
-			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
 	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
@@ -7982,495 +8007,495 @@ yydefault:
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 	case 453:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2448
 		{
-			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[3].bytes), Scope: ImplicitScope}}
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
 	case 454:
 		yyDollar = yyS[yypt-4 : yypt+1]
 //line sql.y:2452
+		{
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
+		}
+	case 455:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2456
+		{
+			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[3].bytes), Scope: ImplicitScope}}
+		}
+	case 456:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2460
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}}
 		}
-	case 455:
+	case 457:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2457
+//line sql.y:2465
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 456:
+	case 458:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2461
+//line sql.y:2469
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 457:
+	case 459:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2465
+//line sql.y:2473
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
-	case 458:
+	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2469
+//line sql.y:2477
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 459:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2474
+//line sql.y:2482
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
-	case 460:
+	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2488
+//line sql.y:2496
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 461:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2492
+//line sql.y:2500
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 462:
+	case 464:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2496
+//line sql.y:2504
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 463:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2502
+//line sql.y:2510
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 464:
+	case 466:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2506
+//line sql.y:2514
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 465:
+	case 467:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2512
+//line sql.y:2520
 		{
 			yyVAL.str = ""
 		}
-	case 466:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2516
+//line sql.y:2524
 		{
 			yyVAL.str = "extended "
 		}
-	case 467:
+	case 469:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2522
+//line sql.y:2530
 		{
 			yyVAL.boolean = false
 		}
-	case 468:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2526
+//line sql.y:2534
 		{
 			yyVAL.boolean = true
 		}
-	case 469:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2532
+//line sql.y:2540
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 470:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2536
+//line sql.y:2544
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 471:
+	case 473:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2542
+//line sql.y:2550
 		{
 			yyVAL.str = ""
 		}
-	case 472:
+	case 474:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2546
+//line sql.y:2554
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 473:
+	case 475:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2550
+//line sql.y:2558
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 474:
+	case 476:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2556
+//line sql.y:2564
 		{
 			yyVAL.showFilter = nil
 		}
-	case 475:
+	case 477:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2560
+//line sql.y:2568
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 476:
+	case 478:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2564
+//line sql.y:2572
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 477:
+	case 479:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2570
+//line sql.y:2578
 		{
 			yyVAL.showFilter = nil
 		}
-	case 478:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2574
+//line sql.y:2582
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 479:
+	case 481:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2580
+//line sql.y:2588
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 480:
+	case 482:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2584
+//line sql.y:2592
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 481:
+	case 483:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2588
+//line sql.y:2596
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 482:
+	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2594
+//line sql.y:2602
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 483:
+	case 485:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2598
+//line sql.y:2606
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 484:
+	case 486:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2604
+//line sql.y:2612
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 485:
+	case 487:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2608
+//line sql.y:2616
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 486:
+	case 488:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2614
+//line sql.y:2622
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 487:
+	case 489:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2620
+//line sql.y:2628
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 488:
+	case 490:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2624
+//line sql.y:2632
 		{
 			yyVAL.statement = &SRollback{Name: yyDollar[5].colIdent}
 		}
-	case 489:
+	case 491:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2629
+//line sql.y:2637
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 490:
+	case 492:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2631
+//line sql.y:2639
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 491:
+	case 493:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2634
+//line sql.y:2642
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 492:
+	case 494:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2636
+//line sql.y:2644
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 493:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2641
+//line sql.y:2649
 		{
 			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
 		}
-	case 494:
+	case 496:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2647
+//line sql.y:2655
 		{
 			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
 		}
-	case 495:
+	case 497:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2652
+//line sql.y:2660
 		{
 			yyVAL.explainType = EmptyType
 		}
-	case 496:
+	case 498:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2656
+//line sql.y:2664
 		{
 			yyVAL.explainType = JSONType
 		}
-	case 497:
+	case 499:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2660
+//line sql.y:2668
 		{
 			yyVAL.explainType = TreeType
 		}
-	case 498:
+	case 500:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2664
+//line sql.y:2672
 		{
 			yyVAL.explainType = VitessType
 		}
-	case 499:
+	case 501:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2668
+//line sql.y:2676
 		{
 			yyVAL.explainType = TraditionalType
 		}
-	case 500:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2672
+//line sql.y:2680
 		{
 			yyVAL.explainType = AnalyzeType
 		}
-	case 501:
+	case 503:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2678
+//line sql.y:2686
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 502:
+	case 504:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2682
+//line sql.y:2690
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 503:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2686
+//line sql.y:2694
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 504:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2692
+//line sql.y:2700
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 505:
+	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2696
+//line sql.y:2704
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 506:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2700
+//line sql.y:2708
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 507:
+	case 509:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2704
+//line sql.y:2712
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 508:
+	case 510:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2709
+//line sql.y:2717
 		{
 			yyVAL.str = ""
 		}
-	case 509:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2713
+//line sql.y:2721
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 510:
+	case 512:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2717
+//line sql.y:2725
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 511:
+	case 513:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2723
+//line sql.y:2731
 		{
 			yyVAL.statement = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
-	case 512:
+	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2727
+//line sql.y:2735
 		{
 			yyVAL.statement = &ExplainStmt{Type: yyDollar[2].explainType, Statement: yyDollar[3].statement}
 		}
-	case 513:
+	case 515:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2733
+//line sql.y:2741
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 514:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2737
+//line sql.y:2745
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 515:
+	case 517:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2743
+//line sql.y:2751
 		{
 			yyVAL.statement = &LockTables{Tables: yyDollar[3].tableAndLockTypes}
 		}
-	case 516:
+	case 518:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2749
+//line sql.y:2757
 		{
 			yyVAL.tableAndLockTypes = TableAndLockTypes{yyDollar[1].tableAndLockType}
 		}
-	case 517:
+	case 519:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2753
+//line sql.y:2761
 		{
 			yyVAL.tableAndLockTypes = append(yyDollar[1].tableAndLockTypes, yyDollar[3].tableAndLockType)
 		}
-	case 518:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2759
+//line sql.y:2767
 		{
 			yyVAL.tableAndLockType = &TableAndLockType{Table: yyDollar[1].aliasedTableName, Lock: yyDollar[2].lockType}
 		}
-	case 519:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2765
+//line sql.y:2773
 		{
 			yyVAL.lockType = Read
 		}
-	case 520:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2769
+//line sql.y:2777
 		{
 			yyVAL.lockType = ReadLocal
 		}
-	case 521:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2773
+//line sql.y:2781
 		{
 			yyVAL.lockType = Write
 		}
-	case 522:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2777
+//line sql.y:2785
 		{
 			yyVAL.lockType = LowPriorityWrite
 		}
-	case 523:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2783
+//line sql.y:2791
 		{
 			yyVAL.statement = &UnlockTables{}
 		}
-	case 524:
+	case 526:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2789
+//line sql.y:2797
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, FlushOptions: yyDollar[3].strs}
 		}
-	case 525:
+	case 527:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2793
+//line sql.y:2801
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean}
 		}
-	case 526:
+	case 528:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2797
+//line sql.y:2805
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, WithLock: true}
 		}
-	case 527:
+	case 529:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2801
+//line sql.y:2809
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, TableNames: yyDollar[4].tableNames}
 		}
-	case 528:
+	case 530:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2805
+//line sql.y:2813
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, TableNames: yyDollar[4].tableNames, WithLock: true}
 		}
-	case 529:
+	case 531:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2809
+//line sql.y:2817
 		{
 			yyVAL.statement = &Flush{IsLocal: yyDollar[2].boolean, TableNames: yyDollar[4].tableNames, ForExport: true}
 		}
-	case 530:
+	case 532:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2815
+//line sql.y:2823
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 531:
+	case 533:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2819
+//line sql.y:2827
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 532:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2825
-		{
-			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
-		}
-	case 533:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2829
-		{
-			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
-		}
 	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2833
@@ -8484,16 +8509,16 @@ yydefault:
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
 	case 536:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2841
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
 	case 537:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2845
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
 	case 538:
 		yyDollar = yyS[yypt-1 : yypt+1]
@@ -8502,28 +8527,28 @@ yydefault:
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 539:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2853
 		{
-			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + yyDollar[3].str
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 540:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:2857
 		{
-			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 541:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:2861
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + yyDollar[3].str
 		}
 	case 542:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:2865
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
 	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
@@ -8532,501 +8557,513 @@ yydefault:
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 544:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2873
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 545:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2877
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 546:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2874
+//line sql.y:2882
 		{
 			yyVAL.boolean = false
 		}
-	case 545:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2878
+//line sql.y:2886
 		{
 			yyVAL.boolean = true
 		}
-	case 546:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2882
+//line sql.y:2890
 		{
 			yyVAL.boolean = true
 		}
-	case 547:
+	case 549:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2887
+//line sql.y:2895
 		{
 			yyVAL.str = ""
 		}
-	case 548:
+	case 550:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2891
+//line sql.y:2899
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + yyDollar[3].colIdent.String()
 		}
-	case 549:
+	case 551:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2896
+//line sql.y:2904
 		{
 			setAllowComments(yylex, true)
 		}
-	case 550:
+	case 552:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2900
+//line sql.y:2908
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 551:
+	case 553:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2906
+//line sql.y:2914
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 552:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2910
+//line sql.y:2918
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 553:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2916
+//line sql.y:2924
 		{
 			yyVAL.boolean = true
 		}
-	case 554:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2920
+//line sql.y:2928
 		{
 			yyVAL.boolean = false
 		}
-	case 555:
+	case 557:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2924
+//line sql.y:2932
 		{
 			yyVAL.boolean = true
 		}
-	case 556:
+	case 558:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2929
+//line sql.y:2937
 		{
 			yyVAL.str = ""
 		}
-	case 557:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2933
+//line sql.y:2941
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 558:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2937
+//line sql.y:2945
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 559:
+	case 561:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2942
+//line sql.y:2950
 		{
 			yyVAL.boolean = false
 		}
-	case 560:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2946
+//line sql.y:2954
 		{
 			yyVAL.boolean = true
 		}
-	case 561:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2950
+//line sql.y:2958
 		{
 			yyVAL.boolean = true
 		}
-	case 562:
+	case 564:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2955
+//line sql.y:2963
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 563:
+	case 565:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2959
+//line sql.y:2967
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 564:
+	case 566:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2964
+//line sql.y:2972
 		{
 			yyVAL.strs = nil
 		}
-	case 565:
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2968
+//line sql.y:2976
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 566:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2972
+//line sql.y:2980
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 567:
+	case 569:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2976
+//line sql.y:2984
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 568:
+	case 570:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2980
+//line sql.y:2988
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 569:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2986
+//line sql.y:2994
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 570:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2990
+//line sql.y:2998
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 571:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2994
+//line sql.y:3002
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 572:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2998
+//line sql.y:3006
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 573:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3002
+//line sql.y:3010
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 574:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3006
+//line sql.y:3014
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 575:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3012
+//line sql.y:3020
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 576:
+	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3016
+//line sql.y:3024
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 577:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3022
+//line sql.y:3030
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 578:
+	case 580:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3026
+//line sql.y:3034
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 579:
+	case 581:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3030
+//line sql.y:3038
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 580:
+	case 582:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3034
+//line sql.y:3042
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 581:
+	case 583:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3039
+//line sql.y:3047
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 582:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3043
+//line sql.y:3051
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 583:
+	case 585:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3047
+//line sql.y:3055
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 585:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3054
+//line sql.y:3062
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 586:
+	case 588:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3059
+//line sql.y:3067
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 587:
+	case 589:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3063
+//line sql.y:3071
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 588:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3069
+//line sql.y:3077
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 589:
+	case 591:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3073
+//line sql.y:3081
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 592:
+	case 594:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3083
+//line sql.y:3091
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 593:
+	case 595:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3087
+//line sql.y:3095
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].derivedTable, As: yyDollar[3].tableIdent}
 		}
-	case 594:
+	case 596:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3091
+//line sql.y:3099
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 595:
+	case 597:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3097
+//line sql.y:3105
 		{
 			yyVAL.derivedTable = &DerivedTable{yyDollar[2].selStmt}
 		}
-	case 596:
+	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3103
+//line sql.y:3111
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 597:
+	case 599:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3107
+//line sql.y:3115
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 598:
+	case 600:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3112
+//line sql.y:3120
 		{
 			yyVAL.columns = nil
 		}
-	case 599:
+	case 601:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3116
+//line sql.y:3124
 		{
 			yyVAL.columns = yyDollar[2].columns
 		}
-	case 600:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3122
+//line sql.y:3130
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 601:
+	case 603:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3126
+//line sql.y:3134
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 602:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3132
+//line sql.y:3140
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 603:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3136
+//line sql.y:3144
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 604:
+	case 606:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3149
+//line sql.y:3157
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 605:
+	case 607:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3153
+//line sql.y:3161
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 606:
+	case 608:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3157
+//line sql.y:3165
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 607:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3161
+//line sql.y:3169
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 608:
+	case 610:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3167
+//line sql.y:3175
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 609:
+	case 611:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3169
+//line sql.y:3177
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 610:
+	case 612:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3173
+//line sql.y:3181
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 611:
+	case 613:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3175
+//line sql.y:3183
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 612:
+	case 614:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3179
+//line sql.y:3187
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 613:
+	case 615:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3181
+//line sql.y:3189
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 614:
+	case 616:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3184
+//line sql.y:3192
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 615:
+	case 617:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3186
+//line sql.y:3194
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 616:
+	case 618:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3189
+//line sql.y:3197
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 617:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3193
+//line sql.y:3201
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 618:
+	case 620:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3197
+//line sql.y:3205
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 620:
+	case 622:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3204
+//line sql.y:3212
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 621:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3210
+//line sql.y:3218
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 622:
+	case 624:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3214
+//line sql.y:3222
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 623:
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3218
+//line sql.y:3226
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 624:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3224
+//line sql.y:3232
 		{
 			yyVAL.joinType = StraightJoinType
 		}
-	case 625:
+	case 627:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3230
+//line sql.y:3238
 		{
 			yyVAL.joinType = LeftJoinType
 		}
-	case 626:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3234
+//line sql.y:3242
 		{
 			yyVAL.joinType = LeftJoinType
 		}
-	case 627:
+	case 629:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3238
+//line sql.y:3246
 		{
 			yyVAL.joinType = RightJoinType
 		}
-	case 628:
+	case 630:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3242
+//line sql.y:3250
 		{
 			yyVAL.joinType = RightJoinType
 		}
-	case 629:
+	case 631:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3248
+//line sql.y:3256
 		{
 			yyVAL.joinType = NaturalJoinType
 		}
-	case 630:
+	case 632:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3252
+//line sql.y:3260
 		{
 			if yyDollar[2].joinType == LeftJoinType {
 				yyVAL.joinType = NaturalLeftJoinType
@@ -9034,489 +9071,489 @@ yydefault:
 				yyVAL.joinType = NaturalRightJoinType
 			}
 		}
-	case 631:
+	case 633:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3262
+//line sql.y:3270
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 632:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3266
+//line sql.y:3274
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 633:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3272
+//line sql.y:3280
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 634:
+	case 636:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3276
+//line sql.y:3284
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 635:
+	case 637:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3282
+//line sql.y:3290
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 636:
+	case 638:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3287
+//line sql.y:3295
 		{
 			yyVAL.indexHints = nil
 		}
-	case 637:
+	case 639:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3291
+//line sql.y:3299
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columns}
 		}
-	case 638:
+	case 640:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3295
+//line sql.y:3303
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseOp}
 		}
-	case 639:
+	case 641:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3299
+//line sql.y:3307
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columns}
 		}
-	case 640:
+	case 642:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3303
+//line sql.y:3311
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columns}
 		}
-	case 641:
+	case 643:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3308
+//line sql.y:3316
 		{
 			yyVAL.expr = nil
 		}
-	case 642:
+	case 644:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3312
+//line sql.y:3320
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 643:
+	case 645:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3318
+//line sql.y:3326
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 644:
+	case 646:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3322
+//line sql.y:3330
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 645:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3326
+//line sql.y:3334
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 646:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3330
+//line sql.y:3338
 		{
 			yyVAL.expr = &XorExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 647:
+	case 649:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3334
+//line sql.y:3342
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 648:
+	case 650:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3338
+//line sql.y:3346
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].isExprOperator, Expr: yyDollar[1].expr}
 		}
-	case 649:
+	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3342
+//line sql.y:3350
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 650:
+	case 652:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3346
+//line sql.y:3354
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 651:
+	case 653:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3352
+//line sql.y:3360
 		{
 			yyVAL.str = ""
 		}
-	case 652:
+	case 654:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3356
+//line sql.y:3364
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 653:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3362
+//line sql.y:3370
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 654:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3366
+//line sql.y:3374
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 655:
+	case 657:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3372
+//line sql.y:3380
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].comparisonExprOperator, Right: yyDollar[3].expr}
 		}
-	case 656:
+	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3376
+//line sql.y:3384
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InOp, Right: yyDollar[3].colTuple}
 		}
-	case 657:
+	case 659:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3380
+//line sql.y:3388
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInOp, Right: yyDollar[4].colTuple}
 		}
-	case 658:
+	case 660:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3384
+//line sql.y:3392
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeOp, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 659:
+	case 661:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3388
+//line sql.y:3396
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeOp, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 660:
+	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3392
+//line sql.y:3400
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpOp, Right: yyDollar[3].expr}
 		}
-	case 661:
+	case 663:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3396
+//line sql.y:3404
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpOp, Right: yyDollar[4].expr}
 		}
-	case 662:
+	case 664:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3400
+//line sql.y:3408
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenOp, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 663:
+	case 665:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3404
+//line sql.y:3412
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenOp, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 664:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3408
-		{
-			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
-		}
-	case 665:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3414
-		{
-			yyVAL.isExprOperator = IsNullOp
-		}
 	case 666:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3418
+//line sql.y:3416
 		{
-			yyVAL.isExprOperator = IsNotNullOp
+			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
 	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3422
 		{
-			yyVAL.isExprOperator = IsTrueOp
+			yyVAL.isExprOperator = IsNullOp
 		}
 	case 668:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:3426
 		{
-			yyVAL.isExprOperator = IsNotTrueOp
+			yyVAL.isExprOperator = IsNotNullOp
 		}
 	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3430
 		{
-			yyVAL.isExprOperator = IsFalseOp
+			yyVAL.isExprOperator = IsTrueOp
 		}
 	case 670:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:3434
 		{
-			yyVAL.isExprOperator = IsNotFalseOp
+			yyVAL.isExprOperator = IsNotTrueOp
 		}
 	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3440
+//line sql.y:3438
 		{
-			yyVAL.comparisonExprOperator = EqualOp
+			yyVAL.isExprOperator = IsFalseOp
 		}
 	case 672:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3444
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3442
 		{
-			yyVAL.comparisonExprOperator = LessThanOp
+			yyVAL.isExprOperator = IsNotFalseOp
 		}
 	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3448
 		{
-			yyVAL.comparisonExprOperator = GreaterThanOp
+			yyVAL.comparisonExprOperator = EqualOp
 		}
 	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3452
 		{
-			yyVAL.comparisonExprOperator = LessEqualOp
+			yyVAL.comparisonExprOperator = LessThanOp
 		}
 	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3456
 		{
-			yyVAL.comparisonExprOperator = GreaterEqualOp
+			yyVAL.comparisonExprOperator = GreaterThanOp
 		}
 	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3460
 		{
-			yyVAL.comparisonExprOperator = NotEqualOp
+			yyVAL.comparisonExprOperator = LessEqualOp
 		}
 	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3464
 		{
-			yyVAL.comparisonExprOperator = NullSafeEqualOp
+			yyVAL.comparisonExprOperator = GreaterEqualOp
 		}
 	case 678:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3469
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3468
 		{
-			yyVAL.expr = nil
+			yyVAL.comparisonExprOperator = NotEqualOp
 		}
 	case 679:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3473
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3472
 		{
-			yyVAL.expr = yyDollar[2].expr
+			yyVAL.comparisonExprOperator = NullSafeEqualOp
 		}
 	case 680:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3479
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3477
 		{
-			yyVAL.colTuple = yyDollar[1].valTuple
+			yyVAL.expr = nil
 		}
 	case 681:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3483
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3481
 		{
-			yyVAL.colTuple = yyDollar[1].subquery
+			yyVAL.expr = yyDollar[2].expr
 		}
 	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3487
 		{
-			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
+			yyVAL.colTuple = yyDollar[1].valTuple
 		}
 	case 683:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3493
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3491
 		{
-			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
+			yyVAL.colTuple = yyDollar[1].subquery
 		}
 	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3499
+//line sql.y:3495
 		{
-			yyVAL.exprs = Exprs{yyDollar[1].expr}
+			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
 	case 685:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3503
+//line sql.y:3501
 		{
-			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
 	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3509
+//line sql.y:3507
 		{
-			yyVAL.expr = yyDollar[1].expr
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
 	case 687:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3513
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3511
 		{
-			yyVAL.expr = yyDollar[1].boolVal
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
 	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3517
 		{
-			yyVAL.expr = yyDollar[1].colName
+			yyVAL.expr = yyDollar[1].expr
 		}
 	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3521
 		{
-			yyVAL.expr = yyDollar[1].expr
+			yyVAL.expr = yyDollar[1].boolVal
 		}
 	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3525
 		{
-			yyVAL.expr = yyDollar[1].subquery
+			yyVAL.expr = yyDollar[1].colName
 		}
 	case 691:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3529
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndOp, Right: yyDollar[3].expr}
+			yyVAL.expr = yyDollar[1].expr
 		}
 	case 692:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-1 : yypt+1]
 //line sql.y:3533
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrOp, Right: yyDollar[3].expr}
+			yyVAL.expr = yyDollar[1].subquery
 		}
 	case 693:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3537
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndOp, Right: yyDollar[3].expr}
 		}
 	case 694:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3541
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrOp, Right: yyDollar[3].expr}
 		}
 	case 695:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3545
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorOp, Right: yyDollar[3].expr}
 		}
 	case 696:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3549
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusOp, Right: yyDollar[3].expr}
 		}
 	case 697:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3553
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusOp, Right: yyDollar[3].expr}
 		}
 	case 698:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3557
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultOp, Right: yyDollar[3].expr}
 		}
 	case 699:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3561
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivOp, Right: yyDollar[3].expr}
 		}
 	case 700:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3565
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivOp, Right: yyDollar[3].expr}
 		}
 	case 701:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3569
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
 		}
 	case 702:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3573
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
 		}
 	case 703:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3577
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftOp, Right: yyDollar[3].expr}
 		}
 	case 704:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3581
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightOp, Right: yyDollar[3].expr}
 		}
 	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3585
 		{
-			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
 	case 706:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3589
 		{
-			yyVAL.expr = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
 	case 707:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 //line sql.y:3593
 		{
-			yyVAL.expr = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].expr}
+			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
 	case 708:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:3597
 		{
-			yyVAL.expr = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].expr}
+			yyVAL.expr = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].expr}
 		}
 	case 709:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:3601
 		{
-			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].expr}
+			yyVAL.expr = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].expr}
 		}
 	case 710:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:3605
 		{
-			yyVAL.expr = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].expr}
+			yyVAL.expr = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].expr}
 		}
 	case 711:
 		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:3609
+		{
+			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].expr}
+		}
+	case 712:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3613
+		{
+			yyVAL.expr = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].expr}
+		}
+	case 713:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3617
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -9524,9 +9561,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusOp, Expr: yyDollar[2].expr}
 			}
 		}
-	case 712:
+	case 714:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3617
+//line sql.y:3625
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -9540,21 +9577,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].expr}
 			}
 		}
-	case 713:
+	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3631
+//line sql.y:3639
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].expr}
 		}
-	case 714:
+	case 716:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3635
+//line sql.y:3643
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].expr}
 		}
-	case 715:
+	case 717:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3639
+//line sql.y:3647
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -9562,503 +9599,503 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 720:
+	case 722:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3657
+//line sql.y:3665
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 721:
+	case 723:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3661
+//line sql.y:3669
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 722:
+	case 724:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3665
+//line sql.y:3673
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 723:
+	case 725:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3669
+//line sql.y:3677
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 724:
+	case 726:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3679
+//line sql.y:3687
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 725:
+	case 727:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3683
+//line sql.y:3691
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 726:
+	case 728:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3687
+//line sql.y:3695
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 727:
+	case 729:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3691
+//line sql.y:3699
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 728:
+	case 730:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3695
+//line sql.y:3703
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 729:
+	case 731:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3699
+//line sql.y:3707
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 730:
+	case 732:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3703
+//line sql.y:3711
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 731:
+	case 733:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3707
+//line sql.y:3715
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 732:
+	case 734:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3711
+//line sql.y:3719
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 733:
+	case 735:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3715
+//line sql.y:3723
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].matchExprOption}
 		}
-	case 734:
+	case 736:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3719
+//line sql.y:3727
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].boolean, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
 		}
-	case 735:
+	case 737:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3723
+//line sql.y:3731
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 736:
+	case 738:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3727
+//line sql.y:3735
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 737:
+	case 739:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3737
+//line sql.y:3745
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 738:
+	case 740:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3741
+//line sql.y:3749
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 739:
+	case 741:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3745
+//line sql.y:3753
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 740:
+	case 742:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3750
+//line sql.y:3758
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 741:
+	case 743:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3755
+//line sql.y:3763
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 742:
+	case 744:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3760
+//line sql.y:3768
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 743:
+	case 745:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3766
+//line sql.y:3774
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 744:
+	case 746:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3771
+//line sql.y:3779
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 745:
+	case 747:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3776
+//line sql.y:3784
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 746:
+	case 748:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3780
+//line sql.y:3788
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 747:
+	case 749:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3784
+//line sql.y:3792
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 748:
+	case 750:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3789
+//line sql.y:3797
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 749:
+	case 751:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3794
+//line sql.y:3802
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 750:
+	case 752:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3799
+//line sql.y:3807
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 751:
+	case 753:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3803
+//line sql.y:3811
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 752:
+	case 754:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3807
+//line sql.y:3815
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 755:
+	case 757:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3817
+//line sql.y:3825
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 756:
+	case 758:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3827
+//line sql.y:3835
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 757:
+	case 759:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3831
+//line sql.y:3839
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 758:
+	case 760:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3835
+//line sql.y:3843
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 759:
+	case 761:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3839
+//line sql.y:3847
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 760:
+	case 762:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3843
+//line sql.y:3851
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 761:
+	case 763:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3847
+//line sql.y:3855
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 762:
+	case 764:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3851
+//line sql.y:3859
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 763:
+	case 765:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3857
+//line sql.y:3865
 		{
 			yyVAL.matchExprOption = NoOption
 		}
-	case 764:
+	case 766:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3861
+//line sql.y:3869
 		{
 			yyVAL.matchExprOption = BooleanModeOpt
 		}
-	case 765:
+	case 767:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3865
+//line sql.y:3873
 		{
 			yyVAL.matchExprOption = NaturalLanguageModeOpt
 		}
-	case 766:
+	case 768:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3869
+//line sql.y:3877
 		{
 			yyVAL.matchExprOption = NaturalLanguageModeWithQueryExpansionOpt
 		}
-	case 767:
+	case 769:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3873
+//line sql.y:3881
 		{
 			yyVAL.matchExprOption = QueryExpansionOpt
 		}
-	case 768:
+	case 770:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3879
+//line sql.y:3887
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 769:
+	case 771:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3883
+//line sql.y:3891
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 770:
+	case 772:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3887
+//line sql.y:3895
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 771:
+	case 773:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3893
+//line sql.y:3901
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 772:
+	case 774:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3897
+//line sql.y:3905
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
-	case 773:
+	case 775:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3901
+//line sql.y:3909
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: string(yyDollar[3].colIdent.String())}
 		}
-	case 774:
+	case 776:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3905
+//line sql.y:3913
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 775:
+	case 777:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3909
+//line sql.y:3917
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 776:
+	case 778:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3913
+//line sql.y:3921
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 777:
+	case 779:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3919
+//line sql.y:3927
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 778:
+	case 780:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3923
+//line sql.y:3931
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 779:
+	case 781:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3927
+//line sql.y:3935
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 780:
+	case 782:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3931
+//line sql.y:3939
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 781:
+	case 783:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3935
+//line sql.y:3943
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 782:
+	case 784:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3939
+//line sql.y:3947
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 783:
+	case 785:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3943
+//line sql.y:3951
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 784:
+	case 786:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3948
+//line sql.y:3956
 		{
 			yyVAL.expr = nil
 		}
-	case 785:
+	case 787:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3952
+//line sql.y:3960
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 786:
+	case 788:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3957
+//line sql.y:3965
 		{
 			yyVAL.str = string("")
 		}
-	case 787:
+	case 789:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3961
+//line sql.y:3969
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 788:
+	case 790:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3967
+//line sql.y:3975
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 789:
+	case 791:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3971
+//line sql.y:3979
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 790:
+	case 792:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3977
+//line sql.y:3985
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 791:
+	case 793:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3982
+//line sql.y:3990
 		{
 			yyVAL.expr = nil
 		}
-	case 792:
+	case 794:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3986
+//line sql.y:3994
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 793:
+	case 795:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3992
+//line sql.y:4000
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 794:
+	case 796:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3996
+//line sql.y:4004
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 795:
+	case 797:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4000
+//line sql.y:4008
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 796:
+	case 798:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4006
+//line sql.y:4014
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 797:
+	case 799:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4010
+//line sql.y:4018
 		{
 			yyVAL.expr = NewHexLiteral(yyDollar[1].bytes)
 		}
-	case 798:
+	case 800:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4014
+//line sql.y:4022
 		{
 			yyVAL.expr = NewBitLiteral(yyDollar[1].bytes)
 		}
-	case 799:
+	case 801:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4018
+//line sql.y:4026
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 800:
+	case 802:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4022
+//line sql.y:4030
 		{
 			yyVAL.expr = NewFloatLiteral(yyDollar[1].bytes)
 		}
-	case 801:
+	case 803:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4026
+//line sql.y:4034
 		{
 			yyVAL.expr = NewHexNumLiteral(yyDollar[1].bytes)
 		}
-	case 802:
+	case 804:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4030
+//line sql.y:4038
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 803:
+	case 805:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4034
+//line sql.y:4042
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 804:
+	case 806:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4040
+//line sql.y:4048
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -10067,597 +10104,597 @@ yydefault:
 			}
 			yyVAL.expr = NewIntLiteral([]byte("1"))
 		}
-	case 805:
+	case 807:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4049
+//line sql.y:4057
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 806:
+	case 808:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4053
+//line sql.y:4061
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 807:
+	case 809:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4058
+//line sql.y:4066
 		{
 			yyVAL.exprs = nil
 		}
-	case 808:
+	case 810:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4062
+//line sql.y:4070
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 809:
+	case 811:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4067
+//line sql.y:4075
 		{
 			yyVAL.expr = nil
 		}
-	case 810:
+	case 812:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4071
+//line sql.y:4079
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 811:
+	case 813:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4076
+//line sql.y:4084
 		{
 			yyVAL.orderBy = nil
 		}
-	case 812:
+	case 814:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4080
+//line sql.y:4088
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 813:
+	case 815:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4086
+//line sql.y:4094
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 814:
+	case 816:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4090
+//line sql.y:4098
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 815:
+	case 817:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4096
+//line sql.y:4104
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].orderDirection}
 		}
-	case 816:
+	case 818:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4101
+//line sql.y:4109
 		{
 			yyVAL.orderDirection = AscOrder
 		}
-	case 817:
+	case 819:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4105
+//line sql.y:4113
 		{
 			yyVAL.orderDirection = AscOrder
 		}
-	case 818:
+	case 820:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4109
+//line sql.y:4117
 		{
 			yyVAL.orderDirection = DescOrder
 		}
-	case 819:
+	case 821:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4114
+//line sql.y:4122
 		{
 			yyVAL.limit = nil
 		}
-	case 820:
+	case 822:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4118
+//line sql.y:4126
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 821:
+	case 823:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4122
+//line sql.y:4130
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 822:
+	case 824:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4126
+//line sql.y:4134
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 823:
+	case 825:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4131
+//line sql.y:4139
 		{
 			yyVAL.alterOptions = nil
 		}
-	case 824:
+	case 826:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4135
+//line sql.y:4143
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption, yyDollar[2].alterOption}
 		}
-	case 825:
+	case 827:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4139
+//line sql.y:4147
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption, yyDollar[2].alterOption}
 		}
-	case 826:
+	case 828:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4143
+//line sql.y:4151
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 827:
+	case 829:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4147
+//line sql.y:4155
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 828:
+	case 830:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4154
+//line sql.y:4162
 		{
 			yyVAL.alterOption = &LockOption{Type: DefaultType}
 		}
-	case 829:
+	case 831:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4158
+//line sql.y:4166
 		{
 			yyVAL.alterOption = &LockOption{Type: NoneType}
 		}
-	case 830:
+	case 832:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4162
+//line sql.y:4170
 		{
 			yyVAL.alterOption = &LockOption{Type: SharedType}
 		}
-	case 831:
+	case 833:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4166
+//line sql.y:4174
 		{
 			yyVAL.alterOption = &LockOption{Type: ExclusiveType}
 		}
-	case 832:
+	case 834:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4172
+//line sql.y:4180
 		{
 			yyVAL.alterOption = AlgorithmValue(yyDollar[3].bytes)
 		}
-	case 833:
+	case 835:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4176
+//line sql.y:4184
 		{
 			yyVAL.alterOption = AlgorithmValue(yyDollar[3].bytes)
 		}
-	case 834:
+	case 836:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4180
+//line sql.y:4188
 		{
 			yyVAL.alterOption = AlgorithmValue(yyDollar[3].bytes)
 		}
-	case 835:
+	case 837:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4185
+//line sql.y:4193
 		{
 			yyVAL.str = ""
 		}
-	case 836:
+	case 838:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4189
+//line sql.y:4197
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 837:
+	case 839:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4193
+//line sql.y:4201
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 838:
+	case 840:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4197
+//line sql.y:4205
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 839:
+	case 841:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4202
+//line sql.y:4210
 		{
 			yyVAL.str = ""
 		}
-	case 840:
+	case 842:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4206
+//line sql.y:4214
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 841:
+	case 843:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4212
+//line sql.y:4220
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 842:
+	case 844:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4216
+//line sql.y:4224
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 843:
+	case 845:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4221
+//line sql.y:4229
 		{
 			yyVAL.str = ""
 		}
-	case 844:
+	case 846:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4225
+//line sql.y:4233
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 845:
+	case 847:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4230
+//line sql.y:4238
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 846:
+	case 848:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4234
+//line sql.y:4242
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 847:
+	case 849:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4238
+//line sql.y:4246
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 848:
+	case 850:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4243
+//line sql.y:4251
 		{
 			yyVAL.str = ""
 		}
-	case 849:
+	case 851:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4247
+//line sql.y:4255
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 850:
+	case 852:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4253
+//line sql.y:4261
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 851:
+	case 853:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4257
+//line sql.y:4265
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 852:
+	case 854:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4261
+//line sql.y:4269
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'@" + string(yyDollar[2].bytes)
 		}
-	case 853:
+	case 855:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4265
+//line sql.y:4273
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 854:
+	case 856:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4270
+//line sql.y:4278
 		{
 			yyVAL.lock = NoLock
 		}
-	case 855:
+	case 857:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4274
+//line sql.y:4282
 		{
 			yyVAL.lock = ForUpdateLock
 		}
-	case 856:
+	case 858:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4278
+//line sql.y:4286
 		{
 			yyVAL.lock = ShareModeLock
 		}
-	case 857:
+	case 859:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4283
+//line sql.y:4291
 		{
 			yyVAL.selectInto = nil
 		}
-	case 858:
+	case 860:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:4287
+//line sql.y:4295
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoOutfileS3, FileName: string(yyDollar[4].bytes), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
-	case 859:
+	case 861:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4291
+//line sql.y:4299
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoDumpfile, FileName: string(yyDollar[3].bytes), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
-	case 860:
+	case 862:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4295
+//line sql.y:4303
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoOutfile, FileName: string(yyDollar[3].bytes), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
-	case 861:
+	case 863:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4300
+//line sql.y:4308
 		{
 			yyVAL.str = ""
 		}
-	case 862:
+	case 864:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4304
+//line sql.y:4312
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 863:
+	case 865:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4308
+//line sql.y:4316
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 864:
+	case 866:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4313
+//line sql.y:4321
 		{
 			yyVAL.str = ""
 		}
-	case 865:
+	case 867:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4317
+//line sql.y:4325
 		{
 			yyVAL.str = " header"
 		}
-	case 866:
+	case 868:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4322
+//line sql.y:4330
 		{
 			yyVAL.str = ""
 		}
-	case 867:
+	case 869:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4326
+//line sql.y:4334
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 868:
+	case 870:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4330
+//line sql.y:4338
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 869:
+	case 871:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4335
+//line sql.y:4343
 		{
 			yyVAL.str = ""
 		}
-	case 870:
+	case 872:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4339
+//line sql.y:4347
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 871:
+	case 873:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4343
+//line sql.y:4351
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 872:
+	case 874:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4349
+//line sql.y:4357
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 873:
+	case 875:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4354
+//line sql.y:4362
 		{
 			yyVAL.str = ""
 		}
-	case 874:
+	case 876:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4358
+//line sql.y:4366
 		{
 			yyVAL.str = " lines" + yyDollar[2].str + yyDollar[3].str
 		}
-	case 875:
+	case 877:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4363
+//line sql.y:4371
 		{
 			yyVAL.str = ""
 		}
-	case 876:
+	case 878:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4367
+//line sql.y:4375
 		{
 			yyVAL.str = " starting by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 877:
+	case 879:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4372
+//line sql.y:4380
 		{
 			yyVAL.str = ""
 		}
-	case 878:
+	case 880:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4376
+//line sql.y:4384
 		{
 			yyVAL.str = " terminated by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 879:
+	case 881:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4381
+//line sql.y:4389
 		{
 			yyVAL.str = ""
 		}
-	case 880:
+	case 882:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4385
+//line sql.y:4393
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str + yyDollar[3].str + yyDollar[4].str
 		}
-	case 881:
+	case 883:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4390
+//line sql.y:4398
 		{
 			yyVAL.str = ""
 		}
-	case 882:
+	case 884:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4394
+//line sql.y:4402
 		{
 			yyVAL.str = " escaped by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 883:
+	case 885:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4399
+//line sql.y:4407
 		{
 			yyVAL.str = ""
 		}
-	case 884:
+	case 886:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4403
+//line sql.y:4411
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by '" + string(yyDollar[4].bytes) + "'"
 		}
-	case 885:
+	case 887:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4408
+//line sql.y:4416
 		{
 			yyVAL.str = ""
 		}
-	case 886:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4412
+//line sql.y:4420
 		{
 			yyVAL.str = " optionally"
 		}
-	case 887:
+	case 889:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4425
+//line sql.y:4433
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 888:
+	case 890:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4429
+//line sql.y:4437
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 889:
+	case 891:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4433
+//line sql.y:4441
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 890:
+	case 892:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4437
+//line sql.y:4445
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[4].values}
 		}
-	case 891:
+	case 893:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4441
+//line sql.y:4449
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 892:
+	case 894:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4447
+//line sql.y:4455
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 893:
+	case 895:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4451
+//line sql.y:4459
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 894:
+	case 896:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4455
+//line sql.y:4463
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 895:
+	case 897:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4459
+//line sql.y:4467
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 896:
+	case 898:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4464
+//line sql.y:4472
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 897:
+	case 899:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4468
+//line sql.y:4476
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 898:
+	case 900:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4474
+//line sql.y:4482
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 899:
+	case 901:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4478
+//line sql.y:4486
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 900:
+	case 902:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4484
+//line sql.y:4492
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 901:
+	case 903:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4488
+//line sql.y:4496
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 902:
+	case 904:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4494
+//line sql.y:4502
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 903:
+	case 905:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4500
+//line sql.y:4508
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = yyDollar[1].valTuple[0]
@@ -10665,265 +10702,271 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 904:
+	case 906:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4510
+//line sql.y:4518
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 905:
+	case 907:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4514
+//line sql.y:4522
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 906:
+	case 908:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4520
+//line sql.y:4528
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 907:
+	case 909:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4526
+//line sql.y:4534
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 908:
+	case 910:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4530
+//line sql.y:4538
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 909:
+	case 911:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4536
+//line sql.y:4544
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("on"))}
 		}
-	case 910:
+	case 912:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4540
+//line sql.y:4548
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("off"))}
 		}
-	case 911:
+	case 913:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4544
+//line sql.y:4552
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].expr}
 		}
-	case 912:
+	case 914:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4548
+//line sql.y:4556
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Scope: ImplicitScope, Expr: yyDollar[2].expr}
 		}
-	case 913:
+	case 915:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4552
+//line sql.y:4560
 		{
 			yyDollar[2].setExpr.Scope = yyDollar[1].scope
 			yyVAL.setExpr = yyDollar[2].setExpr
 		}
-	case 915:
+	case 917:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4560
+//line sql.y:4568
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 918:
+	case 920:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4570
+//line sql.y:4578
 		{
 			yyVAL.expr = NewStrLiteral([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 919:
+	case 921:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4574
+//line sql.y:4582
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 920:
+	case 922:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4578
+//line sql.y:4586
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 923:
+	case 925:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4587
+//line sql.y:4595
 		{
 			yyVAL.boolean = false
 		}
-	case 924:
+	case 926:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4589
+//line sql.y:4597
 		{
 			yyVAL.boolean = true
 		}
-	case 925:
+	case 927:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4592
+//line sql.y:4600
 		{
 			yyVAL.boolean = false
 		}
-	case 926:
+	case 928:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4594
+//line sql.y:4602
 		{
 			yyVAL.boolean = true
 		}
-	case 927:
+	case 929:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4597
+//line sql.y:4605
 		{
 			yyVAL.boolean = false
 		}
-	case 928:
+	case 930:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4599
+//line sql.y:4607
 		{
 			yyVAL.boolean = true
 		}
-	case 929:
+	case 931:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4602
+//line sql.y:4610
 		{
 			yyVAL.ignore = false
 		}
-	case 930:
+	case 932:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4604
+//line sql.y:4612
 		{
 			yyVAL.ignore = true
 		}
-	case 931:
+	case 933:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4607
+//line sql.y:4615
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 932:
+	case 934:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4609
+//line sql.y:4617
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 933:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4611
+//line sql.y:4619
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 934:
+	case 936:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4615
+//line sql.y:4623
 		{
 			yyVAL.statement = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprs}
 		}
-	case 935:
+	case 937:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4629
+		{
+			yyVAL.statement = &CancelDMLJob{UUID: string(yyDollar[3].bytes)}
+		}
+	case 938:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4620
+//line sql.y:4634
 		{
 			yyVAL.exprs = nil
 		}
-	case 936:
+	case 939:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4624
+//line sql.y:4638
 		{
 			yyVAL.exprs = yyDollar[1].exprs
 		}
-	case 937:
+	case 940:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4629
+//line sql.y:4643
 		{
 			yyVAL.indexOptions = nil
 		}
-	case 938:
+	case 941:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4631
+//line sql.y:4645
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 939:
+	case 942:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4635
+//line sql.y:4649
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[2].colIdent.String())}
 		}
-	case 940:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4641
+//line sql.y:4655
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 941:
+	case 944:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4645
+//line sql.y:4659
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 943:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4652
+//line sql.y:4666
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 944:
+	case 947:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4658
+//line sql.y:4672
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 945:
+	case 948:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4662
+//line sql.y:4676
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 947:
+	case 950:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4669
+//line sql.y:4683
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 1346:
+	case 1352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5092
+//line sql.y:5109
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1347:
+	case 1353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5101
+//line sql.y:5118
 		{
 			decNesting(yylex)
 		}
-	case 1348:
+	case 1354:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5106
+//line sql.y:5123
 		{
 			skipToEnd(yylex)
 		}
-	case 1349:
+	case 1355:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5111
+//line sql.y:5128
 		{
 			skipToEnd(yylex)
 		}
-	case 1350:
+	case 1356:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5115
+//line sql.y:5132
 		{
 			skipToEnd(yylex)
 		}
-	case 1351:
+	case 1357:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5119
+//line sql.y:5136
 		{
 			skipToEnd(yylex)
 		}
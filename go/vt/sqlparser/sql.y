@@ -257,6 +257,9 @@ func skipToEnd(yylex interface{}) {
 // Flush tokens
 %token <bytes> NO_WRITE_TO_BINLOG LOGS ERROR GENERAL HOSTS OPTIMIZER_COSTS USER_RESOURCES SLOW CHANNEL RELAY EXPORT
 
+// Background DML job tokens
+%token <bytes> CANCEL VITESS_DML_JOB VITESS_DML_JOBS
+
 // TableOptions tokens
 %token <bytes> AVG_ROW_LENGTH CONNECTION CHECKSUM DELAY_KEY_WRITE ENCRYPTION ENGINE INSERT_METHOD MAX_ROWS MIN_ROWS PACK_KEYS PASSWORD
 %token <bytes> FIXED DYNAMIC COMPRESSED REDUNDANT COMPACT ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES STORAGE MEMORY DISK
@@ -279,7 +282,7 @@ func skipToEnd(yylex interface{}) {
 %type <boolean> default_optional
 %type <statement> analyze_statement show_statement use_statement other_statement
 %type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement load_statement
-%type <statement> lock_statement unlock_statement call_statement
+%type <statement> lock_statement unlock_statement call_statement cancel_statement
 %type <bytes2> comment_opt comment_list
 %type <str> wild_opt check_option_opt cascade_or_local_opt restrict_or_cascade_opt
 %type <explainType> explain_format_opt
@@ -447,6 +450,7 @@ command:
 | lock_statement
 | unlock_statement
 | call_statement
+| cancel_statement
 | /*empty*/
 {
   setParseTree(yylex, nil)
@@ -2340,6 +2344,10 @@ show_statement:
   {
     $$ = &Show{&ShowBasic{Command: Keyspace, Filter: $3}}
   }
+| SHOW VITESS_DML_JOBS like_or_where_opt
+  {
+    $$ = &Show{&ShowBasic{Command: VitessDMLJobs, Filter: $3}}
+  }
 | SHOW FUNCTION STATUS like_or_where_opt
   {
     $$ = &Show{&ShowBasic{Command: Function, Filter: $4}}
@@ -4616,6 +4624,12 @@ call_statement:
     $$ = &CallProc{Name: $2, Params: $4}
   }
 
+cancel_statement:
+  CANCEL VITESS_DML_JOB STRING
+  {
+    $$ = &CancelDMLJob{UUID: string($3)}
+  }
+
 expression_list_opt:  
   {
     $$ = nil
@@ -4839,6 +4853,7 @@ non_reserved_keyword:
 | BOOL
 | BOOLEAN
 | BUCKETS
+| CANCEL
 | CASCADE
 | CASCADED
 | CHANNEL
@@ -5077,6 +5092,8 @@ non_reserved_keyword:
 | VINDEXES
 | VISIBLE
 | VITESS
+| VITESS_DML_JOB
+| VITESS_DML_JOBS
 | VITESS_KEYSPACES
 | VITESS_METADATA
 | VITESS_SHARDS
@@ -103,6 +103,7 @@ var keywords = map[string]int{
 	"both":                UNUSED,
 	"by":                  BY,
 	"call":                CALL,
+	"cancel":              CANCEL,
 	"cascade":             CASCADE,
 	"cascaded":            CASCADED,
 	"case":                CASE,
@@ -474,6 +475,8 @@ var keywords = map[string]int{
 	"vindexes":            VINDEXES,
 	"view":                VIEW,
 	"vitess":              VITESS,
+	"vitess_dml_job":      VITESS_DML_JOB,
+	"vitess_dml_jobs":     VITESS_DML_JOBS,
 	"vitess_keyspaces":    VITESS_KEYSPACES,
 	"vitess_metadata":     VITESS_METADATA,
 	"vitess_shards":       VITESS_SHARDS,
@@ -154,6 +154,28 @@ type Conn interface {
 	// filePath is a path relative to the root directory of the cell.
 	Watch(ctx context.Context, filePath string) (current *WatchData, changes <-chan *WatchData, cancel CancelFunc)
 
+	// WatchRecursive starts watching all the files under a directory
+	// in the provided cell. It returns the current value of every
+	// file under dirPath, a 'changes' channel to read the changes
+	// from, and a 'cancel' function to call to stop the watch. The
+	// directory doesn't need to exist: files created under it later
+	// on show up on the 'changes' channel. If the initial read
+	// fails, err is set, and the other return values are nil.
+	//
+	// Each record on the 'changes' channel has the Path of the file
+	// it is about, and either its new Contents / Version, or Err set
+	// to ErrNoNode if the file was deleted. A record with an empty
+	// Path and Err != nil ends the watch, with ErrInterrupted if
+	// 'cancel' was called. The channel is closed right after that
+	// record, and has to be drained of all events until then.
+	//
+	// Like Watch, the 'changes' channel can skip intermediate
+	// versions of rapidly changing files, or repeat a version.
+	//
+	// dirPath is a path relative to the root directory of the cell,
+	// and so are the Paths that are returned.
+	WatchRecursive(ctx context.Context, dirPath string) (current []*WatchDataRecursive, changes <-chan *WatchDataRecursive, cancel CancelFunc, err error)
+
 	//
 	// Master election methods. This is meant to have a small
 	// number of processes elect a master within a group. The
@@ -244,7 +266,7 @@ type LockDescriptor interface {
 	Unlock(ctx context.Context) error
 }
 
-// CancelFunc is returned by the Watch method.
+// CancelFunc is returned by the Watch and WatchRecursive methods.
 type CancelFunc func()
 
 // WatchData is the structure returned by the Watch() API.
@@ -270,6 +292,16 @@ type WatchData struct {
 	Err error
 }
 
+// WatchDataRecursive is the structure returned by the WatchRecursive() API.
+// It has the WatchData of the file at Path.
+type WatchDataRecursive struct {
+	// Path is the path of the file, relative to the root directory
+	// of the cell.
+	Path string
+
+	WatchData
+}
+
 // MasterParticipation is the object returned by NewMasterParticipation.
 // Sample usage:
 //
@@ -19,6 +19,7 @@ package consultopo
 import (
 	"flag"
 	"path"
+	"strings"
 	"time"
 
 	"context"
@@ -127,3 +128,120 @@ func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <
 
 	return wd, notifications, topo.CancelFunc(watchCancel)
 }
+
+// WatchRecursive is part of the topo.Conn interface.
+// Consul has no way of reporting which keys under a prefix changed, so
+// every time the prefix changes, all of its keys are listed and compared
+// to the ones that were seen before.
+func (s *Server) WatchRecursive(ctx context.Context, dirPath string) ([]*topo.WatchDataRecursive, <-chan *topo.WatchDataRecursive, topo.CancelFunc, error) {
+	nodePath := path.Join(s.root, dirPath) + "/"
+	if nodePath == "//" {
+		// Special case where c.root is "/", dirPath is empty,
+		// we would end up with "//". in that case, we want "/".
+		nodePath = "/"
+	}
+
+	// Initial list.
+	pairs, meta, err := s.kv.List(nodePath, nil)
+	if err != nil {
+		return nil, nil, nil, convertError(err, nodePath)
+	}
+	versions := make(map[string]uint64, len(pairs))
+	var current []*topo.WatchDataRecursive
+	for _, pair := range pairs {
+		versions[pair.Key] = pair.ModifyIndex
+		current = append(current, &topo.WatchDataRecursive{
+			Path: s.relativePath(pair.Key),
+			WatchData: topo.WatchData{
+				Contents: pair.Value,
+				Version:  ConsulVersion(pair.ModifyIndex),
+			},
+		})
+	}
+
+	// Create a context, will be used to cancel the watch.
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+
+	// Create the notifications channel, send updates to it.
+	notifications := make(chan *topo.WatchDataRecursive, 100)
+	go func() {
+		defer close(notifications)
+
+		waitIndex := meta.LastIndex
+		for {
+			opts := &api.QueryOptions{
+				WaitIndex: waitIndex,
+				WaitTime:  *watchPollDuration,
+			}
+
+			// See Watch for why the Context has a timeout.
+			getCtx, cancelGetCtx := context.WithTimeout(watchCtx, 2*opts.WaitTime)
+			pairs, meta, err := s.kv.List(nodePath, opts.WithContext(getCtx))
+			cancelGetCtx()
+			if err != nil {
+				// Serious error or context timeout/cancelled.
+				notifications <- &topo.WatchDataRecursive{WatchData: topo.WatchData{
+					Err: convertError(err, nodePath),
+				}}
+				return
+			}
+
+			// The index can go backwards if the Consul state is reset,
+			// Consul then recommends starting over at 0.
+			waitIndex = meta.LastIndex
+			if waitIndex < opts.WaitIndex {
+				waitIndex = 0
+			}
+
+			// Send the files that were created or updated...
+			seen := make(map[string]bool, len(pairs))
+			for _, pair := range pairs {
+				seen[pair.Key] = true
+				if version, ok := versions[pair.Key]; ok && version == pair.ModifyIndex {
+					continue
+				}
+				versions[pair.Key] = pair.ModifyIndex
+				notifications <- &topo.WatchDataRecursive{
+					Path: s.relativePath(pair.Key),
+					WatchData: topo.WatchData{
+						Contents: pair.Value,
+						Version:  ConsulVersion(pair.ModifyIndex),
+					},
+				}
+			}
+
+			// ...and the ones that were deleted.
+			for key := range versions {
+				if seen[key] {
+					continue
+				}
+				delete(versions, key)
+				filePath := s.relativePath(key)
+				notifications <- &topo.WatchDataRecursive{
+					Path: filePath,
+					WatchData: topo.WatchData{
+						Err: topo.NewError(topo.NoNode, filePath),
+					},
+				}
+			}
+
+			// See if the watch was canceled.
+			select {
+			case <-watchCtx.Done():
+				notifications <- &topo.WatchDataRecursive{WatchData: topo.WatchData{
+					Err: convertError(watchCtx.Err(), nodePath),
+				}}
+				return
+			default:
+			}
+		}
+	}()
+
+	return current, notifications, topo.CancelFunc(watchCancel), nil
+}
+
+// relativePath returns the path of a key relative to the root
+// directory of the cell.
+func (s *Server) relativePath(key string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(key, s.root), "/")
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// TopoArchiveVersion is the version of the archives written by
+	// BackupTopo. RestoreTopo refuses archives with another version.
+	TopoArchiveVersion = 1
+
+	// topoArchiveManifest is the name of the first file of an archive.
+	topoArchiveManifest = "MANIFEST"
+)
+
+// TopoArchiveManifest describes a topo archive.
+type TopoArchiveManifest struct {
+	// Version is the TopoArchiveVersion the archive was written with.
+	Version int
+
+	// BackupTime is when the backup started (RFC 3339 format, UTC).
+	BackupTime string
+
+	// Cells has the cells in the archive, the global cell first.
+	Cells []string
+}
+
+// BackupTopo writes all the files of the global cell and of every cell to
+// w, as a gzipped tar archive. The archive has a MANIFEST, then one entry
+// per file named <cell>/<path>. Ephemeral files, like locks and elections,
+// are skipped. It returns the manifest of the archive and the number of
+// files that were written.
+//
+// The files are read one at a time, so the archive is not an atomic
+// snapshot if topo is changing while it's taken.
+func BackupTopo(ctx context.Context, ts *topo.Server, w io.Writer) (*TopoArchiveManifest, int, error) {
+	cells, err := ts.GetCellInfoNames(ctx)
+	if err != nil {
+		return nil, 0, vterrors.Wrap(err, "GetCellInfoNames failed")
+	}
+	manifest := &TopoArchiveManifest{
+		Version:    TopoArchiveVersion,
+		BackupTime: time.Now().UTC().Format(time.RFC3339),
+		Cells:      append([]string{topo.GlobalCell}, cells...),
+	}
+
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := writeTarFile(tw, topoArchiveManifest, data); err != nil {
+		return nil, 0, err
+	}
+
+	count := 0
+	for _, cell := range manifest.Cells {
+		conn, err := ts.ConnForCell(ctx, cell)
+		if err != nil {
+			return manifest, count, vterrors.Wrapf(err, "ConnForCell(%v) failed", cell)
+		}
+		n, err := backupTopoDir(ctx, conn, tw, cell, "")
+		count += n
+		if err != nil {
+			return manifest, count, vterrors.Wrapf(err, "backup of cell %v failed", cell)
+		}
+		log.Infof("BackupTopo: backed up %v files of cell %v", n, cell)
+	}
+
+	if err := tw.Close(); err != nil {
+		return manifest, count, err
+	}
+	return manifest, count, gzw.Close()
+}
+
+// backupTopoDir writes all the files under dirPath to the archive.
+func backupTopoDir(ctx context.Context, conn topo.Conn, tw *tar.Writer, cell, dirPath string) (int, error) {
+	entries, err := conn.ListDir(ctx, dirPath, true /*full*/)
+	if err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			// The directory went away since it was listed.
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	count := 0
+	for _, e := range entries {
+		if e.Ephemeral {
+			continue
+		}
+		filePath := path.Join(dirPath, e.Name)
+		if e.Type == topo.TypeDirectory {
+			n, err := backupTopoDir(ctx, conn, tw, cell, filePath)
+			count += n
+			if err != nil {
+				return count, err
+			}
+			continue
+		}
+
+		contents, _, err := conn.Get(ctx, filePath)
+		if err != nil {
+			if topo.IsErrType(err, topo.NoNode) {
+				continue
+			}
+			return count, vterrors.Wrapf(err, "Get(%v) failed", filePath)
+		}
+		if err := writeTarFile(tw, path.Join(cell, filePath), contents); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+func writeTarFile(tw *tar.Writer, name string, data []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     0644,
+		Size:     int64(len(data)),
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+// RestoreTopo writes the files of an archive written by BackupTopo to ts.
+// Files that exist are overwritten, and files that are not in the archive
+// are left alone. The global cell is restored first, so the other cells
+// can be reached through their CellInfo. Existing CellInfo records are
+// kept though, so a topo can be restored to other servers by creating
+// its cells before the restore. It returns the manifest of the archive
+// and the number of files that were restored.
+func RestoreTopo(ctx context.Context, ts *topo.Server, r io.Reader) (*TopoArchiveManifest, int, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, 0, vterrors.Wrap(err, "can't read topo archive")
+	}
+	defer gzr.Close()
+	tr := tar.NewReader(gzr)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, 0, vterrors.Wrap(err, "can't read topo archive")
+	}
+	if hdr.Name != topoArchiveManifest {
+		return nil, 0, fmt.Errorf("topo archive doesn't start with a %v", topoArchiveManifest)
+	}
+	data, err := ioutil.ReadAll(tr)
+	if err != nil {
+		return nil, 0, err
+	}
+	manifest := &TopoArchiveManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, 0, vterrors.Wrapf(err, "can't decode topo archive %v", topoArchiveManifest)
+	}
+	if manifest.Version != TopoArchiveVersion {
+		return manifest, 0, fmt.Errorf("topo archive has version %v, only version %v is supported", manifest.Version, TopoArchiveVersion)
+	}
+
+	count := 0
+	conns := make(map[string]topo.Conn)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return manifest, count, vterrors.Wrap(err, "can't read topo archive")
+		}
+		parts := strings.SplitN(hdr.Name, "/", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return manifest, count, fmt.Errorf("unexpected file %v in topo archive", hdr.Name)
+		}
+		cell, filePath := parts[0], parts[1]
+		contents, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return manifest, count, err
+		}
+
+		conn, ok := conns[cell]
+		if !ok {
+			conn, err = ts.ConnForCell(ctx, cell)
+			if err != nil {
+				return manifest, count, vterrors.Wrapf(err, "ConnForCell(%v) failed", cell)
+			}
+			conns[cell] = conn
+		}
+
+		if cell == topo.GlobalCell && path.Base(filePath) == topo.CellInfoFile && path.Dir(path.Dir(filePath)) == topo.CellsPath {
+			if _, _, err := conn.Get(ctx, filePath); err == nil {
+				log.Infof("RestoreTopo: keeping existing %v", filePath)
+				continue
+			}
+		}
+		if _, err := conn.Update(ctx, filePath, contents, nil); err != nil {
+			return manifest, count, vterrors.Wrapf(err, "can't restore %v in cell %v", filePath, cell)
+		}
+		count++
+	}
+	log.Infof("RestoreTopo: restored %v files backed up at %v", count, manifest.BackupTime)
+	return manifest, count, nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestBackupRestoreTopo(t *testing.T) {
+	ctx := context.Background()
+	fromTS, _ := createSetup(ctx, t)
+	require.NoError(t, fromTS.UpdateSrvKeyspace(ctx, "test_cell", "test_keyspace", &topodatapb.SrvKeyspace{
+		Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{{ServedType: topodatapb.TabletType_MASTER}},
+	}))
+	// Locks are not backed up.
+	lockCtx, unlock, err := fromTS.LockKeyspace(ctx, "test_keyspace", "backup")
+	require.NoError(t, err)
+	defer unlock(&err)
+
+	var archive bytes.Buffer
+	manifest, count, err := BackupTopo(lockCtx, fromTS, &archive)
+	require.NoError(t, err)
+	assert.Equal(t, TopoArchiveVersion, manifest.Version)
+	assert.Equal(t, []string{topo.GlobalCell, "test_cell"}, manifest.Cells)
+	assert.Greater(t, count, 0)
+
+	// The CellInfo of the target is kept, so it keeps pointing to its
+	// own server.
+	toTS := memorytopo.NewServer("test_cell")
+	require.NoError(t, toTS.UpdateCellInfoFields(ctx, "test_cell", func(ci *topodatapb.CellInfo) error {
+		ci.Root = "/new_root"
+		return nil
+	}))
+	restored, n, err := RestoreTopo(ctx, toTS, bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, manifest, restored)
+	assert.Equal(t, count-1, n)
+
+	ci, err := toTS.GetCellInfo(ctx, "test_cell", true /*strongRead*/)
+	require.NoError(t, err)
+	assert.Equal(t, "/new_root", ci.Root)
+	require.NoError(t, CompareKeyspaces(ctx, fromTS, toTS))
+	require.NoError(t, CompareShards(ctx, fromTS, toTS))
+	require.NoError(t, CompareShardReplications(ctx, fromTS, toTS))
+	require.NoError(t, CompareTablets(ctx, fromTS, toTS))
+	srvKeyspace, err := toTS.GetSrvKeyspace(ctx, "test_cell", "test_keyspace")
+	require.NoError(t, err)
+	assert.Len(t, srvKeyspace.Partitions, 1)
+
+	conn, err := toTS.ConnForCell(ctx, topo.GlobalCell)
+	require.NoError(t, err)
+	_, err = conn.ListDir(ctx, "keyspaces/test_keyspace/locks", false /*full*/)
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "unexpected error: %v", err)
+}
+
+func TestRestoreTopoVersion(t *testing.T) {
+	ctx := context.Background()
+	var archive bytes.Buffer
+	gzw := gzip.NewWriter(&archive)
+	tw := tar.NewWriter(gzw)
+	data, err := json.Marshal(&TopoArchiveManifest{Version: TopoArchiveVersion + 1})
+	require.NoError(t, err)
+	require.NoError(t, writeTarFile(tw, topoArchiveManifest, data))
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+
+	ts := memorytopo.NewServer("test_cell")
+	_, _, err = RestoreTopo(ctx, ts, &archive)
+	assert.EqualError(t, err, "topo archive has version 2, only version 1 is supported")
+}
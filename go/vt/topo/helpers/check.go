@@ -0,0 +1,388 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// topoChecker has the records read by CheckTopo, and the problems found.
+type topoChecker struct {
+	ts *topo.Server
+
+	cells     []string
+	keyspaces map[string]*topo.KeyspaceInfo
+	// shards has the shards of every keyspace, by keyspace then shard name.
+	shards map[string]map[string]*topo.ShardInfo
+
+	problems []string
+}
+
+func (tc *topoChecker) addProblem(format string, args ...interface{}) {
+	tc.problems = append(tc.problems, fmt.Sprintf(format, args...))
+}
+
+// keyspaceNames returns the names of all keyspaces, sorted.
+func (tc *topoChecker) keyspaceNames() []string {
+	names := make([]string, 0, len(tc.keyspaces))
+	for name := range tc.keyspaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// shardNames returns the names of the shards of a keyspace, sorted.
+func (tc *topoChecker) shardNames(keyspace string) []string {
+	names := make([]string, 0, len(tc.shards[keyspace]))
+	for name := range tc.shards[keyspace] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CheckTopo cross-checks the records of the global topo and of the cell
+// topos, and returns the inconsistencies it finds:
+//   - cells aliases that have unknown cells, or cells in more than one alias.
+//   - shard masters that are not MASTER tablets of their shard.
+//   - tablets of unknown shards, or that are in the wrong cell.
+//   - MASTER tablets that are not the master of their shard.
+//   - ShardReplication records that have dangling tablet aliases, or miss
+//     tablets.
+//   - SrvKeyspace records that disagree with their Keyspace and Shard
+//     records on what is served.
+//
+// An error is only returned if topo can't be read.
+func CheckTopo(ctx context.Context, ts *topo.Server) ([]string, error) {
+	tc := &topoChecker{
+		ts:        ts,
+		keyspaces: make(map[string]*topo.KeyspaceInfo),
+		shards:    make(map[string]map[string]*topo.ShardInfo),
+	}
+	var err error
+	tc.cells, err = ts.GetCellInfoNames(ctx)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "GetCellInfoNames failed")
+	}
+	sort.Strings(tc.cells)
+
+	if err := tc.checkCellsAliases(ctx); err != nil {
+		return nil, err
+	}
+	if err := tc.checkShards(ctx); err != nil {
+		return nil, err
+	}
+	for _, cell := range tc.cells {
+		if err := tc.checkCell(ctx, cell); err != nil {
+			return nil, err
+		}
+	}
+	return tc.problems, nil
+}
+
+// checkCellsAliases checks the cells aliases only have known cells, and
+// don't overlap.
+func (tc *topoChecker) checkCellsAliases(ctx context.Context) error {
+	aliases, err := tc.ts.GetCellsAliases(ctx, true /*strongRead*/)
+	if err != nil {
+		return vterrors.Wrap(err, "GetCellsAliases failed")
+	}
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	cellAlias := make(map[string]string)
+	for _, name := range names {
+		for _, cell := range aliases[name].Cells {
+			if !topo.InCellList(cell, tc.cells) {
+				tc.addProblem("cells alias %v has unknown cell %v", name, cell)
+			}
+			if other, ok := cellAlias[cell]; ok {
+				tc.addProblem("cell %v is in cells aliases %v and %v", cell, other, name)
+				continue
+			}
+			cellAlias[cell] = name
+		}
+	}
+	return nil
+}
+
+// checkShards reads all keyspaces and shards, and checks the master of
+// every shard is a MASTER tablet of that shard.
+func (tc *topoChecker) checkShards(ctx context.Context) error {
+	keyspaces, err := tc.ts.GetKeyspaces(ctx)
+	if err != nil {
+		return vterrors.Wrap(err, "GetKeyspaces failed")
+	}
+	for _, keyspace := range keyspaces {
+		ki, err := tc.ts.GetKeyspace(ctx, keyspace)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetKeyspace(%v) failed", keyspace)
+		}
+		tc.keyspaces[keyspace] = ki
+		shards, err := tc.ts.FindAllShardsInKeyspace(ctx, keyspace)
+		if err != nil {
+			return vterrors.Wrapf(err, "FindAllShardsInKeyspace(%v) failed", keyspace)
+		}
+		tc.shards[keyspace] = shards
+
+		for _, name := range tc.shardNames(keyspace) {
+			if err := tc.checkShardMaster(ctx, shards[name]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (tc *topoChecker) checkShardMaster(ctx context.Context, si *topo.ShardInfo) error {
+	if !si.HasMaster() {
+		return nil
+	}
+	shard := topoproto.KeyspaceShardString(si.Keyspace(), si.ShardName())
+	masterAlias := topoproto.TabletAliasString(si.MasterAlias)
+	ti, err := tc.ts.GetTablet(ctx, si.MasterAlias)
+	switch {
+	case topo.IsErrType(err, topo.NoNode):
+		tc.addProblem("shard %v has master %v, but that tablet doesn't exist", shard, masterAlias)
+		return nil
+	case err != nil:
+		return vterrors.Wrapf(err, "GetTablet(%v) failed", masterAlias)
+	}
+	if ti.Keyspace != si.Keyspace() || ti.Shard != si.ShardName() {
+		tc.addProblem("shard %v has master %v, but that tablet is in shard %v", shard, masterAlias, topoproto.KeyspaceShardString(ti.Keyspace, ti.Shard))
+	} else if ti.Type != topodatapb.TabletType_MASTER {
+		tc.addProblem("shard %v has master %v, but that tablet is a %v", shard, masterAlias, ti.Type)
+	}
+	return nil
+}
+
+// checkCell checks the tablets, ShardReplication and SrvKeyspace records
+// of a cell.
+func (tc *topoChecker) checkCell(ctx context.Context, cell string) error {
+	aliases, err := tc.ts.GetTabletsByCell(ctx, cell)
+	if err != nil {
+		return vterrors.Wrapf(err, "GetTabletsByCell(%v) failed", cell)
+	}
+	tablets, err := tc.ts.GetTabletMap(ctx, aliases)
+	if err != nil {
+		return vterrors.Wrapf(err, "GetTabletMap(%v) failed", cell)
+	}
+	tabletAliases := make([]string, 0, len(tablets))
+	for alias := range tablets {
+		tabletAliases = append(tabletAliases, alias)
+	}
+	sort.Strings(tabletAliases)
+
+	// shardTablets has the tablets of the cell, by keyspace/shard.
+	shardTablets := make(map[string]map[string]bool)
+	for _, alias := range tabletAliases {
+		ti := tablets[alias]
+		if ti.Alias.Cell != cell {
+			tc.addProblem("tablet %v is in the topo of cell %v", alias, cell)
+		}
+		if ti.Keyspace == "" {
+			continue
+		}
+		shard := topoproto.KeyspaceShardString(ti.Keyspace, ti.Shard)
+		si, ok := tc.shards[ti.Keyspace][ti.Shard]
+		if !ok {
+			tc.addProblem("tablet %v is in shard %v, which doesn't exist", alias, shard)
+			continue
+		}
+		if shardTablets[shard] == nil {
+			shardTablets[shard] = make(map[string]bool)
+		}
+		shardTablets[shard][alias] = true
+		if ti.Type == topodatapb.TabletType_MASTER && !topoproto.TabletAliasEqual(ti.Alias, si.MasterAlias) {
+			tc.addProblem("tablet %v is a MASTER, but the master of shard %v is %v", alias, shard, topoproto.TabletAliasString(si.MasterAlias))
+		}
+	}
+
+	for _, keyspace := range tc.keyspaceNames() {
+		for _, name := range tc.shardNames(keyspace) {
+			if err := tc.checkShardReplication(ctx, cell, keyspace, name, tablets, shardTablets[topoproto.KeyspaceShardString(keyspace, name)]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tc.checkSrvKeyspaces(ctx, cell, shardTablets)
+}
+
+// checkShardReplication checks the ShardReplication record of a shard in a
+// cell has all the tablets of the shard in that cell, and only them.
+func (tc *topoChecker) checkShardReplication(ctx context.Context, cell, keyspace, shard string, tablets map[string]*topo.TabletInfo, shardTablets map[string]bool) error {
+	keyspaceShard := topoproto.KeyspaceShardString(keyspace, shard)
+	sri, err := tc.ts.GetShardReplication(ctx, cell, keyspace, shard)
+	switch {
+	case topo.IsErrType(err, topo.NoNode):
+		sri = topo.NewShardReplicationInfo(&topodatapb.ShardReplication{}, cell, keyspace, shard)
+	case err != nil:
+		return vterrors.Wrapf(err, "GetShardReplication(%v, %v) failed", cell, keyspaceShard)
+	}
+
+	inGraph := make(map[string]bool)
+	for _, node := range sri.Nodes {
+		alias := topoproto.TabletAliasString(node.TabletAlias)
+		inGraph[alias] = true
+		if _, ok := tablets[alias]; !ok {
+			tc.addProblem("ShardReplication of %v in cell %v has tablet %v, which doesn't exist", keyspaceShard, cell, alias)
+		} else if !shardTablets[alias] {
+			tc.addProblem("ShardReplication of %v in cell %v has tablet %v, which is in another shard", keyspaceShard, cell, alias)
+		}
+	}
+
+	aliases := make([]string, 0, len(shardTablets))
+	for alias := range shardTablets {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	for _, alias := range aliases {
+		if !inGraph[alias] {
+			tc.addProblem("tablet %v is missing from the ShardReplication of %v in cell %v", alias, keyspaceShard, cell)
+		}
+	}
+	return nil
+}
+
+// checkSrvKeyspaces checks the SrvKeyspace records of a cell match the
+// Keyspace and Shard records.
+func (tc *topoChecker) checkSrvKeyspaces(ctx context.Context, cell string, shardTablets map[string]map[string]bool) error {
+	names, err := tc.ts.GetSrvKeyspaceNames(ctx, cell)
+	if err != nil {
+		return vterrors.Wrapf(err, "GetSrvKeyspaceNames(%v) failed", cell)
+	}
+	sort.Strings(names)
+
+	hasSrvKeyspace := make(map[string]bool)
+	for _, keyspace := range names {
+		// The keyspace directory of a cell also has the ShardReplication
+		// records, so it may have no SrvKeyspace.
+		srvKeyspace, err := tc.ts.GetSrvKeyspace(ctx, cell, keyspace)
+		switch {
+		case topo.IsErrType(err, topo.NoNode):
+			continue
+		case err != nil:
+			return vterrors.Wrapf(err, "GetSrvKeyspace(%v, %v) failed", cell, keyspace)
+		}
+		hasSrvKeyspace[keyspace] = true
+		ki, ok := tc.keyspaces[keyspace]
+		if !ok {
+			tc.addProblem("SrvKeyspace %v in cell %v has no keyspace", keyspace, cell)
+			continue
+		}
+		tc.checkSrvKeyspace(cell, ki, srvKeyspace)
+	}
+
+	// A keyspace that has tablets in the cell is expected to be served
+	// there.
+	for _, keyspace := range tc.keyspaceNames() {
+		if hasSrvKeyspace[keyspace] {
+			continue
+		}
+		for name := range tc.shards[keyspace] {
+			if len(shardTablets[topoproto.KeyspaceShardString(keyspace, name)]) > 0 {
+				tc.addProblem("keyspace %v has tablets in cell %v, but no SrvKeyspace", keyspace, cell)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (tc *topoChecker) checkSrvKeyspace(cell string, ki *topo.KeyspaceInfo, srvKeyspace *topodatapb.SrvKeyspace) {
+	keyspace := ki.KeyspaceName()
+	shards := tc.shards[keyspace]
+
+	var masterShards map[string]bool
+	for _, partition := range srvKeyspace.Partitions {
+		for _, ref := range partition.ShardReferences {
+			if _, ok := shards[ref.Name]; !ok {
+				tc.addProblem("SrvKeyspace %v in cell %v serves %v from shard %v, which doesn't exist", keyspace, cell, partition.ServedType, ref.Name)
+			}
+		}
+		if partition.ServedType == topodatapb.TabletType_MASTER {
+			masterShards = make(map[string]bool)
+			for _, ref := range partition.ShardReferences {
+				masterShards[ref.Name] = true
+			}
+		}
+	}
+
+	// The MASTER partition is only set once the keyspace is served.
+	if masterShards != nil {
+		for _, name := range tc.shardNames(keyspace) {
+			isServing := shards[name].IsMasterServing
+			switch {
+			case isServing && !masterShards[name]:
+				tc.addProblem("shard %v is master serving, but SrvKeyspace %v in cell %v doesn't serve it", topoproto.KeyspaceShardString(keyspace, name), keyspace, cell)
+			case !isServing && masterShards[name]:
+				tc.addProblem("SrvKeyspace %v in cell %v serves MASTER from shard %v, which is not master serving", keyspace, cell, name)
+			}
+		}
+	}
+
+	servedFrom := ki.ComputeCellServedFrom(cell)
+	if !servedFromEqual(servedFrom, srvKeyspace.ServedFrom) {
+		tc.addProblem("SrvKeyspace %v in cell %v has ServedFrom %v, but the keyspace has %v", keyspace, cell, servedFromString(srvKeyspace.ServedFrom), servedFromString(servedFrom))
+	}
+}
+
+// servedFromEqual returns true if both lists have the same ServedFrom
+// records, in any order.
+func servedFromEqual(left, right []*topodatapb.SrvKeyspace_ServedFrom) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for _, l := range left {
+		found := false
+		for _, r := range right {
+			if proto.Equal(l, r) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// servedFromString returns a ServedFrom list as [TYPE:keyspace ...].
+func servedFromString(servedFrom []*topodatapb.SrvKeyspace_ServedFrom) string {
+	parts := make([]string, len(servedFrom))
+	for i, sf := range servedFrom {
+		parts[i] = fmt.Sprintf("%v:%v", sf.TabletType, sf.Keyspace)
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
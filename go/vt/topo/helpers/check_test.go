@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestCheckTopo(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, "ks", "0"))
+	master := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: "ks",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_MASTER,
+	}
+	replica := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 101},
+		Keyspace: "ks",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_REPLICA,
+	}
+	require.NoError(t, ts.CreateTablet(ctx, master))
+	require.NoError(t, ts.CreateTablet(ctx, replica))
+	_, err := ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = master.Alias
+		return nil
+	})
+	require.NoError(t, err)
+	srvKeyspace := &topodatapb.SrvKeyspace{
+		Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{{
+			ServedType:      topodatapb.TabletType_MASTER,
+			ShardReferences: []*topodatapb.ShardReference{{Name: "0"}},
+		}},
+	}
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "cell1", "ks", srvKeyspace))
+	require.NoError(t, ts.CreateCellsAlias(ctx, "region", &topodatapb.CellsAlias{Cells: []string{"cell1", "cell2"}}))
+
+	problems, err := CheckTopo(ctx, ts)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+
+	// Break a few invariants.
+	require.NoError(t, ts.UpdateCellsAlias(ctx, "region", func(ca *topodatapb.CellsAlias) error {
+		ca.Cells = append(ca.Cells, "cell3")
+		return nil
+	}))
+	_, err = ts.UpdateTabletFields(ctx, replica.Alias, func(tablet *topodatapb.Tablet) error {
+		tablet.Type = topodatapb.TabletType_MASTER
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.UpdateShardReplicationFields(ctx, "cell1", "ks", "0", func(sr *topodatapb.ShardReplication) error {
+		sr.Nodes = append(sr.Nodes, &topodatapb.ShardReplication_Node{TabletAlias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 102}})
+		return nil
+	}))
+	require.NoError(t, ts.CreateTablet(ctx, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell2", Uid: 200},
+		Keyspace: "ks",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_RDONLY,
+	}))
+	srvKeyspace.Partitions = append(srvKeyspace.Partitions, &topodatapb.SrvKeyspace_KeyspacePartition{
+		ServedType:      topodatapb.TabletType_REPLICA,
+		ShardReferences: []*topodatapb.ShardReference{{Name: "-80"}},
+	})
+	srvKeyspace.ServedFrom = []*topodatapb.SrvKeyspace_ServedFrom{{TabletType: topodatapb.TabletType_RDONLY, Keyspace: "other"}}
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "cell1", "ks", srvKeyspace))
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "cell2", "gone", &topodatapb.SrvKeyspace{}))
+	_, err = ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = &topodatapb.TabletAlias{Cell: "cell1", Uid: 99}
+		si.IsMasterServing = false
+		return nil
+	})
+	require.NoError(t, err)
+
+	problems, err = CheckTopo(ctx, ts)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"cells alias region has unknown cell cell3",
+		"shard ks/0 has master cell1-0000000099, but that tablet doesn't exist",
+		"tablet cell1-0000000100 is a MASTER, but the master of shard ks/0 is cell1-0000000099",
+		"tablet cell1-0000000101 is a MASTER, but the master of shard ks/0 is cell1-0000000099",
+		"ShardReplication of ks/0 in cell cell1 has tablet cell1-0000000102, which doesn't exist",
+		"SrvKeyspace ks in cell cell1 serves REPLICA from shard -80, which doesn't exist",
+		"SrvKeyspace ks in cell cell1 serves MASTER from shard 0, which is not master serving",
+		"SrvKeyspace ks in cell cell1 has ServedFrom [RDONLY:other], but the keyspace has []",
+		"SrvKeyspace gone in cell cell2 has no keyspace",
+		"keyspace ks has tablets in cell cell2, but no SrvKeyspace",
+	}, problems)
+}
@@ -18,6 +18,8 @@ package k8stopo
 
 import (
 	"context"
+	"path/filepath"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/client-go/tools/cache"
@@ -127,3 +129,124 @@ func closeOnDone(ctx context.Context, filePath string, informerChan chan struct{
 	close(informerChan)
 	close(changes)
 }
+
+// WatchRecursive is part of the topo.Conn interface.
+func (s *Server) WatchRecursive(ctx context.Context, dirPath string) ([]*topo.WatchDataRecursive, <-chan *topo.WatchDataRecursive, topo.CancelFunc, error) {
+	log.Info("Starting Kubernetes topo WatchRecursive on ", dirPath)
+
+	dirPath = filepath.Join(s.root, dirPath)
+	prefix := dirPath + "/"
+
+	// get current
+	var current []*topo.WatchDataRecursive
+	children, err := s.memberIndexer.ByIndex("by_parent", dirPath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, obj := range children {
+		wd := s.recursiveWatchData(obj.(*vtv1beta1.VitessTopoNode))
+		if wd.Err != nil {
+			return nil, nil, nil, wd.Err
+		}
+		current = append(current, wd)
+	}
+
+	// Create a context, will be used to cancel the watch.
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+
+	// Create the changes channel
+	changes := make(chan *topo.WatchDataRecursive, 100)
+
+	// create control chan for informer, it also stops the event
+	// handlers once the watch is over
+	informerChan := make(chan struct{})
+
+	// The informer sends all the changes, only keep the ones
+	// under dirPath.
+	events := make(chan *topo.WatchDataRecursive)
+	send := func(vtn *vtv1beta1.VitessTopoNode, deleted bool) {
+		if !strings.HasPrefix(vtn.Data.Key, prefix) {
+			return
+		}
+		var wd *topo.WatchDataRecursive
+		if deleted {
+			filePath := s.relativePath(vtn.Data.Key)
+			wd = &topo.WatchDataRecursive{
+				Path:      filePath,
+				WatchData: topo.WatchData{Err: topo.NewError(topo.NoNode, filePath)},
+			}
+		} else {
+			wd = s.recursiveWatchData(vtn)
+		}
+		select {
+		case events <- wd:
+		case <-informerChan:
+		}
+	}
+
+	restClient := s.vtKubeClient.TopoV1beta1().RESTClient()
+	listwatch := cache.NewListWatchFromClient(restClient, "vitesstoponodes", s.namespace, fields.Everything())
+	_, memberInformer := cache.NewInformer(listwatch, &vtv1beta1.VitessTopoNode{}, 0,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				send(obj.(*vtv1beta1.VitessTopoNode), false)
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				send(newObj.(*vtv1beta1.VitessTopoNode), false)
+			},
+			DeleteFunc: func(obj interface{}) {
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				if vtn, ok := obj.(*vtv1beta1.VitessTopoNode); ok {
+					send(vtn, true)
+				}
+			},
+		})
+
+	go memberInformer.Run(informerChan)
+
+	// Forward the events until the watch is interrupted, or fails
+	// to decode a node.
+	go func() {
+		defer close(changes)
+		defer close(informerChan)
+
+		for {
+			select {
+			case <-watchCtx.Done():
+				changes <- &topo.WatchDataRecursive{WatchData: topo.WatchData{Err: topo.NewError(topo.Interrupted, dirPath)}}
+				return
+			case wd := <-events:
+				changes <- wd
+				if wd.Path == "" {
+					// Decoding errors have no path, and end the watch.
+					return
+				}
+			}
+		}
+	}()
+
+	return current, changes, topo.CancelFunc(watchCancel), nil
+}
+
+// recursiveWatchData returns the WatchDataRecursive of a node.
+func (s *Server) recursiveWatchData(vtn *vtv1beta1.VitessTopoNode) *topo.WatchDataRecursive {
+	out, err := unpackValue([]byte(vtn.Data.Value))
+	if err != nil {
+		return &topo.WatchDataRecursive{WatchData: topo.WatchData{Err: err}}
+	}
+	return &topo.WatchDataRecursive{
+		Path: s.relativePath(vtn.Data.Key),
+		WatchData: topo.WatchData{
+			Contents: out,
+			Version:  KubernetesVersion(vtn.GetResourceVersion()),
+		},
+	}
+}
+
+// relativePath returns the path of a key relative to the root
+// directory of the cell.
+func (s *Server) relativePath(key string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(key, s.root), "/")
+}
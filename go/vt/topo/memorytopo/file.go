@@ -56,6 +56,10 @@ func (c *Conn) Create(ctx context.Context, filePath string, contents []byte) (to
 	// Create the file.
 	n := c.factory.newFile(file, contents, p)
 	p.children[file] = n
+	c.factory.notifyRecursiveWatches(c.cell, filePath, topo.WatchData{
+		Contents: n.contents,
+		Version:  NodeVersion(n.version),
+	})
 	return NodeVersion(n.version), nil
 }
 
@@ -95,6 +99,10 @@ func (c *Conn) Update(ctx context.Context, filePath string, contents []byte, ver
 		}
 		n = c.factory.newFile(file, contents, p)
 		p.children[file] = n
+		c.factory.notifyRecursiveWatches(c.cell, filePath, topo.WatchData{
+			Contents: n.contents,
+			Version:  NodeVersion(n.version),
+		})
 		return NodeVersion(n.version), nil
 	}
 
@@ -119,6 +127,10 @@ func (c *Conn) Update(ctx context.Context, filePath string, contents []byte, ver
 			Version:  NodeVersion(n.version),
 		}
 	}
+	c.factory.notifyRecursiveWatches(c.cell, filePath, topo.WatchData{
+		Contents: n.contents,
+		Version:  NodeVersion(n.version),
+	})
 
 	return NodeVersion(n.version), nil
 }
@@ -187,6 +199,9 @@ func (c *Conn) Delete(ctx context.Context, filePath string, version topo.Version
 		}
 		close(w)
 	}
+	c.factory.notifyRecursiveWatches(c.cell, filePath, topo.WatchData{
+		Err: topo.NewError(topo.NoNode, filePath),
+	})
 
 	return nil
 }
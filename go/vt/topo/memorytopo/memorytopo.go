@@ -64,6 +64,15 @@ type Factory struct {
 	// err is used for testing purposes to force queries / watches
 	// to return the given error
 	err error
+	// recursiveWatches has all the recursive watches, by watch index.
+	recursiveWatches map[int]*recursiveWatch
+}
+
+// recursiveWatch is a watch on all the files under a directory.
+type recursiveWatch struct {
+	cell    string
+	dirPath string
+	changes chan *topo.WatchDataRecursive
 }
 
 // HasGlobalReadOnlyCell is part of the topo.Factory interface.
@@ -95,6 +104,11 @@ func (f *Factory) SetError(err error) {
 		for _, node := range f.cells {
 			node.PropagateWatchError(err)
 		}
+		for i, w := range f.recursiveWatches {
+			w.changes <- &topo.WatchDataRecursive{WatchData: topo.WatchData{Err: err}}
+			close(w.changes)
+			delete(f.recursiveWatches, i)
+		}
 	}
 }
 
@@ -171,8 +185,9 @@ func (n *node) PropagateWatchError(err error) {
 // in case of a problem.
 func NewServerAndFactory(cells ...string) (*topo.Server, *Factory) {
 	f := &Factory{
-		cells:      make(map[string]*node),
-		generation: uint64(rand.Int63n(1 << 60)),
+		cells:            make(map[string]*node),
+		generation:       uint64(rand.Int63n(1 << 60)),
+		recursiveWatches: make(map[int]*recursiveWatch),
 	}
 	f.cells[topo.GlobalCell] = f.newDirectory(topo.GlobalCell, nil)
 
@@ -273,6 +288,21 @@ func (f *Factory) getOrCreatePath(cell, filePath string) *node {
 	return n
 }
 
+// notifyRecursiveWatches sends the new WatchData of a file to the
+// recursive watches of the directories it is in.
+func (f *Factory) notifyRecursiveWatches(cell, filePath string, wd topo.WatchData) {
+	filePath = strings.Trim(filePath, "/")
+	for _, w := range f.recursiveWatches {
+		if w.cell != cell || !strings.HasPrefix(filePath, w.dirPath) {
+			continue
+		}
+		w.changes <- &topo.WatchDataRecursive{
+			Path:      filePath,
+			WatchData: wd,
+		}
+	}
+}
+
 // recursiveDelete deletes a node and its parent directory if empty.
 func (f *Factory) recursiveDelete(n *node) {
 	parent := n.parent
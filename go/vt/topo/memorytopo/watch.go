@@ -18,6 +18,8 @@ package memorytopo
 
 import (
 	"fmt"
+	"path"
+	"strings"
 
 	"context"
 
@@ -70,3 +72,68 @@ func (c *Conn) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-c
 	}
 	return current, notifications, cancel
 }
+
+// WatchRecursive is part of the topo.Conn interface.
+func (c *Conn) WatchRecursive(ctx context.Context, dirPath string) ([]*topo.WatchDataRecursive, <-chan *topo.WatchDataRecursive, topo.CancelFunc, error) {
+	c.factory.mu.Lock()
+	defer c.factory.mu.Unlock()
+
+	if c.factory.err != nil {
+		return nil, nil, nil, c.factory.err
+	}
+
+	dirPath = strings.Trim(dirPath, "/")
+	var current []*topo.WatchDataRecursive
+	if n := c.factory.nodeByPath(c.cell, dirPath); n != nil {
+		if !n.isDirectory() {
+			return nil, nil, nil, fmt.Errorf("cannot recursively watch file %v in cell %v", dirPath, c.cell)
+		}
+		current = appendFiles(current, dirPath, n)
+	}
+
+	// Only match the files in the directory, not the ones in a sibling
+	// that has the same prefix.
+	if dirPath != "" {
+		dirPath += "/"
+	}
+	notifications := make(chan *topo.WatchDataRecursive, 100)
+	watchIndex := nextWatchIndex
+	nextWatchIndex++
+	c.factory.recursiveWatches[watchIndex] = &recursiveWatch{
+		cell:    c.cell,
+		dirPath: dirPath,
+		changes: notifications,
+	}
+
+	cancel := func() {
+		c.factory.mu.Lock()
+		defer c.factory.mu.Unlock()
+
+		if w, ok := c.factory.recursiveWatches[watchIndex]; ok {
+			delete(c.factory.recursiveWatches, watchIndex)
+			w.changes <- &topo.WatchDataRecursive{WatchData: topo.WatchData{Err: topo.NewError(topo.Interrupted, "watch")}}
+			close(w.changes)
+		}
+	}
+	return current, notifications, cancel, nil
+}
+
+// appendFiles appends the WatchData of all the files under n, which is at
+// dirPath, to current.
+func appendFiles(current []*topo.WatchDataRecursive, dirPath string, n *node) []*topo.WatchDataRecursive {
+	for name, child := range n.children {
+		childPath := path.Join(dirPath, name)
+		if child.isDirectory() {
+			current = appendFiles(current, childPath, child)
+			continue
+		}
+		current = append(current, &topo.WatchDataRecursive{
+			Path: childPath,
+			WatchData: topo.WatchData{
+				Contents: child.contents,
+				Version:  NodeVersion(child.version),
+			},
+		})
+	}
+	return current
+}
@@ -88,6 +88,8 @@ const (
 	TabletsPath      = "tablets"
 	MetadataPath     = "metadata"
 
+	VReplicationWorkflowsPath = "vreplication_workflows"
+
 	ExternalClusterMySQL  = "mysql"
 	ExternalClusterVitess = "vitess"
 )
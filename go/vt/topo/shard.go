@@ -276,6 +276,24 @@ func (ts *Server) UpdateShardFields(ctx context.Context, keyspace, shard string,
 	}
 }
 
+// GetBackupRetentionPolicy returns the backup retention policy that applies
+// to a shard: the shard's own policy if it has one, else its keyspace's.
+// It returns nil if neither has a policy.
+func (ts *Server) GetBackupRetentionPolicy(ctx context.Context, keyspace, shard string) (*topodatapb.BackupRetentionPolicy, error) {
+	si, err := ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	if si.BackupRetentionPolicy != nil {
+		return si.BackupRetentionPolicy, nil
+	}
+	ki, err := ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	return ki.BackupRetentionPolicy, nil
+}
+
 // CreateShard creates a new shard and tries to fill in the right information.
 // This will lock the Keyspace, as we may be looking at other shard servedTypes.
 // Using GetOrCreateShard is probably a better idea for most use cases.
@@ -19,6 +19,7 @@ package topo
 import (
 	"fmt"
 	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -473,6 +474,102 @@ func (ts *Server) GetTabletsByCell(ctx context.Context, cell string) ([]*topodat
 	return result, nil
 }
 
+// WatchTabletData is streamed by WatchTablets.
+// Alias is set for the changes of a tablet: Value has the new tablet
+// record, or Err is ErrNoNode if it was deleted. Otherwise, Err ends the
+// watch.
+type WatchTabletData struct {
+	Alias *topodatapb.TabletAlias
+	Value *TabletInfo
+	Err   error
+}
+
+// WatchTablets will set a watch on all the tablets of a cell.
+// It has the same contract as Conn.WatchRecursive, but it also unpacks
+// the contents into TabletInfo objects, and ignores the files that are
+// not tablet records.
+func (ts *Server) WatchTablets(ctx context.Context, cell string) ([]*TabletInfo, <-chan *WatchTabletData, CancelFunc, error) {
+	conn, err := ts.ConnForCell(ctx, cell)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	current, wdChannel, cancel, err := conn.WatchRecursive(ctx, TabletsPath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var tablets []*TabletInfo
+	for _, wd := range current {
+		if tabletAliasFromPath(wd.Path) == nil {
+			continue
+		}
+		tablet := &topodatapb.Tablet{}
+		if err := proto.Unmarshal(wd.Contents, tablet); err != nil {
+			// Cancel the watch, drain channel.
+			cancel()
+			for range wdChannel {
+			}
+			return nil, nil, nil, vterrors.Wrapf(err, "error unpacking initial Tablet object %v", wd.Path)
+		}
+		tablets = append(tablets, &TabletInfo{version: wd.Version, Tablet: tablet})
+	}
+
+	changes := make(chan *WatchTabletData, 10)
+
+	// The background routine reads any event from the watch channel,
+	// translates it, and sends it to the caller.
+	// If cancel() is called, the underlying WatchRecursive() code will
+	// send an ErrInterrupted and then close the channel. We'll
+	// just propagate that back to our caller.
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Path == "" {
+				// Last error value, we're done.
+				// wdChannel will be closed right after
+				// this, no need to do anything.
+				changes <- &WatchTabletData{Err: wd.Err}
+				return
+			}
+			alias := tabletAliasFromPath(wd.Path)
+			if alias == nil {
+				continue
+			}
+			if wd.Err != nil {
+				changes <- &WatchTabletData{Alias: alias, Err: wd.Err}
+				continue
+			}
+
+			tablet := &topodatapb.Tablet{}
+			if err := proto.Unmarshal(wd.Contents, tablet); err != nil {
+				cancel()
+				for range wdChannel {
+				}
+				changes <- &WatchTabletData{Err: vterrors.Wrapf(err, "error unpacking Tablet object %v", wd.Path)}
+				return
+			}
+			changes <- &WatchTabletData{Alias: alias, Value: &TabletInfo{version: wd.Version, Tablet: tablet}}
+		}
+	}()
+
+	return tablets, changes, cancel, nil
+}
+
+// tabletAliasFromPath returns the alias of the tablet record at filePath,
+// or nil if filePath is not a tablet record.
+func tabletAliasFromPath(filePath string) *topodatapb.TabletAlias {
+	parts := strings.Split(filePath, "/")
+	if len(parts) != 3 || parts[0] != TabletsPath || parts[2] != TabletFile {
+		return nil
+	}
+	alias, err := topoproto.ParseTabletAlias(parts[1])
+	if err != nil {
+		return nil
+	}
+	return alias
+}
+
 // ParseServingTabletType parses the tablet type into the enum, and makes sure
 // that the enum is of serving type (MASTER, REPLICA, RDONLY/BATCH).
 //
@@ -111,4 +111,9 @@ func TopoServerTestSuite(t *testing.T, factory func() *topo.Server) {
 	checkWatch(t, ts)
 	checkWatchInterrupt(t, ts)
 	ts.Close()
+
+	t.Log("=== checkWatchRecursive")
+	ts = factory()
+	checkWatchRecursive(t, ts)
+	ts.Close()
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
@@ -216,3 +218,134 @@ func checkWatchInterrupt(t *testing.T, ts *topo.Server) {
 	// And calling cancel() again should just work.
 	cancel()
 }
+
+// waitForRecursiveChange reads the changes of a recursive watch on the
+// tablets directory until it gets one for filePath that matches, and
+// returns it.
+func waitForRecursiveChange(t *testing.T, changes <-chan *topo.WatchDataRecursive, filePath string, match func(wd *topo.WatchDataRecursive) bool) *topo.WatchDataRecursive {
+	timeout := time.After(30 * time.Second)
+	for {
+		select {
+		case wd, ok := <-changes:
+			if !ok {
+				t.Fatalf("watch channel unexpectedly closed")
+			}
+			if wd.Path == "" {
+				t.Fatalf("watch failed: %v", wd.Err)
+			}
+			if !strings.HasPrefix(wd.Path, topo.TabletsPath+"/") {
+				t.Fatalf("got change outside of the watched directory: %v", wd.Path)
+			}
+			if wd.Path == filePath && match(wd) {
+				return wd
+			}
+		case <-timeout:
+			t.Fatalf("time out waiting for a change of %v", filePath)
+		}
+	}
+}
+
+// tabletPortMatches returns a function that checks a recursive watch
+// change has a tablet record with the given vt port.
+func tabletPortMatches(t *testing.T, port int32) func(wd *topo.WatchDataRecursive) bool {
+	return func(wd *topo.WatchDataRecursive) bool {
+		if wd.Err != nil {
+			return false
+		}
+		got := &topodatapb.Tablet{}
+		if err := proto.Unmarshal(wd.Contents, got); err != nil {
+			t.Fatalf("cannot proto-unmarshal data: %v", err)
+		}
+		return got.PortMap["vt"] == port
+	}
+}
+
+// checkWatchRecursive runs the tests on the WatchRecursive part of the
+// Conn API. We use Tablet objects.
+func checkWatchRecursive(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+	conn, err := ts.ConnForCell(ctx, LocalCellName)
+	if err != nil {
+		t.Fatalf("ConnForCell(test) failed: %v", err)
+	}
+
+	// start watching a directory that doesn't exist yet -> no files
+	current, changes, cancel, err := conn.WatchRecursive(ctx, topo.TabletsPath)
+	if err != nil {
+		t.Fatalf("WatchRecursive failed: %v", err)
+	}
+	if len(current) != 0 {
+		t.Fatalf("WatchRecursive on missing directory returned files: %v", current)
+	}
+
+	// creating a tablet sends it
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: LocalCellName, Uid: 1},
+		Hostname: "localhost",
+		PortMap:  map[string]int32{"vt": 3333},
+		Keyspace: "test_keyspace",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_REPLICA,
+	}
+	tabletPath := "tablets/" + topoproto.TabletAliasString(tablet.Alias) + "/Tablet"
+	if err := ts.CreateTablet(ctx, tablet); err != nil {
+		t.Fatalf("CreateTablet: %v", err)
+	}
+	waitForRecursiveChange(t, changes, tabletPath, tabletPortMatches(t, 3333))
+
+	// updating it sends the new value
+	if _, err := ts.UpdateTabletFields(ctx, tablet.Alias, func(tablet *topodatapb.Tablet) error {
+		tablet.PortMap["vt"] = 4444
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateTabletFields: %v", err)
+	}
+	waitForRecursiveChange(t, changes, tabletPath, tabletPortMatches(t, 4444))
+
+	// a second watch gets the tablet as its current value
+	current2, changes2, cancel2, err := conn.WatchRecursive(ctx, topo.TabletsPath)
+	if err != nil {
+		t.Fatalf("WatchRecursive failed: %v", err)
+	}
+	if len(current2) != 1 || current2[0].Path != tabletPath || !tabletPortMatches(t, 4444)(current2[0]) {
+		t.Fatalf("WatchRecursive returned bad current value: %v", current2)
+	}
+	cancel2()
+	for range changes2 {
+	}
+
+	// files outside of the directory are not sent, and deleting the
+	// tablet sends ErrNoNode
+	if err := ts.UpdateSrvKeyspace(ctx, LocalCellName, "test_keyspace", &topodatapb.SrvKeyspace{}); err != nil {
+		t.Fatalf("UpdateSrvKeyspace: %v", err)
+	}
+	if err := ts.DeleteTablet(ctx, tablet.Alias); err != nil {
+		t.Fatalf("DeleteTablet: %v", err)
+	}
+	wd := waitForRecursiveChange(t, changes, tabletPath, func(wd *topo.WatchDataRecursive) bool {
+		return wd.Err != nil
+	})
+	if !topo.IsErrType(wd.Err, topo.NoNode) {
+		t.Fatalf("got bad error for deleted tablet: %v", wd.Err)
+	}
+
+	// canceling the watch sends ErrInterrupted and closes the channel
+	cancel()
+	for wd := range changes {
+		if wd.Path == "" {
+			if !topo.IsErrType(wd.Err, topo.Interrupted) {
+				t.Fatalf("bad error returned for cancellation: %v", wd.Err)
+			}
+			if wd, ok := <-changes; ok {
+				t.Fatalf("got unexpected event after error: %v", wd)
+			}
+			break
+		}
+		if !strings.HasPrefix(wd.Path, "tablets/") {
+			t.Fatalf("got change outside of the watched directory: %v", wd.Path)
+		}
+	}
+
+	// And calling cancel() again should just work.
+	cancel()
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+
+	"github.com/golang/protobuf/proto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// VReplicationWorkflowInfo is a meta struct that contains metadata to give
+// the data more context and convenience. This is the main way we interact
+// with the lifecycle record of a vreplication workflow stored in the topo.
+type VReplicationWorkflowInfo struct {
+	Keyspace string
+	Name     string
+	version  Version
+	*topodatapb.VReplicationWorkflow
+}
+
+// GetVReplicationWorkflowDir returns the node path containing the
+// vreplication workflows targeting a keyspace.
+func GetVReplicationWorkflowDir(keyspace string) string {
+	return path.Join(KeyspacesPath, keyspace, VReplicationWorkflowsPath)
+}
+
+// GetVReplicationWorkflowPath returns the node path of a vreplication workflow.
+func GetVReplicationWorkflowPath(keyspace, name string) string {
+	return path.Join(GetVReplicationWorkflowDir(keyspace), name)
+}
+
+// CreateVReplicationWorkflow creates the lifecycle record of a vreplication
+// workflow targeting the given keyspace.
+func (ts *Server) CreateVReplicationWorkflow(ctx context.Context, keyspace, name string, value *topodatapb.VReplicationWorkflow) error {
+	data, err := proto.Marshal(value)
+	if err != nil {
+		return err
+	}
+	_, err = ts.globalCell.Create(ctx, GetVReplicationWorkflowPath(keyspace, name), data)
+	return err
+}
+
+// GetVReplicationWorkflow returns the lifecycle record of a vreplication
+// workflow. It returns a NoNode error if the workflow has no record.
+func (ts *Server) GetVReplicationWorkflow(ctx context.Context, keyspace, name string) (*VReplicationWorkflowInfo, error) {
+	data, version, err := ts.globalCell.Get(ctx, GetVReplicationWorkflowPath(keyspace, name))
+	if err != nil {
+		return nil, err
+	}
+	value := &topodatapb.VReplicationWorkflow{}
+	if err := proto.Unmarshal(data, value); err != nil {
+		return nil, vterrors.Wrapf(err, "bad vreplication workflow data for %s.%s", keyspace, name)
+	}
+	return &VReplicationWorkflowInfo{
+		Keyspace:             keyspace,
+		Name:                 name,
+		version:              version,
+		VReplicationWorkflow: value,
+	}, nil
+}
+
+// UpdateVReplicationWorkflow updates the lifecycle record of a vreplication
+// workflow. It fails with a BadVersion error if the record was changed since
+// it was read.
+func (ts *Server) UpdateVReplicationWorkflow(ctx context.Context, wi *VReplicationWorkflowInfo) error {
+	data, err := proto.Marshal(wi.VReplicationWorkflow)
+	if err != nil {
+		return err
+	}
+	version, err := ts.globalCell.Update(ctx, GetVReplicationWorkflowPath(wi.Keyspace, wi.Name), data, wi.version)
+	if err != nil {
+		return err
+	}
+	wi.version = version
+	return nil
+}
+
+// DeleteVReplicationWorkflow deletes the lifecycle record of a vreplication
+// workflow.
+func (ts *Server) DeleteVReplicationWorkflow(ctx context.Context, keyspace, name string) error {
+	return ts.globalCell.Delete(ctx, GetVReplicationWorkflowPath(keyspace, name), nil)
+}
+
+// GetVReplicationWorkflowNames returns the names of the vreplication
+// workflows targeting a keyspace that have a lifecycle record.
+func (ts *Server) GetVReplicationWorkflowNames(ctx context.Context, keyspace string) ([]string, error) {
+	children, err := ts.globalCell.ListDir(ctx, GetVReplicationWorkflowDir(keyspace), false /*full*/)
+	switch {
+	case err == nil:
+		return DirEntriesToStringArray(children), nil
+	case IsErrType(err, NoNode):
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package zk2topo
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/z-division/go-zookeeper/zk"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// WatchRecursive is part of the topo.Conn interface.
+//
+// ZooKeeper watches are set on a single node, and only fire once. So
+// every node under dirPath has a data watch and a children watch, and
+// each watch is set again when it fires. Like ListDir, a node that has
+// no children is a file.
+func (zs *Server) WatchRecursive(ctx context.Context, dirPath string) ([]*topo.WatchDataRecursive, <-chan *topo.WatchDataRecursive, topo.CancelFunc, error) {
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+	w := &recursiveWatcher{
+		zs:     zs,
+		ctx:    watchCtx,
+		root:   path.Join(zs.root, dirPath),
+		nodes:  make(map[string]*recursiveWatchNode),
+		events: make(chan recursiveWatchEvent, 100),
+	}
+
+	// Read and watch the whole tree before returning it.
+	w.nodes[w.root] = &recursiveWatchNode{}
+	if err := w.watchChildren(w.root, w.nodes[w.root]); err != nil {
+		watchCancel()
+		return nil, nil, nil, err
+	}
+	current := w.pending
+	w.pending = nil
+
+	notifications := make(chan *topo.WatchDataRecursive, 100)
+	go func() {
+		defer close(notifications)
+
+		for {
+			var err error
+			select {
+			case <-watchCtx.Done():
+				// user is not interested any more
+				notifications <- &topo.WatchDataRecursive{WatchData: topo.WatchData{Err: topo.NewError(topo.Interrupted, "watch")}}
+				return
+			case ev := <-w.events:
+				err = w.handleEvent(ev)
+			}
+
+			for _, wd := range w.pending {
+				notifications <- wd
+			}
+			w.pending = nil
+			if err != nil {
+				notifications <- &topo.WatchDataRecursive{WatchData: topo.WatchData{Err: err}}
+				return
+			}
+		}
+	}()
+
+	return current, notifications, topo.CancelFunc(watchCancel), nil
+}
+
+// recursiveWatcher has the state of a recursive watch. Only the goroutine
+// of the watch uses it, once WatchRecursive has returned.
+type recursiveWatcher struct {
+	zs  *Server
+	ctx context.Context
+
+	// root is the ZooKeeper path of the watched directory.
+	root string
+
+	// nodes has all the nodes under root that are watched, by path.
+	nodes map[string]*recursiveWatchNode
+
+	// events receives the events of all the ZooKeeper watches.
+	events chan recursiveWatchEvent
+
+	// pending has the changes that were found, but not sent yet.
+	pending []*topo.WatchDataRecursive
+}
+
+// recursiveWatchNode is a watched node.
+type recursiveWatchNode struct {
+	hasChildren bool
+	dataWatched bool
+	// version is the version of the node, if it is a file that was
+	// sent to the watcher.
+	version  ZKVersion
+	reported bool
+}
+
+// recursiveWatchEvent is an event of a ZooKeeper watch.
+type recursiveWatchEvent struct {
+	zkPath string
+	node   *recursiveWatchNode
+	// children is set for children watches, and unset for data watches.
+	children bool
+	event    zk.Event
+}
+
+// forward sends the event of a watch to the events channel, unless the
+// recursive watch is canceled first.
+func (w *recursiveWatcher) forward(zkPath string, n *recursiveWatchNode, children bool, watch <-chan zk.Event) {
+	go func() {
+		select {
+		case event, ok := <-watch:
+			if !ok {
+				event = zk.Event{Type: zk.EventNotWatching, Err: fmt.Errorf("watch on %v was closed", zkPath)}
+			}
+			select {
+			case w.events <- recursiveWatchEvent{zkPath: zkPath, node: n, children: children, event: event}:
+			case <-w.ctx.Done():
+			}
+		case <-w.ctx.Done():
+		}
+	}()
+}
+
+// handleEvent sets the watch that fired again, and reads what changed.
+func (w *recursiveWatcher) handleEvent(ev recursiveWatchEvent) error {
+	if ev.event.Err != nil {
+		return vterrors.Wrapf(ev.event.Err, "received a non-OK event for %v", ev.zkPath)
+	}
+	if ev.event.Type == zk.EventNotWatching {
+		return fmt.Errorf("watch on %v was closed", ev.zkPath)
+	}
+	if w.nodes[ev.zkPath] != ev.node {
+		// The node was removed since this watch was set. If it
+		// was created again, it has new watches.
+		return nil
+	}
+	if ev.children {
+		return w.watchChildren(ev.zkPath, ev.node)
+	}
+	ev.node.dataWatched = false
+	return w.readFile(ev.zkPath, ev.node)
+}
+
+// watchChildren sets the children watch of a node, and starts watching
+// its new children.
+func (w *recursiveWatcher) watchChildren(zkPath string, n *recursiveWatchNode) error {
+	children, _, watch, err := w.zs.conn.ChildrenW(w.ctx, zkPath)
+	if err == zk.ErrNoNode {
+		if zkPath != w.root {
+			w.remove(zkPath)
+			return nil
+		}
+
+		// The watched directory doesn't exist (anymore), wait for
+		// it to be created.
+		w.remove(zkPath)
+		exists, _, existsWatch, err := w.zs.conn.ExistsW(w.ctx, zkPath)
+		if err != nil {
+			return convertError(err, zkPath)
+		}
+		if exists {
+			// It was just created.
+			return w.watchChildren(zkPath, n)
+		}
+		w.forward(zkPath, n, true, existsWatch)
+		return nil
+	}
+	if err != nil {
+		return convertError(err, zkPath)
+	}
+	w.forward(zkPath, n, true, watch)
+
+	n.hasChildren = len(children) > 0
+	if n.hasChildren && n.reported {
+		// The file became a directory.
+		w.sendDeleted(zkPath, n)
+	}
+	for _, child := range children {
+		childPath := path.Join(zkPath, child)
+		if _, ok := w.nodes[childPath]; ok {
+			continue
+		}
+		cn := &recursiveWatchNode{}
+		w.nodes[childPath] = cn
+		if err := w.watchChildren(childPath, cn); err != nil {
+			return err
+		}
+	}
+	if !n.hasChildren && zkPath != w.root {
+		return w.readFile(zkPath, n)
+	}
+	return nil
+}
+
+// readFile reads a node, sets its data watch if it isn't set, and sends
+// its contents if it is a file that changed.
+func (w *recursiveWatcher) readFile(zkPath string, n *recursiveWatchNode) error {
+	var data []byte
+	var stat *zk.Stat
+	var err error
+	if n.dataWatched {
+		data, stat, err = w.zs.conn.Get(w.ctx, zkPath)
+	} else {
+		var watch <-chan zk.Event
+		data, stat, watch, err = w.zs.conn.GetW(w.ctx, zkPath)
+		if err == nil {
+			n.dataWatched = true
+			w.forward(zkPath, n, false, watch)
+		}
+	}
+	if err == zk.ErrNoNode {
+		w.remove(zkPath)
+		return nil
+	}
+	if err != nil {
+		return convertError(err, zkPath)
+	}
+
+	if n.hasChildren || stat.NumChildren > 0 {
+		return nil
+	}
+	version := ZKVersion(stat.Version)
+	if n.reported && n.version == version {
+		return nil
+	}
+	n.version = version
+	n.reported = true
+	w.pending = append(w.pending, &topo.WatchDataRecursive{
+		Path: w.relativePath(zkPath),
+		WatchData: topo.WatchData{
+			Contents: data,
+			Version:  version,
+		},
+	})
+	return nil
+}
+
+// remove stops watching a node and the nodes under it. The watched
+// directory itself stays watched.
+func (w *recursiveWatcher) remove(zkPath string) {
+	for p, n := range w.nodes {
+		if p != zkPath && !strings.HasPrefix(p, zkPath+"/") {
+			continue
+		}
+		if n.reported {
+			w.sendDeleted(p, n)
+		}
+		if p != w.root {
+			delete(w.nodes, p)
+		}
+	}
+}
+
+// sendDeleted tells the watcher a file is gone.
+func (w *recursiveWatcher) sendDeleted(zkPath string, n *recursiveWatchNode) {
+	n.reported = false
+	filePath := w.relativePath(zkPath)
+	w.pending = append(w.pending, &topo.WatchDataRecursive{
+		Path:      filePath,
+		WatchData: topo.WatchData{Err: topo.NewError(topo.NoNode, filePath)},
+	})
+}
+
+// relativePath returns the path of a node relative to the root directory
+// of the cell.
+func (w *recursiveWatcher) relativePath(zkPath string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(zkPath, w.zs.root), "/")
+}
@@ -35,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/vtadmin/grpcserver"
 	vtadminhttp "vitess.io/vitess/go/vt/vtadmin/http"
 	vthandlers "vitess.io/vitess/go/vt/vtadmin/http/handlers"
+	"vitess.io/vitess/go/vt/vtadmin/rbac"
 	"vitess.io/vitess/go/vt/vtadmin/sort"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtexplain"
@@ -52,11 +53,17 @@ type API struct {
 	clusterMap map[string]*cluster.Cluster
 	serv       *grpcserver.Server
 	router     *mux.Router
+
+	// authz authorizes every RPC, per cluster. A nil authz allows everything.
+	authz *rbac.Authorizer
 }
 
 // NewAPI returns a new API, configured to service the given set of clusters,
-// and configured with the given gRPC and HTTP server options.
-func NewAPI(clusters []*cluster.Cluster, opts grpcserver.Options, httpOpts vtadminhttp.Options) *API {
+// and configured with the given gRPC and HTTP server options. RPCs are
+// authorized by authz; a nil authz disables RBAC. If authn is non-nil, it
+// authenticates every gRPC and HTTP API request, and requests that cannot be
+// authenticated are rejected; otherwise, requests are anonymous.
+func NewAPI(clusters []*cluster.Cluster, opts grpcserver.Options, httpOpts vtadminhttp.Options, authz *rbac.Authorizer, authn rbac.Authenticator) *API {
 	clusterMap := make(map[string]*cluster.Cluster, len(clusters))
 	for _, cluster := range clusters {
 		clusterMap[cluster.ID] = cluster
@@ -66,6 +73,11 @@ func NewAPI(clusters []*cluster.Cluster, opts grpcserver.Options, httpOpts vtadm
 		return c1.ID < c2.ID
 	}).Sort(clusters)
 
+	if authn != nil {
+		opts.StreamInterceptors = append(opts.StreamInterceptors, rbac.AuthenticationStreamInterceptor(authn))
+		opts.UnaryInterceptors = append(opts.UnaryInterceptors, rbac.AuthenticationUnaryInterceptor(authn))
+	}
+
 	serv := grpcserver.New("vtadmin", opts)
 	serv.Router().HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("ok\n"))
@@ -78,6 +90,7 @@ func NewAPI(clusters []*cluster.Cluster, opts grpcserver.Options, httpOpts vtadm
 		clusterMap: clusterMap,
 		router:     router,
 		serv:       serv,
+		authz:      authz,
 	}
 
 	vtadminpb.RegisterVTAdminServer(serv.GRPCServer(), api)
@@ -91,12 +104,24 @@ func NewAPI(clusters []*cluster.Cluster, opts grpcserver.Options, httpOpts vtadm
 	router.HandleFunc("/tablets", httpAPI.Adapt(vtadminhttp.GetTablets)).Name("API.GetTablets")
 	router.HandleFunc("/tablet/{tablet}", httpAPI.Adapt(vtadminhttp.GetTablet)).Name("API.GetTablet")
 	router.HandleFunc("/vtexplain", httpAPI.Adapt(vtadminhttp.VTExplain)).Name("API.VTExplain")
+	router.HandleFunc("/workflows", httpAPI.Adapt(vtadminhttp.GetWorkflows)).Name("API.GetWorkflows")
+	router.HandleFunc("/workflow/{cluster_id}/{keyspace}/{name}", httpAPI.Adapt(vtadminhttp.GetWorkflow)).Name("API.GetWorkflow")
+
+	router.HandleFunc("/keyspace/{cluster_id}", httpAPI.Adapt(vtadminhttp.CreateKeyspace)).Name("API.CreateKeyspace").Methods("POST")
+	router.HandleFunc("/keyspace/{cluster_id}/{name}", httpAPI.Adapt(vtadminhttp.DeleteKeyspace)).Name("API.DeleteKeyspace").Methods("DELETE")
+	router.HandleFunc("/shard/{cluster_id}", httpAPI.Adapt(vtadminhttp.CreateShard)).Name("API.CreateShard").Methods("POST")
+	router.HandleFunc("/shards/{cluster_id}", httpAPI.Adapt(vtadminhttp.DeleteShards)).Name("API.DeleteShards").Methods("DELETE")
+	router.HandleFunc("/shard/{cluster_id}/{keyspace}/{shard}/emergency_reparent", httpAPI.Adapt(vtadminhttp.EmergencyReparentShard)).Name("API.EmergencyReparentShard").Methods("POST")
+	router.HandleFunc("/shard/{cluster_id}/{keyspace}/{shard}/planned_reparent", httpAPI.Adapt(vtadminhttp.PlannedReparentShard)).Name("API.PlannedReparentShard").Methods("POST")
+	router.HandleFunc("/tablet/{cluster_id}/{alias}/type", httpAPI.Adapt(vtadminhttp.ChangeTabletType)).Name("API.ChangeTabletType").Methods("PUT")
+	router.HandleFunc("/tablet/{cluster_id}/{alias}/refresh", httpAPI.Adapt(vtadminhttp.RefreshState)).Name("API.RefreshState").Methods("PUT")
 
 	// Middlewares are executed in order of addition. Our ordering (all
 	// middlewares being optional) is:
 	// 	1. CORS. CORS is a special case and is applied globally, the rest are applied only to the subrouter.
 	//	2. Compression
 	//	3. Tracing
+	//	4. Authentication
 	middlewares := []mux.MiddlewareFunc{}
 
 	if len(httpOpts.CORSOrigins) > 0 {
@@ -112,6 +137,10 @@ func NewAPI(clusters []*cluster.Cluster, opts grpcserver.Options, httpOpts vtadm
 		middlewares = append(middlewares, vthandlers.TraceHandler)
 	}
 
+	if authn != nil {
+		middlewares = append(middlewares, vthandlers.NewAuthenticationHandler(authn))
+	}
+
 	router.Use(middlewares...)
 
 	return api
@@ -123,14 +152,108 @@ func (api *API) ListenAndServe() error {
 	return api.serv.ListenAndServe()
 }
 
+// ChangeTabletType is part of the vtadminpb.VTAdminServer interface.
+func (api *API) ChangeTabletType(ctx context.Context, req *vtadminpb.ChangeTabletTypeRequest) (*vtctldatapb.ChangeTabletTypeResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "API.ChangeTabletType")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+
+	c, err := api.getClusterForChange(ctx, req.ClusterId, req.Options != nil, rbac.TabletResource, rbac.PutAction)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Vtctld.ChangeTabletType(ctx, req.Options)
+}
+
+// CreateKeyspace is part of the vtadminpb.VTAdminServer interface.
+func (api *API) CreateKeyspace(ctx context.Context, req *vtadminpb.CreateKeyspaceRequest) (*vtctldatapb.CreateKeyspaceResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "API.CreateKeyspace")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+
+	c, err := api.getClusterForChange(ctx, req.ClusterId, req.Options != nil, rbac.KeyspaceResource, rbac.CreateAction)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Vtctld.CreateKeyspace(ctx, req.Options)
+}
+
+// CreateShard is part of the vtadminpb.VTAdminServer interface.
+func (api *API) CreateShard(ctx context.Context, req *vtadminpb.CreateShardRequest) (*vtctldatapb.CreateShardResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "API.CreateShard")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+
+	c, err := api.getClusterForChange(ctx, req.ClusterId, req.Options != nil, rbac.ShardResource, rbac.CreateAction)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Vtctld.CreateShard(ctx, req.Options)
+}
+
+// DeleteKeyspace is part of the vtadminpb.VTAdminServer interface.
+func (api *API) DeleteKeyspace(ctx context.Context, req *vtadminpb.DeleteKeyspaceRequest) (*vtctldatapb.DeleteKeyspaceResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "API.DeleteKeyspace")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+
+	c, err := api.getClusterForChange(ctx, req.ClusterId, req.Options != nil, rbac.KeyspaceResource, rbac.DeleteAction)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Vtctld.DeleteKeyspace(ctx, req.Options)
+}
+
+// DeleteShards is part of the vtadminpb.VTAdminServer interface.
+func (api *API) DeleteShards(ctx context.Context, req *vtadminpb.DeleteShardsRequest) (*vtctldatapb.DeleteShardsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "API.DeleteShards")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+
+	c, err := api.getClusterForChange(ctx, req.ClusterId, req.Options != nil, rbac.ShardResource, rbac.DeleteAction)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Vtctld.DeleteShards(ctx, req.Options)
+}
+
+// EmergencyReparentShard is part of the vtadminpb.VTAdminServer interface.
+func (api *API) EmergencyReparentShard(ctx context.Context, req *vtadminpb.EmergencyReparentShardRequest) (*vtctldatapb.EmergencyReparentShardResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "API.EmergencyReparentShard")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+
+	c, err := api.getClusterForChange(ctx, req.ClusterId, req.Options != nil, rbac.ShardResource, rbac.EmergencyReparentShardAction)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Vtctld.EmergencyReparentShard(ctx, req.Options)
+}
+
 // GetClusters is part of the vtadminpb.VTAdminServer interface.
 func (api *API) GetClusters(ctx context.Context, req *vtadminpb.GetClustersRequest) (*vtadminpb.GetClustersResponse, error) {
-	span, _ := trace.NewSpan(ctx, "API.GetClusters")
+	span, ctx := trace.NewSpan(ctx, "API.GetClusters")
 	defer span.Finish()
 
 	vcs := make([]*vtadminpb.Cluster, 0, len(api.clusters))
 
 	for _, c := range api.clusters {
+		if !api.authz.IsAuthorized(ctx, c.ID, rbac.ClusterResource, rbac.GetAction) {
+			continue
+		}
+
 		vcs = append(vcs, &vtadminpb.Cluster{
 			Id:   c.ID,
 			Name: c.Name,
@@ -147,7 +270,7 @@ func (api *API) GetGates(ctx context.Context, req *vtadminpb.GetGatesRequest) (*
 	span, ctx := trace.NewSpan(ctx, "API.GetGates")
 	defer span.Finish()
 
-	clusters, _ := api.getClustersForRequest(req.ClusterIds)
+	clusters, _ := api.getClustersForRequest(ctx, req.ClusterIds, rbac.VTGateResource)
 
 	var (
 		gates []*vtadminpb.VTGate
@@ -203,7 +326,7 @@ func (api *API) GetKeyspaces(ctx context.Context, req *vtadminpb.GetKeyspacesReq
 	span, ctx := trace.NewSpan(ctx, "API.GetKeyspaces")
 	defer span.Finish()
 
-	clusters, _ := api.getClustersForRequest(req.ClusterIds)
+	clusters, _ := api.getClustersForRequest(ctx, req.ClusterIds, rbac.KeyspaceResource)
 
 	var (
 		keyspaces []*vtadminpb.Keyspace
@@ -285,7 +408,7 @@ func (api *API) GetSchemas(ctx context.Context, req *vtadminpb.GetSchemasRequest
 	span, ctx := trace.NewSpan(ctx, "API.GetSchemas")
 	defer span.Finish()
 
-	clusters, _ := api.getClustersForRequest(req.ClusterIds)
+	clusters, _ := api.getClustersForRequest(ctx, req.ClusterIds, rbac.SchemaResource)
 
 	var (
 		schemas []*vtadminpb.Schema
@@ -435,7 +558,7 @@ func (api *API) GetTablet(ctx context.Context, req *vtadminpb.GetTabletRequest)
 
 	span.Annotate("tablet_hostname", req.Hostname)
 
-	clusters, ids := api.getClustersForRequest(req.ClusterIds)
+	clusters, ids := api.getClustersForRequest(ctx, req.ClusterIds, rbac.TabletResource)
 
 	var (
 		tablets []*vtadminpb.Tablet
@@ -491,7 +614,7 @@ func (api *API) GetTablets(ctx context.Context, req *vtadminpb.GetTabletsRequest
 	span, ctx := trace.NewSpan(ctx, "API.GetTablets")
 	defer span.Finish()
 
-	clusters, _ := api.getClustersForRequest(req.ClusterIds)
+	clusters, _ := api.getClustersForRequest(ctx, req.ClusterIds, rbac.TabletResource)
 
 	var (
 		tablets []*vtadminpb.Tablet
@@ -529,26 +652,224 @@ func (api *API) GetTablets(ctx context.Context, req *vtadminpb.GetTabletsRequest
 	}, nil
 }
 
-func (api *API) getClustersForRequest(ids []string) ([]*cluster.Cluster, []string) {
-	if len(ids) == 0 {
-		clusterIDs := make([]string, 0, len(api.clusters))
+// GetWorkflow is part of the vtadminpb.VTAdminServer interface.
+func (api *API) GetWorkflow(ctx context.Context, req *vtadminpb.GetWorkflowRequest) (*vtadminpb.Workflow, error) {
+	span, ctx := trace.NewSpan(ctx, "API.GetWorkflow")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Name)
+
+	switch {
+	case req.ClusterId == "":
+		return nil, fmt.Errorf("%w: cluster ID is required", errors.ErrInvalidRequest)
+	case req.Keyspace == "":
+		return nil, fmt.Errorf("%w: keyspace name is required", errors.ErrInvalidRequest)
+	case req.Name == "":
+		return nil, fmt.Errorf("%w: workflow name is required", errors.ErrInvalidRequest)
+	}
+
+	c, ok := api.clusterMap[req.ClusterId]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errors.ErrUnsupportedCluster, req.ClusterId)
+	}
 
-		for k := range api.clusterMap {
-			clusterIDs = append(clusterIDs, k)
+	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.WorkflowResource, rbac.GetAction) {
+		return nil, &errors.Unauthorized{
+			Action:   string(rbac.GetAction),
+			Resource: string(rbac.WorkflowResource),
+			Cluster:  req.ClusterId,
 		}
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
 
-		return api.clusters, clusterIDs
+	resp, err := c.Vtctld.GetWorkflowStatus(ctx, &vtctldatapb.GetWorkflowStatusRequest{
+		Keyspace: req.Keyspace,
+		Workflow: req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtadminpb.Workflow{
+		Cluster:  c.ToProto(),
+		Keyspace: req.Keyspace,
+		Workflow: resp.Status,
+	}, nil
+}
+
+// GetWorkflows is part of the vtadminpb.VTAdminServer interface.
+func (api *API) GetWorkflows(ctx context.Context, req *vtadminpb.GetWorkflowsRequest) (*vtadminpb.GetWorkflowsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "API.GetWorkflows")
+	defer span.Finish()
+
+	span.Annotate("active_only", req.ActiveOnly)
+
+	clusters, _ := api.getClustersForRequest(ctx, req.ClusterIds, rbac.WorkflowResource)
+
+	var (
+		workflows []*vtadminpb.Workflow
+		wg        sync.WaitGroup
+		er        concurrency.AllErrorRecorder
+		m         sync.Mutex
+	)
+
+	for _, c := range clusters {
+		wg.Add(1)
+
+		go func(c *cluster.Cluster) {
+			defer wg.Done()
+
+			if err := c.Vtctld.Dial(ctx); err != nil {
+				er.RecordError(err)
+				return
+			}
+
+			resp, err := c.Vtctld.GetKeyspaces(ctx, &vtctldatapb.GetKeyspacesRequest{})
+			if err != nil {
+				er.RecordError(err)
+				return
+			}
+
+			var kwg sync.WaitGroup
+
+			for _, ks := range resp.Keyspaces {
+				kwg.Add(1)
+
+				// Read the workflows of each keyspace in the cluster, in parallel.
+				go func(c *cluster.Cluster, ks *vtctldatapb.Keyspace) {
+					defer kwg.Done()
+
+					sr, err := c.Vtctld.GetWorkflowStatuses(ctx, &vtctldatapb.GetWorkflowStatusesRequest{
+						Keyspace:   ks.Name,
+						ActiveOnly: req.ActiveOnly,
+					})
+					if err != nil {
+						er.RecordError(fmt.Errorf("GetWorkflowStatuses(cluster = %s, keyspace = %s): %w", c.ID, ks.Name, err))
+						return
+					}
+
+					m.Lock()
+					defer m.Unlock()
+
+					for _, status := range sr.Statuses {
+						workflows = append(workflows, &vtadminpb.Workflow{
+							Cluster:  c.ToProto(),
+							Keyspace: ks.Name,
+							Workflow: status,
+						})
+					}
+				}(c, ks)
+			}
+
+			kwg.Wait()
+		}(c)
+	}
+
+	wg.Wait()
+
+	if er.HasErrors() {
+		return nil, er.Error()
+	}
+
+	return &vtadminpb.GetWorkflowsResponse{
+		Workflows: workflows,
+	}, nil
+}
+
+// getClusterForChange returns the cluster of a request that changes it, with
+// its vtctld proxy dialed. It fails if the request has no options, or if the
+// actor of the request may not perform the action on the resource in that
+// cluster.
+func (api *API) getClusterForChange(ctx context.Context, clusterID string, hasOptions bool, resource rbac.Resource, action rbac.Action) (*cluster.Cluster, error) {
+	if clusterID == "" {
+		return nil, fmt.Errorf("%w: cluster ID is required", errors.ErrInvalidRequest)
+	}
+
+	if !hasOptions {
+		return nil, fmt.Errorf("%w: options are required", errors.ErrInvalidRequest)
+	}
+
+	c, ok := api.clusterMap[clusterID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errors.ErrUnsupportedCluster, clusterID)
+	}
+
+	if !api.authz.IsAuthorized(ctx, clusterID, resource, action) {
+		return nil, &errors.Unauthorized{
+			Action:   string(action),
+			Resource: string(resource),
+			Cluster:  clusterID,
+		}
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// getClustersForRequest returns the clusters of a read request, which are all
+// the clusters if ids is empty, and their IDs. Unknown clusters, and clusters
+// in which the actor of the request may not get the resource, are left out.
+func (api *API) getClustersForRequest(ctx context.Context, ids []string, resource rbac.Resource) ([]*cluster.Cluster, []string) {
+	if len(ids) == 0 {
+		ids = make([]string, 0, len(api.clusters))
+
+		for _, c := range api.clusters {
+			ids = append(ids, c.ID)
+		}
 	}
 
 	clusters := make([]*cluster.Cluster, 0, len(ids))
+	clusterIDs := make([]string, 0, len(ids))
 
 	for _, id := range ids {
-		if c, ok := api.clusterMap[id]; ok {
-			clusters = append(clusters, c)
+		c, ok := api.clusterMap[id]
+		if !ok || !api.authz.IsAuthorized(ctx, id, resource, rbac.GetAction) {
+			continue
 		}
+
+		clusters = append(clusters, c)
+		clusterIDs = append(clusterIDs, id)
+	}
+
+	return clusters, clusterIDs
+}
+
+// PlannedReparentShard is part of the vtadminpb.VTAdminServer interface.
+func (api *API) PlannedReparentShard(ctx context.Context, req *vtadminpb.PlannedReparentShardRequest) (*vtctldatapb.PlannedReparentShardResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "API.PlannedReparentShard")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+
+	c, err := api.getClusterForChange(ctx, req.ClusterId, req.Options != nil, rbac.ShardResource, rbac.PlannedReparentShardAction)
+	if err != nil {
+		return nil, err
 	}
 
-	return clusters, ids
+	return c.Vtctld.PlannedReparentShard(ctx, req.Options)
+}
+
+// RefreshState is part of the vtadminpb.VTAdminServer interface.
+func (api *API) RefreshState(ctx context.Context, req *vtadminpb.RefreshStateRequest) (*vtctldatapb.RefreshStateResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "API.RefreshState")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+
+	c, err := api.getClusterForChange(ctx, req.ClusterId, req.Options != nil, rbac.TabletResource, rbac.RefreshStateAction)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Vtctld.RefreshState(ctx, req.Options)
 }
 
 // VTExplain is part of the vtadminpb.VTAdminServer interface.
@@ -573,6 +894,14 @@ func (api *API) VTExplain(ctx context.Context, req *vtadminpb.VTExplainRequest)
 		return nil, errors.ErrUnsupportedCluster
 	}
 
+	if !api.authz.IsAuthorized(ctx, req.Cluster, rbac.VTExplainResource, rbac.GetAction) {
+		return nil, &errors.Unauthorized{
+			Action:   string(rbac.GetAction),
+			Resource: string(rbac.VTExplainResource),
+			Cluster:  req.Cluster,
+		}
+	}
+
 	tablet, err := c.FindTablet(ctx, func(t *vtadminpb.Tablet) bool {
 		return t.Tablet.Keyspace == req.Keyspace && topo.IsInServingGraph(t.Tablet.Type) && t.Tablet.Type != topodatapb.TabletType_MASTER && t.State == vtadminpb.Tablet_SERVING
 	})
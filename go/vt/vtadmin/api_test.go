@@ -19,11 +19,14 @@ package vtadmin
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -32,6 +35,7 @@ import (
 	vtadminerrors "vitess.io/vitess/go/vt/vtadmin/errors"
 	"vitess.io/vitess/go/vt/vtadmin/grpcserver"
 	"vitess.io/vitess/go/vt/vtadmin/http"
+	"vitess.io/vitess/go/vt/vtadmin/rbac"
 	vtadmintestutil "vitess.io/vitess/go/vt/vtadmin/testutil"
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver/testutil"
@@ -48,6 +52,145 @@ import (
 	"vitess.io/vitess/go/vt/proto/vttime"
 )
 
+func TestCreateDeleteKeyspace(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("c0_cell1")
+	authz, err := rbac.NewAuthorizer(&rbac.Config{
+		Rules: []*rbac.RuleConfig{
+			{
+				Resource: "Keyspace",
+				Actions:  []string{"create"},
+				Subjects: []string{"role:admin"},
+				Clusters: []string{"c0"},
+			},
+			{
+				Resource: "*",
+				Actions:  []string{"*"},
+				Subjects: []string{"user:root"},
+				Clusters: []string{"*"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	testutil.WithTestServer(t, grpcvtctldserver.NewVtctldServer(ts), func(t *testing.T, client vtctldclient.VtctldClient) {
+		api := NewAPI([]*cluster.Cluster{vtadmintestutil.BuildCluster(0, client, nil, nil)}, grpcserver.Options{}, http.Options{}, authz, nil)
+		admin := rbac.NewContext(ctx, &rbac.Actor{Name: "alice", Roles: []string{"admin"}})
+		root := rbac.NewContext(ctx, &rbac.Actor{Name: "root"})
+
+		createReq := &vtadminpb.CreateKeyspaceRequest{
+			ClusterId: "c0",
+			Options:   &vtctldatapb.CreateKeyspaceRequest{Name: "testkeyspace"},
+		}
+
+		_, err := api.CreateKeyspace(ctx, createReq)
+		var unauthorized *vtadminerrors.Unauthorized
+		assert.True(t, errors.As(err, &unauthorized), "expected Unauthorized without an actor, got %v", err)
+
+		_, err = api.CreateKeyspace(admin, &vtadminpb.CreateKeyspaceRequest{ClusterId: "c0"})
+		assert.True(t, errors.Is(err, vtadminerrors.ErrInvalidRequest), "expected ErrInvalidRequest without options, got %v", err)
+
+		_, err = api.CreateKeyspace(admin, &vtadminpb.CreateKeyspaceRequest{ClusterId: "c1", Options: createReq.Options})
+		assert.True(t, errors.Is(err, vtadminerrors.ErrUnsupportedCluster), "expected ErrUnsupportedCluster, got %v", err)
+
+		resp, err := api.CreateKeyspace(admin, createReq)
+		require.NoError(t, err)
+		assert.Equal(t, "testkeyspace", resp.Keyspace.Name)
+		_, err = ts.GetKeyspace(ctx, "testkeyspace")
+		require.NoError(t, err)
+
+		deleteReq := &vtadminpb.DeleteKeyspaceRequest{
+			ClusterId: "c0",
+			Options:   &vtctldatapb.DeleteKeyspaceRequest{Keyspace: "testkeyspace"},
+		}
+
+		_, err = api.DeleteKeyspace(admin, deleteReq)
+		assert.True(t, errors.As(err, &unauthorized), "expected Unauthorized for a delete by admin, got %v", err)
+		assert.Equal(t, "unauthorized: cannot delete Keyspace in cluster c0", err.Error())
+
+		_, err = api.DeleteKeyspace(root, deleteReq)
+		require.NoError(t, err)
+		_, err = ts.GetKeyspace(ctx, "testkeyspace")
+		assert.True(t, topo.IsErrType(err, topo.NoNode), "expected keyspace to be deleted, got %v", err)
+	})
+}
+
+func TestReadAuthorization(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	authz, err := rbac.NewAuthorizer(&rbac.Config{
+		Rules: []*rbac.RuleConfig{
+			{
+				Resource: "*",
+				Actions:  []string{"get"},
+				Subjects: []string{"*"},
+				Clusters: []string{"c0"},
+			},
+			{
+				Resource: "Tablet",
+				Actions:  []string{"get"},
+				Subjects: []string{"role:sre"},
+				Clusters: []string{"*"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tablet := func(uid uint32, hostname string) *vtadminpb.Tablet {
+		return &vtadminpb.Tablet{
+			State: vtadminpb.Tablet_SERVING,
+			Tablet: &topodatapb.Tablet{
+				Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: uid},
+				Hostname: hostname,
+				Keyspace: "ks",
+				Shard:    "-",
+				Type:     topodatapb.TabletType_MASTER,
+			},
+		}
+	}
+
+	clusters := []*cluster.Cluster{
+		vtadmintestutil.BuildCluster(0, nil, []*vtadminpb.Tablet{tablet(100, "c0-tablet")}, nil),
+		vtadmintestutil.BuildCluster(1, nil, []*vtadminpb.Tablet{tablet(200, "c1-tablet")}, nil),
+	}
+	api := NewAPI(clusters, grpcserver.Options{}, http.Options{}, authz, nil)
+	sre := rbac.NewContext(ctx, &rbac.Actor{Name: "alice", Roles: []string{"sre"}})
+
+	clustersResp, err := api.GetClusters(ctx, &vtadminpb.GetClustersRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []*vtadminpb.Cluster{{Id: "c0", Name: "cluster0"}}, clustersResp.Clusters)
+
+	hostnames := func(resp *vtadminpb.GetTabletsResponse) []string {
+		var names []string
+		for _, t := range resp.Tablets {
+			names = append(names, t.Tablet.Hostname)
+		}
+
+		return names
+	}
+
+	tabletsResp, err := api.GetTablets(ctx, &vtadminpb.GetTabletsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c0-tablet"}, hostnames(tabletsResp))
+
+	tabletsResp, err = api.GetTablets(sre, &vtadminpb.GetTabletsRequest{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"c0-tablet", "c1-tablet"}, hostnames(tabletsResp))
+
+	// Explicitly requesting a cluster that may not be read is the same as
+	// requesting an unknown one.
+	_, err = api.GetTablet(ctx, &vtadminpb.GetTabletRequest{Hostname: "c1-tablet", ClusterIds: []string{"c1"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), vtadminerrors.ErrNoTablet.Error())
+
+	_, err = api.VTExplain(ctx, &vtadminpb.VTExplainRequest{Cluster: "c1", Keyspace: "ks", Sql: "select 1"})
+	var unauthorized *vtadminerrors.Unauthorized
+	assert.True(t, errors.As(err, &unauthorized), "expected Unauthorized, got %v", err)
+}
+
 func TestGetClusters(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -88,7 +231,7 @@ func TestGetClusters(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			api := NewAPI(tt.clusters, grpcserver.Options{}, http.Options{})
+			api := NewAPI(tt.clusters, grpcserver.Options{}, http.Options{}, nil, nil)
 			ctx := context.Background()
 
 			resp, err := api.GetClusters(ctx, &vtadminpb.GetClustersRequest{})
@@ -165,7 +308,7 @@ func TestGetGates(t *testing.T) {
 		},
 	}
 
-	api := NewAPI([]*cluster.Cluster{cluster1, cluster2}, grpcserver.Options{}, http.Options{})
+	api := NewAPI([]*cluster.Cluster{cluster1, cluster2}, grpcserver.Options{}, http.Options{}, nil, nil)
 	ctx := context.Background()
 
 	resp, err := api.GetGates(ctx, &vtadminpb.GetGatesRequest{})
@@ -394,7 +537,7 @@ func TestGetKeyspaces(t *testing.T) {
 					vtadmintestutil.BuildCluster(1, clusterClients[1], nil, nil),
 				}
 
-				api := NewAPI(clusters, grpcserver.Options{}, http.Options{})
+				api := NewAPI(clusters, grpcserver.Options{}, http.Options{}, nil, nil)
 				resp, err := api.GetKeyspaces(context.Background(), tt.req)
 				require.NoError(t, err)
 
@@ -895,7 +1038,7 @@ func TestGetSchemas(t *testing.T) {
 						clusters[cdx] = vtadmintestutil.BuildCluster(cdx, clusterClients[cdx], cts, nil)
 					}
 
-					api := NewAPI(clusters, grpcserver.Options{}, http.Options{})
+					api := NewAPI(clusters, grpcserver.Options{}, http.Options{}, nil, nil)
 
 					resp, err := api.GetSchemas(context.Background(), tt.req)
 					require.NoError(t, err)
@@ -1072,7 +1215,7 @@ func TestGetTablets(t *testing.T) {
 				clusters[i] = cluster
 			}
 
-			api := NewAPI(clusters, grpcserver.Options{}, http.Options{})
+			api := NewAPI(clusters, grpcserver.Options{}, http.Options{}, nil, nil)
 			resp, err := api.GetTablets(context.Background(), tt.req)
 			if tt.shouldErr {
 				assert.Error(t, err)
@@ -1309,7 +1452,7 @@ func TestGetTablet(t *testing.T) {
 				clusters[i] = cluster
 			}
 
-			api := NewAPI(clusters, grpcserver.Options{}, http.Options{})
+			api := NewAPI(clusters, grpcserver.Options{}, http.Options{}, nil, nil)
 			resp, err := api.GetTablet(context.Background(), tt.req)
 			if tt.shouldErr {
 				assert.Error(t, err)
@@ -1322,6 +1465,145 @@ func TestGetTablet(t *testing.T) {
 	}
 }
 
+func TestGetWorkflows(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	streamsQuery := "select id, workflow, source, pos, stop_pos, state, db_name, time_updated, transaction_timestamp, message from _vt.vreplication where db_name = 'vt_ks'"
+	streamFields := sqltypes.MakeTestFields(
+		"id|workflow|source|pos|stop_pos|state|db_name|time_updated|transaction_timestamp|message",
+		"int64|varchar|varchar|varchar|varchar|varchar|varchar|int64|int64|varchar")
+	copyFields := sqltypes.MakeTestFields("vrepl_id|table_name|lastpk", "int64|varchar|varchar")
+
+	// newVtctld returns a vtctld for a cluster with a keyspace "ks", whose
+	// primary has a stream for the given workflow in the given state.
+	newVtctld := func(cell string, workflow string, state string) vtctlservicepb.VtctldServer {
+		ts := memorytopo.NewServer(cell)
+		tablet := &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: cell, Uid: 100},
+			Keyspace: "ks",
+			Shard:    "-",
+			Type:     topodatapb.TabletType_MASTER,
+		}
+		testutil.AddTablet(ctx, t, ts, tablet, &testutil.AddTabletOptions{AlsoSetShardMaster: true})
+
+		now := time.Now().Unix()
+		tmc := &testutil.TabletManagerClient{
+			VReplicationExecResults: map[string]map[string]struct {
+				Result *querypb.QueryResult
+				Error  error
+			}{
+				topoproto.TabletAliasString(tablet.Alias): {
+					streamsQuery: {
+						Result: sqltypes.ResultToProto3(sqltypes.MakeTestResult(streamFields,
+							fmt.Sprintf(`1|%s|keyspace:"source" shard:"-" |MySQL56/a:1-10||%s|vt_ks|%d|%d|`, workflow, state, now, now))),
+					},
+					streamsQuery + " and workflow = '" + workflow + "'": {
+						Result: sqltypes.ResultToProto3(sqltypes.MakeTestResult(streamFields,
+							fmt.Sprintf(`1|%s|keyspace:"source" shard:"-" |MySQL56/a:1-10||%s|vt_ks|%d|%d|`, workflow, state, now, now))),
+					},
+					"select vrepl_id, table_name, lastpk from _vt.copy_state where vrepl_id in (1)": {
+						Result: sqltypes.ResultToProto3(sqltypes.MakeTestResult(copyFields)),
+					},
+				},
+			},
+		}
+
+		return testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+			return grpcvtctldserver.NewVtctldServer(ts)
+		})
+	}
+
+	testutil.WithTestServer(t, newVtctld("c0_cell1", "wf0", "Running"), func(t *testing.T, client0 vtctldclient.VtctldClient) {
+		testutil.WithTestServer(t, newVtctld("c1_cell1", "wf1", "Stopped"), func(t *testing.T, client1 vtctldclient.VtctldClient) {
+			clusters := []*cluster.Cluster{
+				vtadmintestutil.BuildCluster(0, client0, nil, nil),
+				vtadmintestutil.BuildCluster(1, client1, nil, nil),
+			}
+			api := NewAPI(clusters, grpcserver.Options{}, http.Options{}, nil, nil)
+
+			names := func(resp *vtadminpb.GetWorkflowsResponse) []string {
+				var names []string
+				for _, w := range resp.Workflows {
+					names = append(names, w.Cluster.Id+"/"+w.Keyspace+"/"+w.Workflow.Name)
+				}
+
+				return names
+			}
+
+			resp, err := api.GetWorkflows(ctx, &vtadminpb.GetWorkflowsRequest{})
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []string{"c0/ks/wf0", "c1/ks/wf1"}, names(resp))
+
+			resp, err = api.GetWorkflows(ctx, &vtadminpb.GetWorkflowsRequest{ActiveOnly: true})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"c0/ks/wf0"}, names(resp))
+
+			resp, err = api.GetWorkflows(ctx, &vtadminpb.GetWorkflowsRequest{ClusterIds: []string{"c1"}})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"c1/ks/wf1"}, names(resp))
+
+			workflow, err := api.GetWorkflow(ctx, &vtadminpb.GetWorkflowRequest{ClusterId: "c1", Keyspace: "ks", Name: "wf1"})
+			require.NoError(t, err)
+			assert.Equal(t, "c1", workflow.Cluster.Id)
+			assert.Equal(t, "source", workflow.Workflow.SourceKeyspace)
+			require.Len(t, workflow.Workflow.Streams, 1)
+			assert.Equal(t, "Stopped", workflow.Workflow.Streams[0].State)
+
+			_, err = api.GetWorkflow(ctx, &vtadminpb.GetWorkflowRequest{ClusterId: "c1", Keyspace: "ks"})
+			assert.True(t, errors.Is(err, vtadminerrors.ErrInvalidRequest), "expected ErrInvalidRequest without a name, got %v", err)
+
+			_, err = api.GetWorkflow(ctx, &vtadminpb.GetWorkflowRequest{ClusterId: "c2", Keyspace: "ks", Name: "wf1"})
+			assert.True(t, errors.Is(err, vtadminerrors.ErrUnsupportedCluster), "expected ErrUnsupportedCluster, got %v", err)
+		})
+	})
+}
+
+func TestRefreshState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("c0_cell1")
+	tablet := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "c0_cell1",
+			Uid:  100,
+		},
+	}
+	testutil.AddTablet(ctx, t, ts, tablet, nil)
+
+	tmc := &testutil.TabletManagerClient{
+		RefreshStateResults: map[string]error{
+			"c0_cell1-0000000100": nil,
+		},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return grpcvtctldserver.NewVtctldServer(ts)
+	})
+
+	testutil.WithTestServer(t, vtctld, func(t *testing.T, client vtctldclient.VtctldClient) {
+		// Without RBAC, everything is allowed.
+		api := NewAPI([]*cluster.Cluster{vtadmintestutil.BuildCluster(0, client, nil, nil)}, grpcserver.Options{}, http.Options{}, nil, nil)
+
+		_, err := api.RefreshState(ctx, &vtadminpb.RefreshStateRequest{
+			ClusterId: "c0",
+			Options:   &vtctldatapb.RefreshStateRequest{TabletAlias: tablet.Alias},
+		})
+		assert.NoError(t, err)
+
+		_, err = api.RefreshState(ctx, &vtadminpb.RefreshStateRequest{
+			ClusterId: "c0",
+			Options: &vtctldatapb.RefreshStateRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "c0_cell1",
+					Uid:  101,
+				},
+			},
+		})
+		assert.Error(t, err)
+	})
+}
+
 func TestVTExplain(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -1583,7 +1865,7 @@ func TestVTExplain(t *testing.T) {
 				c := vtadmintestutil.BuildCluster(0, vtctldClient, tt.tablets, nil)
 				clusters := []*cluster.Cluster{c}
 
-				api := NewAPI(clusters, grpcserver.Options{}, http.Options{})
+				api := NewAPI(clusters, grpcserver.Options{}, http.Options{}, nil, nil)
 				resp, err := api.VTExplain(context.Background(), tt.req)
 
 				if tt.expectedError != nil {
@@ -19,6 +19,9 @@ package errors
 import (
 	"fmt"
 	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // TypedError defines the behavior needed to strongly-type an error into an
@@ -42,6 +45,17 @@ func (e *Unknown) Code() string         { return "unknown" }
 func (e *Unknown) Details() interface{} { return e.ErrDetails }
 func (e *Unknown) HTTPStatus() int      { return 500 }
 
+// BadRequest is returned when the body of a request can't be parsed.
+type BadRequest struct {
+	Err        error
+	ErrDetails interface{}
+}
+
+func (e *BadRequest) Error() string        { return e.Err.Error() }
+func (e *BadRequest) Code() string         { return "bad request" }
+func (e *BadRequest) Details() interface{} { return e.ErrDetails }
+func (e *BadRequest) HTTPStatus() int      { return 400 }
+
 // ErrInvalidCluster is returned when a cluster parameter, either in a route or
 // as a query param, is invalid.
 type ErrInvalidCluster struct {
@@ -66,3 +80,24 @@ func (e *MissingParams) Error() string {
 func (e *MissingParams) Code() string         { return "missing params" }
 func (e *MissingParams) Details() interface{} { return nil }
 func (e *MissingParams) HTTPStatus() int      { return 400 }
+
+// Unauthorized is returned when the actor of a request is not allowed to
+// perform an action.
+type Unauthorized struct {
+	Action   string
+	Resource string
+	Cluster  string
+}
+
+func (e *Unauthorized) Error() string {
+	return fmt.Sprintf("unauthorized: cannot %s %s in cluster %s", e.Action, e.Resource, e.Cluster)
+}
+
+func (e *Unauthorized) Code() string         { return "unauthorized" }
+func (e *Unauthorized) Details() interface{} { return nil }
+func (e *Unauthorized) HTTPStatus() int      { return 403 }
+
+// GRPCStatus lets the gRPC server return a PermissionDenied status.
+func (e *Unauthorized) GRPCStatus() *status.Status {
+	return status.New(codes.PermissionDenied, e.Error())
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package grpcserver
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
@@ -34,7 +36,9 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"github.com/soheilhy/cmux"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 
 	"vitess.io/vitess/go/vt/log"
@@ -63,6 +67,16 @@ type Options struct {
 	// EnableTracing specifies whether to install opentracing interceptors on
 	// the gRPC server.
 	EnableTracing bool
+	// StreamInterceptors and UnaryInterceptors are additional interceptors
+	// installed on the gRPC server, after the tracing interceptors, in the
+	// order given.
+	StreamInterceptors []grpc.StreamServerInterceptor
+	UnaryInterceptors  []grpc.UnaryServerInterceptor
+	// TLSConfig, if set, makes the server accept only TLS connections, for
+	// both gRPC and HTTP. The connection state, including any verified client
+	// certificate, is exposed to handlers as the peer of the request context
+	// (see google.golang.org/grpc/peer).
+	TLSConfig *tls.Config
 }
 
 // Server provides a multiplexed gRPC/HTTP server.
@@ -84,6 +98,8 @@ type Server struct {
 // The underlying gRPC server always has the following interceptors:
 //	- prometheus
 //	- recovery: this handles recovering from panics.
+//
+// Additional interceptors may be given in Options.
 func New(name string, opts Options) *Server {
 	streamInterceptors := []grpc.StreamServerInterceptor{grpc_prometheus.StreamServerInterceptor}
 	unaryInterceptors := []grpc.UnaryServerInterceptor{grpc_prometheus.UnaryServerInterceptor}
@@ -94,6 +110,9 @@ func New(name string, opts Options) *Server {
 		unaryInterceptors = append(unaryInterceptors, otgrpc.UnaryServerInterceptor(otgrpc.WithTracer(tracer)))
 	}
 
+	streamInterceptors = append(streamInterceptors, opts.StreamInterceptors...)
+	unaryInterceptors = append(unaryInterceptors, opts.UnaryInterceptors...)
+
 	recoveryHandler := grpc_recovery.WithRecoveryHandler(func(p interface{}) (err error) {
 		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "panic triggered: %v", p)
 	})
@@ -101,10 +120,16 @@ func New(name string, opts Options) *Server {
 	streamInterceptors = append(streamInterceptors, grpc_recovery.StreamServerInterceptor(recoveryHandler))
 	unaryInterceptors = append(unaryInterceptors, grpc_recovery.UnaryServerInterceptor(recoveryHandler))
 
-	gserv := grpc.NewServer(
+	serverOpts := []grpc.ServerOption{
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
-	)
+	}
+
+	if opts.TLSConfig != nil {
+		serverOpts = append(serverOpts, grpc.Creds(muxedTLSCredentials{}))
+	}
+
+	gserv := grpc.NewServer(serverOpts...)
 
 	if opts.AllowReflection {
 		reflection.Register(gserv)
@@ -156,6 +181,10 @@ func (s *Server) ListenAndServe() error { // nolint:funlen
 	}
 	defer lis.Close()
 
+	if s.opts.TLSConfig != nil {
+		lis = tls.NewListener(lis, s.opts.TLSConfig)
+	}
+
 	lmux := cmux.New(lis)
 
 	if s.opts.CMuxReadTimeout > 0 {
@@ -187,7 +216,12 @@ func (s *Server) ListenAndServe() error { // nolint:funlen
 	}()
 
 	go func() {
-		err := http.Serve(anyLis, s.router)
+		srv := &http.Server{
+			Handler:     s.router,
+			ConnContext: connContext,
+		}
+
+		err := srv.Serve(anyLis)
 		err = fmt.Errorf("http server stopped: %w", err)
 		log.Warning(err)
 		shutdown <- err
@@ -241,3 +275,63 @@ func (s *Server) isServing() bool {
 
 	return s.serving
 }
+
+// tlsConn returns the TLS connection underlying a connection handed out by the
+// cmux listener, if any.
+func tlsConn(conn net.Conn) (*tls.Conn, bool) {
+	if mc, ok := conn.(*cmux.MuxConn); ok {
+		conn = mc.Conn
+	}
+
+	tc, ok := conn.(*tls.Conn)
+	return tc, ok
+}
+
+// connContext exposes the TLS state of an HTTP connection as the peer of the
+// request context, the same way gRPC does. The HTTP server cannot set
+// (*http.Request).TLS itself, because cmux hides the TLS connection from it.
+func connContext(ctx context.Context, conn net.Conn) context.Context {
+	tc, ok := tlsConn(conn)
+	if !ok {
+		return ctx
+	}
+
+	return peer.NewContext(ctx, &peer.Peer{
+		Addr:     conn.RemoteAddr(),
+		AuthInfo: credentials.TLSInfo{State: tc.ConnectionState()},
+	})
+}
+
+// muxedTLSCredentials are the gRPC transport credentials of a server whose
+// listener already terminates TLS, before cmux. The handshake only exposes the
+// TLS state of the connection to gRPC.
+type muxedTLSCredentials struct{}
+
+func (muxedTLSCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, fmt.Errorf("muxedTLSCredentials cannot be used by clients") // nolint:goerr113
+}
+
+func (muxedTLSCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	tc, ok := tlsConn(conn)
+	if !ok {
+		return nil, nil, fmt.Errorf("connection from %v is not a TLS connection", conn.RemoteAddr()) // nolint:goerr113
+	}
+
+	if err := tc.Handshake(); err != nil {
+		return nil, nil, err
+	}
+
+	return conn, credentials.TLSInfo{State: tc.ConnectionState()}, nil
+}
+
+func (muxedTLSCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "tls"}
+}
+
+func (c muxedTLSCredentials) Clone() credentials.TransportCredentials {
+	return c
+}
+
+func (muxedTLSCredentials) OverrideServerName(string) error {
+	return nil
+}
@@ -18,13 +18,25 @@ package grpcserver
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"math/big"
 	"net"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/net/nettest"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
@@ -73,6 +85,124 @@ func TestServer(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func TestServerTLS(t *testing.T) {
+	ca, caKey := newTestCert(t, "ca", nil, nil, nil)
+	serverCert, serverKey := newTestCert(t, "server", nil, ca, caKey)
+	clientCert, clientKey := newTestCert(t, "alice", []string{"sre"}, ca, caKey)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+
+	lis, err := nettest.NewLocalListener("tcp")
+	listenFunc = func(network, address string) (net.Listener, error) {
+		return lis, err
+	}
+
+	defer lis.Close()
+
+	// peerCN returns the CommonName of the client certificate in the peer of
+	// the context.
+	peerCN := func(ctx context.Context) string {
+		p, ok := peer.FromContext(ctx)
+		if !ok {
+			return ""
+		}
+
+		info, ok := p.AuthInfo.(credentials.TLSInfo)
+		if !ok || len(info.State.VerifiedChains) == 0 {
+			return ""
+		}
+
+		return info.State.VerifiedChains[0][0].Subject.CommonName
+	}
+
+	grpcCNs := make(chan string, 1)
+
+	s := New("testservice", Options{
+		CMuxReadTimeout: time.Second,
+		TLSConfig: &tls.Config{
+			Certificates: []tls.Certificate{{Certificate: [][]byte{serverCert.Raw}, PrivateKey: serverKey}},
+			ClientCAs:    pool,
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+		},
+		UnaryInterceptors: []grpc.UnaryServerInterceptor{
+			func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				grpcCNs <- peerCN(ctx)
+				return handler(ctx, req)
+			},
+		},
+	})
+	s.Router().HandleFunc("/whoami", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(peerCN(r.Context())))
+	})
+
+	go func() { err := s.ListenAndServe(); assert.NoError(t, err) }()
+
+	for start := time.Now(); !s.isServing(); {
+		require.Less(t, int64(time.Since(start)), int64(time.Millisecond*500), "server did not start")
+	}
+
+	clientTLS := &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{clientCert.Raw}, PrivateKey: clientKey}},
+		RootCAs:      pool,
+		ServerName:   "localhost",
+	}
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(clientTLS)), grpc.WithBlock())
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{Service: "grpc.health.v1.Health"})
+	require.NoError(t, err)
+	assert.Equal(t, "alice", <-grpcCNs)
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLS}}
+	resp, err := client.Get("https://" + lis.Addr().String() + "/whoami")
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", string(body))
+}
+
+// newTestCert returns a certificate with the given CommonName and
+// OrganizationalUnits, and its key. It is signed by parent, or is a
+// self-signed CA if parent is nil.
+func newTestCert(t *testing.T, cn string, ous []string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn, OrganizationalUnit: ous},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert, key
+}
+
 func TestLameduck(t *testing.T) {
 	lis, err := nettest.NewLocalListener("tcp")
 	listenFunc = func(network, address string) (net.Listener, error) {
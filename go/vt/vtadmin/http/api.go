@@ -21,6 +21,7 @@ import (
 	"net/http"
 
 	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/vtadmin/rbac"
 
 	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
 )
@@ -55,8 +56,8 @@ type VTAdminHandler func(ctx context.Context, r Request, api *API) *JSONResponse
 
 // Adapt converts a VTAdminHandler into an http.HandlerFunc. It deals with
 // wrapping the request in a wrapper for some convenience functions and starts
-// a new context, after extracting any potential spans that were set by an
-// upstream middleware in the request context.
+// a new context, after extracting any potential spans and the actor that were
+// set by upstream middlewares in the request context.
 func (api *API) Adapt(handler VTAdminHandler) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.Background()
@@ -66,6 +67,10 @@ func (api *API) Adapt(handler VTAdminHandler) http.HandlerFunc {
 			ctx = trace.NewContext(ctx, span)
 		}
 
+		if actor, ok := rbac.FromContext(r.Context()); ok {
+			ctx = rbac.NewContext(ctx, actor)
+		}
+
 		handler(ctx, Request{r}, api).Write(w)
 	}
 }
@@ -0,0 +1,41 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"net/http"
+
+	"vitess.io/vitess/go/vt/vtadmin/rbac"
+)
+
+// NewAuthenticationHandler returns a mux.MiddlewareFunc which authenticates
+// the request with the given Authenticator, embeds the actor in the request
+// context, and invokes the next middleware in the chain. Requests that cannot
+// be authenticated get a 401 response.
+func NewAuthenticationHandler(authn rbac.Authenticator) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			actor, err := authn.AuthenticateHTTP(r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(rbac.NewContext(r.Context(), actor)))
+		})
+	}
+}
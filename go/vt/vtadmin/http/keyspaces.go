@@ -20,8 +20,46 @@ import (
 	"context"
 
 	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
+// CreateKeyspace implements the http wrapper for POST /keyspace/{cluster_id}.
+// The body is a JSON vtctldata.CreateKeyspaceRequest.
+func CreateKeyspace(ctx context.Context, r Request, api *API) *JSONResponse {
+	var options vtctldatapb.CreateKeyspaceRequest
+	if err := r.ParseProtoBody(&options); err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	resp, err := api.server.CreateKeyspace(ctx, &vtadminpb.CreateKeyspaceRequest{
+		ClusterId: r.Vars()["cluster_id"],
+		Options:   &options,
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
+// DeleteKeyspace implements the http wrapper for
+// DELETE /keyspace/{cluster_id}/{name}[?recursive=].
+func DeleteKeyspace(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	recursive, err := r.ParseQueryParamAsBool("recursive", false)
+	if err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	resp, err := api.server.DeleteKeyspace(ctx, &vtadminpb.DeleteKeyspaceRequest{
+		ClusterId: vars["cluster_id"],
+		Options: &vtctldatapb.DeleteKeyspaceRequest{
+			Keyspace:  vars["name"],
+			Recursive: recursive,
+		},
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
 // GetKeyspaces implements the http wrapper for /keyspaces[?cluster=[&cluster=]].
 func GetKeyspaces(ctx context.Context, r Request, api *API) *JSONResponse {
 	keyspaces, err := api.server.GetKeyspaces(ctx, &vtadminpb.GetKeyspacesRequest{
@@ -17,9 +17,16 @@ limitations under the License.
 package http
 
 import (
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
 	"github.com/gorilla/mux"
+
+	"vitess.io/vitess/go/vt/vtadmin/errors"
 )
 
 // Request wraps an *http.Request to provide some convenience functions for
@@ -31,3 +38,34 @@ type Request struct{ *http.Request }
 func (r Request) Vars() map[string]string {
 	return mux.Vars(r.Request)
 }
+
+// ParseProtoBody unmarshals the JSON body of a request into msg. An empty body
+// leaves msg unchanged. It returns an errors.BadRequest if the body is not a
+// valid JSON encoding of msg.
+func (r Request) ParseProtoBody(msg proto.Message) error {
+	if r.Body == nil {
+		return nil
+	}
+
+	if err := jsonpb.Unmarshal(r.Body, msg); err != nil && err != io.EOF {
+		return &errors.BadRequest{Err: fmt.Errorf("cannot parse request body: %w", err)}
+	}
+
+	return nil
+}
+
+// ParseQueryParamAsBool returns the value of a query param as a bool, or the
+// given default if the param is not set. It returns an errors.BadRequest if
+// the value is not a bool.
+func (r Request) ParseQueryParamAsBool(name string, defaultVal bool) (bool, error) {
+	if param := r.URL.Query().Get(name); param != "" {
+		val, err := strconv.ParseBool(param)
+		if err != nil {
+			return defaultVal, &errors.BadRequest{Err: fmt.Errorf("cannot parse query param %s: %w", name, err)}
+		}
+
+		return val, nil
+	}
+
+	return defaultVal, nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// CreateShard implements the http wrapper for POST /shard/{cluster_id}. The
+// body is a JSON vtctldata.CreateShardRequest.
+func CreateShard(ctx context.Context, r Request, api *API) *JSONResponse {
+	var options vtctldatapb.CreateShardRequest
+	if err := r.ParseProtoBody(&options); err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	resp, err := api.server.CreateShard(ctx, &vtadminpb.CreateShardRequest{
+		ClusterId: r.Vars()["cluster_id"],
+		Options:   &options,
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
+// DeleteShards implements the http wrapper for DELETE /shards/{cluster_id}.
+// The body is a JSON vtctldata.DeleteShardsRequest.
+func DeleteShards(ctx context.Context, r Request, api *API) *JSONResponse {
+	var options vtctldatapb.DeleteShardsRequest
+	if err := r.ParseProtoBody(&options); err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	resp, err := api.server.DeleteShards(ctx, &vtadminpb.DeleteShardsRequest{
+		ClusterId: r.Vars()["cluster_id"],
+		Options:   &options,
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
+// EmergencyReparentShard implements the http wrapper for
+// POST /shard/{cluster_id}/{keyspace}/{shard}/emergency_reparent. The optional
+// body is a JSON vtctldata.EmergencyReparentShardRequest; its keyspace and
+// shard are taken from the route.
+func EmergencyReparentShard(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	var options vtctldatapb.EmergencyReparentShardRequest
+	if err := r.ParseProtoBody(&options); err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	options.Keyspace = vars["keyspace"]
+	options.Shard = vars["shard"]
+
+	resp, err := api.server.EmergencyReparentShard(ctx, &vtadminpb.EmergencyReparentShardRequest{
+		ClusterId: vars["cluster_id"],
+		Options:   &options,
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
+// PlannedReparentShard implements the http wrapper for
+// POST /shard/{cluster_id}/{keyspace}/{shard}/planned_reparent. The optional
+// body is a JSON vtctldata.PlannedReparentShardRequest; its keyspace and shard
+// are taken from the route.
+func PlannedReparentShard(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	var options vtctldatapb.PlannedReparentShardRequest
+	if err := r.ParseProtoBody(&options); err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	options.Keyspace = vars["keyspace"]
+	options.Shard = vars["shard"]
+
+	resp, err := api.server.PlannedReparentShard(ctx, &vtadminpb.PlannedReparentShardRequest{
+		ClusterId: vars["cluster_id"],
+		Options:   &options,
+	})
+
+	return NewJSONResponse(resp, err)
+}
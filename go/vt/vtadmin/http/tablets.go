@@ -19,9 +19,39 @@ package http
 import (
 	"context"
 
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtadmin/errors"
+
 	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
+// ChangeTabletType implements the http wrapper for
+// PUT /tablet/{cluster_id}/{alias}/type. The body is a JSON
+// vtctldata.ChangeTabletTypeRequest; its tablet alias is taken from the route.
+func ChangeTabletType(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	alias, err := topoproto.ParseTabletAlias(vars["alias"])
+	if err != nil {
+		return NewJSONResponse(nil, &errors.BadRequest{Err: err})
+	}
+
+	var options vtctldatapb.ChangeTabletTypeRequest
+	if err := r.ParseProtoBody(&options); err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	options.TabletAlias = alias
+
+	resp, err := api.server.ChangeTabletType(ctx, &vtadminpb.ChangeTabletTypeRequest{
+		ClusterId: vars["cluster_id"],
+		Options:   &options,
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
 // GetTablets implements the http wrapper for /tablets[?cluster=[&cluster=]].
 func GetTablets(ctx context.Context, r Request, api *API) *JSONResponse {
 	tablets, err := api.server.GetTablets(ctx, &vtadminpb.GetTabletsRequest{
@@ -42,3 +72,23 @@ func GetTablet(ctx context.Context, r Request, api *API) *JSONResponse {
 
 	return NewJSONResponse(tablet, err)
 }
+
+// RefreshState implements the http wrapper for
+// PUT /tablet/{cluster_id}/{alias}/refresh.
+func RefreshState(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	alias, err := topoproto.ParseTabletAlias(vars["alias"])
+	if err != nil {
+		return NewJSONResponse(nil, &errors.BadRequest{Err: err})
+	}
+
+	resp, err := api.server.RefreshState(ctx, &vtadminpb.RefreshStateRequest{
+		ClusterId: vars["cluster_id"],
+		Options: &vtctldatapb.RefreshStateRequest{
+			TabletAlias: alias,
+		},
+	})
+
+	return NewJSONResponse(resp, err)
+}
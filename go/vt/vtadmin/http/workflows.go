@@ -0,0 +1,53 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+// GetWorkflow implements the http wrapper for
+// /workflow/{cluster_id}/{keyspace}/{name}.
+func GetWorkflow(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	workflow, err := api.server.GetWorkflow(ctx, &vtadminpb.GetWorkflowRequest{
+		ClusterId: vars["cluster_id"],
+		Keyspace:  vars["keyspace"],
+		Name:      vars["name"],
+	})
+
+	return NewJSONResponse(workflow, err)
+}
+
+// GetWorkflows implements the http wrapper for
+// /workflows[?cluster=[&cluster=]][&active_only=].
+func GetWorkflows(ctx context.Context, r Request, api *API) *JSONResponse {
+	activeOnly, err := r.ParseQueryParamAsBool("active_only", false)
+	if err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	workflows, err := api.server.GetWorkflows(ctx, &vtadminpb.GetWorkflowsRequest{
+		ClusterIds: r.URL.Query()["cluster"],
+		ActiveOnly: activeOnly,
+	})
+
+	return NewJSONResponse(workflows, err)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import "context"
+
+// Actor is the user a request is made on behalf of.
+type Actor struct {
+	Name  string
+	Roles []string
+}
+
+type actorKey struct{}
+
+// NewContext returns a context with the given actor stored in it.
+func NewContext(ctx context.Context, actor *Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// FromContext returns the actor stored in the context, if any.
+func FromContext(ctx context.Context) (*Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(*Actor)
+	return actor, ok && actor != nil
+}
+
+// subjects returns the subjects the actor matches in a rule.
+func (actor *Actor) subjects() []string {
+	if actor == nil {
+		return nil
+	}
+
+	subjects := make([]string, 0, len(actor.Roles)+1)
+	if actor.Name != "" {
+		subjects = append(subjects, "user:"+actor.Name)
+	}
+
+	for _, role := range actor.Roles {
+		subjects = append(subjects, "role:"+role)
+	}
+
+	return subjects
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Authenticator types.
+const (
+	HeaderAuthenticatorType = "header"
+	TLSAuthenticatorType    = "tls"
+)
+
+// vtadminMethodPrefix is the prefix of the full method names of the VTAdmin
+// gRPC service. Only its calls are authenticated, leaving health checks and
+// reflection open.
+const vtadminMethodPrefix = "/vtadmin.VTAdmin/"
+
+// ErrUnauthenticated is returned by an Authenticator when it cannot identify
+// the actor of a request.
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Authenticator identifies the actor a request is made on behalf of.
+type Authenticator interface {
+	// Authenticate returns the actor of a gRPC request, from its incoming
+	// context.
+	Authenticate(ctx context.Context) (*Actor, error)
+	// AuthenticateHTTP returns the actor of an HTTP request.
+	AuthenticateHTTP(r *http.Request) (*Actor, error)
+}
+
+// NewAuthenticator returns the Authenticator of the given config. A nil config
+// returns a nil Authenticator, in which case requests are anonymous.
+func NewAuthenticator(cfg *AuthenticatorConfig) (Authenticator, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch cfg.Type {
+	case HeaderAuthenticatorType:
+		return &HeaderAuthenticator{
+			UserHeader:  cfg.UserHeader,
+			RolesHeader: cfg.RolesHeader,
+		}, nil
+	default:
+		return &TLSAuthenticator{}, nil
+	}
+}
+
+// HeaderAuthenticator takes the actor from request headers, which for gRPC
+// requests are the metadata keys of the same (lowercased) names. The headers
+// must be set by a trusted proxy in front of vtadmin, which has authenticated
+// the user; vtadmin does not verify them.
+type HeaderAuthenticator struct {
+	// UserHeader is the header holding the name of the user.
+	UserHeader string
+	// RolesHeader is the header holding the roles of the user, as a
+	// comma-separated list. It may be repeated. If empty, actors have no
+	// roles.
+	RolesHeader string
+}
+
+// Authenticate is part of the Authenticator interface.
+func (authn *HeaderAuthenticator) Authenticate(ctx context.Context) (*Actor, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var roles []string
+	if authn.RolesHeader != "" {
+		roles = md.Get(authn.RolesHeader)
+	}
+
+	return authn.actor(md.Get(authn.UserHeader), roles)
+}
+
+// AuthenticateHTTP is part of the Authenticator interface.
+func (authn *HeaderAuthenticator) AuthenticateHTTP(r *http.Request) (*Actor, error) {
+	var roles []string
+	if authn.RolesHeader != "" {
+		roles = r.Header.Values(authn.RolesHeader)
+	}
+
+	return authn.actor(r.Header.Values(authn.UserHeader), roles)
+}
+
+func (authn *HeaderAuthenticator) actor(users []string, roles []string) (*Actor, error) {
+	if len(users) == 0 || strings.TrimSpace(users[0]) == "" {
+		return nil, ErrUnauthenticated
+	}
+
+	actor := &Actor{Name: strings.TrimSpace(users[0])}
+
+	for _, value := range roles {
+		for _, role := range strings.Split(value, ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				actor.Roles = append(actor.Roles, role)
+			}
+		}
+	}
+
+	return actor, nil
+}
+
+// TLSAuthenticator takes the actor from the verified client certificate of a
+// mutual TLS connection. The name of the user is the CommonName of the
+// certificate, and its roles are the OrganizationalUnits.
+type TLSAuthenticator struct{}
+
+// Authenticate is part of the Authenticator interface.
+func (authn *TLSAuthenticator) Authenticate(ctx context.Context) (*Actor, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+
+	return authn.actor(info.State.VerifiedChains)
+}
+
+// AuthenticateHTTP is part of the Authenticator interface. The connection
+// state is taken from the request if it is set, and otherwise from the peer
+// of the request context, which is how the vtadmin server exposes it.
+func (authn *TLSAuthenticator) AuthenticateHTTP(r *http.Request) (*Actor, error) {
+	if r.TLS != nil {
+		return authn.actor(r.TLS.VerifiedChains)
+	}
+
+	return authn.Authenticate(r.Context())
+}
+
+func (authn *TLSAuthenticator) actor(chains [][]*x509.Certificate) (*Actor, error) {
+	if len(chains) == 0 || len(chains[0]) == 0 {
+		return nil, ErrUnauthenticated
+	}
+
+	cert := chains[0][0]
+	if cert.Subject.CommonName == "" {
+		return nil, ErrUnauthenticated
+	}
+
+	return &Actor{
+		Name:  cert.Subject.CommonName,
+		Roles: cert.Subject.OrganizationalUnit,
+	}, nil
+}
+
+// AuthenticationStreamInterceptor returns a gRPC stream interceptor which
+// authenticates the caller of a VTAdmin RPC and stores the actor in the stream
+// context. Calls that cannot be authenticated fail with codes.Unauthenticated.
+func AuthenticationStreamInterceptor(authn Authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !strings.HasPrefix(info.FullMethod, vtadminMethodPrefix) {
+			return handler(srv, ss)
+		}
+
+		actor, err := authn.Authenticate(ss.Context())
+		if err != nil {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return handler(srv, &authenticatedServerStream{
+			ServerStream: ss,
+			ctx:          NewContext(ss.Context(), actor),
+		})
+	}
+}
+
+// AuthenticationUnaryInterceptor returns a gRPC unary interceptor which
+// authenticates the caller of a VTAdmin RPC and stores the actor in the
+// request context. Calls that cannot be authenticated fail with
+// codes.Unauthenticated.
+func AuthenticationUnaryInterceptor(authn Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, vtadminMethodPrefix) {
+			return handler(ctx, req)
+		}
+
+		actor, err := authn.Authenticate(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return handler(NewContext(ctx, actor), req)
+	}
+}
+
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *authenticatedServerStream) Context() context.Context {
+	return ss.ctx
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestHeaderAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	authn, err := NewAuthenticator(&AuthenticatorConfig{
+		Type:        "header",
+		UserHeader:  "X-Forwarded-User",
+		RolesHeader: "X-Forwarded-Groups",
+	})
+	require.NoError(t, err)
+
+	expected := &Actor{Name: "alice", Roles: []string{"sre", "admin", "dev"}}
+
+	r := httptest.NewRequest("GET", "/api/clusters", nil)
+	r.Header.Set("X-Forwarded-User", "alice")
+	r.Header.Add("X-Forwarded-Groups", "sre, admin")
+	r.Header.Add("X-Forwarded-Groups", "dev")
+
+	actor, err := authn.AuthenticateHTTP(r)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actor)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-forwarded-user", "alice",
+		"x-forwarded-groups", "sre,admin",
+		"x-forwarded-groups", "dev",
+	))
+
+	actor, err = authn.Authenticate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actor)
+
+	// Requests without a user are not authenticated, even with roles.
+	r = httptest.NewRequest("GET", "/api/clusters", nil)
+	r.Header.Set("X-Forwarded-Groups", "admin")
+
+	_, err = authn.AuthenticateHTTP(r)
+	assert.Equal(t, ErrUnauthenticated, err)
+
+	_, err = authn.Authenticate(context.Background())
+	assert.Equal(t, ErrUnauthenticated, err)
+}
+
+func TestTLSAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	authn, err := NewAuthenticator(&AuthenticatorConfig{Type: "tls"})
+	require.NoError(t, err)
+
+	state := tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{
+			{Subject: pkix.Name{CommonName: "alice", OrganizationalUnit: []string{"sre", "admin"}}},
+		}},
+	}
+	expected := &Actor{Name: "alice", Roles: []string{"sre", "admin"}}
+
+	r := httptest.NewRequest("GET", "/api/clusters", nil)
+	r.TLS = &state
+
+	actor, err := authn.AuthenticateHTTP(r)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actor)
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+
+	actor, err = authn.Authenticate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actor)
+
+	// Without a TLS request, the connection state comes from the peer.
+	actor, err = authn.AuthenticateHTTP(httptest.NewRequest("GET", "/api/clusters", nil).WithContext(ctx))
+	require.NoError(t, err)
+	assert.Equal(t, expected, actor)
+
+	// Connections without a verified client certificate are not
+	// authenticated.
+	r.TLS = &tls.ConnectionState{}
+	_, err = authn.AuthenticateHTTP(r)
+	assert.Equal(t, ErrUnauthenticated, err)
+
+	_, err = authn.Authenticate(context.Background())
+	assert.Equal(t, ErrUnauthenticated, err)
+}
+
+func TestAuthenticationUnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptor := AuthenticationUnaryInterceptor(&HeaderAuthenticator{UserHeader: "x-user"})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		actor, ok := FromContext(ctx)
+		require.True(t, ok)
+
+		return actor.Name, nil
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/vtadmin.VTAdmin/GetClusters"}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user", "alice"))
+	resp, err := interceptor(ctx, nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", resp)
+
+	_, err = interceptor(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Other services, like health checks, are not authenticated.
+	resp, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
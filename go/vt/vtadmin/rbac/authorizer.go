@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Authorizer decides which actions an actor may perform. A nil Authorizer
+// allows everything, which is how vtadmin runs without RBAC.
+type Authorizer struct {
+	rules []*rule
+}
+
+type rule struct {
+	resource string
+	actions  sets.String
+	subjects sets.String
+	clusters sets.String
+}
+
+// NewAuthorizer returns an Authorizer for the rules of the given config.
+func NewAuthorizer(cfg *Config) (*Authorizer, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	authz := &Authorizer{
+		rules: make([]*rule, 0, len(cfg.Rules)),
+	}
+
+	for _, rc := range cfg.Rules {
+		authz.rules = append(authz.rules, &rule{
+			resource: rc.Resource,
+			actions:  sets.NewString(rc.Actions...),
+			subjects: sets.NewString(rc.Subjects...),
+			clusters: sets.NewString(rc.Clusters...),
+		})
+	}
+
+	return authz, nil
+}
+
+// IsAuthorized returns true if the actor of the context may perform the
+// action on the resource in the given cluster.
+func (authz *Authorizer) IsAuthorized(ctx context.Context, clusterID string, resource Resource, action Action) bool {
+	if authz == nil {
+		return true
+	}
+
+	actor, _ := FromContext(ctx)
+	subjects := actor.subjects()
+
+	for _, r := range authz.rules {
+		if r.matches(clusterID, resource, action, subjects) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *rule) matches(clusterID string, resource Resource, action Action, subjects []string) bool {
+	if r.resource != wildcard && r.resource != string(resource) {
+		return false
+	}
+
+	if !r.actions.Has(wildcard) && !r.actions.Has(string(action)) {
+		return false
+	}
+
+	if !r.clusters.Has(wildcard) && !r.clusters.Has(clusterID) {
+		return false
+	}
+
+	return r.subjects.Has(wildcard) || r.subjects.HasAny(subjects...)
+}
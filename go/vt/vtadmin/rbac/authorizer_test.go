@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsAuthorized(t *testing.T) {
+	t.Parallel()
+
+	authz, err := NewAuthorizer(&Config{
+		Rules: []*RuleConfig{
+			{
+				Resource: "Keyspace",
+				Actions:  []string{"create", "delete"},
+				Subjects: []string{"role:admin"},
+				Clusters: []string{"*"},
+			},
+			{
+				Resource: "Tablet",
+				Actions:  []string{"*"},
+				Subjects: []string{"user:alice"},
+				Clusters: []string{"prod"},
+			},
+			{
+				Resource: "*",
+				Actions:  []string{"refresh_state"},
+				Subjects: []string{"*"},
+				Clusters: []string{"dev"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	admin := NewContext(ctx, &Actor{Name: "bob", Roles: []string{"dev", "admin"}})
+	alice := NewContext(ctx, &Actor{Name: "alice"})
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		cluster  string
+		resource Resource
+		action   Action
+		expected bool
+	}{
+		{"role match", admin, "prod", KeyspaceResource, CreateAction, true},
+		{"action mismatch", admin, "prod", KeyspaceResource, PutAction, false},
+		{"resource mismatch", admin, "prod", ShardResource, CreateAction, false},
+		{"user match", alice, "prod", TabletResource, PutAction, true},
+		{"cluster mismatch", alice, "dev", TabletResource, PutAction, false},
+		{"user is not a role", alice, "prod", KeyspaceResource, CreateAction, false},
+		{"any subject", alice, "dev", TabletResource, RefreshStateAction, true},
+		{"no actor", ctx, "dev", TabletResource, RefreshStateAction, true},
+		{"no actor and no wildcard", ctx, "prod", KeyspaceResource, CreateAction, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, authz.IsAuthorized(tt.ctx, tt.cluster, tt.resource, tt.action))
+		})
+	}
+
+	// A nil Authorizer allows everything, and an empty one nothing.
+	var nilAuthz *Authorizer
+	assert.True(t, nilAuthz.IsAuthorized(ctx, "prod", KeyspaceResource, DeleteAction))
+
+	emptyAuthz, err := NewAuthorizer(&Config{})
+	require.NoError(t, err)
+	assert.False(t, emptyAuthz.IsAuthorized(admin, "prod", KeyspaceResource, DeleteAction))
+}
+
+func TestReadOnlyConfig(t *testing.T) {
+	t.Parallel()
+
+	authz, err := NewAuthorizer(ReadOnlyConfig())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	assert.True(t, authz.IsAuthorized(ctx, "prod", KeyspaceResource, GetAction))
+	assert.True(t, authz.IsAuthorized(ctx, "dev", WorkflowResource, GetAction))
+	assert.False(t, authz.IsAuthorized(ctx, "prod", KeyspaceResource, DeleteAction))
+	assert.False(t, authz.IsAuthorized(ctx, "prod", ShardResource, DeleteAction))
+	assert.False(t, authz.IsAuthorized(ctx, "prod", ShardResource, EmergencyReparentShardAction))
+	assert.False(t, authz.IsAuthorized(ctx, "prod", ShardResource, PlannedReparentShardAction))
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Config is the RBAC configuration of vtadmin. Currently only YAML config
+// files are supported. See the package documentation for an example.
+type Config struct {
+	Authenticator *AuthenticatorConfig
+	Rules         []*RuleConfig
+}
+
+// AuthenticatorConfig configures the Authenticator of a Config. Type is one of
+// "header" or "tls"; the header names are only used by the "header" type.
+type AuthenticatorConfig struct {
+	Type        string
+	UserHeader  string `yaml:"user_header"`
+	RolesHeader string `yaml:"roles_header"`
+}
+
+// RuleConfig is a rule of a Config.
+type RuleConfig struct {
+	Resource string
+	Actions  []string
+	Subjects []string
+	Clusters []string
+}
+
+// LoadConfig reads the RBAC configuration from a YAML file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return nil, fmt.Errorf("cannot parse rbac config %s: %w", path, err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid rbac config %s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+// Validate checks the authenticator is well-formed, and that every rule has a
+// resource, and at least one action, subject and cluster, and that the
+// subjects are well-formed.
+func (cfg *Config) Validate() error {
+	if cfg.Authenticator != nil {
+		if err := cfg.Authenticator.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for i, rule := range cfg.Rules {
+		switch {
+		case rule.Resource == "":
+			return fmt.Errorf("rule %d has no resource", i)
+		case len(rule.Actions) == 0:
+			return fmt.Errorf("rule %d has no actions", i)
+		case len(rule.Subjects) == 0:
+			return fmt.Errorf("rule %d has no subjects", i)
+		case len(rule.Clusters) == 0:
+			return fmt.Errorf("rule %d has no clusters", i)
+		}
+
+		for _, subject := range rule.Subjects {
+			if subject == wildcard || strings.HasPrefix(subject, "user:") || strings.HasPrefix(subject, "role:") {
+				continue
+			}
+
+			return fmt.Errorf("rule %d has subject %q, which is not *, user:<name> or role:<name>", i, subject)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the authenticator type is known, and that the "header" type
+// has a user header.
+func (cfg *AuthenticatorConfig) Validate() error {
+	switch cfg.Type {
+	case HeaderAuthenticatorType:
+		if cfg.UserHeader == "" {
+			return fmt.Errorf("authenticator %q has no user_header", cfg.Type)
+		}
+	case TLSAuthenticatorType:
+	default:
+		return fmt.Errorf("unknown authenticator type %q, expected %q or %q", cfg.Type, HeaderAuthenticatorType, TLSAuthenticatorType)
+	}
+
+	return nil
+}
+
+// ReadOnlyConfig returns a Config that allows everyone to get any resource in
+// any cluster, and nothing else. vtadmin uses it when neither an RBAC config
+// nor -no-rbac is given, so the RPCs that change a cluster are denied.
+func ReadOnlyConfig() *Config {
+	return &Config{
+		Rules: []*RuleConfig{
+			{
+				Resource: wildcard,
+				Actions:  []string{string(GetAction)},
+				Subjects: []string{wildcard},
+				Clusters: []string{wildcard},
+			},
+		},
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "rbac")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name     string
+		yaml     string
+		expected *Config
+		err      string
+	}{
+		{
+			name: "valid",
+			yaml: `authenticator:
+  type: header
+  user_header: X-Forwarded-User
+  roles_header: X-Forwarded-Groups
+rules:
+  - resource: Keyspace
+    actions: ["create", "delete"]
+    subjects: ["role:admin"]
+    clusters: ["*"]
+  - resource: Tablet
+    actions: ["put"]
+    subjects: ["user:alice", "*"]
+    clusters: ["prod"]
+`,
+			expected: &Config{
+				Authenticator: &AuthenticatorConfig{
+					Type:        "header",
+					UserHeader:  "X-Forwarded-User",
+					RolesHeader: "X-Forwarded-Groups",
+				},
+				Rules: []*RuleConfig{
+					{
+						Resource: "Keyspace",
+						Actions:  []string{"create", "delete"},
+						Subjects: []string{"role:admin"},
+						Clusters: []string{"*"},
+					},
+					{
+						Resource: "Tablet",
+						Actions:  []string{"put"},
+						Subjects: []string{"user:alice", "*"},
+						Clusters: []string{"prod"},
+					},
+				},
+			},
+		},
+		{
+			name: "unknown field",
+			yaml: `rules:
+  - resource: Keyspace
+    action: ["create"]
+`,
+			err: "cannot parse rbac config",
+		},
+		{
+			name: "unknown authenticator",
+			yaml: `authenticator:
+  type: oauth
+`,
+			err: `unknown authenticator type "oauth"`,
+		},
+		{
+			name: "header authenticator without user header",
+			yaml: `authenticator:
+  type: header
+  roles_header: X-Forwarded-Groups
+`,
+			err: `authenticator "header" has no user_header`,
+		},
+		{
+			name: "no clusters",
+			yaml: `rules:
+  - resource: Keyspace
+    actions: ["create"]
+    subjects: ["role:admin"]
+`,
+			err: "rule 0 has no clusters",
+		},
+		{
+			name: "bad subject",
+			yaml: `rules:
+  - resource: Keyspace
+    actions: ["create"]
+    subjects: ["admin"]
+    clusters: ["*"]
+`,
+			err: `rule 0 has subject "admin", which is not *, user:<name> or role:<name>`,
+		},
+	}
+
+	for i, tt := range tests {
+		path := filepath.Join(dir, tt.name+".yaml")
+		require.NoError(t, ioutil.WriteFile(path, []byte(tt.yaml), 0644), "test %d", i)
+
+		cfg, err := LoadConfig(path)
+		if tt.err != "" {
+			require.Error(t, err, tt.name)
+			assert.Contains(t, err.Error(), tt.err, tt.name)
+			continue
+		}
+
+		require.NoError(t, err, tt.name)
+		assert.Equal(t, tt.expected, cfg, tt.name)
+	}
+}
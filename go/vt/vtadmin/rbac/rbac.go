@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rbac provides role-based access control for vtadmin. An Authorizer
+// is built from a Config, which is a list of rules. Each rule allows a set of
+// subjects to perform a set of actions on a type of resource, in a set of
+// clusters. Anything not allowed by a rule is denied.
+//
+// A config looks like:
+//
+//	authenticator:
+//	  type: header
+//	  user_header: X-Forwarded-User
+//	  roles_header: X-Forwarded-Groups
+//	rules:
+//	  - resource: "*"
+//	    actions: ["get"]
+//	    subjects: ["*"]
+//	    clusters: ["*"]
+//	  - resource: Keyspace
+//	    actions: ["create", "delete"]
+//	    subjects: ["role:admin"]
+//	    clusters: ["*"]
+//	  - resource: Tablet
+//	    actions: ["put", "refresh_state"]
+//	    subjects: ["user:alice", "role:sre"]
+//	    clusters: ["prod"]
+//
+// Subjects are "user:<name>" or "role:<name>", and are matched against the
+// Actor found in the request context. "*" matches any resource, action,
+// subject or cluster.
+//
+// The Actor is set by the Authenticator of the config, which is run by the
+// gRPC interceptors and HTTP middleware of vtadmin. The "header" authenticator
+// trusts the user and roles headers (or gRPC metadata) set by an upstream
+// proxy, and the "tls" authenticator takes the user from the CommonName of
+// the client certificate and the roles from its OrganizationalUnits. Without
+// an authenticator, requests are anonymous and only match "*" subjects.
+package rbac
+
+// Action is an operation that is performed on a resource.
+type Action string
+
+// Actions.
+const (
+	GetAction    Action = "get"
+	CreateAction Action = "create"
+	DeleteAction Action = "delete"
+	PutAction    Action = "put"
+
+	EmergencyReparentShardAction Action = "emergency_reparent_shard"
+	PlannedReparentShardAction   Action = "planned_reparent_shard"
+	RefreshStateAction           Action = "refresh_state"
+)
+
+// Resource is a type of object that actions are performed on.
+type Resource string
+
+// Resources.
+const (
+	ClusterResource   Resource = "Cluster"
+	KeyspaceResource  Resource = "Keyspace"
+	SchemaResource    Resource = "Schema"
+	ShardResource     Resource = "Shard"
+	TabletResource    Resource = "Tablet"
+	VTExplainResource Resource = "VTExplain"
+	VTGateResource    Resource = "VTGate"
+	WorkflowResource  Resource = "Workflow"
+)
+
+// wildcard matches any resource, action, subject or cluster in a rule.
+const wildcard = "*"
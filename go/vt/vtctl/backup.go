@@ -21,12 +21,18 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"context"
 
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/wrangler"
 )
@@ -35,34 +41,45 @@ func init() {
 	addCommand("Shards", command{
 		"ListBackups",
 		commandListBackups,
-		"<keyspace/shard>",
-		"Lists all the backups for a shard."})
+		"[-show_verification] <keyspace/shard>",
+		"Lists all the backups for a shard. With -show_verification, also shows the result of the last time each backup was verified."})
 	addCommand("Shards", command{
 		"BackupShard",
 		commandBackupShard,
-		"[-allow_master=false] <keyspace/shard>",
+		"[-allow_master=false] [-incremental] <keyspace/shard>",
 		"Chooses a tablet and creates a backup for a shard."})
 	addCommand("Shards", command{
 		"RemoveBackup",
 		commandRemoveBackup,
 		"<keyspace/shard> <backup name>",
 		"Removes a backup for the BackupStorage."})
+	addCommand("Shards", command{
+		"PruneBackups",
+		commandPruneBackups,
+		"[-dry_run] <keyspace|keyspace/shard>",
+		"Removes the backups of a shard, or of all shards in a keyspace, that the shard's backup retention policy doesn't keep. With -dry_run, only lists them."})
+	addCommand("Shards", command{
+		"SetBackupRetentionPolicy",
+		commandSetBackupRetentionPolicy,
+		"[-keep_count=N] [-max_age=<duration>] [-min_valid_count=1] [-clear] <keyspace|keyspace/shard>",
+		"Sets the backup retention policy of a keyspace, or of a shard to override its keyspace's. Full backups beyond -keep_count or older than -max_age are pruned, but the -min_valid_count most recent complete ones are always kept."})
 
 	addCommand("Tablets", command{
 		"Backup",
 		commandBackup,
-		"[-concurrency=4] [-allow_master=false] <tablet alias>",
-		"Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes."})
+		"[-concurrency=4] [-allow_master=false] [-incremental] <tablet alias>",
+		"Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes. With -incremental, mysqld keeps running and only the binary logs written since the most recent backup are stored."})
 	addCommand("Tablets", command{
 		"RestoreFromBackup",
 		commandRestoreFromBackup,
-		"<tablet alias>",
-		"Stops mysqld and restores the data from the latest backup."})
+		"[-restore_to_pos=<position> | -restore_to_timestamp=<RFC3339 time>] <tablet alias>",
+		"Stops mysqld and restores the data from the latest backup. With -restore_to_pos or -restore_to_timestamp, restores the latest full backup before that point, replays binary logs from incremental backups up to it, and leaves the tablet DRAINED without replication."})
 }
 
 func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
 	allowMaster := subFlags.Bool("allow_master", false, "Allows backups to be taken on master. Warning!! If you are using the builtin backup engine, this will shutdown your master mysql for as long as it takes to create a backup ")
+	incremental := subFlags.Bool("incremental", false, "Only back up the binary logs written since the most recent backup, without stopping mysqld")
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -80,12 +97,13 @@ func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fl
 		return err
 	}
 
-	return execBackup(ctx, wr, tabletInfo.Tablet, *concurrency, *allowMaster)
+	return execBackup(ctx, wr, tabletInfo.Tablet, *concurrency, *allowMaster, *incremental)
 }
 
 func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
 	allowMaster := subFlags.Bool("allow_master", false, "Whether to use master tablet for backup. Warning!! If you are using the builtin backup engine, this will shutdown your master mysql for as long as it takes to create a backup ")
+	incremental := subFlags.Bool("incremental", false, "Only back up the binary logs written since the most recent backup, without stopping mysqld")
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -147,12 +165,12 @@ func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 		return errors.New("no tablet available for backup")
 	}
 
-	return execBackup(ctx, wr, tabletForBackup, *concurrency, *allowMaster)
+	return execBackup(ctx, wr, tabletForBackup, *concurrency, *allowMaster, *incremental)
 }
 
 // execBackup is shared by Backup and BackupShard
-func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) error {
-	stream, err := wr.TabletManagerClient().Backup(ctx, tablet, concurrency, allowMaster)
+func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, concurrency int, allowMaster bool, incremental bool) error {
+	stream, err := wr.TabletManagerClient().Backup(ctx, tablet, concurrency, allowMaster, incremental)
 	if err != nil {
 		return err
 	}
@@ -170,6 +188,7 @@ func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.T
 }
 
 func commandListBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	showVerification := subFlags.Bool("show_verification", false, "Also show the result of the last time each backup was verified")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -193,7 +212,21 @@ func commandListBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 		return err
 	}
 	for _, bh := range bhs {
-		wr.Logger().Printf("%v\n", bh.Name())
+		if !*showVerification {
+			wr.Logger().Printf("%v\n", bh.Name())
+			continue
+		}
+		verification, err := mysqlctl.FindBackupVerification(ctx, bh)
+		switch {
+		case err != nil:
+			wr.Logger().Printf("%v\tcan't read verification: %v\n", bh.Name(), err)
+		case verification == nil:
+			wr.Logger().Printf("%v\tnot verified\n", bh.Name())
+		case verification.Passed:
+			wr.Logger().Printf("%v\tverification passed at %v\n", bh.Name(), verification.VerifiedTime)
+		default:
+			wr.Logger().Printf("%v\tverification failed at %v: %v\n", bh.Name(), verification.VerifiedTime, strings.Join(verification.Errors, "; "))
+		}
 	}
 	return nil
 }
@@ -221,13 +254,118 @@ func commandRemoveBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	return bs.RemoveBackup(ctx, bucket, name)
 }
 
+func commandPruneBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	dryRun := subFlags.Bool("dry_run", false, "Only list the backups that would be removed")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("action PruneBackups requires <keyspace|keyspace/shard>")
+	}
+
+	var keyspace string
+	var shards []string
+	if strings.Contains(subFlags.Arg(0), "/") {
+		ks, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+		if err != nil {
+			return err
+		}
+		keyspace, shards = ks, []string{shard}
+	} else {
+		keyspace = subFlags.Arg(0)
+		var err error
+		shards, err = wr.TopoServer().GetShardNames(ctx, keyspace)
+		if err != nil {
+			return err
+		}
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	for _, shard := range shards {
+		policy, err := wr.TopoServer().GetBackupRetentionPolicy(ctx, keyspace, shard)
+		if err != nil {
+			return err
+		}
+		if policy == nil {
+			return fmt.Errorf("no backup retention policy for %v/%v, set one with SetBackupRetentionPolicy", keyspace, shard)
+		}
+		names, err := mysqlctl.PruneBackups(ctx, wr.Logger(), bs, mysqlctl.GetBackupDir(keyspace, shard), policy, *dryRun)
+		for _, name := range names {
+			wr.Logger().Printf("%v/%v/%v\n", keyspace, shard, name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func commandSetBackupRetentionPolicy(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	keepCount := subFlags.Uint("keep_count", 0, "Number of most recent full backups to keep, 0 to not prune by count")
+	maxAge := subFlags.Duration("max_age", 0, "How long to keep full backups, 0 to not prune by age")
+	minValidCount := subFlags.Uint("min_valid_count", 1, "Number of most recent complete full backups to always keep")
+	clearPolicy := subFlags.Bool("clear", false, "Remove the policy instead")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("action SetBackupRetentionPolicy requires <keyspace|keyspace/shard>")
+	}
+
+	var policy *topodatapb.BackupRetentionPolicy
+	if !*clearPolicy {
+		if *keepCount == 0 && *maxAge == 0 {
+			return fmt.Errorf("SetBackupRetentionPolicy requires -keep_count or -max_age, or -clear")
+		}
+		policy = &topodatapb.BackupRetentionPolicy{
+			KeepCount:     uint32(*keepCount),
+			MinValidCount: uint32(*minValidCount),
+		}
+		if *maxAge > 0 {
+			policy.MaxAge = protoutil.DurationToProto(*maxAge)
+		}
+	}
+
+	if !strings.Contains(subFlags.Arg(0), "/") {
+		return wr.SetKeyspaceBackupRetentionPolicy(ctx, subFlags.Arg(0), policy)
+	}
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	_, err = wr.TopoServer().UpdateShardFields(ctx, keyspace, shard, func(si *topo.ShardInfo) error {
+		si.BackupRetentionPolicy = policy
+		return nil
+	})
+	return err
+}
+
 func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	restoreToPos := subFlags.String("restore_to_pos", "", "Replay binary logs from incremental backups up to and including this position, e.g. MySQL56/<uuid>:1-100")
+	restoreToTimestamp := subFlags.String("restore_to_timestamp", "", "Replay binary logs from incremental backups up to this time, in RFC3339 format, e.g. 2021-01-02T15:04:05Z")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 	if subFlags.NArg() != 1 {
 		return fmt.Errorf("the RestoreFromBackup command requires the <tablet alias> argument")
 	}
+	if *restoreToPos != "" && *restoreToTimestamp != "" {
+		return fmt.Errorf("only one of -restore_to_pos and -restore_to_timestamp can be specified")
+	}
+	if _, err := mysql.DecodePosition(*restoreToPos); err != nil {
+		return fmt.Errorf("invalid -restore_to_pos %v: %v", *restoreToPos, err)
+	}
+	var restoreTime time.Time
+	if *restoreToTimestamp != "" {
+		var err error
+		if restoreTime, err = time.Parse(time.RFC3339, *restoreToTimestamp); err != nil {
+			return fmt.Errorf("invalid -restore_to_timestamp %v: %v", *restoreToTimestamp, err)
+		}
+	}
 
 	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
 	if err != nil {
@@ -237,7 +375,7 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 	if err != nil {
 		return err
 	}
-	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet)
+	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet, *restoreToPos, restoreTime)
 	if err != nil {
 		return err
 	}
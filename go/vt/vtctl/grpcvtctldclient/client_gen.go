@@ -26,8 +26,36 @@ import (
 	"google.golang.org/grpc/status"
 
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
 )
 
+// ApplySchema is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ApplySchema(ctx context.Context, in *vtctldatapb.ApplySchemaRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplySchemaResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ApplySchema(ctx, in, opts...)
+}
+
+// ApplyVSchema is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ApplyVSchema(ctx context.Context, in *vtctldatapb.ApplyVSchemaRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyVSchemaResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ApplyVSchema(ctx, in, opts...)
+}
+
+// Backup is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) Backup(ctx context.Context, in *vtctldatapb.BackupRequest, opts ...grpc.CallOption) (vtctlservicepb.Vtctld_BackupClient, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.Backup(ctx, in, opts...)
+}
+
 // ChangeTabletType is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) ChangeTabletType(ctx context.Context, in *vtctldatapb.ChangeTabletTypeRequest, opts ...grpc.CallOption) (*vtctldatapb.ChangeTabletTypeResponse, error) {
 	if client.c == nil {
@@ -208,6 +236,51 @@ func (client *gRPCVtctldClient) GetVSchema(ctx context.Context, in *vtctldatapb.
 	return client.c.GetVSchema(ctx, in, opts...)
 }
 
+// GetWorkflow is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetWorkflow(ctx context.Context, in *vtctldatapb.GetWorkflowRequest, opts ...grpc.CallOption) (*vtctldatapb.GetWorkflowResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetWorkflow(ctx, in, opts...)
+}
+
+// GetWorkflowProgress is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetWorkflowProgress(ctx context.Context, in *vtctldatapb.GetWorkflowProgressRequest, opts ...grpc.CallOption) (*vtctldatapb.GetWorkflowProgressResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetWorkflowProgress(ctx, in, opts...)
+}
+
+// GetWorkflowStatus is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetWorkflowStatus(ctx context.Context, in *vtctldatapb.GetWorkflowStatusRequest, opts ...grpc.CallOption) (*vtctldatapb.GetWorkflowStatusResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetWorkflowStatus(ctx, in, opts...)
+}
+
+// GetWorkflowStatuses is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetWorkflowStatuses(ctx context.Context, in *vtctldatapb.GetWorkflowStatusesRequest, opts ...grpc.CallOption) (*vtctldatapb.GetWorkflowStatusesResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetWorkflowStatuses(ctx, in, opts...)
+}
+
+// GetWorkflows is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetWorkflows(ctx context.Context, in *vtctldatapb.GetWorkflowsRequest, opts ...grpc.CallOption) (*vtctldatapb.GetWorkflowsResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetWorkflows(ctx, in, opts...)
+}
+
 // InitShardPrimary is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) InitShardPrimary(ctx context.Context, in *vtctldatapb.InitShardPrimaryRequest, opts ...grpc.CallOption) (*vtctldatapb.InitShardPrimaryResponse, error) {
 	if client.c == nil {
@@ -217,6 +290,15 @@ func (client *gRPCVtctldClient) InitShardPrimary(ctx context.Context, in *vtctld
 	return client.c.InitShardPrimary(ctx, in, opts...)
 }
 
+// MoveTables is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) MoveTables(ctx context.Context, in *vtctldatapb.MoveTablesRequest, opts ...grpc.CallOption) (*vtctldatapb.MoveTablesResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.MoveTables(ctx, in, opts...)
+}
+
 // PlannedReparentShard is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) PlannedReparentShard(ctx context.Context, in *vtctldatapb.PlannedReparentShardRequest, opts ...grpc.CallOption) (*vtctldatapb.PlannedReparentShardResponse, error) {
 	if client.c == nil {
@@ -226,6 +308,24 @@ func (client *gRPCVtctldClient) PlannedReparentShard(ctx context.Context, in *vt
 	return client.c.PlannedReparentShard(ctx, in, opts...)
 }
 
+// RebuildKeyspaceGraph is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) RebuildKeyspaceGraph(ctx context.Context, in *vtctldatapb.RebuildKeyspaceGraphRequest, opts ...grpc.CallOption) (*vtctldatapb.RebuildKeyspaceGraphResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.RebuildKeyspaceGraph(ctx, in, opts...)
+}
+
+// RefreshState is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) RefreshState(ctx context.Context, in *vtctldatapb.RefreshStateRequest, opts ...grpc.CallOption) (*vtctldatapb.RefreshStateResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.RefreshState(ctx, in, opts...)
+}
+
 // RemoveKeyspaceCell is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) RemoveKeyspaceCell(ctx context.Context, in *vtctldatapb.RemoveKeyspaceCellRequest, opts ...grpc.CallOption) (*vtctldatapb.RemoveKeyspaceCellResponse, error) {
 	if client.c == nil {
@@ -253,6 +353,24 @@ func (client *gRPCVtctldClient) ReparentTablet(ctx context.Context, in *vtctldat
 	return client.c.ReparentTablet(ctx, in, opts...)
 }
 
+// Reshard is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) Reshard(ctx context.Context, in *vtctldatapb.ReshardRequest, opts ...grpc.CallOption) (*vtctldatapb.ReshardResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.Reshard(ctx, in, opts...)
+}
+
+// RestoreFromBackup is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) RestoreFromBackup(ctx context.Context, in *vtctldatapb.RestoreFromBackupRequest, opts ...grpc.CallOption) (vtctlservicepb.Vtctld_RestoreFromBackupClient, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.RestoreFromBackup(ctx, in, opts...)
+}
+
 // TabletExternallyReparented is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) TabletExternallyReparented(ctx context.Context, in *vtctldatapb.TabletExternallyReparentedRequest, opts ...grpc.CallOption) (*vtctldatapb.TabletExternallyReparentedResponse, error) {
 	if client.c == nil {
@@ -261,3 +379,39 @@ func (client *gRPCVtctldClient) TabletExternallyReparented(ctx context.Context,
 
 	return client.c.TabletExternallyReparented(ctx, in, opts...)
 }
+
+// ThrottleApp is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ThrottleApp(ctx context.Context, in *vtctldatapb.ThrottleAppRequest, opts ...grpc.CallOption) (*vtctldatapb.ThrottleAppResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ThrottleApp(ctx, in, opts...)
+}
+
+// UnthrottleApp is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) UnthrottleApp(ctx context.Context, in *vtctldatapb.UnthrottleAppRequest, opts ...grpc.CallOption) (*vtctldatapb.UnthrottleAppResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.UnthrottleApp(ctx, in, opts...)
+}
+
+// VDiff is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) VDiff(ctx context.Context, in *vtctldatapb.VDiffRequest, opts ...grpc.CallOption) (*vtctldatapb.VDiffResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.VDiff(ctx, in, opts...)
+}
+
+// WorkflowAction is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) WorkflowAction(ctx context.Context, in *vtctldatapb.WorkflowActionRequest, opts ...grpc.CallOption) (*vtctldatapb.WorkflowActionResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.WorkflowAction(ctx, in, opts...)
+}
@@ -132,13 +132,13 @@ func main() { // nolint:funlen
 		// The second result is always error.
 		result := sig.Results().At(0)
 
-		localType, localImport, pkgPath, err = extractLocalPointerType(result) // (TODO|@amason): does not work for streaming rpcs
+		resultType, localImport, pkgPath, err := extractLocalResultType(result)
 		if err != nil {
 			panic(err)
 		}
 
 		f.Result.Name = result.Name()
-		f.Result.Type = "*" + localImport + "." + localType
+		f.Result.Type = resultType
 
 		if _, ok := imports[localImport]; !ok {
 			importNames = append(importNames, localImport)
@@ -271,6 +271,26 @@ func rewriteProtoImports(pkg *types.Package) string {
 	return pkg.Name()
 }
 
+// extractLocalResultType returns the locally-qualified type of the first result
+// of a client method. For unary RPCs, this is a pointer to the response
+// message; for server-streaming RPCs, this is the stream client interface
+// (e.g. vtctlservicepb.Vtctld_BackupClient).
+func extractLocalResultType(v *types.Var) (typ string, localImport string, pkgPath string, err error) {
+	if named, ok := v.Type().(*types.Named); ok {
+		if _, ok := named.Underlying().(*types.Interface); ok {
+			localImport = rewriteProtoImports(named.Obj().Pkg())
+			return localImport + "." + named.Obj().Name(), localImport, named.Obj().Pkg().Path(), nil
+		}
+	}
+
+	name, localImport, pkgPath, err := extractLocalPointerType(v)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return "*" + localImport + "." + name, localImport, pkgPath, nil
+}
+
 func extractLocalPointerType(v *types.Var) (name string, localImport string, pkgPath string, err error) {
 	ptr, ok := v.Type().(*types.Pointer)
 	if !ok {
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
 	"sync"
 	"time"
@@ -28,18 +29,22 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"vitess.io/vitess/go/event"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/mysqlctl/mysqlctlproto"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/topotools/events"
 	"vitess.io/vitess/go/vt/vtctl/reparentutil"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
@@ -55,17 +60,165 @@ import (
 
 const (
 	initShardMasterOperation = "InitShardMaster" // (TODO:@amason) Can I rename this to Primary?
+
+	// defaultThrottleAppDuration is how long ThrottleApp throttles an app for
+	// when the request does not specify a duration.
+	defaultThrottleAppDuration = time.Hour
 )
 
 // VtctldServer implements the Vtctld RPC service protocol.
 type VtctldServer struct {
 	ts  *topo.Server
 	tmc tmclient.TabletManagerClient
+	ws  *workflow.Server
+	wr  WranglerServer
+}
+
+// WranglerServer implements the Vtctld RPCs whose logic still lives in package
+// wrangler (and in the packages built on top of it, like schemamanager). Those
+// packages import this one, so the implementation cannot be called directly
+// and is instead provided with SetWranglerServer. Without one, these RPCs
+// return an UNIMPLEMENTED error.
+type WranglerServer interface {
+	ApplySchema(ctx context.Context, req *vtctldatapb.ApplySchemaRequest) (*vtctldatapb.ApplySchemaResponse, error)
+	MoveTables(ctx context.Context, req *vtctldatapb.MoveTablesRequest) (*vtctldatapb.MoveTablesResponse, error)
+	Reshard(ctx context.Context, req *vtctldatapb.ReshardRequest) (*vtctldatapb.ReshardResponse, error)
+	VDiff(ctx context.Context, req *vtctldatapb.VDiffRequest) (*vtctldatapb.VDiffResponse, error)
+	WorkflowAction(ctx context.Context, req *vtctldatapb.WorkflowActionRequest) (*vtctldatapb.WorkflowActionResponse, error)
 }
 
 // NewVtctldServer returns a new VtctldServer for the given topo server.
 func NewVtctldServer(ts *topo.Server) *VtctldServer {
-	return &VtctldServer{ts: ts, tmc: tmclient.NewTabletManagerClient()}
+	tmc := tmclient.NewTabletManagerClient()
+
+	return &VtctldServer{
+		ts:  ts,
+		tmc: tmc,
+		ws:  workflow.NewServer(ts, tmc),
+	}
+}
+
+// SetWranglerServer sets the implementation of the RPCs that depend on the
+// wrangler. It must be called before the server starts serving.
+func (s *VtctldServer) SetWranglerServer(wr WranglerServer) {
+	s.wr = wr
+}
+
+func (s *VtctldServer) wranglerServer(rpc string) (WranglerServer, error) {
+	if s.wr == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "%s is not available on this vtctld: no WranglerServer configured", rpc)
+	}
+
+	return s.wr, nil
+}
+
+// ApplySchema is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ApplySchema(ctx context.Context, req *vtctldatapb.ApplySchemaRequest) (*vtctldatapb.ApplySchemaResponse, error) {
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+
+	if len(req.Sql) == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "sql field is required")
+	}
+
+	wr, err := s.wranglerServer("ApplySchema")
+	if err != nil {
+		return nil, err
+	}
+
+	return wr.ApplySchema(ctx, req)
+}
+
+// ApplyVSchema is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ApplyVSchema(ctx context.Context, req *vtctldatapb.ApplyVSchemaRequest) (*vtctldatapb.ApplyVSchemaResponse, error) {
+	if (req.Sql != "") == (req.VSchema != nil) {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "exactly one of Sql or VSchema must be specified")
+	}
+
+	if _, err := s.ts.GetKeyspace(ctx, req.Keyspace); err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "keyspace(%s) doesn't exist, check if the keyspace is initialized", req.Keyspace)
+		}
+
+		return nil, err
+	}
+
+	vs := req.VSchema
+	if req.Sql != "" {
+		stmt, err := sqlparser.Parse(req.Sql)
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "error parsing vschema statement `%s`: %v", req.Sql, err)
+		}
+
+		ddl, ok := stmt.(*sqlparser.AlterVschema)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "error parsing vschema statement `%s`: not a ddl statement", req.Sql)
+		}
+
+		vs, err = s.ts.GetVSchema(ctx, req.Keyspace)
+		if err != nil {
+			if !topo.IsErrType(err, topo.NoNode) {
+				return nil, err
+			}
+
+			vs = &vschemapb.Keyspace{}
+		}
+
+		vs, err = topotools.ApplyVSchemaDDL(req.Keyspace, vs, ddl)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.DryRun {
+		return &vtctldatapb.ApplyVSchemaResponse{VSchema: vs}, nil
+	}
+
+	if err := s.ts.SaveVSchema(ctx, req.Keyspace, vs); err != nil {
+		return nil, fmt.Errorf("SaveVSchema(%v) = %w", vs, err)
+	}
+
+	if !req.SkipRebuild {
+		if err := s.ts.RebuildSrvVSchema(ctx, req.Cells); err != nil {
+			return nil, fmt.Errorf("RebuildSrvVSchema(%v) = %w", req.Cells, err)
+		}
+	}
+
+	return &vtctldatapb.ApplyVSchemaResponse{VSchema: vs}, nil
+}
+
+// Backup is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) Backup(req *vtctldatapb.BackupRequest, stream vtctlservicepb.Vtctld_BackupServer) error {
+	if req.TabletAlias == nil {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "tablet alias must not be nil")
+	}
+
+	ctx := stream.Context()
+
+	ti, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return err
+	}
+
+	concurrency := int(req.Concurrency)
+	if concurrency == 0 {
+		concurrency = 4
+	}
+
+	logStream, err := s.tmc.Backup(ctx, ti.Tablet, concurrency, req.AllowPrimary, req.Incremental)
+	if err != nil {
+		return err
+	}
+
+	return forwardEvents(logStream, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.BackupResponse{
+			TabletAlias: req.TabletAlias,
+			Keyspace:    ti.Keyspace,
+			Shard:       ti.Shard,
+			Event:       e,
+		})
+	})
 }
 
 // ChangeTabletType is part of the vtctlservicepb.VtctldServer interface.
@@ -127,6 +280,12 @@ func (s *VtctldServer) CreateKeyspace(ctx context.Context, req *vtctldatapb.Crea
 		return nil, fmt.Errorf("unknown keyspace type %v", req.Type)
 	}
 
+	if req.DurabilityPolicy != "" {
+		if _, err := reparentutil.GetDurabilityPolicy(req.DurabilityPolicy); err != nil {
+			return nil, err
+		}
+	}
+
 	ki := &topodatapb.Keyspace{
 		KeyspaceType:       req.Type,
 		ShardingColumnName: req.ShardingColumnName,
@@ -136,6 +295,8 @@ func (s *VtctldServer) CreateKeyspace(ctx context.Context, req *vtctldatapb.Crea
 
 		BaseKeyspace: req.BaseKeyspace,
 		SnapshotTime: req.SnapshotTime,
+
+		DurabilityPolicy: req.DurabilityPolicy,
 	}
 
 	err := s.ts.CreateKeyspace(ctx, req.Name, ki)
@@ -327,6 +488,11 @@ func (s *VtctldServer) EmergencyReparentShard(ctx context.Context, req *vtctldat
 		waitReplicasTimeout = time.Second * 30
 	}
 
+	maxReplicationLag, _, err := protoutil.DurationFromProto(req.MaxReplicationLag)
+	if err != nil {
+		return nil, err
+	}
+
 	m := sync.RWMutex{}
 	logstream := []*logutilpb.Event{}
 	logger := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
@@ -343,6 +509,8 @@ func (s *VtctldServer) EmergencyReparentShard(ctx context.Context, req *vtctldat
 			NewPrimaryAlias:     req.NewPrimary,
 			IgnoreReplicas:      sets.NewString(topoproto.TabletAliasList(req.IgnoreReplicas).ToStringSlice()...),
 			WaitReplicasTimeout: waitReplicasTimeout,
+			MaxReplicationLag:   maxReplicationLag,
+			DryRun:              req.DryRun,
 		},
 	)
 
@@ -411,6 +579,14 @@ func (s *VtctldServer) GetBackups(ctx context.Context, req *vtctldatapb.GetBacku
 
 	for i, bh := range bhs {
 		resp.Backups[i] = mysqlctlproto.BackupHandleToProto(bh)
+
+		// A backup whose verification can't be read is still listed.
+		verification, err := mysqlctl.FindBackupVerification(ctx, bh)
+		if err != nil {
+			log.Warningf("can't read the verification of backup %v: %v", bh.Name(), err)
+			continue
+		}
+		resp.Backups[i].Verification = mysqlctlproto.BackupVerificationToProto(verification)
 	}
 
 	return resp, nil
@@ -676,6 +852,31 @@ func (s *VtctldServer) GetVSchema(ctx context.Context, req *vtctldatapb.GetVSche
 	}, nil
 }
 
+// GetWorkflow is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetWorkflow(ctx context.Context, req *vtctldatapb.GetWorkflowRequest) (*vtctldatapb.GetWorkflowResponse, error) {
+	return s.ws.GetWorkflow(ctx, req)
+}
+
+// GetWorkflowProgress is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetWorkflowProgress(ctx context.Context, req *vtctldatapb.GetWorkflowProgressRequest) (*vtctldatapb.GetWorkflowProgressResponse, error) {
+	return s.ws.GetWorkflowProgress(ctx, req)
+}
+
+// GetWorkflowStatus is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetWorkflowStatus(ctx context.Context, req *vtctldatapb.GetWorkflowStatusRequest) (*vtctldatapb.GetWorkflowStatusResponse, error) {
+	return s.ws.GetWorkflowStatus(ctx, req)
+}
+
+// GetWorkflowStatuses is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetWorkflowStatuses(ctx context.Context, req *vtctldatapb.GetWorkflowStatusesRequest) (*vtctldatapb.GetWorkflowStatusesResponse, error) {
+	return s.ws.GetWorkflowStatuses(ctx, req)
+}
+
+// GetWorkflows is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetWorkflows(ctx context.Context, req *vtctldatapb.GetWorkflowsRequest) (*vtctldatapb.GetWorkflowsResponse, error) {
+	return s.ws.GetWorkflows(ctx, req)
+}
+
 // InitShardPrimary is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) InitShardPrimary(ctx context.Context, req *vtctldatapb.InitShardPrimaryRequest) (*vtctldatapb.InitShardPrimaryResponse, error) {
 	if req.Keyspace == "" {
@@ -928,6 +1129,27 @@ func (s *VtctldServer) InitShardPrimaryLocked(
 	return nil
 }
 
+// MoveTables is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) MoveTables(ctx context.Context, req *vtctldatapb.MoveTablesRequest) (*vtctldatapb.MoveTablesResponse, error) {
+	switch {
+	case req.Workflow == "":
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "workflow field is required")
+	case req.SourceKeyspace == "" || req.TargetKeyspace == "":
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "source_keyspace and target_keyspace fields are required")
+	case (len(req.IncludeTables) > 0) == req.AllTables:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "exactly one of IncludeTables or AllTables must be specified")
+	case len(req.ExcludeTables) > 0 && !req.AllTables:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ExcludeTables can only be specified along with AllTables")
+	}
+
+	wr, err := s.wranglerServer("MoveTables")
+	if err != nil {
+		return nil, err
+	}
+
+	return wr.MoveTables(ctx, req)
+}
+
 // PlannedReparentShard is part of the vtctldservicepb.VtctldServer interface.
 func (s *VtctldServer) PlannedReparentShard(ctx context.Context, req *vtctldatapb.PlannedReparentShardRequest) (*vtctldatapb.PlannedReparentShardResponse, error) {
 	waitReplicasTimeout, ok, err := protoutil.DurationFromProto(req.WaitReplicasTimeout)
@@ -979,6 +1201,37 @@ func (s *VtctldServer) PlannedReparentShard(ctx context.Context, req *vtctldatap
 	return resp, err
 }
 
+// RebuildKeyspaceGraph is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) RebuildKeyspaceGraph(ctx context.Context, req *vtctldatapb.RebuildKeyspaceGraphRequest) (*vtctldatapb.RebuildKeyspaceGraphResponse, error) {
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+
+	if err := topotools.RebuildKeyspace(ctx, logutil.NewConsoleLogger(), s.ts, req.Keyspace, req.Cells, req.AllowPartial); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.RebuildKeyspaceGraphResponse{}, nil
+}
+
+// RefreshState is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) RefreshState(ctx context.Context, req *vtctldatapb.RefreshStateRequest) (*vtctldatapb.RefreshStateResponse, error) {
+	if req.TabletAlias == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "tablet alias must not be nil")
+	}
+
+	tablet, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.tmc.RefreshState(ctx, tablet.Tablet); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.RefreshStateResponse{}, nil
+}
+
 // RemoveKeyspaceCell is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) RemoveKeyspaceCell(ctx context.Context, req *vtctldatapb.RemoveKeyspaceCellRequest) (*vtctldatapb.RemoveKeyspaceCellResponse, error) {
 	shards, err := s.ts.GetShardNames(ctx, req.Keyspace)
@@ -1056,6 +1309,66 @@ func (s *VtctldServer) ReparentTablet(ctx context.Context, req *vtctldatapb.Repa
 	}, nil
 }
 
+// Reshard is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) Reshard(ctx context.Context, req *vtctldatapb.ReshardRequest) (*vtctldatapb.ReshardResponse, error) {
+	switch {
+	case req.Workflow == "":
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "workflow field is required")
+	case req.Keyspace == "":
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	case len(req.SourceShards) == 0 || len(req.TargetShards) == 0:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "source_shards and target_shards fields are required")
+	}
+
+	wr, err := s.wranglerServer("Reshard")
+	if err != nil {
+		return nil, err
+	}
+
+	return wr.Reshard(ctx, req)
+}
+
+// RestoreFromBackup is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) RestoreFromBackup(req *vtctldatapb.RestoreFromBackupRequest, stream vtctlservicepb.Vtctld_RestoreFromBackupServer) error {
+	if req.TabletAlias == nil {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "tablet alias must not be nil")
+	}
+
+	if req.RestoreToPos != "" && req.RestoreToTimestamp != nil {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "only one of RestoreToPos and RestoreToTimestamp can be specified")
+	}
+
+	if _, err := mysql.DecodePosition(req.RestoreToPos); err != nil {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid RestoreToPos %v: %v", req.RestoreToPos, err)
+	}
+
+	var restoreTime time.Time
+	if req.RestoreToTimestamp != nil {
+		restoreTime = logutil.ProtoToTime(req.RestoreToTimestamp)
+	}
+
+	ctx := stream.Context()
+
+	ti, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return err
+	}
+
+	logStream, err := s.tmc.RestoreFromBackup(ctx, ti.Tablet, req.RestoreToPos, restoreTime)
+	if err != nil {
+		return err
+	}
+
+	return forwardEvents(logStream, func(e *logutilpb.Event) error {
+		return stream.Send(&vtctldatapb.RestoreFromBackupResponse{
+			TabletAlias: req.TabletAlias,
+			Keyspace:    ti.Keyspace,
+			Shard:       ti.Shard,
+			Event:       e,
+		})
+	})
+}
+
 // TabletExternallyReparented is part of the vtctldservicepb.VtctldServer interface.
 func (s *VtctldServer) TabletExternallyReparented(ctx context.Context, req *vtctldatapb.TabletExternallyReparentedRequest) (*vtctldatapb.TabletExternallyReparentedResponse, error) {
 	if req.Tablet == nil {
@@ -1116,7 +1429,170 @@ func (s *VtctldServer) TabletExternallyReparented(ctx context.Context, req *vtct
 	return resp, nil
 }
 
+// ThrottleApp is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ThrottleApp(ctx context.Context, req *vtctldatapb.ThrottleAppRequest) (resp *vtctldatapb.ThrottleAppResponse, err error) {
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+
+	if req.AppName == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "app_name field is required")
+	}
+
+	ratio := req.Ratio
+	if ratio == 0 {
+		ratio = 1
+	}
+
+	if ratio < 0 || ratio > 1 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "ratio must be between 0 and 1, got %v", req.Ratio)
+	}
+
+	duration, ok, err := protoutil.DurationFromProto(req.Duration)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		duration = defaultThrottleAppDuration
+	}
+
+	if duration <= 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "duration must be positive, got %v", duration)
+	}
+
+	ctx, unlock, lockErr := s.ts.LockKeyspace(ctx, req.Keyspace, "ThrottleApp")
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock(&err)
+
+	ki, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	rule := &topodatapb.ThrottledAppRule{
+		Name:      req.AppName,
+		Ratio:     ratio,
+		ExpiresAt: logutil.TimeToProto(now.Add(duration)),
+	}
+
+	ki.ThrottledApps = append(removeThrottledAppRules(ki.ThrottledApps, req.AppName, now), rule)
+	if err := s.ts.UpdateKeyspace(ctx, ki); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.ThrottleAppResponse{
+		Rule: rule,
+	}, nil
+}
+
+// UnthrottleApp is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) UnthrottleApp(ctx context.Context, req *vtctldatapb.UnthrottleAppRequest) (resp *vtctldatapb.UnthrottleAppResponse, err error) {
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+
+	if req.AppName == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "app_name field is required")
+	}
+
+	ctx, unlock, lockErr := s.ts.LockKeyspace(ctx, req.Keyspace, "UnthrottleApp")
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock(&err)
+
+	ki, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	ki.ThrottledApps = removeThrottledAppRules(ki.ThrottledApps, req.AppName, time.Now())
+	if err := s.ts.UpdateKeyspace(ctx, ki); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.UnthrottleAppResponse{}, nil
+}
+
+// VDiff is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VDiff(ctx context.Context, req *vtctldatapb.VDiffRequest) (*vtctldatapb.VDiffResponse, error) {
+	if req.Keyspace == "" || req.Workflow == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace and workflow fields are required")
+	}
+
+	wr, err := s.wranglerServer("VDiff")
+	if err != nil {
+		return nil, err
+	}
+
+	return wr.VDiff(ctx, req)
+}
+
+// WorkflowAction is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) WorkflowAction(ctx context.Context, req *vtctldatapb.WorkflowActionRequest) (*vtctldatapb.WorkflowActionResponse, error) {
+	if req.Keyspace == "" || req.Workflow == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace and workflow fields are required")
+	}
+
+	switch req.Action {
+	case "start", "stop", "delete":
+	default:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unsupported workflow action %q; must be one of start, stop or delete", req.Action)
+	}
+
+	wr, err := s.wranglerServer("WorkflowAction")
+	if err != nil {
+		return nil, err
+	}
+
+	return wr.WorkflowAction(ctx, req)
+}
+
 // StartServer registers a VtctldServer for RPCs on the given gRPC server.
 func StartServer(s *grpc.Server, ts *topo.Server) {
 	vtctlservicepb.RegisterVtctldServer(s, NewVtctldServer(ts))
 }
+
+// StartServerWithWrangler registers a VtctldServer for RPCs on the given gRPC
+// server, using wr to serve the RPCs that depend on the wrangler.
+func StartServerWithWrangler(s *grpc.Server, ts *topo.Server, wr WranglerServer) {
+	server := NewVtctldServer(ts)
+	server.SetWranglerServer(wr)
+
+	vtctlservicepb.RegisterVtctldServer(s, server)
+}
+
+// forwardEvents calls send for each event received from a tablet's log stream,
+// until the stream ends.
+func forwardEvents(logStream logutil.EventStream, send func(e *logutilpb.Event) error) error {
+	for {
+		e, err := logStream.Recv()
+		switch err {
+		case nil:
+			if err := send(e); err != nil {
+				return err
+			}
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
+// removeThrottledAppRules returns the rules that are neither for the given app
+// nor expired at the given time.
+func removeThrottledAppRules(rules []*topodatapb.ThrottledAppRule, appName string, now time.Time) []*topodatapb.ThrottledAppRule {
+	var remaining []*topodatapb.ThrottledAppRule
+	for _, rule := range rules {
+		if rule.Name == appName || !logutil.ProtoToTime(rule.ExpiresAt).After(now) {
+			continue
+		}
+
+		remaining = append(remaining, rule)
+	}
+
+	return remaining
+}
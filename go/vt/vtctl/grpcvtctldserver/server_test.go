@@ -28,14 +28,17 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/test/utils"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver/testutil"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	mysqlctlpb "vitess.io/vitess/go/vt/proto/mysqlctl"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
@@ -44,6 +47,7 @@ import (
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/proto/vttime"
 )
 
@@ -63,6 +67,289 @@ func init() {
 	})
 }
 
+func TestApplySchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+
+	t.Run("no wrangler server", func(t *testing.T) {
+		t.Parallel()
+
+		vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+			return NewVtctldServer(ts)
+		})
+
+		_, err := vtctld.ApplySchema(ctx, &vtctldatapb.ApplySchemaRequest{
+			Keyspace: "testkeyspace",
+			Sql:      []string{"alter table t add column c int"},
+		})
+		assert.Equal(t, vtrpc.Code_UNIMPLEMENTED, vterrors.Code(err))
+	})
+
+	t.Run("missing sql", func(t *testing.T) {
+		t.Parallel()
+
+		vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+			s := NewVtctldServer(ts)
+			s.SetWranglerServer(&fakeWranglerServer{})
+			return s
+		})
+
+		_, err := vtctld.ApplySchema(ctx, &vtctldatapb.ApplySchemaRequest{
+			Keyspace: "testkeyspace",
+		})
+		assert.Equal(t, vtrpc.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	})
+
+	t.Run("delegates to wrangler server", func(t *testing.T) {
+		t.Parallel()
+
+		expected := &vtctldatapb.ApplySchemaResponse{
+			UuidList: []string{"c4b7a8f2_5e2b_11eb_ae93_0242ac130002"},
+		}
+		vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+			s := NewVtctldServer(ts)
+			s.SetWranglerServer(&fakeWranglerServer{
+				ApplySchemaResponse: expected,
+			})
+			return s
+		})
+
+		resp, err := vtctld.ApplySchema(ctx, &vtctldatapb.ApplySchemaRequest{
+			Keyspace:    "testkeyspace",
+			Sql:         []string{"alter table t add column c int"},
+			DdlStrategy: "gh-ost",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, expected, resp)
+	})
+}
+
+func TestApplyVSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.ApplyVSchemaRequest
+		expected  *vtctldatapb.ApplyVSchemaResponse
+		saved     *vschemapb.Keyspace // the vschema expected in the topo afterwards
+		shouldErr bool
+	}{
+		{
+			name: "vschema",
+			req: &vtctldatapb.ApplyVSchemaRequest{
+				Keyspace: "testkeyspace",
+				VSchema: &vschemapb.Keyspace{
+					Sharded: true,
+				},
+			},
+			expected: &vtctldatapb.ApplyVSchemaResponse{
+				VSchema: &vschemapb.Keyspace{
+					Sharded: true,
+				},
+			},
+			saved: &vschemapb.Keyspace{
+				Sharded: true,
+			},
+		},
+		{
+			name: "sql",
+			req: &vtctldatapb.ApplyVSchemaRequest{
+				Keyspace: "testkeyspace",
+				Sql:      "alter vschema create vindex hash_vdx using hash",
+			},
+			expected: &vtctldatapb.ApplyVSchemaResponse{
+				VSchema: &vschemapb.Keyspace{
+					Sharded: true,
+					Vindexes: map[string]*vschemapb.Vindex{
+						"hash_vdx": {
+							Type:   "hash",
+							Params: map[string]string{},
+						},
+					},
+					Tables: map[string]*vschemapb.Table{},
+				},
+			},
+			saved: &vschemapb.Keyspace{
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash_vdx": {
+						Type: "hash",
+					},
+				},
+			},
+		},
+		{
+			name: "dry run",
+			req: &vtctldatapb.ApplyVSchemaRequest{
+				Keyspace: "testkeyspace",
+				VSchema: &vschemapb.Keyspace{
+					Sharded: true,
+				},
+				DryRun: true,
+			},
+			expected: &vtctldatapb.ApplyVSchemaResponse{
+				VSchema: &vschemapb.Keyspace{
+					Sharded: true,
+				},
+			},
+			saved: &vschemapb.Keyspace{},
+		},
+		{
+			name: "both vschema and sql",
+			req: &vtctldatapb.ApplyVSchemaRequest{
+				Keyspace: "testkeyspace",
+				VSchema:  &vschemapb.Keyspace{},
+				Sql:      "alter vschema create vindex hash_vdx using hash",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "not a vschema ddl",
+			req: &vtctldatapb.ApplyVSchemaRequest{
+				Keyspace: "testkeyspace",
+				Sql:      "select 1",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "keyspace not found",
+			req: &vtctldatapb.ApplyVSchemaRequest{
+				Keyspace: "doesnotexist",
+				VSchema:  &vschemapb.Keyspace{},
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+				Name:     "testkeyspace",
+				Keyspace: &topodatapb.Keyspace{},
+			})
+			require.NoError(t, ts.SaveVSchema(ctx, "testkeyspace", &vschemapb.Keyspace{}))
+
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+
+			resp, err := vtctld.ApplyVSchema(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, resp)
+
+			vs, err := ts.GetVSchema(ctx, "testkeyspace")
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.saved, vs)
+
+			if !tt.req.DryRun {
+				srvVSchema, err := ts.GetSrvVSchema(ctx, "zone1")
+				require.NoError(t, err)
+				utils.MustMatch(t, tt.saved, srvVSchema.Keyspaces["testkeyspace"])
+			}
+		})
+	}
+}
+
+func TestBackup(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddTablet(ctx, t, ts, &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "zone1",
+			Uid:  100,
+		},
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+	}, nil)
+
+	events := []*logutilpb.Event{
+		{Value: "starting backup"},
+		{Value: "backup done"},
+	}
+	tmc := &testutil.TabletManagerClient{
+		BackupResults: map[string]struct {
+			Events []*logutilpb.Event
+			Error  error
+		}{
+			"zone1-0000000100": {
+				Events: events,
+			},
+			"zone1-0000000200": {
+				Events: events[:1],
+				Error:  assert.AnError,
+			},
+		},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		stream := &backupServerStream{ctx: ctx}
+		err := vtctld.Backup(&vtctldatapb.BackupRequest{
+			TabletAlias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+		}, stream)
+		require.NoError(t, err)
+		require.Len(t, stream.responses, 2)
+
+		for i, resp := range stream.responses {
+			assert.Equal(t, "testkeyspace", resp.Keyspace)
+			assert.Equal(t, "-", resp.Shard)
+			assert.Equal(t, events[i], resp.Event)
+		}
+	})
+
+	t.Run("tablet not found", func(t *testing.T) {
+		stream := &backupServerStream{ctx: ctx}
+		err := vtctld.Backup(&vtctldatapb.BackupRequest{
+			TabletAlias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  404,
+			},
+		}, stream)
+		assert.Error(t, err)
+	})
+
+	t.Run("stream error", func(t *testing.T) {
+		testutil.AddTablet(ctx, t, ts, &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  200,
+			},
+			Keyspace: "testkeyspace",
+			Shard:    "-",
+		}, nil)
+
+		stream := &backupServerStream{ctx: ctx}
+		err := vtctld.Backup(&vtctldatapb.BackupRequest{
+			TabletAlias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  200,
+			},
+		}, stream)
+		assert.True(t, errors.Is(err, assert.AnError), "expected %v, got %v", assert.AnError, err)
+		assert.Len(t, stream.responses, 1)
+	})
+}
+
 func TestChangeTabletType(t *testing.T) {
 	t.Parallel()
 
@@ -328,6 +615,40 @@ func TestCreateKeyspace(t *testing.T) {
 			},
 			shouldErr: false,
 		},
+		{
+			name: "keyspace with durability policy",
+			topo: nil,
+			req: &vtctldatapb.CreateKeyspaceRequest{
+				Name:             "testkeyspace",
+				Type:             topodatapb.KeyspaceType_NORMAL,
+				DurabilityPolicy: "semi_sync",
+			},
+			expected: &vtctldatapb.CreateKeyspaceResponse{
+				Keyspace: &vtctldatapb.Keyspace{
+					Name: "testkeyspace",
+					Keyspace: &topodatapb.Keyspace{
+						KeyspaceType:     topodatapb.KeyspaceType_NORMAL,
+						DurabilityPolicy: "semi_sync",
+					},
+				},
+			},
+			vschemaShouldExist: true,
+			expectedVSchema: &vschemapb.Keyspace{
+				Sharded: false,
+			},
+			shouldErr: false,
+		},
+		{
+			name: "unknown durability policy",
+			topo: nil,
+			req: &vtctldatapb.CreateKeyspaceRequest{
+				Name:             "testkeyspace",
+				Type:             topodatapb.KeyspaceType_NORMAL,
+				DurabilityPolicy: "unknown",
+			},
+			expected:  nil,
+			shouldErr: true,
+		},
 		{
 			name: "snapshot keyspace",
 			topo: map[string]*topodatapb.Keyspace{
@@ -3243,6 +3564,93 @@ func TestGetVSchema(t *testing.T) {
 	})
 }
 
+func TestMoveTables(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		s := NewVtctldServer(ts)
+		s.SetWranglerServer(&fakeWranglerServer{
+			MoveTablesResponse: &vtctldatapb.MoveTablesResponse{},
+		})
+		return s
+	})
+
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.MoveTablesRequest
+		shouldErr bool
+	}{
+		{
+			name: "tables",
+			req: &vtctldatapb.MoveTablesRequest{
+				Workflow:       "wf",
+				SourceKeyspace: "source",
+				TargetKeyspace: "target",
+				IncludeTables:  []string{"t1", "t2"},
+			},
+		},
+		{
+			name: "all tables",
+			req: &vtctldatapb.MoveTablesRequest{
+				Workflow:       "wf",
+				SourceKeyspace: "source",
+				TargetKeyspace: "target",
+				AllTables:      true,
+				ExcludeTables:  []string{"t3"},
+			},
+		},
+		{
+			name: "no workflow",
+			req: &vtctldatapb.MoveTablesRequest{
+				SourceKeyspace: "source",
+				TargetKeyspace: "target",
+				AllTables:      true,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "tables and all tables",
+			req: &vtctldatapb.MoveTablesRequest{
+				Workflow:       "wf",
+				SourceKeyspace: "source",
+				TargetKeyspace: "target",
+				IncludeTables:  []string{"t1"},
+				AllTables:      true,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "exclude without all tables",
+			req: &vtctldatapb.MoveTablesRequest{
+				Workflow:       "wf",
+				SourceKeyspace: "source",
+				TargetKeyspace: "target",
+				IncludeTables:  []string{"t1"},
+				ExcludeTables:  []string{"t2"},
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := vtctld.MoveTables(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Equal(t, vtrpc.Code_INVALID_ARGUMENT, vterrors.Code(err))
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestPlannedReparentShard(t *testing.T) {
 	t.Parallel()
 
@@ -3429,6 +3837,39 @@ func TestPlannedReparentShard(t *testing.T) {
 	}
 }
 
+func TestRebuildKeyspaceGraph(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+		Name:     "testkeyspace",
+		Keyspace: &topodatapb.Keyspace{},
+	})
+	testutil.AddShards(ctx, t, ts, &vtctldatapb.Shard{
+		Keyspace: "testkeyspace",
+		Name:     "-",
+	})
+
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	_, err := vtctld.RebuildKeyspaceGraph(ctx, &vtctldatapb.RebuildKeyspaceGraphRequest{
+		Keyspace: "testkeyspace",
+	})
+	require.NoError(t, err)
+
+	srvKeyspace, err := ts.GetSrvKeyspace(ctx, "zone1", "testkeyspace")
+	require.NoError(t, err)
+	assert.NotEmpty(t, srvKeyspace.Partitions)
+
+	_, err = vtctld.RebuildKeyspaceGraph(ctx, &vtctldatapb.RebuildKeyspaceGraphRequest{
+		Keyspace: "doesnotexist",
+	})
+	assert.Error(t, err)
+}
+
 func TestRemoveKeyspaceCell(t *testing.T) {
 	t.Parallel()
 
@@ -4449,6 +4890,179 @@ func TestReparentTablet(t *testing.T) {
 	}
 }
 
+func TestRefreshState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name           string
+		ts             *topo.Server
+		tablet         *topodatapb.Tablet
+		refreshResults map[string]error
+		req            *vtctldatapb.RefreshStateRequest
+		shouldErr      bool
+	}{
+		{
+			name: "success",
+			ts:   memorytopo.NewServer("zone1"),
+			tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+			},
+			refreshResults: map[string]error{
+				"zone1-0000000100": nil,
+			},
+			req: &vtctldatapb.RefreshStateRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+			},
+		},
+		{
+			name:      "tablet alias is nil",
+			ts:        memorytopo.NewServer(),
+			req:       &vtctldatapb.RefreshStateRequest{},
+			shouldErr: true,
+		},
+		{
+			name: "tablet not found",
+			ts:   memorytopo.NewServer("zone1"),
+			req: &vtctldatapb.RefreshStateRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  400,
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "RefreshState failed",
+			ts:   memorytopo.NewServer("zone1"),
+			tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+			},
+			refreshResults: map[string]error{
+				"zone1-0000000100": fmt.Errorf("%w: RefreshState failed", assert.AnError),
+			},
+			req: &vtctldatapb.RefreshStateRequest{
+				TabletAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  100,
+				},
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if tt.tablet != nil {
+				testutil.AddTablet(ctx, t, tt.ts, tt.tablet, nil)
+			}
+
+			tmc := &testutil.TabletManagerClient{
+				RefreshStateResults: tt.refreshResults,
+			}
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, tt.ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+			_, err := vtctld.RefreshState(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRestoreFromBackup(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddTablet(ctx, t, ts, &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: "zone1",
+			Uid:  100,
+		},
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+	}, nil)
+
+	events := []*logutilpb.Event{
+		{Value: "restoring"},
+		{Value: "restore done"},
+	}
+	tmc := &testutil.TabletManagerClient{
+		RestoreFromBackupResults: map[string]struct {
+			Events []*logutilpb.Event
+			Error  error
+		}{
+			"zone1-0000000100": {
+				Events: events,
+			},
+		},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		stream := &restoreFromBackupServerStream{ctx: ctx}
+		err := vtctld.RestoreFromBackup(&vtctldatapb.RestoreFromBackupRequest{
+			TabletAlias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+		}, stream)
+		require.NoError(t, err)
+		require.Len(t, stream.responses, 2)
+
+		for i, resp := range stream.responses {
+			assert.Equal(t, "testkeyspace", resp.Keyspace)
+			assert.Equal(t, "-", resp.Shard)
+			assert.Equal(t, events[i], resp.Event)
+		}
+	})
+
+	t.Run("position and timestamp", func(t *testing.T) {
+		stream := &restoreFromBackupServerStream{ctx: ctx}
+		err := vtctld.RestoreFromBackup(&vtctldatapb.RestoreFromBackupRequest{
+			TabletAlias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+			RestoreToPos:       "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-10",
+			RestoreToTimestamp: logutil.TimeToProto(time.Now()),
+		}, stream)
+		assert.Equal(t, vtrpc.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	})
+
+	t.Run("invalid position", func(t *testing.T) {
+		stream := &restoreFromBackupServerStream{ctx: ctx}
+		err := vtctld.RestoreFromBackup(&vtctldatapb.RestoreFromBackupRequest{
+			TabletAlias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+			RestoreToPos: "not a position",
+		}, stream)
+		assert.Equal(t, vtrpc.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	})
+}
+
 func TestTabletExternallyReparented(t *testing.T) {
 	t.Parallel()
 
@@ -4862,3 +5476,201 @@ func TestTabletExternallyReparented(t *testing.T) {
 		})
 	}
 }
+
+func TestThrottleApp(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+		Name: "testkeyspace",
+		Keyspace: &topodatapb.Keyspace{
+			ThrottledApps: []*topodatapb.ThrottledAppRule{
+				{
+					Name:      "online-ddl",
+					Ratio:     1,
+					ExpiresAt: logutil.TimeToProto(time.Now().Add(time.Hour)),
+				},
+				{
+					Name:      "expired",
+					Ratio:     1,
+					ExpiresAt: logutil.TimeToProto(time.Now().Add(-time.Hour)),
+				},
+			},
+		},
+	})
+
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	resp, err := vtctld.ThrottleApp(ctx, &vtctldatapb.ThrottleAppRequest{
+		Keyspace: "testkeyspace",
+		AppName:  "online-ddl",
+		Ratio:    0.7,
+		Duration: protoutil.DurationToProto(time.Minute),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "online-ddl", resp.Rule.Name)
+	assert.Equal(t, 0.7, resp.Rule.Ratio)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), logutil.ProtoToTime(resp.Rule.ExpiresAt), 10*time.Second)
+
+	resp, err = vtctld.ThrottleApp(ctx, &vtctldatapb.ThrottleAppRequest{
+		Keyspace: "testkeyspace",
+		AppName:  "vreplication",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, resp.Rule.Ratio)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), logutil.ProtoToTime(resp.Rule.ExpiresAt), 10*time.Second)
+
+	ki, err := ts.GetKeyspace(ctx, "testkeyspace")
+	require.NoError(t, err)
+
+	names := []string{}
+	for _, rule := range ki.ThrottledApps {
+		names = append(names, rule.Name)
+	}
+	assert.Equal(t, []string{"online-ddl", "vreplication"}, names)
+	assert.Equal(t, 0.7, ki.ThrottledApps[0].Ratio)
+
+	for _, req := range []*vtctldatapb.ThrottleAppRequest{
+		{AppName: "online-ddl"},
+		{Keyspace: "testkeyspace"},
+		{Keyspace: "testkeyspace", AppName: "online-ddl", Ratio: 1.5},
+		{Keyspace: "testkeyspace", AppName: "online-ddl", Duration: protoutil.DurationToProto(-time.Minute)},
+		{Keyspace: "doesnotexist", AppName: "online-ddl"},
+	} {
+		_, err := vtctld.ThrottleApp(ctx, req)
+		assert.Error(t, err, "ThrottleApp(%+v)", req)
+	}
+}
+
+func TestUnthrottleApp(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	expiresAt := logutil.TimeToProto(time.Now().Add(time.Hour))
+	testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+		Name: "testkeyspace",
+		Keyspace: &topodatapb.Keyspace{
+			ThrottledApps: []*topodatapb.ThrottledAppRule{
+				{Name: "online-ddl", Ratio: 1, ExpiresAt: expiresAt},
+				{Name: "vreplication", Ratio: 0.5, ExpiresAt: expiresAt},
+			},
+		},
+	})
+
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	_, err := vtctld.UnthrottleApp(ctx, &vtctldatapb.UnthrottleAppRequest{
+		Keyspace: "testkeyspace",
+		AppName:  "online-ddl",
+	})
+	require.NoError(t, err)
+
+	ki, err := ts.GetKeyspace(ctx, "testkeyspace")
+	require.NoError(t, err)
+	require.Len(t, ki.ThrottledApps, 1)
+	assert.Equal(t, "vreplication", ki.ThrottledApps[0].Name)
+
+	_, err = vtctld.UnthrottleApp(ctx, &vtctldatapb.UnthrottleAppRequest{
+		Keyspace: "testkeyspace",
+	})
+	assert.Error(t, err)
+
+	_, err = vtctld.UnthrottleApp(ctx, &vtctldatapb.UnthrottleAppRequest{
+		Keyspace: "doesnotexist",
+		AppName:  "online-ddl",
+	})
+	assert.Error(t, err)
+}
+
+func TestWorkflowAction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+
+	expected := &vtctldatapb.WorkflowActionResponse{
+		RowsAffectedByTablet: map[string]uint64{
+			"zone1-0000000100": 1,
+		},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		s := NewVtctldServer(ts)
+		s.SetWranglerServer(&fakeWranglerServer{
+			WorkflowActionResponse: expected,
+		})
+		return s
+	})
+
+	for _, action := range []string{"start", "stop", "delete"} {
+		resp, err := vtctld.WorkflowAction(ctx, &vtctldatapb.WorkflowActionRequest{
+			Keyspace: "testkeyspace",
+			Workflow: "wf",
+			Action:   action,
+		})
+		require.NoError(t, err, "action %s", action)
+		assert.Equal(t, expected, resp)
+	}
+
+	_, err := vtctld.WorkflowAction(ctx, &vtctldatapb.WorkflowActionRequest{
+		Keyspace: "testkeyspace",
+		Workflow: "wf",
+		Action:   "show",
+	})
+	assert.Equal(t, vtrpc.Code_INVALID_ARGUMENT, vterrors.Code(err))
+}
+
+// fakeWranglerServer is a WranglerServer that returns fixed responses.
+type fakeWranglerServer struct {
+	WranglerServer
+
+	ApplySchemaResponse    *vtctldatapb.ApplySchemaResponse
+	MoveTablesResponse     *vtctldatapb.MoveTablesResponse
+	WorkflowActionResponse *vtctldatapb.WorkflowActionResponse
+}
+
+func (fake *fakeWranglerServer) ApplySchema(ctx context.Context, req *vtctldatapb.ApplySchemaRequest) (*vtctldatapb.ApplySchemaResponse, error) {
+	return fake.ApplySchemaResponse, nil
+}
+
+func (fake *fakeWranglerServer) MoveTables(ctx context.Context, req *vtctldatapb.MoveTablesRequest) (*vtctldatapb.MoveTablesResponse, error) {
+	return fake.MoveTablesResponse, nil
+}
+
+func (fake *fakeWranglerServer) WorkflowAction(ctx context.Context, req *vtctldatapb.WorkflowActionRequest) (*vtctldatapb.WorkflowActionResponse, error) {
+	return fake.WorkflowActionResponse, nil
+}
+
+// backupServerStream records the responses sent on a Backup stream.
+type backupServerStream struct {
+	vtctlservicepb.Vtctld_BackupServer
+	ctx       context.Context
+	responses []*vtctldatapb.BackupResponse
+}
+
+func (stream *backupServerStream) Context() context.Context { return stream.ctx }
+
+func (stream *backupServerStream) Send(resp *vtctldatapb.BackupResponse) error {
+	stream.responses = append(stream.responses, resp)
+	return nil
+}
+
+// restoreFromBackupServerStream records the responses sent on a
+// RestoreFromBackup stream.
+type restoreFromBackupServerStream struct {
+	vtctlservicepb.Vtctld_RestoreFromBackupServer
+	ctx       context.Context
+	responses []*vtctldatapb.RestoreFromBackupResponse
+}
+
+func (stream *restoreFromBackupServerStream) Context() context.Context { return stream.ctx }
+
+func (stream *restoreFromBackupServerStream) Send(resp *vtctldatapb.RestoreFromBackupResponse) error {
+	stream.responses = append(stream.responses, resp)
+	return nil
+}
@@ -18,6 +18,8 @@ package testutil
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"sort"
 
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
@@ -68,6 +70,12 @@ type backupHandle struct {
 func (bh *backupHandle) Directory() string { return bh.directory }
 func (bh *backupHandle) Name() string      { return bh.name }
 
+// ReadFile is part of the backupstorage.BackupHandle interface. The test
+// backups have no files.
+func (bh *backupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("%w: %v", backupstorage.ErrFileNotFound, filename)
+}
+
 // handlesByName implements the sort interface for backup handles by Name().
 type handlesByName []backupstorage.BackupHandle
 
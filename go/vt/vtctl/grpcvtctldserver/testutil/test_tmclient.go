@@ -19,17 +19,21 @@ package testutil
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -125,6 +129,12 @@ type TabletManagerClient struct {
 	// information, e.g. ChangeType. To force an error result for those rpcs in
 	// a test, set tmc.TopoServer = nil.
 	TopoServer *topo.Server
+	// keyed by tablet alias. The events are streamed back before Error, if
+	// any, is returned from the stream.
+	BackupResults map[string]struct {
+		Events []*logutilpb.Event
+		Error  error
+	}
 	// keyed by tablet alias.
 	DemoteMasterDelays map[string]time.Duration
 	// keyed by tablet alias.
@@ -147,10 +157,20 @@ type TabletManagerClient struct {
 		Error    error
 	}
 	// keyed by tablet alias.
+	MasterStatusResults map[string]struct {
+		Status *replicationdatapb.MasterStatus
+		Error  error
+	}
+	// keyed by tablet alias.
 	PopulateReparentJournalDelays map[string]time.Duration
 	// keyed by tablet alias
 	PopulateReparentJournalResults map[string]error
 	// keyed by tablet alias.
+	PreflightSchemaResults map[string]struct {
+		ChangeResults []*tabletmanagerdatapb.SchemaChangeResult
+		Error         error
+	}
+	// keyed by tablet alias.
 	PromoteReplicaDelays map[string]time.Duration
 	// keyed by tablet alias. injects a sleep to the end of the function
 	// regardless of parent context timeout or error result.
@@ -160,10 +180,18 @@ type TabletManagerClient struct {
 		Result string
 		Error  error
 	}
+	// keyed by tablet alias.
+	RefreshStateResults      map[string]error
 	ReplicationStatusResults map[string]struct {
 		Position *replicationdatapb.Status
 		Error    error
 	}
+	// keyed by tablet alias. The events are streamed back before Error, if
+	// any, is returned from the stream.
+	RestoreFromBackupResults map[string]struct {
+		Events []*logutilpb.Event
+		Error  error
+	}
 	// keyed by tablet alias.
 	SetMasterDelays map[string]time.Duration
 	// keyed by tablet alias.
@@ -180,6 +208,12 @@ type TabletManagerClient struct {
 		StopStatus *replicationdatapb.StopReplicationStatus
 		Error      error
 	}
+	// VReplicationExec(tablet *topodatapb.Tablet, query string), so we key by
+	// tablet alias and then by query.
+	VReplicationExecResults map[string]map[string]struct {
+		Result *querypb.QueryResult
+		Error  error
+	}
 	// keyed by tablet alias.
 	WaitForPositionDelays map[string]time.Duration
 	// keyed by tablet alias. injects a sleep to the end of the function
@@ -194,6 +228,43 @@ type TabletManagerClient struct {
 	UndoDemoteMasterResults map[string]error
 }
 
+// eventStream is a logutil.EventStream that returns a fixed list of events,
+// followed by an error, or io.EOF if there is none.
+type eventStream struct {
+	events []*logutilpb.Event
+	err    error
+}
+
+// Recv is part of the logutil.EventStream interface.
+func (stream *eventStream) Recv() (*logutilpb.Event, error) {
+	if len(stream.events) == 0 {
+		if stream.err != nil {
+			return nil, stream.err
+		}
+
+		return nil, io.EOF
+	}
+
+	e := stream.events[0]
+	stream.events = stream.events[1:]
+
+	return e, nil
+}
+
+// Backup is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool, incremental bool) (logutil.EventStream, error) {
+	if fake.BackupResults == nil {
+		return nil, assert.AnError
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.BackupResults[key]; ok {
+		return &eventStream{events: result.Events, err: result.Error}, nil
+	}
+
+	return nil, fmt.Errorf("%w: no Backup result set for tablet %s", assert.AnError, key)
+}
+
 // ChangeType is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, newType topodatapb.TabletType) error {
 	if fake.TopoServer == nil {
@@ -294,6 +365,21 @@ func (fake *TabletManagerClient) MasterPosition(ctx context.Context, tablet *top
 	return "", assert.AnError
 }
 
+// MasterStatus is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) MasterStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.MasterStatus, error) {
+	if fake.MasterStatusResults == nil {
+		return nil, assert.AnError
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+
+	if result, ok := fake.MasterStatusResults[key]; ok {
+		return result.Status, result.Error
+	}
+
+	return nil, assert.AnError
+}
+
 // PopulateReparentJournal is part of the tmclient.TabletManagerClient
 // interface.
 func (fake *TabletManagerClient) PopulateReparentJournal(ctx context.Context, tablet *topodatapb.Tablet, timeCreatedNS int64, actionName string, primaryAlias *topodatapb.TabletAlias, pos string) error {
@@ -320,6 +406,21 @@ func (fake *TabletManagerClient) PopulateReparentJournal(ctx context.Context, ta
 	return assert.AnError
 }
 
+// PreflightSchema is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) PreflightSchema(ctx context.Context, tablet *topodatapb.Tablet, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error) {
+	if fake.PreflightSchemaResults == nil {
+		return nil, assert.AnError
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+
+	if result, ok := fake.PreflightSchemaResults[key]; ok {
+		return result.ChangeResults, result.Error
+	}
+
+	return nil, fmt.Errorf("%w: no preflight schema results for %s", assert.AnError, key)
+}
+
 // PromoteReplica is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) PromoteReplica(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
 	if fake.PromoteReplicaResults == nil {
@@ -356,6 +457,23 @@ func (fake *TabletManagerClient) PromoteReplica(ctx context.Context, tablet *top
 	return "", assert.AnError
 }
 
+// RefreshState is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
+	if fake.RefreshStateResults == nil {
+		return assert.AnError
+	}
+
+	if tablet.Alias == nil {
+		return assert.AnError
+	}
+
+	if err, ok := fake.RefreshStateResults[topoproto.TabletAliasString(tablet.Alias)]; ok {
+		return err
+	}
+
+	return assert.AnError
+}
+
 // ReplicationStatus is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) ReplicationStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
 	if fake.ReplicationStatusResults == nil {
@@ -371,6 +489,20 @@ func (fake *TabletManagerClient) ReplicationStatus(ctx context.Context, tablet *
 	return nil, assert.AnError
 }
 
+// RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTimestamp time.Time) (logutil.EventStream, error) {
+	if fake.RestoreFromBackupResults == nil {
+		return nil, assert.AnError
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.RestoreFromBackupResults[key]; ok {
+		return &eventStream{events: result.Events, err: result.Error}, nil
+	}
+
+	return nil, fmt.Errorf("%w: no RestoreFromBackup result set for tablet %s", assert.AnError, key)
+}
+
 // SetMaster is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) SetMaster(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, timeCreatedNS int64, waitPosition string, forceStartReplication bool) error {
 	if fake.SetMasterResults == nil {
@@ -458,6 +590,27 @@ func (fake *TabletManagerClient) StopReplicationAndGetStatus(ctx context.Context
 	return nil, nil, assert.AnError
 }
 
+// VReplicationExec is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	if fake.VReplicationExecResults == nil {
+		return nil, assert.AnError
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+
+	tabletResultsByQuery, ok := fake.VReplicationExecResults[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: no VReplicationExec results for %s", assert.AnError, key)
+	}
+
+	result, ok := tabletResultsByQuery[query]
+	if !ok {
+		return nil, fmt.Errorf("%w: no VReplicationExec result for %s on %s", assert.AnError, query, key)
+	}
+
+	return result.Result, result.Error
+}
+
 // WaitForPosition is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) WaitForPosition(ctx context.Context, tablet *topodatapb.Tablet, position string) error {
 	tabletKey := topoproto.TabletAliasString(tablet.Alias)
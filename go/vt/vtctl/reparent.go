@@ -24,6 +24,7 @@ import (
 
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	"vitess.io/vitess/go/vt/mysqlctl"
@@ -50,8 +51,8 @@ func init() {
 	addCommand("Shards", command{
 		"EmergencyReparentShard",
 		commandEmergencyReparentShard,
-		"-keyspace_shard=<keyspace/shard> [-new_master=<tablet alias>] [-wait_replicas_timeout=<duration>] [-ignore_replicas=<tablet alias list>]",
-		"Reparents the shard to the new master. Assumes the old master is dead and not responding."})
+		"-keyspace_shard=<keyspace/shard> [-new_master=<tablet alias>] [-wait_replicas_timeout=<duration>] [-ignore_replicas=<tablet alias list>] [-max_replication_lag=<duration>] [-dry_run]",
+		"Reparents the shard to the new master. Assumes the old master is dead and not responding. Candidates with errant GTIDs, lagging candidates and candidates the durability policy forbids are excluded; replicas in the cell of the old master are preferred. With -dry_run, only prints which tablet would be promoted and why."})
 	addCommand("Shards", command{
 		"TabletExternallyReparented",
 		commandTabletExternallyReparented,
@@ -154,6 +155,8 @@ func commandEmergencyReparentShard(ctx context.Context, wr *wrangler.Wrangler, s
 	keyspaceShard := subFlags.String("keyspace_shard", "", "keyspace/shard of the shard that needs to be reparented")
 	newMaster := subFlags.String("new_master", "", "optional alias of a tablet that should be the new master. If not specified, Vitess will select the best candidate")
 	ignoreReplicasList := subFlags.String("ignore_replicas", "", "comma-separated list of replica tablet aliases to ignore during emergency reparent")
+	maxReplicationLag := subFlags.Duration("max_replication_lag", 0, "exclude candidates that were lagging by more than this. Candidates whose lag is unknown are not excluded. 0 means no limit")
+	dryRun := subFlags.Bool("dry_run", false, "only print which tablet would be promoted and why, without stopping replication or changing any tablet")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -180,7 +183,13 @@ func commandEmergencyReparentShard(ctx context.Context, wr *wrangler.Wrangler, s
 		}
 	}
 	unreachableReplicas := topoproto.ParseTabletSet(*ignoreReplicasList)
-	return wr.EmergencyReparentShard(ctx, keyspace, shard, tabletAlias, *waitReplicasTimeout, unreachableReplicas)
+	return wr.EmergencyReparentShard(ctx, keyspace, shard, reparentutil.EmergencyReparentOptions{
+		NewPrimaryAlias:     tabletAlias,
+		WaitReplicasTimeout: *waitReplicasTimeout,
+		IgnoreReplicas:      unreachableReplicas,
+		MaxReplicationLag:   *maxReplicationLag,
+		DryRun:              *dryRun,
+	})
 }
 
 func commandTabletExternallyReparented(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
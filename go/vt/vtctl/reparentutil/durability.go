@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reparentutil
+
+import (
+	"context"
+	"flag"
+	"sort"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil/promotionrule"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	// defaultDurabilityPolicy is the durability policy used for keyspaces
+	// that do not specify one in the topo. vtorc uses it too, unless its
+	// configuration sets a Durability, so the reparenting tools and vtorc
+	// agree on the policy of every shard.
+	defaultDurabilityPolicy = flag.String("durability_policy", "none", "the durability policy used to reparent the shards of keyspaces that don't specify one in the topo. Used by PlannedReparentShard, EmergencyReparentShard and vtorc, so it must be the same for vtctld and vtorc")
+
+	durabilityPolicies = make(map[string]NewDurabler)
+)
+
+func init() {
+	RegisterDurability("none", func() Durabler { return &durabilityNone{} })
+	RegisterDurability("semi_sync", func() Durabler { return &durabilitySemiSync{} })
+	RegisterDurability("cross_cell", func() Durabler { return &durabilityCrossCell{} })
+}
+
+// Durabler is the interface implemented by durability policies. A durability
+// policy decides which tablets may be promoted to primary, which of them are
+// preferred, and how semi-sync replication is set up around a primary. Both
+// the reparenting tools in this package and vtorc make those decisions through
+// the policy of the keyspace, so they agree on who should be primary.
+type Durabler interface {
+	// PromotionRule returns the promotion rule of the tablet.
+	PromotionRule(tablet *topodatapb.Tablet) promotionrule.CandidatePromotionRule
+	// SemiSyncAckers returns the number of semi-sync acks the primary
+	// requires. Zero means the primary does not use semi-sync.
+	SemiSyncAckers(primary *topodatapb.Tablet) int
+	// IsReplicaSemiSync returns true if the replica should send semi-sync
+	// acks to the primary.
+	IsReplicaSemiSync(primary, replica *topodatapb.Tablet) bool
+}
+
+// NewDurabler is a function that creates a new Durabler.
+type NewDurabler func() Durabler
+
+// RegisterDurability registers a durability policy under the given name.
+// Policies are normally registered from an init function, in the same way as
+// topo implementations or backup engines.
+func RegisterDurability(name string, newDurablerFunc NewDurabler) {
+	if durabilityPolicies[name] != nil {
+		log.Fatalf("durability policy %v already registered", name)
+	}
+	durabilityPolicies[name] = newDurablerFunc
+}
+
+// DefaultDurabilityPolicy returns the name of the durability policy used for
+// keyspaces that do not specify one in the topo.
+func DefaultDurabilityPolicy() string {
+	return *defaultDurabilityPolicy
+}
+
+// GetDurabilityPolicy returns a new instance of the durability policy
+// registered under the given name. An empty name returns the
+// DefaultDurabilityPolicy.
+func GetDurabilityPolicy(name string) (Durabler, error) {
+	if name == "" {
+		name = DefaultDurabilityPolicy()
+	}
+
+	newDurabler, ok := durabilityPolicies[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "durability policy %v not found", name)
+	}
+
+	return newDurabler(), nil
+}
+
+// GetDurabilityPolicyNames returns the sorted names of all registered
+// durability policies.
+func GetDurabilityPolicyNames() []string {
+	names := make([]string, 0, len(durabilityPolicies))
+	for name := range durabilityPolicies {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// GetKeyspaceDurability returns the durability policy configured for the
+// keyspace in the topo, or defaultPolicy if the keyspace does not specify
+// one.
+func GetKeyspaceDurability(ctx context.Context, ts *topo.Server, keyspace string, defaultPolicy string) (Durabler, error) {
+	ki, err := ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	name := ki.DurabilityPolicy
+	if name == "" {
+		name = defaultPolicy
+	}
+
+	return GetDurabilityPolicy(name)
+}
+
+// SemiSyncAckersAvailable returns true if enough of the given tablets would
+// send semi-sync acks to the primary to satisfy the durability policy. The
+// primary itself may be included in tablets; it is never counted.
+func SemiSyncAckersAvailable(durability Durabler, primary *topodatapb.Tablet, tablets []*topodatapb.Tablet) bool {
+	required := durability.SemiSyncAckers(primary)
+	if required == 0 {
+		return true
+	}
+
+	ackers := 0
+	for _, tablet := range tablets {
+		if topoproto.TabletAliasEqual(tablet.Alias, primary.Alias) {
+			continue
+		}
+
+		if durability.IsReplicaSemiSync(primary, tablet) {
+			ackers++
+		}
+	}
+
+	return ackers >= required
+}
+
+// filterPromotableCandidates returns the candidates the durability policy
+// allows to be promoted and that have enough potential semi-sync ackers among
+// tablets, restricted to those with the best promotion rule.
+func filterPromotableCandidates(durability Durabler, candidates []*topodatapb.Tablet, tablets []*topodatapb.Tablet) []*topodatapb.Tablet {
+	var (
+		best     []*topodatapb.Tablet
+		bestRule = promotionrule.MustNotPromoteRule
+	)
+
+	for _, candidate := range candidates {
+		rule := durability.PromotionRule(candidate)
+		if rule == promotionrule.MustNotPromoteRule {
+			continue
+		}
+
+		if !SemiSyncAckersAvailable(durability, candidate, tablets) {
+			continue
+		}
+
+		switch {
+		case rule.BetterThan(bestRule):
+			bestRule = rule
+			best = []*topodatapb.Tablet{candidate}
+		case rule == bestRule:
+			best = append(best, candidate)
+		}
+	}
+
+	return best
+}
+
+//=======================================================================
+
+// durabilityNone has no semi-sync and allows any primary or replica to be
+// promoted.
+type durabilityNone struct{}
+
+// PromotionRule is part of the Durabler interface.
+func (d *durabilityNone) PromotionRule(tablet *topodatapb.Tablet) promotionrule.CandidatePromotionRule {
+	switch tablet.Type {
+	case topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA:
+		return promotionrule.NeutralPromoteRule
+	}
+	return promotionrule.MustNotPromoteRule
+}
+
+// SemiSyncAckers is part of the Durabler interface.
+func (d *durabilityNone) SemiSyncAckers(primary *topodatapb.Tablet) int {
+	return 0
+}
+
+// IsReplicaSemiSync is part of the Durabler interface.
+func (d *durabilityNone) IsReplicaSemiSync(primary, replica *topodatapb.Tablet) bool {
+	return false
+}
+
+//=======================================================================
+
+// durabilitySemiSync requires one semi-sync ack from any primary or replica.
+type durabilitySemiSync struct{}
+
+// PromotionRule is part of the Durabler interface.
+func (d *durabilitySemiSync) PromotionRule(tablet *topodatapb.Tablet) promotionrule.CandidatePromotionRule {
+	switch tablet.Type {
+	case topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA:
+		return promotionrule.NeutralPromoteRule
+	}
+	return promotionrule.MustNotPromoteRule
+}
+
+// SemiSyncAckers is part of the Durabler interface.
+func (d *durabilitySemiSync) SemiSyncAckers(primary *topodatapb.Tablet) int {
+	return 1
+}
+
+// IsReplicaSemiSync is part of the Durabler interface.
+func (d *durabilitySemiSync) IsReplicaSemiSync(primary, replica *topodatapb.Tablet) bool {
+	switch replica.Type {
+	case topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA:
+		return true
+	}
+	return false
+}
+
+//=======================================================================
+
+// durabilityCrossCell requires one semi-sync ack from a primary or replica in
+// a different cell than the primary.
+type durabilityCrossCell struct{}
+
+// PromotionRule is part of the Durabler interface.
+func (d *durabilityCrossCell) PromotionRule(tablet *topodatapb.Tablet) promotionrule.CandidatePromotionRule {
+	switch tablet.Type {
+	case topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA:
+		return promotionrule.NeutralPromoteRule
+	}
+	return promotionrule.MustNotPromoteRule
+}
+
+// SemiSyncAckers is part of the Durabler interface.
+func (d *durabilityCrossCell) SemiSyncAckers(primary *topodatapb.Tablet) int {
+	return 1
+}
+
+// IsReplicaSemiSync is part of the Durabler interface.
+func (d *durabilityCrossCell) IsReplicaSemiSync(primary, replica *topodatapb.Tablet) bool {
+	// Prevent panics.
+	if primary.Alias == nil || replica.Alias == nil {
+		return false
+	}
+	switch replica.Type {
+	case topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA:
+		return primary.Alias.Cell != replica.Alias.Cell
+	}
+	return false
+}
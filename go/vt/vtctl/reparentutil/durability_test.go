@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reparentutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil/promotionrule"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// durabilityPreferZone2 is a custom policy for the tests. It prefers primaries
+// in zone2, and requires a semi-sync ack from a replica in another cell.
+type durabilityPreferZone2 struct {
+	durabilityCrossCell
+}
+
+func (d *durabilityPreferZone2) PromotionRule(tablet *topodatapb.Tablet) promotionrule.CandidatePromotionRule {
+	rule := d.durabilityCrossCell.PromotionRule(tablet)
+	if rule != promotionrule.MustNotPromoteRule && tablet.Alias.Cell == "zone2" {
+		return promotionrule.PreferPromoteRule
+	}
+
+	return rule
+}
+
+func init() {
+	RegisterDurability("test_prefer_zone2", func() Durabler { return &durabilityPreferZone2{} })
+}
+
+func newTestTablet(cell string, uid uint32, tabletType topodatapb.TabletType) *topodatapb.Tablet {
+	return &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{
+			Cell: cell,
+			Uid:  uid,
+		},
+		Type: tabletType,
+	}
+}
+
+func TestGetDurabilityPolicy(t *testing.T) {
+	t.Parallel()
+
+	d, err := GetDurabilityPolicy("")
+	require.NoError(t, err)
+	assert.IsType(t, &durabilityNone{}, d)
+
+	d, err = GetDurabilityPolicy("cross_cell")
+	require.NoError(t, err)
+	assert.IsType(t, &durabilityCrossCell{}, d)
+
+	d, err = GetDurabilityPolicy("test_prefer_zone2")
+	require.NoError(t, err)
+	assert.IsType(t, &durabilityPreferZone2{}, d)
+
+	_, err = GetDurabilityPolicy("unknown")
+	assert.Error(t, err)
+
+	assert.Equal(t, []string{"cross_cell", "none", "semi_sync", "test_prefer_zone2"}, GetDurabilityPolicyNames())
+}
+
+func TestGetKeyspaceDurability(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+
+	require.NoError(t, ts.CreateKeyspace(ctx, "unset", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateKeyspace(ctx, "semisync", &topodatapb.Keyspace{DurabilityPolicy: "semi_sync"}))
+	require.NoError(t, ts.CreateKeyspace(ctx, "bad", &topodatapb.Keyspace{DurabilityPolicy: "unknown"}))
+
+	d, err := GetKeyspaceDurability(ctx, ts, "unset", "cross_cell")
+	require.NoError(t, err)
+	assert.IsType(t, &durabilityCrossCell{}, d)
+
+	d, err = GetKeyspaceDurability(ctx, ts, "semisync", "cross_cell")
+	require.NoError(t, err)
+	assert.IsType(t, &durabilitySemiSync{}, d)
+
+	_, err = GetKeyspaceDurability(ctx, ts, "bad", DefaultDurabilityPolicy())
+	assert.Error(t, err)
+
+	_, err = GetKeyspaceDurability(ctx, ts, "missing", DefaultDurabilityPolicy())
+	assert.Error(t, err)
+}
+
+func TestSemiSyncAckersAvailable(t *testing.T) {
+	t.Parallel()
+
+	primary := newTestTablet("zone1", 100, topodatapb.TabletType_MASTER)
+	sameCellReplica := newTestTablet("zone1", 101, topodatapb.TabletType_REPLICA)
+	otherCellReplica := newTestTablet("zone2", 200, topodatapb.TabletType_REPLICA)
+	otherCellRdonly := newTestTablet("zone2", 201, topodatapb.TabletType_RDONLY)
+
+	tests := []struct {
+		name     string
+		policy   string
+		tablets  []*topodatapb.Tablet
+		expected bool
+	}{
+		{
+			name:     "none needs no ackers",
+			policy:   "none",
+			tablets:  []*topodatapb.Tablet{primary},
+			expected: true,
+		},
+		{
+			name:     "semi_sync does not count the primary itself",
+			policy:   "semi_sync",
+			tablets:  []*topodatapb.Tablet{primary},
+			expected: false,
+		},
+		{
+			name:     "semi_sync with a replica",
+			policy:   "semi_sync",
+			tablets:  []*topodatapb.Tablet{primary, sameCellReplica},
+			expected: true,
+		},
+		{
+			name:     "cross_cell with only same cell replicas",
+			policy:   "cross_cell",
+			tablets:  []*topodatapb.Tablet{primary, sameCellReplica, otherCellRdonly},
+			expected: false,
+		},
+		{
+			name:     "cross_cell with a replica in another cell",
+			policy:   "cross_cell",
+			tablets:  []*topodatapb.Tablet{primary, sameCellReplica, otherCellReplica},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := GetDurabilityPolicy(tt.policy)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, SemiSyncAckersAvailable(d, primary, tt.tablets))
+		})
+	}
+}
+
+func TestFilterPromotableCandidates(t *testing.T) {
+	t.Parallel()
+
+	zone1Replica := newTestTablet("zone1", 100, topodatapb.TabletType_REPLICA)
+	zone1Rdonly := newTestTablet("zone1", 101, topodatapb.TabletType_RDONLY)
+	zone2Replica := newTestTablet("zone2", 200, topodatapb.TabletType_REPLICA)
+	zone3Replica := newTestTablet("zone3", 300, topodatapb.TabletType_REPLICA)
+
+	tests := []struct {
+		name       string
+		policy     string
+		candidates []*topodatapb.Tablet
+		tablets    []*topodatapb.Tablet
+		expected   []string
+	}{
+		{
+			name:       "must not promote rdonly",
+			policy:     "none",
+			candidates: []*topodatapb.Tablet{zone1Replica, zone1Rdonly},
+			tablets:    []*topodatapb.Tablet{zone1Replica, zone1Rdonly},
+			expected:   []string{"zone1-0000000100"},
+		},
+		{
+			name:       "cross_cell needs an acker in another cell",
+			policy:     "cross_cell",
+			candidates: []*topodatapb.Tablet{zone1Replica, zone1Rdonly},
+			tablets:    []*topodatapb.Tablet{zone1Replica, zone1Rdonly},
+			expected:   nil,
+		},
+		{
+			name:       "custom policy prefers zone2",
+			policy:     "test_prefer_zone2",
+			candidates: []*topodatapb.Tablet{zone1Replica, zone2Replica, zone3Replica},
+			tablets:    []*topodatapb.Tablet{zone1Replica, zone2Replica, zone3Replica},
+			expected:   []string{"zone2-0000000200"},
+		},
+		{
+			name:       "custom policy falls back to neutral candidates",
+			policy:     "test_prefer_zone2",
+			candidates: []*topodatapb.Tablet{zone1Replica, zone3Replica},
+			tablets:    []*topodatapb.Tablet{zone1Replica, zone3Replica},
+			expected:   []string{"zone1-0000000100", "zone3-0000000300"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := GetDurabilityPolicy(tt.policy)
+			require.NoError(t, err)
+
+			var actual []string
+			for _, tablet := range filterPromotableCandidates(d, tt.candidates, tt.tablets) {
+				actual = append(actual, topoproto.TabletAliasString(tablet.Alias))
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
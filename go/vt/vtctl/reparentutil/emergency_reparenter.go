@@ -19,6 +19,7 @@ package reparentutil
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -55,6 +56,15 @@ type EmergencyReparentOptions struct {
 	IgnoreReplicas      sets.String
 	WaitReplicasTimeout time.Duration
 
+	// MaxReplicationLag excludes candidates that were lagging by more than
+	// this when their status was read. Replicas whose lag is unknown are not
+	// excluded. Zero means no limit.
+	MaxReplicationLag time.Duration
+	// DryRun reads the replication status of the tablets and reports which
+	// tablet would be promoted, without stopping replication or changing any
+	// tablet.
+	DryRun bool
+
 	// Private options managed internally. We use value passing to avoid leaking
 	// these details back out.
 
@@ -277,7 +287,12 @@ func (erp *EmergencyReparenter) reparentShardLocked(ctx context.Context, ev *eve
 		return vterrors.Wrapf(err, "failed to get tablet map for %v/%v: %v", keyspace, shard, err)
 	}
 
-	statusMap, primaryStatusMap, err := StopReplicationAndBuildStatusMaps(ctx, erp.tmc, ev, tabletMap, opts.WaitReplicasTimeout, opts.IgnoreReplicas, erp.logger)
+	buildStatusMaps := StopReplicationAndBuildStatusMaps
+	if opts.DryRun {
+		buildStatusMaps = BuildStatusMaps
+	}
+
+	statusMap, primaryStatusMap, err := buildStatusMaps(ctx, erp.tmc, ev, tabletMap, opts.WaitReplicasTimeout, opts.IgnoreReplicas, erp.logger)
 	if err != nil {
 		return vterrors.Wrapf(err, "failed to stop replication and build status maps: %v", err)
 	}
@@ -286,58 +301,206 @@ func (erp *EmergencyReparenter) reparentShardLocked(ctx context.Context, ev *eve
 		return vterrors.Wrapf(err, "lost topology lock, aborting: %v", err)
 	}
 
-	validCandidates, err := FindValidEmergencyReparentCandidates(statusMap, primaryStatusMap)
+	validCandidates, errantGTIDs, err := findValidEmergencyReparentCandidates(statusMap, primaryStatusMap)
 	if err != nil {
 		return err
-	} else if len(validCandidates) == 0 {
+	}
+
+	for alias, gtids := range errantGTIDs {
+		erp.logger.Warningf("excluding %v from the candidates: errant GTIDs %v", alias, gtids)
+	}
+
+	if len(validCandidates) == 0 {
 		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no valid candidates for emergency reparent")
 	}
 
-	// Wait for all candidates to apply relay logs
-	if err := erp.waitForAllRelayLogsToApply(ctx, validCandidates, tabletMap, statusMap, opts); err != nil {
+	// Wait for all candidates to apply relay logs. There is nothing to wait
+	// for in a dry run, since replication was not stopped.
+	if !opts.DryRun {
+		if err := erp.waitForAllRelayLogsToApply(ctx, validCandidates, tabletMap, statusMap, opts); err != nil {
+			return err
+		}
+	}
+
+	durability, err := GetKeyspaceDurability(ctx, erp.ts, keyspace, DefaultDurabilityPolicy())
+	if err != nil {
+		return err
+	}
+
+	var primaryCell string
+	if shardInfo.MasterAlias != nil {
+		primaryCell = shardInfo.MasterAlias.Cell
+	}
+
+	winningPrimaryTabletAliasStr, err := erp.chooseNewPrimary(durability, primaryCell, tabletMap, statusMap, primaryStatusMap, validCandidates, errantGTIDs, opts)
+	if err != nil {
 		return err
 	}
 
-	// Elect the candidate with the most up-to-date position.
-	var (
-		winningPosition              mysql.Position
-		winningPrimaryTabletAliasStr string
-	)
+	if opts.DryRun {
+		erp.logger.Infof("dry run: would promote %v to master", winningPrimaryTabletAliasStr)
+		ev.NewMaster = *tabletMap[winningPrimaryTabletAliasStr].Tablet
 
-	for alias, position := range validCandidates {
+		return nil
+	}
+
+	// Check (again) we still have the topology lock.
+	if err := topo.CheckShardLocked(ctx, keyspace, shard); err != nil {
+		return vterrors.Wrapf(err, "lost topology lock, aborting: %v", err)
+	}
+
+	// Do the promotion.
+	if err := erp.promoteNewPrimary(ctx, ev, keyspace, shard, winningPrimaryTabletAliasStr, tabletMap, statusMap, opts); err != nil {
+		return err
+	}
+
+	ev.NewMaster = *tabletMap[winningPrimaryTabletAliasStr].Tablet
+
+	return nil
+}
+
+// chooseNewPrimary elects the candidate to promote among the valid candidates,
+// logging why every other tablet was not chosen. The candidate must be at the
+// most up-to-date position, so that no transactions are lost, must not lag by
+// more than opts.MaxReplicationLag, and must be promotable under the
+// durability policy. Among those, the candidates with the best promotion rule
+// are preferred, then the ones in the cell of the failed primary, then the
+// REPLICA tablets.
+func (erp *EmergencyReparenter) chooseNewPrimary(
+	durability Durabler,
+	primaryCell string,
+	tabletMap map[string]*topo.TabletInfo,
+	statusMap map[string]*replicationdatapb.StopReplicationStatus,
+	primaryStatusMap map[string]*replicationdatapb.MasterStatus,
+	validCandidates map[string]mysql.Position,
+	errantGTIDs map[string]mysql.Mysql56GTIDSet,
+	opts EmergencyReparentOptions,
+) (string, error) {
+	// Find the most up-to-date position among all the candidates.
+	var winningPosition mysql.Position
+
+	for _, position := range validCandidates {
 		if winningPosition.IsZero() || position.AtLeast(winningPosition) {
 			winningPosition = position
-			winningPrimaryTabletAliasStr = alias
 		}
 	}
 
+	// Only reachable tablets can send semi-sync acks to the new primary.
+	reachableTablets := make([]*topodatapb.Tablet, 0, len(statusMap)+len(primaryStatusMap))
+	for alias, ti := range tabletMap {
+		_, isReplica := statusMap[alias]
+		_, isPrimary := primaryStatusMap[alias]
+
+		if isReplica || isPrimary {
+			reachableTablets = append(reachableTablets, ti.Tablet)
+		}
+	}
+
+	// Tablets reporting as primaries have no replication lag, and replicas
+	// whose lag is unknown are not excluded: the position checks already make
+	// sure they don't miss any transaction.
+	isLagging := func(alias string) bool {
+		status, ok := statusMap[alias]
+		if !ok || opts.MaxReplicationLag == 0 {
+			return false
+		}
+
+		lag, ok := replicationLag(status.Before)
+
+		return ok && lag > opts.MaxReplicationLag
+	}
+
+	lagOf := func(alias string) time.Duration {
+		lag, _ := replicationLag(statusMap[alias].Before)
+		return lag
+	}
+
 	// If we were requested to elect a particular primary, verify it's a valid
-	// candidate (non-zero position, no errant GTIDs) and is at least as
-	// advanced as the winning position.
+	// candidate (non-zero position, no errant GTIDs), is at least as advanced
+	// as the winning position, is not lagging, and can be promoted under the
+	// durability policy.
 	if opts.NewPrimaryAlias != nil {
-		winningPrimaryTabletAliasStr = topoproto.TabletAliasString(opts.NewPrimaryAlias)
-		pos, ok := validCandidates[winningPrimaryTabletAliasStr]
+		alias := topoproto.TabletAliasString(opts.NewPrimaryAlias)
+		pos, ok := validCandidates[alias]
 		switch {
+		case !ok && errantGTIDs[alias] != nil:
+			return "", vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "master elect %v has errant GTIDs %v", alias, errantGTIDs[alias])
 		case !ok:
-			return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "master elect %v has errant GTIDs", winningPrimaryTabletAliasStr)
+			return "", vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "master elect %v has errant GTIDs", alias)
 		case !pos.AtLeast(winningPosition):
-			return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "master elect %v at position %v is not fully caught up. Winning position: %v", winningPrimaryTabletAliasStr, pos, winningPosition)
+			return "", vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "master elect %v at position %v is not fully caught up. Winning position: %v", alias, pos, winningPosition)
+		case isLagging(alias):
+			return "", vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "master elect %v was lagging by %v, more than %v", alias, lagOf(alias), opts.MaxReplicationLag)
+		}
+
+		promotable := filterPromotableCandidates(durability, []*topodatapb.Tablet{tabletMap[alias].Tablet}, reachableTablets)
+		if len(promotable) == 0 {
+			return "", vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "master elect %v cannot be promoted under the durability policy", alias)
 		}
+
+		erp.logger.Infof("elected requested master %v at position %v", alias, pos)
+
+		return alias, nil
 	}
 
-	// Check (again) we still have the topology lock.
-	if err := topo.CheckShardLocked(ctx, keyspace, shard); err != nil {
-		return vterrors.Wrapf(err, "lost topology lock, aborting: %v", err)
+	candidates := make([]*topodatapb.Tablet, 0, len(validCandidates))
+	for alias, position := range validCandidates {
+		switch {
+		case !position.AtLeast(winningPosition):
+			erp.logger.Infof("excluding %v from the candidates: position %v is behind the winning position %v", alias, position, winningPosition)
+		case isLagging(alias):
+			erp.logger.Infof("excluding %v from the candidates: was lagging by %v, more than %v", alias, lagOf(alias), opts.MaxReplicationLag)
+		default:
+			candidates = append(candidates, tabletMap[alias].Tablet)
+		}
 	}
 
-	// Do the promotion.
-	if err := erp.promoteNewPrimary(ctx, ev, keyspace, shard, winningPrimaryTabletAliasStr, tabletMap, statusMap, opts); err != nil {
-		return err
+	promotable := filterPromotableCandidates(durability, candidates, reachableTablets)
+	if len(promotable) == 0 {
+		return "", vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no candidate at the most advanced position %v can be promoted under the durability policy", winningPosition)
 	}
 
-	ev.NewMaster = *tabletMap[winningPrimaryTabletAliasStr].Tablet
+	if len(promotable) < len(candidates) {
+		for _, candidate := range candidates {
+			if !containsTablet(promotable, candidate) {
+				erp.logger.Infof("excluding %v from the candidates: not preferred by the durability policy (promotion rule %v)", topoproto.TabletAliasString(candidate.Alias), durability.PromotionRule(candidate))
+			}
+		}
+	}
 
-	return nil
+	sort.SliceStable(promotable, func(i, j int) bool {
+		a, b := promotable[i], promotable[j]
+
+		if sameCellA, sameCellB := a.Alias.Cell == primaryCell, b.Alias.Cell == primaryCell; sameCellA != sameCellB {
+			return sameCellA
+		}
+
+		if replicaA, replicaB := a.Type == topodatapb.TabletType_REPLICA, b.Type == topodatapb.TabletType_REPLICA; replicaA != replicaB {
+			return replicaA
+		}
+
+		return topoproto.TabletAliasString(a.Alias) < topoproto.TabletAliasString(b.Alias)
+	})
+
+	for i, candidate := range promotable {
+		alias := topoproto.TabletAliasString(candidate.Alias)
+		erp.logger.Infof("candidate %d: %v (cell %v, type %v, promotion rule %v) at position %v", i+1, alias, candidate.Alias.Cell, candidate.Type, durability.PromotionRule(candidate), validCandidates[alias])
+	}
+
+	winner := topoproto.TabletAliasString(promotable[0].Alias)
+	erp.logger.Infof("elected %v as the new master", winner)
+
+	return winner, nil
+}
+
+func containsTablet(tablets []*topodatapb.Tablet, tablet *topodatapb.Tablet) bool {
+	for _, t := range tablets {
+		if topoproto.TabletAliasEqual(t.Alias, tablet.Alias) {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (erp *EmergencyReparenter) waitForAllRelayLogsToApply(
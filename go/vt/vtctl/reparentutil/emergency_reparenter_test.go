@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools/events"
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver/testutil"
 
@@ -203,6 +204,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -211,6 +213,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -219,6 +222,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 					Hostname: "most up-to-date position, wins election",
 				},
 			},
@@ -308,6 +312,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -316,6 +321,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -324,6 +330,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 			},
 			keyspace: "testkeyspace",
@@ -425,6 +432,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -433,6 +441,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 					Hostname: "most up-to-date position, wins election",
 				},
 			},
@@ -487,6 +496,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -495,6 +505,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -503,6 +514,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 			},
 			keyspace:  "testkeyspace",
@@ -545,6 +557,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -553,6 +566,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -561,6 +575,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 			},
 			keyspace:  "testkeyspace",
@@ -614,6 +629,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -622,6 +638,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -630,6 +647,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 					Hostname: "has a zero relay log position",
 				},
 			},
@@ -716,6 +734,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -724,6 +743,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 					Hostname: "slow to apply relay logs",
 				},
 				{
@@ -733,6 +753,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 					Hostname: "fails to apply relay logs",
 				},
 			},
@@ -803,6 +824,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -811,6 +833,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -819,6 +842,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 			},
 			keyspace: "testkeyspace",
@@ -891,6 +915,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -899,6 +924,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -907,6 +933,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 					Hostname: "not most up-to-date position",
 				},
 			},
@@ -988,6 +1015,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -996,6 +1024,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 				},
 				{
 					Alias: &topodatapb.TabletAlias{
@@ -1004,6 +1033,7 @@ func TestEmergencyReparenter_reparentShardLocked(t *testing.T) {
 					},
 					Keyspace: "testkeyspace",
 					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
 					Hostname: "not most up-to-date position",
 				},
 			},
@@ -1750,3 +1780,309 @@ func TestEmergencyReparenter_waitForAllRelayLogsToApply(t *testing.T) {
 		})
 	}
 }
+
+func TestEmergencyReparenter_chooseNewPrimary(t *testing.T) {
+	t.Parallel()
+
+	tablet := func(cell string, uid uint32, tabletType topodatapb.TabletType) *topo.TabletInfo {
+		return &topo.TabletInfo{
+			Tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: cell,
+					Uid:  uid,
+				},
+				Type: tabletType,
+			},
+		}
+	}
+
+	position := func(s string) mysql.Position {
+		pos, err := mysql.DecodePosition(s)
+		require.NoError(t, err)
+
+		return pos
+	}
+
+	status := func(lag uint32) *replicationdatapb.StopReplicationStatus {
+		return &replicationdatapb.StopReplicationStatus{
+			Before: &replicationdatapb.Status{
+				SecondsBehindMaster: lag,
+			},
+		}
+	}
+
+	unknownLag := &replicationdatapb.StopReplicationStatus{
+		Before: &replicationdatapb.Status{
+			ReplicationLagUnknown: true,
+		},
+	}
+
+	tabletMap := map[string]*topo.TabletInfo{
+		"zone1-0000000100": tablet("zone1", 100, topodatapb.TabletType_REPLICA),
+		"zone1-0000000101": tablet("zone1", 101, topodatapb.TabletType_RDONLY),
+		"zone1-0000000102": tablet("zone1", 102, topodatapb.TabletType_REPLICA),
+		"zone1-0000000103": tablet("zone1", 103, topodatapb.TabletType_REPLICA),
+		"zone2-0000000200": tablet("zone2", 200, topodatapb.TabletType_REPLICA),
+		"zone2-0000000201": tablet("zone2", 201, topodatapb.TabletType_REPLICA),
+		"zone2-0000000202": tablet("zone2", 202, topodatapb.TabletType_REPLICA),
+	}
+	statusMap := map[string]*replicationdatapb.StopReplicationStatus{
+		"zone1-0000000100": status(0),
+		"zone1-0000000101": status(0),
+		"zone1-0000000102": status(0),
+		"zone1-0000000103": status(0),
+		"zone2-0000000200": status(600),
+		"zone2-0000000201": status(0),
+		"zone2-0000000202": unknownLag,
+	}
+	validCandidates := map[string]mysql.Position{
+		"zone1-0000000100": position("MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-26"),
+		"zone1-0000000101": position("MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-26"),
+		"zone1-0000000102": position("MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-20"),
+		"zone2-0000000200": position("MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-26"),
+		"zone2-0000000201": position("MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-26"),
+		"zone2-0000000202": position("MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-26"),
+	}
+	errantGTIDs := map[string]mysql.Mysql56GTIDSet{}
+	errantPos := position("MySQL56/AAAAAAAA-71CA-11E1-9E33-C80AA9429562:1")
+	errantGTIDs["zone1-0000000103"] = errantPos.GTIDSet.(mysql.Mysql56GTIDSet)
+
+	tests := []struct {
+		name        string
+		policy      string
+		primaryCell string
+		opts        EmergencyReparentOptions
+		candidates  []string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			name:        "prefers the cell of the failed primary",
+			policy:      "none",
+			primaryCell: "zone2",
+			expected:    "zone2-0000000200",
+		},
+		{
+			name:        "excludes lagging candidates",
+			policy:      "none",
+			primaryCell: "zone2",
+			opts: EmergencyReparentOptions{
+				MaxReplicationLag: time.Minute,
+			},
+			expected: "zone2-0000000201",
+		},
+		{
+			name:        "keeps candidates with unknown lag",
+			policy:      "none",
+			primaryCell: "zone2",
+			opts: EmergencyReparentOptions{
+				MaxReplicationLag: time.Minute,
+			},
+			candidates: []string{"zone1-0000000100", "zone2-0000000202"},
+			expected:   "zone2-0000000202",
+		},
+		{
+			name:        "excludes candidates the durability policy forbids",
+			policy:      "none",
+			primaryCell: "zone1",
+			expected:    "zone1-0000000100",
+		},
+		{
+			name:        "no promotable candidate at the winning position",
+			policy:      "none",
+			primaryCell: "zone1",
+			candidates:  []string{"zone1-0000000101", "zone1-0000000102"},
+			shouldErr:   true,
+		},
+		{
+			name:   "requested primary-elect",
+			policy: "none",
+			opts: EmergencyReparentOptions{
+				NewPrimaryAlias: &topodatapb.TabletAlias{
+					Cell: "zone2",
+					Uid:  201,
+				},
+			},
+			expected: "zone2-0000000201",
+		},
+		{
+			name:   "requested primary-elect forbidden by the durability policy",
+			policy: "none",
+			opts: EmergencyReparentOptions{
+				NewPrimaryAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  101,
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name:   "requested primary-elect without semi-sync ackers in another cell",
+			policy: "cross_cell",
+			opts: EmergencyReparentOptions{
+				NewPrimaryAlias: &topodatapb.TabletAlias{
+					Cell: "zone2",
+					Uid:  201,
+				},
+			},
+			candidates: []string{"zone2-0000000200", "zone2-0000000201"},
+			shouldErr:  true,
+		},
+		{
+			name:   "requested primary-elect has errant GTIDs",
+			policy: "none",
+			opts: EmergencyReparentOptions{
+				NewPrimaryAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  103,
+				},
+			},
+			shouldErr: true,
+		},
+		{
+			name:   "requested primary-elect is lagging",
+			policy: "none",
+			opts: EmergencyReparentOptions{
+				NewPrimaryAlias: &topodatapb.TabletAlias{
+					Cell: "zone2",
+					Uid:  200,
+				},
+				MaxReplicationLag: time.Minute,
+			},
+			shouldErr: true,
+		},
+		{
+			name:   "requested primary-elect has unknown lag",
+			policy: "none",
+			opts: EmergencyReparentOptions{
+				NewPrimaryAlias: &topodatapb.TabletAlias{
+					Cell: "zone2",
+					Uid:  202,
+				},
+				MaxReplicationLag: time.Minute,
+			},
+			expected: "zone2-0000000202",
+		},
+		{
+			name:   "requested primary-elect is behind",
+			policy: "none",
+			opts: EmergencyReparentOptions{
+				NewPrimaryAlias: &topodatapb.TabletAlias{
+					Cell: "zone1",
+					Uid:  102,
+				},
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			durability, err := GetDurabilityPolicy(tt.policy)
+			require.NoError(t, err)
+
+			// Restrict the shard to the given candidates, if any.
+			tablets := tabletMap
+			statuses := statusMap
+			candidates := validCandidates
+
+			if tt.candidates != nil {
+				tablets = map[string]*topo.TabletInfo{}
+				statuses = map[string]*replicationdatapb.StopReplicationStatus{}
+				candidates = map[string]mysql.Position{}
+
+				for _, alias := range tt.candidates {
+					tablets[alias] = tabletMap[alias]
+					statuses[alias] = statusMap[alias]
+					candidates[alias] = validCandidates[alias]
+				}
+			}
+
+			erp := NewEmergencyReparenter(nil, nil, logutil.NewMemoryLogger())
+
+			actual, err := erp.chooseNewPrimary(durability, tt.primaryCell, tablets, statuses, nil, candidates, errantGTIDs, tt.opts)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestEmergencyReparenter_reparentShardLockedDryRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	// No PromoteReplica, SetMaster or StopReplication results are set, so any
+	// attempt to change a tablet fails the reparent.
+	tmc := &testutil.TabletManagerClient{
+		ReplicationStatusResults: map[string]struct {
+			Position *replicationdatapb.Status
+			Error    error
+		}{
+			"zone1-0000000100": {
+				Position: &replicationdatapb.Status{
+					MasterUuid:       "3E11FA47-71CA-11E1-9E33-C80AA9429562",
+					RelayLogPosition: "MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-21",
+				},
+			},
+			"zone1-0000000101": {
+				Position: &replicationdatapb.Status{
+					MasterUuid:       "3E11FA47-71CA-11E1-9E33-C80AA9429562",
+					RelayLogPosition: "MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-26",
+				},
+			},
+		},
+	}
+
+	testutil.AddShards(ctx, t, ts, &vtctldatapb.Shard{
+		Keyspace: "testkeyspace",
+		Name:     "-",
+	})
+	testutil.AddTablets(ctx, t, ts, nil,
+		&topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  100,
+			},
+			Keyspace: "testkeyspace",
+			Shard:    "-",
+			Type:     topodatapb.TabletType_REPLICA,
+		},
+		&topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{
+				Cell: "zone1",
+				Uid:  101,
+			},
+			Keyspace: "testkeyspace",
+			Shard:    "-",
+			Type:     topodatapb.TabletType_REPLICA,
+		},
+	)
+
+	lctx, unlock, lerr := ts.LockShard(ctx, "testkeyspace", "-", "test lock")
+	require.NoError(t, lerr)
+
+	defer func() {
+		unlock(&lerr)
+		require.NoError(t, lerr)
+	}()
+
+	ev := &events.Reparent{}
+	erp := NewEmergencyReparenter(ts, tmc, logutil.NewMemoryLogger())
+
+	err := erp.reparentShardLocked(lctx, ev, "testkeyspace", "-", EmergencyReparentOptions{
+		WaitReplicasTimeout: time.Second,
+		DryRun:              true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "zone1-0000000101", topoproto.TabletAliasString(ev.NewMaster.Alias))
+}
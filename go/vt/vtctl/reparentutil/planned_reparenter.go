@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools/events"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil/promotionrule"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
@@ -139,6 +140,7 @@ func (pr *PlannedReparenter) preflightChecks(
 	keyspace string,
 	shard string,
 	tabletMap map[string]*topo.TabletInfo,
+	durability Durabler,
 	opts *PlannedReparentOptions, // we take a pointer here to set NewPrimaryAlias
 ) (isNoop bool, err error) {
 	if topoproto.TabletAliasEqual(opts.NewPrimaryAlias, opts.AvoidPrimaryAlias) {
@@ -153,7 +155,7 @@ func (pr *PlannedReparenter) preflightChecks(
 
 		event.DispatchUpdate(ev, "searching for primary candidate")
 
-		opts.NewPrimaryAlias, err = ChooseNewPrimary(ctx, pr.tmc, &ev.ShardInfo, tabletMap, opts.AvoidPrimaryAlias, opts.WaitReplicasTimeout, pr.logger)
+		opts.NewPrimaryAlias, err = ChooseNewPrimary(ctx, pr.tmc, &ev.ShardInfo, tabletMap, opts.AvoidPrimaryAlias, durability, opts.WaitReplicasTimeout, pr.logger)
 		if err != nil {
 			return true, err
 		}
@@ -173,6 +175,19 @@ func (pr *PlannedReparenter) preflightChecks(
 		return true, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "primary-elect tablet %v is not in the shard", primaryElectAliasStr)
 	}
 
+	if durability.PromotionRule(newPrimaryTabletInfo.Tablet) == promotionrule.MustNotPromoteRule {
+		return true, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "primary-elect tablet %v must not be promoted under the durability policy", primaryElectAliasStr)
+	}
+
+	tablets := make([]*topodatapb.Tablet, 0, len(tabletMap))
+	for _, tablet := range tabletMap {
+		tablets = append(tablets, tablet.Tablet)
+	}
+
+	if !SemiSyncAckersAvailable(durability, newPrimaryTabletInfo.Tablet, tablets) {
+		return true, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "primary-elect tablet %v does not have enough semi-sync ackers under the durability policy", primaryElectAliasStr)
+	}
+
 	ev.NewMaster = *newPrimaryTabletInfo.Tablet
 
 	if topoproto.TabletAliasIsZero(ev.ShardInfo.MasterAlias) {
@@ -479,8 +494,13 @@ func (pr *PlannedReparenter) reparentShardLocked(
 		return err
 	}
 
+	durability, err := GetKeyspaceDurability(ctx, pr.ts, keyspace, DefaultDurabilityPolicy())
+	if err != nil {
+		return err
+	}
+
 	// Check invariants that PlannedReparentShard depends on.
-	if isNoop, err := pr.preflightChecks(ctx, ev, keyspace, shard, tabletMap, &opts); err != nil {
+	if isNoop, err := pr.preflightChecks(ctx, ev, keyspace, shard, tabletMap, durability, &opts); err != nil {
 		return err
 	} else if isNoop {
 		return nil
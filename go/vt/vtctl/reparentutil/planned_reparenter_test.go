@@ -376,6 +376,7 @@ func TestPlannedReparenter_preflightChecks(t *testing.T) {
 							Cell: "zone1",
 							Uid:  100,
 						},
+						Type: topodatapb.TabletType_REPLICA,
 					},
 				},
 			},
@@ -398,6 +399,7 @@ func TestPlannedReparenter_preflightChecks(t *testing.T) {
 						Cell: "zone1",
 						Uid:  100,
 					},
+					Type: topodatapb.TabletType_REPLICA,
 				},
 			},
 			shouldErr: false,
@@ -590,6 +592,7 @@ func TestPlannedReparenter_preflightChecks(t *testing.T) {
 							Cell: "zone1",
 							Uid:  100,
 						},
+						Type: topodatapb.TabletType_REPLICA,
 					},
 				},
 			},
@@ -609,6 +612,7 @@ func TestPlannedReparenter_preflightChecks(t *testing.T) {
 						Cell: "zone1",
 						Uid:  100,
 					},
+					Type: topodatapb.TabletType_REPLICA,
 				},
 			},
 			shouldErr: true,
@@ -634,8 +638,11 @@ func TestPlannedReparenter_preflightChecks(t *testing.T) {
 				}
 			}()
 
+			durability, err := GetDurabilityPolicy(DefaultDurabilityPolicy())
+			require.NoError(t, err)
+
 			pr := NewPlannedReparenter(tt.ts, tt.tmc, logger)
-			isNoop, err := pr.preflightChecks(ctx, tt.ev, tt.keyspace, tt.shard, tt.tabletMap, tt.opts)
+			isNoop, err := pr.preflightChecks(ctx, tt.ev, tt.keyspace, tt.shard, tt.tabletMap, durability, tt.opts)
 			if tt.shouldErr {
 				assert.Error(t, err)
 				assert.Equal(t, tt.expectedIsNoop, isNoop, "preflightChecks returned wrong isNoop signal")
@@ -43,6 +43,18 @@ func FindValidEmergencyReparentCandidates(
 	statusMap map[string]*replicationdatapb.StopReplicationStatus,
 	primaryStatusMap map[string]*replicationdatapb.MasterStatus,
 ) (map[string]mysql.Position, error) {
+	positionMap, _, err := findValidEmergencyReparentCandidates(statusMap, primaryStatusMap)
+	return positionMap, err
+}
+
+// findValidEmergencyReparentCandidates is FindValidEmergencyReparentCandidates,
+// additionally returning the errant GTIDs of every tablet it excluded for
+// having them.
+func findValidEmergencyReparentCandidates(
+	statusMap map[string]*replicationdatapb.StopReplicationStatus,
+	primaryStatusMap map[string]*replicationdatapb.MasterStatus,
+) (map[string]mysql.Position, map[string]mysql.Mysql56GTIDSet, error) {
+	errantGTIDsMap := make(map[string]mysql.Mysql56GTIDSet)
 	replicationStatusMap := make(map[string]*mysql.ReplicationStatus, len(statusMap))
 	positionMap := make(map[string]mysql.Position)
 
@@ -76,11 +88,11 @@ func FindValidEmergencyReparentCandidates(
 	}
 
 	if isGTIDBased && emptyRelayPosErrorRecorder.HasErrors() {
-		return nil, emptyRelayPosErrorRecorder.Error()
+		return nil, nil, emptyRelayPosErrorRecorder.Error()
 	}
 
 	if isGTIDBased && isNonGTIDBased {
-		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "encountered mix of GTID-based and non GTID-based relay logs")
+		return nil, nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "encountered mix of GTID-based and non GTID-based relay logs")
 	}
 
 	// Create relevant position list of errant GTID-based positions for later
@@ -98,7 +110,7 @@ func FindValidEmergencyReparentCandidates(
 		// in the earlier loop, but let's be doubly sure.
 		relayLogGTIDSet, ok := status.RelayLogPosition.GTIDSet.(mysql.Mysql56GTIDSet)
 		if !ok {
-			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "we got a filled-in relay log position, but it's not of type Mysql56GTIDSet, even though we've determined we need to use GTID based assesment")
+			return nil, nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "we got a filled-in relay log position, but it's not of type Mysql56GTIDSet, even though we've determined we need to use GTID based assesment")
 		}
 
 		// We need to remove this alias's status from the list, otherwise the
@@ -116,10 +128,11 @@ func FindValidEmergencyReparentCandidates(
 		case err != nil:
 			// Could not look up GTIDs to determine if we have any. It's not
 			// safe to continue.
-			return nil, err
+			return nil, nil, err
 		case len(errantGTIDs) != 0:
 			// This tablet has errant GTIDs. It's not a valid candidate for
 			// reparent, so don't insert it into the final mapping.
+			errantGTIDsMap[alias] = errantGTIDs
 			continue
 		}
 
@@ -130,13 +143,13 @@ func FindValidEmergencyReparentCandidates(
 	for alias, primaryStatus := range primaryStatusMap {
 		executedPosition, err := mysql.DecodePosition(primaryStatus.Position)
 		if err != nil {
-			return nil, vterrors.Wrapf(err, "could not decode a master status executed position for tablet %v: %v", alias, err)
+			return nil, nil, vterrors.Wrapf(err, "could not decode a master status executed position for tablet %v: %v", alias, err)
 		}
 
 		positionMap[alias] = executedPosition
 	}
 
-	return positionMap, nil
+	return positionMap, errantGTIDsMap, nil
 }
 
 // ReplicaWasRunning returns true if a StopReplicationStatus indicates that the
@@ -150,6 +163,37 @@ func ReplicaWasRunning(stopStatus *replicationdatapb.StopReplicationStatus) (boo
 	return stopStatus.Before.IoThreadRunning || stopStatus.Before.SqlThreadRunning, nil
 }
 
+// replicationLag returns the replication lag of a replica, and false if it is
+// unknown. MySQL stops reporting the lag once the IO thread lost the primary
+// and the SQL thread executed the whole relay log, so a replica that executed
+// every transaction it retrieved has no lag, even without a primary.
+func replicationLag(status *replicationdatapb.Status) (time.Duration, bool) {
+	if status == nil {
+		return 0, false
+	}
+
+	if !status.ReplicationLagUnknown {
+		return time.Duration(status.SecondsBehindMaster) * time.Second, true
+	}
+
+	executed, retrieved := status.Position, status.RelayLogPosition
+	if retrieved == "" {
+		executed, retrieved = status.FilePosition, status.FileRelayLogPosition
+	}
+
+	executedPos, err := mysql.DecodePosition(executed)
+	if err != nil {
+		return 0, false
+	}
+
+	retrievedPos, err := mysql.DecodePosition(retrieved)
+	if err != nil || retrievedPos.IsZero() || !executedPos.AtLeast(retrievedPos) {
+		return 0, false
+	}
+
+	return 0, true
+}
+
 // StopReplicationAndBuildStatusMaps stops replication on all replicas, then
 // collects and returns a mapping of TabletAlias (as string) to their current
 // replication positions.
@@ -228,6 +272,90 @@ func StopReplicationAndBuildStatusMaps(
 	return statusMap, masterStatusMap, nil
 }
 
+// BuildStatusMaps collects and returns a mapping of TabletAlias (as string) to
+// the current replication positions of all replicas, like
+// StopReplicationAndBuildStatusMaps, but without stopping replication or
+// demoting any tablet. The Before and After statuses of each replica are the
+// same. It is used to plan a reparent without acting on it.
+func BuildStatusMaps(
+	ctx context.Context,
+	tmc tmclient.TabletManagerClient,
+	ev *events.Reparent,
+	tabletMap map[string]*topo.TabletInfo,
+	waitReplicasTimeout time.Duration,
+	ignoredTablets sets.String,
+	logger logutil.Logger,
+) (map[string]*replicationdatapb.StopReplicationStatus, map[string]*replicationdatapb.MasterStatus, error) {
+	event.DispatchUpdate(ev, "reading replication status of all replicas")
+
+	var (
+		statusMap       = map[string]*replicationdatapb.StopReplicationStatus{}
+		masterStatusMap = map[string]*replicationdatapb.MasterStatus{}
+		m               sync.Mutex
+		errChan         = make(chan error)
+	)
+
+	groupCtx, groupCancel := context.WithTimeout(ctx, waitReplicasTimeout)
+	defer groupCancel()
+
+	fillStatus := func(alias string, tabletInfo *topo.TabletInfo) {
+		err := vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "fillStatus did not successfully complete")
+		defer func() { errChan <- err }()
+
+		logger.Infof("getting replication position from %v", alias)
+
+		var status *replicationdatapb.Status
+
+		status, err = tmc.ReplicationStatus(groupCtx, tabletInfo.Tablet)
+		switch err {
+		case mysql.ErrNotReplica:
+			var masterStatus *replicationdatapb.MasterStatus
+
+			masterStatus, err = tmc.MasterStatus(groupCtx, tabletInfo.Tablet)
+			if err != nil {
+				err = vterrors.Wrapf(err, "error when getting master status for alias %v: %v", alias, err)
+
+				logger.Warningf("failed to get master status from %v: %v", alias, err)
+				return
+			}
+
+			m.Lock()
+			masterStatusMap[alias] = masterStatus
+			m.Unlock()
+		case nil:
+			m.Lock()
+			statusMap[alias] = &replicationdatapb.StopReplicationStatus{
+				Before: status,
+				After:  status,
+			}
+			m.Unlock()
+		default:
+			logger.Warningf("failed to get replication status from %v: %v", alias, err)
+
+			err = vterrors.Wrapf(err, "error when getting replication status for alias %v: %v", alias, err)
+		}
+	}
+
+	for alias, tabletInfo := range tabletMap {
+		if !ignoredTablets.Has(alias) {
+			go fillStatus(alias, tabletInfo)
+		}
+	}
+
+	errgroup := concurrency.ErrorGroup{
+		NumGoroutines:        len(tabletMap) - ignoredTablets.Len(),
+		NumRequiredSuccesses: len(tabletMap) - ignoredTablets.Len() - 1,
+		NumAllowedErrors:     1,
+	}
+
+	errRecorder := errgroup.Wait(groupCancel, errChan)
+	if len(errRecorder.Errors) > 1 {
+		return nil, nil, vterrors.Wrapf(errRecorder.Error(), "encountered more than one error when trying to get positions: %v", errRecorder.Error())
+	}
+
+	return statusMap, masterStatusMap, nil
+}
+
 // WaitForRelayLogsToApply blocks execution waiting for the given tablet's relay
 // logs to apply, unless the specified context is canceled or exceeded.
 // Typically a caller will set a timeout of WaitReplicasTimeout on a context and
@@ -800,6 +800,79 @@ func TestReplicaWasRunning(t *testing.T) {
 	}
 }
 
+func TestReplicationLag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		in       *replicationdatapb.Status
+		expected time.Duration
+		known    bool
+	}{
+		{
+			name: "reported lag",
+			in: &replicationdatapb.Status{
+				SecondsBehindMaster: 30,
+			},
+			expected: 30 * time.Second,
+			known:    true,
+		},
+		{
+			name: "unknown lag and relay log executed",
+			in: &replicationdatapb.Status{
+				Position:              "MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-20",
+				RelayLogPosition:      "MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-20",
+				ReplicationLagUnknown: true,
+			},
+			expected: 0,
+			known:    true,
+		},
+		{
+			name: "unknown lag and relay log file positions executed",
+			in: &replicationdatapb.Status{
+				FilePosition:          "FilePos/binlog.000002:100",
+				FileRelayLogPosition:  "FilePos/binlog.000002:100",
+				ReplicationLagUnknown: true,
+			},
+			expected: 0,
+			known:    true,
+		},
+		{
+			name: "unknown lag and relay log not executed",
+			in: &replicationdatapb.Status{
+				Position:              "MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-20",
+				RelayLogPosition:      "MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-26",
+				ReplicationLagUnknown: true,
+			},
+			known: false,
+		},
+		{
+			name: "unknown lag and no positions",
+			in: &replicationdatapb.Status{
+				ReplicationLagUnknown: true,
+			},
+			known: false,
+		},
+		{
+			name:  "no status",
+			in:    nil,
+			known: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, known := replicationLag(tt.in)
+			assert.Equal(t, tt.known, known)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
 // waitForRelayLogsToApplyTestTMClient implements just the WaitForPosition
 // method of the tmclient.TabletManagerClient interface for
 // TestWaitForRelayLogsToApply, with the necessary trackers to facilitate
@@ -34,9 +34,11 @@ import (
 
 // ChooseNewPrimary finds a tablet that should become a primary after reparent.
 // The criteria for the new primary-elect are (preferably) to be in the same
-// cell as the current primary, and to be different from avoidPrimaryAlias. The
-// tablet with the most advanced replication position is chosen to minimize the
-// amount of time spent catching up with the current primary.
+// cell as the current primary, to be different from avoidPrimaryAlias, and to
+// be promotable under the durability policy with enough potential semi-sync
+// ackers. Only the candidates with the best promotion rule are considered, and
+// of those the tablet with the most advanced replication position is chosen
+// to minimize the amount of time spent catching up with the current primary.
 //
 // Note that the search for the most advanced replication position will race
 // with transactions being executed on the current primary, so when all tablets
@@ -48,6 +50,7 @@ func ChooseNewPrimary(
 	shardInfo *topo.ShardInfo,
 	tabletMap map[string]*topo.TabletInfo,
 	avoidPrimaryAlias *topodatapb.TabletAlias,
+	durability Durabler,
 	waitReplicasTimeout time.Duration,
 	// (TODO:@ajm188) it's a little gross we need to pass this, maybe embed in the context?
 	logger logutil.Logger,
@@ -62,11 +65,15 @@ func ChooseNewPrimary(
 	}
 
 	var (
-		searcher = topotools.NewMaxReplicationPositionSearcher(tmc, logger, waitReplicasTimeout)
-		wg       sync.WaitGroup
+		searcher   = topotools.NewMaxReplicationPositionSearcher(tmc, logger, waitReplicasTimeout)
+		wg         sync.WaitGroup
+		tablets    = make([]*topodatapb.Tablet, 0, len(tabletMap))
+		candidates []*topodatapb.Tablet
 	)
 
 	for _, tablet := range tabletMap {
+		tablets = append(tablets, tablet.Tablet)
+
 		switch {
 		case primaryCell != "" && tablet.Alias.Cell != primaryCell:
 			continue
@@ -76,12 +83,16 @@ func ChooseNewPrimary(
 			continue
 		}
 
+		candidates = append(candidates, tablet.Tablet)
+	}
+
+	for _, tablet := range filterPromotableCandidates(durability, candidates, tablets) {
 		wg.Add(1)
 
 		go func(tablet *topodatapb.Tablet) {
 			defer wg.Done()
 			searcher.ProcessTablet(ctx, tablet)
-		}(tablet.Tablet)
+		}(tablet)
 	}
 
 	wg.Wait()
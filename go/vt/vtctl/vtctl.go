@@ -102,6 +102,7 @@ import (
 	"vitess.io/vitess/go/flagutil"
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
 	hk "vitess.io/vitess/go/vt/hook"
@@ -112,8 +113,11 @@ import (
 	"vitess.io/vitess/go/vt/schemamanager"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/helpers"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/wrangler"
 
@@ -276,7 +280,7 @@ var commands = []commandGroup{
 	{
 		"Keyspaces", []command{
 			{"CreateKeyspace", commandCreateKeyspace,
-				"[-sharding_column_name=name] [-sharding_column_type=type] [-served_from=tablettype1:ks1,tablettype2:ks2,...] [-force] [-keyspace_type=type] [-base_keyspace=base_keyspace] [-snapshot_time=time] <keyspace name>",
+				"[-sharding_column_name=name] [-sharding_column_type=type] [-served_from=tablettype1:ks1,tablettype2:ks2,...] [-force] [-keyspace_type=type] [-base_keyspace=base_keyspace] [-snapshot_time=time] [-durability_policy=policy] <keyspace name>",
 				"Creates the specified keyspace. keyspace_type can be NORMAL or SNAPSHOT. For a SNAPSHOT keyspace you must specify the name of a base_keyspace, and a snapshot_time in UTC, in RFC3339 time format, e.g. 2006-01-02T15:04:05+00:00"},
 			{"DeleteKeyspace", commandDeleteKeyspace,
 				"[-recursive] <keyspace>",
@@ -293,6 +297,15 @@ var commands = []commandGroup{
 			{"SetKeyspaceShardingInfo", commandSetKeyspaceShardingInfo,
 				"[-force] <keyspace name> [<column name>] [<column type>]",
 				"Updates the sharding information for a keyspace."},
+			{"SetKeyspaceDurabilityPolicy", commandSetKeyspaceDurabilityPolicy,
+				"<keyspace name> <policy>",
+				"Sets the durability policy used by the reparenting commands and vtorc for all shards of the keyspace. An empty policy resets the keyspace to the default policy."},
+			{"ThrottleApp", commandThrottleApp,
+				"[-ratio=1.0] [-duration=1h] <keyspace name> <app name>",
+				"Throttles the named app (e.g. vreplication or online-ddl) on the tablet throttlers of all shards of the keyspace. The throttlers reject the given ratio of the app's checks until the duration elapses."},
+			{"UnthrottleApp", commandUnthrottleApp,
+				"<keyspace name> <app name>",
+				"Removes the throttling of the named app from the keyspace."},
 			{"SetKeyspaceServedFrom", commandSetKeyspaceServedFrom,
 				"[-source=<source keyspace name>] [-remove] [-cells=c1,c2,...] <keyspace name> <tablet type>",
 				"Changes the ServedFromMap manually. This command is intended for emergency fixes. This field is automatically set when you call the *MigrateServedFrom* command. This command does not rebuild the serving graph."},
@@ -322,7 +335,7 @@ var commands = []commandGroup{
 				`Externalize a backfilled vindex.`},
 			{"Materialize", commandMaterialize,
 				`[-cells=<cells>] [-tablet_types=<source_tablet_types>] <json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
-				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL."},
+				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL. The optional on_ddl setting (IGNORE, STOP, EXEC, EXEC_IGNORE or EXEC_ADDITIVE) can be specified for the workflow, and overridden for individual tables in table_settings. If bidirectional is true, the changes made on the target keyspace are also replicated back to the source keyspace, and conflicts are resolved with the on_conflict setting (OVERWRITE, LAST_WRITER_WINS with a conflict_column, or REJECT)."},
 			{"SplitClone", commandSplitClone,
 				"<keyspace> <from_shards> <to_shards>",
 				"Start the SplitClone process to perform horizontal resharding. Example: SplitClone ks '0' '-80,80-'"},
@@ -368,6 +381,9 @@ var commands = []commandGroup{
 			{"Validate", commandValidate,
 				"[-ping-tablets]",
 				"Validates that all nodes reachable from the global replication graph and that all tablets in all discoverable cells are consistent."},
+			{"CheckTopo", commandCheckTopo,
+				"",
+				"Cross-checks the topo records: shard masters against tablet records, SrvKeyspace against Keyspace and Shard served types, ShardReplication against tablets, and cells aliases against cells. Lists the inconsistencies found."},
 			{"ListAllTablets", commandListAllTablets,
 				"<cell name1>, <cell name2>, ...",
 				"Lists all tablets in an awk-friendly way."},
@@ -489,7 +505,7 @@ var commands = []commandGroup{
 		"Workflow", []command{
 			{"Workflow", commandWorkflow,
 				"<ks.workflow> <action> --dry-run",
-				"Start/Stop/Delete/Show/ListAll Workflow on all target tablets in workflow. Example: Workflow merchant.morders Start",
+				"Start/Stop/Delete/Show/ListAll Workflow on all target tablets in workflow. State/Progress return the lifecycle state of the workflow, and its copy progress, lag and ETA, as JSON. Example: Workflow merchant.morders Start",
 			},
 		},
 	},
@@ -1619,12 +1635,18 @@ func commandCreateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	keyspaceType := subFlags.String("keyspace_type", "", "Specifies the type of the keyspace")
 	baseKeyspace := subFlags.String("base_keyspace", "", "Specifies the base keyspace for a snapshot keyspace")
 	timestampStr := subFlags.String("snapshot_time", "", "Specifies the snapshot time for this keyspace")
+	durabilityPolicy := subFlags.String("durability_policy", "", "Specifies the durability policy used to reparent the shards of this keyspace, one of "+strings.Join(reparentutil.GetDurabilityPolicyNames(), ", ")+". Empty uses the default policy")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 	if subFlags.NArg() != 1 {
 		return fmt.Errorf("the <keyspace name> argument is required for the CreateKeyspace command")
 	}
+	if *durabilityPolicy != "" {
+		if _, err := reparentutil.GetDurabilityPolicy(*durabilityPolicy); err != nil {
+			return err
+		}
+	}
 
 	keyspace := subFlags.Arg(0)
 	kit, err := key.ParseKeyspaceIDType(*shardingColumnType)
@@ -1668,6 +1690,7 @@ func commandCreateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags
 		KeyspaceType:       ktype,
 		BaseKeyspace:       *baseKeyspace,
 		SnapshotTime:       snapshotTime,
+		DurabilityPolicy:   *durabilityPolicy,
 	}
 	if len(servedFrom) > 0 {
 		for name, value := range servedFrom {
@@ -1816,6 +1839,55 @@ func commandSetKeyspaceShardingInfo(ctx context.Context, wr *wrangler.Wrangler,
 	return wr.SetKeyspaceShardingInfo(ctx, keyspace, columnName, kit, *force)
 }
 
+func commandSetKeyspaceDurabilityPolicy(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace name> and <policy> arguments are required for the SetKeyspaceDurabilityPolicy command")
+	}
+
+	return wr.SetKeyspaceDurabilityPolicy(ctx, subFlags.Arg(0), subFlags.Arg(1))
+}
+
+func commandThrottleApp(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	ratio := subFlags.Float64("ratio", 1, "Specifies the ratio of the app's throttler checks to reject, between 0 and 1")
+	duration := subFlags.Duration("duration", time.Hour, "Specifies how long to throttle the app for")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace name> and <app name> arguments are required for the ThrottleApp command")
+	}
+
+	resp, err := wr.VtctldServer().ThrottleApp(ctx, &vtctldatapb.ThrottleAppRequest{
+		Keyspace: subFlags.Arg(0),
+		AppName:  subFlags.Arg(1),
+		Ratio:    *ratio,
+		Duration: protoutil.DurationToProto(*duration),
+	})
+	if err != nil {
+		return err
+	}
+
+	return printJSON(wr.Logger(), resp.Rule)
+}
+
+func commandUnthrottleApp(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace name> and <app name> arguments are required for the UnthrottleApp command")
+	}
+
+	_, err := wr.VtctldServer().UnthrottleApp(ctx, &vtctldatapb.UnthrottleAppRequest{
+		Keyspace: subFlags.Arg(0),
+		AppName:  subFlags.Arg(1),
+	})
+	return err
+}
+
 func commandSetKeyspaceServedFrom(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	source := subFlags.String("source", "", "Specifies the source keyspace name")
 	remove := subFlags.Bool("remove", false, "Indicates whether to add (default) or remove the served from record")
@@ -2057,6 +2129,9 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 				if st.TransactionTimestamp > 0 { // if no events occur after copy phase, TransactionTimeStamp can be 0
 					msg += fmt.Sprintf(" Tx time: %s.", time.Unix(st.TransactionTimestamp, 0).Format(time.ANSIC))
 				}
+				if st.PendingDDL != "" {
+					msg += fmt.Sprintf(" DDL awaiting approval: %s.", st.PendingDDL)
+				}
 				s += fmt.Sprintf("id=%d on %s: Status: %s.%s\n", st.ID, ksShard, st.State, msg)
 			}
 		}
@@ -2670,6 +2745,27 @@ func commandValidate(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 	return wr.Validate(ctx, *pingTablets)
 }
 
+func commandCheckTopo(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("action CheckTopo doesn't take any parameter")
+	}
+
+	problems, err := helpers.CheckTopo(ctx, wr.TopoServer())
+	if err != nil {
+		return err
+	}
+	for _, problem := range problems {
+		wr.Logger().Printf("%v\n", problem)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("found %v inconsistencies in the topo", len(problems))
+	}
+	return nil
+}
+
 func commandListAllTablets(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -3430,7 +3526,7 @@ func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 		return err
 	}
 	if subFlags.NArg() != 2 {
-		return fmt.Errorf("usage: Workflow --dry-run keyspace[.workflow] start/stop/delete/list/listall")
+		return fmt.Errorf("usage: Workflow --dry-run keyspace[.workflow] start/stop/delete/list/listall/state/progress")
 	}
 	keyspace := subFlags.Arg(0)
 	action := strings.ToLower(subFlags.Arg(1))
@@ -3451,6 +3547,10 @@ func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 		wr.Logger().Errorf("Keyspace %s not found", keyspace)
 	}
 
+	if action == "state" || action == "progress" {
+		return printWorkflowLifecycle(ctx, wr, keyspace, workflow, action)
+	}
+
 	results, err := wr.WorkflowAction(ctx, workflow, keyspace, action, *dryRun)
 	if err != nil {
 		return err
@@ -3468,6 +3568,25 @@ func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 	return nil
 }
 
+// printWorkflowLifecycle prints the lifecycle record or the progress of a
+// workflow as JSON.
+func printWorkflowLifecycle(ctx context.Context, wr *wrangler.Wrangler, keyspace, name, action string) error {
+	ws := workflow.NewServer(wr.TopoServer(), wr.TabletManagerClient())
+	if action == "state" {
+		resp, err := ws.GetWorkflow(ctx, &vtctldatapb.GetWorkflowRequest{Keyspace: keyspace, Workflow: name})
+		if err != nil {
+			return err
+		}
+		return printJSON(wr.Logger(), resp.Workflow)
+	}
+
+	resp, err := ws.GetWorkflowProgress(ctx, &vtctldatapb.GetWorkflowProgressRequest{Keyspace: keyspace, Workflow: name})
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), resp.Progress)
+}
+
 func commandMount(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	clusterType := subFlags.String("type", "vitess", "Specify cluster type: mysql or vitess, only vitess clustered right now")
 	unmount := subFlags.Bool("unmount", false, "Unmount cluster")
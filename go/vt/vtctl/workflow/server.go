@@ -0,0 +1,392 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	streamsQuery     = "select id, source, state, time_updated from _vt.vreplication where db_name = %s and workflow = %s"
+	copyTablesQuery  = "select distinct table_name from _vt.copy_state where vrepl_id in (%s)"
+	tableSizesQuery  = "select table_name, table_rows, data_length from information_schema.tables where table_schema = %s and table_name in (%s)"
+	maxCopyTableRows = 1000
+)
+
+// Server provides the lifecycle records and the progress of vreplication
+// workflows.
+type Server struct {
+	ts  *topo.Server
+	tmc tmclient.TabletManagerClient
+}
+
+// NewServer returns a new server instance with the given topo.Server and
+// TabletManagerClient.
+func NewServer(ts *topo.Server, tmc tmclient.TabletManagerClient) *Server {
+	return &Server{ts: ts, tmc: tmc}
+}
+
+// GetWorkflow returns the lifecycle record of a workflow.
+func (s *Server) GetWorkflow(ctx context.Context, req *vtctldatapb.GetWorkflowRequest) (*vtctldatapb.GetWorkflowResponse, error) {
+	if err := validateWorkflowRequest(req.Keyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+
+	wi, err := s.ts.GetVReplicationWorkflow(ctx, req.Keyspace, req.Workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetWorkflowResponse{
+		Workflow: workflowFromInfo(wi),
+	}, nil
+}
+
+// GetWorkflows returns the lifecycle records of all the workflows targeting a
+// keyspace, sorted by name.
+func (s *Server) GetWorkflows(ctx context.Context, req *vtctldatapb.GetWorkflowsRequest) (*vtctldatapb.GetWorkflowsResponse, error) {
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+
+	names, err := s.ts.GetVReplicationWorkflowNames(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	workflows := make([]*vtctldatapb.Workflow, 0, len(names))
+	for _, name := range names {
+		wi, err := s.ts.GetVReplicationWorkflow(ctx, req.Keyspace, name)
+		if err != nil {
+			return nil, err
+		}
+		workflows = append(workflows, workflowFromInfo(wi))
+	}
+
+	return &vtctldatapb.GetWorkflowsResponse{
+		Workflows: workflows,
+	}, nil
+}
+
+// GetWorkflowProgress returns the state of a workflow along with the copy
+// progress, lag and ETA of its streams. A workflow recorded as COPYING whose
+// streams are done copying is reported as RUNNING. The record itself is only
+// updated by the component that drives the workflow.
+func (s *Server) GetWorkflowProgress(ctx context.Context, req *vtctldatapb.GetWorkflowProgressRequest) (*vtctldatapb.GetWorkflowProgressResponse, error) {
+	if err := validateWorkflowRequest(req.Keyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+
+	wi, err := s.ts.GetVReplicationWorkflow(ctx, req.Keyspace, req.Workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &vtctldatapb.WorkflowProgress{
+		State:                wi.State,
+		RowsCopiedPercentage: 100,
+	}
+	if IsTerminal(wi.State) {
+		return &vtctldatapb.GetWorkflowProgressResponse{Progress: progress}, nil
+	}
+
+	now := time.Now()
+	targets, err := s.readStreams(ctx, req.Keyspace, req.Workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	copying := false
+	for _, target := range targets {
+		for _, stream := range target.streams {
+			progress.TotalStreams++
+			if stream.state != binlogplayer.BlpRunning {
+				continue
+			}
+			progress.RunningStreams++
+			if lag := int64(now.Sub(stream.timeUpdated).Seconds()); lag > progress.MaxLagSeconds {
+				progress.MaxLagSeconds = lag
+			}
+		}
+		if len(target.copyTables) > 0 {
+			copying = true
+		}
+	}
+
+	if copying {
+		if err := s.computeCopyProgress(ctx, targets, progress); err != nil {
+			return nil, err
+		}
+		if pct := progress.RowsCopiedPercentage; wi.Created != nil && pct > 0 && pct < 100 {
+			elapsed := now.Sub(logutil.ProtoToTime(wi.Created)).Seconds()
+			progress.EtaSeconds = int64(elapsed * (100 - pct) / pct)
+		}
+	} else if wi.State == StateCopying && progress.TotalStreams > 0 {
+		progress.State = StateRunning
+	}
+
+	return &vtctldatapb.GetWorkflowProgressResponse{Progress: progress}, nil
+}
+
+type stream struct {
+	id          int64
+	bls         *binlogdatapb.BinlogSource
+	state       string
+	timeUpdated time.Time
+}
+
+type streamTarget struct {
+	primary    *topodatapb.Tablet
+	streams    []*stream
+	copyTables []string
+}
+
+// readStreams returns, for each target shard with streams for the workflow,
+// its primary tablet, the streams and the tables they are still copying.
+func (s *Server) readStreams(ctx context.Context, keyspace, workflow string) ([]*streamTarget, error) {
+	shards, err := s.ts.FindAllShardsInKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(shards))
+	for name := range shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var targets []*streamTarget
+	for _, name := range names {
+		si := shards[name]
+		if !si.HasMaster() {
+			continue
+		}
+		ti, err := s.ts.GetTablet(ctx, si.MasterAlias)
+		if err != nil {
+			return nil, err
+		}
+
+		query := fmt.Sprintf(streamsQuery, encodeString(ti.DbName()), encodeString(workflow))
+		p3qr, err := s.tmc.VReplicationExec(ctx, ti.Tablet, query)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "VReplicationExec(%v, %s)", topoproto.TabletAliasString(si.MasterAlias), query)
+		}
+		qr := sqltypes.ToNamedResult(sqltypes.Proto3ToResult(p3qr))
+		if len(qr.Rows) == 0 {
+			continue
+		}
+
+		target := &streamTarget{primary: ti.Tablet}
+		var ids []string
+		for _, row := range qr.Rows {
+			id, err := row.ToInt64("id")
+			if err != nil {
+				return nil, err
+			}
+			bls := &binlogdatapb.BinlogSource{}
+			if err := proto.UnmarshalText(row.AsString("source", ""), bls); err != nil {
+				return nil, vterrors.Wrapf(err, "bad source for stream %d on %v", id, topoproto.TabletAliasString(si.MasterAlias))
+			}
+			target.streams = append(target.streams, &stream{
+				id:          id,
+				bls:         bls,
+				state:       row.AsString("state", ""),
+				timeUpdated: time.Unix(row.AsInt64("time_updated", 0), 0),
+			})
+			ids = append(ids, fmt.Sprintf("%d", id))
+		}
+
+		query = fmt.Sprintf(copyTablesQuery, strings.Join(ids, ", "))
+		p3qr, err = s.tmc.ExecuteFetchAsDba(ctx, ti.Tablet, true, []byte(query), maxCopyTableRows, false, false)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "ExecuteFetchAsDba(%v, %s)", topoproto.TabletAliasString(si.MasterAlias), query)
+		}
+		for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+			target.copyTables = append(target.copyTables, row[0].ToString())
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// computeCopyProgress fills in the copy progress of the tables that are
+// still being copied, comparing the table sizes reported by the target and
+// source primaries.
+func (s *Server) computeCopyProgress(ctx context.Context, targets []*streamTarget, progress *vtctldatapb.WorkflowProgress) error {
+	tableSet := make(map[string]bool)
+	for _, target := range targets {
+		for _, table := range target.copyTables {
+			tableSet[table] = true
+		}
+	}
+	tables := make([]string, 0, len(tableSet))
+	for table := range tableSet {
+		tables = append(tables, encodeString(table))
+	}
+	sort.Strings(tables)
+	tableList := strings.Join(tables, ",")
+
+	progress.Tables = make(map[string]*vtctldatapb.WorkflowProgress_TableCopyProgress)
+	for table := range tableSet {
+		progress.Tables[table] = &vtctldatapb.WorkflowProgress_TableCopyProgress{}
+	}
+
+	sources := make(map[string]*topodatapb.Tablet)
+	for _, target := range targets {
+		err := s.addTableSizes(ctx, target.primary, tableList, progress.Tables, func(tcp *vtctldatapb.WorkflowProgress_TableCopyProgress, rows, size int64) {
+			tcp.TargetRowCount += rows
+			tcp.TargetTableSize += size
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, stream := range target.streams {
+			key := fmt.Sprintf("%s/%s/%s", stream.bls.ExternalCluster, stream.bls.Keyspace, stream.bls.Shard)
+			if _, ok := sources[key]; ok {
+				continue
+			}
+			primary, err := s.sourcePrimary(ctx, stream.bls)
+			if err != nil {
+				return err
+			}
+			sources[key] = primary
+		}
+	}
+	for _, primary := range sources {
+		err := s.addTableSizes(ctx, primary, tableList, progress.Tables, func(tcp *vtctldatapb.WorkflowProgress_TableCopyProgress, rows, size int64) {
+			tcp.SourceRowCount += rows
+			tcp.SourceTableSize += size
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	var sourceRows, targetRows int64
+	for _, tcp := range progress.Tables {
+		tcp.RowsCopiedPercentage = percentage(tcp.TargetRowCount, tcp.SourceRowCount)
+		sourceRows += tcp.SourceRowCount
+		targetRows += tcp.TargetRowCount
+	}
+	progress.RowsCopiedPercentage = percentage(targetRows, sourceRows)
+	return nil
+}
+
+// sourcePrimary returns the primary tablet of the source shard of a stream,
+// which may live in an external cluster.
+func (s *Server) sourcePrimary(ctx context.Context, bls *binlogdatapb.BinlogSource) (*topodatapb.Tablet, error) {
+	ts := s.ts
+	if bls.ExternalCluster != "" {
+		var err error
+		if ts, err = s.ts.OpenExternalVitessClusterServer(ctx, bls.ExternalCluster); err != nil {
+			return nil, err
+		}
+	}
+	si, err := ts.GetShard(ctx, bls.Keyspace, bls.Shard)
+	if err != nil {
+		return nil, err
+	}
+	if !si.HasMaster() {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "source shard %s/%s has no primary", bls.Keyspace, bls.Shard)
+	}
+	ti, err := ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return nil, err
+	}
+	return ti.Tablet, nil
+}
+
+// addTableSizes reads the row counts and data sizes of the given tables from
+// a tablet, and adds them to the table progress using the add function.
+func (s *Server) addTableSizes(ctx context.Context, tablet *topodatapb.Tablet, tableList string, tables map[string]*vtctldatapb.WorkflowProgress_TableCopyProgress,
+	add func(tcp *vtctldatapb.WorkflowProgress_TableCopyProgress, rows, size int64)) error {
+	query := fmt.Sprintf(tableSizesQuery, encodeString(topoproto.TabletDbName(tablet)), tableList)
+	p3qr, err := s.tmc.ExecuteFetchAsDba(ctx, tablet, true, []byte(query), len(tables), false, false)
+	if err != nil {
+		return vterrors.Wrapf(err, "ExecuteFetchAsDba(%v, %s)", topoproto.TabletAliasString(tablet.Alias), query)
+	}
+	for _, row := range sqltypes.ToNamedResult(sqltypes.Proto3ToResult(p3qr)).Rows {
+		tcp, ok := tables[row.AsString("table_name", "")]
+		if !ok {
+			continue
+		}
+		add(tcp, row.AsInt64("table_rows", 0), row.AsInt64("data_length", 0))
+	}
+	return nil
+}
+
+// percentage returns how much of total is done, capped to 100 since the row
+// counts reported by information_schema are estimates.
+func percentage(done, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	pct := 100 * float64(done) / float64(total)
+	if pct > 100 {
+		pct = 100
+	}
+	return pct
+}
+
+func workflowFromInfo(wi *topo.VReplicationWorkflowInfo) *vtctldatapb.Workflow {
+	return &vtctldatapb.Workflow{
+		Name:           wi.Name,
+		WorkflowType:   wi.WorkflowType,
+		SourceKeyspace: wi.SourceKeyspace,
+		TargetKeyspace: wi.Keyspace,
+		State:          wi.State,
+		Created:        wi.Created,
+		Updated:        wi.Updated,
+	}
+}
+
+func validateWorkflowRequest(keyspace, workflow string) error {
+	if keyspace == "" {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+	if workflow == "" {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "workflow field is required")
+	}
+	return nil
+}
+
+func encodeString(in string) string {
+	buf := bytes.NewBuffer(nil)
+	sqltypes.NewVarChar(in).EncodeSQL(buf)
+	return buf.String()
+}
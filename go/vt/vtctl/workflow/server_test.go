@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// fakeTMClient answers queries from a per-tablet map of query to result.
+type fakeTMClient struct {
+	tmclient.TabletManagerClient
+	results map[uint32]map[string]*sqltypes.Result
+}
+
+func (tmc *fakeTMClient) result(tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	qr, ok := tmc.results[tablet.Alias.Uid][query]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query on tablet %d: %s", tablet.Alias.Uid, query)
+	}
+	return sqltypes.ResultToProto3(qr), nil
+}
+
+func (tmc *fakeTMClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	return tmc.result(tablet, query)
+}
+
+func (tmc *fakeTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	return tmc.result(tablet, string(query))
+}
+
+func addPrimary(ctx context.Context, t *testing.T, ts *topo.Server, keyspace string, uid uint32) {
+	alias := &topodatapb.TabletAlias{Cell: "zone1", Uid: uid}
+	require.NoError(t, ts.CreateTablet(ctx, &topodatapb.Tablet{
+		Alias:    alias,
+		Keyspace: keyspace,
+		Shard:    "0",
+		Type:     topodatapb.TabletType_MASTER,
+	}))
+	require.NoError(t, ts.CreateKeyspace(ctx, keyspace, &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, keyspace, "0"))
+	_, err := ts.UpdateShardFields(ctx, keyspace, "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = alias
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+const (
+	testStreamsQuery = "select id, source, state, time_updated from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'wf'"
+	testCopyQuery    = "select distinct table_name from _vt.copy_state where vrepl_id in (1)"
+)
+
+func testStreams(state string, timeUpdated int64) *sqltypes.Result {
+	return sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"id|source|state|time_updated",
+		"int64|varchar|varchar|int64"),
+		fmt.Sprintf(`1|keyspace:"ks1" shard:"0" |%s|%d`, state, timeUpdated),
+	)
+}
+
+func TestGetWorkflowProgress(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	addPrimary(ctx, t, ts, "ks1", 100)
+	addPrimary(ctx, t, ts, "ks2", 200)
+	require.NoError(t, ts.CreateVReplicationWorkflow(ctx, "ks2", "wf", &topodatapb.VReplicationWorkflow{
+		WorkflowType:   "MoveTables",
+		SourceKeyspace: "ks1",
+		State:          StateCopying,
+		Created:        logutil.TimeToProto(time.Now().Add(-100 * time.Second)),
+	}))
+
+	tableFields := sqltypes.MakeTestFields("table_name|table_rows|data_length", "varchar|int64|int64")
+	tmc := &fakeTMClient{
+		results: map[uint32]map[string]*sqltypes.Result{
+			200: {
+				testStreamsQuery: testStreams("Running", time.Now().Unix()-5),
+				testCopyQuery:    sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_name", "varchar"), "t1", "t2"),
+				"select table_name, table_rows, data_length from information_schema.tables where table_schema = 'vt_ks2' and table_name in ('t1','t2')": sqltypes.MakeTestResult(tableFields,
+					"t1|50|500",
+					"t2|0|0",
+				),
+			},
+			100: {
+				"select table_name, table_rows, data_length from information_schema.tables where table_schema = 'vt_ks1' and table_name in ('t1','t2')": sqltypes.MakeTestResult(tableFields,
+					"t1|100|1000",
+					"t2|100|1000",
+				),
+			},
+		},
+	}
+	s := NewServer(ts, tmc)
+
+	resp, err := s.GetWorkflowProgress(ctx, &vtctldatapb.GetWorkflowProgressRequest{Keyspace: "ks2", Workflow: "wf"})
+	require.NoError(t, err)
+	progress := resp.Progress
+	assert.Equal(t, StateCopying, progress.State)
+	assert.EqualValues(t, 1, progress.TotalStreams)
+	assert.EqualValues(t, 1, progress.RunningStreams)
+	assert.InDelta(t, 5, progress.MaxLagSeconds, 2)
+	assert.InDelta(t, 25, progress.RowsCopiedPercentage, 0.01)
+	assert.InDelta(t, 50, progress.Tables["t1"].RowsCopiedPercentage, 0.01)
+	assert.EqualValues(t, 1000, progress.Tables["t1"].SourceTableSize)
+	assert.EqualValues(t, 0, progress.Tables["t2"].TargetRowCount)
+	// 25% was copied in 100s, so the rest should take about 300s.
+	assert.InDelta(t, 300, progress.EtaSeconds, 5)
+
+	// Once the copy is done, the workflow is reported as RUNNING, but the
+	// record is left as is.
+	tmc.results[200][testCopyQuery] = sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_name", "varchar"))
+	resp, err = s.GetWorkflowProgress(ctx, &vtctldatapb.GetWorkflowProgressRequest{Keyspace: "ks2", Workflow: "wf"})
+	require.NoError(t, err)
+	assert.Equal(t, StateRunning, resp.Progress.State)
+	assert.EqualValues(t, 100, resp.Progress.RowsCopiedPercentage)
+	assert.EqualValues(t, 0, resp.Progress.EtaSeconds)
+
+	wresp, err := s.GetWorkflow(ctx, &vtctldatapb.GetWorkflowRequest{Keyspace: "ks2", Workflow: "wf"})
+	require.NoError(t, err)
+	assert.Equal(t, StateCopying, wresp.Workflow.State)
+	assert.Equal(t, "ks2", wresp.Workflow.TargetKeyspace)
+	assert.Equal(t, "ks1", wresp.Workflow.SourceKeyspace)
+}
+
+func TestGetWorkflows(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	s := NewServer(ts, &fakeTMClient{})
+
+	resp, err := s.GetWorkflows(ctx, &vtctldatapb.GetWorkflowsRequest{Keyspace: "ks2"})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Workflows)
+
+	require.NoError(t, Create(ctx, ts, "ks2", "wf2", "Reshard", "ks2"))
+	require.NoError(t, Create(ctx, ts, "ks2", "wf1", "MoveTables", "ks1"))
+	require.NoError(t, UpdateState(ctx, ts, "ks2", "wf1", StateCancelled))
+
+	resp, err = s.GetWorkflows(ctx, &vtctldatapb.GetWorkflowsRequest{Keyspace: "ks2"})
+	require.NoError(t, err)
+	require.Len(t, resp.Workflows, 2)
+	assert.Equal(t, "wf1", resp.Workflows[0].Name)
+	assert.Equal(t, StateCancelled, resp.Workflows[0].State)
+	assert.Equal(t, "wf2", resp.Workflows[1].Name)
+	assert.Equal(t, "Reshard", resp.Workflows[1].WorkflowType)
+
+	_, err = s.GetWorkflows(ctx, &vtctldatapb.GetWorkflowsRequest{})
+	assert.Error(t, err)
+	_, err = s.GetWorkflow(ctx, &vtctldatapb.GetWorkflowRequest{Keyspace: "ks2"})
+	assert.Error(t, err)
+}
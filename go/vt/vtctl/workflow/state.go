@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package workflow tracks the lifecycle of vreplication workflows (MoveTables,
+Reshard, Migrate).
+
+Each workflow has a record in the topo, stored under its target keyspace,
+holding its state:
+
+	COPYING -> RUNNING -> READS_SWITCHED -> WRITES_SWITCHED -> COMPLETED
+	   |          |
+	   +----------+-----> CANCELLED
+
+Traffic can also be switched back, so READS_SWITCHED can go back to RUNNING
+and WRITES_SWITCHED back to READS_SWITCHED.
+
+Independently of the record, the vreplication status of a workflow is read
+from the _vt.vreplication tables of its target primaries (see
+Server.GetWorkflowStatus). The refinement of the stream states it uses is
+shared with `vtctl Workflow show`.
+*/
+package workflow
+
+import (
+	"context"
+	"time"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// State is the lifecycle state of a workflow.
+type State = topodatapb.VReplicationWorkflow_State
+
+// The lifecycle states of a workflow.
+const (
+	StateUnknown        = topodatapb.VReplicationWorkflow_UNKNOWN
+	StateCopying        = topodatapb.VReplicationWorkflow_COPYING
+	StateRunning        = topodatapb.VReplicationWorkflow_RUNNING
+	StateReadsSwitched  = topodatapb.VReplicationWorkflow_READS_SWITCHED
+	StateWritesSwitched = topodatapb.VReplicationWorkflow_WRITES_SWITCHED
+	StateCompleted      = topodatapb.VReplicationWorkflow_COMPLETED
+	StateCancelled      = topodatapb.VReplicationWorkflow_CANCELLED
+)
+
+// validTransitions lists the states a workflow can move to from each state.
+// StateUnknown is not listed: workflows created before their state was
+// tracked can move to any state.
+var validTransitions = map[State][]State{
+	StateCopying:        {StateRunning, StateReadsSwitched, StateWritesSwitched, StateCancelled},
+	StateRunning:        {StateReadsSwitched, StateWritesSwitched, StateCancelled},
+	StateReadsSwitched:  {StateRunning, StateWritesSwitched},
+	StateWritesSwitched: {StateRunning, StateReadsSwitched, StateCompleted},
+	StateCompleted:      {},
+	StateCancelled:      {},
+}
+
+// ValidateTransition returns an error if a workflow cannot move from one
+// state to the other. Moving to the current state is always allowed.
+func ValidateTransition(from, to State) error {
+	if from == to || from == StateUnknown {
+		return nil
+	}
+	for _, state := range validTransitions[from] {
+		if state == to {
+			return nil
+		}
+	}
+	return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "invalid workflow state transition from %v to %v", from, to)
+}
+
+// IsTerminal returns true if a workflow in the given state cannot move to
+// any other state.
+func IsTerminal(state State) bool {
+	transitions, ok := validTransitions[state]
+	return ok && len(transitions) == 0
+}
+
+// Create records a new workflow in the COPYING state. It must be called
+// before the streams of the workflow are created, so that a workflow with
+// the same name that is still in progress is detected before any stream is
+// created. The record of a previous workflow with the same name is replaced
+// if that workflow has been completed or cancelled.
+func Create(ctx context.Context, ts *topo.Server, keyspace, name, workflowType, sourceKeyspace string) error {
+	now := logutil.TimeToProto(time.Now())
+	value := &topodatapb.VReplicationWorkflow{
+		WorkflowType:   workflowType,
+		SourceKeyspace: sourceKeyspace,
+		State:          StateCopying,
+		Created:        now,
+		Updated:        now,
+	}
+
+	wi, err := ts.GetVReplicationWorkflow(ctx, keyspace, name)
+	switch {
+	case topo.IsErrType(err, topo.NoNode):
+		return ts.CreateVReplicationWorkflow(ctx, keyspace, name, value)
+	case err != nil:
+		return err
+	case !IsTerminal(wi.State):
+		return vterrors.Errorf(vtrpcpb.Code_ALREADY_EXISTS, "workflow %s.%s already exists in state %v", keyspace, name, wi.State)
+	}
+
+	wi.VReplicationWorkflow = value
+	return ts.UpdateVReplicationWorkflow(ctx, wi)
+}
+
+// Delete deletes the record of a workflow, if it has one. It is called when
+// the creation of a workflow failed. Completed and cancelled workflows keep
+// their record, in a terminal state, until a workflow with the same name is
+// created.
+func Delete(ctx context.Context, ts *topo.Server, keyspace, name string) error {
+	err := ts.DeleteVReplicationWorkflow(ctx, keyspace, name)
+	if topo.IsErrType(err, topo.NoNode) {
+		return nil
+	}
+	return err
+}
+
+// UpdateState moves a workflow to the given state, after checking that the
+// transition is valid. A workflow without a record is recorded in the given
+// state.
+func UpdateState(ctx context.Context, ts *topo.Server, keyspace, name string, state State) error {
+	now := logutil.TimeToProto(time.Now())
+
+	wi, err := ts.GetVReplicationWorkflow(ctx, keyspace, name)
+	switch {
+	case topo.IsErrType(err, topo.NoNode):
+		return ts.CreateVReplicationWorkflow(ctx, keyspace, name, &topodatapb.VReplicationWorkflow{
+			State:   state,
+			Updated: now,
+		})
+	case err != nil:
+		return err
+	}
+
+	if wi.State == state {
+		return nil
+	}
+	if err := ValidateTransition(wi.State, state); err != nil {
+		return vterrors.Wrapf(err, "workflow %s.%s", keyspace, name)
+	}
+
+	wi.State = state
+	wi.Updated = now
+	return ts.UpdateVReplicationWorkflow(ctx, wi)
+}
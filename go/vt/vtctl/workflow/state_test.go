@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+)
+
+func TestValidateTransition(t *testing.T) {
+	tests := []struct {
+		from, to State
+		valid    bool
+	}{
+		{from: StateCopying, to: StateRunning, valid: true},
+		{from: StateCopying, to: StateCancelled, valid: true},
+		{from: StateRunning, to: StateReadsSwitched, valid: true},
+		{from: StateRunning, to: StateCompleted, valid: false},
+		{from: StateReadsSwitched, to: StateWritesSwitched, valid: true},
+		{from: StateReadsSwitched, to: StateRunning, valid: true},
+		{from: StateReadsSwitched, to: StateCancelled, valid: false},
+		{from: StateWritesSwitched, to: StateCompleted, valid: true},
+		{from: StateWritesSwitched, to: StateCancelled, valid: false},
+		{from: StateCompleted, to: StateRunning, valid: false},
+		{from: StateCancelled, to: StateCopying, valid: false},
+		{from: StateCompleted, to: StateCompleted, valid: true},
+		{from: StateUnknown, to: StateWritesSwitched, valid: true},
+	}
+	for _, tt := range tests {
+		err := ValidateTransition(tt.from, tt.to)
+		if tt.valid {
+			assert.NoError(t, err, "%v -> %v", tt.from, tt.to)
+		} else {
+			assert.Error(t, err, "%v -> %v", tt.from, tt.to)
+		}
+	}
+}
+
+func TestCreateAndUpdateState(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+
+	require.NoError(t, Create(ctx, ts, "ks2", "wf", "MoveTables", "ks1"))
+	wi, err := ts.GetVReplicationWorkflow(ctx, "ks2", "wf")
+	require.NoError(t, err)
+	assert.Equal(t, StateCopying, wi.State)
+	assert.Equal(t, "MoveTables", wi.WorkflowType)
+	assert.Equal(t, "ks1", wi.SourceKeyspace)
+	assert.NotNil(t, wi.Created)
+
+	err = Create(ctx, ts, "ks2", "wf", "MoveTables", "ks1")
+	assert.Error(t, err, "workflow in progress should not be recreated")
+
+	for _, state := range []State{StateRunning, StateReadsSwitched, StateWritesSwitched} {
+		require.NoError(t, UpdateState(ctx, ts, "ks2", "wf", state))
+	}
+	err = UpdateState(ctx, ts, "ks2", "wf", StateCancelled)
+	assert.Error(t, err)
+	require.NoError(t, UpdateState(ctx, ts, "ks2", "wf", StateCompleted))
+
+	wi, err = ts.GetVReplicationWorkflow(ctx, "ks2", "wf")
+	require.NoError(t, err)
+	assert.Equal(t, StateCompleted, wi.State)
+
+	// A completed workflow can be created again.
+	require.NoError(t, Create(ctx, ts, "ks2", "wf", "MoveTables", "ks1"))
+	wi, err = ts.GetVReplicationWorkflow(ctx, "ks2", "wf")
+	require.NoError(t, err)
+	assert.Equal(t, StateCopying, wi.State)
+
+	// Workflows without a record get one.
+	require.NoError(t, UpdateState(ctx, ts, "ks2", "old", StateReadsSwitched))
+	wi, err = ts.GetVReplicationWorkflow(ctx, "ks2", "old")
+	require.NoError(t, err)
+	assert.Equal(t, StateReadsSwitched, wi.State)
+
+	names, err := ts.GetVReplicationWorkflowNames(ctx, "ks2")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"wf", "old"}, names)
+
+	require.NoError(t, ts.DeleteVReplicationWorkflow(ctx, "ks2", "old"))
+	_, err = ts.GetVReplicationWorkflow(ctx, "ks2", "old")
+	assert.True(t, topo.IsErrType(err, topo.NoNode))
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	streamStatusesQuery = "select id, workflow, source, pos, stop_pos, state, db_name, time_updated, transaction_timestamp, message from _vt.vreplication where db_name = %s"
+	copyStatesQuery     = "select vrepl_id, table_name, lastpk from _vt.copy_state where vrepl_id in (%s)"
+)
+
+// StreamState refines the state of a stream, as found in _vt.vreplication, to
+// the one shown to users: a stream whose message mentions an error is in
+// Error, and a running stream is Copying while it has tables left to copy, and
+// Lagging when it has not been updated for more than 10 seconds.
+func StreamState(message, state string, copying bool, timeUpdated int64) string {
+	switch {
+	case strings.Contains(strings.ToLower(message), "error"):
+		return binlogplayer.BlpError
+	case state == binlogplayer.BlpRunning && copying:
+		return binlogplayer.VReplicationCopying
+	case state == binlogplayer.BlpRunning && time.Now().Unix()-timeUpdated > 10 /* seconds */ :
+		return "Lagging"
+	}
+
+	return state
+}
+
+// PendingDDL returns the DDL a stream stopped at, if it is waiting for an
+// operator to apply it, and the empty string otherwise.
+func PendingDDL(state, message string) string {
+	if state == binlogplayer.BlpStopped && strings.HasPrefix(message, binlogplayer.DDLAwaitingApproval) {
+		return strings.TrimPrefix(message, binlogplayer.DDLAwaitingApproval)
+	}
+
+	return ""
+}
+
+// GetWorkflowStatus returns the vreplication status of a workflow. It fails
+// with NOT_FOUND if no target primary has streams for it.
+func (s *Server) GetWorkflowStatus(ctx context.Context, req *vtctldatapb.GetWorkflowStatusRequest) (*vtctldatapb.GetWorkflowStatusResponse, error) {
+	if err := validateWorkflowRequest(req.Keyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+
+	statuses, err := s.ReadWorkflowStatuses(ctx, req.Keyspace, req.Workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(statuses) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "no streams found for workflow %s in keyspace %s", req.Workflow, req.Keyspace)
+	}
+
+	return &vtctldatapb.GetWorkflowStatusResponse{
+		Status: statuses[0],
+	}, nil
+}
+
+// GetWorkflowStatuses returns the vreplication status of all the workflows
+// with streams in a keyspace, sorted by name.
+func (s *Server) GetWorkflowStatuses(ctx context.Context, req *vtctldatapb.GetWorkflowStatusesRequest) (*vtctldatapb.GetWorkflowStatusesResponse, error) {
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+
+	statuses, err := s.ReadWorkflowStatuses(ctx, req.Keyspace, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ActiveOnly {
+		active := statuses[:0]
+		for _, status := range statuses {
+			if isActive(status) {
+				active = append(active, status)
+			}
+		}
+		statuses = active
+	}
+
+	return &vtctldatapb.GetWorkflowStatusesResponse{
+		Statuses: statuses,
+	}, nil
+}
+
+// isActive returns true if any stream of the workflow is not stopped.
+func isActive(status *vtctldatapb.WorkflowStatus) bool {
+	for _, stream := range status.Streams {
+		if stream.State != binlogplayer.BlpStopped {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadWorkflowStatuses reads the streams of the primaries of every shard of a
+// keyspace, and groups them by workflow. If workflow is non-empty, only the
+// streams of that workflow are read. The statuses are sorted by name.
+func (s *Server) ReadWorkflowStatuses(ctx context.Context, keyspace, workflow string) ([]*vtctldatapb.WorkflowStatus, error) {
+	shards, err := s.ts.FindAllShardsInKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(shards))
+	for name := range shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	now := time.Now()
+	statuses := make(map[string]*vtctldatapb.WorkflowStatus)
+	sourceShards := make(map[string]sets.String)
+	for _, shard := range names {
+		si := shards[shard]
+		if !si.HasMaster() {
+			continue
+		}
+		ti, err := s.ts.GetTablet(ctx, si.MasterAlias)
+		if err != nil {
+			return nil, err
+		}
+
+		streams, err := s.readStreamStatuses(ctx, ti.Tablet, workflow)
+		if err != nil {
+			return nil, err
+		}
+
+		targets := sets.NewString()
+		for _, stream := range streams {
+			name := stream.workflow
+			status, ok := statuses[name]
+			if !ok {
+				status = &vtctldatapb.WorkflowStatus{
+					Name:           name,
+					TargetKeyspace: keyspace,
+				}
+				statuses[name] = status
+				sourceShards[name] = sets.NewString()
+			}
+			if !targets.Has(name) {
+				status.TargetShards = append(status.TargetShards, si.ShardName())
+				targets.Insert(name)
+			}
+
+			status.SourceKeyspace = stream.BinlogSource.Keyspace
+			sourceShards[name].Insert(stream.BinlogSource.Shard)
+			status.Streams = append(status.Streams, stream.WorkflowStatus_Stream)
+
+			if lag := int64(now.Sub(logutil.ProtoToTime(stream.TimeUpdated)).Seconds()); lag > status.MaxVReplicationLag {
+				status.MaxVReplicationLag = lag
+			}
+		}
+	}
+
+	result := make([]*vtctldatapb.WorkflowStatus, 0, len(statuses))
+	for name, status := range statuses {
+		status.SourceShards = sourceShards[name].List()
+		result = append(result, status)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// namedStream is a stream along with the workflow it belongs to.
+type namedStream struct {
+	*vtctldatapb.WorkflowStatus_Stream
+	workflow string
+}
+
+// readStreamStatuses reads the streams of a primary tablet, along with their
+// copy state. If workflow is non-empty, only the streams of that workflow are
+// read.
+func (s *Server) readStreamStatuses(ctx context.Context, primary *topodatapb.Tablet, workflow string) ([]*namedStream, error) {
+	alias := topoproto.TabletAliasString(primary.Alias)
+
+	query := fmt.Sprintf(streamStatusesQuery, encodeString(topoproto.TabletDbName(primary)))
+	if workflow != "" {
+		query += " and workflow = " + encodeString(workflow)
+	}
+	p3qr, err := s.tmc.VReplicationExec(ctx, primary, query)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "VReplicationExec(%v, %s)", alias, query)
+	}
+	qr := sqltypes.ToNamedResult(sqltypes.Proto3ToResult(p3qr))
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+
+	streams := make([]*namedStream, 0, len(qr.Rows))
+	byID := make(map[int64]*namedStream, len(qr.Rows))
+	ids := make([]string, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		id, err := row.ToInt64("id")
+		if err != nil {
+			return nil, err
+		}
+		bls := &binlogdatapb.BinlogSource{}
+		if err := proto.UnmarshalText(row.AsString("source", ""), bls); err != nil {
+			return nil, vterrors.Wrapf(err, "bad source for stream %d on %v", id, alias)
+		}
+
+		stream := &namedStream{
+			WorkflowStatus_Stream: &vtctldatapb.WorkflowStatus_Stream{
+				Id:                   id,
+				Shard:                primary.Shard,
+				Tablet:               primary.Alias,
+				BinlogSource:         bls,
+				Position:             row.AsString("pos", ""),
+				StopPosition:         row.AsString("stop_pos", ""),
+				State:                row.AsString("state", ""),
+				DbName:               row.AsString("db_name", ""),
+				TransactionTimestamp: logutil.TimeToProto(time.Unix(row.AsInt64("transaction_timestamp", 0), 0)),
+				TimeUpdated:          logutil.TimeToProto(time.Unix(row.AsInt64("time_updated", 0), 0)),
+				Message:              row.AsString("message", ""),
+			},
+			workflow: row.AsString("workflow", ""),
+		}
+		stream.PendingDdl = PendingDDL(stream.State, stream.Message)
+
+		streams = append(streams, stream)
+		byID[id] = stream
+		ids = append(ids, fmt.Sprintf("%d", id))
+	}
+
+	query = fmt.Sprintf(copyStatesQuery, strings.Join(ids, ", "))
+	p3qr, err = s.tmc.VReplicationExec(ctx, primary, query)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "VReplicationExec(%v, %s)", alias, query)
+	}
+	for _, row := range sqltypes.ToNamedResult(sqltypes.Proto3ToResult(p3qr)).Rows {
+		stream, ok := byID[row.AsInt64("vrepl_id", 0)]
+		if !ok {
+			continue
+		}
+		stream.CopyStates = append(stream.CopyStates, &vtctldatapb.WorkflowStatus_CopyState{
+			Table:  row.AsString("table_name", ""),
+			LastPk: row.AsString("lastpk", ""),
+		})
+	}
+
+	for _, stream := range streams {
+		stream.State = StreamState(stream.Message, stream.State, len(stream.CopyStates) > 0, logutil.ProtoToTime(stream.TimeUpdated).Unix())
+	}
+	return streams, nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestStreamState(t *testing.T) {
+	now := time.Now().Unix()
+
+	assert.Equal(t, "Running", StreamState("for vdiff", "Running", false, now))
+	assert.Equal(t, "Lagging", StreamState("", "Running", false, now-100))
+	assert.Equal(t, "Copying", StreamState("", "Running", true, now))
+	assert.Equal(t, "Error", StreamState("error: master tablet not contactable", "Running", false, 0))
+	assert.Equal(t, "Stopped", StreamState("", "Stopped", false, 0))
+
+	assert.Equal(t, "alter table t1 add column c2 int", PendingDDL("Stopped", "DDL awaiting approval: alter table t1 add column c2 int"))
+	assert.Equal(t, "", PendingDDL("Running", "DDL awaiting approval: alter table t1 add column c2 int"))
+}
+
+const (
+	testStreamStatusesQuery = "select id, workflow, source, pos, stop_pos, state, db_name, time_updated, transaction_timestamp, message from _vt.vreplication where db_name = 'vt_ks2'"
+	testCopyStatesQuery     = "select vrepl_id, table_name, lastpk from _vt.copy_state where vrepl_id in (%s)"
+)
+
+func TestGetWorkflowStatuses(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	addPrimary(ctx, t, ts, "ks2", 200)
+
+	now := time.Now().Unix()
+	streamFields := sqltypes.MakeTestFields(
+		"id|workflow|source|pos|stop_pos|state|db_name|time_updated|transaction_timestamp|message",
+		"int64|varchar|varchar|varchar|varchar|varchar|varchar|int64|int64|varchar")
+	copyFields := sqltypes.MakeTestFields("vrepl_id|table_name|lastpk", "int64|varchar|varchar")
+	tmc := &fakeTMClient{
+		results: map[uint32]map[string]*sqltypes.Result{
+			200: {
+				testStreamStatusesQuery: sqltypes.MakeTestResult(streamFields,
+					fmt.Sprintf(`1|wf1|keyspace:"ks1" shard:"-80" |MySQL56/a:1-10||Running|vt_ks2|%d|%d|`, now-5, now-6),
+					fmt.Sprintf(`2|wf1|keyspace:"ks1" shard:"80-" |MySQL56/a:1-10||Running|vt_ks2|%d|%d|error: source unreachable`, now-30, now-31),
+					fmt.Sprintf(`3|wf2|keyspace:"ks3" shard:"0" |MySQL56/b:1-5||Stopped|vt_ks2|%d|%d|DDL awaiting approval: drop table t2`, now, now),
+				),
+				fmt.Sprintf(testCopyStatesQuery, "1, 2, 3"): sqltypes.MakeTestResult(copyFields, "1|t1|[[INT64(10)]]"),
+				testStreamStatusesQuery + " and workflow = 'wf2'": sqltypes.MakeTestResult(streamFields,
+					fmt.Sprintf(`3|wf2|keyspace:"ks3" shard:"0" |MySQL56/b:1-5||Stopped|vt_ks2|%d|%d|DDL awaiting approval: drop table t2`, now, now),
+				),
+				fmt.Sprintf(testCopyStatesQuery, "3"):              sqltypes.MakeTestResult(copyFields),
+				testStreamStatusesQuery + " and workflow = 'nope'": sqltypes.MakeTestResult(streamFields),
+			},
+		},
+	}
+	s := NewServer(ts, tmc)
+
+	resp, err := s.GetWorkflowStatuses(ctx, &vtctldatapb.GetWorkflowStatusesRequest{Keyspace: "ks2"})
+	require.NoError(t, err)
+	require.Len(t, resp.Statuses, 2)
+
+	wf1 := resp.Statuses[0]
+	assert.Equal(t, "wf1", wf1.Name)
+	assert.Equal(t, "ks1", wf1.SourceKeyspace)
+	assert.Equal(t, []string{"-80", "80-"}, wf1.SourceShards)
+	assert.Equal(t, "ks2", wf1.TargetKeyspace)
+	assert.Equal(t, []string{"0"}, wf1.TargetShards)
+	assert.InDelta(t, 30, wf1.MaxVReplicationLag, 2)
+	require.Len(t, wf1.Streams, 2)
+	assert.Equal(t, "Copying", wf1.Streams[0].State)
+	assert.Equal(t, []*vtctldatapb.WorkflowStatus_CopyState{{Table: "t1", LastPk: "[[INT64(10)]]"}}, wf1.Streams[0].CopyStates)
+	assert.Equal(t, "MySQL56/a:1-10", wf1.Streams[0].Position)
+	assert.EqualValues(t, 200, wf1.Streams[0].Tablet.Uid)
+	assert.Equal(t, "Error", wf1.Streams[1].State)
+	assert.Equal(t, "error: source unreachable", wf1.Streams[1].Message)
+
+	wf2 := resp.Statuses[1]
+	assert.Equal(t, "wf2", wf2.Name)
+	require.Len(t, wf2.Streams, 1)
+	assert.Equal(t, "Stopped", wf2.Streams[0].State)
+	assert.Equal(t, "drop table t2", wf2.Streams[0].PendingDdl)
+
+	resp, err = s.GetWorkflowStatuses(ctx, &vtctldatapb.GetWorkflowStatusesRequest{Keyspace: "ks2", ActiveOnly: true})
+	require.NoError(t, err)
+	require.Len(t, resp.Statuses, 1)
+	assert.Equal(t, "wf1", resp.Statuses[0].Name)
+
+	sresp, err := s.GetWorkflowStatus(ctx, &vtctldatapb.GetWorkflowStatusRequest{Keyspace: "ks2", Workflow: "wf2"})
+	require.NoError(t, err)
+	assert.Equal(t, "wf2", sresp.Status.Name)
+	assert.Equal(t, "ks3", sresp.Status.SourceKeyspace)
+
+	_, err = s.GetWorkflowStatus(ctx, &vtctldatapb.GetWorkflowStatusRequest{Keyspace: "ks2", Workflow: "nope"})
+	assert.Equal(t, vtrpcpb.Code_NOT_FOUND, vterrors.Code(err))
+
+	_, err = s.GetWorkflowStatuses(ctx, &vtctldatapb.GetWorkflowStatusesRequest{})
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+}
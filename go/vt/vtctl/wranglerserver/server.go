@@ -0,0 +1,281 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package wranglerserver implements the Vtctld RPCs that are still built on top
+of the wrangler, for use as a grpcvtctldserver.WranglerServer.
+
+The wrangler imports grpcvtctldserver, so these RPCs cannot live there. The
+VtctldServer validates each request before handing it to this package.
+*/
+package wranglerserver
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemamanager"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Server implements grpcvtctldserver.WranglerServer, creating a new wrangler
+// for every request so the log events of each request can be returned in its
+// response.
+type Server struct {
+	ts  *topo.Server
+	tmc tmclient.TabletManagerClient
+}
+
+// New returns a new Server for the given topo server and tablet manager
+// client.
+func New(ts *topo.Server, tmc tmclient.TabletManagerClient) *Server {
+	return &Server{
+		ts:  ts,
+		tmc: tmc,
+	}
+}
+
+// newWrangler returns a wrangler whose log events are written to the vtctld
+// log as well as recorded in the returned MemoryLogger.
+func (s *Server) newWrangler() (*wrangler.Wrangler, *logutil.MemoryLogger) {
+	logger := logutil.NewMemoryLogger()
+	return wrangler.New(logutil.NewTeeLogger(logger, logutil.NewConsoleLogger()), s.ts, s.tmc), logger
+}
+
+func events(logger *logutil.MemoryLogger) []*logutilpb.Event {
+	events := make([]*logutilpb.Event, len(logger.Events))
+	copy(events, logger.Events)
+
+	return events
+}
+
+// eventsError attaches the log events of a failed request to its error,
+// since there is no response to return them in.
+func eventsError(err error, logger *logutil.MemoryLogger) error {
+	if len(events(logger)) == 0 {
+		return err
+	}
+
+	return vterrors.Errorf(vterrors.Code(err), "%v\nlog events:\n%s", err, logger.String())
+}
+
+// resultController is a PlainController that keeps the ExecuteResult of the
+// schema change, which schemamanager.Run does not return.
+type resultController struct {
+	*schemamanager.PlainController
+	result *schemamanager.ExecuteResult
+}
+
+// OnExecutorComplete is part of the schemamanager.Controller interface.
+func (c *resultController) OnExecutorComplete(ctx context.Context, result *schemamanager.ExecuteResult) error {
+	c.result = result
+	return c.PlainController.OnExecutorComplete(ctx, result)
+}
+
+// ApplySchema is part of the grpcvtctldserver.WranglerServer interface.
+func (s *Server) ApplySchema(ctx context.Context, req *vtctldatapb.ApplySchemaRequest) (*vtctldatapb.ApplySchemaResponse, error) {
+	waitReplicasTimeout, ok, err := protoutil.DurationFromProto(req.WaitReplicasTimeout)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		waitReplicasTimeout = wrangler.DefaultWaitReplicasTimeout
+	}
+
+	ddlStrategy := req.DdlStrategy
+	if ddlStrategy == "" {
+		ddlStrategy = string(schema.DDLStrategyDirect)
+	}
+
+	executionUUID, err := schema.CreateUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	wr, logger := s.newWrangler()
+
+	executor := schemamanager.NewTabletExecutor(fmt.Sprintf("vtctld:%s", executionUUID), wr, waitReplicasTimeout)
+	if req.AllowLongUnavailability {
+		executor.AllowBigSchemaChange()
+	}
+
+	if err := executor.SetDDLStrategy(ddlStrategy); err != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid ddl_strategy %q: %v", ddlStrategy, err)
+	}
+
+	controller := &resultController{
+		PlainController: schemamanager.NewPlainController(strings.Join(req.Sql, ";"), req.Keyspace),
+	}
+
+	if err := schemamanager.Run(ctx, controller, executor); err != nil {
+		return nil, eventsError(err, logger)
+	}
+
+	resp := &vtctldatapb.ApplySchemaResponse{
+		Events: events(logger),
+	}
+
+	if controller.result != nil {
+		resp.UuidList = controller.result.UUIDs
+	}
+
+	return resp, nil
+}
+
+// MoveTables is part of the grpcvtctldserver.WranglerServer interface.
+func (s *Server) MoveTables(ctx context.Context, req *vtctldatapb.MoveTablesRequest) (*vtctldatapb.MoveTablesResponse, error) {
+	wr, logger := s.newWrangler()
+
+	err := wr.MoveTables(ctx,
+		req.Workflow,
+		req.SourceKeyspace,
+		req.TargetKeyspace,
+		strings.Join(req.IncludeTables, ","),
+		strings.Join(req.Cells, ","),
+		strings.Join(topoproto.MakeStringTypeList(req.TabletTypes), ","),
+		req.AllTables,
+		strings.Join(req.ExcludeTables, ","),
+		req.AutoStart,
+		req.StopAfterCopy,
+		req.ExternalClusterName,
+	)
+	if err != nil {
+		return nil, eventsError(err, logger)
+	}
+
+	return &vtctldatapb.MoveTablesResponse{
+		Events: events(logger),
+	}, nil
+}
+
+// Reshard is part of the grpcvtctldserver.WranglerServer interface.
+func (s *Server) Reshard(ctx context.Context, req *vtctldatapb.ReshardRequest) (*vtctldatapb.ReshardResponse, error) {
+	wr, logger := s.newWrangler()
+
+	err := wr.Reshard(ctx,
+		req.Keyspace,
+		req.Workflow,
+		req.SourceShards,
+		req.TargetShards,
+		req.SkipSchemaCopy,
+		strings.Join(req.Cells, ","),
+		strings.Join(topoproto.MakeStringTypeList(req.TabletTypes), ","),
+		req.AutoStart,
+		req.StopAfterCopy,
+	)
+	if err != nil {
+		return nil, eventsError(err, logger)
+	}
+
+	return &vtctldatapb.ReshardResponse{
+		Events: events(logger),
+	}, nil
+}
+
+// VDiff is part of the grpcvtctldserver.WranglerServer interface.
+func (s *Server) VDiff(ctx context.Context, req *vtctldatapb.VDiffRequest) (*vtctldatapb.VDiffResponse, error) {
+	filteredReplicationWaitTime, ok, err := protoutil.DurationFromProto(req.FilteredReplicationWaitTime)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		filteredReplicationWaitTime = 30 * time.Second
+	}
+
+	tabletTypes := "master,replica,rdonly"
+	if len(req.TabletTypes) > 0 {
+		tabletTypes = strings.Join(topoproto.MakeStringTypeList(req.TabletTypes), ",")
+	}
+
+	maxRows := req.MaxRows
+	if maxRows <= 0 {
+		maxRows = math.MaxInt64
+	}
+
+	wr, logger := s.newWrangler()
+
+	reports, err := wr.VDiff(ctx,
+		req.Keyspace,
+		req.Workflow,
+		req.SourceCell,
+		req.TargetCell,
+		tabletTypes,
+		filteredReplicationWaitTime,
+		"",
+		maxRows,
+		strings.Join(req.Tables, ","),
+	)
+	if err != nil {
+		return nil, eventsError(err, logger)
+	}
+
+	resp := &vtctldatapb.VDiffResponse{
+		Reports: make(map[string]*vtctldatapb.VDiffReport, len(reports)),
+	}
+
+	for table, report := range reports {
+		resp.Reports[table] = &vtctldatapb.VDiffReport{
+			ProcessedRows:   int64(report.ProcessedRows),
+			MatchingRows:    int64(report.MatchingRows),
+			MismatchedRows:  int64(report.MismatchedRows),
+			ExtraRowsSource: int64(report.ExtraRowsSource),
+			ExtraRowsTarget: int64(report.ExtraRowsTarget),
+		}
+	}
+
+	return resp, nil
+}
+
+// WorkflowAction is part of the grpcvtctldserver.WranglerServer interface.
+func (s *Server) WorkflowAction(ctx context.Context, req *vtctldatapb.WorkflowActionRequest) (*vtctldatapb.WorkflowActionResponse, error) {
+	wr, logger := s.newWrangler()
+
+	results, err := wr.WorkflowAction(ctx, req.Workflow, req.Keyspace, req.Action, req.DryRun)
+	if err != nil {
+		return nil, eventsError(err, logger)
+	}
+
+	resp := &vtctldatapb.WorkflowActionResponse{
+		RowsAffectedByTablet: make(map[string]uint64, len(results)),
+	}
+
+	for tablet, result := range results {
+		resp.RowsAffectedByTablet[tablet.AliasString()] = result.RowsAffected
+	}
+
+	if req.DryRun {
+		for _, e := range events(logger) {
+			resp.DryRunResults = append(resp.DryRunResults, e.Value)
+		}
+	}
+
+	return resp, nil
+}
+
+var _ grpcvtctldserver.WranglerServer = (*Server)(nil)
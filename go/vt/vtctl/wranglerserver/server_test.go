@@ -0,0 +1,437 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wranglerserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver/testutil"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func init() {
+	// The wrangler creates a VtctldServer, which needs a tmclient to be
+	// registered, but it's not used by these tests.
+	*tmclient.TabletManagerProtocol = "wranglerserver.test"
+	tmclient.RegisterTabletManagerClientFactory("wranglerserver.test", func() tmclient.TabletManagerClient {
+		return nil
+	})
+}
+
+var testMaster = &topodatapb.Tablet{
+	Alias: &topodatapb.TabletAlias{
+		Cell: "zone1",
+		Uid:  100,
+	},
+	Keyspace: "testkeyspace",
+	Shard:    "-",
+	Type:     topodatapb.TabletType_MASTER,
+}
+
+func TestApplySchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		tmc  *testutil.TabletManagerClient
+		req  *vtctldatapb.ApplySchemaRequest
+		// expectedEvents are the log events that the response, or the
+		// error if shouldErr is set, contains.
+		expectedEvents []string
+		shouldErr      bool
+	}{
+		{
+			name: "online ddl",
+			tmc: &testutil.TabletManagerClient{
+				GetSchemaResults: map[string]struct {
+					Schema *tabletmanagerdatapb.SchemaDefinition
+					Error  error
+				}{
+					"zone1-0000000100": {
+						Schema: &tabletmanagerdatapb.SchemaDefinition{},
+					},
+				},
+				PreflightSchemaResults: map[string]struct {
+					ChangeResults []*tabletmanagerdatapb.SchemaChangeResult
+					Error         error
+				}{
+					"zone1-0000000100": {},
+				},
+			},
+			req: &vtctldatapb.ApplySchemaRequest{
+				Keyspace:    "testkeyspace",
+				Sql:         []string{"alter table t add column c int"},
+				DdlStrategy: "gh-ost",
+			},
+			expectedEvents: []string{"Received DDL request. strategy=gh-ost"},
+		},
+		{
+			name: "big schema change",
+			tmc: &testutil.TabletManagerClient{
+				GetSchemaResults: map[string]struct {
+					Schema *tabletmanagerdatapb.SchemaDefinition
+					Error  error
+				}{
+					"zone1-0000000100": {
+						Schema: &tabletmanagerdatapb.SchemaDefinition{
+							TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+								{
+									Name:     "t",
+									RowCount: 200000,
+								},
+							},
+						},
+					},
+				},
+				PreflightSchemaResults: map[string]struct {
+					ChangeResults []*tabletmanagerdatapb.SchemaChangeResult
+					Error         error
+				}{
+					"zone1-0000000100": {
+						Error: assert.AnError,
+					},
+				},
+			},
+			req: &vtctldatapb.ApplySchemaRequest{
+				Keyspace:                "testkeyspace",
+				Sql:                     []string{"alter table t add column c int"},
+				AllowLongUnavailability: true,
+			},
+			expectedEvents: []string{"Processing big schema change"},
+			shouldErr:      true,
+		},
+		{
+			name: "no master",
+			tmc:  &testutil.TabletManagerClient{},
+			req: &vtctldatapb.ApplySchemaRequest{
+				Keyspace: "otherkeyspace",
+				Sql:      []string{"alter table t add column c int"},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "invalid ddl strategy",
+			tmc:  &testutil.TabletManagerClient{},
+			req: &vtctldatapb.ApplySchemaRequest{
+				Keyspace:    "testkeyspace",
+				Sql:         []string{"alter table t add column c int"},
+				DdlStrategy: "unknown",
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := memorytopo.NewServer("zone1")
+			testutil.AddTablet(ctx, t, ts, testMaster, &testutil.AddTabletOptions{AlsoSetShardMaster: true})
+			testutil.AddShards(ctx, t, ts, &vtctldatapb.Shard{Keyspace: "otherkeyspace", Name: "-"})
+
+			resp, err := New(ts, tt.tmc).ApplySchema(ctx, tt.req)
+			if tt.shouldErr {
+				require.Error(t, err)
+				for _, event := range tt.expectedEvents {
+					assert.Contains(t, err.Error(), event)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, resp.UuidList, 1)
+			assert.Contains(t, eventsString(resp.Events), resp.UuidList[0])
+			for _, event := range tt.expectedEvents {
+				assert.Contains(t, eventsString(resp.Events), event)
+			}
+		})
+	}
+}
+
+func TestMoveTables(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddTablet(ctx, t, ts, testMaster, &testutil.AddTabletOptions{AlsoSetShardMaster: true})
+
+	err := ts.SaveVSchema(ctx, "testkeyspace", &vschemapb.Keyspace{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		req      *vtctldatapb.MoveTablesRequest
+		expected string
+	}{
+		{
+			name: "no target keyspace",
+			req: &vtctldatapb.MoveTablesRequest{
+				Workflow:       "wf",
+				SourceKeyspace: "testkeyspace",
+				TargetKeyspace: "nokeyspace",
+				IncludeTables:  []string{"t1"},
+			},
+			expected: "node doesn't exist",
+		},
+		{
+			name: "no tables",
+			req: &vtctldatapb.MoveTablesRequest{
+				Workflow:       "wf",
+				SourceKeyspace: "testkeyspace",
+				TargetKeyspace: "testkeyspace",
+			},
+			expected: "no tables to move",
+		},
+		{
+			name: "missing table",
+			req: &vtctldatapb.MoveTablesRequest{
+				Workflow:       "wf",
+				SourceKeyspace: "testkeyspace",
+				TargetKeyspace: "testkeyspace",
+				IncludeTables:  []string{"t2"},
+			},
+			expected: "t2",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(ts, &testutil.TabletManagerClient{
+				GetSchemaResults: map[string]struct {
+					Schema *tabletmanagerdatapb.SchemaDefinition
+					Error  error
+				}{
+					"zone1-0000000100": {
+						Schema: &tabletmanagerdatapb.SchemaDefinition{
+							TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+								{
+									Name: "t1",
+								},
+							},
+						},
+					},
+				},
+			}).MoveTables(ctx, tt.req)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+		})
+	}
+}
+
+func TestReshard(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddTablet(ctx, t, ts, testMaster, &testutil.AddTabletOptions{AlsoSetShardMaster: true})
+
+	tests := []struct {
+		name string
+		req  *vtctldatapb.ReshardRequest
+	}{
+		{
+			name: "no source shard",
+			req: &vtctldatapb.ReshardRequest{
+				Keyspace:     "testkeyspace",
+				Workflow:     "wf",
+				SourceShards: []string{"-80"},
+				TargetShards: []string{"-40", "40-80"},
+			},
+		},
+		{
+			name: "no keyspace",
+			req: &vtctldatapb.ReshardRequest{
+				Keyspace:     "nokeyspace",
+				Workflow:     "wf",
+				SourceShards: []string{"-"},
+				TargetShards: []string{"-80", "80-"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(ts, &testutil.TabletManagerClient{}).Reshard(ctx, tt.req)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestVDiff(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddTablet(ctx, t, ts, testMaster, &testutil.AddTabletOptions{AlsoSetShardMaster: true})
+
+	_, err := New(ts, &testutil.TabletManagerClient{
+		VReplicationExecResults: map[string]map[string]struct {
+			Result *querypb.QueryResult
+			Error  error
+		}{
+			"zone1-0000000100": {},
+		},
+	}).VDiff(ctx, &vtctldatapb.VDiffRequest{
+		Keyspace: "testkeyspace",
+		Workflow: "wf",
+	})
+	require.Error(t, err)
+	// The error logged by the wrangler is attached to the error.
+	assert.Contains(t, err.Error(), "buildTrafficSwitcher")
+}
+
+func TestWorkflowAction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddTablet(ctx, t, ts, testMaster, &testutil.AddTabletOptions{AlsoSetShardMaster: true})
+
+	streams := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"id|workflow|source|pos|stop_pos|state|db_name|time_updated|transaction_timestamp|message",
+		"int64|varchar|varchar|varchar|varchar|varchar|varchar|int64|int64|varchar"),
+		`1|wf|keyspace:"sourcekeyspace" shard:"-" filter:<rules:<match:"t1" > > |MySQL56/uuid:1-10||Running|vt_testkeyspace|1|0|`,
+	)
+	tmc := &testutil.TabletManagerClient{
+		VReplicationExecResults: map[string]map[string]struct {
+			Result *querypb.QueryResult
+			Error  error
+		}{
+			"zone1-0000000100": {
+				"update _vt.vreplication set state = 'Stopped' where db_name = 'vt_testkeyspace' and workflow = 'wf'": {
+					Result: &querypb.QueryResult{RowsAffected: 1},
+				},
+				"update _vt.vreplication set state = 'Running' where db_name = 'vt_testkeyspace' and workflow = 'wf'": {
+					Error: assert.AnError,
+				},
+				"select id, workflow, source, pos, stop_pos, state, db_name, time_updated, transaction_timestamp, message from _vt.vreplication where db_name = 'vt_testkeyspace' and workflow = 'wf'": {
+					Result: sqltypes.ResultToProto3(streams),
+				},
+				"select vrepl_id, table_name, lastpk from _vt.copy_state where vrepl_id in (1)": {
+					Result: &querypb.QueryResult{},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.WorkflowActionRequest
+		expected  *vtctldatapb.WorkflowActionResponse
+		shouldErr bool
+	}{
+		{
+			name: "stop",
+			req: &vtctldatapb.WorkflowActionRequest{
+				Keyspace: "testkeyspace",
+				Workflow: "wf",
+				Action:   "stop",
+			},
+			expected: &vtctldatapb.WorkflowActionResponse{
+				RowsAffectedByTablet: map[string]uint64{
+					"zone1-0000000100": 1,
+				},
+			},
+		},
+		{
+			name: "start fails",
+			req: &vtctldatapb.WorkflowActionRequest{
+				Keyspace: "testkeyspace",
+				Workflow: "wf",
+				Action:   "start",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "no keyspace",
+			req: &vtctldatapb.WorkflowActionRequest{
+				Keyspace: "nokeyspace",
+				Workflow: "wf",
+				Action:   "stop",
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := New(ts, tmc).WorkflowAction(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := New(ts, tmc).WorkflowAction(ctx, &vtctldatapb.WorkflowActionRequest{
+			Keyspace: "testkeyspace",
+			Workflow: "wf",
+			Action:   "delete",
+			DryRun:   true,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.RowsAffectedByTablet)
+		require.NotEmpty(t, resp.DryRunResults)
+		assert.Contains(t, resp.DryRunResults[0], "delete from _vt.vreplication where db_name = 'vt_testkeyspace' and workflow = 'wf'")
+	})
+}
+
+func eventsString(events []*logutilpb.Event) string {
+	values := make([]string, len(events))
+	for i, e := range events {
+		values[i] = e.Value
+	}
+
+	return strings.Join(values, "\n")
+}
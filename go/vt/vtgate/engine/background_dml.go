@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*BackgroundDML)(nil)
+
+const sqlInsertDMLJob = `insert into _vt.dml_jobs (job_uuid, keyspace, shard, mysql_table, job_statement, job_status) values (%a, %a, %a, %a, %a, %a)`
+
+// BackgroundDML submits an UPDATE or DELETE statement as a background DML job to every shard
+// of the target. Each shard's primary then runs the statement in primary key ordered batches,
+// checking the tablet throttler in between batches.
+type BackgroundDML struct {
+	// Keyspace specifies the keyspace to submit the job to.
+	Keyspace *vindexes.Keyspace
+
+	// TargetDestination specifies the shards to submit the job to.
+	TargetDestination key.Destination
+
+	// TableName is the name of the table the statement operates on.
+	TableName string
+
+	// Query is the statement the job runs. It is bound with the bind variables
+	// of the execution before being submitted.
+	Query *sqlparser.ParsedQuery
+
+	txNeeded
+
+	noInputs
+}
+
+// RouteType implements the Primitive interface
+func (b *BackgroundDML) RouteType() string {
+	return "BackgroundDML"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (b *BackgroundDML) GetKeyspaceName() string {
+	return b.Keyspace.Name
+}
+
+// GetTableName implements the Primitive interface
+func (b *BackgroundDML) GetTableName() string {
+	return b.TableName
+}
+
+// Execute implements the Primitive interface
+func (b *BackgroundDML) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	rss, _, err := vcursor.ResolveDestinations(b.Keyspace.Name, nil, []key.Destination{b.TargetDestination})
+	if err != nil {
+		return nil, err
+	}
+	statement, err := b.Query.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return nil, err
+	}
+	uuid, err := schema.CreateDMLJobUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i, rs := range rss {
+		query, err := sqlparser.ParseAndBind(sqlInsertDMLJob,
+			sqltypes.StringBindVariable(uuid),
+			sqltypes.StringBindVariable(b.Keyspace.Name),
+			sqltypes.StringBindVariable(rs.Target.Shard),
+			sqltypes.StringBindVariable(b.TableName),
+			sqltypes.StringBindVariable(statement),
+			sqltypes.StringBindVariable(string(schema.DMLJobStatusQueued)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		queries[i] = &querypb.BoundQuery{Sql: query}
+	}
+
+	canAutocommit := len(rss) == 1 && vcursor.AutocommitApproval()
+	_, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, canAutocommit)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{
+				Name: "uuid",
+				Type: sqltypes.VarChar,
+			},
+		},
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarChar(uuid)},
+		},
+	}, nil
+}
+
+// StreamExecute implements the Primitive interface
+func (b *BackgroundDML) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := b.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (b *BackgroundDML) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] GetFields is not reachable")
+}
+
+func (b *BackgroundDML) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType:      "BackgroundDML",
+		Keyspace:          b.Keyspace,
+		TargetDestination: b.TargetDestination,
+		Other: map[string]interface{}{
+			"Query": b.Query.Query,
+			"Table": b.TableName,
+		},
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestBackgroundDMLExecute(t *testing.T) {
+	stmt, err := sqlparser.Parse("delete from events where created_at < :vtg1")
+	require.NoError(t, err)
+	bdml := &BackgroundDML{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		TargetDestination: key.DestinationAllShards{},
+		TableName:         "events",
+		Query:             sqlparser.NewParsedQuery(stmt),
+	}
+	bindVars := map[string]*querypb.BindVariable{
+		"vtg1": sqltypes.StringBindVariable("2020-01-01"),
+	}
+
+	vc := &loggingVCursor{shards: []string{"-20", "20-"}}
+	result, err := bdml.Execute(vc, bindVars, false)
+	require.NoError(t, err)
+	require.Len(t, result.Rows, 1)
+
+	uuid := result.Rows[0][0].ToString()
+	assert.True(t, schema.IsOnlineDDLUUID(uuid))
+
+	insert := func(shard string) string {
+		return fmt.Sprintf("insert into _vt.dml_jobs (job_uuid, keyspace, shard, mysql_table, job_statement, job_status) "+
+			"values ('%s', 'ks', '%s', 'events', 'delete from events where created_at < \\'2020-01-01\\'', 'queued')", uuid, shard)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		fmt.Sprintf(`ExecuteMultiShard ks.-20: %s {} ks.20-: %s {} true false`, insert("-20"), insert("20-")),
+	})
+
+	vc = &loggingVCursor{shards: []string{"-20", "20-"}, multiShardErrs: []error{fmt.Errorf("table _vt.dml_jobs doesn't exist")}}
+	_, err = bdml.Execute(vc, bindVars, false)
+	assert.EqualError(t, err, "table _vt.dml_jobs doesn't exist")
+}
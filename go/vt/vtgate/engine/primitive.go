@@ -142,6 +142,9 @@ type (
 		SetReadAfterWriteTimeout(float64)
 		SetSessionTrackGTIDs(bool)
 
+		// SetMaxReplicaLag restricts replica reads to tablets reporting a replication lag within the given bound
+		SetMaxReplicaLag(time.Duration)
+
 		// HasCreatedTempTable will mark the session as having created temp tables
 		HasCreatedTempTable()
 	}
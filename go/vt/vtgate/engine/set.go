@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"vitess.io/vitess/go/vt/sysvars"
 
@@ -434,6 +435,12 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 			return err
 		}
 		vcursor.Session().SetReadAfterWriteTimeout(val)
+	case sysvars.MaxReplicaLag.Name:
+		maxLag, err := svss.evalAsDuration(env)
+		if err != nil {
+			return err
+		}
+		vcursor.Session().SetMaxReplicaLag(maxLag)
 	case sysvars.SessionTrackGTIDs.Name:
 		str, err := svss.evalAsString(env)
 		if err != nil {
@@ -498,6 +505,32 @@ func (svss *SysVarSetAware) evalAsString(env evalengine.ExpressionEnv) (string,
 	return v.ToString(), nil
 }
 
+// evalAsDuration evaluates the expression as a duration, either given as a string such as '2s', or as a number of seconds
+func (svss *SysVarSetAware) evalAsDuration(env evalengine.ExpressionEnv) (time.Duration, error) {
+	value, err := svss.Expr.Evaluate(env)
+	if err != nil {
+		return 0, err
+	}
+	v := value.Value()
+	var duration time.Duration
+	if v.IsText() || v.IsBinary() {
+		duration, err = time.ParseDuration(v.ToString())
+		if err != nil {
+			return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "Variable '%s' can't be set to the value of '%s'", svss.Name, v.ToString())
+		}
+	} else {
+		seconds, err := v.ToFloat64()
+		if err != nil {
+			return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongTypeForVar, "Incorrect argument type to variable '%s': %s", svss.Name, v.Type().String())
+		}
+		duration = time.Duration(seconds * float64(time.Second))
+	}
+	if duration < 0 {
+		return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "Variable '%s' can't be set to the value of '%s'", svss.Name, v.ToString())
+	}
+	return duration, nil
+}
+
 func (svss *SysVarSetAware) setBoolSysVar(env evalengine.ExpressionEnv, setter func(bool) error) error {
 	value, err := svss.Expr.Evaluate(env)
 	if err != nil {
@@ -310,6 +310,8 @@ func (e *Executor) addNeededBindVars(bindVarNeeds *sqlparser.BindVarNeeds, bindV
 				v = raw.ReadAfterWriteTimeout
 			})
 			bindVars[key] = sqltypes.Float64BindVariable(v)
+		case sysvars.MaxReplicaLag.Name:
+			bindVars[key] = sqltypes.StringBindVariable(session.GetMaxReplicaLag().String())
 		case sysvars.SessionTrackGTIDs.Name:
 			v := "off"
 			ifReadAfterWriteExist(session, func(raw *vtgatepb.ReadAfterWrite) {
@@ -153,6 +153,21 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set workload = 1",
 		err: "Incorrect argument type to variable 'workload': INT64",
+	}, {
+		in:  "set @@max_replica_lag = '2s'",
+		out: &vtgatepb.Session{Autocommit: true, MaxReplicaLag: 2},
+	}, {
+		in:  "set @@max_replica_lag = '1m30s'",
+		out: &vtgatepb.Session{Autocommit: true, MaxReplicaLag: 90},
+	}, {
+		in:  "set @@max_replica_lag = 0.5",
+		out: &vtgatepb.Session{Autocommit: true, MaxReplicaLag: 0.5},
+	}, {
+		in:  "set @@max_replica_lag = 'aa'",
+		err: "Variable 'max_replica_lag' can't be set to the value of 'aa'",
+	}, {
+		in:  "set @@max_replica_lag = -1",
+		err: "Variable 'max_replica_lag' can't be set to the value of '-1'",
 	}, {
 		in:  "set transaction_mode = 'twopc', autocommit=1",
 		out: &vtgatepb.Session{Autocommit: true, TransactionMode: vtgatepb.TransactionMode_TWOPC},
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	sqlShowDMLJobs  = "select * from _vt.dml_jobs"
+	sqlCancelDMLJob = "update _vt.dml_jobs set job_status = %a where job_uuid = %a and job_status in (%a, %a)"
+)
+
+// isBackgroundDML returns true when an UPDATE or DELETE statement carries the BACKGROUND_DML directive.
+func isBackgroundDML(comments sqlparser.Comments) bool {
+	return sqlparser.ExtractCommentDirectives(comments).IsSet(sqlparser.DirectiveBackgroundDML)
+}
+
+// buildBackgroundDMLPlan builds a plan which submits an UPDATE or DELETE statement as a background DML job
+// to the shards of the target table, rather than running it inline.
+func buildBackgroundDMLPlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
+	tableExpr, err := schema.BackgroundDMLTable(stmt)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %v", err)
+	}
+	table, _, tabletType, dest, err := vschema.FindTable(tableExpr.Expr.(sqlparser.TableName))
+	if err != nil {
+		return nil, err
+	}
+	if tabletType != topodatapb.TabletType_MASTER {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.InnodbReadOnly, "unsupported: background DML with a replica target")
+	}
+	if len(table.Owned) > 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: background DML on table %s with owned vindexes", table.Name.String())
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Update:
+		for _, vindex := range table.ColumnVindexes {
+			for _, assignment := range stmt.Exprs {
+				for _, vcol := range vindex.Columns {
+					if vcol.Equal(assignment.Name.Name) {
+						return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: background DML changing vindex column '%v'", assignment.Name.Name)
+					}
+				}
+			}
+		}
+		stmt.Comments = nil
+	case *sqlparser.Delete:
+		stmt.Comments = nil
+	}
+	if dest == nil {
+		dest = key.DestinationAllShards{}
+	}
+
+	// Strip the keyspace qualifier and resolve routed tables, as the tablets run the statement against their own database.
+	tableExpr.Expr = sqlparser.TableName{Name: table.Name}
+
+	return &engine.BackgroundDML{
+		Keyspace:          table.Keyspace,
+		TargetDestination: dest,
+		TableName:         table.Name.String(),
+		Query:             sqlparser.NewParsedQuery(stmt),
+	}, nil
+}
+
+// buildCancelDMLJobPlan builds a plan which cancels a queued or running background DML job on all shards
+// of the target keyspace.
+func buildCancelDMLJobPlan(stmt *sqlparser.CancelDMLJob, vschema ContextVSchema) (engine.Primitive, error) {
+	dest, ks, _, err := vschema.TargetDestination("")
+	if err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = key.DestinationAllShards{}
+	}
+	query, err := sqlparser.ParseAndBind(sqlCancelDMLJob,
+		sqltypes.StringBindVariable(string(schema.DMLJobStatusCancelled)),
+		sqltypes.StringBindVariable(stmt.UUID),
+		sqltypes.StringBindVariable(string(schema.DMLJobStatusQueued)),
+		sqltypes.StringBindVariable(string(schema.DMLJobStatusRunning)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.Send{
+		Keyspace:          ks,
+		TargetDestination: dest,
+		Query:             query,
+		IsDML:             true,
+	}, nil
+}
+
+// buildShowDMLJobsPlan builds a plan which lists the background DML jobs of all shards of the target keyspace.
+// A LIKE filter applies to the job UUID, a WHERE filter to the columns of _vt.dml_jobs.
+func buildShowDMLJobsPlan(show *sqlparser.ShowBasic, vschema ContextVSchema) (engine.Primitive, error) {
+	dest, ks, _, err := vschema.TargetDestination(show.DbName)
+	if err != nil {
+		return nil, err
+	}
+	if dest == nil {
+		dest = key.DestinationAllShards{}
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf(sqlShowDMLJobs)
+	if show.Filter != nil {
+		if show.Filter.Like != "" {
+			buf.Myprintf(" where job_uuid like %v", sqlparser.NewStrLiteral([]byte(show.Filter.Like)))
+		} else {
+			buf.Myprintf(" where %v", show.Filter.Filter)
+		}
+	}
+	return &engine.Send{
+		Keyspace:          ks,
+		TargetDestination: dest,
+		Query:             buf.String(),
+	}, nil
+}
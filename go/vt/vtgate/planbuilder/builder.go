@@ -144,8 +144,14 @@ func createInstructionFor(query string, stmt sqlparser.Statement, vschema Contex
 	case *sqlparser.Insert:
 		return buildRoutePlan(stmt, vschema, buildInsertPlan)
 	case *sqlparser.Update:
+		if isBackgroundDML(stmt.Comments) {
+			return buildBackgroundDMLPlan(stmt, vschema)
+		}
 		return buildRoutePlan(stmt, vschema, buildUpdatePlan)
 	case *sqlparser.Delete:
+		if isBackgroundDML(stmt.Comments) {
+			return buildBackgroundDMLPlan(stmt, vschema)
+		}
 		return buildRoutePlan(stmt, vschema, buildDeletePlan)
 	case *sqlparser.Union:
 		return buildRoutePlan(stmt, vschema, buildUnionPlan)
@@ -180,6 +186,8 @@ func createInstructionFor(query string, stmt sqlparser.Statement, vschema Contex
 		return buildFlushPlan(stmt, vschema)
 	case *sqlparser.CallProc:
 		return buildCallProcPlan(stmt, vschema)
+	case *sqlparser.CancelDMLJob:
+		return buildCancelDMLJobPlan(stmt, vschema)
 	}
 
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected statement type: %T", stmt)
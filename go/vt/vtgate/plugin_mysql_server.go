@@ -212,18 +212,31 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 	}()
 
 	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
+		fillInSessionStateFlags(c, nil)
 		err := vh.vtg.StreamExecute(ctx, session, query, make(map[string]*querypb.BindVariable), callback)
 		return mysql.NewSQLErrorFromError(err)
 	}
 	session, result, err := vh.vtg.Execute(ctx, session, query, make(map[string]*querypb.BindVariable))
 	err = mysql.NewSQLErrorFromError(err)
 	if err != nil {
+		fillInSessionStateFlags(c, nil)
 		return err
 	}
 	fillInTxStatusFlags(c, session)
+	fillInSessionStateFlags(c, result)
 	return callback(result)
 }
 
+// fillInSessionStateFlags flags the GTID set of a tracked write to be sent to the client. A nil
+// result, as on error or when streaming, clears the flag left over from an earlier query.
+func fillInSessionStateFlags(c *mysql.Conn, result *sqltypes.Result) {
+	if result != nil && result.SessionStateChanges != "" {
+		c.StatusFlags |= mysql.ServerSessionStateChanged
+	} else {
+		c.StatusFlags &^= mysql.ServerSessionStateChanged
+	}
+}
+
 func fillInTxStatusFlags(c *mysql.Conn, session *vtgatepb.Session) {
 	if session.InTransaction {
 		c.StatusFlags |= mysql.ServerStatusInTrans
@@ -315,15 +328,18 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 	}()
 
 	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
+		fillInSessionStateFlags(c, nil)
 		err := vh.vtg.StreamExecute(ctx, session, prepare.PrepareStmt, prepare.BindVars, callback)
 		return mysql.NewSQLErrorFromError(err)
 	}
 	_, qr, err := vh.vtg.Execute(ctx, session, prepare.PrepareStmt, prepare.BindVars)
 	if err != nil {
+		fillInSessionStateFlags(c, nil)
 		err = mysql.NewSQLErrorFromError(err)
 		return err
 	}
 	fillInTxStatusFlags(c, session)
+	fillInSessionStateFlags(c, qr)
 
 	return callback(qr)
 }
@@ -248,6 +248,20 @@ func TestDefaultWorkloadOLAP(t *testing.T) {
 	}
 }
 
+func TestFillInSessionStateFlags(t *testing.T) {
+	c := &mysql.Conn{}
+	fillInSessionStateFlags(c, &sqltypes.Result{SessionStateChanges: "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5"})
+	assert.Equal(t, mysql.ServerSessionStateChanged, c.StatusFlags&mysql.ServerSessionStateChanged)
+
+	// A write that was not tracked, or an error, leaves no GTID set to send
+	fillInSessionStateFlags(c, &sqltypes.Result{})
+	assert.Zero(t, c.StatusFlags&mysql.ServerSessionStateChanged)
+
+	c.StatusFlags |= mysql.ServerSessionStateChanged
+	fillInSessionStateFlags(c, nil)
+	assert.Zero(t, c.StatusFlags&mysql.ServerSessionStateChanged)
+}
+
 func TestInitTLSConfig(t *testing.T) {
 	// Create the certs.
 	root, err := ioutil.TempDir("", "TestInitTLSConfig")
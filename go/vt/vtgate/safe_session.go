@@ -23,6 +23,8 @@ import (
 
 	"github.com/golang/protobuf/proto"
 
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -520,6 +522,99 @@ func (session *SafeSession) SetSessionTrackGtids(enable bool) {
 		session.ReadAfterWrite = &vtgatepb.ReadAfterWrite{}
 	}
 	session.ReadAfterWrite.SessionTrackGtids = enable
+	if !enable {
+		session.ReadAfterWrite.ShardGtids = nil
+	}
+}
+
+// TrackGTIDs returns true if the session tracks the GTID sets of its writes.
+func (session *SafeSession) TrackGTIDs() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.ReadAfterWrite != nil && session.ReadAfterWrite.SessionTrackGtids
+}
+
+// SetShardGTID records the GTID set executed by the master of the target after
+// the session's last write to it.
+func (session *SafeSession) SetShardGTID(target *querypb.Target, gtid string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.ReadAfterWrite == nil || !session.ReadAfterWrite.SessionTrackGtids {
+		return
+	}
+	if session.ReadAfterWrite.ShardGtids == nil {
+		session.ReadAfterWrite.ShardGtids = make(map[string]string)
+	}
+	session.ReadAfterWrite.ShardGtids[topoproto.KeyspaceShardString(target.Keyspace, target.Shard)] = gtid
+}
+
+// ExecuteOptionsForTarget returns the options to run a query on the target with.
+// Replica reads carry the GTID set the session has to read its own writes from,
+// and master queries ask for the GTID sets of their writes if the session tracks them.
+func (session *SafeSession) ExecuteOptionsForTarget(target *querypb.Target) *querypb.ExecuteOptions {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	raw := session.ReadAfterWrite
+	if raw == nil || target == nil {
+		return session.Options
+	}
+	if target.TabletType == topodatapb.TabletType_MASTER {
+		if !raw.SessionTrackGtids {
+			return session.Options
+		}
+		options := cloneExecuteOptions(session.Options)
+		options.SessionTrackGtids = true
+		return options
+	}
+	gtid := unionGTIDSets(raw.ReadAfterWriteGtid, raw.ShardGtids[topoproto.KeyspaceShardString(target.Keyspace, target.Shard)])
+	if gtid == "" {
+		return session.Options
+	}
+	options := cloneExecuteOptions(session.Options)
+	options.ReadAfterWriteGtid = gtid
+	options.ReadAfterWriteTimeout = raw.ReadAfterWriteTimeout
+	return options
+}
+
+func cloneExecuteOptions(options *querypb.ExecuteOptions) *querypb.ExecuteOptions {
+	if options == nil {
+		return &querypb.ExecuteOptions{}
+	}
+	return proto.Clone(options).(*querypb.ExecuteOptions)
+}
+
+// unionGTIDSets returns the union of two MySQL 5.6 GTID sets. A set which
+// cannot be parsed is returned as is, for the tablet to reject it.
+func unionGTIDSets(a, b string) string {
+	if a == "" {
+		return b
+	}
+	if b == "" {
+		return a
+	}
+	posA, err := mysql.ParsePosition(mysql.Mysql56FlavorID, a)
+	if err != nil {
+		return a
+	}
+	posB, err := mysql.ParsePosition(mysql.Mysql56FlavorID, b)
+	if err != nil {
+		return b
+	}
+	return posA.GTIDSet.Union(posB.GTIDSet).String()
+}
+
+// SetMaxReplicaLag set the MaxReplicaLag setting.
+func (session *SafeSession) SetMaxReplicaLag(maxLag time.Duration) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.MaxReplicaLag = maxLag.Seconds()
+}
+
+// GetMaxReplicaLag returns the MaxReplicaLag value.
+func (session *SafeSession) GetMaxReplicaLag() time.Duration {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return time.Duration(session.MaxReplicaLag * float64(time.Second))
 }
 
 func removeShard(tabletAlias *topodatapb.TabletAlias, sessions []*vtgatepb.Session_ShardSession) ([]*vtgatepb.Session_ShardSession, error) {
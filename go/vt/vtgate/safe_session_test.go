@@ -20,8 +20,11 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/test/utils"
+
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
@@ -67,3 +70,40 @@ func TestPrequeries(t *testing.T) {
 		t.Errorf("got %v but wanted %v", preQueries, want)
 	}
 }
+
+func TestExecuteOptionsForTarget(t *testing.T) {
+	master := &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: topodatapb.TabletType_MASTER}
+	replica := &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: topodatapb.TabletType_REPLICA}
+	otherReplica := &querypb.Target{Keyspace: "ks", Shard: "80-", TabletType: topodatapb.TabletType_REPLICA}
+	options := &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLTP}
+	session := NewSafeSession(&vtgatepb.Session{Options: options})
+
+	// Without read after write settings the session options are used as is.
+	assert.Equal(t, options, session.ExecuteOptionsForTarget(master))
+	assert.Equal(t, options, session.ExecuteOptionsForTarget(replica))
+
+	session.SetSessionTrackGtids(true)
+	session.SetReadAfterWriteTimeout(0.5)
+	assert.True(t, session.ExecuteOptionsForTarget(master).SessionTrackGtids)
+	assert.Equal(t, options, session.ExecuteOptionsForTarget(replica))
+	assert.False(t, options.SessionTrackGtids, "session options must not be modified")
+
+	session.SetShardGTID(master, "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5")
+	utils.MustMatch(t, &querypb.ExecuteOptions{
+		Workload:              querypb.ExecuteOptions_OLTP,
+		ReadAfterWriteGtid:    "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5",
+		ReadAfterWriteTimeout: 0.5,
+	}, session.ExecuteOptionsForTarget(replica), "")
+	assert.Equal(t, options, session.ExecuteOptionsForTarget(otherReplica))
+
+	// A GTID set set by the user applies to all shards.
+	session.SetReadAfterWriteGTID("3e11fa47-71ca-11e1-9e33-c80aa9429562:3-8,4e11fa47-71ca-11e1-9e33-c80aa9429562:1")
+	assert.Equal(t, "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-8,4e11fa47-71ca-11e1-9e33-c80aa9429562:1", session.ExecuteOptionsForTarget(replica).ReadAfterWriteGtid)
+	assert.Equal(t, "3e11fa47-71ca-11e1-9e33-c80aa9429562:3-8,4e11fa47-71ca-11e1-9e33-c80aa9429562:1", session.ExecuteOptionsForTarget(otherReplica).ReadAfterWriteGtid)
+
+	// Disabling tracking forgets the tracked GTID sets.
+	session.SetSessionTrackGtids(false)
+	session.SetReadAfterWriteGTID("")
+	assert.Equal(t, options, session.ExecuteOptionsForTarget(master))
+	assert.Equal(t, options, session.ExecuteOptionsForTarget(replica))
+}
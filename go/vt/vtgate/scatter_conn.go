@@ -201,7 +201,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			reservedID := info.reservedID
 
 			if session != nil && session.Session != nil {
-				opts = session.Session.Options
+				opts = session.ExecuteOptionsForTarget(rs.Target)
 			}
 
 			if autocommit {
@@ -248,6 +248,9 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected actionNeeded on query execution: %v", info.actionNeeded)
 			}
+			if innerqr.SessionStateChanges != "" {
+				session.SetShardGTID(rs.Target, innerqr.SessionStateChanges)
+			}
 			mu.Lock()
 			defer mu.Unlock()
 
@@ -255,6 +258,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			if ignoreMaxMemoryRows || len(qr.Rows) <= *maxMemoryRows {
 				qr.AppendResult(innerqr)
 			}
+			qr.SessionStateChanges = unionGTIDSets(qr.SessionStateChanges, innerqr.SessionStateChanges)
 			return info.updateTransactionAndReservedID(transactionID, reservedID, alias), nil
 		},
 	)
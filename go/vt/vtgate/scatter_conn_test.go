@@ -102,6 +102,38 @@ func TestExecuteFailOnAutocommit(t *testing.T) {
 	utils.MustMatch(t, []*querypb.BoundQuery{queries[1]}, sbc1.Queries, "")
 }
 
+func TestExecuteReadAfterWrite(t *testing.T) {
+	keyspace := "TestExecuteReadAfterWrite"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck()
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbcMaster := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+	sbcReplica := hc.AddTestTablet("aa", "1", 1, keyspace, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	gtid := "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5"
+	sbcMaster.SetResults([]*sqltypes.Result{{RowsAffected: 1, SessionStateChanges: gtid}})
+
+	session := NewSafeSession(&vtgatepb.Session{
+		ReadAfterWrite: &vtgatepb.ReadAfterWrite{SessionTrackGtids: true, ReadAfterWriteTimeout: 2},
+	})
+	write := []*srvtopo.ResolvedShard{{
+		Target:  &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: topodatapb.TabletType_MASTER},
+		Gateway: sbcMaster,
+	}}
+	qr, errs := sc.ExecuteMultiShard(ctx, write, []*querypb.BoundQuery{{Sql: "update t set a = 1"}}, session, true /*autocommit*/, false)
+	require.Empty(t, errs)
+	assert.Equal(t, gtid, qr.SessionStateChanges)
+	assert.True(t, sbcMaster.Options[0].SessionTrackGtids)
+
+	read := []*srvtopo.ResolvedShard{{
+		Target:  &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: topodatapb.TabletType_REPLICA},
+		Gateway: sbcReplica,
+	}}
+	_, errs = sc.ExecuteMultiShard(ctx, read, []*querypb.BoundQuery{{Sql: "select a from t"}}, session, false, false)
+	require.Empty(t, errs)
+	assert.Equal(t, gtid, sbcReplica.Options[0].ReadAfterWriteGtid)
+	assert.EqualValues(t, 2, sbcReplica.Options[0].ReadAfterWriteTimeout)
+}
+
 func TestReservedOnMultiReplica(t *testing.T) {
 	keyspace := "keyspace"
 	createSandbox(keyspace)
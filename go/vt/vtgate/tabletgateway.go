@@ -52,8 +52,25 @@ var (
 	_ discovery.HealthCheck = (*discovery.HealthCheckImpl)(nil)
 	// CellsToWatch is the list of cells the healthcheck operates over. If it is empty, only the local cell is watched
 	CellsToWatch = flag.String("cells_to_watch", "", "comma-separated list of cells for watching tablets")
+
+	maxReplicaLagFallbackToMaster = flag.Bool("max_replica_lag_fallback_to_master", false, "When no replica satisfies the session's @@max_replica_lag, route the query to the master instead of failing it")
 )
 
+// maxReplicaLagKey is the context key for the session's max replica lag
+type maxReplicaLagKey struct{}
+
+// withMaxReplicaLag returns a context which restricts queries on REPLICA and RDONLY
+// targets to tablets reporting a replication lag within maxLag.
+func withMaxReplicaLag(ctx context.Context, maxLag time.Duration) context.Context {
+	return context.WithValue(ctx, maxReplicaLagKey{}, maxLag)
+}
+
+// maxReplicaLagFromContext returns the max replica lag set with withMaxReplicaLag, if any.
+func maxReplicaLagFromContext(ctx context.Context) (time.Duration, bool) {
+	maxLag, ok := ctx.Value(maxReplicaLagKey{}).(time.Duration)
+	return maxLag, ok
+}
+
 // TabletGateway implements the Gateway interface.
 // This implementation uses the new healthcheck module.
 type TabletGateway struct {
@@ -232,10 +249,27 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 			}
 		}
 
+		tabletTarget := target
 		tablets := gw.hc.GetHealthyTabletStats(target)
+		if maxLag, ok := maxReplicaLagFromContext(ctx); ok && target.TabletType != topodatapb.TabletType_MASTER {
+			tablets = filterTabletsByReplicationLag(tablets, maxLag)
+			if len(tablets) == 0 {
+				if !*maxReplicaLagFallbackToMaster {
+					err = vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet with replication lag within %v available for '%s'", maxLag, target.String())
+					break
+				}
+				tabletTarget = &querypb.Target{
+					Keyspace:   target.Keyspace,
+					Shard:      target.Shard,
+					TabletType: topodatapb.TabletType_MASTER,
+					Cell:       target.Cell,
+				}
+				tablets = gw.hc.GetHealthyTabletStats(tabletTarget)
+			}
+		}
 		if len(tablets) == 0 {
 			// fail fast if there is no tablet
-			err = vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet available for '%s'", target.String())
+			err = vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet available for '%s'", tabletTarget.String())
 			break
 		}
 		gw.shuffleTablets(gw.localCell, tablets)
@@ -266,7 +300,7 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 
 		startTime := time.Now()
 		var canRetry bool
-		canRetry, err = inner(ctx, target, th.Conn)
+		canRetry, err = inner(ctx, tabletTarget, th.Conn)
 		gw.updateStats(target, startTime, err)
 		if canRetry {
 			invalidTablets[topoproto.TabletAliasString(tabletLastUsed.Alias)] = true
@@ -299,6 +333,17 @@ func (gw *TabletGateway) getStatsAggregator(target *querypb.Target) *TabletStatu
 	return aggr
 }
 
+// filterTabletsByReplicationLag returns the tablets reporting a replication lag within maxLag.
+func filterTabletsByReplicationLag(tablets []*discovery.TabletHealth, maxLag time.Duration) []*discovery.TabletHealth {
+	var filtered []*discovery.TabletHealth
+	for _, th := range tablets {
+		if th.Stats != nil && time.Duration(th.Stats.SecondsBehindMaster)*time.Second <= maxLag {
+			filtered = append(filtered, th)
+		}
+	}
+	return filtered
+}
+
 func (gw *TabletGateway) shuffleTablets(cell string, tablets []*discovery.TabletHealth) {
 	sameCell, diffCell, sameCellMax := 0, 0, -1
 	length := len(tablets)
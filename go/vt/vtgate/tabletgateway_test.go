@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -184,6 +185,57 @@ func TestTabletGatewayReplicaTransactionError(t *testing.T) {
 	verifyContainsError(t, err, "query service can only be used for non-transactional queries on replicas", vtrpcpb.Code_INTERNAL)
 }
 
+func TestTabletGatewayMaxReplicaLag(t *testing.T) {
+	keyspace := "ks"
+	shard := "0"
+	host := "1.1.1.1"
+	port := int32(1001)
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck()
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+
+	sbcMaster := hc.AddTestTablet("cell", host, port, keyspace, shard, topodatapb.TabletType_MASTER, true, 10, nil)
+	sbcFresh := hc.AddTestTablet("cell", host, port+1, keyspace, shard, topodatapb.TabletType_REPLICA, true, 0, nil)
+	sbcLagging := hc.AddTestTablet("cell", host, port+2, keyspace, shard, topodatapb.TabletType_REPLICA, true, 0, nil)
+	for _, th := range hc.GetHealthyTabletStats(target) {
+		if th.Tablet.PortMap["vt"] == port+1 {
+			th.Stats.SecondsBehindMaster = 1
+		} else {
+			th.Stats.SecondsBehindMaster = 30
+		}
+	}
+
+	// only the fresh replica qualifies
+	ctx := withMaxReplicaLag(context.Background(), 5*time.Second)
+	for i := 0; i < 10; i++ {
+		_, err := tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 10, sbcFresh.ExecCount.Get())
+	assert.EqualValues(t, 0, sbcLagging.ExecCount.Get())
+	assert.EqualValues(t, 0, sbcMaster.ExecCount.Get())
+
+	// no replica qualifies
+	ctx = withMaxReplicaLag(context.Background(), 500*time.Millisecond)
+	_, err := tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "no healthy tablet with replication lag within 500ms available for 'keyspace:\"ks\" shard:\"0\" tablet_type:REPLICA", vtrpcpb.Code_UNAVAILABLE)
+
+	// fall back to the master
+	defer func(fallback bool) {
+		*maxReplicaLagFallbackToMaster = fallback
+	}(*maxReplicaLagFallbackToMaster)
+	*maxReplicaLagFallbackToMaster = true
+	_, err = tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbcMaster.ExecCount.Get())
+	assert.EqualValues(t, 10, sbcFresh.ExecCount.Get())
+	assert.EqualValues(t, 0, sbcLagging.ExecCount.Get())
+}
+
 func testTabletGatewayGeneric(t *testing.T, f func(tg *TabletGateway, target *querypb.Target) error) {
 	t.Helper()
 	keyspace := "ks"
@@ -35,6 +35,8 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 )
 
+const sqlSelectGTIDExecuted = "select @@global.gtid_executed"
+
 // TxConn is used for executing transactional requests.
 type TxConn struct {
 	gateway Gateway
@@ -109,21 +111,51 @@ func (txc *TxConn) commitShard(ctx context.Context, s *vtgatepb.Session_ShardSes
 	return nil
 }
 
+// commitShardAndTrackGTID commits the shard session, and then records the GTID set
+// executed by the master, so that the session can read its own writes from replicas.
+func (txc *TxConn) commitShardAndTrackGTID(ctx context.Context, session *SafeSession, s *vtgatepb.Session_ShardSession) error {
+	if s.TransactionId == 0 || s.Target.TabletType != topodatapb.TabletType_MASTER {
+		return txc.commitShard(ctx, s)
+	}
+	if err := txc.commitShard(ctx, s); err != nil {
+		return err
+	}
+	// The transaction is committed already, so we only fail to track it.
+	qs, err := txc.queryService(s.TabletAlias)
+	if err != nil {
+		log.Warningf("Failed to track the GTID set of a commit on %v: %v", s.Target, err)
+		return nil
+	}
+	qr, err := qs.Execute(ctx, s.Target, sqlSelectGTIDExecuted, nil, 0, 0, nil)
+	if err != nil || len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		log.Warningf("Failed to track the GTID set of a commit on %v: %v", s.Target, err)
+		return nil
+	}
+	session.SetShardGTID(s.Target, qr.Rows[0][0].ToString())
+	return nil
+}
+
 func (txc *TxConn) commitNormal(ctx context.Context, session *SafeSession) error {
-	if err := txc.runSessions(ctx, session.PreSessions, txc.commitShard); err != nil {
+	commitShard := txc.commitShard
+	if session.TrackGTIDs() {
+		commitShard = func(ctx context.Context, s *vtgatepb.Session_ShardSession) error {
+			return txc.commitShardAndTrackGTID(ctx, session, s)
+		}
+	}
+	if err := txc.runSessions(ctx, session.PreSessions, commitShard); err != nil {
 		_ = txc.Release(ctx, session)
 		return err
 	}
 
 	// Retain backward compatibility on commit order for the normal session.
 	for _, shardSession := range session.ShardSessions {
-		if err := txc.commitShard(ctx, shardSession); err != nil {
+		if err := commitShard(ctx, shardSession); err != nil {
 			_ = txc.Release(ctx, session)
 			return err
 		}
 	}
 
-	if err := txc.runSessions(ctx, session.PostSessions, txc.commitShard); err != nil {
+	if err := txc.runSessions(ctx, session.PostSessions, commitShard); err != nil {
 		// If last commit fails, there will be nothing to rollback.
 		session.RecordWarning(&querypb.QueryWarning{Message: fmt.Sprintf("post-operation transaction had an error: %v", err)})
 		// With reserved connection we should release them.
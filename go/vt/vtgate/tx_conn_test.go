@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/test/utils"
 
 	"context"
@@ -113,6 +114,33 @@ func TestTxConnCommitSuccess(t *testing.T) {
 	assert.EqualValues(t, 1, sbc1.CommitCount.Get(), "sbc1.CommitCount")
 }
 
+func TestTxConnCommitTrackGTID(t *testing.T) {
+	sc, sbc0, sbc1, rss0, rss1, _ := newTestTxConnEnv(t, "TestTxConn")
+	gtid := "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5"
+	sbc0.SetResults([]*sqltypes.Result{
+		{RowsAffected: 1},
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("gtid", "varchar"), gtid),
+	})
+
+	session := NewSafeSession(&vtgatepb.Session{
+		InTransaction:  true,
+		ReadAfterWrite: &vtgatepb.ReadAfterWrite{SessionTrackGtids: true},
+	})
+	_, errs := sc.ExecuteMultiShard(ctx, rss0, queries, session, false, false)
+	require.Empty(t, errs)
+	require.NoError(t, sc.txConn.Commit(ctx, session))
+	assert.Equal(t, map[string]string{"TestTxConn/0": gtid}, session.ReadAfterWrite.ShardGtids)
+	assert.Equal(t, sqlSelectGTIDExecuted, sbc0.Queries[len(sbc0.Queries)-1].Sql)
+
+	// Without tracking, commits do not query the executed GTID set.
+	session = NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	_, errs = sc.ExecuteMultiShard(ctx, rss1, queries, session, false, false)
+	require.Empty(t, errs)
+	require.NoError(t, sc.txConn.Commit(ctx, session))
+	assert.Nil(t, session.ReadAfterWrite)
+	assert.Len(t, sbc1.Queries, 1)
+}
+
 func TestTxConnReservedCommitSuccess(t *testing.T) {
 	sc, sbc0, sbc1, rss0, _, rss01 := newTestTxConnEnv(t, "TestTxConn")
 	sc.txConn.mode = vtgatepb.TransactionMode_MULTI
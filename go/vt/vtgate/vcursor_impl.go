@@ -164,6 +164,9 @@ func newVCursorImpl(ctx context.Context, safeSession *SafeSession, marginComment
 			return nil, err
 		}
 	}
+	if maxLag := safeSession.GetMaxReplicaLag(); maxLag > 0 {
+		ctx = withMaxReplicaLag(ctx, maxLag)
+	}
 
 	return &vcursorImpl{
 		ctx:            ctx,
@@ -689,6 +692,11 @@ func (vc *vcursorImpl) SetSessionTrackGTIDs(enable bool) {
 	vc.safeSession.SetSessionTrackGtids(enable)
 }
 
+// SetMaxReplicaLag implements the SessionActions interface
+func (vc *vcursorImpl) SetMaxReplicaLag(maxLag time.Duration) {
+	vc.safeSession.SetMaxReplicaLag(maxLag)
+}
+
 // HasCreatedTempTable implements the SessionActions interface
 func (vc *vcursorImpl) HasCreatedTempTable() {
 	vc.safeSession.GetOrCreateOptions().HasCreatedTempTables = true
@@ -800,7 +800,7 @@ func (e *backupStreamAdapter) Recv() (*logutilpb.Event, error) {
 }
 
 // Backup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error) {
+func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool, incremental bool) (logutil.EventStream, error) {
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
@@ -809,6 +809,7 @@ func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, con
 	stream, err := c.Backup(ctx, &tabletmanagerdatapb.BackupRequest{
 		Concurrency: int64(concurrency),
 		AllowMaster: bool(allowMaster),
+		Incremental: incremental,
 	})
 	if err != nil {
 		cc.Close()
@@ -835,13 +836,19 @@ func (e *restoreFromBackupStreamAdapter) Recv() (*logutilpb.Event, error) {
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTimestamp time.Time) (logutil.EventStream, error) {
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
 	}
 
-	stream, err := c.RestoreFromBackup(ctx, &tabletmanagerdatapb.RestoreFromBackupRequest{})
+	request := &tabletmanagerdatapb.RestoreFromBackupRequest{
+		RestoreToPos: restoreToPos,
+	}
+	if !restoreToTimestamp.IsZero() {
+		request.RestoreToTimestamp = logutil.TimeToProto(restoreToTimestamp)
+	}
+	stream, err := c.RestoreFromBackup(ctx, request)
 	if err != nil {
 		cc.Close()
 		return nil, err
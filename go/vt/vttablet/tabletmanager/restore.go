@@ -78,10 +78,10 @@ func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger,
 	if tm.Cnf == nil {
 		return fmt.Errorf("cannot perform restore without my.cnf, please restart vttablet with a my.cnf file specified")
 	}
-	return tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore)
+	return tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore, mysql.Position{}, time.Time{})
 }
 
-func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool) error {
+func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, restoreToPos mysql.Position, restoreToTimestamp time.Time) error {
 
 	tablet := tm.Tablet()
 	originalType := tablet.Type
@@ -118,7 +118,10 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 		Keyspace:            keyspace,
 		Shard:               tablet.Shard,
 		StartTime:           logutil.ProtoToTime(keyspaceInfo.SnapshotTime),
+		RestoreToPos:        restoreToPos,
+		RestoreToTimestamp:  restoreToTimestamp,
 	}
+	pointInTime := !restoreToPos.IsZero() || !restoreToTimestamp.IsZero()
 
 	// Check whether we're going to restore before changing to RESTORE type,
 	// so we keep our MasterTermStartTime (if any) if we aren't actually restoring.
@@ -174,7 +177,12 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 	case nil:
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. Thus we use the background context to get through to the finish.
-		if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
+		if pointInTime {
+			// The tablet is now behind the rest of the shard on purpose:
+			// it must neither catch up through replication nor serve.
+			params.Logger.Infof("Restore: restored to %v, not starting replication", pos)
+			originalType = topodatapb.TabletType_DRAINED
+		} else if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
 			// Reconnect to master only for "NORMAL" keyspaces
 			if err := tm.startReplication(context.Background(), pos, originalType); err != nil {
 				return err
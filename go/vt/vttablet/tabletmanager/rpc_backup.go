@@ -22,6 +22,7 @@ import (
 
 	"context"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -35,8 +36,10 @@ const (
 	backupModeOffline = "offline"
 )
 
-// Backup takes a db backup and sends it to the BackupStorage
-func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool) error {
+// Backup takes a db backup and sends it to the BackupStorage.
+// An incremental backup only archives binary logs, so it doesn't drain the
+// tablet. It still needs allowMaster to run on a MASTER.
+func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool, incremental bool) error {
 	if tm.Cnf == nil {
 		return fmt.Errorf("cannot perform backup without my.cnf, please restart vttablet with a my.cnf file specified")
 	}
@@ -63,8 +66,9 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 	}
 
 	// prevent concurrent backups, and record stats
+	shouldDrain := engine.ShouldDrainForBackup() && !incremental
 	backupMode := backupModeOnline
-	if engine.ShouldDrainForBackup() {
+	if shouldDrain {
 		backupMode = backupModeOffline
 	}
 	if err := tm.beginBackup(backupMode); err != nil {
@@ -73,7 +77,7 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 	defer tm.endBackup(backupMode)
 
 	var originalType topodatapb.TabletType
-	if engine.ShouldDrainForBackup() {
+	if shouldDrain {
 		if err := tm.lock(ctx); err != nil {
 			return err
 		}
@@ -104,11 +108,12 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 		Shard:        tablet.Shard,
 		TabletAlias:  topoproto.TabletAliasString(tablet.Alias),
 		BackupTime:   time.Now(),
+		Incremental:  incremental,
 	}
 
 	returnErr := mysqlctl.Backup(ctx, backupParams)
 
-	if engine.ShouldDrainForBackup() {
+	if shouldDrain {
 		bgCtx := context.Background()
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. It is also possible that the context already timed out during the
@@ -130,7 +135,17 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 }
 
 // RestoreFromBackup deletes all local data and restores anew from the latest backup.
-func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+// If restoreToPos or restoreToTimestamp is set, it restores up to that point in
+// time instead, and leaves the tablet DRAINED and not replicating.
+func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToPos string, restoreToTimestamp time.Time) error {
+	pos, err := mysql.DecodePosition(restoreToPos)
+	if err != nil {
+		return vterrors.Wrapf(err, "invalid restore position %v", restoreToPos)
+	}
+	if !pos.IsZero() && !restoreToTimestamp.IsZero() {
+		return fmt.Errorf("cannot restore to both a position and a timestamp")
+	}
+
 	if err := tm.lock(ctx); err != nil {
 		return err
 	}
@@ -148,7 +163,7 @@ func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.L
 	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
 
 	// now we can run restore
-	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */)
+	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */, pos, restoreToTimestamp)
 
 	// re-run health check to be sure to capture any replication delay
 	tm.QueryServiceControl.BroadcastHealth()
@@ -18,12 +18,15 @@ package vreplication
 
 import (
 	"sync"
+	"time"
 
 	"context"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/log"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -41,6 +44,9 @@ var (
 	_ VStreamerClient = (*tabletConnector)(nil)
 )
 
+// externalFlavorTimeout bounds the connection to an external mysql that detects its flavor
+var externalFlavorTimeout = 30 * time.Second
+
 // VStreamerClient exposes the core interface of a vstreamer
 type VStreamerClient interface {
 	Open(context.Context) error
@@ -74,19 +80,45 @@ func (ec *externalConnector) Close() {
 }
 
 func (ec *externalConnector) Get(name string) (*mysqlConnector, error) {
+	ec.mu.Lock()
+	c, ok := ec.connectors[name]
+	dbcfgs := ec.dbconfigs[name]
+	detectFlavor := dbcfgs != nil && dbcfgs.Flavor == ""
+	var dbaConnector dbconfigs.Connector
+	if detectFlavor {
+		dbaConnector = dbcfgs.DbaConnector()
+	}
+	ec.mu.Unlock()
+	if ok {
+		return c, nil
+	}
+	if dbcfgs == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "external mysqlConnector %v not found", name)
+	}
+
+	// The flavor is detected outside of the lock, so that an unreachable
+	// external mysql does not block the connectors of other sources.
+	var flavor string
+	if detectFlavor {
+		var err error
+		if flavor, err = detectExternalFlavor(name, dbaConnector); err != nil {
+			return nil, vterrors.Wrapf(err, "external mysqlConnector: %v", name)
+		}
+	}
+
 	ec.mu.Lock()
 	defer ec.mu.Unlock()
 	if c, ok := ec.connectors[name]; ok {
 		return c, nil
 	}
+	if flavor != "" && dbcfgs.Flavor == "" {
+		dbcfgs.SetFlavor(flavor)
+	}
 
 	// Construct
 	config := tabletenv.NewDefaultConfig()
-	config.DB = ec.dbconfigs[name]
-	if config.DB == nil {
-		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "external mysqlConnector %v not found", name)
-	}
-	c := &mysqlConnector{}
+	config.DB = dbcfgs
+	c = &mysqlConnector{}
 	c.env = tabletenv.NewEnv(config, name)
 	c.se = schema.NewEngine(c.env)
 	c.vstreamer = vstreamer.NewEngine(c.env, nil, c.se, nil, "")
@@ -104,6 +136,30 @@ func (ec *externalConnector) Get(name string) (*mysqlConnector, error) {
 	return c, nil
 }
 
+// detectExternalFlavor returns the FilePos flavor for an external source that
+// does not write GTIDs, so that its binary logs are streamed, and its copy
+// snapshots are taken, by file position. It returns an empty flavor for sources
+// that write GTIDs.
+func detectExternalFlavor(name string, dbaConnector dbconfigs.Connector) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), externalFlavorTimeout)
+	defer cancel()
+	conn, err := dbaConnector.Connect(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	gtidEnabled, err := conn.GTIDEnabled()
+	if err != nil {
+		return "", err
+	}
+	if gtidEnabled {
+		return "", nil
+	}
+	log.Infof("external mysql %v does not have GTIDs enabled, using the %v flavor", name, mysql.FilePosFlavorID)
+	return mysql.FilePosFlavorID, nil
+}
+
 //-----------------------------------------------------------
 
 type mysqlConnector struct {
@@ -42,11 +42,15 @@ import (
 // of the TablePlan from ReplicatorPlan, and fill the rest
 // of the members, leaving the original plan unchanged.
 // The constructor is buildReplicatorPlan in table_plan_builder.go
+// OnConflict and ConflictColumn are applied when the final plans
+// are built.
 type ReplicatorPlan struct {
-	VStreamFilter *binlogdatapb.Filter
-	TargetTables  map[string]*TablePlan
-	TablePlans    map[string]*TablePlan
-	PKInfoMap     map[string][]*PrimaryKeyInfo
+	VStreamFilter  *binlogdatapb.Filter
+	TargetTables   map[string]*TablePlan
+	TablePlans     map[string]*TablePlan
+	PKInfoMap      map[string][]*PrimaryKeyInfo
+	OnConflict     binlogdatapb.OnConflictAction
+	ConflictColumn string
 }
 
 // buildExecution plan uses the field info as input and the partially built
@@ -65,10 +69,32 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 		// If so, we have to strip them out to allow them to match the expected
 		// bind var names.
 		tplanv.Fields = make([]*querypb.Field, 0, len(fieldEvent.Fields))
+		var jsonCols map[string]bool
 		for _, fld := range fieldEvent.Fields {
 			trimmed := *fld
 			trimmed.Name = strings.Trim(trimmed.Name, "`")
 			tplanv.Fields = append(tplanv.Fields, &trimmed)
+			if trimmed.Type == querypb.Type_JSON {
+				if jsonCols == nil {
+					jsonCols = make(map[string]bool)
+				}
+				jsonCols[strings.ToLower(trimmed.Name)] = true
+			}
+		}
+		if (jsonCols != nil || rp.OnConflict != binlogdatapb.OnConflictAction_OVERWRITE) && prelim.builder != nil {
+			// The column types are known only now. If there are JSON
+			// columns, the statements have to be regenerated to convert
+			// their values, which may be used in expressions like
+			// json_extract(doc, '$.name').
+			tpb := *prelim.builder
+			tpb.jsonCols = jsonCols
+			if err := tpb.analyzeConflict(rp.OnConflict, rp.ConflictColumn); err != nil {
+				return nil, err
+			}
+			tplan := tpb.generate()
+			tplan.SendRule = prelim.SendRule
+			tplan.Fields = tplanv.Fields
+			return tplan, nil
 		}
 		return &tplanv, nil
 	}
@@ -108,6 +134,9 @@ func (rp *ReplicatorPlan) buildFromFields(tableName string, lastpk *sqltypes.Res
 	if err := tpb.analyzePK(rp.PKInfoMap); err != nil {
 		return nil, err
 	}
+	if err := tpb.analyzeConflict(rp.OnConflict, rp.ConflictColumn); err != nil {
+		return nil, err
+	}
 	return tpb.generate(), nil
 }
 
@@ -173,6 +202,19 @@ type TablePlan struct {
 	// PKReferences is used to check if an event changed
 	// a primary key column (row move).
 	PKReferences []string
+	// OnConflict is the conflict resolution the statements were
+	// generated for. If it's not OVERWRITE, a statement that affects
+	// no rows may indicate a conflict.
+	OnConflict binlogdatapb.OnConflictAction
+	// ConflictCheck is set for LAST_WRITER_WINS. It selects whether the
+	// target row is newer than the after image, which tells a conflict
+	// apart from a statement that affects no rows because the target
+	// row already matches. It selects nothing if the target row is missing.
+	ConflictCheck *sqlparser.ParsedQuery
+	// builder is set if the column names were known upfront.
+	// It's used to regenerate the statements if the field info
+	// requires it.
+	builder *tablePlanBuilder
 }
 
 // MarshalJSON performs a custom JSON Marshalling.
@@ -224,7 +266,9 @@ func (tp *TablePlan) applyBulkInsert(rows *binlogdatapb.VStreamRowsResponse, exe
 	return executor(buf.String())
 }
 
-func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+// applyChange applies a row change to the target. It returns true if
+// the change conflicted with the target row and was skipped.
+func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor func(string) (*sqltypes.Result, error)) (conflict bool, err error) {
 	// MakeRowTrusted is needed here because Proto3ToResult is not convenient.
 	var before, after bool
 	bindvars := make(map[string]*querypb.BindVariable, len(tp.Fields))
@@ -244,25 +288,80 @@ func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor fun
 	}
 	switch {
 	case !before && after:
-		return execParsedQuery(tp.Insert, bindvars, executor)
+		qr, err := execParsedQuery(tp.Insert, bindvars, executor)
+		if err != nil {
+			return false, err
+		}
+		return tp.isConflict(qr, bindvars, "a_", executor)
 	case before && !after:
 		if tp.Delete == nil {
-			return nil, nil
+			return false, nil
 		}
-		return execParsedQuery(tp.Delete, bindvars, executor)
+		qr, err := execParsedQuery(tp.Delete, bindvars, executor)
+		if err != nil {
+			return false, err
+		}
+		return tp.isConflict(qr, bindvars, "b_", executor)
 	case before && after:
 		if !tp.pkChanged(bindvars) {
-			return execParsedQuery(tp.Update, bindvars, executor)
+			qr, err := execParsedQuery(tp.Update, bindvars, executor)
+			if err != nil {
+				return false, err
+			}
+			// An update that doesn't change the target row
+			// also affects no rows.
+			if !tp.rowChanged(bindvars) {
+				return false, nil
+			}
+			return tp.isConflict(qr, bindvars, "a_", executor)
 		}
 		if tp.Delete != nil {
-			if _, err := execParsedQuery(tp.Delete, bindvars, executor); err != nil {
-				return nil, err
+			qr, err := execParsedQuery(tp.Delete, bindvars, executor)
+			if err != nil {
+				return false, err
 			}
+			conflict, err := tp.isConflict(qr, bindvars, "b_", executor)
+			if err != nil || conflict {
+				// The row can't be moved if it was not deleted.
+				return conflict, err
+			}
+		}
+		qr, err := execParsedQuery(tp.Insert, bindvars, executor)
+		if err != nil {
+			return false, err
 		}
-		return execParsedQuery(tp.Insert, bindvars, executor)
+		return tp.isConflict(qr, bindvars, "a_", executor)
 	}
 	// Unreachable.
-	return nil, nil
+	return false, nil
+}
+
+// isConflict returns true if conflicts are being detected and
+// the statement didn't affect any row. For LAST_WRITER_WINS, it
+// also requires the target row to be missing or newer than the
+// image whose bind vars are prefixed with prefix: an upsert or
+// update that matches the target row affects no rows either.
+func (tp *TablePlan) isConflict(qr *sqltypes.Result, bindvars map[string]*querypb.BindVariable, prefix string, executor func(string) (*sqltypes.Result, error)) (bool, error) {
+	if tp.OnConflict == binlogdatapb.OnConflictAction_OVERWRITE || qr == nil || qr.RowsAffected != 0 {
+		return false, nil
+	}
+	if tp.ConflictCheck == nil {
+		return true, nil
+	}
+	// The check is generated for the after image.
+	checkvars := make(map[string]*querypb.BindVariable, len(tp.Fields))
+	for _, field := range tp.Fields {
+		checkvars["a_"+field.Name] = bindvars[prefix+field.Name]
+	}
+	qr, err := execParsedQuery(tp.ConflictCheck, checkvars, executor)
+	if err != nil {
+		return false, err
+	}
+	if len(qr.Rows) == 0 {
+		// The update or delete found no target row.
+		return true, nil
+	}
+	return qr.Rows[0][0].ToString() == "1", nil
 }
 
 func execParsedQuery(pq *sqlparser.ParsedQuery, bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
@@ -284,6 +383,17 @@ func (tp *TablePlan) pkChanged(bindvars map[string]*querypb.BindVariable) bool {
 	return false
 }
 
+func (tp *TablePlan) rowChanged(bindvars map[string]*querypb.BindVariable) bool {
+	for _, field := range tp.Fields {
+		v1, _ := sqltypes.BindVariableToValue(bindvars["b_"+field.Name])
+		v2, _ := sqltypes.BindVariableToValue(bindvars["a_"+field.Name])
+		if !valsEqual(v1, v2) {
+			return true
+		}
+	}
+	return false
+}
+
 func valsEqual(v1, v2 sqltypes.Value) bool {
 	if v1.IsNull() && v2.IsNull() {
 		return true
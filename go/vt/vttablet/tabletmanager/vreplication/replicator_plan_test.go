@@ -24,7 +24,10 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 type TestReplicatorPlan struct {
@@ -766,3 +769,304 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 	wantPlan, _ := json.Marshal(want)
 	assert.Equal(t, string(gotPlan), string(wantPlan))
 }
+
+func TestBuildExecutionPlanJSON(t *testing.T) {
+	PrimaryKeyInfos := map[string][]*PrimaryKeyInfo{
+		"t1": {&PrimaryKeyInfo{Name: "c1"}},
+	}
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, json_unquote(json_extract(doc, '$.name')) as c2, concat(c3, '-x') as c3 from t2",
+		}},
+	}
+	plan, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil)
+	assert.NoError(t, err)
+
+	tplan, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{
+		TableName: "t2",
+		Fields: []*querypb.Field{{
+			Name: "c1",
+			Type: querypb.Type_INT64,
+		}, {
+			Name: "doc",
+			Type: querypb.Type_JSON,
+		}, {
+			Name: "c3",
+			Type: querypb.Type_VARCHAR,
+		}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "t1", tplan.TargetName)
+	assert.Equal(t, "t2", tplan.SendRule.Match)
+	assert.Equal(t, 3, len(tplan.Fields))
+	assert.Equal(t,
+		"insert into t1(c1,c2,c3) values (:a_c1,json_unquote(json_extract(convert(:a_doc using utf8mb4), '$.name')),concat(:a_c3, '-x'))",
+		tplan.Insert.Query)
+	assert.Equal(t,
+		"update t1 set c2=json_unquote(json_extract(convert(:a_doc using utf8mb4), '$.name')), c3=concat(:a_c3, '-x') where c1=:b_c1",
+		tplan.Update.Query)
+
+	// The preliminary plan must remain unchanged.
+	assert.Equal(t,
+		"insert into t1(c1,c2,c3) values (:a_c1,json_unquote(json_extract(:a_doc, '$.name')),concat(:a_c3, '-x'))",
+		plan.TablePlans["t2"].Insert.Query)
+}
+
+func TestBuildExecutionPlanConflict(t *testing.T) {
+	PrimaryKeyInfos := map[string][]*PrimaryKeyInfo{
+		"t1": {&PrimaryKeyInfo{Name: "c1"}},
+	}
+	fieldEvent := &binlogdatapb.FieldEvent{
+		TableName: "t1",
+		Fields: []*querypb.Field{{
+			Name: "c1",
+			Type: querypb.Type_INT64,
+		}, {
+			Name: "c2",
+			Type: querypb.Type_VARCHAR,
+		}, {
+			Name: "ts",
+			Type: querypb.Type_TIMESTAMP,
+		}},
+	}
+	testcases := []struct {
+		filter     string
+		onConflict binlogdatapb.OnConflictAction
+		column     string
+		insert     string
+		update     string
+		delete     string
+		check      string
+		err        string
+	}{{
+		filter:     "",
+		onConflict: binlogdatapb.OnConflictAction_LAST_WRITER_WINS,
+		column:     "ts",
+		insert:     "insert into t1(c1,c2,ts) values (:a_c1,:a_c2,:a_ts) on duplicate key update c2=if(ts<=values(ts), values(c2), c2), ts=if(ts<=values(ts), values(ts), ts)",
+		update:     "update t1 set c2=:a_c2, ts=:a_ts where c1=:b_c1 and ts<=:a_ts",
+		delete:     "delete from t1 where c1=:b_c1 and ts<=:b_ts",
+		check:      "select ts>:a_ts from t1 where c1=:a_c1",
+	}, {
+		filter:     "select c1, c2, ts from t1",
+		onConflict: binlogdatapb.OnConflictAction_LAST_WRITER_WINS,
+		column:     "ts",
+		insert:     "insert into t1(c1,c2,ts) values (:a_c1,:a_c2,:a_ts) on duplicate key update c2=if(ts<=values(ts), values(c2), c2), ts=if(ts<=values(ts), values(ts), ts)",
+		update:     "update t1 set c2=:a_c2, ts=:a_ts where c1=:b_c1 and ts<=:a_ts",
+		delete:     "delete from t1 where c1=:b_c1 and ts<=:b_ts",
+		check:      "select ts>:a_ts from t1 where c1=:a_c1",
+	}, {
+		filter:     "",
+		onConflict: binlogdatapb.OnConflictAction_REJECT,
+		insert:     "insert ignore into t1(c1,c2,ts) values (:a_c1,:a_c2,:a_ts)",
+		update:     "update t1 set c2=:a_c2, ts=:a_ts where c1=:b_c1 and c2<=>:b_c2 and ts<=>:b_ts",
+		delete:     "delete from t1 where c1=:b_c1 and c2<=>:b_c2 and ts<=>:b_ts",
+	}, {
+		filter:     "select c1, upper(c2) as c2, ts from t1",
+		onConflict: binlogdatapb.OnConflictAction_REJECT,
+		insert:     "insert ignore into t1(c1,c2,ts) values (:a_c1,upper(:a_c2),:a_ts)",
+		update:     "update t1 set c2=upper(:a_c2), ts=:a_ts where c1=:b_c1 and c2<=>(upper(:b_c2)) and ts<=>:b_ts",
+		delete:     "delete from t1 where c1=:b_c1 and c2<=>(upper(:b_c2)) and ts<=>:b_ts",
+	}, {
+		filter:     "",
+		onConflict: binlogdatapb.OnConflictAction_LAST_WRITER_WINS,
+		err:        "a conflict column must be specified for LAST_WRITER_WINS",
+	}, {
+		filter:     "",
+		onConflict: binlogdatapb.OnConflictAction_LAST_WRITER_WINS,
+		column:     "c3",
+		err:        "conflict column c3 not found in table t1",
+	}, {
+		filter:     "",
+		onConflict: binlogdatapb.OnConflictAction_LAST_WRITER_WINS,
+		column:     "c1",
+		err:        "conflict column c1 cannot be a primary key column of table t1",
+	}, {
+		filter:     "select c1, count(*) as c2 from t1 group by c1",
+		onConflict: binlogdatapb.OnConflictAction_REJECT,
+		err:        "conflict detection is not supported for table t1 because it aggregates rows",
+	}}
+	for _, tcase := range testcases {
+		input := &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: tcase.filter,
+			}},
+		}
+		plan, err := buildReplicatorPlan(input, PrimaryKeyInfos, nil)
+		assert.NoError(t, err)
+		plan.OnConflict = tcase.onConflict
+		plan.ConflictColumn = tcase.column
+
+		tplan, err := plan.buildExecutionPlan(fieldEvent)
+		if tcase.err != "" {
+			assert.EqualError(t, err, tcase.err, tcase.filter)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, tcase.onConflict, tplan.OnConflict)
+		assert.Equal(t, tcase.insert, tplan.Insert.Query, tcase.filter)
+		assert.Equal(t, tcase.update, tplan.Update.Query, tcase.filter)
+		assert.Equal(t, tcase.delete, tplan.Delete.Query, tcase.filter)
+		if tcase.check == "" {
+			assert.Nil(t, tplan.ConflictCheck, tcase.filter)
+		} else {
+			assert.Equal(t, tcase.check, tplan.ConflictCheck.Query, tcase.filter)
+		}
+	}
+}
+
+func TestApplyChangeConflict(t *testing.T) {
+	tplan := &TablePlan{
+		Insert:       sqlparser.BuildParsedQuery("insert ignore into t1(c1,c2) values (%a,%a)", ":a_c1", ":a_c2"),
+		Update:       sqlparser.BuildParsedQuery("update t1 set c2=%a where c1=%a and c2<=>%a", ":a_c2", ":b_c1", ":b_c2"),
+		Delete:       sqlparser.BuildParsedQuery("delete from t1 where c1=%a and c2<=>%a", ":b_c1", ":b_c2"),
+		Fields:       []*querypb.Field{{Name: "c1", Type: querypb.Type_INT64}, {Name: "c2", Type: querypb.Type_INT64}},
+		PKReferences: []string{"c1"},
+		OnConflict:   binlogdatapb.OnConflictAction_REJECT,
+	}
+	row := func(c1, c2 int64) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(c1), sqltypes.NewInt64(c2)})
+	}
+	testcases := []struct {
+		change   *binlogdatapb.RowChange
+		affected uint64
+		queries  []string
+		conflict bool
+	}{{
+		change:   &binlogdatapb.RowChange{After: row(1, 10)},
+		affected: 1,
+		queries:  []string{"insert ignore into t1(c1,c2) values (1,10)"},
+	}, {
+		change:   &binlogdatapb.RowChange{After: row(1, 10)},
+		affected: 0,
+		queries:  []string{"insert ignore into t1(c1,c2) values (1,10)"},
+		conflict: true,
+	}, {
+		change:   &binlogdatapb.RowChange{Before: row(1, 10), After: row(1, 20)},
+		affected: 0,
+		queries:  []string{"update t1 set c2=20 where c1=1 and c2<=>10"},
+		conflict: true,
+	}, {
+		// An update that doesn't change the row is not a conflict.
+		change:   &binlogdatapb.RowChange{Before: row(1, 10), After: row(1, 10)},
+		affected: 0,
+		queries:  []string{"update t1 set c2=10 where c1=1 and c2<=>10"},
+	}, {
+		change:   &binlogdatapb.RowChange{Before: row(1, 10)},
+		affected: 0,
+		queries:  []string{"delete from t1 where c1=1 and c2<=>10"},
+		conflict: true,
+	}, {
+		// A row that can't be deleted is not moved.
+		change:   &binlogdatapb.RowChange{Before: row(1, 10), After: row(2, 10)},
+		affected: 0,
+		queries:  []string{"delete from t1 where c1=1 and c2<=>10"},
+		conflict: true,
+	}}
+	for _, tcase := range testcases {
+		var queries []string
+		conflict, err := tplan.applyChange(tcase.change, func(sql string) (*sqltypes.Result, error) {
+			queries = append(queries, sql)
+			return &sqltypes.Result{RowsAffected: tcase.affected}, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, tcase.queries, queries)
+		assert.Equal(t, tcase.conflict, conflict, tcase.queries)
+	}
+}
+
+func TestApplyChangeLastWriterWins(t *testing.T) {
+	tplan := &TablePlan{
+		Insert:        sqlparser.BuildParsedQuery("insert into t1(c1,ts) values (%a,%a) on duplicate key update ts=if(ts<=values(ts), values(ts), ts)", ":a_c1", ":a_ts"),
+		Update:        sqlparser.BuildParsedQuery("update t1 set ts=%a where c1=%a and ts<=%a", ":a_ts", ":b_c1", ":a_ts"),
+		Delete:        sqlparser.BuildParsedQuery("delete from t1 where c1=%a and ts<=%a", ":b_c1", ":b_ts"),
+		ConflictCheck: sqlparser.BuildParsedQuery("select ts>%a from t1 where c1=%a", ":a_ts", ":a_c1"),
+		Fields:        []*querypb.Field{{Name: "c1", Type: querypb.Type_INT64}, {Name: "ts", Type: querypb.Type_INT64}},
+		PKReferences:  []string{"c1"},
+		OnConflict:    binlogdatapb.OnConflictAction_LAST_WRITER_WINS,
+	}
+	row := func(c1, ts int64) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(c1), sqltypes.NewInt64(ts)})
+	}
+	testcases := []struct {
+		change   *binlogdatapb.RowChange
+		affected uint64
+		newer    bool
+		missing  bool
+		queries  []string
+		conflict bool
+	}{{
+		change:   &binlogdatapb.RowChange{After: row(1, 10)},
+		affected: 1,
+		queries:  []string{"insert into t1(c1,ts) values (1,10) on duplicate key update ts=if(ts<=values(ts), values(ts), ts)"},
+	}, {
+		// An identical upsert affects no rows, but is not a conflict.
+		change: &binlogdatapb.RowChange{After: row(1, 10)},
+		queries: []string{
+			"insert into t1(c1,ts) values (1,10) on duplicate key update ts=if(ts<=values(ts), values(ts), ts)",
+			"select ts>10 from t1 where c1=1",
+		},
+	}, {
+		change: &binlogdatapb.RowChange{After: row(1, 10)},
+		newer:  true,
+		queries: []string{
+			"insert into t1(c1,ts) values (1,10) on duplicate key update ts=if(ts<=values(ts), values(ts), ts)",
+			"select ts>10 from t1 where c1=1",
+		},
+		conflict: true,
+	}, {
+		change: &binlogdatapb.RowChange{Before: row(1, 10), After: row(1, 20)},
+		newer:  true,
+		queries: []string{
+			"update t1 set ts=20 where c1=1 and ts<=20",
+			"select ts>20 from t1 where c1=1",
+		},
+		conflict: true,
+	}, {
+		// The delete is checked against the before image.
+		change: &binlogdatapb.RowChange{Before: row(1, 10)},
+		newer:  true,
+		queries: []string{
+			"delete from t1 where c1=1 and ts<=10",
+			"select ts>10 from t1 where c1=1",
+		},
+		conflict: true,
+	}, {
+		// An update or delete of a missing row is a conflict.
+		change:  &binlogdatapb.RowChange{Before: row(1, 10), After: row(1, 20)},
+		missing: true,
+		queries: []string{
+			"update t1 set ts=20 where c1=1 and ts<=20",
+			"select ts>20 from t1 where c1=1",
+		},
+		conflict: true,
+	}, {
+		change:  &binlogdatapb.RowChange{Before: row(1, 10)},
+		missing: true,
+		queries: []string{
+			"delete from t1 where c1=1 and ts<=10",
+			"select ts>10 from t1 where c1=1",
+		},
+		conflict: true,
+	}}
+	for _, tcase := range testcases {
+		var queries []string
+		conflict, err := tplan.applyChange(tcase.change, func(sql string) (*sqltypes.Result, error) {
+			queries = append(queries, sql)
+			if strings.HasPrefix(sql, "select") {
+				switch {
+				case tcase.missing:
+					return &sqltypes.Result{}, nil
+				case tcase.newer:
+					return &sqltypes.Result{Rows: [][]sqltypes.Value{{sqltypes.NewInt64(1)}}}, nil
+				}
+				return &sqltypes.Result{Rows: [][]sqltypes.Value{{sqltypes.NewInt64(0)}}}, nil
+			}
+			return &sqltypes.Result{RowsAffected: tcase.affected}, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, tcase.queries, queries)
+		assert.Equal(t, tcase.conflict, conflict, tcase.queries)
+	}
+}
@@ -252,6 +252,22 @@ func (st *vrStats) register() {
 			return result
 		})
 
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationConflictCount",
+		"vreplication changes skipped because of conflicts per stream and table",
+		[]string{"source_keyspace", "source_shard", "workflow", "counts", "table"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				for table, count := range ct.blpStats.ConflictCounts.Counts() {
+					result[ct.source.Keyspace+"."+ct.source.Shard+"."+ct.workflow+"."+fmt.Sprintf("%v", ct.id)+"."+table] = count
+				}
+			}
+			return result
+		})
+
 	stats.NewGaugesFuncWithMultiLabels(
 		"VReplicationCopyLoopCount",
 		"Number of times the copy phase looped per stream",
@@ -353,6 +369,7 @@ func (st *vrStats) status() *EngineStatus {
 			PhaseTimings:        ct.blpStats.PhaseTimings.Counts(),
 			CopyRowCount:        ct.blpStats.CopyRowCount.Get(),
 			CopyLoopCount:       ct.blpStats.CopyLoopCount.Get(),
+			ConflictCounts:      ct.blpStats.ConflictCounts.Counts(),
 		}
 		i++
 	}
@@ -384,6 +401,7 @@ type ControllerStatus struct {
 	PhaseTimings        map[string]int64
 	CopyRowCount        int64
 	CopyLoopCount       int64
+	ConflictCounts      map[string]int64
 }
 
 var vreplicationTemplate = `
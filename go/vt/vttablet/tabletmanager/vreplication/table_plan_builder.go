@@ -51,6 +51,14 @@ type tablePlanBuilder struct {
 	pkCols     []*colExpr
 	lastpk     *sqltypes.Result
 	pkInfos    []*PrimaryKeyInfo
+	// jsonCols contains the source columns of type JSON. Their values
+	// are converted to utf8mb4 wherever they're referenced.
+	jsonCols map[string]bool
+	// onConflict specifies how conflicts with the target rows are
+	// handled. For LAST_WRITER_WINS, conflictCol is the column that
+	// decides which row is newer.
+	onConflict  binlogdatapb.OnConflictAction
+	conflictCol *colExpr
 }
 
 // colExpr describes the processing to be performed to
@@ -122,10 +130,13 @@ const (
 // buildExecutionPlan is the function that builds the full plan.
 func buildReplicatorPlan(filter *binlogdatapb.Filter, pkInfoMap map[string][]*PrimaryKeyInfo, copyState map[string]*sqltypes.Result) (*ReplicatorPlan, error) {
 	plan := &ReplicatorPlan{
-		VStreamFilter: &binlogdatapb.Filter{FieldEventMode: filter.FieldEventMode},
-		TargetTables:  make(map[string]*TablePlan),
-		TablePlans:    make(map[string]*TablePlan),
-		PKInfoMap:     pkInfoMap,
+		VStreamFilter: &binlogdatapb.Filter{
+			FieldEventMode:              filter.FieldEventMode,
+			ExcludeVreplicationWorkflow: filter.ExcludeVreplicationWorkflow,
+		},
+		TargetTables: make(map[string]*TablePlan),
+		TablePlans:   make(map[string]*TablePlan),
+		PKInfoMap:    pkInfoMap,
 	}
 	for tableName := range pkInfoMap {
 		lastpk, ok := copyState[tableName]
@@ -266,6 +277,7 @@ func buildTablePlan(tableName, filter string, pkInfoMap map[string][]*PrimaryKey
 
 	tablePlan := tpb.generate()
 	tablePlan.SendRule = sendRule
+	tablePlan.builder = tpb
 	return tablePlan, nil
 }
 
@@ -287,7 +299,7 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 	}
 	sort.Strings(pkrefs)
 
-	bvf := &bindvarFormatter{}
+	bvf := &bindvarFormatter{jsonCols: tpb.jsonCols}
 
 	return &TablePlan{
 		TargetName:       tpb.name.String(),
@@ -299,6 +311,8 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 		Update:           tpb.generateUpdateStatement(),
 		Delete:           tpb.generateDeleteStatement(),
 		PKReferences:     pkrefs,
+		OnConflict:       tpb.onConflict,
+		ConflictCheck:    tpb.generateConflictCheck(),
 	}
 }
 
@@ -489,6 +503,32 @@ func (tpb *tablePlanBuilder) analyzePK(pkInfoMap map[string][]*PrimaryKeyInfo) e
 	return nil
 }
 
+// analyzeConflict validates the conflict settings against the columns
+// of the table, and saves them for generating the statements.
+func (tpb *tablePlanBuilder) analyzeConflict(onConflict binlogdatapb.OnConflictAction, column string) error {
+	switch onConflict {
+	case binlogdatapb.OnConflictAction_OVERWRITE:
+		return nil
+	case binlogdatapb.OnConflictAction_LAST_WRITER_WINS:
+		if column == "" {
+			return fmt.Errorf("a conflict column must be specified for %v", onConflict)
+		}
+		cexpr := tpb.findCol(sqlparser.NewColIdent(column))
+		if cexpr == nil || cexpr.operation != opExpr {
+			return fmt.Errorf("conflict column %s not found in table %s", column, tpb.name.String())
+		}
+		if cexpr.isPK {
+			return fmt.Errorf("conflict column %s cannot be a primary key column of table %s", column, tpb.name.String())
+		}
+		tpb.conflictCol = cexpr
+	}
+	if tpb.onInsert != insertNormal {
+		return fmt.Errorf("conflict detection is not supported for table %s because it aggregates rows", tpb.name.String())
+	}
+	tpb.onConflict = onConflict
+	return nil
+}
+
 func (tpb *tablePlanBuilder) findCol(name sqlparser.ColIdent) *colExpr {
 	for _, cexpr := range tpb.colExprs {
 		if cexpr.colName.Equal(name) {
@@ -499,10 +539,11 @@ func (tpb *tablePlanBuilder) findCol(name sqlparser.ColIdent) *colExpr {
 }
 
 func (tpb *tablePlanBuilder) generateInsertStatement() *sqlparser.ParsedQuery {
-	bvf := &bindvarFormatter{}
+	bvf := &bindvarFormatter{jsonCols: tpb.jsonCols}
 	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
 
-	tpb.generateInsertPart(buf)
+	// With REJECT, a row that already exists on the target is a conflict.
+	tpb.generateInsertInto(buf, tpb.onInsert == insertIgnore || tpb.onConflict == binlogdatapb.OnConflictAction_REJECT)
 	if tpb.lastpk == nil {
 		// If there's no lastpk, generate straight values.
 		buf.Myprintf(" values ", tpb.name)
@@ -513,12 +554,19 @@ func (tpb *tablePlanBuilder) generateInsertStatement() *sqlparser.ParsedQuery {
 		tpb.generateSelectPart(buf, bvf)
 	}
 	tpb.generateOnDupPart(buf)
+	if tpb.onConflict == binlogdatapb.OnConflictAction_LAST_WRITER_WINS {
+		tpb.generateLastWriterOnDupPart(buf)
+	}
 
 	return buf.ParsedQuery()
 }
 
 func (tpb *tablePlanBuilder) generateInsertPart(buf *sqlparser.TrackedBuffer) *sqlparser.ParsedQuery {
-	if tpb.onInsert == insertIgnore {
+	return tpb.generateInsertInto(buf, tpb.onInsert == insertIgnore)
+}
+
+func (tpb *tablePlanBuilder) generateInsertInto(buf *sqlparser.TrackedBuffer, ignore bool) *sqlparser.ParsedQuery {
+	if ignore {
 		buf.Myprintf("insert ignore into %v(", tpb.name)
 	} else {
 		buf.Myprintf("insert into %v(", tpb.name)
@@ -609,11 +657,97 @@ func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sq
 	return buf.ParsedQuery()
 }
 
+// generateLastWriterOnDupPart generates the on duplicate key clause that
+// keeps the existing row if its conflict column is newer. The conflict
+// column is assigned last because MySQL evaluates the assignments in order.
+func (tpb *tablePlanBuilder) generateLastWriterOnDupPart(buf *sqlparser.TrackedBuffer) {
+	buf.Myprintf(" on duplicate key update ")
+	cc := tpb.conflictCol.colName
+	separator := ""
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.isPK || cexpr == tpb.conflictCol {
+			continue
+		}
+		buf.Myprintf("%s%v=if(%v<=values(%v), values(%v), %v)", separator, cexpr.colName, cc, cc, cexpr.colName, cexpr.colName)
+		separator = ", "
+	}
+	buf.Myprintf("%s%v=if(%v<=values(%v), values(%v), %v)", separator, cc, cc, cc, cc, cc)
+}
+
+// generateConflictGuard adds the conditions that make an update or
+// delete skip a conflicting target row. For LAST_WRITER_WINS, mode
+// specifies the image whose conflict column value is compared.
+// For REJECT, the target row must match the before image.
+func (tpb *tablePlanBuilder) generateConflictGuard(buf *sqlparser.TrackedBuffer, bvf *bindvarFormatter, mode bindvarMode) {
+	switch tpb.onConflict {
+	case binlogdatapb.OnConflictAction_LAST_WRITER_WINS:
+		bvf.mode = mode
+		buf.Myprintf(" and %v<=", tpb.conflictCol.colName)
+		if _, ok := tpb.conflictCol.expr.(*sqlparser.ColName); ok {
+			buf.Myprintf("%v", tpb.conflictCol.expr)
+		} else {
+			buf.Myprintf("(%v)", tpb.conflictCol.expr)
+		}
+	case binlogdatapb.OnConflictAction_REJECT:
+		bvf.mode = bvBefore
+		for _, cexpr := range tpb.colExprs {
+			if cexpr.isPK {
+				continue
+			}
+			buf.Myprintf(" and %v<=>", cexpr.colName)
+			_, isColName := cexpr.expr.(*sqlparser.ColName)
+			switch {
+			case cexpr.colType == querypb.Type_JSON:
+				buf.Myprintf("cast(convert(%v using utf8mb4) as json)", cexpr.expr)
+			case isColName && tpb.jsonCols[cexpr.expr.(*sqlparser.ColName).Name.Lowered()]:
+				buf.Myprintf("cast(%v as json)", cexpr.expr)
+			case isColName:
+				buf.Myprintf("%v", cexpr.expr)
+			default:
+				buf.Myprintf("(%v)", cexpr.expr)
+			}
+		}
+	}
+}
+
+// generateConflictCheck generates the query that finds out if a target row
+// is newer than the after image. It's only needed for LAST_WRITER_WINS,
+// where a statement also affects no rows if it didn't change the row.
+// The query returns no rows if the target row doesn't exist.
+func (tpb *tablePlanBuilder) generateConflictCheck() *sqlparser.ParsedQuery {
+	if tpb.onConflict != binlogdatapb.OnConflictAction_LAST_WRITER_WINS {
+		return nil
+	}
+	bvf := &bindvarFormatter{jsonCols: tpb.jsonCols, mode: bvAfter}
+	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
+	buf.Myprintf("select %v>", tpb.conflictCol.colName)
+	if _, ok := tpb.conflictCol.expr.(*sqlparser.ColName); ok {
+		buf.Myprintf("%v", tpb.conflictCol.expr)
+	} else {
+		buf.Myprintf("(%v)", tpb.conflictCol.expr)
+	}
+	buf.Myprintf(" from %v where ", tpb.name)
+	separator := ""
+	for _, cexpr := range tpb.pkCols {
+		buf.Myprintf("%s", separator)
+		separator = " and "
+		if _, ok := cexpr.expr.(*sqlparser.ColName); ok {
+			buf.Myprintf("%v=", cexpr.colName)
+			castIfNecessary(buf, cexpr)
+		} else {
+			buf.Myprintf("%v=(", cexpr.colName)
+			castIfNecessary(buf, cexpr)
+			buf.Myprintf(")")
+		}
+	}
+	return buf.ParsedQuery()
+}
+
 func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 	if tpb.onInsert == insertIgnore {
 		return tpb.generateInsertStatement()
 	}
-	bvf := &bindvarFormatter{}
+	bvf := &bindvarFormatter{jsonCols: tpb.jsonCols}
 	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
 	buf.Myprintf("update %v set ", tpb.name)
 	separator := ""
@@ -638,16 +772,18 @@ func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 		}
 	}
 	tpb.generateWhere(buf, bvf)
+	tpb.generateConflictGuard(buf, bvf, bvAfter)
 	return buf.ParsedQuery()
 }
 
 func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
-	bvf := &bindvarFormatter{}
+	bvf := &bindvarFormatter{jsonCols: tpb.jsonCols}
 	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
 	switch tpb.onInsert {
 	case insertNormal:
 		buf.Myprintf("delete from %v", tpb.name)
 		tpb.generateWhere(buf, bvf)
+		tpb.generateConflictGuard(buf, bvf, bvBefore)
 	case insertOnDup:
 		bvf.mode = bvBefore
 		buf.Myprintf("update %v set ", tpb.name)
@@ -751,8 +887,12 @@ func (tpb *tablePlanBuilder) generatePKConstraint(buf *sqlparser.TrackedBuffer,
 // use bvAfter, whereas deletes will always use bvBefore.
 // For updates, values being set will use bvAfter, whereas
 // the where clause will use bvBefore.
+// If a column is in jsonCols, its bind var is converted to utf8mb4.
+// Otherwise, MySQL rejects the value as a JSON document because
+// it's sent as a binary string.
 type bindvarFormatter struct {
-	mode bindvarMode
+	mode     bindvarMode
+	jsonCols map[string]bool
 }
 
 type bindvarMode int
@@ -764,6 +904,10 @@ const (
 
 func (bvf *bindvarFormatter) formatter(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
 	if node, ok := node.(*sqlparser.ColName); ok {
+		if bvf.jsonCols[node.Name.Lowered()] {
+			buf.WriteString("convert(")
+			defer buf.WriteString(" using utf8mb4)")
+		}
 		switch bvf.mode {
 		case bvBefore:
 			buf.WriteArg(fmt.Sprintf(":b_%s", node.Name.String()))
@@ -17,6 +17,7 @@ limitations under the License.
 package vreplication
 
 import (
+	"fmt"
 	"io"
 	"time"
 
@@ -36,6 +37,9 @@ type vdbClient struct {
 	InTransaction bool
 	startTime     time.Time
 	queries       []string
+	// markedID is the id of the stream whose row in _vt.vreplication is
+	// written at the start of every transaction, if not 0.
+	markedID uint32
 }
 
 func newVDBClient(dbclient binlogplayer.DBClient, stats *binlogplayer.Stats) *vdbClient {
@@ -55,10 +59,31 @@ func (vc *vdbClient) Begin() error {
 	vc.queries = append(vc.queries, "begin")
 	vc.InTransaction = true
 	vc.startTime = time.Now()
+	if vc.markedID != 0 {
+		// The value must change, or the write is not logged.
+		query := fmt.Sprintf("update _vt.vreplication set message='Applying transaction %d' where id=%d", vc.startTime.UnixNano(), vc.markedID)
+		if _, err := vc.DBClient.ExecuteFetch(query, 1); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// MarkTransactions makes every transaction start with a write to the row
+// of the stream in _vt.vreplication. This lets the source streams of a
+// bi-directional workflow tell the transactions that came from the other
+// direction apart.
+func (vc *vdbClient) MarkTransactions(id uint32) {
+	vc.markedID = id
+}
+
 func (vc *vdbClient) Commit() error {
+	if vc.markedID != 0 {
+		query := fmt.Sprintf("update _vt.vreplication set message='' where id=%d", vc.markedID)
+		if _, err := vc.DBClient.ExecuteFetch(query, 1); err != nil {
+			return err
+		}
+	}
 	if err := vc.DBClient.Commit(); err != nil {
 		return err
 	}
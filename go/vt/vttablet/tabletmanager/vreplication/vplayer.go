@@ -31,6 +31,7 @@ import (
 
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 )
@@ -102,7 +103,7 @@ func (vp *vplayer) play(ctx context.Context) error {
 		return nil
 	}
 
-	plan, err := buildReplicatorPlan(vp.vr.source.Filter, vp.vr.pkInfoMap, vp.copyState)
+	plan, err := vp.buildReplicatorPlan(vp.vr.pkInfoMap)
 	if err != nil {
 		vp.vr.stats.ErrorCounts.Add([]string{"Plan"}, 1)
 		return err
@@ -121,6 +122,18 @@ func (vp *vplayer) play(ctx context.Context) error {
 	return vp.fetchAndApply(ctx)
 }
 
+// buildReplicatorPlan builds the replicator plan for the current
+// copy state, with the conflict settings of the source.
+func (vp *vplayer) buildReplicatorPlan(pkInfoMap map[string][]*PrimaryKeyInfo) (*ReplicatorPlan, error) {
+	plan, err := buildReplicatorPlan(vp.vr.source.Filter, pkInfoMap, vp.copyState)
+	if err != nil {
+		return nil, err
+	}
+	plan.OnConflict = vp.vr.source.OnConflict
+	plan.ConflictColumn = vp.vr.source.ConflictColumn
+	return plan, nil
+}
+
 // fetchAndApply performs the fetching and application of the binlogs.
 // This is done by two different threads. The fetcher thread pulls
 // events from the vstreamer and adds them to the relayLog.
@@ -210,7 +223,7 @@ func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.Row
 		return fmt.Errorf("unexpected event on table %s", rowEvent.TableName)
 	}
 	for _, change := range rowEvent.RowChanges {
-		_, err := tplan.applyChange(change, func(sql string) (*sqltypes.Result, error) {
+		conflict, err := tplan.applyChange(change, func(sql string) (*sqltypes.Result, error) {
 			stats := NewVrLogStats("ROWCHANGE")
 			start := time.Now()
 			qr, err := vp.vr.dbClient.ExecuteWithRetry(ctx, sql)
@@ -222,6 +235,10 @@ func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.Row
 		if err != nil {
 			return err
 		}
+		if conflict {
+			vp.vr.stats.ConflictCounts.Add(tplan.TargetName, 1)
+			log.Warningf("VReplication %d: skipped change on table %s because it conflicts with the target row (%v): %v", vp.vr.id, tplan.TargetName, tplan.OnConflict, change)
+		}
 	}
 	return nil
 }
@@ -246,6 +263,105 @@ func (vp *vplayer) updatePos(ts int64) (posReached bool, err error) {
 	return posReached, nil
 }
 
+// stopAtDDL saves the position past the DDL and stops the stream
+// with the specified message. Restarting the stream will resume
+// after the DDL, which is expected to have been applied by then.
+func (vp *vplayer) stopAtDDL(event *binlogdatapb.VEvent, message string) error {
+	if err := vp.vr.dbClient.Begin(); err != nil {
+		return err
+	}
+	if _, err := vp.updatePos(event.Timestamp); err != nil {
+		return err
+	}
+	if err := vp.vr.setState(binlogplayer.BlpStopped, message); err != nil {
+		return err
+	}
+	if err := vp.vr.dbClient.Commit(); err != nil {
+		return err
+	}
+	return io.EOF
+}
+
+// ddlAction returns the action to be taken for a DDL. An action
+// specified for the target table in TableOnDdl takes precedence
+// over OnDdl.
+func (vp *vplayer) ddlAction(ddl string) binlogdatapb.OnDDLAction {
+	if len(vp.vr.source.TableOnDdl) == 0 {
+		return vp.vr.source.OnDdl
+	}
+	stmt, err := sqlparser.Parse(ddl)
+	if err != nil {
+		return vp.vr.source.OnDdl
+	}
+	ddlStmt, ok := stmt.(sqlparser.DDLStatement)
+	if !ok {
+		return vp.vr.source.OnDdl
+	}
+	for _, table := range ddlStmt.AffectedTables() {
+		targetName := table.Name.String()
+		if tplan := vp.replicatorPlan.TablePlans[targetName]; tplan != nil {
+			targetName = tplan.TargetName
+		}
+		if action, ok := vp.vr.source.TableOnDdl[targetName]; ok {
+			return action
+		}
+	}
+	return vp.vr.source.OnDdl
+}
+
+// rebuildPlans rebuilds the replicator plan after a DDL was applied
+// to the target. The existing table plans are left as is: a schema
+// change causes the source to send a new field event for the table,
+// which will build its new table plan from the rebuilt replicator plan.
+func (vp *vplayer) rebuildPlans(ctx context.Context) error {
+	pkInfoMap, err := vp.vr.buildPkInfoMap(ctx)
+	if err != nil {
+		return err
+	}
+	plan, err := vp.buildReplicatorPlan(pkInfoMap)
+	if err != nil {
+		vp.vr.stats.ErrorCounts.Add([]string{"Plan"}, 1)
+		return err
+	}
+	vp.vr.pkInfoMap = pkInfoMap
+	vp.replicatorPlan = plan
+	return nil
+}
+
+// isAdditiveDDL returns true if the DDL only adds columns or non-unique
+// secondary indexes to an existing table. Such DDLs don't invalidate the
+// rows that were already replicated, and can be safely applied to the target.
+// Unique and primary keys are excluded: they can fail on, or change the
+// outcome of, the replicated rows.
+func isAdditiveDDL(ddl string) bool {
+	stmt, err := sqlparser.Parse(ddl)
+	if err != nil {
+		return false
+	}
+	alter, ok := stmt.(*sqlparser.AlterTable)
+	if !ok || !alter.FullyParsed || alter.PartitionSpec != nil || len(alter.AlterOptions) == 0 {
+		return false
+	}
+	for _, option := range alter.AlterOptions {
+		switch option := option.(type) {
+		case *sqlparser.AddColumns:
+			for _, column := range option.Columns {
+				// Any key specified inline with the column may be unique.
+				if column.Type.Options != nil && column.Type.Options.KeyOpt != 0 {
+					return false
+				}
+			}
+		case *sqlparser.AddIndexDefinition:
+			if info := option.IndexDefinition.Info; info.Primary || info.Unique {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 func (vp *vplayer) recordHeartbeat() (err error) {
 	tm := time.Now().Unix()
 	vp.vr.stats.RecordHeartbeat(tm)
@@ -263,7 +379,8 @@ func (vp *vplayer) recordHeartbeat() (err error) {
 // cases to take into account:
 // * Normal transaction that has row mutations. In this case, the transaction
 //   is committed along with an update of the position.
-// * DDL event: the action depends on the OnDDL setting.
+// * DDL event: the action depends on the OnDDL setting, or the TableOnDdl
+//   setting of the table affected by the DDL.
 // * OTHER event: the current position of the event is saved.
 // * JOURNAL event: if the event is relevant to the current stream, invoke registerJournal
 //   of the engine, and terminate.
@@ -504,7 +621,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			log.Errorf("internal error: vplayer is in a transaction on event: %v", event)
 			return fmt.Errorf("internal error: vplayer is in a transaction on event: %v", event)
 		}
-		switch vp.vr.source.OnDdl {
+		switch vp.ddlAction(event.Statement) {
 		case binlogdatapb.OnDDLAction_IGNORE:
 			// We still have to update the position.
 			posReached, err := vp.updatePos(event.Timestamp)
@@ -515,19 +632,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 				return io.EOF
 			}
 		case binlogdatapb.OnDDLAction_STOP:
-			if err := vp.vr.dbClient.Begin(); err != nil {
-				return err
-			}
-			if _, err := vp.updatePos(event.Timestamp); err != nil {
-				return err
-			}
-			if err := vp.vr.setState(binlogplayer.BlpStopped, fmt.Sprintf("Stopped at DDL %s", event.Statement)); err != nil {
-				return err
-			}
-			if err := vp.vr.dbClient.Commit(); err != nil {
-				return err
-			}
-			return io.EOF
+			return vp.stopAtDDL(event, fmt.Sprintf("Stopped at DDL %s", event.Statement))
 		case binlogdatapb.OnDDLAction_EXEC:
 			// It's impossible to save the position transactionally with the statement.
 			// So, we apply the DDL first, and then save the position.
@@ -537,6 +642,9 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 				return err
 			}
 			stats.Send(fmt.Sprintf("%v", event.Statement))
+			if err := vp.rebuildPlans(ctx); err != nil {
+				return err
+			}
 			posReached, err := vp.updatePos(event.Timestamp)
 			if err != nil {
 				return err
@@ -556,6 +664,25 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			if posReached {
 				return io.EOF
 			}
+		case binlogdatapb.OnDDLAction_EXEC_ADDITIVE:
+			if !isAdditiveDDL(event.Statement) {
+				return vp.stopAtDDL(event, binlogplayer.DDLAwaitingApproval+event.Statement)
+			}
+			// Same as EXEC: the position is saved after the DDL is applied.
+			if _, err := vp.vr.dbClient.ExecuteWithRetry(ctx, event.Statement); err != nil {
+				return err
+			}
+			stats.Send(fmt.Sprintf("%v", event.Statement))
+			if err := vp.rebuildPlans(ctx); err != nil {
+				return err
+			}
+			posReached, err := vp.updatePos(event.Timestamp)
+			if err != nil {
+				return err
+			}
+			if posReached {
+				return io.EOF
+			}
 		}
 	case binlogdatapb.VEventType_JOURNAL:
 		if vp.vr.dbClient.InTransaction {
@@ -1547,6 +1547,125 @@ func TestPlayerDDL(t *testing.T) {
 	cancel()
 }
 
+func TestPlayerDDLExecAdditive(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+	execStatements(t, []string{
+		"create table t1(id int, primary key(id))",
+		fmt.Sprintf("create table %s.t1(id int, primary key(id))", vrepldb),
+		"create table t2(id int, primary key(id))",
+		fmt.Sprintf("create table %s.t2(id int, primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+		fmt.Sprintf("drop table %s.t1", vrepldb),
+		"drop table t2",
+		fmt.Sprintf("drop table %s.t2", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*",
+		}},
+	}
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_EXEC_ADDITIVE,
+		TableOnDdl: map[string]binlogdatapb.OnDDLAction{
+			"t2": binlogdatapb.OnDDLAction_IGNORE,
+		},
+	}
+	cancel, _ := startVReplication(t, bls, "")
+	defer cancel()
+	// Issue a dummy change to ensure vreplication is initialized.
+	execStatements(t, []string{"insert into t1 values(1)"})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"insert into t1(id) values (1)",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+
+	// Additive DDLs are applied, and the new column is replicated.
+	execStatements(t, []string{
+		"alter table t1 add column val varchar(128)",
+		"insert into t1 values(2, 'aaa')",
+	})
+	expectDBClientQueries(t, []string{
+		"alter table t1 add column val varchar(128)",
+		"/update _vt.vreplication set pos=",
+		// The apply of the DDL on target generates an "other" event.
+		"/update _vt.vreplication set pos=",
+		"begin",
+		"insert into t1(id,val) values (2,'aaa')",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+
+	// The table specific action takes precedence.
+	execStatements(t, []string{"alter table t2 drop primary key"})
+	expectDBClientQueries(t, []string{
+		"/update _vt.vreplication set pos=",
+	})
+
+	// Other DDLs stop the stream until an operator applies them.
+	execStatements(t, []string{"alter table t1 drop column val"})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"/update _vt.vreplication set pos=",
+		"/update _vt.vreplication set state='Stopped', message='DDL awaiting approval: alter table t1 drop column val'",
+		"commit",
+	})
+}
+
+func TestIsAdditiveDDL(t *testing.T) {
+	testcases := []struct {
+		ddl  string
+		want bool
+	}{{
+		ddl:  "alter table t1 add column val varchar(128)",
+		want: true,
+	}, {
+		ddl:  "alter table t1 add column val1 int, add index val1_idx(val1)",
+		want: true,
+	}, {
+		ddl:  "create index val_idx on t1(val)",
+		want: true,
+	}, {
+		ddl:  "alter table t1 add unique index val_idx(val)",
+		want: false,
+	}, {
+		ddl:  "create unique index val_idx on t1(val)",
+		want: false,
+	}, {
+		ddl:  "alter table t1 add primary key(id)",
+		want: false,
+	}, {
+		ddl:  "alter table t1 add column val1 int unique",
+		want: false,
+	}, {
+		ddl:  "alter table t1 drop column val",
+		want: false,
+	}, {
+		ddl:  "alter table t1 add column val1 int, modify column val bigint",
+		want: false,
+	}, {
+		ddl:  "create table t2(id int, primary key(id))",
+		want: false,
+	}, {
+		ddl:  "drop table t1",
+		want: false,
+	}, {
+		ddl:  "not a ddl",
+		want: false,
+	}}
+	for _, tcase := range testcases {
+		require.Equal(t, tcase.want, isAdditiveDDL(tcase.ddl), tcase.ddl)
+	}
+}
+
 func TestPlayerStopPos(t *testing.T) {
 	defer deleteTablet(addTablet(100))
 
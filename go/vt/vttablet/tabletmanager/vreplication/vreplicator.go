@@ -87,13 +87,18 @@ type vreplicator struct {
 //   More advanced constructs can be used. Please see the table plan builder
 //   documentation for more info.
 func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
+	vdbClient := newVDBClient(dbClient, stats)
+	if source.GetFilter().GetExcludeVreplicationWorkflow() != "" {
+		// The stream is part of a bi-directional workflow.
+		vdbClient.MarkTransactions(id)
+	}
 	return &vreplicator{
 		vre:             vre,
 		id:              id,
 		source:          source,
 		sourceVStreamer: sourceVStreamer,
 		stats:           stats,
-		dbClient:        newVDBClient(dbClient, stats),
+		dbClient:        vdbClient,
 		mysqld:          mysqld,
 	}
 }
@@ -0,0 +1,460 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dmljob
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/log"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
+)
+
+const (
+	leaderCheckInterval = 5 * time.Second
+	throttlerAppName    = "dmljob"
+)
+
+// checkInterval marks the interval between looking for queued or running background DML jobs
+var checkInterval = flag.Duration("dml_job_check_interval", 10*time.Second, "Interval between checks for queued background DML jobs")
+
+// batchSize is the number of rows each batch of a background DML job operates on
+var batchSize = flag.Int("dml_job_batch_size", 1000, "Number of rows a background DML job changes in each batch")
+
+// dmlJob is a background DML job, as read from _vt.dml_jobs
+type dmlJob struct {
+	uuid      string
+	table     string
+	statement string
+	// lastPK holds the primary key values of the last row processed, or nil when no batch has yet run
+	lastPK []sqltypes.Value
+}
+
+// Executor runs background DML jobs submitted via vtgate. On the primary tablet, it picks the
+// oldest queued job (or resumes a running one), splits its statement into primary key ordered
+// batches, checks the throttler between batches, and persists its progress in _vt.dml_jobs.
+type Executor struct {
+	keyspace string
+	shard    string
+	dbName   string
+
+	isPrimary int64
+	isOpen    int64
+	isRunning int64
+
+	throttlerClient *throttle.Client
+
+	env            tabletenv.Env
+	pool           *connpool.Pool
+	tabletTypeFunc func() topodatapb.TabletType
+
+	initMutex         sync.Mutex
+	schemaInitialized bool
+
+	tickers [](*timer.SuspendableTicker)
+}
+
+// NewExecutor creates a background DML job executor
+func NewExecutor(env tabletenv.Env, tabletTypeFunc func() topodatapb.TabletType, lagThrottler *throttle.Throttler) *Executor {
+	return &Executor{
+		throttlerClient: throttle.NewBackgroundClient(lagThrottler, throttlerAppName, throttle.ThrottleCheckPrimaryWrite),
+
+		env:            env,
+		tabletTypeFunc: tabletTypeFunc,
+		pool: connpool.NewPool(env, "DMLJobPool", tabletenv.ConnPoolConfig{
+			Size:               2,
+			IdleTimeoutSeconds: env.Config().OltpReadPool.IdleTimeoutSeconds,
+		}),
+
+		tickers: [](*timer.SuspendableTicker){},
+	}
+}
+
+// InitDBConfig initializes keyspace and shard
+func (e *Executor) InitDBConfig(keyspace, shard, dbName string) {
+	log.Info("DMLJob: init")
+	e.keyspace = keyspace
+	e.shard = shard
+	e.dbName = dbName
+	go e.Operate(context.Background())
+}
+
+// Open opens database pool
+func (e *Executor) Open() error {
+	e.initMutex.Lock()
+	defer e.initMutex.Unlock()
+	if atomic.LoadInt64(&e.isOpen) > 0 {
+		// already open
+		return nil
+	}
+
+	log.Info("DMLJob: opening")
+	e.pool.Open(e.env.Config().DB.AppWithDB(), e.env.Config().DB.DbaWithDB(), e.env.Config().DB.AppDebugWithDB())
+	atomic.StoreInt64(&e.isOpen, 1)
+
+	for _, t := range e.tickers {
+		t.Resume()
+	}
+	return nil
+}
+
+// Close frees resources
+func (e *Executor) Close() {
+	e.initMutex.Lock()
+	defer e.initMutex.Unlock()
+	if atomic.LoadInt64(&e.isOpen) == 0 {
+		// not open
+		return
+	}
+
+	log.Info("DMLJob: closing")
+	for _, t := range e.tickers {
+		t.Suspend()
+	}
+	e.pool.Close()
+	atomic.StoreInt64(&e.isOpen, 0)
+}
+
+// Operate is the main entry point for the background DML job executor
+func (e *Executor) Operate(ctx context.Context) {
+	addTicker := func(d time.Duration) *timer.SuspendableTicker {
+		e.initMutex.Lock()
+		defer e.initMutex.Unlock()
+
+		t := timer.NewSuspendableTicker(d, true)
+		e.tickers = append(e.tickers, t)
+		return t
+	}
+	leaderCheckTicker := addTicker(leaderCheckInterval)
+	jobCheckTicker := addTicker(*checkInterval)
+
+	log.Info("DMLJob: operating")
+	for {
+		select {
+		case <-leaderCheckTicker.C:
+			func() {
+				e.initMutex.Lock()
+				defer e.initMutex.Unlock()
+
+				shouldBePrimary := false
+				if atomic.LoadInt64(&e.isOpen) > 0 {
+					if e.tabletTypeFunc() == topodatapb.TabletType_MASTER {
+						shouldBePrimary = true
+					}
+				}
+				if e.isPrimary == 0 && shouldBePrimary {
+					log.Infof("DMLJob: transition into primary")
+				}
+				if e.isPrimary > 0 && !shouldBePrimary {
+					log.Infof("DMLJob: transition out of primary")
+				}
+				if shouldBePrimary {
+					atomic.StoreInt64(&e.isPrimary, 1)
+				} else {
+					atomic.StoreInt64(&e.isPrimary, 0)
+				}
+			}()
+		case <-jobCheckTicker.C:
+			go func() {
+				if uuid, err := e.runNextJob(ctx); err != nil {
+					log.Errorf("DMLJob: error running job %s: %+v", uuid, err)
+				}
+			}()
+		}
+	}
+}
+
+// isActive returns true when the executor is open and the tablet is a primary
+func (e *Executor) isActive() bool {
+	return atomic.LoadInt64(&e.isOpen) > 0 && atomic.LoadInt64(&e.isPrimary) > 0
+}
+
+func (e *Executor) execQuery(ctx context.Context, query string) (*sqltypes.Result, error) {
+	conn, err := e.pool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+	return conn.Exec(ctx, query, math.MaxInt32, true)
+}
+
+func (e *Executor) initSchema(ctx context.Context) error {
+	e.initMutex.Lock()
+	defer e.initMutex.Unlock()
+
+	if e.schemaInitialized {
+		return nil
+	}
+
+	conn, err := e.pool.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+
+	for _, ddl := range applyDDL {
+		_, err := conn.Exec(ctx, ddl, math.MaxInt32, false)
+		if mysql.IsSchemaApplyError(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	e.schemaInitialized = true
+	return nil
+}
+
+// readNextJob returns the running job, if any, or else the oldest queued job. It returns nil
+// when there is no job to run.
+func (e *Executor) readNextJob(ctx context.Context) (*dmlJob, error) {
+	qr, err := e.execQuery(ctx, sqlSelectNextJob)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+	row := sqltypes.ToNamedResult(qr).Row()
+	job := &dmlJob{
+		uuid:      row.AsString("job_uuid", ""),
+		table:     row.AsString("mysql_table", ""),
+		statement: row.AsString("job_statement", ""),
+	}
+	job.lastPK, err = decodeLastPK(row.AsString("last_pk", ""))
+	if err != nil {
+		return job, err
+	}
+	return job, nil
+}
+
+// readPKColumns returns the primary key columns of the given table, in index order
+func (e *Executor) readPKColumns(ctx context.Context, tableName string) (columns []string, err error) {
+	query, err := sqlparser.ParseAndBind(sqlSelectPKColumns,
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(tableName),
+	)
+	if err != nil {
+		return nil, err
+	}
+	qr, err := e.execQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range qr.Rows {
+		columns = append(columns, row[0].ToString())
+	}
+	return columns, nil
+}
+
+// updateJobStatus sets the status of a running job, along with a message
+func (e *Executor) updateJobStatus(ctx context.Context, uuid string, status schema.DMLJobStatus, message string) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateJobCompleted,
+		sqltypes.StringBindVariable(string(status)),
+		sqltypes.StringBindVariable(message),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
+// isRetryableError returns true for MySQL errors which do not fail a job, but only interrupt it:
+// deadlocks, lock wait timeouts and lost connections.
+func isRetryableError(err error) bool {
+	if mysql.IsConnErr(err) {
+		return true
+	}
+	if sqlErr, ok := err.(*mysql.SQLError); ok {
+		switch sqlErr.Number() {
+		case mysql.ERLockDeadlock, mysql.ERLockWaitTimeout:
+			return true
+		}
+	}
+	return false
+}
+
+// runNextJob runs the next background DML job to completion, or until the executor is closed or
+// the tablet is no longer a primary. This function is non-reentrant: there's only one instance of
+// this function running at any given time. A timer keeps calling this function, so a job which is
+// interrupted is later resumed from its last persisted primary key.
+func (e *Executor) runNextJob(ctx context.Context) (uuid string, err error) {
+	if !e.isActive() {
+		return "", nil
+	}
+	if atomic.CompareAndSwapInt64(&e.isRunning, 0, 1) {
+		defer atomic.StoreInt64(&e.isRunning, 0)
+	} else {
+		// A job is already running
+		return "", nil
+	}
+
+	if err := e.initSchema(ctx); err != nil {
+		return "", err
+	}
+	job, err := e.readNextJob(ctx)
+	if job == nil {
+		return "", err
+	}
+	defer func() {
+		if err != nil && isRetryableError(err) {
+			// The job is left running, to be resumed from its last persisted primary key
+			log.Warningf("DMLJob: job %s interrupted by retryable error: %+v", job.uuid, err)
+			return
+		}
+		if err != nil {
+			if updateErr := e.updateJobStatus(ctx, job.uuid, schema.DMLJobStatusFailed, err.Error()); updateErr != nil {
+				log.Errorf("DMLJob: error marking job %s as failed: %+v", job.uuid, updateErr)
+			}
+		}
+	}()
+	if err != nil {
+		return job.uuid, err
+	}
+
+	query, err := sqlparser.ParseAndBind(sqlUpdateJobRunning, sqltypes.StringBindVariable(job.uuid))
+	if err != nil {
+		return job.uuid, err
+	}
+	if _, err := e.execQuery(ctx, query); err != nil {
+		return job.uuid, err
+	}
+	pkColumns, err := e.readPKColumns(ctx, job.table)
+	if err != nil {
+		return job.uuid, err
+	}
+	if len(pkColumns) == 0 {
+		return job.uuid, fmt.Errorf("table %s has no primary key", job.table)
+	}
+
+	// The batches run as the app user, as the job's statement would have had it not been submitted in the background
+	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.AppWithDB())
+	if err != nil {
+		return job.uuid, err
+	}
+	defer conn.Close()
+
+	log.Infof("DMLJob: running job %s on table %s", job.uuid, job.table)
+	for {
+		if !e.isActive() {
+			// We will resume the job when we are next open and primary
+			log.Infof("DMLJob: interrupting job %s", job.uuid)
+			return job.uuid, nil
+		}
+		if !e.throttlerClient.ThrottleCheckOKOrWait(ctx) {
+			continue
+		}
+		// OK, we're clear to go!
+		done, err := e.runBatch(ctx, conn, job, pkColumns)
+		if err != nil {
+			return job.uuid, err
+		}
+		if done {
+			return job.uuid, nil
+		}
+	}
+}
+
+// runBatch runs the job's statement over the next batch of rows, and persists its progress in the
+// same transaction. It returns true once there are no more rows to process, or the job is no
+// longer running, e.g. because it was cancelled.
+func (e *Executor) runBatch(ctx context.Context, conn *dbconnpool.DBConnection, job *dmlJob, pkColumns []string) (done bool, err error) {
+	selectQuery, err := generateSelectBatchQuery(job.statement, pkColumns, job.lastPK, *batchSize)
+	if err != nil {
+		return false, err
+	}
+	qr, err := conn.ExecuteFetch(selectQuery, *batchSize, true)
+	if err != nil {
+		return false, err
+	}
+	if len(qr.Rows) == 0 {
+		log.Infof("DMLJob: job %s complete", job.uuid)
+		return true, e.updateJobStatus(ctx, job.uuid, schema.DMLJobStatusComplete, "")
+	}
+	upperPK := qr.Rows[len(qr.Rows)-1]
+
+	applyQuery, err := generateApplyBatchQuery(job.statement, pkColumns, job.lastPK, upperPK)
+	if err != nil {
+		return false, err
+	}
+	encodedPK, err := encodeLastPK(qr.Fields, upperPK)
+	if err != nil {
+		return false, err
+	}
+	statusQuery, err := sqlparser.ParseAndBind(sqlSelectJobStatusForUpdate, sqltypes.StringBindVariable(job.uuid))
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := conn.ExecuteFetch("begin", 0, false); err != nil {
+		return false, err
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if _, err := conn.ExecuteFetch("rollback", 0, false); err != nil {
+				log.Errorf("DMLJob: error rolling back batch of job %s: %+v", job.uuid, err)
+			}
+		}
+	}()
+
+	statusResult, err := conn.ExecuteFetch(statusQuery, 1, false)
+	if err != nil {
+		return false, err
+	}
+	if len(statusResult.Rows) == 0 || statusResult.Rows[0][0].ToString() != string(schema.DMLJobStatusRunning) {
+		log.Infof("DMLJob: job %s is no longer running", job.uuid)
+		return true, nil
+	}
+	applyResult, err := conn.ExecuteFetch(applyQuery, 0, false)
+	if err != nil {
+		return false, err
+	}
+	progressQuery, err := sqlparser.ParseAndBind(sqlUpdateJobProgress,
+		sqltypes.StringBindVariable(encodedPK),
+		sqltypes.Uint64BindVariable(applyResult.RowsAffected),
+		sqltypes.StringBindVariable(job.uuid),
+	)
+	if err != nil {
+		return false, err
+	}
+	if _, err := conn.ExecuteFetch(progressQuery, 0, false); err != nil {
+		return false, err
+	}
+	if _, err := conn.ExecuteFetch("commit", 0, false); err != nil {
+		return false, err
+	}
+	committed = true
+	job.lastPK = upperPK
+	return false, nil
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dmljob
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	testJobUUID      = "a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a"
+	testJobStatement = "delete from events where created_at < '2020-01-01'"
+)
+
+func newTestExecutor(t *testing.T, db *fakesqldb.DB, lagThrottler *throttle.Throttler) *Executor {
+	t.Helper()
+
+	config := tabletenv.NewDefaultConfig()
+	params, err := db.ConnParams().MysqlParams()
+	require.NoError(t, err)
+	config.DB = dbconfigs.NewTestDBConfigs(*params, *params, "vttest")
+	env := tabletenv.NewEnv(config, "DMLJobTest")
+
+	e := NewExecutor(env, masterTabletType, lagThrottler)
+	e.dbName = "vttest"
+	require.NoError(t, e.Open())
+	t.Cleanup(e.Close)
+	atomic.StoreInt64(&e.isPrimary, 1)
+	return e
+}
+
+func masterTabletType() topodatapb.TabletType {
+	return topodatapb.TabletType_MASTER
+}
+
+func bind(t *testing.T, query string, args ...string) string {
+	t.Helper()
+
+	var bindVars []*querypb.BindVariable
+	for _, arg := range args {
+		bindVars = append(bindVars, sqltypes.StringBindVariable(arg))
+	}
+	bound, err := sqlparser.ParseAndBind(query, bindVars...)
+	require.NoError(t, err)
+	return bound
+}
+
+// addJobQueries sets up the queries the executor runs to pick the job with the given last_pk,
+// up to reading its table's primary key.
+func addJobQueries(t *testing.T, db *fakesqldb.DB, lastPK string) {
+	t.Helper()
+
+	for _, ddl := range applyDDL {
+		db.AddQuery(ddl, &sqltypes.Result{})
+	}
+	db.AddQuery(sqlSelectNextJob, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("job_uuid|mysql_table|job_statement|last_pk", "varchar|varchar|varchar|varbinary"),
+		testJobUUID+"|events|"+testJobStatement+"|"+lastPK,
+	))
+	db.AddQuery(bind(t, sqlUpdateJobRunning, testJobUUID), &sqltypes.Result{})
+	db.AddQuery(bind(t, sqlSelectPKColumns, "vttest", "events"), sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("COLUMN_NAME", "varchar"),
+		"id",
+	))
+	db.AddQuery("use `vttest`", &sqltypes.Result{})
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("commit", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+}
+
+func selectBatchQuery(t *testing.T, lastPK []sqltypes.Value) string {
+	t.Helper()
+
+	query, err := generateSelectBatchQuery(testJobStatement, []string{"id"}, lastPK, *batchSize)
+	require.NoError(t, err)
+	return query
+}
+
+func applyBatchQuery(t *testing.T, lastPK, upperPK []sqltypes.Value) string {
+	t.Helper()
+
+	query, err := generateApplyBatchQuery(testJobStatement, []string{"id"}, lastPK, upperPK)
+	require.NoError(t, err)
+	return query
+}
+
+func batchResult(ids ...string) *sqltypes.Result {
+	return sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), ids...)
+}
+
+func statusResult(status schema.DMLJobStatus) *sqltypes.Result {
+	return sqltypes.MakeTestResult(sqltypes.MakeTestFields("job_status", "varchar"), string(status))
+}
+
+func TestRunNextJob(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	e := newTestExecutor(t, db, nil)
+
+	addJobQueries(t, db, "")
+	upperPK := []sqltypes.Value{sqltypes.NewInt64(2)}
+	db.AddQuery(selectBatchQuery(t, nil), batchResult("1", "2"))
+	db.AddQuery(selectBatchQuery(t, upperPK), batchResult())
+	db.AddQuery(bind(t, sqlSelectJobStatusForUpdate, testJobUUID), statusResult(schema.DMLJobStatusRunning))
+	db.AddQuery(applyBatchQuery(t, nil, upperPK), &sqltypes.Result{RowsAffected: 2})
+	encodedPK, err := encodeLastPK(sqltypes.MakeTestFields("id", "int64"), upperPK)
+	require.NoError(t, err)
+	progressQuery, err := sqlparser.ParseAndBind(sqlUpdateJobProgress,
+		sqltypes.StringBindVariable(encodedPK),
+		sqltypes.Uint64BindVariable(2),
+		sqltypes.StringBindVariable(testJobUUID),
+	)
+	require.NoError(t, err)
+	db.AddQuery(progressQuery, &sqltypes.Result{})
+	completeQuery := bind(t, sqlUpdateJobCompleted, string(schema.DMLJobStatusComplete), "", testJobUUID)
+	db.AddQuery(completeQuery, &sqltypes.Result{})
+
+	uuid, err := e.runNextJob(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, testJobUUID, uuid)
+	assert.Equal(t, 1, db.GetQueryCalledNum(applyBatchQuery(t, nil, upperPK)))
+	assert.Equal(t, 1, db.GetQueryCalledNum(progressQuery))
+	assert.Equal(t, 1, db.GetQueryCalledNum("commit"))
+	assert.Equal(t, 0, db.GetQueryCalledNum("rollback"))
+	assert.Equal(t, 1, db.GetQueryCalledNum(completeQuery))
+}
+
+func TestRunNextJobResume(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	e := newTestExecutor(t, db, nil)
+
+	lastPK := []sqltypes.Value{sqltypes.NewInt64(7)}
+	encodedPK, err := encodeLastPK(sqltypes.MakeTestFields("id", "int64"), lastPK)
+	require.NoError(t, err)
+	addJobQueries(t, db, encodedPK)
+	db.AddQuery(selectBatchQuery(t, lastPK), batchResult())
+	completeQuery := bind(t, sqlUpdateJobCompleted, string(schema.DMLJobStatusComplete), "", testJobUUID)
+	db.AddQuery(completeQuery, &sqltypes.Result{})
+
+	_, err = e.runNextJob(context.Background())
+	require.NoError(t, err)
+	// The job picks up after the rows its earlier batches already processed
+	assert.Equal(t, 1, db.GetQueryCalledNum(selectBatchQuery(t, lastPK)))
+	assert.Equal(t, 0, db.GetQueryCalledNum(selectBatchQuery(t, nil)))
+	assert.Equal(t, 1, db.GetQueryCalledNum(completeQuery))
+}
+
+func TestRunNextJobCancelled(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	e := newTestExecutor(t, db, nil)
+
+	addJobQueries(t, db, "")
+	upperPK := []sqltypes.Value{sqltypes.NewInt64(2)}
+	db.AddQuery(selectBatchQuery(t, nil), batchResult("1", "2"))
+	// The job was cancelled after the batch was read, but before it was applied
+	db.AddQuery(bind(t, sqlSelectJobStatusForUpdate, testJobUUID), statusResult(schema.DMLJobStatusCancelled))
+
+	uuid, err := e.runNextJob(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, testJobUUID, uuid)
+	assert.Equal(t, 0, db.GetQueryCalledNum(applyBatchQuery(t, nil, upperPK)))
+	assert.Equal(t, 0, db.GetQueryCalledNum("commit"))
+	assert.Equal(t, 1, db.GetQueryCalledNum("rollback"))
+}
+
+func TestRunNextJobFailed(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	e := newTestExecutor(t, db, nil)
+
+	addJobQueries(t, db, "")
+	db.AddQuery(selectBatchQuery(t, nil), batchResult("1", "2"))
+	db.AddQuery(bind(t, sqlSelectJobStatusForUpdate, testJobUUID), statusResult(schema.DMLJobStatusRunning))
+	// The apply query is not set up, and so fails
+	failed := 0
+	db.AddQueryPatternWithCallback(`UPDATE _vt.dml_jobs\s+SET job_status='failed',\s+message='.*is not supported.*',.*job_uuid='`+testJobUUID+`'.*`, &sqltypes.Result{}, func(string) {
+		failed++
+	})
+
+	uuid, err := e.runNextJob(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, testJobUUID, uuid)
+	assert.Equal(t, 1, failed)
+	assert.Equal(t, 0, db.GetQueryCalledNum("commit"))
+	assert.Equal(t, 1, db.GetQueryCalledNum("rollback"))
+}
+
+func TestRunNextJobRetryableError(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	e := newTestExecutor(t, db, nil)
+
+	addJobQueries(t, db, "")
+	upperPK := []sqltypes.Value{sqltypes.NewInt64(2)}
+	db.AddQuery(selectBatchQuery(t, nil), batchResult("1", "2"))
+	db.AddQuery(bind(t, sqlSelectJobStatusForUpdate, testJobUUID), statusResult(schema.DMLJobStatusRunning))
+	db.AddRejectedQuery(applyBatchQuery(t, nil, upperPK), mysql.NewSQLError(mysql.ERLockDeadlock, mysql.SSLockDeadlock, "Deadlock found when trying to get lock"))
+	failed := 0
+	db.AddQueryPatternWithCallback(`UPDATE _vt.dml_jobs\s+SET job_status='failed'.*`, &sqltypes.Result{}, func(string) {
+		failed++
+	})
+
+	uuid, err := e.runNextJob(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, testJobUUID, uuid)
+	// The job is left running, to be resumed by the next run
+	assert.Equal(t, 0, failed)
+	assert.Equal(t, 1, db.GetQueryCalledNum("rollback"))
+
+	// The next run resumes the job, and the batch succeeds this time
+	db.DeleteRejectedQuery(applyBatchQuery(t, nil, upperPK))
+	db.AddQuery(applyBatchQuery(t, nil, upperPK), &sqltypes.Result{RowsAffected: 2})
+	db.AddQuery(selectBatchQuery(t, upperPK), batchResult())
+	db.AddQueryPattern(`UPDATE _vt.dml_jobs\s+SET last_pk=.*`, &sqltypes.Result{})
+	completeQuery := bind(t, sqlUpdateJobCompleted, string(schema.DMLJobStatusComplete), "", testJobUUID)
+	db.AddQuery(completeQuery, &sqltypes.Result{})
+
+	uuid, err = e.runNextJob(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, testJobUUID, uuid)
+	assert.Equal(t, 0, failed)
+	assert.Equal(t, 1, db.GetQueryCalledNum(applyBatchQuery(t, nil, upperPK)))
+	assert.Equal(t, 1, db.GetQueryCalledNum(completeQuery))
+}
+
+func TestRunNextJobThrottled(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	config := tabletenv.NewDefaultConfig()
+	config.EnableLagThrottler = true
+	lagThrottler := throttle.NewThrottler(tabletenv.NewEnv(config, "DMLJobTest"), nil, masterTabletType)
+	lagThrottler.ThrottleApp(throttlerAppName, time.Now().Add(time.Hour), 1)
+	e := newTestExecutor(t, db, lagThrottler)
+
+	addJobQueries(t, db, "")
+	db.AddQuery(selectBatchQuery(t, nil), batchResult())
+
+	done := make(chan error)
+	go func() {
+		_, err := e.runNextJob(context.Background())
+		done <- err
+	}()
+
+	time.Sleep(time.Second)
+	// The job waits on the throttler, and has not yet run a batch
+	assert.Equal(t, 1, db.GetQueryCalledNum(sqlSelectNextJob))
+	assert.Equal(t, 0, db.GetQueryCalledNum(selectBatchQuery(t, nil)))
+
+	// Closing the executor interrupts the job, to be resumed later rather than failed
+	e.Close()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "job not interrupted")
+	}
+	assert.Equal(t, 0, db.GetQueryCalledNum(selectBatchQuery(t, nil)))
+}
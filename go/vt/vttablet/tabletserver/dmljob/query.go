@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dmljob
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// pkExpr returns the primary key columns as an expression: the column itself for a single
+// column key, or a tuple of the columns for a composite key.
+func pkExpr(columns []sqlparser.Expr) sqlparser.Expr {
+	if len(columns) == 1 {
+		return columns[0]
+	}
+	return sqlparser.ValTuple(columns)
+}
+
+// pkRangeExpr compares the primary key with the given values, which are passed as bind variables
+// with the given prefix.
+func pkRangeExpr(pkColumns []string, operator sqlparser.ComparisonExprOperator, prefix string, values []sqltypes.Value, bindVars map[string]*querypb.BindVariable) sqlparser.Expr {
+	var left, right []sqlparser.Expr
+	for i, column := range pkColumns {
+		name := fmt.Sprintf("%s%d", prefix, i)
+		left = append(left, sqlparser.NewColName(column))
+		right = append(right, sqlparser.NewArgument([]byte(":"+name)))
+		bindVars[name] = sqltypes.ValueBindVariable(values[i])
+	}
+	return &sqlparser.ComparisonExpr{
+		Operator: operator,
+		Left:     pkExpr(left),
+		Right:    pkExpr(right),
+	}
+}
+
+// andWhere adds the given expression to a WHERE clause, which may be nil
+func andWhere(where *sqlparser.Where, expr sqlparser.Expr) *sqlparser.Where {
+	if where == nil || where.Expr == nil {
+		return sqlparser.NewWhere(sqlparser.WhereClause, expr)
+	}
+	return sqlparser.NewWhere(sqlparser.WhereClause, &sqlparser.AndExpr{Left: where.Expr, Right: expr})
+}
+
+func statementWhere(stmt sqlparser.Statement) *sqlparser.Where {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Update:
+		return stmt.Where
+	case *sqlparser.Delete:
+		return stmt.Where
+	}
+	return nil
+}
+
+// generateSelectBatchQuery generates the query which reads the primary key values of the next
+// batch of rows matched by the job's statement, following lastPK.
+func generateSelectBatchQuery(statement string, pkColumns []string, lastPK []sqltypes.Value, batchSize int) (string, error) {
+	stmt, err := sqlparser.Parse(statement)
+	if err != nil {
+		return "", err
+	}
+	tableExpr, err := schema.BackgroundDMLTable(stmt)
+	if err != nil {
+		return "", err
+	}
+	bindVars := map[string]*querypb.BindVariable{}
+	where := statementWhere(stmt)
+	if lastPK != nil {
+		where = andWhere(where, pkRangeExpr(pkColumns, sqlparser.GreaterThanOp, "lastpk", lastPK, bindVars))
+	}
+
+	var selectExprs sqlparser.SelectExprs
+	var orderBy sqlparser.OrderBy
+	for _, column := range pkColumns {
+		selectExprs = append(selectExprs, &sqlparser.AliasedExpr{Expr: sqlparser.NewColName(column)})
+		orderBy = append(orderBy, &sqlparser.Order{Expr: sqlparser.NewColName(column), Direction: sqlparser.AscOrder})
+	}
+	sel := sqlparser.NewSelect(nil, selectExprs, nil, sqlparser.TableExprs{tableExpr}, where, nil, nil)
+	sel.OrderBy = orderBy
+	sel.Limit = &sqlparser.Limit{Rowcount: sqlparser.NewIntLiteral([]byte(strconv.Itoa(batchSize)))}
+	return sqlparser.NewParsedQuery(sel).GenerateQuery(bindVars, nil)
+}
+
+// generateApplyBatchQuery generates the job's statement, limited to the rows following lastPK
+// up to and including upperPK.
+func generateApplyBatchQuery(statement string, pkColumns []string, lastPK, upperPK []sqltypes.Value) (string, error) {
+	stmt, err := sqlparser.Parse(statement)
+	if err != nil {
+		return "", err
+	}
+	bindVars := map[string]*querypb.BindVariable{}
+	var pkRange sqlparser.Expr = pkRangeExpr(pkColumns, sqlparser.LessEqualOp, "upperpk", upperPK, bindVars)
+	if lastPK != nil {
+		pkRange = &sqlparser.AndExpr{
+			Left:  pkRangeExpr(pkColumns, sqlparser.GreaterThanOp, "lastpk", lastPK, bindVars),
+			Right: pkRange,
+		}
+	}
+
+	switch stmt := stmt.(type) {
+	case *sqlparser.Update:
+		stmt.Where = andWhere(stmt.Where, pkRange)
+	case *sqlparser.Delete:
+		stmt.Where = andWhere(stmt.Where, pkRange)
+	default:
+		return "", fmt.Errorf("background DML only supports UPDATE and DELETE statements: %s", statement)
+	}
+	return sqlparser.NewParsedQuery(stmt).GenerateQuery(bindVars, nil)
+}
+
+// encodeLastPK encodes the primary key values of the last processed row, in the same
+// text format as vreplication's copy state
+func encodeLastPK(fields []*querypb.Field, row []sqltypes.Value) (string, error) {
+	var buf bytes.Buffer
+	err := proto.CompactText(&buf, sqltypes.ResultToProto3(&sqltypes.Result{
+		Fields: fields,
+		Rows:   [][]sqltypes.Value{row},
+	}))
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// decodeLastPK decodes primary key values as encoded by encodeLastPK. It returns nil
+// for an empty value.
+func decodeLastPK(encoded string) ([]sqltypes.Value, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+	var r querypb.QueryResult
+	if err := proto.UnmarshalText(encoded, &r); err != nil {
+		return nil, err
+	}
+	result := sqltypes.Proto3ToResult(&r)
+	if len(result.Rows) != 1 {
+		return nil, fmt.Errorf("unexpected last_pk value: %s", encoded)
+	}
+	return result.Rows[0], nil
+}
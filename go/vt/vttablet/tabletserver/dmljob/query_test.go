@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dmljob
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestGenerateSelectBatchQuery(t *testing.T) {
+	tt := []struct {
+		sql       string
+		pkColumns []string
+		lastPK    []sqltypes.Value
+		expected  string
+	}{
+		{
+			sql:       "delete from events where created_at < '2020-01-01'",
+			pkColumns: []string{"id"},
+			expected:  "select id from events where created_at < '2020-01-01' order by id asc limit 100",
+		},
+		{
+			sql:       "delete from events where created_at < '2020-01-01'",
+			pkColumns: []string{"id"},
+			lastPK:    []sqltypes.Value{sqltypes.NewInt64(7)},
+			expected:  "select id from events where created_at < '2020-01-01' and id > 7 order by id asc limit 100",
+		},
+		{
+			sql:       "update events set archived = 1 where a = 1 or b = 2",
+			pkColumns: []string{"tenant", "id"},
+			lastPK:    []sqltypes.Value{sqltypes.NewVarChar("acme"), sqltypes.NewInt64(7)},
+			expected:  "select tenant, id from events where (a = 1 or b = 2) and (tenant, id) > ('acme', 7) order by tenant asc, id asc limit 100",
+		},
+		{
+			sql:       "update events set archived = 1",
+			pkColumns: []string{"id"},
+			lastPK:    []sqltypes.Value{sqltypes.NewInt64(7)},
+			expected:  "select id from events where id > 7 order by id asc limit 100",
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.sql, func(t *testing.T) {
+			query, err := generateSelectBatchQuery(ts.sql, ts.pkColumns, ts.lastPK, 100)
+			require.NoError(t, err)
+			assert.Equal(t, ts.expected, query)
+		})
+	}
+}
+
+func TestGenerateApplyBatchQuery(t *testing.T) {
+	tt := []struct {
+		sql       string
+		pkColumns []string
+		lastPK    []sqltypes.Value
+		upperPK   []sqltypes.Value
+		expected  string
+	}{
+		{
+			sql:       "delete from events where created_at < '2020-01-01'",
+			pkColumns: []string{"id"},
+			upperPK:   []sqltypes.Value{sqltypes.NewInt64(100)},
+			expected:  "delete from events where created_at < '2020-01-01' and id <= 100",
+		},
+		{
+			sql:       "delete from events where created_at < '2020-01-01'",
+			pkColumns: []string{"id"},
+			lastPK:    []sqltypes.Value{sqltypes.NewInt64(100)},
+			upperPK:   []sqltypes.Value{sqltypes.NewInt64(200)},
+			expected:  "delete from events where created_at < '2020-01-01' and (id > 100 and id <= 200)",
+		},
+		{
+			sql:       "update events set archived = 1",
+			pkColumns: []string{"tenant", "id"},
+			lastPK:    []sqltypes.Value{sqltypes.NewVarChar("acme"), sqltypes.NewInt64(7)},
+			upperPK:   []sqltypes.Value{sqltypes.NewVarChar("zeta"), sqltypes.NewInt64(3)},
+			expected:  "update events set archived = 1 where (tenant, id) > ('acme', 7) and (tenant, id) <= ('zeta', 3)",
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.sql, func(t *testing.T) {
+			query, err := generateApplyBatchQuery(ts.sql, ts.pkColumns, ts.lastPK, ts.upperPK)
+			require.NoError(t, err)
+			assert.Equal(t, ts.expected, query)
+		})
+	}
+}
+
+func TestEncodeLastPK(t *testing.T) {
+	fields := sqltypes.MakeTestFields("tenant|id", "varchar|int64")
+	row := []sqltypes.Value{sqltypes.NewVarChar("acme"), sqltypes.NewInt64(7)}
+
+	encoded, err := encodeLastPK(fields, row)
+	require.NoError(t, err)
+
+	decoded, err := decodeLastPK(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, row, decoded)
+
+	decoded, err = decodeLastPK("")
+	require.NoError(t, err)
+	assert.Nil(t, decoded)
+}
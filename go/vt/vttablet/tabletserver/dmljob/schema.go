@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dmljob
+
+const (
+	sqlCreateSidecarDB    = "create database if not exists _vt"
+	sqlCreateDMLJobsTable = `CREATE TABLE IF NOT EXISTS _vt.dml_jobs (
+		id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+		job_uuid varchar(64) NOT NULL,
+		keyspace varchar(256) NOT NULL,
+		shard varchar(255) NOT NULL,
+		mysql_table varchar(128) NOT NULL,
+		job_statement text NOT NULL,
+		job_status varchar(128) NOT NULL,
+		added_timestamp timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		started_timestamp timestamp NULL DEFAULT NULL,
+		liveness_timestamp timestamp NULL DEFAULT NULL,
+		completed_timestamp timestamp NULL DEFAULT NULL,
+		last_pk varbinary(2000) NOT NULL DEFAULT '',
+		batches bigint(20) unsigned NOT NULL DEFAULT 0,
+		rows_affected bigint(20) unsigned NOT NULL DEFAULT 0,
+		message varchar(1024) NOT NULL DEFAULT '',
+		PRIMARY KEY (id),
+		UNIQUE KEY uuid_idx (job_uuid),
+		KEY status_idx (job_status, added_timestamp)
+	) engine=InnoDB DEFAULT CHARSET=utf8mb4`
+
+	sqlSelectNextJob = `SELECT
+			job_uuid,
+			mysql_table,
+			job_statement,
+			last_pk
+		FROM _vt.dml_jobs
+		WHERE
+			job_status IN ('running', 'queued')
+		ORDER BY
+			FIELD(job_status, 'running', 'queued'),
+			id ASC
+		LIMIT 1
+	`
+	sqlUpdateJobRunning = `UPDATE _vt.dml_jobs
+			SET job_status='running',
+			started_timestamp=IFNULL(started_timestamp, NOW()),
+			liveness_timestamp=NOW()
+		WHERE
+			job_uuid=%a
+			AND job_status IN ('running', 'queued')
+	`
+	sqlSelectJobStatusForUpdate = `SELECT
+			job_status
+		FROM _vt.dml_jobs
+		WHERE
+			job_uuid=%a
+		FOR UPDATE
+	`
+	sqlUpdateJobProgress = `UPDATE _vt.dml_jobs
+			SET last_pk=%a,
+			batches=batches + 1,
+			rows_affected=rows_affected + %a,
+			liveness_timestamp=NOW()
+		WHERE
+			job_uuid=%a
+	`
+	sqlUpdateJobCompleted = `UPDATE _vt.dml_jobs
+			SET job_status=%a,
+			message=%a,
+			completed_timestamp=NOW()
+		WHERE
+			job_uuid=%a
+			AND job_status='running'
+	`
+	sqlSelectPKColumns = `SELECT
+			COLUMN_NAME
+		FROM information_schema.key_column_usage
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+			AND CONSTRAINT_NAME='PRIMARY'
+		ORDER BY
+			ORDINAL_POSITION ASC
+	`
+)
+
+// applyDDL creates the sidecar table in which background DML jobs are tracked
+var applyDDL = []string{
+	sqlCreateSidecarDB,
+	sqlCreateDMLJobsTable,
+}
@@ -117,6 +117,9 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 }
 
 func analyzeInsert(ins *sqlparser.Insert, tables map[string]*schema.Table) (plan *Plan, err error) {
+	if _, err := dmlJobStatements(ins); err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+	}
 	plan = &Plan{
 		PlanID:    PlanInsert,
 		FullQuery: GenerateFullQuery(ins),
@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"fmt"
 
+	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 )
@@ -41,6 +42,12 @@ func BuildPermissions(stmt sqlparser.Statement) []Permission {
 	case *sqlparser.Insert:
 		permissions = buildTableNamePermissions(node.Table, tableacl.WRITER, permissions)
 		permissions = buildSubqueryPermissions(node, tableacl.READER, permissions)
+		// Submitting a background DML job requires the permissions of the job's statement,
+		// which the tablet later runs on its own behalf.
+		statements, _ := dmlJobStatements(node)
+		for _, statement := range statements {
+			permissions = append(permissions, BuildPermissions(statement)...)
+		}
 	case *sqlparser.Update:
 		permissions = buildTableExprsPermissions(node.TableExprs, tableacl.WRITER, permissions)
 		permissions = buildSubqueryPermissions(node, tableacl.READER, permissions)
@@ -113,3 +120,36 @@ func buildTableNamePermissions(node sqlparser.TableName, role tableacl.Role, per
 	})
 	return permissions
 }
+
+// dmlJobStatements returns the statements of the background DML jobs submitted by
+// an insert into _vt.dml_jobs, or nil for any other insert. Each job statement
+// must be a literal UPDATE or DELETE, so that its permissions can be checked.
+func dmlJobStatements(ins *sqlparser.Insert) ([]sqlparser.Statement, error) {
+	if ins.Table.Qualifier.String() != "_vt" || ins.Table.Name.String() != schema.DMLJobsTableName {
+		return nil, nil
+	}
+	col := ins.Columns.FindColumn(sqlparser.NewColIdent("job_statement"))
+	rows, ok := ins.Rows.(sqlparser.Values)
+	if col < 0 || !ok {
+		return nil, fmt.Errorf("background DML jobs must be submitted with literal job_statement values: %s", sqlparser.String(ins))
+	}
+	var statements []sqlparser.Statement
+	for _, row := range rows {
+		if col >= len(row) {
+			return nil, fmt.Errorf("background DML jobs must be submitted with literal job_statement values: %s", sqlparser.String(ins))
+		}
+		val, ok := row[col].(*sqlparser.Literal)
+		if !ok || val.Type != sqlparser.StrVal {
+			return nil, fmt.Errorf("background DML jobs must be submitted with literal job_statement values: %s", sqlparser.String(ins))
+		}
+		statement, err := sqlparser.Parse(string(val.Val))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := schema.BackgroundDMLTable(statement); err != nil {
+			return nil, err
+		}
+		statements = append(statements, statement)
+	}
+	return statements, nil
+}
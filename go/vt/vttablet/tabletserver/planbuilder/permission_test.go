@@ -49,6 +49,18 @@ func TestBuildPermissions(t *testing.T) {
 			TableName: "t",
 			Role:      tableacl.WRITER,
 		}},
+	}, {
+		input: "insert into _vt.dml_jobs(job_uuid, mysql_table, job_statement) values ('a', 't1', 'delete from t1 where id in (select id from t2)')",
+		output: []Permission{{
+			TableName: "dml_jobs",
+			Role:      tableacl.WRITER,
+		}, {
+			TableName: "t1",
+			Role:      tableacl.WRITER,
+		}, {
+			TableName: "t2",
+			Role:      tableacl.READER,
+		}},
 	}, {
 		input: "update t set a=1",
 		output: []Permission{{
@@ -153,6 +153,9 @@ type Plan struct {
 	// WhereClause is set for DMLs. It is used by the hot row protection
 	// to serialize e.g. UPDATEs going to the same row.
 	WhereClause *sqlparser.ParsedQuery
+
+	// WorkloadPool is the workload pool requested by the query's comment directive, if any.
+	WorkloadPool string
 }
 
 // TableName returns the table name for the plan.
@@ -225,6 +228,7 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table, isRes
 		return nil, err
 	}
 	plan.Permissions = BuildPermissions(statement)
+	plan.WorkloadPool = sqlparser.WorkloadPoolDirective(statement)
 	return plan, nil
 }
 
@@ -243,9 +247,10 @@ func BuildStreaming(sql string, tables map[string]*schema.Table, isReservedConn
 	}
 
 	plan := &Plan{
-		PlanID:      PlanSelectStream,
-		FullQuery:   GenerateFullQuery(statement),
-		Permissions: BuildPermissions(statement),
+		PlanID:       PlanSelectStream,
+		FullQuery:    GenerateFullQuery(statement),
+		Permissions:  BuildPermissions(statement),
+		WorkloadPool: sqlparser.WorkloadPoolDirective(statement),
 	}
 
 	switch stmt := statement.(type) {
@@ -121,6 +121,10 @@ type QueryEngine struct {
 	// Pools
 	conns       *connpool.Pool
 	streamConns *connpool.Pool
+	// workloadPools are keyed by name, workloadPoolsByUser by the
+	// usernames assigned to them.
+	workloadPools       map[string]*workloadPool
+	workloadPoolsByUser map[string]*workloadPool
 
 	// Services
 	consolidator *sync2.Consolidator
@@ -176,6 +180,7 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 
 	qe.conns = connpool.NewPool(env, "ConnPool", config.OltpReadPool)
 	qe.streamConns = connpool.NewPool(env, "StreamConnPool", config.OlapReadPool)
+	qe.workloadPools, qe.workloadPoolsByUser = newWorkloadPools(env)
 	qe.consolidatorMode.Set(config.Consolidator)
 	qe.enableQueryPlanFieldCaching = config.CacheResultFields
 	qe.consolidator = sync2.NewConsolidator()
@@ -258,6 +263,9 @@ func (qe *QueryEngine) Open() error {
 	}
 
 	qe.streamConns.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
+	for _, wp := range qe.workloadPools {
+		wp.conns.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
+	}
 	qe.se.RegisterNotifier("qe", qe.schemaChanged)
 	qe.isOpen = true
 	return nil
@@ -274,6 +282,9 @@ func (qe *QueryEngine) Close() {
 	qe.se.UnregisterNotifier("qe")
 	qe.plans.Clear()
 	qe.tables = make(map[string]*schema.Table)
+	for _, wp := range qe.workloadPools {
+		wp.conns.Close()
+	}
 	qe.streamConns.Close()
 	qe.conns.Close()
 	qe.isOpen = false
@@ -59,6 +59,11 @@ type QueryExecutor struct {
 	tabletType     topodatapb.TabletType
 }
 
+const (
+	sqlWaitForExecutedGTIDSet = "select wait_for_executed_gtid_set(%a, %a)"
+	sqlSelectGTIDExecuted     = "select @@global.gtid_executed"
+)
+
 var sequenceFields = []*querypb.Field{
 	{
 		Name: "nextval",
@@ -151,6 +156,79 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "%s unexpected plan type", qre.plan.PlanID.String())
 }
 
+// waitForReadAfterWriteGTID blocks until the database has executed the GTID set
+// the session has to read its own writes from, if any. It fails if the set is not
+// executed within the session's read after write timeout. The wait runs on the
+// connection which then runs the read, so that a read holds a single connection.
+func (qre *QueryExecutor) waitForReadAfterWriteGTID(conn *connpool.DBConn) error {
+	gtid := qre.options.GetReadAfterWriteGtid()
+	if gtid == "" {
+		return nil
+	}
+	pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, gtid)
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid read after write GTID set '%s': %v", gtid, err)
+	}
+
+	// A timeout of 0 makes MySQL wait indefinitely, until the query is killed.
+	timeout := time.Duration(qre.options.GetReadAfterWriteTimeout() * float64(time.Second))
+	if deadline, ok := qre.ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); timeout <= 0 || remaining < timeout {
+			timeout = remaining
+		}
+		if timeout <= 0 {
+			return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "timed out waiting for GTID set %v", pos)
+		}
+	}
+	sql, err := sqlparser.ParseAndBind(sqlWaitForExecutedGTIDSet,
+		sqltypes.StringBindVariable(pos.String()),
+		sqltypes.Float64BindVariable(timeout.Seconds()),
+	)
+	if err != nil {
+		return err
+	}
+
+	qr, err := qre.execDBConn(conn, sql, false)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 || qr.Rows[0][0].IsNull() {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot wait for GTID set %v: GTIDs are not enabled", pos)
+	}
+	if qr.Rows[0][0].ToString() != "0" {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "GTID set %v was not executed within %v", pos, timeout)
+	}
+	return nil
+}
+
+// trackGTIDs sets the GTID set executed by the database once an autocommitted write
+// finished as the session state changes of its result, if the session tracks GTIDs.
+// The set is read on the write's own connection, before it is released.
+func (qre *QueryExecutor) trackGTIDs(conn *StatefulConnection, qr *sqltypes.Result) *sqltypes.Result {
+	if !qre.options.GetSessionTrackGtids() {
+		return qr
+	}
+	gtid, err := qre.selectGTIDExecuted(conn)
+	if err != nil {
+		// The write is committed already, so we only fail to track it.
+		log.Warningf("Failed to track the GTID set of a write: %v", err)
+		return qr
+	}
+	qr.SessionStateChanges = gtid
+	return qr
+}
+
+func (qre *QueryExecutor) selectGTIDExecuted(conn *StatefulConnection) (string, error) {
+	qr, err := qre.execStatefulConn(conn, sqlSelectGTIDExecuted, false)
+	if err != nil {
+		return "", err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return "", vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for %s: %v", sqlSelectGTIDExecuted, qr.Rows)
+	}
+	return qr.Rows[0][0].ToString(), nil
+}
+
 func (qre *QueryExecutor) execAutocommit(f func(conn *StatefulConnection) (*sqltypes.Result, error)) (reply *sqltypes.Result, err error) {
 	if qre.options == nil {
 		qre.options = &querypb.ExecuteOptions{}
@@ -164,7 +242,11 @@ func (qre *QueryExecutor) execAutocommit(f func(conn *StatefulConnection) (*sqlt
 	}
 	defer qre.tsv.te.txPool.RollbackAndRelease(qre.ctx, conn)
 
-	return f(conn)
+	result, err := f(conn)
+	if err != nil {
+		return nil, err
+	}
+	return qre.trackGTIDs(conn, result), nil
 }
 
 func (qre *QueryExecutor) execAsTransaction(f func(conn *StatefulConnection) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
@@ -192,7 +274,7 @@ func (qre *QueryExecutor) execAsTransaction(f func(conn *StatefulConnection) (*s
 	if _, err := qre.tsv.te.txPool.Commit(qre.ctx, conn); err != nil {
 		return nil, err
 	}
-	return result, nil
+	return qre.trackGTIDs(conn, result), nil
 }
 
 func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result, error) {
@@ -260,6 +342,9 @@ func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 			return err
 		}
 		defer dbConn.Recycle()
+		if err := qre.waitForReadAfterWriteGTID(dbConn); err != nil {
+			return err
+		}
 		conn = dbConn
 	}
 
@@ -512,7 +597,9 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 // execSelect sends a query to mysql only if another identical query is not running. Otherwise, it waits and
 // reuses the result. If the plan is missing field info, it sends the query to mysql requesting full info.
 func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
-	if qre.tsv.qe.enableQueryPlanFieldCaching && qre.plan.Fields != nil {
+	// A read after write has to wait on its own connection, so it does not share the
+	// connection, nor the result, of consolidated reads.
+	if qre.tsv.qe.enableQueryPlanFieldCaching && qre.plan.Fields != nil && qre.options.GetReadAfterWriteGtid() == "" {
 		result, err := qre.qFetch(qre.logStats, qre.plan.FullQuery, qre.bindVars)
 		if err != nil {
 			return nil, err
@@ -527,6 +614,9 @@ func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
 		return nil, err
 	}
 	defer conn.Recycle()
+	if err := qre.waitForReadAfterWriteGTID(conn); err != nil {
+		return nil, err
+	}
 
 	sql, _, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
 	if err != nil {
@@ -570,6 +660,9 @@ func (qre *QueryExecutor) execOther() (*sqltypes.Result, error) {
 		return nil, err
 	}
 	defer conn.Recycle()
+	if err := qre.waitForReadAfterWriteGTID(conn); err != nil {
+		return nil, err
+	}
 	return qre.execDBConn(conn, qre.query, true)
 }
 
@@ -578,7 +671,11 @@ func (qre *QueryExecutor) getConn() (*connpool.DBConn, error) {
 	defer span.Finish()
 
 	start := time.Now()
-	conn, err := qre.tsv.qe.conns.Get(ctx)
+	pool := qre.tsv.qe.conns
+	if wp := qre.workloadPool(); wp != nil {
+		pool = wp.conns
+	}
+	conn, err := pool.Get(ctx)
 	switch err {
 	case nil:
 		qre.logStats.WaitingForConnection += time.Since(start)
@@ -594,7 +691,11 @@ func (qre *QueryExecutor) getStreamConn() (*connpool.DBConn, error) {
 	defer span.Finish()
 
 	start := time.Now()
-	conn, err := qre.tsv.qe.streamConns.Get(ctx)
+	pool := qre.tsv.qe.streamConns
+	if wp := qre.workloadPool(); wp != nil {
+		pool = wp.conns
+	}
+	conn, err := pool.Get(ctx)
 	switch err {
 	case nil:
 		qre.logStats.WaitingForConnection += time.Since(start)
@@ -755,8 +856,27 @@ func (qre *QueryExecutor) drainResultSetOnConn(conn *connpool.DBConn) error {
 	return nil
 }
 
+// workloadPool returns the workload pool of the query, or nil if it runs in
+// the default pools. Queries on a transaction or reserved connection never
+// run in a workload pool.
+func (qre *QueryExecutor) workloadPool() *workloadPool {
+	if qre.connID != 0 {
+		return nil
+	}
+	return qre.tsv.qe.workloadPoolFor(qre.ctx, qre.plan)
+}
+
+// maxResultSize returns the max result size of the workload pool of the
+// query if it sets one, or else the max result size of the query engine.
+func (qre *QueryExecutor) maxResultSize() int64 {
+	if wp := qre.workloadPool(); wp != nil && wp.maxResultSize > 0 {
+		return wp.maxResultSize
+	}
+	return qre.tsv.qe.maxResultSize.Get()
+}
+
 func (qre *QueryExecutor) getSelectLimit() int64 {
-	maxRows := qre.tsv.qe.maxResultSize.Get()
+	maxRows := qre.maxResultSize()
 	sqlLimit := qre.options.GetSqlSelectLimit()
 	if sqlLimit > 0 && sqlLimit < maxRows {
 		return sqlLimit
@@ -774,7 +894,7 @@ func (qre *QueryExecutor) execDBConn(conn *connpool.DBConn, sql string, wantfiel
 	qre.tsv.statelessql.Add(qd)
 	defer qre.tsv.statelessql.Remove(qd)
 
-	return conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	return conn.Exec(ctx, sql, int(qre.maxResultSize()), wantfields)
 }
 
 func (qre *QueryExecutor) execStatefulConn(conn *StatefulConnection, sql string, wantfields bool) (*sqltypes.Result, error) {
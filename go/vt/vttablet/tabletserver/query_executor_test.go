@@ -535,6 +535,116 @@ func TestQueryExecutorPlanPassSelectWithLockOutsideATransaction(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorReadAfterWrite(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	gtid := "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5"
+	waitQuery := "select wait_for_executed_gtid_set('3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5', 0.5)"
+	fields := sqltypes.MakeTestFields("a|b", "int64|varchar")
+	selectResult := sqltypes.MakeTestResult(fields, "1|aaa")
+	db.AddQuery("select * from t where 1 != 1", sqltypes.MakeTestResult(fields))
+	db.AddQuery("select * from t limit 10001", selectResult)
+	db.AddQuery("insert into test_table(a) values (1)", &sqltypes.Result{RowsAffected: 1})
+	db.AddQuery("select @@global.gtid_executed", sqltypes.MakeTestResult(sqltypes.MakeTestFields("gtid", "varchar"), gtid))
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	options := &querypb.ExecuteOptions{
+		ReadAfterWriteGtid:    gtid,
+		ReadAfterWriteTimeout: 0.5,
+	}
+
+	// The GTID set is executed.
+	db.AddQuery(waitQuery, sqltypes.MakeTestResult(sqltypes.MakeTestFields("wait", "int64"), "0"))
+	qre := newTestQueryExecutor(ctx, tsv, "select * from t", 0)
+	qre.options = options
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, selectResult, got)
+	assert.Contains(t, db.QueryLog(), waitQuery)
+
+	// The GTID set is not executed within the timeout.
+	db.AddQuery(waitQuery, sqltypes.MakeTestResult(sqltypes.MakeTestFields("wait", "int64"), "1"))
+	qre = newTestQueryExecutor(ctx, tsv, "select * from t", 0)
+	qre.options = options
+	_, err = qre.Execute()
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "GTID set 3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5 was not executed within 500ms")
+
+	// An autocommitted write returns the executed GTID set.
+	qre = newTestQueryExecutor(ctx, tsv, "insert into test_table(a) values(1)", 0)
+	qre.options = &querypb.ExecuteOptions{SessionTrackGtids: true}
+	got, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, gtid, got.SessionStateChanges)
+}
+
+func TestQueryExecutorWorkloadPools(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	fields := sqltypes.MakeTestFields("a|b", "int64|varchar")
+	db.AddQuery("select /*vt+ WORKLOAD_POOL=analytics */ * from t where 1 != 1", sqltypes.MakeTestResult(fields))
+	db.AddQuery("select /*vt+ WORKLOAD_POOL=analytics */ * from t limit 3", sqltypes.MakeTestResult(fields, "1|a", "2|b", "3|c"))
+	db.AddQuery("select * from t where 1 != 1", sqltypes.MakeTestResult(fields))
+	db.AddQuery("select * from t limit 10001", sqltypes.MakeTestResult(fields, "1|a", "2|b", "3|c"))
+
+	config := tabletenv.NewDefaultConfig()
+	config.WorkloadPools = map[string]*tabletenv.WorkloadPoolConfig{
+		"analytics": {
+			ConnPoolConfig: tabletenv.ConnPoolConfig{Size: 1},
+			MaxResultSize:  2,
+			Users:          []string{"reporting"},
+		},
+		"oltp": {
+			ConnPoolConfig: tabletenv.ConnPoolConfig{Size: 5},
+		},
+	}
+	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), topodatapb.TabletAlias{})
+	err := tsv.StartService(querypb.Target{TabletType: topodatapb.TabletType_MASTER}, newDBConfigs(db), nil /* mysqld */)
+	require.NoError(t, err)
+	defer tsv.StopService()
+
+	analytics := tsv.qe.workloadPools["analytics"]
+	assert.EqualValues(t, 1, analytics.conns.Capacity())
+	assert.EqualValues(t, 5, tsv.qe.workloadPools["oltp"].conns.Capacity())
+
+	ctx := context.Background()
+	reportingCtx := callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("reporting"))
+	txID := newTransaction(tsv, nil)
+	defer tsv.Rollback(ctx, &querypb.Target{TabletType: topodatapb.TabletType_MASTER}, txID)
+	testCases := []struct {
+		ctx  context.Context
+		sql  string
+		txID int64
+		want *workloadPool
+	}{
+		{ctx, "select * from t", 0, nil},
+		{ctx, "select /*vt+ WORKLOAD_POOL=analytics */ * from t", 0, analytics},
+		{ctx, "select /*vt+ WORKLOAD_POOL=unknown */ * from t", 0, nil},
+		{reportingCtx, "select * from t", 0, analytics},
+		// A caller assigned to a pool cannot switch to another one.
+		{reportingCtx, "select /*vt+ WORKLOAD_POOL=oltp */ * from t", 0, analytics},
+		{reportingCtx, "select /*vt+ WORKLOAD_POOL=unknown */ * from t", 0, analytics},
+		{reportingCtx, "select * from t", txID, nil},
+	}
+	for _, tcase := range testCases {
+		qre := newTestQueryExecutor(tcase.ctx, tsv, tcase.sql, tcase.txID)
+		assert.Equal(t, tcase.want, qre.workloadPool(), tcase.sql)
+	}
+
+	// The analytics pool has its own max result size.
+	qre := newTestQueryExecutor(ctx, tsv, "select /*vt+ WORKLOAD_POOL=analytics */ * from t", 0)
+	_, err = qre.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Row count exceeded 2")
+
+	qre = newTestQueryExecutor(ctx, tsv, "select * from t", 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(got.Rows))
+}
+
 func TestQueryExecutorPlanNextval(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -113,6 +113,7 @@ type stateManager struct {
 	ddle        onlineDDLExecutor
 	throttler   lagThrottler
 	tableGC     tableGarbageCollector
+	dmlJobs     dmlJobExecutor
 
 	// hcticks starts on initialiazation and runs forever.
 	hcticks *timer.Timer
@@ -178,6 +179,11 @@ type (
 		Open() error
 		Close()
 	}
+
+	dmlJobExecutor interface {
+		Open() error
+		Close()
+	}
 )
 
 // Init performs the second phase of initialization.
@@ -432,6 +438,7 @@ func (sm *stateManager) serveMaster() error {
 	sm.throttler.Open()
 	sm.tableGC.Open()
 	sm.ddle.Open()
+	sm.dmlJobs.Open()
 	sm.setState(topodatapb.TabletType_MASTER, StateServing)
 	return nil
 }
@@ -456,6 +463,7 @@ func (sm *stateManager) serveNonMaster(wantTabletType topodatapb.TabletType) err
 	cancel := sm.handleShutdownGracePeriod()
 	defer cancel()
 
+	sm.dmlJobs.Close()
 	sm.ddle.Close()
 	sm.tableGC.Close()
 	sm.messager.Close()
@@ -507,6 +515,7 @@ func (sm *stateManager) unserveCommon() {
 	cancel := sm.handleShutdownGracePeriod()
 	defer cancel()
 
+	sm.dmlJobs.Close()
 	sm.ddle.Close()
 	sm.tableGC.Close()
 	sm.throttler.Close()
@@ -257,6 +257,10 @@ type TabletConfig struct {
 
 	ExternalConnections map[string]*dbconfigs.DBConfigs `json:"externalConnections,omitempty"`
 
+	// WorkloadPools are named connection pools, such as oltp, batch or analytics,
+	// that isolate classes of queries from each other.
+	WorkloadPools map[string]*WorkloadPoolConfig `json:"workloadPools,omitempty"`
+
 	StrictTableACL          bool    `json:"-"`
 	EnableTableACLDryRun    bool    `json:"-"`
 	TableACLExemptACL       string  `json:"-"`
@@ -284,6 +288,16 @@ type ConnPoolConfig struct {
 	MaxWaiters         int     `json:"maxWaiters,omitempty"`
 }
 
+// WorkloadPoolConfig contains the config for a workload pool.
+// Queries are assigned to a pool by the username of the immediate caller,
+// or else by the WORKLOAD_POOL comment directive.
+type WorkloadPoolConfig struct {
+	ConnPoolConfig
+	// MaxResultSize overrides queryserver-config-max-result-size if > 0.
+	MaxResultSize int      `json:"maxResultSize,omitempty"`
+	Users         []string `json:"users,omitempty"`
+}
+
 // OltpConfig contains the config for oltp settings.
 type OltpConfig struct {
 	QueryTimeoutSeconds Seconds `json:"queryTimeoutSeconds,omitempty"`
@@ -350,6 +364,14 @@ func (c *TabletConfig) Clone() *TabletConfig {
 	if tc.DB != nil {
 		tc.DB = c.DB.Clone()
 	}
+	if tc.WorkloadPools != nil {
+		tc.WorkloadPools = make(map[string]*WorkloadPoolConfig, len(c.WorkloadPools))
+		for name, wpc := range c.WorkloadPools {
+			clone := *wpc
+			clone.Users = append([]string(nil), wpc.Users...)
+			tc.WorkloadPools[name] = &clone
+		}
+	}
 	return &tc
 }
 
@@ -370,6 +392,27 @@ func (c *TabletConfig) Verify() error {
 	if v := c.HotRowProtection.MaxConcurrency; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	return c.verifyWorkloadPools()
+}
+
+// verifyWorkloadPools checks that every workload pool has connections
+// and that no user is assigned to more than one pool.
+func (c *TabletConfig) verifyWorkloadPools() error {
+	poolByUser := make(map[string]string)
+	for name, wpc := range c.WorkloadPools {
+		if name == "" {
+			return errors.New("workload pool name must not be empty")
+		}
+		if wpc == nil || wpc.Size <= 0 {
+			return fmt.Errorf("workload pool %s: size must be > 0", name)
+		}
+		for _, user := range wpc.Users {
+			if other, ok := poolByUser[user]; ok && other != name {
+				return fmt.Errorf("user %s is assigned to workload pools %s and %s", user, other, name)
+			}
+			poolByUser[user] = name
+		}
+	}
 	return nil
 }
 
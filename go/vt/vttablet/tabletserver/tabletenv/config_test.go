@@ -326,3 +326,50 @@ func TestFlags(t *testing.T) {
 	want.GracePeriods.TransitionSeconds = 4
 	assert.Equal(t, want, currentConfig)
 }
+
+func TestWorkloadPoolsConfig(t *testing.T) {
+	inBytes := []byte(`workloadPools:
+  analytics:
+    size: 4
+    timeoutSeconds: 30
+    maxResultSize: 1000000
+    users:
+    - reporting
+  oltp:
+    size: 50
+`)
+	cfg := NewDefaultConfig()
+	err := yaml2.Unmarshal(inBytes, cfg)
+	require.NoError(t, err)
+	want := map[string]*WorkloadPoolConfig{
+		"analytics": {
+			ConnPoolConfig: ConnPoolConfig{
+				Size:           4,
+				TimeoutSeconds: 30,
+			},
+			MaxResultSize: 1000000,
+			Users:         []string{"reporting"},
+		},
+		"oltp": {
+			ConnPoolConfig: ConnPoolConfig{
+				Size: 50,
+			},
+		},
+	}
+	assert.Equal(t, want, cfg.WorkloadPools)
+	require.NoError(t, cfg.Verify())
+
+	clone := cfg.Clone()
+	assert.Equal(t, cfg, clone)
+	clone.WorkloadPools["analytics"].Users[0] = "other"
+	assert.Equal(t, "reporting", cfg.WorkloadPools["analytics"].Users[0])
+
+	cfg.WorkloadPools["oltp"].Users = []string{"reporting"}
+	err = cfg.Verify()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "user reporting is assigned to workload pools")
+
+	cfg.WorkloadPools["oltp"].Users = nil
+	cfg.WorkloadPools["oltp"].Size = 0
+	assert.EqualError(t, cfg.Verify(), "workload pool oltp: size must be > 0")
+}
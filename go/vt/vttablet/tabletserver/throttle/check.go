@@ -33,6 +33,7 @@ type CheckFlags struct {
 	OverrideThreshold float64
 	LowPriority       bool
 	OKIfNotExists     bool
+	MetricName        string // if non-empty, only this metric is checked
 }
 
 // StandardCheckFlags have no special hints
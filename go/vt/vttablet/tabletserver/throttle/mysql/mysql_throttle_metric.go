@@ -8,6 +8,9 @@ package mysql
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,10 +18,13 @@ import (
 	metrics "github.com/rcrowley/go-metrics"
 
 	"vitess.io/vitess/go/vt/orchestrator/external/golib/sqlutils"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
 )
 
 var mysqlMetricCache = cache.New(cache.NoExpiration, 10*time.Millisecond)
 
+var httpClient = base.SetupHTTPClient(0)
+
 func getMySQLMetricCacheKey(probe *Probe) string {
 	return fmt.Sprintf("%s:%s", probe.Key, probe.MetricQuery)
 }
@@ -67,7 +73,27 @@ func (metric *MySQLThrottleMetric) Get() (float64, error) {
 	return metric.Value, metric.Err
 }
 
-// ReadThrottleMetric returns a metric for the given probe. Either by explicit query
+// readHTTPThrottleMetric reads a metric via the HTTP check of the given probe. The check
+// is expected to respond with 200 OK and a numeric value as its body.
+func readHTTPThrottleMetric(probe *Probe) (float64, error) {
+	url := probe.GetHTTPCheckURL()
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("HTTP check %s returned status %d", url, resp.StatusCode)
+	}
+	return strconv.ParseFloat(strings.TrimSpace(string(body)), 64)
+}
+
+// ReadThrottleMetric returns a metric for the given probe. Either by HTTP check, by explicit query
 // or via SHOW SLAVE STATUS
 func ReadThrottleMetric(probe *Probe, clusterName string, overrideGetMetricFunc func() *MySQLThrottleMetric) (mySQLThrottleMetric *MySQLThrottleMetric) {
 	if mySQLThrottleMetric := getCachedMySQLThrottleMetric(probe); mySQLThrottleMetric != nil {
@@ -90,6 +116,11 @@ func ReadThrottleMetric(probe *Probe, clusterName string, overrideGetMetricFunc
 		}()
 	}(mySQLThrottleMetric, started)
 
+	if probe.HTTPCheckPort > 0 {
+		mySQLThrottleMetric.Value, mySQLThrottleMetric.Err = readHTTPThrottleMetric(probe)
+		return cacheMySQLThrottleMetric(probe, mySQLThrottleMetric)
+	}
+
 	if overrideGetMetricFunc != nil {
 		mySQLThrottleMetric = overrideGetMetricFunc()
 		return cacheMySQLThrottleMetric(probe, mySQLThrottleMetric)
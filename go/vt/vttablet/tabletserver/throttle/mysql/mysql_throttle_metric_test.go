@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadThrottleMetricHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			fmt.Fprintln(w, "42.5")
+		case "/garbage":
+			fmt.Fprintln(w, "not a number")
+		default:
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+
+	newProbe := func(path string) *Probe {
+		probe := NewProbe()
+		probe.Key = InstanceKey{Hostname: serverURL.Hostname(), Port: 3306}
+		probe.HTTPCheckPort = port
+		probe.HTTPCheckPath = path
+		return probe
+	}
+
+	metric := ReadThrottleMetric(newProbe("/ok"), "c0", nil)
+	require.NoError(t, metric.Err)
+	assert.Equal(t, 42.5, metric.Value)
+	assert.Equal(t, "c0", metric.ClusterName)
+
+	metric = ReadThrottleMetric(newProbe("/garbage"), "c0", nil)
+	assert.Error(t, metric.Err)
+
+	metric = ReadThrottleMetric(newProbe("/down"), "c0", nil)
+	assert.Error(t, metric.Err)
+}
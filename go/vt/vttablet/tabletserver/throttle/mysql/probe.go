@@ -9,6 +9,7 @@ package mysql
 import (
 	"fmt"
 	"net"
+	"strings"
 )
 
 const maxPoolConnections = 3
@@ -21,6 +22,8 @@ type Probe struct {
 	User            string
 	Password        string
 	MetricQuery     string
+	HTTPCheckPort   int
+	HTTPCheckPath   string
 	CacheMillis     int
 	QueryInProgress int64
 }
@@ -84,3 +87,22 @@ func (p *Probe) GetDBUri(databaseName string) string {
 	}
 	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?interpolateParams=true&charset=utf8mb4,utf8,latin1&timeout=%dms", p.User, p.Password, hostname, p.Key.Port, databaseName, timeoutMillis)
 }
+
+// GetHTTPCheckURL returns the URL of the HTTP check for the mysql server indicated by this probe.
+// The "self" probe checks on localhost.
+func (p *Probe) GetHTTPCheckURL() string {
+	hostname := p.Key.Hostname
+	if p.Key.IsSelf() {
+		hostname = "localhost"
+	}
+	var ip = net.ParseIP(hostname)
+	if (ip != nil) && (ip.To4() == nil) {
+		// Wrap IPv6 literals in square brackets
+		hostname = fmt.Sprintf("[%s]", hostname)
+	}
+	path := p.HTTPCheckPath
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return fmt.Sprintf("http://%s:%d%s", hostname, p.HTTPCheckPort, path)
+}
@@ -45,3 +45,18 @@ func TestDuplicate(t *testing.T) {
 	assert.Equal(t, "gromit", dup.User)
 	assert.Equal(t, "penguin", dup.Password)
 }
+
+func TestGetHTTPCheckURL(t *testing.T) {
+	c := NewProbe()
+	c.Key = InstanceKey{Hostname: "myhost", Port: 3306}
+	c.HTTPCheckPort = 8080
+	c.HTTPCheckPath = "metrics/lag"
+	assert.Equal(t, "http://myhost:8080/metrics/lag", c.GetHTTPCheckURL())
+
+	c.Key = InstanceKey{Hostname: "::1", Port: 3306}
+	c.HTTPCheckPath = "/lag"
+	assert.Equal(t, "http://[::1]:8080/lag", c.GetHTTPCheckURL())
+
+	c.Key = *SelfInstanceKey
+	assert.Equal(t, "http://localhost:8080/lag", c.GetHTTPCheckURL())
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -21,6 +22,7 @@ import (
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
@@ -56,10 +58,27 @@ const (
 
 	shardStoreName = "shard"
 	selfStoreName  = "self"
+
+	lagMetricName               = "lag"
+	threadsRunningMetricName    = "threads_running"
+	historyListLengthMetricName = "history_list_length"
+	customMetricName            = "custom"
+	httpMetricName              = "http"
+
+	defaultThreadsRunningThreshold    = 100.0
+	defaultHistoryListLengthThreshold = 1000000.0
 )
 
 var throttleThreshold = flag.Duration("throttle_threshold", 1*time.Second, "Replication lag threshold for throttling")
 var throttleTabletTypes = flag.String("throttle_tablet_types", "replica", "Comma separated VTTablet types to be considered by the throttler. default: 'replica'. example: 'replica,rdonly'. 'replica' aways implicitly included")
+var throttleMetrics = flag.String("throttle_metrics", lagMetricName, "Comma separated metrics checked by the throttler when an app does not ask for a specific metric; the check fails if any of them exceeds its threshold. Available: lag, threads_running, history_list_length, custom, http")
+var throttleThreadsRunningThreshold = flag.Float64("throttle_threads_running_threshold", 0, "Threads running threshold for throttling. The threads_running metric is only collected when listed in -throttle_metrics or when this is set; default 100 when listed")
+var throttleHistoryListLengthThreshold = flag.Float64("throttle_history_list_length_threshold", 0, "InnoDB history list length threshold for throttling. The history_list_length metric is only collected when listed in -throttle_metrics or when this is set; default 1000000 when listed")
+var throttleMetricsQuery = flag.String("throttle_metrics_query", "", "Query of the 'custom' throttler metric. Use either `SELECT` (must return single row, single value) or `SHOW GLOBAL ... LIKE ...` queries")
+var throttleMetricsThreshold = flag.Float64("throttle_metrics_threshold", 0, "Threshold of the 'custom' throttler metric")
+var throttleHTTPProbePort = flag.Int("throttle_http_probe_port", 0, "Port of the 'http' throttler metric. The throttler reads the metric from http://<mysql host>:<port><path>, which must respond with a numeric value; localhost is used for the tablet's own MySQL")
+var throttleHTTPProbePath = flag.String("throttle_http_probe_path", "/", "Path of the 'http' throttler metric")
+var throttleHTTPProbeThreshold = flag.Float64("throttle_http_probe_threshold", 0, "Threshold of the 'http' throttler metric")
 
 var (
 	throttlerUser  = "vt_tablet_throttler"
@@ -72,7 +91,13 @@ var (
 	sqlGrantThrottlerUser = []string{
 		`GRANT SELECT ON _vt.heartbeat TO %s`,
 	}
-	replicationLagQuery = `select unix_timestamp(now(6))-max(ts/1000000000) as replication_lag from _vt.heartbeat`
+	// sqlGrantThrottlerUserProcess is needed to read information_schema.innodb_metrics, and so is only
+	// granted when the history_list_length metric is collected
+	sqlGrantThrottlerUserProcess = `GRANT PROCESS ON *.* TO %s`
+
+	replicationLagQuery    = `select unix_timestamp(now(6))-max(ts/1000000000) as replication_lag from _vt.heartbeat`
+	threadsRunningQuery    = `show global status like 'threads_running'`
+	historyListLengthQuery = `select count as history_list_length from information_schema.innodb_metrics where name = 'trx_rseg_history_len'`
 )
 
 type ThrottleCheckType int
@@ -102,6 +127,7 @@ type Throttler struct {
 	ts             *topo.Server
 
 	throttleTabletTypesMap map[topodatapb.TabletType]bool
+	metricNames            []string
 
 	mysqlThrottleMetricChan chan *mysql.MySQLThrottleMetric
 	mysqlInventoryChan      chan *mysql.Inventory
@@ -121,6 +147,12 @@ type Throttler struct {
 	throttledAppsMutex sync.Mutex
 	tickers            [](*timer.SuspendableTicker)
 
+	// topoThrottledApps are the apps throttled by the keyspace record in the topo. They are kept
+	// apart from throttledApps, the apps throttled locally through the tablet's HTTP API, so that
+	// either source can lift its own throttling without affecting the other's.
+	topoThrottledApps      *cache.Cache
+	topoThrottledAppsMutex sync.Mutex
+
 	nonLowPriorityAppRequestsThrottled *cache.Cache
 	httpClient                         *http.Client
 }
@@ -164,13 +196,15 @@ func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topo
 		recentApps:             cache.New(recentAppsExpiration, time.Minute),
 		metricsHealth:          cache.New(cache.NoExpiration, 0),
 
-		tickers: [](*timer.SuspendableTicker){},
+		tickers:           [](*timer.SuspendableTicker){},
+		topoThrottledApps: cache.New(cache.NoExpiration, 10*time.Second),
 
 		nonLowPriorityAppRequestsThrottled: cache.New(nonDeprioritizedAppMapExpiration, nonDeprioritizedAppMapInterval),
 
 		httpClient: base.SetupHTTPClient(0),
 	}
 	throttler.initThrottleTabletTypes()
+	throttler.initThrottleMetrics()
 	throttler.ThrottleApp("abusing-app", time.Now().Add(time.Hour*24*365*10), defaultThrottleRatio)
 	throttler.check = NewThrottlerCheck(throttler)
 	throttler.initConfig("")
@@ -195,6 +229,94 @@ func (throttler *Throttler) initThrottleTabletTypes() {
 	throttler.throttleTabletTypesMap[topodatapb.TabletType_REPLICA] = true
 }
 
+// initThrottleMetrics reads the user supplied throttle_metrics, which are the metrics checked
+// when an app does not ask for a specific metric
+func (throttler *Throttler) initThrottleMetrics() {
+	throttler.metricNames = parseThrottleMetrics(*throttleMetrics, throttleMetricSettings(*throttleMetrics))
+}
+
+// parseThrottleMetrics returns the known metric names found in the given comma separated list.
+// It falls back to the lag metric when no known metric is listed.
+func parseThrottleMetrics(metrics string, settings map[string]*config.MySQLClusterConfigurationSettings) (metricNames []string) {
+	for _, token := range textutil.SplitDelimitedList(metrics) {
+		token = strings.ToLower(token)
+		if _, ok := settings[token]; !ok {
+			log.Errorf("Throttler: ignoring unknown or unconfigured metric %s", token)
+			continue
+		}
+		metricNames = append(metricNames, token)
+	}
+	if len(metricNames) == 0 {
+		metricNames = []string{lagMetricName}
+	}
+	return metricNames
+}
+
+// throttleMetricSettings returns the settings of the metrics the throttler collects, by metric name.
+// The lag metric is always collected. The threads_running and history_list_length metrics are only
+// collected when found in the given comma separated list of checked metrics, or when their threshold
+// is set. The custom and http metrics are only collected when configured.
+func throttleMetricSettings(metrics string) map[string]*config.MySQLClusterConfigurationSettings {
+	listed := map[string]bool{}
+	for _, token := range textutil.SplitDelimitedList(metrics) {
+		listed[strings.ToLower(token)] = true
+	}
+	settings := map[string]*config.MySQLClusterConfigurationSettings{
+		lagMetricName: {
+			ThrottleThreshold: throttleThreshold.Seconds(),
+			MetricQuery:       replicationLagQuery,
+		},
+	}
+	if listed[threadsRunningMetricName] || *throttleThreadsRunningThreshold > 0 {
+		settings[threadsRunningMetricName] = &config.MySQLClusterConfigurationSettings{
+			ThrottleThreshold: thresholdOrDefault(*throttleThreadsRunningThreshold, defaultThreadsRunningThreshold),
+			MetricQuery:       threadsRunningQuery,
+		}
+	}
+	if listed[historyListLengthMetricName] || *throttleHistoryListLengthThreshold > 0 {
+		settings[historyListLengthMetricName] = &config.MySQLClusterConfigurationSettings{
+			ThrottleThreshold: thresholdOrDefault(*throttleHistoryListLengthThreshold, defaultHistoryListLengthThreshold),
+			MetricQuery:       historyListLengthQuery,
+		}
+	}
+	if *throttleMetricsQuery != "" {
+		settings[customMetricName] = &config.MySQLClusterConfigurationSettings{
+			ThrottleThreshold: *throttleMetricsThreshold,
+			MetricQuery:       *throttleMetricsQuery,
+		}
+	}
+	if *throttleHTTPProbePort > 0 {
+		settings[httpMetricName] = &config.MySQLClusterConfigurationSettings{
+			ThrottleThreshold: *throttleHTTPProbeThreshold,
+			HTTPCheckPort:     *throttleHTTPProbePort,
+			HTTPCheckPath:     *throttleHTTPProbePath,
+		}
+	}
+	return settings
+}
+
+// thresholdOrDefault returns the given threshold, or the default one when the threshold is not set
+func thresholdOrDefault(threshold float64, defaultThreshold float64) float64 {
+	if threshold > 0 {
+		return threshold
+	}
+	return defaultThreshold
+}
+
+// metricClusterName returns the name of the MySQL cluster which collects the given metric for the
+// given store. The lag metric is collected by the clusters named after the stores themselves.
+func metricClusterName(storeName string, metricName string) string {
+	if metricName == lagMetricName {
+		return storeName
+	}
+	return fmt.Sprintf("%s.%s", storeName, metricName)
+}
+
+// isSelfCluster returns true when the given cluster collects a metric of this very tablet's MySQL
+func isSelfCluster(clusterName string) bool {
+	return clusterName == selfStoreName || strings.HasPrefix(clusterName, selfStoreName+".")
+}
+
 // InitDBConfig initializes keyspace and shard
 func (throttler *Throttler) InitDBConfig(keyspace, shard string) {
 	throttler.keyspace = keyspace
@@ -215,21 +337,27 @@ func (throttler *Throttler) initConfig(password string) {
 			},
 		},
 	}
-	config.Instance.Stores.MySQL.Clusters[selfStoreName] = &config.MySQLClusterConfigurationSettings{
-		User:              "", // running on local tablet server, will use vttablet DBA user
-		Password:          "", // running on local tablet server, will use vttablet DBA user
-		ThrottleThreshold: throttleThreshold.Seconds(),
-		MetricQuery:       replicationLagQuery,
-		IgnoreHostsCount:  0,
-	}
-	if password != "" {
-		config.Instance.Stores.MySQL.Clusters[shardStoreName] = &config.MySQLClusterConfigurationSettings{
-			User:              throttlerUser,
-			Password:          password,
-			ThrottleThreshold: throttleThreshold.Seconds(),
-			MetricQuery:       replicationLagQuery,
+	for metricName, metricSettings := range throttleMetricSettings(*throttleMetrics) {
+		config.Instance.Stores.MySQL.Clusters[metricClusterName(selfStoreName, metricName)] = &config.MySQLClusterConfigurationSettings{
+			User:              "", // running on local tablet server, will use vttablet DBA user
+			Password:          "", // running on local tablet server, will use vttablet DBA user
+			ThrottleThreshold: metricSettings.ThrottleThreshold,
+			MetricQuery:       metricSettings.MetricQuery,
+			HTTPCheckPort:     metricSettings.HTTPCheckPort,
+			HTTPCheckPath:     metricSettings.HTTPCheckPath,
 			IgnoreHostsCount:  0,
 		}
+		if password != "" {
+			config.Instance.Stores.MySQL.Clusters[metricClusterName(shardStoreName, metricName)] = &config.MySQLClusterConfigurationSettings{
+				User:              throttlerUser,
+				Password:          password,
+				ThrottleThreshold: metricSettings.ThrottleThreshold,
+				MetricQuery:       metricSettings.MetricQuery,
+				HTTPCheckPort:     metricSettings.HTTPCheckPort,
+				HTTPCheckPath:     metricSettings.HTTPCheckPath,
+				IgnoreHostsCount:  0,
+			}
+		}
 	}
 }
 
@@ -320,7 +448,7 @@ func (throttler *Throttler) createThrottlerUser(ctx context.Context) (password s
 			return password, err
 		}
 	}
-	for _, query := range sqlGrantThrottlerUser {
+	for _, query := range throttlerUserGrants(throttleMetricSettings(*throttleMetrics)) {
 		parsed := sqlparser.BuildParsedQuery(query, throttlerGrant)
 		if _, err := conn.ExecuteFetch(parsed.Query, 0, false); err != nil {
 			return password, err
@@ -330,10 +458,19 @@ func (throttler *Throttler) createThrottlerUser(ctx context.Context) (password s
 	return password, nil
 }
 
+// throttlerUserGrants returns the grants the throttler user needs to collect the given metrics
+func throttlerUserGrants(settings map[string]*config.MySQLClusterConfigurationSettings) []string {
+	grants := append([]string{}, sqlGrantThrottlerUser...)
+	if _, ok := settings[historyListLengthMetricName]; ok {
+		grants = append(grants, sqlGrantThrottlerUserProcess)
+	}
+	return grants
+}
+
 // readSelfMySQLThrottleMetric reads the mysql metric from thi very tablet's backend mysql.
-func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMetric {
+func (throttler *Throttler) readSelfMySQLThrottleMetric(clusterName string, metricQuery string) *mysql.MySQLThrottleMetric {
 	metric := &mysql.MySQLThrottleMetric{
-		ClusterName: selfStoreName,
+		ClusterName: clusterName,
 		Key:         *mysql.SelfInstanceKey,
 		Value:       3.14,
 		Err:         nil,
@@ -346,17 +483,19 @@ func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMe
 	}
 	defer conn.Recycle()
 
-	tm, err := conn.Exec(ctx, replicationLagQuery, 1, true)
+	tm, err := conn.Exec(ctx, metricQuery, 1, true)
 	if err != nil {
 		metric.Err = err
 		return metric
 	}
-	row := tm.Named().Row()
-	if row == nil {
+	if len(tm.Rows) == 0 || len(tm.Rows[0]) == 0 {
 		metric.Err = fmt.Errorf("no results for ReadSelfMySQLThrottleMetric")
 		return metric
 	}
-	metric.Value, metric.Err = row.ToFloat64("replication_lag")
+	// SELECT queries return the metric as the single value of the row; SHOW GLOBAL queries
+	// return the variable name followed by its value.
+	row := tm.Rows[0]
+	metric.Value, metric.Err = strconv.ParseFloat(row[len(row)-1].ToString(), 64)
 
 	return metric
 }
@@ -412,9 +551,12 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 					if shouldBeLeader > throttler.isLeader {
 						log.Infof("Throttler: transition into leadership")
 						shouldCreateThrottlerUser = true
+						// pick up the keyspace's throttled apps without waiting for the next tick
+						go throttledAppsTicker.TickNow()
 					}
 					if shouldBeLeader < throttler.isLeader {
 						log.Infof("Throttler: transition out of leadership")
+						go throttler.clearTopoThrottledApps()
 					}
 
 					atomic.StoreInt64(&throttler.isLeader, shouldBeLeader)
@@ -477,6 +619,10 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 			{
 				if atomic.LoadInt64(&throttler.isOpen) > 0 {
 					go throttler.expireThrottledApps()
+					if atomic.LoadInt64(&throttler.isLeader) > 0 {
+						// sparse, only the primary reads the keyspace record
+						go throttler.refreshTopoThrottledApps(ctx)
+					}
 				}
 			}
 		}
@@ -501,11 +647,13 @@ func (throttler *Throttler) collectMySQLMetrics(ctx context.Context) error {
 					}
 					defer atomic.StoreInt64(&probe.QueryInProgress, 0)
 
-					// Apply an override to metrics read, if this is the special "self" cluster
+					// Apply an override to metrics read, if this is a special "self" cluster
 					// (where we incidentally know there's a single probe)
-					overrideGetMySQLThrottleMetricFunc := throttler.readSelfMySQLThrottleMetric
-					if clusterName != selfStoreName {
-						overrideGetMySQLThrottleMetricFunc = nil
+					var overrideGetMySQLThrottleMetricFunc func() *mysql.MySQLThrottleMetric
+					if isSelfCluster(clusterName) {
+						overrideGetMySQLThrottleMetricFunc = func() *mysql.MySQLThrottleMetric {
+							return throttler.readSelfMySQLThrottleMetric(clusterName, probe.MetricQuery)
+						}
 					}
 					throttleMetrics := mysql.ReadThrottleMetric(probe, clusterName, overrideGetMySQLThrottleMetricFunc)
 					throttler.mysqlThrottleMetricChan <- throttleMetrics
@@ -532,11 +680,13 @@ func (throttler *Throttler) refreshMySQLInventory(ctx context.Context) error {
 		}
 
 		probe := &mysql.Probe{
-			Key:         *key,
-			User:        clusterSettings.User,
-			Password:    clusterSettings.Password,
-			MetricQuery: clusterSettings.MetricQuery,
-			CacheMillis: clusterSettings.CacheMillis,
+			Key:           *key,
+			User:          clusterSettings.User,
+			Password:      clusterSettings.Password,
+			MetricQuery:   clusterSettings.MetricQuery,
+			HTTPCheckPort: clusterSettings.HTTPCheckPort,
+			HTTPCheckPath: clusterSettings.HTTPCheckPath,
+			CacheMillis:   clusterSettings.CacheMillis,
 		}
 		(*probes)[*key] = probe
 	}
@@ -554,7 +704,7 @@ func (throttler *Throttler) refreshMySQLInventory(ctx context.Context) error {
 				InstanceProbes:   mysql.NewProbes(),
 			}
 
-			if clusterName == selfStoreName {
+			if isSelfCluster(clusterName) {
 				// special case: just looking at this tablet's MySQL server
 				// We will probe this "cluster" (of one server) is a special way.
 				addInstanceKey(mysql.SelfInstanceKey, clusterName, clusterSettings, clusterProbes.InstanceProbes)
@@ -648,6 +798,54 @@ func (throttler *Throttler) expireThrottledApps() {
 	}
 }
 
+// refreshTopoThrottledApps applies the throttled apps rules of the keyspace record in the topo,
+// so that apps throttled with vtctl are throttled by all primaries in the keyspace. Apps which
+// were throttled by a rule that has since been removed are no longer throttled by it. It is only
+// called on the primary, so that replicas don't all read the keyspace record.
+func (throttler *Throttler) refreshTopoThrottledApps(ctx context.Context) {
+	if throttler.ts == nil || throttler.keyspace == "" {
+		return
+	}
+	throttler.topoThrottledAppsMutex.Lock()
+	defer throttler.topoThrottledAppsMutex.Unlock()
+
+	ki, err := throttler.ts.GetKeyspace(ctx, throttler.keyspace)
+	if err != nil {
+		log.Errorf("Throttler: error reading keyspace %s: %+v", throttler.keyspace, err)
+		return
+	}
+	throttler.applyTopoThrottledApps(ki.ThrottledApps)
+}
+
+// clearTopoThrottledApps drops the rules of the keyspace record, which a tablet no longer
+// follows once it is not the primary. Apps throttled locally remain throttled.
+func (throttler *Throttler) clearTopoThrottledApps() {
+	throttler.topoThrottledAppsMutex.Lock()
+	defer throttler.topoThrottledAppsMutex.Unlock()
+
+	throttler.applyTopoThrottledApps(nil)
+}
+
+// applyTopoThrottledApps replaces the apps throttled by topo rules with the apps listed in the
+// given rules. Apps throttled locally are not affected.
+func (throttler *Throttler) applyTopoThrottledApps(rules []*topodatapb.ThrottledAppRule) {
+	now := time.Now()
+	listed := map[string]bool{}
+	for _, rule := range rules {
+		expireAt := logutil.ProtoToTime(rule.ExpiresAt)
+		if !expireAt.After(now) {
+			continue
+		}
+		throttler.topoThrottledApps.Set(rule.Name, base.NewAppThrottle(rule.Name, expireAt, rule.Ratio), expireAt.Sub(now))
+		listed[rule.Name] = true
+	}
+	for appName := range throttler.topoThrottledApps.Items() {
+		if !listed[appName] {
+			throttler.topoThrottledApps.Delete(appName)
+		}
+	}
+}
+
 // ThrottleApp instructs the throttler to begin throttling an app, to som eperiod and with some ratio.
 func (throttler *Throttler) ThrottleApp(appName string, expireAt time.Time, ratio float64) (appThrottle *base.AppThrottle) {
 	throttler.throttledAppsMutex.Lock()
@@ -689,8 +887,8 @@ func (throttler *Throttler) UnthrottleApp(appName string) (appThrottle *base.App
 // Assuming an app is throttled to some extend, it will randomize the result based
 // on the throttle ratio
 func (throttler *Throttler) IsAppThrottled(appName string) bool {
-	isSingleAppNameThrottled := func(singleAppName string) bool {
-		if object, found := throttler.throttledApps.Get(singleAppName); found {
+	isThrottledBy := func(throttledApps *cache.Cache, singleAppName string) bool {
+		if object, found := throttledApps.Get(singleAppName); found {
 			appThrottle := object.(*base.AppThrottle)
 			if appThrottle.ExpireAt.Before(time.Now()) {
 				// throttling cleanup hasn't purged yet, but it is expired
@@ -703,6 +901,10 @@ func (throttler *Throttler) IsAppThrottled(appName string) bool {
 		}
 		return false
 	}
+	isSingleAppNameThrottled := func(singleAppName string) bool {
+		// an app is throttled if either the local or the topo throttling says so
+		return isThrottledBy(throttler.throttledApps, singleAppName) || isThrottledBy(throttler.topoThrottledApps, singleAppName)
+	}
 	if isSingleAppNameThrottled(appName) {
 		return true
 	}
@@ -717,10 +919,15 @@ func (throttler *Throttler) IsAppThrottled(appName string) bool {
 	return false
 }
 
-// ThrottledAppsMap returns a (copy) map of currently throttled apps
+// ThrottledAppsMap returns a (copy) map of currently throttled apps, whether throttled locally or
+// by topo rules. An app throttled by both is listed with its local throttling.
 func (throttler *Throttler) ThrottledAppsMap() (result map[string](*base.AppThrottle)) {
 	result = make(map[string](*base.AppThrottle))
 
+	for appName, item := range throttler.topoThrottledApps.Items() {
+		appThrottle := item.Object.(*base.AppThrottle)
+		result[appName] = appThrottle
+	}
 	for appName, item := range throttler.throttledApps.Items() {
 		appThrottle := item.Object.(*base.AppThrottle)
 		result[appName] = appThrottle
@@ -778,12 +985,23 @@ func (throttler *Throttler) AppRequestMetricResult(ctx context.Context, appName
 	return metricResultFunc()
 }
 
-// checkStore checks the aggregated value of given MySQL store
+// checkStore checks the aggregated values of given MySQL store. It checks the metric named in flags,
+// or else all of the throttler's metrics, and returns the first result that is not OK.
 func (throttler *Throttler) checkStore(ctx context.Context, appName string, storeName string, remoteAddr string, flags *CheckFlags) (checkResult *CheckResult) {
 	if !throttler.env.Config().EnableLagThrottler {
 		return okMetricCheckResult
 	}
-	return throttler.check.Check(ctx, appName, "mysql", storeName, remoteAddr, flags)
+	metricNames := throttler.metricNames
+	if flags.MetricName != "" {
+		metricNames = []string{flags.MetricName}
+	}
+	for _, metricName := range metricNames {
+		checkResult = throttler.check.Check(ctx, appName, "mysql", metricClusterName(storeName, metricName), remoteAddr, flags)
+		if checkResult.StatusCode != http.StatusOK {
+			return checkResult
+		}
+	}
+	return checkResult
 }
 
 // Check is the main serving function of the throttler, and returns a check result for this cluster's lag; it is only applicable on a Primary tablet.
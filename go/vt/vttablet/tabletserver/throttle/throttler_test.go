@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/logutil"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestParseThrottleMetrics(t *testing.T) {
+	settings := throttleMetricSettings("lag, History_List_Length,threads_running")
+	assert.Contains(t, settings, lagMetricName)
+	assert.Contains(t, settings, threadsRunningMetricName)
+	assert.Contains(t, settings, historyListLengthMetricName)
+	assert.NotContains(t, settings, customMetricName)
+	assert.NotContains(t, settings, httpMetricName)
+	assert.Equal(t, defaultThreadsRunningThreshold, settings[threadsRunningMetricName].ThrottleThreshold)
+	assert.Equal(t, defaultHistoryListLengthThreshold, settings[historyListLengthMetricName].ThrottleThreshold)
+
+	assert.Equal(t, []string{lagMetricName}, parseThrottleMetrics("", settings))
+	assert.Equal(t, []string{lagMetricName}, parseThrottleMetrics("custom", settings))
+	assert.Equal(t, []string{lagMetricName, historyListLengthMetricName}, parseThrottleMetrics("lag, History_List_Length,unknown", settings))
+	assert.Equal(t, []string{threadsRunningMetricName}, parseThrottleMetrics("threads_running", settings))
+}
+
+func TestThrottleMetricSettings(t *testing.T) {
+	// Only the lag metric is collected by default
+	settings := throttleMetricSettings("lag")
+	assert.Equal(t, []string{lagMetricName}, parseThrottleMetrics("threads_running,history_list_length", settings))
+	assert.Len(t, settings, 1)
+	assert.Equal(t, []string{"GRANT SELECT ON _vt.heartbeat TO %s"}, throttlerUserGrants(settings))
+
+	// A metric with a configured threshold is collected, for apps asking for it, even when not listed
+	defer func(threshold float64) {
+		*throttleThreadsRunningThreshold = threshold
+	}(*throttleThreadsRunningThreshold)
+	*throttleThreadsRunningThreshold = 50
+	settings = throttleMetricSettings("lag")
+	require.Contains(t, settings, threadsRunningMetricName)
+	assert.Equal(t, 50.0, settings[threadsRunningMetricName].ThrottleThreshold)
+	assert.NotContains(t, settings, historyListLengthMetricName)
+	assert.Equal(t, []string{"GRANT SELECT ON _vt.heartbeat TO %s"}, throttlerUserGrants(settings))
+
+	// Reading the history list length needs the PROCESS privilege
+	settings = throttleMetricSettings("history_list_length")
+	assert.Contains(t, settings, historyListLengthMetricName)
+	assert.Equal(t, []string{"GRANT SELECT ON _vt.heartbeat TO %s", "GRANT PROCESS ON *.* TO %s"}, throttlerUserGrants(settings))
+}
+
+func TestMetricClusterName(t *testing.T) {
+	assert.Equal(t, "self", metricClusterName(selfStoreName, lagMetricName))
+	assert.Equal(t, "shard", metricClusterName(shardStoreName, lagMetricName))
+	assert.Equal(t, "self.threads_running", metricClusterName(selfStoreName, threadsRunningMetricName))
+	assert.Equal(t, "shard.history_list_length", metricClusterName(shardStoreName, historyListLengthMetricName))
+
+	assert.True(t, isSelfCluster(metricClusterName(selfStoreName, lagMetricName)))
+	assert.True(t, isSelfCluster(metricClusterName(selfStoreName, customMetricName)))
+	assert.False(t, isSelfCluster(metricClusterName(shardStoreName, lagMetricName)))
+	assert.False(t, isSelfCluster(metricClusterName(shardStoreName, httpMetricName)))
+}
+
+func TestApplyTopoThrottledApps(t *testing.T) {
+	throttler := &Throttler{
+		throttledApps:     cache.New(cache.NoExpiration, 0),
+		topoThrottledApps: cache.New(cache.NoExpiration, 0),
+	}
+	throttler.ThrottleApp("local-app", time.Now().Add(time.Hour), 1)
+	// vreplication is throttled both locally and by a topo rule
+	throttler.ThrottleApp("vreplication", time.Now().Add(2*time.Hour), 1)
+
+	expiresAt := logutil.TimeToProto(time.Now().Add(time.Hour))
+	rules := []*topodatapb.ThrottledAppRule{
+		{Name: "online-ddl", Ratio: 0.7, ExpiresAt: expiresAt},
+		{Name: "vreplication", Ratio: 0.2, ExpiresAt: expiresAt},
+		{Name: "expired", Ratio: 1, ExpiresAt: logutil.TimeToProto(time.Now().Add(-time.Minute))},
+	}
+	throttler.applyTopoThrottledApps(rules)
+	// Rules are applied again on every refresh
+	throttler.applyTopoThrottledApps(rules)
+
+	apps := throttler.ThrottledAppsMap()
+	assert.Contains(t, apps, "local-app")
+	assert.NotContains(t, apps, "expired")
+	if assert.Contains(t, apps, "online-ddl") {
+		assert.Equal(t, 0.7, apps["online-ddl"].Ratio)
+	}
+	// The topo rule does not override the local throttling
+	if assert.Contains(t, apps, "vreplication") {
+		assert.Equal(t, 1.0, apps["vreplication"].Ratio)
+	}
+	assert.True(t, throttler.IsAppThrottled("vreplication"))
+	assert.False(t, throttler.IsAppThrottled("backfill"))
+
+	// vreplication was removed from the keyspace record, but its local throttling
+	// and apps throttled locally are left alone.
+	throttler.applyTopoThrottledApps([]*topodatapb.ThrottledAppRule{
+		{Name: "online-ddl", Ratio: 1, ExpiresAt: expiresAt},
+	})
+
+	apps = throttler.ThrottledAppsMap()
+	assert.Contains(t, apps, "local-app")
+	assert.Contains(t, apps, "online-ddl")
+	assert.Contains(t, apps, "vreplication")
+	assert.True(t, throttler.IsAppThrottled("vreplication"))
+	assert.True(t, throttler.IsAppThrottled("online-ddl"))
+
+	// A tablet that is no longer the primary drops the keyspace rules.
+	throttler.clearTopoThrottledApps()
+
+	apps = throttler.ThrottledAppsMap()
+	assert.Contains(t, apps, "local-app")
+	assert.Contains(t, apps, "vreplication")
+	assert.NotContains(t, apps, "online-ddl")
+	assert.False(t, throttler.IsAppThrottled("online-ddl"))
+
+	// Unthrottling an app locally does not lift its topo rule
+	throttler.applyTopoThrottledApps([]*topodatapb.ThrottledAppRule{
+		{Name: "vreplication", Ratio: 1, ExpiresAt: expiresAt},
+	})
+	throttler.UnthrottleApp("vreplication")
+	assert.True(t, throttler.IsAppThrottled("vreplication"))
+}
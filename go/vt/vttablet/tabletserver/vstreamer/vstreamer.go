@@ -74,6 +74,12 @@ type vstreamer struct {
 	plans          map[uint64]*streamerPlan
 	journalTableID uint64
 	versionTableID uint64
+	// vreplicationTableID is the id of the _vt.vreplication table. It's
+	// tracked only if the filter excludes the events of a workflow.
+	vreplicationTableID uint64
+	// inExcludedTxn is set if the current transaction was applied by the
+	// workflow the filter excludes. Its rows are not sent.
+	inExcludedTxn bool
 
 	// format and pos are updated by parseEvent.
 	format  mysql.BinlogFormat
@@ -394,9 +400,11 @@ func (vs *vstreamer) parseEvent(ev mysql.BinlogEvent) ([]*binlogdatapb.VEvent, e
 			vevents = append(vevents, &binlogdatapb.VEvent{
 				Type: binlogdatapb.VEventType_BEGIN,
 			})
+			vs.inExcludedTxn = false
 		}
 		vs.pos = mysql.AppendGTID(vs.pos, gtid) //TODO: #sugu why Append?
 	case ev.IsXID():
+		vs.inExcludedTxn = false
 		vevents = append(vevents, &binlogdatapb.VEvent{
 			Type: binlogdatapb.VEventType_GTID,
 			Gtid: mysql.EncodePosition(vs.pos),
@@ -445,10 +453,12 @@ func (vs *vstreamer) parseEvent(ev mysql.BinlogEvent) ([]*binlogdatapb.VEvent, e
 				})
 			}
 		case sqlparser.StmtBegin:
+			vs.inExcludedTxn = false
 			vevents = append(vevents, &binlogdatapb.VEvent{
 				Type: binlogdatapb.VEventType_BEGIN,
 			})
 		case sqlparser.StmtCommit:
+			vs.inExcludedTxn = false
 			vevents = append(vevents, &binlogdatapb.VEvent{
 				Type: binlogdatapb.VEventType_COMMIT,
 			})
@@ -516,6 +526,9 @@ func (vs *vstreamer) parseEvent(ev mysql.BinlogEvent) ([]*binlogdatapb.VEvent, e
 		} else if tm.Database == "_vt" && tm.Name == "schema_version" && !vs.se.SkipMetaCheck {
 			// Generates a Version event when it detects that a schema is stored in the schema_version table.
 			return nil, vs.buildVersionPlan(id, tm)
+		} else if tm.Database == "_vt" && tm.Name == "vreplication" && vs.filter.GetExcludeVreplicationWorkflow() != "" {
+			// The streams of the excluded workflow mark the transactions they apply by writing to their row.
+			return nil, vs.buildVReplicationPlan(id, tm)
 		}
 		if tm.Database != "" && tm.Database != vs.cp.DBName() {
 			vs.plans[id] = nil
@@ -561,7 +574,9 @@ func (vs *vstreamer) parseEvent(ev mysql.BinlogEvent) ([]*binlogdatapb.VEvent, e
 			}
 			vevents = append(vevents, vevent)
 
-		} else {
+		} else if id == vs.vreplicationTableID {
+			err = vs.processVReplicationEvent(plan, rows)
+		} else if !vs.inExcludedTxn {
 			vevents, err = vs.processRowEvent(vevents, plan, rows)
 		}
 		if err != nil {
@@ -608,6 +623,37 @@ func (vs *vstreamer) buildJournalPlan(id uint64, tm *mysql.TableMap) error {
 	return nil
 }
 
+func (vs *vstreamer) buildVReplicationPlan(id uint64, tm *mysql.TableMap) error {
+	conn, err := vs.cp.Connect(vs.ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	qr, err := conn.ExecuteFetch("select * from _vt.vreplication where 1 != 1", 1, true)
+	if err != nil {
+		return err
+	}
+	fields := qr.Fields
+	if len(fields) < len(tm.Types) {
+		return fmt.Errorf("cannot determine table columns for %s: event has %v, schema as %v", tm.Name, tm.Types, fields)
+	}
+	table := &Table{
+		Name:   "_vt.vreplication",
+		Fields: fields[:len(tm.Types)],
+	}
+	// The rows are not sent: they only tell which workflow applied the transaction.
+	plan, err := buildREPlan(table, nil, "")
+	if err != nil {
+		return err
+	}
+	vs.plans[id] = &streamerPlan{
+		Plan:     plan,
+		TableMap: tm,
+	}
+	vs.vreplicationTableID = id
+	return nil
+}
+
 func (vs *vstreamer) buildVersionPlan(id uint64, tm *mysql.TableMap) error {
 	conn, err := vs.cp.Connect(vs.ctx)
 	if err != nil {
@@ -798,6 +844,34 @@ nextrow:
 	return vevents, nil
 }
 
+// processVReplicationEvent marks the current transaction as excluded if it writes to
+// the row of a stream of the excluded workflow that replicates into this database.
+func (vs *vstreamer) processVReplicationEvent(plan *streamerPlan, rows mysql.Rows) error {
+	for _, row := range rows.Rows {
+		afterOK, afterValues, err := vs.extractRowAndFilter(plan, row.Data, rows.DataColumns, row.NullColumns)
+		if err != nil {
+			return err
+		}
+		if !afterOK {
+			// The stream was deleted.
+			continue
+		}
+		var workflow, dbName string
+		for i, fld := range plan.fields() {
+			switch fld.Name {
+			case "workflow":
+				workflow = afterValues[i].ToString()
+			case "db_name":
+				dbName = afterValues[i].ToString()
+			}
+		}
+		if workflow == vs.filter.GetExcludeVreplicationWorkflow() && dbName == vs.cp.DBName() {
+			vs.inExcludedTxn = true
+		}
+	}
+	return nil
+}
+
 func (vs *vstreamer) processRowEvent(vevents []*binlogdatapb.VEvent, plan *streamerPlan, rows mysql.Rows) ([]*binlogdatapb.VEvent, error) {
 	rowChanges := make([]*binlogdatapb.RowChange, 0, len(rows.Rows))
 	for _, row := range rows.Rows {
@@ -1743,6 +1743,74 @@ func TestJournal(t *testing.T) {
 	runCases(t, nil, testcases, "", nil)
 }
 
+func TestExcludeVreplicationWorkflow(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	execStatements(t, []string{
+		"create table t1(id int, val varbinary(128), primary key(id))",
+		"create table _vt.vreplication(id int, workflow varbinary(1000), db_name varbinary(255), message varbinary(1000), primary key(id))",
+		"insert into _vt.vreplication values (1, 'wf_reverse', 'vttest', ''), (2, 'other', 'vttest', '')",
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+		"drop table _vt.vreplication",
+	})
+	engine.se.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*",
+		}},
+		ExcludeVreplicationWorkflow: "wf_reverse",
+	}
+	testcases := []testcase{{
+		// Transactions applied by the excluded workflow only send their position.
+		input: []string{
+			"begin",
+			"update _vt.vreplication set message='Applying transaction 1' where id=1",
+			"insert into t1 values (1, 'aaa')",
+			"update _vt.vreplication set message='' where id=1",
+			"commit",
+		},
+		output: [][]string{{
+			`begin`,
+			`type:FIELD field_event:<table_name:"t1" fields:<name:"id" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id" column_length:11 charset:63 > fields:<name:"val" type:VARBINARY table:"t1" org_table:"t1" database:"vttest" org_name:"val" column_length:128 charset:63 > > `,
+			`gtid`,
+			`commit`,
+		}},
+	}, {
+		// Transactions applied by other workflows are sent.
+		input: []string{
+			"begin",
+			"update _vt.vreplication set message='Applying transaction 2' where id=2",
+			"insert into t1 values (2, 'bbb')",
+			"update _vt.vreplication set message='' where id=2",
+			"commit",
+		},
+		output: [][]string{{
+			`begin`,
+			`type:ROW row_event:<table_name:"t1" row_changes:<after:<lengths:1 lengths:3 values:"2bbb" > > > `,
+			`gtid`,
+			`commit`,
+		}},
+	}, {
+		input: []string{
+			"begin",
+			"insert into t1 values (3, 'ccc')",
+			"commit",
+		},
+		output: [][]string{{
+			`begin`,
+			`type:ROW row_event:<table_name:"t1" row_changes:<after:<lengths:1 lengths:3 values:"3ccc" > > > `,
+			`gtid`,
+			`commit`,
+		}},
+	}}
+	runCases(t, filter, testcases, "", nil)
+}
+
 func TestMinimalMode(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"strings"
+
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// workloadPool is a named connection pool that isolates a class of
+// non-transactional queries, such as analytics, from the default pools.
+// It serves both regular and streaming queries.
+type workloadPool struct {
+	name          string
+	conns         *connpool.Pool
+	maxResultSize int64
+}
+
+// newWorkloadPools creates the workload pools of the config, and an index
+// of the pools by the usernames assigned to them.
+func newWorkloadPools(env tabletenv.Env) (map[string]*workloadPool, map[string]*workloadPool) {
+	config := env.Config()
+	pools := make(map[string]*workloadPool, len(config.WorkloadPools))
+	poolsByUser := make(map[string]*workloadPool)
+	for name, wpc := range config.WorkloadPools {
+		cfg := wpc.ConnPoolConfig
+		if cfg.IdleTimeoutSeconds == 0 {
+			cfg.IdleTimeoutSeconds = config.OltpReadPool.IdleTimeoutSeconds
+		}
+		wp := &workloadPool{
+			name:          name,
+			conns:         connpool.NewPool(env, "WorkloadPool"+strings.Title(name), cfg),
+			maxResultSize: int64(wpc.MaxResultSize),
+		}
+		pools[name] = wp
+		for _, user := range wpc.Users {
+			poolsByUser[user] = wp
+		}
+	}
+	return pools, poolsByUser
+}
+
+// workloadPoolFor returns the workload pool a query should run in: the one
+// assigned to the immediate caller, or else the one named by its WORKLOAD_POOL
+// directive. A caller assigned to a pool cannot switch out of it, so that the
+// pool keeps isolating its queries. It returns nil if the query belongs to the
+// default pools.
+func (qe *QueryEngine) workloadPoolFor(ctx context.Context, plan *TabletPlan) *workloadPool {
+	if len(qe.workloadPools) == 0 {
+		return nil
+	}
+	if wp, ok := qe.workloadPoolsByUser[callerid.ImmediateCallerIDFromContext(ctx).GetUsername()]; ok {
+		return wp
+	}
+	if plan != nil && plan.WorkloadPool != "" {
+		return qe.workloadPools[plan.WorkloadPool]
+	}
+	return nil
+}
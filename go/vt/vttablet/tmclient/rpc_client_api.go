@@ -198,11 +198,14 @@ type TabletManagerClient interface {
 	// Backup / restore related methods
 	//
 
-	// Backup creates a database backup
-	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error)
-
-	// RestoreFromBackup deletes local data and restores database from backup
-	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
+	// Backup creates a database backup. If incremental is set, it only
+	// archives the binary logs written since the most recent backup.
+	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool, incremental bool) (logutil.EventStream, error)
+
+	// RestoreFromBackup deletes local data and restores database from backup.
+	// If restoreToPos or restoreToTimestamp is set, it also replays binary logs
+	// from incremental backups up to that point.
+	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToPos string, restoreToTimestamp time.Time) (logutil.EventStream, error)
 
 	//
 	// Management methods
@@ -38,6 +38,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/topotools/events"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 	"vitess.io/vitess/go/vt/vterrors"
 )
 
@@ -91,6 +92,46 @@ func (wr *Wrangler) SetKeyspaceShardingInfo(ctx context.Context, keyspace, shard
 	return wr.ts.UpdateKeyspace(ctx, ki)
 }
 
+// SetKeyspaceBackupRetentionPolicy locks a keyspace and sets its backup
+// retention policy. A nil policy removes it.
+func (wr *Wrangler) SetKeyspaceBackupRetentionPolicy(ctx context.Context, keyspace string, policy *topodatapb.BackupRetentionPolicy) (err error) {
+	ctx, unlock, lockErr := wr.ts.LockKeyspace(ctx, keyspace, "SetKeyspaceBackupRetentionPolicy")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	ki, err := wr.ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	ki.BackupRetentionPolicy = policy
+	return wr.ts.UpdateKeyspace(ctx, ki)
+}
+
+// SetKeyspaceDurabilityPolicy sets the durability policy of the keyspace. An
+// empty policy resets the keyspace to the default policy.
+func (wr *Wrangler) SetKeyspaceDurabilityPolicy(ctx context.Context, keyspace string, policy string) (err error) {
+	if policy != "" {
+		if _, err := reparentutil.GetDurabilityPolicy(policy); err != nil {
+			return err
+		}
+	}
+
+	ctx, unlock, lockErr := wr.ts.LockKeyspace(ctx, keyspace, "SetKeyspaceDurabilityPolicy")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	ki, err := wr.ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	ki.DurabilityPolicy = policy
+	return wr.ts.UpdateKeyspace(ctx, ki)
+}
+
 // validateNewWorkflow ensures that the specified workflow doesn't already exist
 // in the keyspace.
 func (wr *Wrangler) validateNewWorkflow(ctx context.Context, keyspace, workflow string) error {
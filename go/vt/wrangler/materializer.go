@@ -55,6 +55,9 @@ type materializer struct {
 	targetVSchema *vindexes.KeyspaceSchema
 	sourceShards  []*topo.ShardInfo
 	targetShards  []*topo.ShardInfo
+	// startPositions contains the positions to start streaming from,
+	// by source shard. If it's not set, the streams copy the tables first.
+	startPositions map[string]string
 }
 
 const (
@@ -801,21 +804,29 @@ func (wr *Wrangler) prepareMaterializerStreams(ctx context.Context, ms *vtctldat
 	if err != nil {
 		return nil, err
 	}
-	if err := mz.deploySchema(ctx); err != nil {
+	if err := mz.prepareStreams(ctx); err != nil {
 		return nil, err
 	}
+	return mz, nil
+}
+
+// prepareStreams deploys the schema and creates the streams of the materializer.
+func (mz *materializer) prepareStreams(ctx context.Context) error {
+	if err := mz.deploySchema(ctx); err != nil {
+		return err
+	}
 	inserts, err := mz.generateInserts(ctx)
 	if err != nil {
-		return nil, err
-	}
-	if err := mz.createStreams(ctx, inserts); err != nil {
-		return nil, err
+		return err
 	}
-	return mz, nil
+	return mz.createStreams(ctx, inserts)
 }
 
 // Materialize performs the steps needed to materialize a list of tables based on the materialization specs.
 func (wr *Wrangler) Materialize(ctx context.Context, ms *vtctldatapb.MaterializeSettings) error {
+	if ms.Bidirectional {
+		return wr.materializeBidirectional(ctx, ms)
+	}
 	mz, err := wr.prepareMaterializerStreams(ctx, ms)
 	if err != nil {
 		return err
@@ -823,6 +834,104 @@ func (wr *Wrangler) Materialize(ctx context.Context, ms *vtctldatapb.Materialize
 	return mz.startStreams(ctx)
 }
 
+// materializeBidirectional creates the streams from the source to the target
+// keyspace, and the reverse streams from the target back to the source.
+// The reverse streams don't copy the tables. They start at the current positions
+// of the target shards, which are taken before the forward streams are started.
+func (wr *Wrangler) materializeBidirectional(ctx context.Context, ms *vtctldatapb.MaterializeSettings) error {
+	rms, err := reverseMaterializeSettings(ms)
+	if err != nil {
+		return err
+	}
+	if err := wr.validateNewWorkflow(ctx, rms.TargetKeyspace, rms.Workflow); err != nil {
+		return err
+	}
+	mz, err := wr.prepareMaterializerStreams(ctx, ms)
+	if err != nil {
+		return err
+	}
+	rmz, err := wr.buildMaterializer(ctx, rms)
+	if err != nil {
+		return err
+	}
+	rmz.startPositions = make(map[string]string, len(rmz.sourceShards))
+	for _, source := range rmz.sourceShards {
+		sourceMaster, err := wr.ts.GetTablet(ctx, source.MasterAlias)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetTablet(%v) failed", source.MasterAlias)
+		}
+		pos, err := wr.tmc.MasterPosition(ctx, sourceMaster.Tablet)
+		if err != nil {
+			return vterrors.Wrapf(err, "MasterPosition(%v) failed", source.MasterAlias)
+		}
+		rmz.startPositions[source.ShardName()] = pos
+	}
+	if err := rmz.prepareStreams(ctx); err != nil {
+		return err
+	}
+	if err := rmz.startStreams(ctx); err != nil {
+		return err
+	}
+	return mz.startStreams(ctx)
+}
+
+// reverseMaterializeSettings returns the settings of the streams that
+// replicate the changes made on the target keyspace back to the source.
+// This is possible only if all the tables are materialized as is.
+func reverseMaterializeSettings(ms *vtctldatapb.MaterializeSettings) (*vtctldatapb.MaterializeSettings, error) {
+	if ms.ExternalCluster != "" {
+		return nil, fmt.Errorf("bidirectional workflows are not supported for external clusters")
+	}
+	rms := &vtctldatapb.MaterializeSettings{
+		Workflow:       reverseName(ms.Workflow),
+		SourceKeyspace: ms.TargetKeyspace,
+		TargetKeyspace: ms.SourceKeyspace,
+		Cell:           ms.Cell,
+		TabletTypes:    ms.TabletTypes,
+		OnDdl:          ms.OnDdl,
+		Bidirectional:  true,
+		OnConflict:     ms.OnConflict,
+		ConflictColumn: ms.ConflictColumn,
+	}
+	for _, ts := range ms.TableSettings {
+		if !isTableCopy(ts) {
+			return nil, fmt.Errorf("bidirectional workflows require table %s to be materialized as is: %s", ts.TargetTable, ts.SourceExpression)
+		}
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select * from %v", sqlparser.NewTableIdent(ts.TargetTable))
+		rms.TableSettings = append(rms.TableSettings, &vtctldatapb.TableMaterializeSettings{
+			TargetTable:      ts.TargetTable,
+			SourceExpression: buf.String(),
+			OnDdl:            ts.OnDdl,
+		})
+	}
+	return rms, nil
+}
+
+// isTableCopy returns true if the source expression selects all the
+// rows and columns of the table with the same name as the target table.
+func isTableCopy(ts *vtctldatapb.TableMaterializeSettings) bool {
+	if ts.SourceExpression == "" {
+		return true
+	}
+	stmt, err := sqlparser.Parse(ts.SourceExpression)
+	if err != nil {
+		return false
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.SelectExprs) != 1 || len(sel.From) != 1 || sel.Where != nil || sel.GroupBy != nil {
+		return false
+	}
+	if _, ok := sel.SelectExprs[0].(*sqlparser.StarExpr); !ok {
+		return false
+	}
+	table, err := sqlparser.TableFromStatement(ts.SourceExpression)
+	if err != nil {
+		return false
+	}
+	return table.Qualifier.IsEmpty() && table.Name.String() == ts.TargetTable
+}
+
 func (wr *Wrangler) buildMaterializer(ctx context.Context, ms *vtctldatapb.MaterializeSettings) (*materializer, error) {
 	vschema, err := wr.ts.GetVSchema(ctx, ms.TargetKeyspace)
 	if err != nil {
@@ -939,6 +1048,13 @@ func (mz *materializer) deploySchema(ctx context.Context) error {
 						return fmt.Errorf("source and target table names must match for copying schema: %v vs %v", sqlparser.String(sourceTableName), ts.TargetTable)
 
 					}
+					computed, err := computedColumns(ts.SourceExpression)
+					if err != nil {
+						return err
+					}
+					if len(computed) != 0 {
+						return fmt.Errorf("schema cannot be copied for table %v because it has computed columns: %v; a create_ddl must be specified", ts.TargetTable, strings.Join(computed, ", "))
+					}
 				}
 
 				ddl, ok := sourceDDLs[ts.TargetTable]
@@ -1005,6 +1121,17 @@ func stripTableConstraints(ddl string) (string, error) {
 func (mz *materializer) generateInserts(ctx context.Context) (string, error) {
 	ig := vreplication.NewInsertGenerator(binlogplayer.BlpStopped, "{{.dbname}}")
 
+	onDDL, err := parseOnDDL(mz.ms.OnDdl)
+	if err != nil {
+		return "", err
+	}
+	onConflict, err := parseOnConflict(mz.ms.OnConflict)
+	if err != nil {
+		return "", err
+	}
+	if onConflict == binlogdatapb.OnConflictAction_LAST_WRITER_WINS && mz.ms.ConflictColumn == "" {
+		return "", fmt.Errorf("a conflict_column must be specified for %v", onConflict)
+	}
 	for _, source := range mz.sourceShards {
 		bls := &binlogdatapb.BinlogSource{
 			Keyspace:        mz.ms.SourceKeyspace,
@@ -1012,12 +1139,30 @@ func (mz *materializer) generateInserts(ctx context.Context) (string, error) {
 			Filter:          &binlogdatapb.Filter{},
 			StopAfterCopy:   mz.ms.StopAfterCopy,
 			ExternalCluster: mz.ms.ExternalCluster,
+			OnDdl:           onDDL,
+			OnConflict:      onConflict,
+			ConflictColumn:  mz.ms.ConflictColumn,
+		}
+		if mz.ms.Bidirectional {
+			// The streams don't send back the changes applied by the streams going the other way
+			bls.Filter.ExcludeVreplicationWorkflow = reverseName(mz.ms.Workflow)
 		}
 		for _, ts := range mz.ms.TableSettings {
 			rule := &binlogdatapb.Rule{
 				Match: ts.TargetTable,
 			}
 
+			if ts.OnDdl != "" {
+				tableOnDDL, err := parseOnDDL(ts.OnDdl)
+				if err != nil {
+					return "", err
+				}
+				if bls.TableOnDdl == nil {
+					bls.TableOnDdl = make(map[string]binlogdatapb.OnDDLAction)
+				}
+				bls.TableOnDdl[ts.TargetTable] = tableOnDDL
+			}
+
 			if ts.SourceExpression == "" {
 				bls.Filter.Rules = append(bls.Filter.Rules, rule)
 				continue
@@ -1069,11 +1214,67 @@ func (mz *materializer) generateInserts(ctx context.Context) (string, error) {
 
 			bls.Filter.Rules = append(bls.Filter.Rules, rule)
 		}
-		ig.AddRow(mz.ms.Workflow, bls, "", mz.ms.Cell, mz.ms.TabletTypes)
+		ig.AddRow(mz.ms.Workflow, bls, mz.startPositions[source.ShardName()], mz.ms.Cell, mz.ms.TabletTypes)
 	}
 	return ig.String(), nil
 }
 
+// computedColumns returns the names of the columns in the select statement
+// that are computed from expressions, like "concat(a, b) as c". The values
+// of these columns are evaluated by the target while applying the rows.
+func computedColumns(query string) ([]string, error) {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unrecognized statement: %s", query)
+	}
+	var computed []string
+	for _, selExpr := range sel.SelectExprs {
+		aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			continue
+		}
+		if _, ok := aliased.Expr.(*sqlparser.ColName); ok {
+			continue
+		}
+		if aliased.As.IsEmpty() {
+			computed = append(computed, sqlparser.String(aliased.Expr))
+			continue
+		}
+		computed = append(computed, aliased.As.String())
+	}
+	return computed, nil
+}
+
+// parseOnDDL returns the OnDDLAction for the specified name.
+// An empty name is treated as IGNORE.
+func parseOnDDL(name string) (binlogdatapb.OnDDLAction, error) {
+	if name == "" {
+		return binlogdatapb.OnDDLAction_IGNORE, nil
+	}
+	action, ok := binlogdatapb.OnDDLAction_value[strings.ToUpper(name)]
+	if !ok {
+		return 0, fmt.Errorf("invalid on_ddl action: %s", name)
+	}
+	return binlogdatapb.OnDDLAction(action), nil
+}
+
+// parseOnConflict returns the OnConflictAction for the specified name.
+// An empty name is treated as OVERWRITE.
+func parseOnConflict(name string) (binlogdatapb.OnConflictAction, error) {
+	if name == "" {
+		return binlogdatapb.OnConflictAction_OVERWRITE, nil
+	}
+	action, ok := binlogdatapb.OnConflictAction_value[strings.ToUpper(name)]
+	if !ok {
+		return 0, fmt.Errorf("invalid on_conflict action: %s", name)
+	}
+	return binlogdatapb.OnConflictAction(action), nil
+}
+
 func matchColInSelect(col sqlparser.ColIdent, sel *sqlparser.Select) (*sqlparser.ColName, error) {
 	for _, selExpr := range sel.SelectExprs {
 		switch selExpr := selExpr.(type) {
@@ -286,3 +286,7 @@ func (tmc *testMaterializerTMClient) ApplySchema(ctx context.Context, tablet *to
 
 	return nil, nil
 }
+
+func (tmc *testMaterializerTMClient) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	return fmt.Sprintf("MariaDB/0-1-%d", tablet.Alias.Uid), nil
+}
@@ -1603,6 +1603,75 @@ func TestMaterializerOneToOne(t *testing.T) {
 	env.tmc.verifyQueries(t)
 }
 
+func TestMaterializerOnDDL(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		OnDdl:          "exec_additive",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{
+			{
+				TargetTable:      "t1",
+				SourceExpression: "select * from t1",
+				CreateDdl:        "t1ddl",
+			},
+			{
+				TargetTable:      "t2",
+				SourceExpression: "select * from t3",
+				CreateDdl:        "t2ddl",
+				OnDdl:            "STOP",
+			},
+		},
+		Cell:        "zone1",
+		TabletTypes: "master,rdonly",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(
+		200,
+		insertPrefix+
+			`\(`+
+			`'workflow', `+
+			(`'keyspace:\\"sourceks\\" shard:\\"0\\" `+
+				`filter:<`+
+				`rules:<match:\\"t1\\" filter:\\"select.*t1\\" > `+
+				`rules:<match:\\"t2\\" filter:\\"select.*t3\\" > `+
+				`> on_ddl:EXEC_ADDITIVE `+
+				`table_on_ddl:<key:\\"t2\\" value:STOP > ', `)+
+			`'', [0-9]*, [0-9]*, 'zone1', 'master,rdonly', [0-9]*, 0, 'Stopped', 'vt_targetks'`+
+			`\)`+eol,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestMaterializerInvalidOnDDL(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+			CreateDdl:        "t1ddl",
+			OnDdl:            "drop",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	require.EqualError(t, err, "invalid on_ddl action: drop")
+}
+
 func TestMaterializerManyToOne(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
@@ -1852,6 +1921,131 @@ func TestMaterializerDeploySchema(t *testing.T) {
 	require.Equal(t, env.tmc.getSchemaRequestCount(200), 1)
 }
 
+func TestMaterializerBidirectional(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+			CreateDdl:        "t1ddl",
+		}},
+		Bidirectional:  true,
+		OnConflict:     "last_writer_wins",
+		ConflictColumn: "updated_at",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(100, "select 1 from _vt.vreplication where db_name='vt_sourceks' and workflow='workflow_reverse'", &sqltypes.Result{})
+	env.tmc.expectVRQuery(100, "select 1 from _vt.vreplication where db_name='vt_sourceks' and message='FROZEN'", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(
+		200,
+		insertPrefix+
+			`\('workflow', 'keyspace:\\"sourceks\\" shard:\\"0\\" filter:<rules:<match:\\"t1\\" filter:\\"select.*t1\\" > exclude_vreplication_workflow:\\"workflow_reverse\\" > on_conflict:LAST_WRITER_WINS conflict_column:\\"updated_at\\" ', '', [0-9]*, [0-9]*, '', '', [0-9]*, 0, 'Stopped', 'vt_targetks'\)`+
+			eol,
+		&sqltypes.Result{},
+	)
+	// The reverse streams start at the current position of the target.
+	env.tmc.expectVRQuery(
+		100,
+		insertPrefix+
+			`\('workflow_reverse', 'keyspace:\\"targetks\\" shard:\\"0\\" filter:<rules:<match:\\"t1\\" filter:\\"select.*t1\\" > exclude_vreplication_workflow:\\"workflow\\" > on_conflict:LAST_WRITER_WINS conflict_column:\\"updated_at\\" ', 'MariaDB/0-1-200', [0-9]*, [0-9]*, '', '', [0-9]*, 0, 'Stopped', 'vt_sourceks'\)`+
+			eol,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(100, "update _vt.vreplication set state='Running' where db_name='vt_sourceks' and workflow='workflow_reverse'", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestMaterializerBidirectionalErrors(t *testing.T) {
+	testcases := []struct {
+		ms  *vtctldatapb.MaterializeSettings
+		err string
+	}{{
+		ms: &vtctldatapb.MaterializeSettings{
+			Workflow:       "workflow",
+			SourceKeyspace: "sourceks",
+			TargetKeyspace: "targetks",
+			TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+				TargetTable:      "t1",
+				SourceExpression: "select c1, c2 from t1",
+				CreateDdl:        "t1ddl",
+			}},
+			Bidirectional: true,
+		},
+		err: "bidirectional workflows require table t1 to be materialized as is: select c1, c2 from t1",
+	}, {
+		ms: &vtctldatapb.MaterializeSettings{
+			Workflow:       "workflow",
+			SourceKeyspace: "sourceks",
+			TargetKeyspace: "targetks",
+			TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+				TargetTable:      "t1",
+				SourceExpression: "select * from t1 where c1 > 0",
+				CreateDdl:        "t1ddl",
+			}},
+			Bidirectional: true,
+		},
+		err: "bidirectional workflows require table t1 to be materialized as is: select * from t1 where c1 > 0",
+	}, {
+		ms: &vtctldatapb.MaterializeSettings{
+			Workflow:       "workflow",
+			SourceKeyspace: "sourceks",
+			TargetKeyspace: "targetks",
+			TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+				TargetTable:      "t1",
+				SourceExpression: "select * from t1",
+				CreateDdl:        "t1ddl",
+			}},
+			Bidirectional:   true,
+			ExternalCluster: "ext1",
+		},
+		err: "bidirectional workflows are not supported for external clusters",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.err, func(t *testing.T) {
+			env := newTestMaterializerEnv(t, tcase.ms, []string{"0"}, []string{"0"})
+			defer env.close()
+
+			err := env.wr.Materialize(context.Background(), tcase.ms)
+			require.EqualError(t, err, tcase.err)
+		})
+	}
+}
+
+func TestMaterializerInvalidOnConflict(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+			CreateDdl:        "t1ddl",
+		}},
+		OnConflict: "last_writer_wins",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	err := env.wr.Materialize(context.Background(), ms)
+	require.EqualError(t, err, "a conflict_column must be specified for LAST_WRITER_WINS")
+
+	ms.OnConflict = "first_writer_wins"
+	env.tmc.expectVRQuery(200, "select 1 from _vt.vreplication where db_name='vt_targetks' and workflow='workflow'", &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	err = env.wr.Materialize(context.Background(), ms)
+	require.EqualError(t, err, "invalid on_conflict action: first_writer_wins")
+}
+
 func TestMaterializerCopySchema(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
@@ -1891,6 +2085,28 @@ func TestMaterializerCopySchema(t *testing.T) {
 
 }
 
+func TestMaterializerCopySchemaComputedColumns(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select c1, concat(c2, '-', c3) as c4 from t1",
+			CreateDdl:        "copy",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	delete(env.tmc.schema, "targetks.t1")
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	require.EqualError(t, err, "schema cannot be copied for table t1 because it has computed columns: c4; a create_ddl must be specified")
+}
+
 func TestMaterializerExplicitColumns(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
@@ -139,6 +139,7 @@ type tsSource struct {
 const (
 	workflowTypeReshard    = "Reshard"
 	workflowTypeMoveTables = "MoveTables"
+	workflowTypeMigrate    = "Migrate"
 )
 
 type workflowState struct {
@@ -24,19 +24,20 @@ import (
 	"sync"
 	"time"
 
-	"github.com/golang/protobuf/proto"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
-	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
 const (
@@ -383,114 +384,81 @@ type ReplicationStatus struct {
 	TimeUpdated int64
 	// Message represents the message column from the _vt.vreplication table.
 	Message string
+	// PendingDDL is the DDL the stream stopped at, if it's waiting for an operator
+	// to apply it. See binlogdatapb.OnDDLAction_EXEC_ADDITIVE.
+	PendingDDL string `json:",omitempty"`
 
 	// CopyState represents the rows from the _vt.copy_state table.
 	CopyState []copyState
 }
 
-func (wr *Wrangler) getReplicationStatusFromRow(ctx context.Context, row []sqltypes.Value, master *topo.TabletInfo) (*ReplicationStatus, string, error) {
-	var err error
-	var id, timeUpdated, transactionTimestamp int64
-	var state, dbName, pos, stopPos, message string
-	var bls binlogdatapb.BinlogSource
-	id, err = evalengine.ToInt64(row[0])
-	if err != nil {
-		return nil, "", err
-	}
-	if err := proto.UnmarshalText(row[1].ToString(), &bls); err != nil {
-		return nil, "", err
-	}
-	pos = row[2].ToString()
-	stopPos = row[3].ToString()
-	state = row[5].ToString()
-	dbName = row[6].ToString()
-	timeUpdated, err = evalengine.ToInt64(row[7])
-	if err != nil {
-		return nil, "", err
-	}
-	transactionTimestamp, err = evalengine.ToInt64(row[8])
-	if err != nil {
-		return nil, "", err
-	}
-	message = row[9].ToString()
-	status := &ReplicationStatus{
-		Shard:                master.Shard,
-		Tablet:               master.AliasString(),
-		ID:                   id,
-		Bls:                  bls,
-		Pos:                  pos,
-		StopPos:              stopPos,
-		State:                state,
-		DBName:               dbName,
-		TransactionTimestamp: transactionTimestamp,
-		TimeUpdated:          timeUpdated,
-		Message:              message,
-	}
-	status.CopyState, err = wr.getCopyState(ctx, master, id)
-	if err != nil {
-		return nil, "", err
-	}
-
-	status.State = updateState(message, status.State, status.CopyState, timeUpdated)
-	return status, bls.Keyspace, nil
-}
-
-func (wr *Wrangler) getStreams(ctx context.Context, workflow, keyspace string) (*ReplicationStatusResult, error) {
+// getStreams returns the streams of a workflow, as read by the workflow
+// server, grouped by target shard and master.
+func (wr *Wrangler) getStreams(ctx context.Context, workflowName, keyspace string) (*ReplicationStatusResult, error) {
 	var rsr ReplicationStatusResult
 	rsr.ShardStatuses = make(map[string]*ShardReplicationStatus)
-	rsr.Workflow = workflow
-	var results map[*topo.TabletInfo]*querypb.QueryResult
-	query := "select id, source, pos, stop_pos, max_replication_lag, state, db_name, time_updated, transaction_timestamp, message from _vt.vreplication"
-	results, err := wr.runVexec(ctx, workflow, keyspace, query, false)
+	rsr.Workflow = workflowName
+	rsr.SourceLocation.Shards = []string{}
+	rsr.TargetLocation = ReplicationLocation{
+		Keyspace: keyspace,
+		Shards:   []string{},
+	}
+
+	statuses, err := workflow.NewServer(wr.ts, wr.tmc).ReadWorkflowStatuses(ctx, keyspace, workflowName)
 	if err != nil {
 		return nil, err
 	}
+	if len(statuses) == 0 {
+		return &rsr, nil
+	}
+	status := statuses[0]
+	rsr.SourceLocation = ReplicationLocation{
+		Keyspace: status.SourceKeyspace,
+		Shards:   status.SourceShards,
+	}
+	rsr.TargetLocation.Shards = status.TargetShards
+	rsr.MaxVReplicationLag = status.MaxVReplicationLag
 
 	// We set a topo timeout since we contact topo for the shard record.
 	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
 	defer cancel()
-	var sourceKeyspace string
-	sourceShards := sets.NewString()
-	targetShards := sets.NewString()
-	for master, result := range results {
-		var rsrStatus []*ReplicationStatus
-		qr := sqltypes.Proto3ToResult(result)
-		if len(qr.Rows) == 0 {
-			continue
-		}
-		for _, row := range qr.Rows {
-			status, sk, err := wr.getReplicationStatusFromRow(ctx, row, master)
+	for _, stream := range status.Streams {
+		key := fmt.Sprintf("%s/%s", stream.Shard, topoproto.TabletAliasString(stream.Tablet))
+		shardStatus, ok := rsr.ShardStatuses[key]
+		if !ok {
+			si, err := wr.ts.GetShard(ctx, keyspace, stream.Shard)
 			if err != nil {
 				return nil, err
 			}
-			sourceKeyspace = sk
-			sourceShards.Insert(status.Bls.Shard)
-			rsrStatus = append(rsrStatus, status)
-
-			timeUpdated := time.Unix(status.TimeUpdated, 0)
-			replicationLag := time.Since(timeUpdated)
-			if replicationLag.Seconds() > float64(rsr.MaxVReplicationLag) {
-				rsr.MaxVReplicationLag = int64(replicationLag.Seconds())
+			shardStatus = &ShardReplicationStatus{
+				TabletControls:  si.TabletControls,
+				MasterIsServing: si.IsMasterServing,
 			}
+			rsr.ShardStatuses[key] = shardStatus
 		}
-		si, err := wr.ts.GetShard(ctx, keyspace, master.Shard)
-		if err != nil {
-			return nil, err
-		}
-		targetShards.Insert(si.ShardName())
-		rsr.ShardStatuses[fmt.Sprintf("%s/%s", master.Shard, master.AliasString())] = &ShardReplicationStatus{
-			MasterReplicationStatuses: rsrStatus,
-			TabletControls:            si.TabletControls,
-			MasterIsServing:           si.IsMasterServing,
+
+		var cs []copyState
+		for _, copyStatus := range stream.CopyStates {
+			cs = append(cs, copyState{
+				Table:  copyStatus.Table,
+				LastPK: copyStatus.LastPk,
+			})
 		}
-	}
-	rsr.SourceLocation = ReplicationLocation{
-		Keyspace: sourceKeyspace,
-		Shards:   sourceShards.List(),
-	}
-	rsr.TargetLocation = ReplicationLocation{
-		Keyspace: keyspace,
-		Shards:   targetShards.List(),
+		shardStatus.MasterReplicationStatuses = append(shardStatus.MasterReplicationStatuses, &ReplicationStatus{
+			Shard:                stream.Shard,
+			Tablet:               topoproto.TabletAliasString(stream.Tablet),
+			ID:                   stream.Id,
+			Bls:                  *stream.BinlogSource,
+			Pos:                  stream.Position,
+			StopPos:              stream.StopPosition,
+			State:                stream.State,
+			DBName:               stream.DbName,
+			TransactionTimestamp: logutil.ProtoToTime(stream.TransactionTimestamp).Unix(),
+			TimeUpdated:          logutil.ProtoToTime(stream.TimeUpdated).Unix(),
+			Message:              stream.Message,
+			PendingDDL:           stream.PendingDdl,
+			CopyState:            cs,
+		})
 	}
 
 	return &rsr, nil
@@ -542,17 +510,6 @@ func (wr *Wrangler) ShowWorkflow(ctx context.Context, workflow, keyspace string)
 	return replStatus, nil
 }
 
-func updateState(message, state string, cs []copyState, timeUpdated int64) string {
-	if strings.Contains(strings.ToLower(message), "error") {
-		state = "Error"
-	} else if state == "Running" && len(cs) > 0 {
-		state = "Copying"
-	} else if state == "Running" && int64(time.Now().Second())-timeUpdated > 10 /* seconds */ {
-		state = "Lagging"
-	}
-	return state
-}
-
 func dumpStreamListAsJSON(replStatus *ReplicationStatusResult, wr *Wrangler) error {
 	text, err := json.MarshalIndent(replStatus, "", "\t")
 	if err != nil {
@@ -570,28 +527,3 @@ func (wr *Wrangler) printWorkflowList(keyspace string, workflows []string) {
 	}
 	wr.Logger().Printf("Following workflow(s) found in keyspace %s: %v\n", keyspace, list)
 }
-
-func (wr *Wrangler) getCopyState(ctx context.Context, tablet *topo.TabletInfo, id int64) ([]copyState, error) {
-	var cs []copyState
-	query := fmt.Sprintf("select table_name, lastpk from _vt.copy_state where vrepl_id = %d", id)
-	qr, err := wr.VReplicationExec(ctx, tablet.Alias, query)
-	if err != nil {
-		return nil, err
-	}
-
-	result := sqltypes.Proto3ToResult(qr)
-	if result != nil {
-		for _, row := range result.Rows {
-			// These fields are varbinary, but close enough
-			table := row[0].ToString()
-			lastPK := row[1].ToString()
-			copyState := copyState{
-				Table:  table,
-				LastPK: lastPK,
-			}
-			cs = append(cs, copyState)
-		}
-	}
-
-	return cs, nil
-}
@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/test/utils"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
 )
 
 func TestVExec(t *testing.T) {
@@ -167,11 +168,11 @@ func TestVExec(t *testing.T) {
 }
 
 func TestWorkflowStatusUpdate(t *testing.T) {
-	require.Equal(t, "Running", updateState("for vdiff", "Running", nil, int64(time.Now().Second())))
-	require.Equal(t, "Running", updateState("", "Running", nil, int64(time.Now().Second())))
-	require.Equal(t, "Lagging", updateState("", "Running", nil, int64(time.Now().Second())-100))
-	require.Equal(t, "Copying", updateState("", "Running", []copyState{{Table: "t1", LastPK: "[[INT64(10)]]"}}, int64(time.Now().Second())))
-	require.Equal(t, "Error", updateState("error: master tablet not contactable", "Running", nil, 0))
+	require.Equal(t, "Running", workflow.StreamState("for vdiff", "Running", false, time.Now().Unix()))
+	require.Equal(t, "Running", workflow.StreamState("", "Running", false, time.Now().Unix()))
+	require.Equal(t, "Lagging", workflow.StreamState("", "Running", false, time.Now().Unix()-100))
+	require.Equal(t, "Copying", workflow.StreamState("", "Running", true, time.Now().Unix()))
+	require.Equal(t, "Error", workflow.StreamState("error: master tablet not contactable", "Running", false, 0))
 }
 
 func TestWorkflowListStreams(t *testing.T) {
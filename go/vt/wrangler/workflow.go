@@ -9,7 +9,9 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"vitess.io/vitess/go/vt/log"
@@ -171,14 +173,23 @@ func (vrw *VReplicationWorkflow) Create() error {
 		return fmt.Errorf("workflow has already been created, state is %s", vrw.CachedState())
 	}
 	switch vrw.workflowType {
-	case MoveTablesWorkflow, MigrateWorkflow:
-		err = vrw.initMoveTables()
-	case ReshardWorkflow:
-		err = vrw.initReshard()
+	case MoveTablesWorkflow, MigrateWorkflow, ReshardWorkflow:
 	default:
 		return fmt.Errorf("unknown workflow type %d", vrw.workflowType)
 	}
+	// The record is created first so that a workflow with the same name that
+	// is still in progress is detected before any stream is created.
+	if err := workflow.Create(vrw.ctx, vrw.wr.ts, vrw.params.TargetKeyspace, vrw.params.Workflow,
+		vrw.workflowTypeName(), vrw.params.SourceKeyspace); err != nil {
+		return err
+	}
+	if vrw.workflowType == ReshardWorkflow {
+		err = vrw.initReshard()
+	} else {
+		err = vrw.initMoveTables()
+	}
 	if err != nil {
+		vrw.deleteRecord(vrw.params.TargetKeyspace, vrw.params.Workflow)
 		return err
 	}
 	return nil
@@ -252,7 +263,13 @@ func (vrw *VReplicationWorkflow) SwitchTraffic(direction TrafficSwitchDirection)
 	if isCopyInProgress {
 		return nil, fmt.Errorf("cannot switch traffic at this time, copy is still in progress for this workflow")
 	}
+	if direction == DirectionForward && !vrw.params.DryRun {
+		vrw.recordCopyDone(vrw.params.TargetKeyspace, vrw.params.Workflow)
+	}
 
+	// switchWrites points the params to the reverse workflow when switching
+	// backwards, so the lifecycle state is recorded for the original one.
+	targetKeyspace, workflowName := vrw.params.TargetKeyspace, vrw.params.Workflow
 	vrw.params.Direction = direction
 	hasReplica, hasRdonly, hasMaster, err = vrw.parseTabletTypes()
 	if err != nil {
@@ -274,6 +291,9 @@ func (vrw *VReplicationWorkflow) SwitchTraffic(direction TrafficSwitchDirection)
 	if wrDryRunResults != nil {
 		dryRunResults = append(dryRunResults, *wrDryRunResults...)
 	}
+	if !vrw.params.DryRun {
+		vrw.recordSwitchedState(targetKeyspace, workflowName)
+	}
 	return &dryRunResults, nil
 }
 
@@ -301,8 +321,14 @@ func (vrw *VReplicationWorkflow) Complete() (*[]string, error) {
 	ws := vrw.ws
 
 	if vrw.workflowType == MigrateWorkflow {
-		return vrw.wr.finalizeMigrateWorkflow(vrw.ctx, ws.TargetKeyspace, ws.Workflow, vrw.params.Tables,
-			false, vrw.params.KeepData, vrw.params.DryRun)
+		if dryRunResults, err = vrw.wr.finalizeMigrateWorkflow(vrw.ctx, ws.TargetKeyspace, ws.Workflow, vrw.params.Tables,
+			false, vrw.params.KeepData, vrw.params.DryRun); err != nil {
+			return nil, err
+		}
+		if !vrw.params.DryRun {
+			vrw.recordState(ws.TargetKeyspace, ws.Workflow, workflow.StateCompleted)
+		}
+		return dryRunResults, nil
 	}
 
 	if !ws.WritesSwitched || len(ws.ReplicaCellsNotSwitched) > 0 || len(ws.RdonlyCellsNotSwitched) > 0 {
@@ -318,6 +344,9 @@ func (vrw *VReplicationWorkflow) Complete() (*[]string, error) {
 		false, vrw.params.KeepData, vrw.params.DryRun); err != nil {
 		return nil, err
 	}
+	if !vrw.params.DryRun {
+		vrw.recordState(ws.TargetKeyspace, ws.Workflow, workflow.StateCompleted)
+	}
 	return dryRunResults, nil
 }
 
@@ -325,9 +354,14 @@ func (vrw *VReplicationWorkflow) Complete() (*[]string, error) {
 func (vrw *VReplicationWorkflow) Cancel() error {
 	ws := vrw.ws
 	if vrw.workflowType == MigrateWorkflow {
-		_, err := vrw.wr.finalizeMigrateWorkflow(vrw.ctx, ws.TargetKeyspace, ws.Workflow, "",
-			true, vrw.params.KeepData, vrw.params.DryRun)
-		return err
+		if _, err := vrw.wr.finalizeMigrateWorkflow(vrw.ctx, ws.TargetKeyspace, ws.Workflow, "",
+			true, vrw.params.KeepData, vrw.params.DryRun); err != nil {
+			return err
+		}
+		if !vrw.params.DryRun {
+			vrw.recordState(ws.TargetKeyspace, ws.Workflow, workflow.StateCancelled)
+		}
+		return nil
 	}
 
 	if ws.WritesSwitched || len(ws.ReplicaCellsSwitched) > 0 || len(ws.RdonlyCellsSwitched) > 0 {
@@ -337,6 +371,7 @@ func (vrw *VReplicationWorkflow) Cancel() error {
 		return err
 	}
 	vrw.ts = nil
+	vrw.recordState(ws.TargetKeyspace, ws.Workflow, workflow.StateCancelled)
 	return nil
 }
 
@@ -344,6 +379,68 @@ func (vrw *VReplicationWorkflow) Cancel() error {
 
 // region Helpers
 
+func (vrw *VReplicationWorkflow) workflowTypeName() string {
+	switch vrw.workflowType {
+	case ReshardWorkflow:
+		return workflowTypeReshard
+	case MigrateWorkflow:
+		return workflowTypeMigrate
+	default:
+		return workflowTypeMoveTables
+	}
+}
+
+// recordState persists the lifecycle state of a workflow. The action that
+// led to the new state has already been applied at this point, so failures
+// are logged rather than returned.
+func (vrw *VReplicationWorkflow) recordState(keyspace, name string, state workflow.State) {
+	if err := workflow.UpdateState(vrw.ctx, vrw.wr.ts, keyspace, name, state); err != nil {
+		vrw.wr.Logger().Warningf("Could not record state %v for workflow %s.%s: %v", state, keyspace, name, err)
+	}
+}
+
+// recordCopyDone moves a workflow that is recorded as COPYING to RUNNING,
+// once its streams are done copying.
+func (vrw *VReplicationWorkflow) recordCopyDone(keyspace, name string) {
+	wi, err := vrw.wr.ts.GetVReplicationWorkflow(vrw.ctx, keyspace, name)
+	if err != nil {
+		if !topo.IsErrType(err, topo.NoNode) {
+			vrw.wr.Logger().Warningf("Could not read record of workflow %s.%s: %v", keyspace, name, err)
+		}
+		return
+	}
+	if wi.State == workflow.StateCopying {
+		vrw.recordState(keyspace, name, workflow.StateRunning)
+	}
+}
+
+// deleteRecord deletes the lifecycle record of a workflow whose creation
+// failed. Failures are logged, as the action itself has already been applied.
+func (vrw *VReplicationWorkflow) deleteRecord(keyspace, name string) {
+	if err := workflow.Delete(vrw.ctx, vrw.wr.ts, keyspace, name); err != nil {
+		vrw.wr.Logger().Warningf("Could not delete record of workflow %s.%s: %v", keyspace, name, err)
+	}
+}
+
+// recordSwitchedState persists the lifecycle state of a workflow after
+// traffic has been switched, deducing it from the routing rules and shard
+// records.
+func (vrw *VReplicationWorkflow) recordSwitchedState(keyspace, name string) {
+	_, ws, err := vrw.wr.getWorkflowState(vrw.ctx, keyspace, name)
+	if err != nil || ws == nil {
+		vrw.wr.Logger().Warningf("Could not read state of workflow %s.%s: %v", keyspace, name, err)
+		return
+	}
+	switch {
+	case ws.WritesSwitched:
+		vrw.recordState(keyspace, name, workflow.StateWritesSwitched)
+	case len(ws.ReplicaCellsSwitched) > 0 || len(ws.RdonlyCellsSwitched) > 0:
+		vrw.recordState(keyspace, name, workflow.StateReadsSwitched)
+	default:
+		vrw.recordState(keyspace, name, workflow.StateRunning)
+	}
+}
+
 func (vrw *VReplicationWorkflow) getCellsAsArray() []string {
 	if vrw.params.Cells != "" {
 		return strings.Split(vrw.params.Cells, ",")
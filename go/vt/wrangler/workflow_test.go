@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
 
 	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
@@ -185,11 +186,59 @@ func TestMoveTablesV2(t *testing.T) {
 	tme.expectNoPreviousJournals()
 	require.NoError(t, testSwitchForward(t, wf))
 	require.Equal(t, WorkflowStateAllSwitched, wf.CurrentState())
+	checkWorkflowRecordState(ctx, t, wf.wr.ts, "ks2", "test", workflow.StateWritesSwitched)
 
 	tme.expectNoPreviousJournals()
 	tme.expectNoPreviousReverseJournals()
 	require.NoError(t, testReverse(t, wf))
 	require.Equal(t, WorkflowStateNotSwitched, wf.CurrentState())
+	checkWorkflowRecordState(ctx, t, wf.wr.ts, "ks2", "test", workflow.StateRunning)
+}
+
+func checkWorkflowRecordState(ctx context.Context, t *testing.T, ts *topo.Server, keyspace, name string, state workflow.State) {
+	wi, err := ts.GetVReplicationWorkflow(ctx, keyspace, name)
+	require.NoError(t, err)
+	require.Equal(t, state, wi.State)
+}
+
+func checkWorkflowRecordDeleted(ctx context.Context, t *testing.T, ts *topo.Server, keyspace, name string) {
+	_, err := ts.GetVReplicationWorkflow(ctx, keyspace, name)
+	require.True(t, topo.IsErrType(err, topo.NoNode), "workflow record %s.%s was not deleted: %v", keyspace, name, err)
+}
+
+func TestMoveTablesV2CreateRecord(t *testing.T) {
+	ctx := context.Background()
+	p := &VReplicationWorkflowParams{
+		Workflow:       "test2",
+		SourceKeyspace: "ks1",
+		TargetKeyspace: "ks2",
+		Tables:         "t1,t2",
+		Cells:          "cell1,cell2",
+		TabletTypes:    "replica,rdonly,master",
+		Timeout:        DefaultActionTimeout,
+	}
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+	for _, dbclient := range tme.dbTargetClients {
+		dbclient.addInvariant("select id, source, message, cell, tablet_types from _vt.vreplication where workflow='test2' and db_name='vt_ks2'", &sqltypes.Result{})
+	}
+
+	// A workflow that is still in progress is detected before any stream is created.
+	require.NoError(t, workflow.Create(ctx, tme.ts, "ks2", "test2", "MoveTables", "ks1"))
+	wf, err := tme.wr.NewVReplicationWorkflow(ctx, MoveTablesWorkflow, p)
+	require.NoError(t, err)
+	err = wf.Create()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "workflow ks2.test2 already exists in state COPYING")
+	checkWorkflowRecordState(ctx, t, tme.ts, "ks2", "test2", workflow.StateCopying)
+
+	// The record is deleted if the streams cannot be created.
+	require.NoError(t, workflow.Delete(ctx, tme.ts, "ks2", "test2"))
+	p.SourceKeyspace = "nonexistent"
+	wf, err = tme.wr.NewVReplicationWorkflow(ctx, MoveTablesWorkflow, p)
+	require.NoError(t, err)
+	require.Error(t, wf.Create())
+	checkWorkflowRecordDeleted(ctx, t, tme.ts, "ks2", "test2")
 }
 
 func validateRoutingRuleCount(ctx context.Context, t *testing.T, ts *topo.Server, cnt int) {
@@ -239,6 +288,7 @@ func TestMoveTablesV2Complete(t *testing.T) {
 	require.True(t, checkIfTableExistInVSchema(ctx, t, wf.wr.ts, "ks2", "t1"))
 	require.True(t, checkIfTableExistInVSchema(ctx, t, wf.wr.ts, "ks2", "t2"))
 	require.NoError(t, testComplete(t, wf))
+	checkWorkflowRecordState(ctx, t, wf.wr.ts, "ks2", "test", workflow.StateCompleted)
 	require.False(t, checkIfTableExistInVSchema(ctx, t, wf.wr.ts, "ks1", "t1"))
 	require.False(t, checkIfTableExistInVSchema(ctx, t, wf.wr.ts, "ks1", "t2"))
 	require.True(t, checkIfTableExistInVSchema(ctx, t, wf.wr.ts, "ks2", "t1"))
@@ -294,6 +344,7 @@ func TestMoveTablesV2Partial(t *testing.T) {
 	wf.params.Cells = "cell1,cell2"
 	require.NoError(t, testSwitchForward(t, wf))
 	require.Equal(t, WorkflowStateReadsSwitched, wf.CurrentState())
+	checkWorkflowRecordState(ctx, t, wf.wr.ts, "ks2", "test", workflow.StateReadsSwitched)
 
 	tme.expectNoPreviousJournals()
 	wf.params.TabletTypes = "replica,rdonly"
@@ -340,6 +391,7 @@ func TestMoveTablesV2Cancel(t *testing.T) {
 	require.True(t, checkIfTableExistInVSchema(ctx, t, wf.wr.ts, "ks2", "t2"))
 
 	require.NoError(t, wf.Cancel())
+	checkWorkflowRecordState(ctx, t, wf.wr.ts, "ks2", "test", workflow.StateCancelled)
 
 	validateRoutingRuleCount(ctx, t, wf.wr.ts, 0)
 